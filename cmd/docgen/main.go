@@ -38,6 +38,7 @@ var packages = []Package{
 	{Name: "errors", Path: "pkg/errors", Position: 12},
 	{Name: "validation", Path: "pkg/validation", Position: 13},
 	{Name: "accessibility", Path: "pkg/accessibility", Position: 14},
+	{Name: "intl", Path: "pkg/intl", Position: 15},
 }
 
 func main() {
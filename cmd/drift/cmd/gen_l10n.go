@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-drift/drift/cmd/drift/internal/l10ngen"
+)
+
+func init() {
+	RegisterCommand(&Command{
+		Name:  "gen-l10n",
+		Short: "Generate typed message accessors from ARB files",
+		Long: `Generate a Go package with typed accessors for localized messages.
+
+Reads every .arb and .json file in the message directory, one per locale.
+The locale comes from the file's "@@locale" key, or from its name
+(en.json, app_fr.arb, app_pt_BR.arb). Messages use ICU syntax with plural
+and select support, and are validated before any code is written.
+
+The template locale's messages define the accessors; other locales may
+leave messages out, which then fall back to the template.
+
+Flags:
+  --dir DIR          Message directory (default: l10n)
+  --out FILE         Output file (default: DIR/l10n.go)
+  --package NAME     Go package name (default: base name of the output directory)
+  --template LOCALE  Template locale (default: en)
+
+The generated package provides SupportedLocales and Load for
+intl.Localizations, and Of(ctx) for typed access:
+
+  l10n.Of(ctx).ItemCount(3)`,
+		Usage: "drift gen-l10n [--dir DIR] [--out FILE] [--package NAME] [--template LOCALE]",
+		Run:   runGenL10n,
+	})
+}
+
+func runGenL10n(args []string) error {
+	opts := l10ngen.Options{Dir: "l10n"}
+	var out string
+	for i := 0; i < len(args); i++ {
+		var value *string
+		switch args[i] {
+		case "--dir":
+			value = &opts.Dir
+		case "--out":
+			value = &out
+		case "--package":
+			value = &opts.Package
+		case "--template":
+			value = &opts.TemplateLocale
+		default:
+			return fmt.Errorf("unknown argument %q\n\nUsage: drift gen-l10n [--dir DIR] [--out FILE] [--package NAME] [--template LOCALE]", args[i])
+		}
+		if i+1 >= len(args) {
+			return fmt.Errorf("%s requires a value", args[i])
+		}
+		*value = args[i+1]
+		i++
+	}
+	if out == "" {
+		out = filepath.Join(opts.Dir, "l10n.go")
+	}
+	if opts.Package == "" {
+		abs, err := filepath.Abs(filepath.Dir(out))
+		if err != nil {
+			return err
+		}
+		opts.Package = strings.ReplaceAll(filepath.Base(abs), "-", "_")
+	}
+
+	src, err := l10ngen.Generate(opts)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, src, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", out, err)
+	}
+	fmt.Printf("Generated %s\n", out)
+	return nil
+}
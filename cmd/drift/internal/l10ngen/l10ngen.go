@@ -0,0 +1,422 @@
+// Package l10ngen generates typed message accessors from ARB and JSON
+// message files for the intl package.
+//
+// It validates messages with internal/icu rather than pkg/intl, which would
+// link the CLI against Skia.
+package l10ngen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-drift/drift/internal/icu"
+)
+
+// Options configures Generate.
+type Options struct {
+	// Dir holds the .arb and .json message files, one per locale.
+	Dir string
+	// Package is the Go package name of the generated file.
+	Package string
+	// TemplateLocale is the locale whose messages define the accessors and
+	// fill in messages other locales lack. Defaults to "en".
+	TemplateLocale string
+}
+
+// messageFile is one locale's messages and, for ARB files, their metadata.
+type messageFile struct {
+	path     string
+	locale   locale
+	messages map[string]string
+	meta     map[string]messageMeta
+}
+
+// locale is a normalized BCP 47 language tag.
+type locale struct {
+	language, script, region string
+}
+
+func parseLocale(tag string) locale {
+	language, script, region := icu.ParseTag(tag)
+	return locale{language, script, region}
+}
+
+func (l locale) String() string {
+	return icu.FormatTag(l.language, l.script, l.region)
+}
+
+// messageMeta is the "@key" metadata of an ARB message.
+type messageMeta struct {
+	Description  string                     `json:"description"`
+	Placeholders map[string]placeholderMeta `json:"placeholders"`
+}
+
+type placeholderMeta struct {
+	Type string `json:"type"`
+}
+
+// Generate reads the message files in opts.Dir and returns Go source for a
+// package with SupportedLocales, a Load function for intl.Localizations,
+// and a Localizations type with one method per template message.
+func Generate(opts Options) ([]byte, error) {
+	if opts.TemplateLocale == "" {
+		opts.TemplateLocale = "en"
+	}
+	files, err := readDir(opts.Dir)
+	if err != nil {
+		return nil, err
+	}
+	templateLocale := parseLocale(opts.TemplateLocale)
+	var template *messageFile
+	for i, f := range files {
+		if f.locale == templateLocale {
+			template = f
+			// The template locale is listed first so it is the fallback.
+			files[0], files[i] = files[i], files[0]
+			break
+		}
+	}
+	if template == nil {
+		return nil, fmt.Errorf("no messages for template locale %q in %s", opts.TemplateLocale, opts.Dir)
+	}
+	sort.SliceStable(files[1:], func(i, j int) bool {
+		return files[1+i].locale.String() < files[1+j].locale.String()
+	})
+
+	for _, f := range files {
+		for key, message := range f.messages {
+			if _, err := icu.Parse(message); err != nil {
+				return nil, fmt.Errorf("%s: message %q: %w", f.path, key, err)
+			}
+			if _, ok := template.messages[key]; !ok {
+				return nil, fmt.Errorf("%s: message %q is not in the template %s", f.path, key, template.path)
+			}
+		}
+	}
+
+	methods, err := buildMethods(template)
+	if err != nil {
+		return nil, err
+	}
+	return render(opts.Package, files, methods)
+}
+
+// readDir loads every .arb and .json file in dir.
+func readDir(dir string) ([]*messageFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read message directory: %w", err)
+	}
+	var files []*messageFile
+	seen := map[locale]string{}
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || ext != ".arb" && ext != ".json" {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		f, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := seen[f.locale]; ok {
+			return nil, fmt.Errorf("%s and %s both hold messages for %s", other, path, f.locale)
+		}
+		seen[f.locale] = path
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no .arb or .json message files in %s", dir)
+	}
+	return files, nil
+}
+
+func readFile(path string) (*messageFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	f := &messageFile{
+		path:     path,
+		messages: map[string]string{},
+		meta:     map[string]messageMeta{},
+	}
+	for key, value := range raw {
+		switch {
+		case key == "@@locale":
+			var tag string
+			if err := json.Unmarshal(value, &tag); err != nil {
+				return nil, fmt.Errorf("%s: @@locale must be a string", path)
+			}
+			f.locale = parseLocale(tag)
+		case strings.HasPrefix(key, "@@"):
+			// Other global ARB attributes, such as @@last_modified.
+		case strings.HasPrefix(key, "@"):
+			var meta messageMeta
+			if err := json.Unmarshal(value, &meta); err != nil {
+				return nil, fmt.Errorf("%s: invalid metadata %q: %w", path, key, err)
+			}
+			f.meta[key[1:]] = meta
+		default:
+			var message string
+			if err := json.Unmarshal(value, &message); err != nil {
+				return nil, fmt.Errorf("%s: message %q must be a string", path, key)
+			}
+			f.messages[key] = message
+		}
+	}
+	if f.locale.language == "" {
+		f.locale = localeFromFileName(path)
+	}
+	if f.locale.language == "" {
+		return nil, fmt.Errorf("%s: no @@locale, and the file name doesn't end in a locale", path)
+	}
+	return f, nil
+}
+
+// localeFromFileName takes the locale from names like "fr.json",
+// "app_fr.arb", or "app_pt_BR.arb": everything after the first underscore,
+// or the whole name if it has none.
+func localeFromFileName(path string) locale {
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if _, suffix, ok := strings.Cut(base, "_"); ok {
+		base = suffix
+	}
+	l := parseLocale(base)
+	if len(l.language) > 3 || !strings.EqualFold(l.String(), strings.ReplaceAll(base, "_", "-")) {
+		return locale{}
+	}
+	return l
+}
+
+// method is one generated accessor.
+type method struct {
+	name        string
+	key         string
+	message     string
+	description string
+	params      []param
+}
+
+type param struct {
+	name   string
+	arg    string
+	goType string
+}
+
+func buildMethods(template *messageFile) ([]method, error) {
+	keys := make([]string, 0, len(template.messages))
+	for key := range template.messages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var methods []method
+	names := map[string]string{}
+	for _, key := range keys {
+		name := exportedName(key)
+		if name == "" {
+			return nil, fmt.Errorf("%s: message %q has no valid Go name", template.path, key)
+		}
+		if other, ok := names[name]; ok {
+			return nil, fmt.Errorf("%s: messages %q and %q both map to %s", template.path, other, key, name)
+		}
+		names[name] = key
+
+		if name == "Messages" {
+			return nil, fmt.Errorf("%s: message %q conflicts with the Messages method", template.path, key)
+		}
+
+		message, err := icu.Parse(template.messages[key])
+		if err != nil {
+			return nil, fmt.Errorf("%s: message %q: %w", template.path, key, err)
+		}
+		meta := template.meta[key]
+		m := method{name: name, key: key, message: template.messages[key], description: meta.Description}
+		for _, arg := range message.Args() {
+			m.params = append(m.params, param{
+				name:   paramName(arg.Name),
+				arg:    arg.Name,
+				goType: goType(meta.Placeholders[arg.Name].Type, arg.Kind),
+			})
+		}
+		methods = append(methods, m)
+	}
+	return methods, nil
+}
+
+// goType maps an ARB placeholder type to a Go type, guessing from how the
+// message uses the argument when the type is not declared.
+func goType(arbType string, kind icu.ArgKind) string {
+	switch arbType {
+	case "int":
+		return "int"
+	case "num", "double":
+		return "float64"
+	case "String":
+		return "string"
+	case "":
+	default:
+		return "any"
+	}
+	switch kind {
+	case icu.ArgPlural:
+		return "int"
+	case icu.ArgNumber:
+		return "float64"
+	default:
+		return "string"
+	}
+}
+
+// exportedName turns a message key such as "cart_title" or "cartTitle" into
+// CartTitle.
+func exportedName(key string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range key {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if b.Len() == 0 && unicode.IsDigit(r) {
+			return ""
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// paramName turns a placeholder name into a Go parameter name.
+func paramName(arg string) string {
+	name := exportedName(arg)
+	if name == "" {
+		return "arg"
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	name = string(runes)
+	if token.IsKeyword(name) {
+		name += "_"
+	}
+	return name
+}
+
+func render(pkg string, files []*messageFile, methods []method) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by \"drift gen-l10n\"; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import (\n\t\"github.com/go-drift/drift/pkg/core\"\n\t\"github.com/go-drift/drift/pkg/intl\"\n)\n\n")
+
+	fmt.Fprintf(&b, "// SupportedLocales lists the locales with messages, the template locale first.\n")
+	fmt.Fprintf(&b, "var SupportedLocales = []intl.Locale{\n")
+	for _, f := range files {
+		fields := []string{fmt.Sprintf("Language: %q", f.locale.language)}
+		if f.locale.script != "" {
+			fields = append(fields, fmt.Sprintf("Script: %q", f.locale.script))
+		}
+		if f.locale.region != "" {
+			fields = append(fields, fmt.Sprintf("Region: %q", f.locale.region))
+		}
+		fmt.Fprintf(&b, "\t{%s},\n", strings.Join(fields, ", "))
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "var sources = map[string]map[string]string{\n")
+	for _, f := range files {
+		fmt.Fprintf(&b, "\t%q: {\n", f.locale.String())
+		keys := make([]string, 0, len(f.messages))
+		for key := range f.messages {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(&b, "\t\t%q: %s,\n", key, strconv.Quote(f.messages[key]))
+		}
+		fmt.Fprintf(&b, "\t},\n")
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	b.WriteString(`// Load returns the messages for locale, using the template locale's
+// messages for any it lacks. Pass it to intl.Localizations.
+func Load(locale intl.Locale) (*intl.Messages, error) {
+	template, err := intl.NewMessages(SupportedLocales[0], sources[SupportedLocales[0].String()])
+	if err != nil {
+		return nil, err
+	}
+	source, ok := sources[locale.String()]
+	if !ok || locale == SupportedLocales[0] {
+		return template, nil
+	}
+	messages, err := intl.NewMessages(locale, source)
+	if err != nil {
+		return nil, err
+	}
+	return messages.WithFallback(template), nil
+}
+
+// Localizations provides typed access to the app's messages.
+type Localizations struct {
+	messages *intl.Messages
+}
+
+// Of returns the localizations provided by the nearest intl.Localizations.
+func Of(ctx core.BuildContext) Localizations {
+	return Localizations{messages: intl.LocalizationsOf(ctx)}
+}
+
+// Messages returns the underlying messages.
+func (l Localizations) Messages() *intl.Messages {
+	return l.messages
+}
+`)
+
+	for _, m := range methods {
+		fmt.Fprintf(&b, "\n// %s returns %s.\n", m.name, commentQuote(m.message))
+		if m.description != "" {
+			fmt.Fprintf(&b, "//\n// %s\n", strings.ReplaceAll(m.description, "\n", "\n// "))
+		}
+		if len(m.params) == 0 {
+			fmt.Fprintf(&b, "func (l Localizations) %s() string {\n\treturn l.messages.Text(%q)\n}\n", m.name, m.key)
+			continue
+		}
+		var params, args []string
+		for _, p := range m.params {
+			params = append(params, p.name+" "+p.goType)
+			args = append(args, fmt.Sprintf("%q: %s", p.arg, p.name))
+		}
+		fmt.Fprintf(&b, "func (l Localizations) %s(%s) string {\n\treturn l.messages.Format(%q, map[string]any{%s})\n}\n",
+			m.name, strings.Join(params, ", "), m.key, strings.Join(args, ", "))
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return src, nil
+}
+
+// commentQuote quotes a message for a doc comment, keeping it to one line.
+func commentQuote(message string) string {
+	if runes := []rune(message); len(runes) > 60 {
+		message = string(runes[:60]) + "..."
+	}
+	return strconv.Quote(message)
+}
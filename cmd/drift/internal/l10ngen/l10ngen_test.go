@@ -0,0 +1,129 @@
+package l10ngen
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestGenerate(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"app_en.arb": `{
+			"@@locale": "en",
+			"cartTitle": "Shopping cart",
+			"@cartTitle": {"description": "Title of the cart screen."},
+			"item_count": "{count, plural, one{# item} other{# items}}",
+			"greeting": "Hello, {name}!",
+			"total": "Total: {amount, number}",
+			"@total": {"placeholders": {"amount": {"type": "num"}}},
+			"reply": "{type, select, female{She} other{They}} replied"
+		}`,
+		"app_pt_BR.arb": `{"cartTitle": "Carrinho"}`,
+		"fr.json":       `{"cartTitle": "Panier", "greeting": "Bonjour, {name} !"}`,
+		"README.md":     "not a message file",
+	})
+
+	src, err := Generate(Options{Dir: dir, Package: "l10n"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "l10n.go", src, 0); err != nil {
+		t.Fatalf("generated code doesn't parse: %v\n%s", err, src)
+	}
+	code := string(src)
+	for _, want := range []string{
+		"// Code generated by \"drift gen-l10n\"; DO NOT EDIT.",
+		"package l10n",
+		"{Language: \"en\"},\n\t{Language: \"fr\"},\n\t{Language: \"pt\", Region: \"BR\"},",
+		"// CartTitle returns \"Shopping cart\".\n//\n// Title of the cart screen.\nfunc (l Localizations) CartTitle() string {",
+		"func (l Localizations) ItemCount(count int) string {",
+		`return l.messages.Format("item_count", map[string]any{"count": count})`,
+		"func (l Localizations) Greeting(name string) string {",
+		"func (l Localizations) Total(amount float64) string {",
+		"func (l Localizations) Reply(type_ string) string {",
+		`"cartTitle": "Carrinho",`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("expected generated code to contain %q\n%s", want, code)
+		}
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		opts  Options
+		want  string
+	}{
+		{
+			name:  "missing template",
+			files: map[string]string{"fr.json": `{"a": "b"}`},
+			want:  `template locale "en"`,
+		},
+		{
+			name:  "key not in template",
+			files: map[string]string{"en.json": `{"a": "A"}`, "fr.json": `{"b": "B"}`},
+			want:  `message "b" is not in the template`,
+		},
+		{
+			name:  "invalid message",
+			files: map[string]string{"en.json": `{"a": "{count, plural, one{x}}"}`},
+			want:  `message "a"`,
+		},
+		{
+			name:  "colliding names",
+			files: map[string]string{"en.json": `{"cart_title": "A", "cartTitle": "B"}`},
+			want:  "both map to CartTitle",
+		},
+		{
+			name:  "no locale",
+			files: map[string]string{"messages.json": `{"a": "A"}`},
+			want:  "no @@locale",
+		},
+		{
+			name:  "duplicate locale",
+			files: map[string]string{"en.json": `{"a": "A"}`, "app_en.arb": `{"a": "A"}`},
+			want:  "both hold messages for en",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Dir = writeFiles(t, tt.files)
+			tt.opts.Package = "l10n"
+			_, err := Generate(tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestLocaleFromFileName(t *testing.T) {
+	tests := map[string]string{
+		"en.json":          "en",
+		"app_fr.arb":       "fr",
+		"app_pt_BR.arb":    "pt-BR",
+		"intl_zh_Hant.arb": "zh-Hant",
+		"messages.json":    "",
+	}
+	for name, want := range tests {
+		if got := localeFromFileName(name).String(); got != want {
+			t.Errorf("localeFromFileName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+// Package plugins discovers drift plugins among a project's Go dependencies
+// and bundles their native code into generated platform projects.
+//
+// A plugin is a Go module with a drift_plugin.yaml manifest at its root:
+//
+//	name: example.com/battery
+//	android:
+//	  class: com.example.battery.BatteryPlugin
+//	  dependencies:
+//	    - androidx.core:core-ktx:1.12.0
+//	ios:
+//	  class: BatteryPlugin
+//
+// Native sources live in the module's android/ and ios/ directories unless
+// the manifest names other directories. They are rendered as templates with
+// the app's template data, so Kotlin sources can import the embedder's
+// DriftPlugin interface from {{.PackageName}}.
+package plugins
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-drift/drift/cmd/drift/internal/templates"
+)
+
+// ManifestName is the file that marks a Go module as a drift plugin.
+const ManifestName = "drift_plugin.yaml"
+
+// Manifest describes a plugin's native halves.
+type Manifest struct {
+	// Name is the name the Go half passes to platform.RegisterPlugin,
+	// conventionally the plugin's import path.
+	Name    string          `yaml:"name"`
+	Android AndroidManifest `yaml:"android,omitempty"`
+	IOS     IOSManifest     `yaml:"ios,omitempty"`
+}
+
+// AndroidManifest describes a plugin's Android half.
+type AndroidManifest struct {
+	// Class is the fully qualified name of the class implementing
+	// DriftPlugin. Empty means the plugin has no Android half.
+	Class string `yaml:"class,omitempty"`
+	// Sources is the directory of Kotlin and Java sources, relative to the
+	// module root. Defaults to "android".
+	Sources string `yaml:"sources,omitempty"`
+	// Dependencies are Gradle dependency coordinates added to the app.
+	Dependencies []string `yaml:"dependencies,omitempty"`
+}
+
+// IOSManifest describes a plugin's iOS half.
+type IOSManifest struct {
+	// Class is the name of the Swift class implementing DriftPlugin. Empty
+	// means the plugin has no iOS half.
+	Class string `yaml:"class,omitempty"`
+	// Sources is the directory of Swift sources, relative to the module
+	// root. Defaults to "ios".
+	Sources string `yaml:"sources,omitempty"`
+}
+
+// Plugin is a plugin found in a module dependency.
+type Plugin struct {
+	Manifest
+	Module  string // Go module path
+	Version string // module version, empty for the main module or replacements
+	Dir     string // module root directory
+}
+
+// Load reads the plugin manifest in dir. It returns nil without an error if
+// dir has no manifest.
+func Load(module, version, dir string) (*Plugin, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ManifestName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s for %s: %w", ManifestName, module, err)
+	}
+
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse %s for %s: %w", ManifestName, module, err)
+	}
+	if strings.TrimSpace(manifest.Name) == "" {
+		return nil, fmt.Errorf("%s for %s: name is required", ManifestName, module)
+	}
+	if manifest.Android.Sources == "" {
+		manifest.Android.Sources = "android"
+	}
+	if manifest.IOS.Sources == "" {
+		manifest.IOS.Sources = "ios"
+	}
+
+	return &Plugin{Manifest: manifest, Module: module, Version: version, Dir: dir}, nil
+}
+
+// Discover returns the plugins among the modules that provide packages
+// linked into the app at root, sorted by module path. Modules that are
+// required but not imported are ignored.
+func Discover(root string) ([]Plugin, error) {
+	// -e keeps listing when user code has errors; the build reports them.
+	cmd := exec.Command("go", "list", "-e", "-deps",
+		"-f", "{{with .Module}}{{.Path}}\t{{.Version}}\t{{if .Replace}}{{.Replace.Dir}}{{else}}{{.Dir}}{{end}}{{end}}",
+		"./...")
+	cmd.Dir = root
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list module dependencies: %w\n%s", err, stderr.String())
+	}
+
+	seen := make(map[string]bool)
+	var plugins []Plugin
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) != 3 || fields[2] == "" || seen[fields[0]] {
+			continue
+		}
+		seen[fields[0]] = true
+
+		plugin, err := Load(fields[0], fields[1], fields[2])
+		if err != nil {
+			return nil, err
+		}
+		if plugin != nil {
+			plugins = append(plugins, *plugin)
+		}
+	}
+
+	slices.SortFunc(plugins, func(a, b Plugin) int {
+		return strings.Compare(a.Module, b.Module)
+	})
+	return plugins, nil
+}
+
+// AndroidDependencies returns the Gradle dependencies of all plugins,
+// without duplicates.
+func AndroidDependencies(plugins []Plugin) []string {
+	var deps []string
+	for _, p := range plugins {
+		for _, dep := range p.Android.Dependencies {
+			if !slices.Contains(deps, dep) {
+				deps = append(deps, dep)
+			}
+		}
+	}
+	return deps
+}
+
+// WriteAndroid copies the plugins' Android sources into javaDir, the
+// app's src/main/java directory, and writes a GeneratedPluginRegistrant
+// that adds each plugin to DriftPluginRegistry. It writes nothing if no
+// plugin has an Android half.
+func WriteAndroid(javaDir string, data *templates.TemplateData, plugins []Plugin) error {
+	var classes []string
+	for _, p := range plugins {
+		if p.Android.Class == "" {
+			continue
+		}
+		dest := filepath.Join(javaDir, "drift_plugins", dirName(p.Module))
+		if _, err := copySources(filepath.Join(p.Dir, p.Android.Sources), dest, data, ".kt", ".java"); err != nil {
+			return fmt.Errorf("plugin %s: %w", p.Module, err)
+		}
+		classes = append(classes, p.Android.Class)
+	}
+	if len(classes) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString("// Generated by drift. Do not edit.\n")
+	fmt.Fprintf(&b, "package %s\n\n", data.PackageName)
+	b.WriteString("object GeneratedPluginRegistrant {\n")
+	b.WriteString("    @JvmStatic\n")
+	b.WriteString("    fun registerWith(registry: DriftPluginRegistry) {\n")
+	for _, class := range classes {
+		fmt.Fprintf(&b, "        registry.add(%s())\n", class)
+	}
+	b.WriteString("    }\n}\n")
+
+	appDir := filepath.Join(javaDir, filepath.FromSlash(data.PackagePath))
+	if err := os.MkdirAll(appDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", appDir, err)
+	}
+	return os.WriteFile(filepath.Join(appDir, "GeneratedPluginRegistrant.kt"), []byte(b.String()), 0o644)
+}
+
+// WriteIOS copies the plugins' Swift sources into a Plugins directory
+// under sourcesDir, the app's Swift source directory, and writes a
+// GeneratedPluginRegistrant that adds each plugin to DriftPluginRegistry.
+// It returns the written files as paths relative to sourcesDir, for adding
+// to an Xcode project. It writes nothing if no plugin has an iOS half.
+func WriteIOS(sourcesDir string, data *templates.TemplateData, plugins []Plugin) ([]string, error) {
+	var files, classes []string
+	for _, p := range plugins {
+		if p.IOS.Class == "" {
+			continue
+		}
+		rel := filepath.Join("Plugins", dirName(p.Module))
+		copied, err := copySources(filepath.Join(p.Dir, p.IOS.Sources), filepath.Join(sourcesDir, rel), data, ".swift")
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s: %w", p.Module, err)
+		}
+		for _, file := range copied {
+			files = append(files, filepath.Join(rel, file))
+		}
+		classes = append(classes, p.IOS.Class)
+	}
+	if len(classes) == 0 {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	b.WriteString("// Generated by drift. Do not edit.\n")
+	b.WriteString("import Foundation\n\n")
+	b.WriteString("@objc(GeneratedPluginRegistrant)\n")
+	b.WriteString("final class GeneratedPluginRegistrant: NSObject, DriftPluginRegistrant {\n")
+	b.WriteString("    static func register(with registry: DriftPluginRegistry.Type) {\n")
+	for _, class := range classes {
+		fmt.Fprintf(&b, "        registry.add(%s())\n", class)
+	}
+	b.WriteString("    }\n}\n")
+
+	registrant := filepath.Join("Plugins", "GeneratedPluginRegistrant.swift")
+	if err := os.MkdirAll(filepath.Join(sourcesDir, "Plugins"), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create plugin directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(sourcesDir, registrant), []byte(b.String()), 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", registrant, err)
+	}
+	return append(files, registrant), nil
+}
+
+// copySources renders the files with the given extensions under src into
+// dest, keeping their relative paths, and returns those paths.
+func copySources(src, dest string, data *templates.TemplateData, exts ...string) ([]string, error) {
+	var copied []string
+	err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !slices.Contains(exts, filepath.Ext(path)) {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		processed, err := templates.ProcessTemplate(string(content), data)
+		if err != nil {
+			return fmt.Errorf("failed to process %s: %w", path, err)
+		}
+
+		rel, _ := filepath.Rel(src, path)
+		target := filepath.Join(dest, rel)
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", target, err)
+		}
+		if err := os.WriteFile(target, []byte(processed), 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", target, err)
+		}
+		copied = append(copied, rel)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy native sources: %w", err)
+	}
+	return copied, nil
+}
+
+// dirName turns a module path into a directory name.
+func dirName(module string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, module)
+}
@@ -0,0 +1,159 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-drift/drift/cmd/drift/internal/templates"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// batteryPlugin writes a plugin module with an Android and iOS half.
+func batteryPlugin(t *testing.T) Plugin {
+	t.Helper()
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, ManifestName), `name: example.com/battery
+android:
+  class: com.example.battery.BatteryPlugin
+  dependencies:
+    - androidx.core:core-ktx:1.12.0
+ios:
+  class: BatteryPlugin
+`)
+	writeFile(t, filepath.Join(dir, "android", "com", "example", "battery", "BatteryPlugin.kt"),
+		"package com.example.battery\n\nimport {{.PackageName}}.DriftPlugin\n")
+	writeFile(t, filepath.Join(dir, "android", "README.md"), "not a source")
+	writeFile(t, filepath.Join(dir, "ios", "BatteryPlugin.swift"), "final class BatteryPlugin: DriftPlugin {}\n")
+
+	plugin, err := Load("example.com/battery", "v1.0.0", dir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return *plugin
+}
+
+func testData() *templates.TemplateData {
+	return templates.NewTemplateData(templates.TemplateInput{
+		AppName:        "demo",
+		AndroidPackage: "com.example.demo",
+		IOSBundleID:    "com.example.demo",
+	})
+}
+
+func TestLoad(t *testing.T) {
+	plugin := batteryPlugin(t)
+	if plugin.Name != "example.com/battery" || plugin.Version != "v1.0.0" {
+		t.Errorf("unexpected plugin %+v", plugin)
+	}
+	if plugin.Android.Sources != "android" || plugin.IOS.Sources != "ios" {
+		t.Errorf("expected default source directories, got %q and %q", plugin.Android.Sources, plugin.IOS.Sources)
+	}
+
+	if plugin, err := Load("example.com/plain", "", t.TempDir()); plugin != nil || err != nil {
+		t.Errorf("expected nil without a manifest, got %+v, %v", plugin, err)
+	}
+
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, ManifestName), "android:\n  class: Foo\n")
+	if _, err := Load("example.com/unnamed", "", dir); err == nil || !strings.Contains(err.Error(), "name is required") {
+		t.Errorf("expected a missing name error, got %v", err)
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/app\n\ngo 1.21\n")
+	writeFile(t, filepath.Join(root, "main.go"), "package main\n\nfunc main() {}\n")
+	writeFile(t, filepath.Join(root, ManifestName), "name: example.com/app\nios:\n  class: AppPlugin\n")
+
+	found, err := Discover(root)
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	if len(found) != 1 || found[0].Module != "example.com/app" || found[0].IOS.Class != "AppPlugin" {
+		t.Errorf("expected the app's own plugin, got %+v", found)
+	}
+}
+
+func TestWriteAndroid(t *testing.T) {
+	javaDir := t.TempDir()
+	data := testData()
+	plugins := []Plugin{batteryPlugin(t), {Manifest: Manifest{Name: "example.com/iosonly", IOS: IOSManifest{Class: "Only"}}}}
+
+	if err := WriteAndroid(javaDir, data, plugins); err != nil {
+		t.Fatalf("WriteAndroid: %v", err)
+	}
+
+	copied := readFile(t, filepath.Join(javaDir, "drift_plugins", "example_com_battery", "com", "example", "battery", "BatteryPlugin.kt"))
+	if !strings.Contains(copied, "import com.example.demo.DriftPlugin") {
+		t.Errorf("expected the source to be rendered, got:\n%s", copied)
+	}
+	if _, err := os.Stat(filepath.Join(javaDir, "drift_plugins", "example_com_battery", "README.md")); !os.IsNotExist(err) {
+		t.Error("expected non-source files to be skipped")
+	}
+
+	registrant := readFile(t, filepath.Join(javaDir, "com", "example", "demo", "GeneratedPluginRegistrant.kt"))
+	if !strings.Contains(registrant, "package com.example.demo") ||
+		!strings.Contains(registrant, "registry.add(com.example.battery.BatteryPlugin())") {
+		t.Errorf("unexpected registrant:\n%s", registrant)
+	}
+	if strings.Contains(registrant, "Only") {
+		t.Error("expected plugins without an Android half to be skipped")
+	}
+
+	if deps := AndroidDependencies(append(plugins, plugins[0])); len(deps) != 1 || deps[0] != "androidx.core:core-ktx:1.12.0" {
+		t.Errorf("expected one deduplicated dependency, got %v", deps)
+	}
+}
+
+func TestWriteAndroid_NoPlugins(t *testing.T) {
+	javaDir := t.TempDir()
+	if err := WriteAndroid(javaDir, testData(), nil); err != nil {
+		t.Fatalf("WriteAndroid: %v", err)
+	}
+	if entries, _ := os.ReadDir(javaDir); len(entries) != 0 {
+		t.Errorf("expected nothing to be written, got %d entries", len(entries))
+	}
+}
+
+func TestWriteIOS(t *testing.T) {
+	sourcesDir := t.TempDir()
+
+	files, err := WriteIOS(sourcesDir, testData(), []Plugin{batteryPlugin(t)})
+	if err != nil {
+		t.Fatalf("WriteIOS: %v", err)
+	}
+	want := []string{
+		filepath.Join("Plugins", "example_com_battery", "BatteryPlugin.swift"),
+		filepath.Join("Plugins", "GeneratedPluginRegistrant.swift"),
+	}
+	if strings.Join(files, ",") != strings.Join(want, ",") {
+		t.Errorf("expected files %v, got %v", want, files)
+	}
+
+	registrant := readFile(t, filepath.Join(sourcesDir, want[1]))
+	if !strings.Contains(registrant, "@objc(GeneratedPluginRegistrant)") ||
+		!strings.Contains(registrant, "registry.add(BatteryPlugin())") {
+		t.Errorf("unexpected registrant:\n%s", registrant)
+	}
+}
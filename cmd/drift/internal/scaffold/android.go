@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/go-drift/drift/cmd/drift/internal/icongen"
+	"github.com/go-drift/drift/cmd/drift/internal/plugins"
 	"github.com/go-drift/drift/cmd/drift/internal/templates"
 )
 
@@ -36,6 +37,8 @@ func WriteAndroid(root string, settings Settings) error {
 		IOSBundleID:    settings.Bundle,
 		Orientation:    settings.Orientation,
 		AllowHTTP:      settings.AllowHTTP,
+
+		AndroidDependencies: plugins.AndroidDependencies(settings.Plugins),
 	})
 
 	writeTemplateFile := func(templatePath, destPath string, perm os.FileMode) error {
@@ -112,6 +115,11 @@ func WriteAndroid(root string, settings Settings) error {
 		return err
 	}
 
+	// Bundle plugin sources and the generated plugin registrant
+	if err := plugins.WriteAndroid(filepath.Join(srcDir, "java"), tmplData, settings.Plugins); err != nil {
+		return err
+	}
+
 	// Write C/C++ files from templates
 	if err := templates.CopyTree("android/cpp", cppDir, tmplData, nil); err != nil {
 		return err
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/go-drift/drift/cmd/drift/internal/icongen"
+	"github.com/go-drift/drift/cmd/drift/internal/plugins"
 	"github.com/go-drift/drift/cmd/drift/internal/templates"
 )
 
@@ -21,13 +22,22 @@ func WriteIOS(root string, settings Settings) error {
 	iosDir := filepath.Join(root, "ios", "Runner")
 
 	// Create template data
-	tmplData := templates.NewTemplateData(templates.TemplateInput{
+	input := templates.TemplateInput{
 		AppName:        settings.AppName,
 		AndroidPackage: settings.AppID,
 		IOSBundleID:    settings.Bundle,
 		Orientation:    settings.Orientation,
 		AllowHTTP:      settings.AllowHTTP,
-	})
+	}
+
+	// Bundle plugin sources and the generated plugin registrant, which the
+	// Xcode project must list
+	pluginSources, err := plugins.WriteIOS(iosDir, templates.NewTemplateData(input), settings.Plugins)
+	if err != nil {
+		return err
+	}
+	input.IOSSources = pluginSources
+	tmplData := templates.NewTemplateData(input)
 
 	// Write iOS template files (Info.plist, Swift sources, LaunchScreen.storyboard)
 	isIOSFile := func(name string) bool {
@@ -1,5 +1,7 @@
 package scaffold
 
+import "github.com/go-drift/drift/cmd/drift/internal/plugins"
+
 // Settings describes the app metadata used for scaffolding.
 type Settings struct {
 	AppName        string
@@ -11,4 +13,5 @@ type Settings struct {
 	ProjectRoot    string
 	Icon           string
 	IconBackground string
+	Plugins        []plugins.Plugin // native halves to bundle into the project
 }
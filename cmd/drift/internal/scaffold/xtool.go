@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/go-drift/drift/cmd/drift/internal/icongen"
+	"github.com/go-drift/drift/cmd/drift/internal/plugins"
 	"github.com/go-drift/drift/cmd/drift/internal/templates"
 )
 
@@ -58,6 +59,12 @@ func WriteXtool(root string, settings Settings) error {
 		return err
 	}
 
+	// Bundle plugin sources and the generated plugin registrant; SwiftPM
+	// compiles every source under Sources/Runner
+	if _, err := plugins.WriteIOS(sourcesDir, tmplData, settings.Plugins); err != nil {
+		return err
+	}
+
 	// Write LaunchScreen.storyboard to resources
 	if err := templates.CopyTree("ios", resourcesDir, tmplData, func(name string) bool {
 		return name == "LaunchScreen.storyboard"
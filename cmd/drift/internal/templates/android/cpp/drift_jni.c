@@ -136,6 +136,15 @@ typedef void (*DriftSetScheduleFrameHandlerFn)(void (*handler)(void));
  */
 typedef int (*DriftHitTestPlatformViewFn)(int64_t viewID, double x, double y);
 
+/**
+ * Function pointer type for DriftPlatformViewTextureFrame.
+ * Matches the signature exported by Go:
+ *   func DriftPlatformViewTextureFrame(viewID C.int64_t, width, height, stride C.int, pixels *C.uint8_t)
+ *
+ * Go copies the pixels before returning, so the buffer may be reused.
+ */
+typedef void (*DriftPlatformViewTextureFrameFn)(int64_t viewID, int width, int height, int stride, uint8_t *pixels);
+
 /* Cached function pointers. NULL until resolved. */
 static DriftPointerFn drift_pointer_event = NULL;
 static DriftSetScaleFn drift_set_scale = NULL;
@@ -150,6 +159,7 @@ static DriftBackButtonFn drift_back_button = NULL;
 static DriftRequestFrameFn drift_request_frame = NULL;
 static DriftNeedsFrameFn drift_needs_frame = NULL;
 static DriftHitTestPlatformViewFn drift_hit_test_platform_view = NULL;
+static DriftPlatformViewTextureFrameFn drift_platform_view_texture_frame = NULL;
 static DriftSetScheduleFrameHandlerFn drift_set_schedule_frame_handler = NULL;
 
 /* Function pointer types for unified orchestrator */
@@ -759,6 +769,40 @@ Java_{{.JNIPackage}}_NativeBridge_hitTestPlatformView(
     return (jint)drift_hit_test_platform_view((int64_t)viewID, x, y);
 }
 
+/**
+ * JNI implementation for NativeBridge.platformViewTextureFrame().
+ *
+ * Hands a captured frame of a texture-composited platform view to Go.
+ *
+ * @param viewID Platform view ID the frame belongs to
+ * @param width  Frame width in pixels
+ * @param height Frame height in pixels
+ * @param pixels Direct ByteBuffer holding tightly packed RGBA_8888 pixels
+ */
+JNIEXPORT void JNICALL
+Java_{{.JNIPackage}}_NativeBridge_platformViewTextureFrame(
+    JNIEnv *env,
+    jclass clazz,
+    jlong viewID,
+    jint width,
+    jint height,
+    jobject pixels
+) {
+    (void)clazz;
+
+    if (resolve_symbol("DriftPlatformViewTextureFrame", (void **)&drift_platform_view_texture_frame) != 0) {
+        return;
+    }
+
+    uint8_t *data = (uint8_t *)(*env)->GetDirectBufferAddress(env, pixels);
+    jlong capacity = (*env)->GetDirectBufferCapacity(env, pixels);
+    if (data == NULL || width <= 0 || height <= 0 || capacity < (jlong)width * height * 4) {
+        return;
+    }
+
+    drift_platform_view_texture_frame((int64_t)viewID, width, height, width * 4, data);
+}
+
 /**
  * JNI_OnLoad is called when the native library is loaded.
  * We save the JavaVM reference for later use in callbacks.
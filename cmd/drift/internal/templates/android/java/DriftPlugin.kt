@@ -0,0 +1,99 @@
+/**
+ * DriftPlugin.kt
+ * Native half of the plugin interface. A plugin pairs a Go package that calls
+ * platform.RegisterPlugin with Kotlin code that registers handlers for the
+ * same channel names and view types here.
+ */
+package {{.PackageName}}
+
+import android.content.Context
+import android.util.Log
+
+/**
+ * Implemented by the Android side of a Drift plugin.
+ */
+interface DriftPlugin {
+    /** Registers the plugin's channel handlers and platform view factories. */
+    fun onRegister(registrar: DriftPluginRegistrar)
+}
+
+/** Creates a platform view container for a plugin view type. */
+typealias PlatformViewContainerFactory = (context: Context, viewId: Int, params: Map<String, Any?>) -> PlatformViewContainer
+
+/**
+ * Registers channels and platform views on behalf of a plugin.
+ */
+class DriftPluginRegistrar internal constructor(val context: Context) {
+    /**
+     * Registers a handler for a channel created in Go with the registrar's
+     * MethodChannel or MethodChannelWithCodec. The codec must match.
+     */
+    fun registerChannel(channel: String, codec: MessageCodec = JsonCodec, handler: MethodHandler) {
+        PlatformChannelManager.register(channel, handler, codec)
+    }
+
+    /** Sets the codec for an event-only channel created in Go. */
+    fun setEventCodec(channel: String, codec: MessageCodec) {
+        PlatformChannelManager.setCodec(channel, codec)
+    }
+
+    /** Sends an event to Go listeners of an event channel. */
+    fun sendEvent(channel: String, data: Any?) {
+        PlatformChannelManager.sendEvent(channel, data)
+    }
+
+    /** Registers a factory for a view type the Go side creates with a PlatformViewFactory. */
+    fun registerViewFactory(viewType: String, factory: PlatformViewContainerFactory) {
+        PlatformViewHandler.registerFactory(viewType, factory)
+    }
+}
+
+/**
+ * Registers plugins with the embedder at startup.
+ *
+ * Plugins are listed in a generated class named GeneratedPluginRegistrant in
+ * the app package with a static registerWith(DriftPluginRegistry) method.
+ * When the class is absent, no plugins are registered.
+ */
+object DriftPluginRegistry {
+    private const val TAG = "DriftPlugins"
+    private val plugins = mutableListOf<DriftPlugin>()
+    private var registrar: DriftPluginRegistrar? = null
+
+    /** Adds a plugin. Plugins added after startup register immediately. */
+    @Synchronized
+    fun add(plugin: DriftPlugin) {
+        if (plugins.any { it.javaClass == plugin.javaClass }) return
+        plugins.add(plugin)
+        registrar?.let { register(plugin, it) }
+    }
+
+    /**
+     * Loads the generated plugin list and registers every plugin. Safe to call
+     * more than once; plugins register only on the first call.
+     */
+    @Synchronized
+    fun registerAll(context: Context) {
+        if (registrar != null) return
+        try {
+            Class.forName("{{.PackageName}}.GeneratedPluginRegistrant")
+                .getMethod("registerWith", DriftPluginRegistry::class.java)
+                .invoke(null, this)
+        } catch (_: ClassNotFoundException) {
+            // No plugins in this app.
+        } catch (e: Exception) {
+            Log.e(TAG, "Failed to load generated plugins", e)
+        }
+        val r = DriftPluginRegistrar(context.applicationContext)
+        registrar = r
+        plugins.forEach { register(it, r) }
+    }
+
+    private fun register(plugin: DriftPlugin, registrar: DriftPluginRegistrar) {
+        try {
+            plugin.onRegister(registrar)
+        } catch (e: Exception) {
+            Log.e(TAG, "Plugin ${plugin.javaClass.name} failed to register", e)
+        }
+    }
+}
@@ -10,8 +10,11 @@ package {{.PackageName}}
 
 import android.os.Bundle
 import android.util.Log
+import android.view.View
+import android.view.ViewGroup
 import androidx.activity.OnBackPressedCallback
 import androidx.appcompat.app.AppCompatActivity
+import androidx.core.content.ContextCompat
 import androidx.core.view.ViewCompat
 
 class MainActivity : AppCompatActivity() {
@@ -24,6 +27,7 @@ class MainActivity : AppCompatActivity() {
         super.onCreate(savedInstanceState)
 
         PlatformChannelManager.init(applicationContext)
+        DriftPluginRegistry.registerAll(applicationContext)
         Log.i("DriftDeepLink", "onCreate intent action=${intent?.action} data=${intent?.dataString}")
         NotificationHandler.handleNotificationOpen(intent)
         DeepLinkHandler.handleIntent(intent, "launch")
@@ -31,6 +35,21 @@ class MainActivity : AppCompatActivity() {
         container = DriftContainer(this)
         setContentView(container)
 
+        // Cover the surface with the launch background until the first Drift
+        // frame is on screen. Skipped when the activity is recreated after
+        // the engine already hid it.
+        if (!SplashHandler.isHidden) {
+            val splash = View(this).apply {
+                background = ContextCompat.getDrawable(this@MainActivity, R.drawable.launch_background)
+                importantForAccessibility = View.IMPORTANT_FOR_ACCESSIBILITY_NO
+            }
+            addContentView(splash, ViewGroup.LayoutParams(
+                ViewGroup.LayoutParams.MATCH_PARENT,
+                ViewGroup.LayoutParams.MATCH_PARENT
+            ))
+            SplashHandler.attach(splash)
+        }
+
         val density = resources.displayMetrics.density
         val overlayController = InputOverlayController(container.overlayLayout, density)
         orchestrator = UnifiedFrameOrchestrator(container.skiaView, overlayController)
@@ -51,6 +70,7 @@ class MainActivity : AppCompatActivity() {
             insets
         }
         container.post { SafeAreaHandler.sendInsetsUpdate() }
+        container.post { AppearanceHandler.sendAppearanceUpdate(this) }
 
         // Handle back button presses via the Go navigation system
         onBackPressedDispatcher.addCallback(this, object : OnBackPressedCallback(true) {
@@ -91,9 +111,19 @@ class MainActivity : AppCompatActivity() {
         StorageHandler.onActivityResult(requestCode, resultCode, data, this)
     }
 
+    override fun onConfigurationChanged(newConfig: android.content.res.Configuration) {
+        super.onConfigurationChanged(newConfig)
+        // Dark mode and font size changes arrive here because the manifest
+        // handles uiMode and fontScale.
+        AppearanceHandler.sendAppearanceUpdate(this)
+    }
+
     override fun onResume() {
         super.onResume()
         container.skiaView.notifyResume()
+        // High contrast and "Remove animations" have no change callback; pick
+        // them up when returning from Settings.
+        AppearanceHandler.sendAppearanceUpdate(this)
         orchestrator.start()
     }
 
@@ -0,0 +1,256 @@
+/**
+ * MessageCodec.kt
+ * Message encodings for platform channels. Each channel must use the same
+ * codec on the Go and Kotlin sides.
+ */
+package {{.PackageName}}
+
+import java.io.ByteArrayOutputStream
+import java.nio.ByteBuffer
+import java.nio.ByteOrder
+
+/**
+ * Encodes and decodes platform channel messages.
+ */
+interface MessageCodec {
+    fun encode(value: Any?): ByteArray
+    fun decode(data: ByteArray): Any?
+}
+
+/**
+ * Passes raw bytes through unchanged. Matches Go's platform.BinaryCodec.
+ * Encode accepts a ByteArray, ByteBuffer, or null.
+ */
+object BinaryCodec : MessageCodec {
+    override fun encode(value: Any?): ByteArray = when (value) {
+        null -> ByteArray(0)
+        is ByteArray -> value
+        is ByteBuffer -> {
+            val copy = value.duplicate()
+            ByteArray(copy.remaining()).also { copy.get(it) }
+        }
+        else -> throw IllegalArgumentException("BinaryCodec cannot encode ${value.javaClass.name}")
+    }
+
+    override fun decode(data: ByteArray): Any? = if (data.isEmpty()) null else data
+}
+
+/**
+ * Compact binary encoding that keeps integer, float, and byte buffer types
+ * intact. Matches Go's platform.StandardMessageCodec.
+ *
+ * Encodes null, Boolean, Byte/Short/Int/Long, Float/Double, String,
+ * ByteArray, IntArray, LongArray, FloatArray, DoubleArray, List, Array,
+ * and Map. Decodes integers that fit in 32 bits as Int and larger ones as
+ * Long, floats as Double, typed lists as primitive arrays, lists as List,
+ * and maps as Map.
+ */
+object StandardMessageCodec : MessageCodec {
+    private const val NULL: Byte = 0
+    private const val TRUE: Byte = 1
+    private const val FALSE: Byte = 2
+    private const val INT32: Byte = 3
+    private const val INT64: Byte = 4
+    private const val FLOAT64: Byte = 6
+    private const val STRING: Byte = 7
+    private const val UINT8_LIST: Byte = 8
+    private const val INT32_LIST: Byte = 9
+    private const val INT64_LIST: Byte = 10
+    private const val FLOAT64_LIST: Byte = 11
+    private const val LIST: Byte = 12
+    private const val MAP: Byte = 13
+    private const val FLOAT32_LIST: Byte = 14
+
+    override fun encode(value: Any?): ByteArray {
+        if (value == null) return ByteArray(0)
+        val out = ByteArrayOutputStream()
+        writeValue(out, value)
+        return out.toByteArray()
+    }
+
+    override fun decode(data: ByteArray): Any? {
+        if (data.isEmpty()) return null
+        val buffer = ByteBuffer.wrap(data).order(ByteOrder.LITTLE_ENDIAN)
+        val value = readValue(buffer)
+        require(!buffer.hasRemaining()) { "Message has trailing bytes" }
+        return value
+    }
+
+    private fun writeSize(out: ByteArrayOutputStream, size: Int) {
+        when {
+            size < 254 -> out.write(size)
+            size <= 0xffff -> {
+                out.write(254)
+                writeInt16(out, size)
+            }
+            else -> {
+                out.write(255)
+                writeInt32(out, size)
+            }
+        }
+    }
+
+    private fun writeInt16(out: ByteArrayOutputStream, value: Int) {
+        out.write(value and 0xff)
+        out.write((value ushr 8) and 0xff)
+    }
+
+    private fun writeInt32(out: ByteArrayOutputStream, value: Int) {
+        for (shift in 0 until 32 step 8) out.write((value ushr shift) and 0xff)
+    }
+
+    private fun writeInt64(out: ByteArrayOutputStream, value: Long) {
+        for (shift in 0 until 64 step 8) out.write(((value ushr shift) and 0xff).toInt())
+    }
+
+    private fun align(out: ByteArrayOutputStream, alignment: Int) {
+        val pad = (alignment - out.size() % alignment) % alignment
+        repeat(pad) { out.write(0) }
+    }
+
+    private fun writeValue(out: ByteArrayOutputStream, value: Any?) {
+        when (value) {
+            null -> out.write(NULL.toInt())
+            is Boolean -> out.write(if (value) TRUE.toInt() else FALSE.toInt())
+            is Byte, is Short, is Int -> {
+                out.write(INT32.toInt())
+                writeInt32(out, (value as Number).toInt())
+            }
+            is Long -> {
+                if (value in Int.MIN_VALUE..Int.MAX_VALUE) {
+                    out.write(INT32.toInt())
+                    writeInt32(out, value.toInt())
+                } else {
+                    out.write(INT64.toInt())
+                    writeInt64(out, value)
+                }
+            }
+            is Float, is Double -> {
+                out.write(FLOAT64.toInt())
+                align(out, 8)
+                writeInt64(out, java.lang.Double.doubleToRawLongBits((value as Number).toDouble()))
+            }
+            is String -> {
+                val bytes = value.toByteArray(Charsets.UTF_8)
+                out.write(STRING.toInt())
+                writeSize(out, bytes.size)
+                out.write(bytes)
+            }
+            is ByteArray -> {
+                out.write(UINT8_LIST.toInt())
+                writeSize(out, value.size)
+                out.write(value)
+            }
+            is IntArray -> {
+                out.write(INT32_LIST.toInt())
+                writeSize(out, value.size)
+                align(out, 4)
+                value.forEach { writeInt32(out, it) }
+            }
+            is LongArray -> {
+                out.write(INT64_LIST.toInt())
+                writeSize(out, value.size)
+                align(out, 8)
+                value.forEach { writeInt64(out, it) }
+            }
+            is FloatArray -> {
+                out.write(FLOAT32_LIST.toInt())
+                writeSize(out, value.size)
+                align(out, 4)
+                value.forEach { writeInt32(out, java.lang.Float.floatToRawIntBits(it)) }
+            }
+            is DoubleArray -> {
+                out.write(FLOAT64_LIST.toInt())
+                writeSize(out, value.size)
+                align(out, 8)
+                value.forEach { writeInt64(out, java.lang.Double.doubleToRawLongBits(it)) }
+            }
+            is List<*> -> {
+                out.write(LIST.toInt())
+                writeSize(out, value.size)
+                value.forEach { writeValue(out, it) }
+            }
+            is Array<*> -> {
+                out.write(LIST.toInt())
+                writeSize(out, value.size)
+                value.forEach { writeValue(out, it) }
+            }
+            is Map<*, *> -> {
+                out.write(MAP.toInt())
+                writeSize(out, value.size)
+                for ((key, item) in value) {
+                    writeValue(out, key)
+                    writeValue(out, item)
+                }
+            }
+            else -> throw IllegalArgumentException("StandardMessageCodec cannot encode ${value.javaClass.name}")
+        }
+    }
+
+    private fun readSize(buffer: ByteBuffer): Int {
+        return when (val first = buffer.get().toInt() and 0xff) {
+            254 -> buffer.short.toInt() and 0xffff
+            255 -> buffer.int
+            else -> first
+        }
+    }
+
+    private fun align(buffer: ByteBuffer, alignment: Int) {
+        val pad = (alignment - buffer.position() % alignment) % alignment
+        buffer.position(buffer.position() + pad)
+    }
+
+    private fun readValue(buffer: ByteBuffer): Any? {
+        return when (val type = buffer.get()) {
+            NULL -> null
+            TRUE -> true
+            FALSE -> false
+            INT32 -> buffer.int
+            INT64 -> buffer.long
+            FLOAT64 -> {
+                align(buffer, 8)
+                buffer.double
+            }
+            STRING -> {
+                val bytes = ByteArray(readSize(buffer))
+                buffer.get(bytes)
+                String(bytes, Charsets.UTF_8)
+            }
+            UINT8_LIST -> ByteArray(readSize(buffer)).also { buffer.get(it) }
+            INT32_LIST -> {
+                val size = readSize(buffer)
+                align(buffer, 4)
+                IntArray(size).also { buffer.asIntBuffer().get(it); buffer.position(buffer.position() + size * 4) }
+            }
+            INT64_LIST -> {
+                val size = readSize(buffer)
+                align(buffer, 8)
+                LongArray(size).also { buffer.asLongBuffer().get(it); buffer.position(buffer.position() + size * 8) }
+            }
+            FLOAT32_LIST -> {
+                val size = readSize(buffer)
+                align(buffer, 4)
+                FloatArray(size).also { buffer.asFloatBuffer().get(it); buffer.position(buffer.position() + size * 4) }
+            }
+            FLOAT64_LIST -> {
+                val size = readSize(buffer)
+                align(buffer, 8)
+                DoubleArray(size).also { buffer.asDoubleBuffer().get(it); buffer.position(buffer.position() + size * 8) }
+            }
+            LIST -> {
+                val size = readSize(buffer)
+                List(size) { readValue(buffer) }
+            }
+            MAP -> {
+                val size = readSize(buffer)
+                val map = LinkedHashMap<Any?, Any?>(size)
+                repeat(size) {
+                    val key = readValue(buffer)
+                    map[key] = readValue(buffer)
+                }
+                map
+            }
+            else -> throw IllegalArgumentException("Unknown message type $type")
+        }
+    }
+}
@@ -199,6 +199,17 @@ object NativeBridge {
      */
     external fun hitTestPlatformView(viewID: Long, x: Double, y: Double): Int
 
+    /**
+     * Sends a captured frame of a texture-composited platform view to Go.
+     *
+     * @param viewID The platform view ID the frame belongs to.
+     * @param width  Frame width in pixels.
+     * @param height Frame height in pixels.
+     * @param pixels Direct buffer of tightly packed RGBA_8888 pixels. Go copies
+     *               the pixels before returning, so the buffer may be reused.
+     */
+    external fun platformViewTextureFrame(viewID: Long, width: Int, height: Int, pixels: java.nio.ByteBuffer)
+
     // ─── Unified Frame Orchestrator (Vulkan + HardwareBuffer + HWUI path) ───
 
     /** Initializes Vulkan instance, physical device, logical device, and graphics queue. */
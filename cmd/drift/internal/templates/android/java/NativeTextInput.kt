@@ -201,8 +201,9 @@ class NativeTextInputContainer(
     }
 
     private fun EditText.applyConfig(config: TextInputViewConfig) {
-        // Font
-        setTextSize(TypedValue.COMPLEX_UNIT_SP, config.fontSize)
+        // Font. Sizes arrive already multiplied by the app's text scale
+        // factor, so use DIP; SP would apply the system font scale twice.
+        setTextSize(TypedValue.COMPLEX_UNIT_DIP, config.fontSize)
         typeface = config.typeface
 
         // Colors
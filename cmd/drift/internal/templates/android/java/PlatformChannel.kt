@@ -23,6 +23,7 @@ import android.os.VibratorManager
 import android.util.Log
 import android.view.HapticFeedbackConstants
 import android.view.View
+import android.view.ViewConfiguration
 import androidx.appcompat.app.AppCompatActivity
 import androidx.core.content.FileProvider
 import androidx.core.view.ViewCompat
@@ -45,6 +46,7 @@ object PlatformChannelManager {
     private var view: View? = null
     private var currentActivity: Activity? = null
     private val handlers = mutableMapOf<String, MethodHandler>()
+    private val codecs = mutableMapOf<String, MessageCodec>()
     private val codec = JsonCodec
     private var lastError: String? = null
     @Volatile
@@ -83,12 +85,27 @@ object PlatformChannelManager {
     }
 
     /**
-     * Registers a handler for a platform channel.
+     * Registers a handler for a platform channel. The codec must match the
+     * one the Go side passes to NewMethodChannelWithCodec; it is also used
+     * for events sent on the same channel name.
      */
-    fun register(channel: String, handler: MethodHandler) {
+    fun register(channel: String, handler: MethodHandler, codec: MessageCodec = JsonCodec) {
         handlers[channel] = handler
+        setCodec(channel, codec)
     }
 
+    /**
+     * Sets the codec for a channel without a method handler, such as an
+     * event-only channel created in Go with NewEventChannelWithCodec.
+     */
+    fun setCodec(channel: String, codec: MessageCodec) {
+        synchronized(codecs) {
+            if (codec === JsonCodec) codecs.remove(channel) else codecs[channel] = codec
+        }
+    }
+
+    private fun codecFor(channel: String): MessageCodec = synchronized(codecs) { codecs[channel] } ?: JsonCodec
+
     /**
      * JNI entry point for Go->Kotlin method calls.
      * Called by native code when Go invokes a platform channel method.
@@ -130,8 +147,13 @@ object PlatformChannelManager {
         val handler = handlers[channel]
             ?: return Pair(null, errorPayload("channel_not_found", "Channel not found: $channel"))
 
+        val channelCodec = codecFor(channel)
         val args = if (argsData != null && argsData.isNotEmpty()) {
-            JsonCodec.decode(argsData)
+            try {
+                channelCodec.decode(argsData)
+            } catch (e: Exception) {
+                return Pair(null, errorPayload("invalid_arguments", e.message ?: "Malformed arguments"))
+            }
         } else {
             null
         }
@@ -145,7 +167,11 @@ object PlatformChannelManager {
             return Pair(null, errorPayload(code, message, details))
         }
 
-        val resultData = codec.encode(result)
+        val resultData = try {
+            channelCodec.encode(result)
+        } catch (e: Exception) {
+            return Pair(null, errorPayload("native_error", e.message ?: "Unencodable result"))
+        }
         return Pair(resultData, null)
     }
 
@@ -154,7 +180,7 @@ object PlatformChannelManager {
      * After dispatching, wakes the frame loop so the engine renders the state change.
      */
     fun sendEvent(channel: String, data: Any?) {
-        val encoded = codec.encode(data)
+        val encoded = codecFor(channel).encode(data)
         NativeBridge.platformHandleEvent(channel, encoded, encoded.size)
         onFrameNeeded?.invoke()
     }
@@ -192,6 +218,25 @@ object PlatformChannelManager {
             HapticsHandler.handle(context, view, method, args)
         }
 
+        // View configuration channel
+        register("drift/display") { method, args ->
+            DisplayHandler.handle(method, args)
+        }
+
+        register("drift/view_configuration") { method, args ->
+            ViewConfigurationHandler.handle(context, method)
+        }
+
+        // Dynamic color channel
+        register("drift/dynamic_color") { method, args ->
+            DynamicColorHandler.handle(context, method)
+        }
+
+        // Locale channel
+        register("drift/locale") { method, args ->
+            LocaleHandler.handle(method)
+        }
+
         // Share channel
         register("drift/share") { method, args ->
             ShareHandler.handle(context, method, args)
@@ -207,6 +252,11 @@ object PlatformChannelManager {
             SystemUIHandler.handle(method, args)
         }
 
+        // Splash channel
+        register("drift/splash") { method, args ->
+            SplashHandler.handle(method)
+        }
+
         // Notifications channel
         register("drift/notifications") { method, args ->
             NotificationHandler.handle(context, method, args)
@@ -437,6 +487,132 @@ object HapticsHandler {
     }
 }
 
+// MARK: - Display Handler
+
+/**
+ * Picks the display mode closest to the frame rate preferred by the Go
+ * engine, at the current resolution, and replies with its refresh rate.
+ * A rate of 0 asks for the highest mode, such as 120Hz on high-refresh
+ * devices.
+ */
+object DisplayHandler {
+    fun handle(method: String, args: Any?): Pair<Any?, Exception?> {
+        if (method != "setPreferredFrameRate") {
+            return Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+        val activity = PlatformChannelManager.currentActivity()
+            ?: return Pair(null, IllegalStateException("No active activity"))
+        val fps = ((args as? Map<*, *>)?.get("fps") as? Number)?.toFloat() ?: 0f
+
+        @Suppress("DEPRECATION")
+        val display = activity.windowManager.defaultDisplay
+        val current = display.mode
+        // Only modes at the current resolution, so switching never resizes the surface.
+        val modes = display.supportedModes.filter {
+            it.physicalWidth == current.physicalWidth && it.physicalHeight == current.physicalHeight
+        }
+        val mode = if (fps > 0) {
+            modes.filter { it.refreshRate <= fps + 0.5f }.maxByOrNull { it.refreshRate }
+                ?: modes.minByOrNull { it.refreshRate }
+        } else {
+            modes.maxByOrNull { it.refreshRate }
+        } ?: return Pair(current.refreshRate.toDouble(), null)
+
+        activity.runOnUiThread {
+            val params = activity.window.attributes
+            params.preferredDisplayModeId = mode.modeId
+            activity.window.attributes = params
+        }
+        return Pair(mode.refreshRate.toDouble(), null)
+    }
+}
+
+// MARK: - View Configuration Handler
+
+object ViewConfigurationHandler {
+    fun handle(context: Context, method: String): Pair<Any?, Exception?> {
+        if (method != "get") {
+            return Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+        val density = context.resources.displayMetrics.density
+        // The long-press timeout follows the user's "Touch & hold delay"
+        // accessibility setting.
+        return Pair(mapOf(
+            "touchSlop" to (ViewConfiguration.get(context).scaledTouchSlop / density).toDouble(),
+            "longPressTimeoutMs" to ViewConfiguration.getLongPressTimeout(),
+            "doubleTapTimeoutMs" to ViewConfiguration.getDoubleTapTimeout()
+        ), null)
+    }
+}
+
+// MARK: - Dynamic Color Handler
+
+object DynamicColorHandler {
+    fun handle(context: Context, method: String): Pair<Any?, Exception?> {
+        if (method != "get") {
+            return Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+        val result = mutableMapOf<String, Any?>("highContrast" to isHighContrast(context))
+        if (Build.VERSION.SDK_INT >= Build.VERSION_CODES.S) {
+            // Material You palettes derived from the wallpaper.
+            result["primary"] = context.getColor(android.R.color.system_accent1_500)
+            result["secondary"] = context.getColor(android.R.color.system_accent2_500)
+            result["tertiary"] = context.getColor(android.R.color.system_accent3_500)
+            result["neutral"] = context.getColor(android.R.color.system_neutral1_500)
+            result["neutralVariant"] = context.getColor(android.R.color.system_neutral2_500)
+        }
+        return Pair(result, null)
+    }
+
+    fun isHighContrast(context: Context): Boolean {
+        if (Build.VERSION.SDK_INT >= Build.VERSION_CODES.UPSIDE_DOWN_CAKE) {
+            val uiModeManager = context.getSystemService(Context.UI_MODE_SERVICE) as android.app.UiModeManager
+            if (uiModeManager.contrast > 0f) {
+                return true
+            }
+        }
+        return android.provider.Settings.Secure.getInt(
+            context.contentResolver, "high_text_contrast_enabled", 0
+        ) == 1
+    }
+}
+
+// MARK: - Locale Handler
+
+object LocaleHandler {
+    fun handle(method: String): Pair<Any?, Exception?> {
+        if (method != "getPreferredLocales") {
+            return Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+        val tags = android.os.LocaleList.getDefault().toLanguageTags()
+            .split(",")
+            .filter { it.isNotEmpty() }
+        return Pair(mapOf("locales" to tags), null)
+    }
+}
+
+// MARK: - Appearance Handler
+
+object AppearanceHandler {
+    fun sendAppearanceUpdate(context: Context) {
+        val nightMode = context.resources.configuration.uiMode and
+            android.content.res.Configuration.UI_MODE_NIGHT_MASK
+        PlatformChannelManager.sendEvent("drift/appearance/events", mapOf(
+            "darkMode" to (nightMode == android.content.res.Configuration.UI_MODE_NIGHT_YES),
+            "highContrast" to DynamicColorHandler.isHighContrast(context),
+            "textScaleFactor" to context.resources.configuration.fontScale.toDouble(),
+            "reduceMotion" to isReduceMotion(context)
+        ))
+    }
+
+    // "Remove animations" sets the animator duration scale to zero.
+    private fun isReduceMotion(context: Context): Boolean {
+        return android.provider.Settings.Global.getFloat(
+            context.contentResolver, android.provider.Settings.Global.ANIMATOR_DURATION_SCALE, 1f
+        ) == 0f
+    }
+}
+
 // MARK: - Share Handler
 
 object ShareHandler {
@@ -656,6 +832,47 @@ object SystemUIHandler {
     }
 }
 
+// MARK: - Splash Handler
+
+/**
+ * Keeps a copy of the launch background over the Drift surface until the Go
+ * engine reports that its first frame is on screen, then fades it out. This
+ * avoids showing the empty surface between the launch screen and the first
+ * frame on cold start.
+ */
+object SplashHandler {
+    private const val FADE_DURATION_MS = 200L
+
+    private var view: View? = null
+
+    /** True once the engine has asked to hide the splash. */
+    @Volatile
+    var isHidden = false
+        private set
+
+    /** Shows [splash] until the engine hides it. Called from onCreate. */
+    fun attach(splash: View) {
+        view = splash
+    }
+
+    fun handle(method: String): Pair<Any?, Exception?> {
+        if (method != "hide") {
+            return Pair(null, IllegalArgumentException("Unknown method: $method"))
+        }
+        isHidden = true
+        val splash = view ?: return Pair(null, null)
+        view = null
+        splash.post {
+            splash.animate()
+                .alpha(0f)
+                .setDuration(FADE_DURATION_MS)
+                .withEndAction { (splash.parent as? android.view.ViewGroup)?.removeView(splash) }
+                .start()
+        }
+        return Pair(null, null)
+    }
+}
+
 // MARK: - Safe Area Handler
 
 object SafeAreaHandler {
@@ -711,10 +928,10 @@ object URLLauncherHandler {
 // MARK: - JSON Implementation
 
 /**
- * Simple JSON codec for basic types.
+ * Simple JSON codec for basic types. The default codec for channels.
  */
-object JsonCodec {
-    fun encode(value: Any?): ByteArray {
+object JsonCodec : MessageCodec {
+    override fun encode(value: Any?): ByteArray {
         val jsonValue = toJson(value)
         val jsonString = when (jsonValue) {
             JSONObject.NULL -> "null"
@@ -726,7 +943,7 @@ object JsonCodec {
         return jsonString.toByteArray(Charsets.UTF_8)
     }
 
-    fun decode(data: ByteArray): Any? {
+    override fun decode(data: ByteArray): Any? {
         if (data.isEmpty()) return null
         val jsonString = String(data, Charsets.UTF_8)
         val parsed = JSONTokener(jsonString).nextValue()
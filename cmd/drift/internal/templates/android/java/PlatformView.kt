@@ -6,9 +6,12 @@ package {{.PackageName}}
 
 import android.annotation.SuppressLint
 import android.content.Context
+import android.graphics.Bitmap
+import android.graphics.Canvas
 import android.util.Log
 import android.view.View
 import android.view.ViewGroup
+import android.view.ViewTreeObserver
 import android.webkit.WebView
 import android.webkit.WebViewClient
 import android.widget.EditText
@@ -20,6 +23,8 @@ import android.widget.FrameLayout
 object PlatformViewHandler {
     private val views = mutableMapOf<Int, PlatformViewContainer>()
     private val interceptors = mutableMapOf<Int, TouchInterceptorView>()
+    private val textureCaptures = mutableMapOf<Int, PlatformViewTextureCapture>()
+    private val factories = mutableMapOf<String, PlatformViewContainerFactory>()
     private var context: Context? = null
     private var hostView: ViewGroup? = null
     private var surfaceView: View? = null
@@ -32,6 +37,14 @@ object PlatformViewHandler {
     private val activityIndicatorMethods = setOf("setAnimating", "updateConfig")
     private val videoPlayerMethods = setOf("play", "pause", "stop", "seekTo", "setVolume", "setLooping", "setPlaybackSpeed", "setShowControls", "load")
 
+    /**
+     * Registers a factory for a plugin view type. Built-in view types
+     * cannot be replaced.
+     */
+    fun registerFactory(viewType: String, factory: PlatformViewContainerFactory) {
+        synchronized(factories) { factories[viewType] = factory }
+    }
+
     fun init(context: Context, hostView: ViewGroup, surfaceView: View, overlayController: InputOverlayController) {
         this.context = context
         this.hostView = hostView
@@ -75,6 +88,7 @@ object PlatformViewHandler {
             "dispose" -> dispose(argsMap)
             "setVisible" -> setVisible(argsMap)
             "setEnabled" -> setEnabled(argsMap)
+            "setCompositionMode" -> setCompositionMode(argsMap)
             "invokeViewMethod" -> invokeViewMethod(argsMap)
             else -> Pair(null, IllegalArgumentException("Unknown method: $method"))
         }
@@ -224,7 +238,7 @@ object PlatformViewHandler {
             "switch" -> { { NativeSwitchContainer(ctx, viewId, params) } }
             "activity_indicator" -> { { NativeActivityIndicatorContainer(ctx, viewId, params) } }
             "video_player" -> { { NativeVideoPlayerContainer(ctx, viewId, params) } }
-            else -> null
+            else -> synchronized(factories) { factories[viewType] }?.let { factory -> { factory(ctx, viewId, params) } }
         }
 
         if (creator == null) {
@@ -267,6 +281,7 @@ object PlatformViewHandler {
 
         host.post {
             val container = views.remove(viewId) ?: return@post
+            textureCaptures.remove(viewId)?.stop()
             container.dispose()
             overlayController?.removeView(viewId.toLong())
             val interceptor = interceptors.remove(viewId)
@@ -308,6 +323,106 @@ object PlatformViewHandler {
 
         return Pair(null, null)
     }
+
+    private fun setCompositionMode(args: Map<*, *>): Pair<Any?, Exception?> {
+        val viewId = (args["viewId"] as? Number)?.toInt()
+            ?: return Pair(null, IllegalArgumentException("Missing viewId"))
+        val texture = when (val mode = args["mode"] as? String) {
+            "overlay" -> false
+            "texture" -> true
+            else -> return Pair(null, IllegalArgumentException("Unknown composition mode: $mode"))
+        }
+        val host = hostView ?: return Pair(null, IllegalStateException("Host view not initialized"))
+
+        host.post {
+            val container = views[viewId] ?: return@post
+            val interceptor = interceptors[viewId] ?: return@post
+            if (texture) {
+                if (textureCaptures.containsKey(viewId)) return@post
+                val capture = PlatformViewTextureCapture(viewId.toLong(), container.view)
+                textureCaptures[viewId] = capture
+                // Skia draws the captured frames, so hide the native copy while
+                // keeping it in place to receive touches and input.
+                interceptor.alpha = 0f
+                capture.start()
+            } else {
+                textureCaptures.remove(viewId)?.stop() ?: return@post
+                interceptor.alpha = 1f
+            }
+        }
+
+        return Pair(null, null)
+    }
+}
+
+/**
+ * Copies a platform view into a bitmap whenever its window redraws and sends
+ * the pixels to Go, which composites them with Skia in paint order.
+ *
+ * Views that render into their own SurfaceView (such as the video player)
+ * draw nothing into the bitmap and cannot be captured this way.
+ */
+private class PlatformViewTextureCapture(
+    private val viewId: Long,
+    private val view: View
+) : ViewTreeObserver.OnDrawListener {
+    private var bitmap: Bitmap? = null
+    private var buffer: java.nio.ByteBuffer? = null
+    private var capturePending = false
+    private var running = false
+
+    private val captureRunnable = Runnable {
+        capturePending = false
+        capture()
+    }
+
+    fun start() {
+        running = true
+        view.viewTreeObserver.addOnDrawListener(this)
+        view.post(captureRunnable)
+    }
+
+    fun stop() {
+        running = false
+        if (view.viewTreeObserver.isAlive) {
+            view.viewTreeObserver.removeOnDrawListener(this)
+        }
+        view.removeCallbacks(captureRunnable)
+        bitmap?.recycle()
+        bitmap = null
+        buffer = null
+    }
+
+    override fun onDraw() {
+        // Views must not be drawn from inside the draw pass; capture right after.
+        if (running && !capturePending) {
+            capturePending = true
+            view.post(captureRunnable)
+        }
+    }
+
+    private fun capture() {
+        val width = view.width
+        val height = view.height
+        if (!running || width <= 0 || height <= 0) return
+
+        var bmp = bitmap
+        if (bmp == null || bmp.width != width || bmp.height != height) {
+            bmp?.recycle()
+            bmp = Bitmap.createBitmap(width, height, Bitmap.Config.ARGB_8888)
+            bitmap = bmp
+            buffer = java.nio.ByteBuffer.allocateDirect(width * height * 4)
+        }
+        val pixels = buffer ?: return
+
+        bmp.eraseColor(0)
+        view.draw(Canvas(bmp))
+
+        // ARGB_8888 bitmaps store premultiplied RGBA bytes, matching Go's image.RGBA.
+        pixels.rewind()
+        bmp.copyPixelsToBuffer(pixels)
+        NativeBridge.platformViewTextureFrame(viewId, width, height, pixels)
+    }
 }
 
 /**
@@ -17,6 +17,7 @@
 package {{.PackageName}}
 
 import android.content.Context
+import android.content.res.Configuration
 import android.graphics.Bitmap
 import android.graphics.Canvas
 import android.graphics.ColorSpace
@@ -98,6 +99,19 @@ class SkiaHostView(context: Context) : View(context), DriftSkiaHost {
         }
     }
 
+    /**
+     * Handles density changes, such as a new display size setting or moving
+     * to another display, which the activity receives without restarting.
+     */
+    override fun onConfigurationChanged(newConfig: Configuration) {
+        super.onConfigurationChanged(newConfig)
+        updateDeviceScale()
+        if (engineReady) {
+            NativeBridge.requestFrame()
+            onFrameNeeded?.invoke()
+        }
+    }
+
     /**
      * Renders a frame synchronously on the UI thread. Called from doFrame
      * (ANIMATION phase) after stepping the engine and applying overlay positions.
@@ -8,8 +8,10 @@ import android.app.Activity
 import android.content.Context
 import android.content.Intent
 import android.net.Uri
+import android.os.Build
 import android.os.Environment
 import android.provider.DocumentsContract
+import android.provider.MediaStore
 import android.provider.OpenableColumns
 import android.webkit.MimeTypeMap
 import java.io.File
@@ -23,6 +25,8 @@ object StorageHandler {
     private var pendingSaveData: ByteArray? = null
     private var pendingRequestType: String? = null
     private var pendingRequestId: String? = null
+    private var pendingCopyToCache = false
+    private var pendingReportProgress = false
 
     fun handle(context: Context, method: String, args: Any?): Pair<Any?, Exception?> {
         return when (method) {
@@ -47,8 +51,24 @@ object StorageHandler {
         @Suppress("UNCHECKED_CAST")
         val allowedTypes = argsMap["allowedTypes"] as? List<String> ?: listOf("*/*")
 
+        val source = argsMap["source"] as? String ?: "documents"
+
         pendingRequestType = "pickFile"
         pendingRequestId = argsMap["requestId"] as? String
+        pendingCopyToCache = argsMap["copyToCache"] as? Boolean ?: false
+        pendingReportProgress = argsMap["reportProgress"] as? Boolean ?: false
+
+        if (source == "images" && Build.VERSION.SDK_INT >= Build.VERSION_CODES.TIRAMISU) {
+            // The system photo picker needs no storage permission.
+            val intent = Intent(MediaStore.ACTION_PICK_IMAGES).apply {
+                type = if (allowedTypes.size == 1) allowedTypes[0] else "image/*"
+                if (allowMultiple) {
+                    putExtra(MediaStore.EXTRA_PICK_IMAGES_MAX, MediaStore.getPickImagesMaxLimit())
+                }
+            }
+            activity.startActivityForResult(intent, PICK_FILE_REQUEST)
+            return Pair(mapOf("pending" to true), null)
+        }
 
         val intent = Intent(Intent.ACTION_OPEN_DOCUMENT).apply {
             addCategory(Intent.CATEGORY_OPENABLE)
@@ -257,21 +277,90 @@ object StorageHandler {
         )
     }
 
+    /**
+     * Copies a picked document into the app cache so Go can read it as a plain
+     * file path, emitting progress on drift/storage/progress when requested.
+     */
+    private fun copyToCache(
+        context: Context,
+        uri: Uri,
+        index: Int,
+        count: Int,
+        requestId: String?,
+        reportProgress: Boolean
+    ): Map<String, Any?> {
+        val info = getContentUriInfo(context, uri).toMutableMap()
+        val name = (info["name"] as? String)?.takeIf { it.isNotEmpty() } ?: "file"
+        val totalBytes = info["size"] as? Long ?: 0L
+        val dir = File(context.cacheDir, "drift_picked/${System.nanoTime()}").apply { mkdirs() }
+        val target = File(dir, File(name).name)
+
+        fun progress(bytesCopied: Long) {
+            if (!reportProgress) return
+            val event = mutableMapOf<String, Any?>(
+                "name" to name,
+                "index" to index,
+                "count" to count,
+                "bytesCopied" to bytesCopied,
+                "totalBytes" to totalBytes
+            )
+            requestId?.let { event["requestId"] = it }
+            PlatformChannelManager.sendEvent("drift/storage/progress", event)
+        }
+
+        val input = context.contentResolver.openInputStream(uri)
+            ?: throw IllegalStateException("Cannot open $name")
+        input.use { stream ->
+            target.outputStream().use { out ->
+                val buffer = ByteArray(64 * 1024)
+                var copied = 0L
+                var lastReported = 0L
+                progress(0)
+                while (true) {
+                    val read = stream.read(buffer)
+                    if (read < 0) break
+                    out.write(buffer, 0, read)
+                    copied += read
+                    if (copied - lastReported >= 512 * 1024) {
+                        progress(copied)
+                        lastReported = copied
+                    }
+                }
+                progress(copied)
+                info["size"] = copied
+            }
+        }
+
+        info["path"] = target.absolutePath
+        return info
+    }
+
     fun onActivityResult(requestCode: Int, resultCode: Int, data: Intent?, context: Context) {
         when (requestCode) {
             PICK_FILE_REQUEST -> {
                 if (resultCode == Activity.RESULT_OK) {
-                    val files = mutableListOf<Map<String, Any?>>()
+                    val uris = mutableListOf<Uri>()
                     data?.clipData?.let { clipData ->
                         for (i in 0 until clipData.itemCount) {
-                            clipData.getItemAt(i).uri?.let { uri ->
-                                files.add(getContentUriInfo(context, uri))
-                            }
+                            clipData.getItemAt(i).uri?.let { uris.add(it) }
                         }
-                    } ?: data?.data?.let { uri ->
-                        files.add(getContentUriInfo(context, uri))
+                    } ?: data?.data?.let { uris.add(it) }
+                    if (pendingCopyToCache) {
+                        val requestId = pendingRequestId
+                        val reportProgress = pendingReportProgress
+                        Thread {
+                            try {
+                                val files = uris.mapIndexed { index, uri ->
+                                    copyToCache(context, uri, index, uris.size, requestId, reportProgress)
+                                }
+                                sendPickFileResult(files, requestId)
+                            } catch (e: Exception) {
+                                sendError("pickFile", e.message ?: "Failed to copy picked file", requestId)
+                            }
+                        }.start()
+                    } else {
+                        sendPickFileResult(uris.map { getContentUriInfo(context, it) })
                     }
-                    sendPickFileResult(files)
                 } else {
                     sendCancelled("pickFile")
                 }
@@ -309,14 +398,14 @@ object StorageHandler {
         }
     }
 
-    private fun sendPickFileResult(files: List<Map<String, Any?>>) {
+    private fun sendPickFileResult(files: List<Map<String, Any?>>, requestId: String? = pendingRequestId) {
         val event = mutableMapOf<String, Any?>(
             "type" to "pickFile",
             "files" to files
         )
-        pendingRequestId?.let { event["requestId"] = it }
+        requestId?.let { event["requestId"] = it }
         PlatformChannelManager.sendEvent("drift/storage/result", event)
-        pendingRequestId = null
+        if (requestId == pendingRequestId) pendingRequestId = null
     }
 
     private fun sendPickDirectoryResult(path: String?) {
@@ -349,13 +438,13 @@ object StorageHandler {
         pendingRequestId = null
     }
 
-    private fun sendError(requestType: String, message: String) {
+    private fun sendError(requestType: String, message: String, requestId: String? = pendingRequestId) {
         val event = mutableMapOf<String, Any?>(
             "type" to requestType,
             "error" to message
         )
-        pendingRequestId?.let { event["requestId"] = it }
+        requestId?.let { event["requestId"] = it }
         PlatformChannelManager.sendEvent("drift/storage/result", event)
-        pendingRequestId = null
+        if (requestId == pendingRequestId) pendingRequestId = null
     }
 }
@@ -2,7 +2,9 @@
 package templates
 
 import (
+	"crypto/sha1"
 	"embed"
+	"encoding/hex"
 	"fmt"
 	"io/fs"
 	"os"
@@ -21,6 +23,21 @@ type TemplateInput struct {
 	IOSBundleID    string
 	Orientation    string
 	AllowHTTP      bool
+
+	// AndroidDependencies are extra Gradle dependency coordinates, such as
+	// those required by plugins.
+	AndroidDependencies []string
+	// IOSSources are extra Swift files, as paths relative to the Runner
+	// directory, to add to the Xcode project.
+	IOSSources []string
+}
+
+// XcodeSource is an extra Swift file in the generated Xcode project.
+type XcodeSource struct {
+	Path      string // relative to the Runner group
+	Name      string // file name shown in Xcode
+	FileRef   string // PBXFileReference ID
+	BuildFile string // PBXBuildFile ID
 }
 
 // TemplateData contains the data for template substitution.
@@ -33,6 +50,9 @@ type TemplateData struct {
 	URLScheme   string // e.g., "my-app"
 	Orientation string // "portrait", "landscape", or "all"
 	AllowHTTP   bool   // allow cleartext HTTP traffic
+
+	AndroidDependencies []string      // extra Gradle dependencies
+	IOSSources          []XcodeSource // extra Swift files in the Xcode project
 }
 
 // NewTemplateData creates template data from the given input, deriving
@@ -47,7 +67,28 @@ func NewTemplateData(in TemplateInput) *TemplateData {
 		URLScheme:   sanitizeURLScheme(in.AppName),
 		Orientation: in.Orientation,
 		AllowHTTP:   in.AllowHTTP,
+
+		AndroidDependencies: in.AndroidDependencies,
+		IOSSources:          xcodeSources(in.IOSSources),
+	}
+}
+
+// xcodeSources assigns Xcode object IDs to extra Swift files. IDs are
+// derived from the path so regenerated projects are stable, and use
+// prefixes that cannot collide with the fixed IDs in the project template.
+func xcodeSources(paths []string) []XcodeSource {
+	sources := make([]XcodeSource, 0, len(paths))
+	for _, path := range paths {
+		sum := sha1.Sum([]byte(path))
+		id := strings.ToUpper(hex.EncodeToString(sum[:11]))
+		sources = append(sources, XcodeSource{
+			Path:      filepath.ToSlash(path),
+			Name:      filepath.Base(path),
+			FileRef:   "B2" + id,
+			BuildFile: "B3" + id,
+		})
 	}
+	return sources
 }
 
 func sanitizeURLScheme(appName string) string {
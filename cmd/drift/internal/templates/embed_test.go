@@ -27,3 +27,50 @@ func TestIOSPlatformView_OnViewCreatedSentAfterInterceptorAttach(t *testing.T) {
 		t.Fatalf("onViewCreated appears before interceptor attachment (onViewCreated=%d, addSubview=%d)", onCreatedIdx, addSubviewIdx)
 	}
 }
+
+func TestTemplates_PluginSourcesAndDependencies(t *testing.T) {
+	data := NewTemplateData(TemplateInput{
+		AppName:             "demo",
+		AndroidPackage:      "com.example.demo",
+		IOSBundleID:         "com.example.demo",
+		AndroidDependencies: []string{"androidx.core:core-ktx:1.12.0"},
+		IOSSources:          []string{"Plugins/GeneratedPluginRegistrant.swift"},
+	})
+
+	render := func(path string) string {
+		t.Helper()
+		content, err := ReadFile(path)
+		if err != nil {
+			t.Fatalf("ReadFile(%s) failed: %v", path, err)
+		}
+		out, err := ProcessTemplate(string(content), data)
+		if err != nil {
+			t.Fatalf("ProcessTemplate(%s) failed: %v", path, err)
+		}
+		return out
+	}
+
+	gradle := render("android/app.build.gradle.tmpl")
+	if !strings.Contains(gradle, `implementation "androidx.core:core-ktx:1.12.0"`) {
+		t.Error("expected the plugin dependency in app/build.gradle")
+	}
+
+	source := data.IOSSources[0]
+	pbxproj := render("xcodeproj/project.pbxproj.tmpl")
+	for _, want := range []string{
+		source.BuildFile + " /* GeneratedPluginRegistrant.swift in Sources */ = {isa = PBXBuildFile; fileRef = " + source.FileRef,
+		`path = "Plugins/GeneratedPluginRegistrant.swift"`,
+	} {
+		if !strings.Contains(pbxproj, want) {
+			t.Errorf("expected %q in project.pbxproj", want)
+		}
+	}
+	// Referenced from the group and the Sources phase, besides the
+	// definitions themselves.
+	if n := strings.Count(pbxproj, source.FileRef); n != 3 {
+		t.Errorf("expected the file reference 3 times, got %d", n)
+	}
+	if n := strings.Count(pbxproj, source.BuildFile); n != 2 {
+		t.Errorf("expected the build file 2 times, got %d", n)
+	}
+}
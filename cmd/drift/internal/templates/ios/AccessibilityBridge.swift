@@ -160,13 +160,15 @@ final class AccessibilityBridge {
         }
     }
 
-    /// Announces a message to VoiceOver.
+    /// Announces a message to VoiceOver. Polite announcements wait for
+    /// current speech to finish; assertive ones interrupt it.
     func announce(message: String, politeness: String) {
+        let announcement = NSAttributedString(
+            string: message,
+            attributes: [.accessibilitySpeechQueueAnnouncement: politeness != "assertive"]
+        )
         DispatchQueue.main.async {
-            let notification: UIAccessibility.Notification = politeness == "assertive"
-                ? .announcement
-                : .announcement
-            UIAccessibility.post(notification: notification, argument: message)
+            UIAccessibility.post(notification: .announcement, argument: announcement)
         }
     }
 
@@ -52,6 +52,13 @@ func DriftPointerEvent(_ pointerID: Int64, _ phase: Int32, _ x: Double, _ y: Dou
 @_silgen_name("DriftSetDeviceScale")
 func DriftSetDeviceScale(_ scale: Double)
 
+/// FFI declaration for reporting the display's refresh interval to the Go
+/// engine, which passes it on to animations.
+///
+/// - Parameter nanos: Time between display refreshes in nanoseconds.
+@_silgen_name("DriftSetVsyncInterval")
+func DriftSetVsyncInterval(_ nanos: Int64)
+
 /// FFI declaration for checking if a new frame needs to be rendered.
 /// Returns 1 if a frame is needed, 0 otherwise.
 @_silgen_name("DriftNeedsFrame")
@@ -251,6 +258,18 @@ final class DriftMetalView: UIView {
         DriftRequestFrame()
     }
 
+    /// Called when the view moves to a window, which may be on a screen with
+    /// a different scale, such as an external display.
+    ///
+    /// Adopts that screen's scale; layoutSubviews then resizes the drawable
+    /// and passes the new scale to the engine.
+    override func didMoveToWindow() {
+        super.didMoveToWindow()
+        guard let screen = window?.screen, contentScaleFactor != screen.scale else { return }
+        contentScaleFactor = screen.scale
+        setNeedsLayout()
+    }
+
     /// Set by the view controller during rotation transitions to force
     /// synchronous presentation regardless of platform view state.
     var syncPresentationForRotation = false
@@ -86,9 +86,23 @@ final class DriftViewController: UIViewController {
         // Initialize accessibility support
         AccessibilityHandler.shared.initialize(hostView: view)
         applySystemUIStyle(SystemUIHandler.currentStyle)
+        // Keep the launch screen up until the first Drift frame is on screen
+        SplashHandler.attach(to: view)
+        // Report dark mode, contrast, text size, and reduce motion now and
+        // whenever "Increase Contrast" or "Reduce Motion" is toggled; dark mode
+        // and Dynamic Type changes arrive via traitCollectionDidChange.
+        AppearanceHandler.sendAppearanceUpdate(traitCollection)
+        for name in [UIAccessibility.darkerSystemColorsStatusDidChangeNotification,
+                     UIAccessibility.reduceMotionStatusDidChangeNotification] {
+            NotificationCenter.default.addObserver(forName: name, object: nil, queue: .main) { [weak self] _ in
+                guard let self else { return }
+                AppearanceHandler.sendAppearanceUpdate(self.traitCollection)
+            }
+        }
         // Register the schedule-frame callback so the Go engine can request frames
         driftScheduleFrameCallback = { [weak self] in self?.scheduleFrame() }
         DriftSetScheduleFrameHandler(nativeScheduleFrame)
+        DisplayHandler.onChange = { [weak self] in self?.applyPreferredFrameRate() }
         // Create the display link (starts paused) and request the first frame
         startDisplayLink()
         scheduleFrame()
@@ -132,6 +146,14 @@ final class DriftViewController: UIViewController {
         SafeAreaHandler.sendInsetsUpdate()
     }
 
+    override func traitCollectionDidChange(_ previousTraitCollection: UITraitCollection?) {
+        super.traitCollectionDidChange(previousTraitCollection)
+        if traitCollection.userInterfaceStyle != previousTraitCollection?.userInterfaceStyle ||
+            traitCollection.preferredContentSizeCategory != previousTraitCollection?.preferredContentSizeCategory {
+            AppearanceHandler.sendAppearanceUpdate(traitCollection)
+        }
+    }
+
     override func viewSafeAreaInsetsDidChange() {
         super.viewSafeAreaInsetsDidChange()
         SafeAreaHandler.sendInsetsUpdate()
@@ -170,14 +192,23 @@ final class DriftViewController: UIViewController {
     /// running even during UI tracking (e.g., scrolling).
     private func startDisplayLink() {
         let link = CADisplayLink(target: self, selector: #selector(drawFrame))
+        displayLink = link
+        applyPreferredFrameRate()
+        link.add(to: .main, forMode: .common)
+        link.isPaused = true
+    }
+
+    /// Applies the frame rate requested by the Go engine to the display link.
+    /// ProMotion displays only run above 60Hz when
+    /// CADisableMinimumFrameDurationOnPhone is set in Info.plist.
+    func applyPreferredFrameRate() {
+        guard let link = displayLink else { return }
+        let fps = DisplayHandler.targetFrameRate
         if #available(iOS 15.0, *) {
-            link.preferredFrameRateRange = CAFrameRateRange(minimum: 60, maximum: 60, preferred: 60)
+            link.preferredFrameRateRange = CAFrameRateRange(minimum: min(60, fps), maximum: fps, preferred: fps)
         } else {
-            link.preferredFramesPerSecond = 60
+            link.preferredFramesPerSecond = Int(fps)
         }
-        link.add(to: .main, forMode: .common)
-        link.isPaused = true
-        displayLink = link
     }
 
     /// Unpauses the display link so the next vsync triggers a frame render.
@@ -216,6 +247,9 @@ final class DriftViewController: UIViewController {
     /// (e.g. animations have finished). The link will be unpaused again when
     /// the Go engine calls the schedule-frame callback.
     @objc private func drawFrame() {
+        if let link = displayLink {
+            DisplayHandler.reportInterval(link.targetTimestamp - link.timestamp)
+        }
         metalView.renderFrame()
         if DriftNeedsFrame() == 0 {
             displayLink?.isPaused = true
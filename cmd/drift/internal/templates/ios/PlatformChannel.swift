@@ -105,10 +105,274 @@ private let driftNativeMethodHandlerImpl: DriftNativeMethodHandler = { channelPt
     return 0
 }
 
+// MARK: - Message Codecs
+
+/// Encodes and decodes platform channel messages. Each channel must use the
+/// same codec on the Go and Swift sides.
+protocol MessageCodec {
+    func encode(_ value: Any?) -> Data
+    func decode(_ data: Data) -> Any?
+}
+
+/// Passes raw bytes through unchanged. Matches Go's platform.BinaryCodec.
+final class BinaryCodec: MessageCodec {
+    func encode(_ value: Any?) -> Data {
+        return value as? Data ?? Data()
+    }
+
+    func decode(_ data: Data) -> Any? {
+        return data.isEmpty ? nil : data
+    }
+}
+
+/// Compact binary encoding that keeps integer, float, and byte buffer types
+/// intact. Matches Go's platform.StandardMessageCodec.
+///
+/// Numbers decode as NSNumber, byte buffers as Data, typed lists as
+/// [Int32], [Int64], [Float], and [Double], lists as [Any], and maps as
+/// [String: Any] when every key is a string ([AnyHashable: Any] otherwise).
+/// Values that cannot be encoded or decoded become nil.
+final class StandardMessageCodec: MessageCodec {
+    private enum Tag: UInt8 {
+        case null = 0, `true`, `false`, int32, int64
+        case float64 = 6, string, uint8List, int32List, int64List, float64List, list, map, float32List
+    }
+
+    func encode(_ value: Any?) -> Data {
+        guard let value = value, !(value is NSNull) else { return Data() }
+        var out = Data()
+        write(value, to: &out)
+        return out
+    }
+
+    func decode(_ data: Data) -> Any? {
+        guard !data.isEmpty else { return nil }
+        var reader = Reader(bytes: [UInt8](data))
+        let value = reader.readValue()
+        return reader.failed || reader.pos != reader.bytes.count ? nil : value
+    }
+
+    private func writeSize(_ size: Int, to out: inout Data) {
+        if size < 254 {
+            out.append(UInt8(size))
+        } else if size <= 0xffff {
+            out.append(254)
+            append(UInt16(size), to: &out)
+        } else {
+            out.append(255)
+            append(UInt32(size), to: &out)
+        }
+    }
+
+    private func append<T: FixedWidthInteger>(_ value: T, to out: inout Data) {
+        withUnsafeBytes(of: value.littleEndian) { out.append(contentsOf: $0) }
+    }
+
+    private func align(_ alignment: Int, _ out: inout Data) {
+        let pad = (alignment - out.count % alignment) % alignment
+        out.append(contentsOf: [UInt8](repeating: 0, count: pad))
+    }
+
+    /// Reports whether value is a native Swift array of T. Conditional casts
+    /// alone would also match [Any] lists of numbers through bridging.
+    private func isArray<T>(_ value: Any?, of type: T.Type) -> Bool {
+        guard let value = value else { return false }
+        return Swift.type(of: value) == [T].self
+    }
+
+    private func writeTag(_ tag: Tag, _ out: inout Data) {
+        out.append(tag.rawValue)
+    }
+
+    private func write(_ value: Any?, to out: inout Data) {
+        switch value {
+        case nil, is NSNull:
+            writeTag(.null, &out)
+        case let number as NSNumber where CFGetTypeID(number) == CFBooleanGetTypeID():
+            writeTag(number.boolValue ? .true : .false, &out)
+        case let number as NSNumber where CFNumberIsFloatType(number):
+            writeTag(.float64, &out)
+            align(8, &out)
+            append(number.doubleValue.bitPattern, to: &out)
+        case let number as NSNumber:
+            let n = number.int64Value
+            if n >= Int64(Int32.min) && n <= Int64(Int32.max) {
+                writeTag(.int32, &out)
+                append(Int32(n), to: &out)
+            } else {
+                writeTag(.int64, &out)
+                append(n, to: &out)
+            }
+        case let string as String:
+            let bytes = Data(string.utf8)
+            writeTag(.string, &out)
+            writeSize(bytes.count, to: &out)
+            out.append(bytes)
+        case let data as Data:
+            writeTag(.uint8List, &out)
+            writeSize(data.count, to: &out)
+            out.append(data)
+        case let list as [Int32] where isArray(value, of: Int32.self):
+            writeTag(.int32List, &out)
+            writeSize(list.count, to: &out)
+            align(4, &out)
+            list.forEach { append($0, to: &out) }
+        case let list as [Int64] where isArray(value, of: Int64.self):
+            writeTag(.int64List, &out)
+            writeSize(list.count, to: &out)
+            align(8, &out)
+            list.forEach { append($0, to: &out) }
+        case let list as [Float] where isArray(value, of: Float.self):
+            writeTag(.float32List, &out)
+            writeSize(list.count, to: &out)
+            align(4, &out)
+            list.forEach { append($0.bitPattern, to: &out) }
+        case let list as [Double] where isArray(value, of: Double.self):
+            writeTag(.float64List, &out)
+            writeSize(list.count, to: &out)
+            align(8, &out)
+            list.forEach { append($0.bitPattern, to: &out) }
+        case let list as [Any]:
+            writeTag(.list, &out)
+            writeSize(list.count, to: &out)
+            list.forEach { write($0, to: &out) }
+        case let map as [AnyHashable: Any]:
+            writeTag(.map, &out)
+            writeSize(map.count, to: &out)
+            for (key, item) in map {
+                write(key.base, to: &out)
+                write(item, to: &out)
+            }
+        default:
+            writeTag(.null, &out)
+        }
+    }
+
+    private struct Reader {
+        let bytes: [UInt8]
+        var pos = 0
+        var failed = false
+
+        mutating func take(_ count: Int) -> ArraySlice<UInt8>? {
+            guard !failed, count >= 0, bytes.count - pos >= count else {
+                failed = true
+                return nil
+            }
+            defer { pos += count }
+            return bytes[pos..<pos + count]
+        }
+
+        mutating func read<T: FixedWidthInteger>(_ type: T.Type) -> T? {
+            guard let slice = take(MemoryLayout<T>.size) else { return nil }
+            var value: T = 0
+            for (i, byte) in slice.enumerated() {
+                value |= T(truncatingIfNeeded: byte) << (i * 8)
+            }
+            return value
+        }
+
+        mutating func align(_ alignment: Int) {
+            _ = take((alignment - pos % alignment) % alignment)
+        }
+
+        mutating func readSize() -> Int? {
+            guard let first = read(UInt8.self) else { return nil }
+            switch first {
+            case 254: return read(UInt16.self).map(Int.init)
+            case 255: return read(UInt32.self).map(Int.init)
+            default: return Int(first)
+            }
+        }
+
+        mutating func readList<T>(width: Int, _ element: (inout Reader) -> T?) -> [T]? {
+            guard let count = readSize() else { return nil }
+            align(width)
+            guard count <= (bytes.count - pos) / width else {
+                failed = true
+                return nil
+            }
+            var list: [T] = []
+            list.reserveCapacity(count)
+            for _ in 0..<count {
+                guard let item = element(&self) else { return nil }
+                list.append(item)
+            }
+            return list
+        }
+
+        mutating func readValue() -> Any? {
+            guard let raw = read(UInt8.self), let tag = Tag(rawValue: raw) else {
+                failed = true
+                return nil
+            }
+            switch tag {
+            case .null: return nil
+            case .true: return NSNumber(value: true)
+            case .false: return NSNumber(value: false)
+            case .int32: return read(Int32.self).map { NSNumber(value: $0) }
+            case .int64: return read(Int64.self).map { NSNumber(value: $0) }
+            case .float64:
+                align(8)
+                return read(UInt64.self).map { NSNumber(value: Double(bitPattern: $0)) }
+            case .string:
+                guard let count = readSize(), let slice = take(count) else { return nil }
+                return String(decoding: slice, as: UTF8.self)
+            case .uint8List:
+                guard let count = readSize(), let slice = take(count) else { return nil }
+                return Data(slice)
+            case .int32List:
+                return readList(width: 4) { $0.read(Int32.self) }
+            case .int64List:
+                return readList(width: 8) { $0.read(Int64.self) }
+            case .float32List:
+                return readList(width: 4) { $0.read(UInt32.self).map(Float.init(bitPattern:)) }
+            case .float64List:
+                return readList(width: 8) { $0.read(UInt64.self).map(Double.init(bitPattern:)) }
+            case .list:
+                guard let count = readSize(), count <= bytes.count - pos else {
+                    failed = true
+                    return nil
+                }
+                var list: [Any] = []
+                for _ in 0..<count {
+                    let item = readValue()
+                    if failed { return nil }
+                    list.append(item ?? NSNull())
+                }
+                return list
+            case .map:
+                guard let count = readSize(), count <= (bytes.count - pos) / 2 else {
+                    failed = true
+                    return nil
+                }
+                var map: [AnyHashable: Any] = [:]
+                var stringKeys = true
+                for _ in 0..<count {
+                    let key = readValue()
+                    let item = readValue()
+                    if failed { return nil }
+                    guard let hashable = (key ?? NSNull()) as? AnyHashable else {
+                        failed = true
+                        return nil
+                    }
+                    if !(hashable.base is String) { stringKeys = false }
+                    map[hashable] = item ?? NSNull()
+                }
+                if stringKeys {
+                    var strings: [String: Any] = [:]
+                    for (key, item) in map { strings[key.base as! String] = item }
+                    return strings
+                }
+                return map
+            }
+        }
+    }
+}
+
 // MARK: - JSON Helpers
 
-/// Simple JSON codec for basic types.
-final class JsonCodec {
+/// Simple JSON codec for basic types. The default codec for channels.
+final class JsonCodec: MessageCodec {
     func encode(_ value: Any?) -> Data {
         let normalized = normalize(value)
         // JSONSerialization requires top-level array/dict, so wrap primitives
@@ -162,17 +426,38 @@ final class PlatformChannelManager {
     static let shared = PlatformChannelManager()
 
     private var handlers: [String: MethodHandler] = [:]
+    private var codecs: [String: MessageCodec] = [:]
+    private let codecsLock = NSLock()
     private let codec = JsonCodec()
 
     typealias MethodHandler = (String, Any?) -> (Any?, Error?)
 
     private init() {
         registerBuiltInChannels()
+        DriftPluginRegistry.registerAll(channels: self)
     }
 
-    /// Registers a handler for a platform channel.
-    func register(channel: String, handler: @escaping MethodHandler) {
+    /// Registers a handler for a platform channel. The codec must match the
+    /// one the Go side passes to NewMethodChannelWithCodec; it is also used for
+    /// events sent on the same channel name.
+    func register(channel: String, codec: MessageCodec? = nil, handler: @escaping MethodHandler) {
         handlers[channel] = handler
+        setCodec(channel: channel, codec: codec)
+    }
+
+    /// Sets the codec for a channel without a method handler, such as an
+    /// event-only channel created in Go with NewEventChannelWithCodec. Pass nil
+    /// to restore the default JSON codec.
+    func setCodec(channel: String, codec: MessageCodec?) {
+        codecsLock.lock()
+        codecs[channel] = codec
+        codecsLock.unlock()
+    }
+
+    private func codecFor(_ channel: String) -> MessageCodec {
+        codecsLock.lock()
+        defer { codecsLock.unlock() }
+        return codecs[channel] ?? codec
     }
 
     /// Handles a method call from Go and returns the result.
@@ -181,9 +466,10 @@ final class PlatformChannelManager {
             return (nil, NSError(domain: "PlatformChannel", code: 404, userInfo: [NSLocalizedDescriptionKey: "Channel not found: \(channel)"]))
         }
 
+        let channelCodec = codecFor(channel)
         var args: Any? = nil
         if let argsData = argsData, !argsData.isEmpty {
-            args = codec.decode(argsData)
+            args = channelCodec.decode(argsData)
         }
 
         let (result, error) = handler(method, args)
@@ -192,13 +478,13 @@ final class PlatformChannelManager {
             return (nil, error)
         }
 
-        let resultData = codec.encode(result)
+        let resultData = channelCodec.encode(result)
         return (resultData, nil)
     }
 
     /// Sends an event to Go listeners.
     func sendEvent(channel: String, data: Any?) {
-        let encoded = codec.encode(data)
+        let encoded = codecFor(channel).encode(data)
         encoded.withUnsafeBytes { ptr in
             channel.withCString { channelPtr in
                 DriftPlatformHandleEvent(channelPtr, ptr.baseAddress, Int32(encoded.count))
@@ -237,6 +523,25 @@ final class PlatformChannelManager {
             return HapticsHandler.handle(method: method, args: args)
         }
 
+        // View configuration channel
+        register(channel: "drift/display") { method, args in
+            return DisplayHandler.handle(method: method, args: args)
+        }
+
+        register(channel: "drift/view_configuration") { method, args in
+            return ViewConfigurationHandler.handle(method: method, args: args)
+        }
+
+        // Dynamic color channel
+        register(channel: "drift/dynamic_color") { method, args in
+            return DynamicColorHandler.handle(method: method, args: args)
+        }
+
+        // Locale channel
+        register(channel: "drift/locale") { method, args in
+            return LocaleHandler.handle(method: method, args: args)
+        }
+
         // Share channel
         register(channel: "drift/share") { method, args in
             return ShareHandler.handle(method: method, args: args)
@@ -252,6 +557,11 @@ final class PlatformChannelManager {
             return SystemUIHandler.handle(method: method, args: args)
         }
 
+        // Splash channel
+        register(channel: "drift/splash") { method, _ in
+            return SplashHandler.handle(method: method)
+        }
+
         // Notifications channel
         NotificationHandler.start()
         register(channel: "drift/notifications") { method, args in
@@ -330,6 +640,81 @@ final class PlatformChannelManager {
     }
 }
 
+// MARK: - Plugins
+
+/// Implemented by the iOS side of a Drift plugin. A plugin pairs a Go package
+/// that calls platform.RegisterPlugin with Swift code that registers handlers
+/// for the same channel names and view types.
+protocol DriftPlugin: AnyObject {
+    /// Registers the plugin's channel handlers and platform view factories.
+    func register(with registrar: DriftPluginRegistrar)
+}
+
+/// Implemented by the generated `GeneratedPluginRegistrant` class, which must
+/// be exposed to Objective-C under that name so it can be found at runtime.
+protocol DriftPluginRegistrant {
+    static func register(with registry: DriftPluginRegistry.Type)
+}
+
+/// Registers channels and platform views on behalf of a plugin.
+final class DriftPluginRegistrar {
+    private unowned let channels: PlatformChannelManager
+
+    fileprivate init(channels: PlatformChannelManager) {
+        self.channels = channels
+    }
+
+    /// Registers a handler for a channel created in Go with the registrar's
+    /// MethodChannel or MethodChannelWithCodec. The codec must match.
+    func register(channel: String, codec: MessageCodec? = nil, handler: @escaping PlatformChannelManager.MethodHandler) {
+        channels.register(channel: channel, codec: codec, handler: handler)
+    }
+
+    /// Sets the codec for an event-only channel created in Go.
+    func setEventCodec(channel: String, codec: MessageCodec?) {
+        channels.setCodec(channel: channel, codec: codec)
+    }
+
+    /// Sends an event to Go listeners of an event channel.
+    func sendEvent(channel: String, data: Any?) {
+        channels.sendEvent(channel: channel, data: data)
+    }
+
+    /// Registers a factory for a view type the Go side creates with a PlatformViewFactory.
+    func registerViewFactory(viewType: String, factory: @escaping PlatformViewHandler.Factory) {
+        PlatformViewHandler.registerFactory(viewType: viewType, factory: factory)
+    }
+}
+
+/// Registers plugins with the embedder at startup. Plugins are listed by the
+/// generated `GeneratedPluginRegistrant` class; when it is absent, no plugins
+/// are registered.
+enum DriftPluginRegistry {
+    private static var plugins: [DriftPlugin] = []
+    private static var registrar: DriftPluginRegistrar?
+
+    /// Adds a plugin. Plugins added after startup register immediately.
+    static func add(_ plugin: DriftPlugin) {
+        if plugins.contains(where: { type(of: $0) == type(of: plugin) }) {
+            return
+        }
+        plugins.append(plugin)
+        if let registrar = registrar {
+            plugin.register(with: registrar)
+        }
+    }
+
+    fileprivate static func registerAll(channels: PlatformChannelManager) {
+        guard registrar == nil else { return }
+        if let registrant = NSClassFromString("GeneratedPluginRegistrant") as? DriftPluginRegistrant.Type {
+            registrant.register(with: DriftPluginRegistry.self)
+        }
+        let r = DriftPluginRegistrar(channels: channels)
+        registrar = r
+        plugins.forEach { $0.register(with: r) }
+    }
+}
+
 // MARK: - Clipboard Handler
 
 enum ClipboardHandler {
@@ -360,6 +745,92 @@ enum ClipboardHandler {
     }
 }
 
+// MARK: - View Configuration Handler
+
+enum ViewConfigurationHandler {
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        switch method {
+        case "get":
+            // UIKit has no user setting for these; report its recognizer defaults.
+            let longPress = UILongPressGestureRecognizer().minimumPressDuration
+            return (["longPressTimeoutMs": Int(longPress * 1000)], nil)
+
+        default:
+            return (nil, NSError(domain: "ViewConfiguration", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+    }
+}
+
+// MARK: - Display Handler
+
+/// Applies the frame rate preferred by the Go engine and reports the display's
+/// refresh interval back to it.
+enum DisplayHandler {
+    /// The preferred rate from Go; 0 means the display's highest rate.
+    private static var preferredFrameRate: Float = 0
+    private static var reportedInterval: CFTimeInterval = 0
+
+    /// Called on the main thread when the preferred rate changes.
+    static var onChange: (() -> Void)?
+
+    /// The rate the display link should ask for, capped by the display.
+    static var targetFrameRate: Float {
+        let maximum = Float(UIScreen.main.maximumFramesPerSecond)
+        guard preferredFrameRate > 0 else { return maximum }
+        return min(preferredFrameRate, maximum)
+    }
+
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        guard method == "setPreferredFrameRate" else {
+            return (nil, NSError(domain: "Display", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+        let fps = ((args as? [String: Any])?["fps"] as? NSNumber)?.floatValue ?? 0
+        DispatchQueue.main.async {
+            preferredFrameRate = max(0, fps)
+            onChange?()
+        }
+        let maximum = Float(UIScreen.main.maximumFramesPerSecond)
+        return (Double(fps > 0 ? min(fps, maximum) : maximum), nil)
+    }
+
+    /// Reports the display link's frame interval to Go when it changes, as
+    /// when the system lowers the rate in low power mode.
+    static func reportInterval(_ interval: CFTimeInterval) {
+        guard interval > 0, abs(interval - reportedInterval) > 0.0005 else { return }
+        reportedInterval = interval
+        DriftSetVsyncInterval(Int64(interval * 1_000_000_000))
+    }
+}
+
+// MARK: - Dynamic Color Handler
+
+enum DynamicColorHandler {
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        switch method {
+        case "get":
+            // iOS has no system palette; the app's tint color is its accent.
+            let window = UIApplication.shared.connectedScenes
+                .compactMap { ($0 as? UIWindowScene)?.keyWindow }
+                .first
+            let tint = window?.tintColor ?? UIColor.systemBlue
+            return ([
+                "primary": argb(tint),
+                "highContrast": UIAccessibility.isDarkerSystemColorsEnabled
+            ], nil)
+
+        default:
+            return (nil, NSError(domain: "DynamicColor", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+    }
+
+    private static func argb(_ color: UIColor) -> Int {
+        var r: CGFloat = 0, g: CGFloat = 0, b: CGFloat = 0, a: CGFloat = 0
+        color.getRed(&r, green: &g, blue: &b, alpha: &a)
+        func byte(_ v: CGFloat) -> Int { Int((min(max(v, 0), 1) * 255).rounded()) }
+        return (byte(a) << 24) | (byte(r) << 16) | (byte(g) << 8) | byte(b)
+    }
+}
+
 // MARK: - Haptics Handler
 
 enum HapticsHandler {
@@ -1025,6 +1496,56 @@ enum LifecycleHandler {
     }
 }
 
+// MARK: - Splash Handler
+
+/// Keeps the launch screen over the Drift surface until the Go engine reports
+/// that its first frame is on screen, then fades it out. This avoids showing
+/// the empty surface between the launch screen and the first frame on cold
+/// start.
+enum SplashHandler {
+    private static let fadeDuration: TimeInterval = 0.2
+
+    private static var view: UIView?
+
+    /// True once the engine has asked to hide the splash.
+    private(set) static var isHidden = false
+
+    /// Covers hostView with the launch screen until the engine hides it.
+    static func attach(to hostView: UIView) {
+        // UIStoryboard(name:bundle:) raises if the storyboard is missing,
+        // so check that it was compiled into the main bundle first.
+        guard !isHidden, view == nil,
+              Bundle.main.path(forResource: "LaunchScreen", ofType: "storyboardc") != nil,
+              let splash = UIStoryboard(name: "LaunchScreen", bundle: nil)
+                .instantiateInitialViewController()?.view else {
+            return
+        }
+        splash.frame = hostView.bounds
+        splash.autoresizingMask = [.flexibleWidth, .flexibleHeight]
+        splash.isAccessibilityElement = false
+        splash.accessibilityElementsHidden = true
+        hostView.addSubview(splash)
+        view = splash
+    }
+
+    static func handle(method: String) -> (Any?, Error?) {
+        guard method == "hide" else {
+            return (nil, NSError(domain: "Splash", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+        DispatchQueue.main.async {
+            isHidden = true
+            guard let splash = view else { return }
+            view = nil
+            UIView.animate(withDuration: fadeDuration, animations: {
+                splash.alpha = 0
+            }, completion: { _ in
+                splash.removeFromSuperview()
+            })
+        }
+        return (nil, nil)
+    }
+}
+
 // MARK: - Safe Area Handler
 
 enum SafeAreaHandler {
@@ -1046,6 +1567,43 @@ enum SafeAreaHandler {
     }
 }
 
+// MARK: - Locale Handler
+
+enum LocaleHandler {
+    static func handle(method: String, args: Any?) -> (Any?, Error?) {
+        switch method {
+        case "getPreferredLocales":
+            return (["locales": Locale.preferredLanguages], nil)
+
+        default:
+            return (nil, NSError(domain: "Locale", code: 404, userInfo: [NSLocalizedDescriptionKey: "Unknown method: \(method)"]))
+        }
+    }
+}
+
+// MARK: - Appearance Handler
+
+enum AppearanceHandler {
+    static func sendAppearanceUpdate(_ traits: UITraitCollection) {
+        PlatformChannelManager.shared.sendEvent(
+            channel: "drift/appearance/events",
+            data: [
+                "darkMode": traits.userInterfaceStyle == .dark,
+                "highContrast": UIAccessibility.isDarkerSystemColorsEnabled,
+                "textScaleFactor": textScaleFactor(traits),
+                "reduceMotion": UIAccessibility.isReduceMotionEnabled
+            ]
+        )
+    }
+
+    /// Returns the Dynamic Type size relative to the default "Large" size,
+    /// measured on the body text style (17pt at the default setting).
+    private static func textScaleFactor(_ traits: UITraitCollection) -> Double {
+        let metrics = UIFontMetrics(forTextStyle: .body)
+        return Double(metrics.scaledValue(for: 17, compatibleWith: traits) / 17)
+    }
+}
+
 // MARK: - URL Launcher Handler
 
 enum URLLauncherHandler {
@@ -285,8 +285,18 @@ enum PlatformViewHandler {
     private static var views: [Int: PlatformViewContainer] = [:]
     private static var interceptors: [Int: TouchInterceptorView] = [:]
     private static var maskLayers: [Int: CAShapeLayer] = [:]
+    private static var factories: [String: Factory] = [:]
     private static weak var hostView: UIView?
 
+    /// Creates a platform view container for a plugin view type.
+    typealias Factory = (_ viewId: Int, _ params: [String: Any]) -> PlatformViewContainer?
+
+    /// Registers a factory for a plugin view type. Built-in view types
+    /// cannot be replaced.
+    static func registerFactory(viewType: String, factory: @escaping Factory) {
+        factories[viewType] = factory
+    }
+
     /// Sets the host view where platform views will be added.
     static func setHostView(_ view: UIView) {
         hostView = view
@@ -530,6 +540,10 @@ enum PlatformViewHandler {
         case "video_player":
             container = NativeVideoPlayerContainer(viewId: viewId, params: params)
         default:
+            if let factory = factories[viewType] {
+                container = factory(viewId, params)
+                break
+            }
             return (nil, NSError(domain: "PlatformView", code: 400, userInfo: [NSLocalizedDescriptionKey: "Unknown view type: \(viewType)"]))
         }
 
@@ -1,6 +1,7 @@
 /// StorageHandler.swift
 /// Handles file system access and document picking for the Drift platform channel.
 
+import PhotosUI
 import UIKit
 import UniformTypeIdentifiers
 
@@ -10,6 +11,7 @@ final class StorageHandler: NSObject {
     private var pendingSaveData: Data?
     private var pendingRequestType: String = "pickFile"
     private var pendingRequestId: String?
+    private var pendingReportProgress = false
 
     private override init() {
         super.init()
@@ -42,12 +44,18 @@ final class StorageHandler: NSObject {
         let dict = args as? [String: Any] ?? [:]
         let allowMultiple = dict["allowMultiple"] as? Bool ?? false
         let allowedTypes = dict["allowedTypes"] as? [String] ?? ["public.item"]
+        let source = dict["source"] as? String ?? "documents"
 
         pendingRequestType = "pickFile"
         pendingRequestId = dict["requestId"] as? String
+        pendingReportProgress = dict["reportProgress"] as? Bool ?? false
 
         DispatchQueue.main.async {
-            self.presentDocumentPicker(allowMultiple: allowMultiple, allowedTypes: allowedTypes)
+            if source == "images" {
+                self.presentImagePicker(allowMultiple: allowMultiple)
+            } else {
+                self.presentDocumentPicker(allowMultiple: allowMultiple, allowedTypes: allowedTypes)
+            }
         }
 
         // Result will be delivered via drift/storage/result event channel
@@ -75,6 +83,15 @@ final class StorageHandler: NSObject {
         presentPicker(picker)
     }
 
+    private func presentImagePicker(allowMultiple: Bool) {
+        var config = PHPickerConfiguration()
+        config.selectionLimit = allowMultiple ? 0 : 1
+        config.filter = .images
+        let picker = PHPickerViewController(configuration: config)
+        picker.delegate = self
+        presentPicker(picker)
+    }
+
     private func pickDirectory(args: Any?) -> (Any?, Error?) {
         let dict = args as? [String: Any] ?? [:]
         pendingRequestType = "pickDirectory"
@@ -291,6 +308,18 @@ final class StorageHandler: NSObject {
         pendingRequestId = nil
     }
 
+    private func sendProgress(name: String, index: Int, count: Int, bytesCopied: Int64, totalBytes: Int64, requestId: String?) {
+        var event: [String: Any] = [
+            "name": name,
+            "index": index,
+            "count": count,
+            "bytesCopied": bytesCopied,
+            "totalBytes": totalBytes
+        ]
+        if let reqId = requestId { event["requestId"] = reqId }
+        PlatformChannelManager.shared.sendEvent(channel: "drift/storage/progress", data: event)
+    }
+
     private func sendPickDirectoryResult(_ path: String?) {
         var event: [String: Any] = [
             "type": "pickDirectory",
@@ -357,6 +386,12 @@ extension StorageHandler: UIDocumentPickerDelegate {
                     mimeType = uti.preferredMIMEType ?? ""
                 }
 
+                if pendingReportProgress {
+                    // Document picker copies are complete by the time we're called.
+                    sendProgress(name: url.lastPathComponent, index: files.count, count: urls.count,
+                                 bytesCopied: size, totalBytes: size, requestId: pendingRequestId)
+                }
+
                 files.append([
                     "name": url.lastPathComponent,
                     "path": url.path,
@@ -382,3 +417,66 @@ extension StorageHandler: UIDocumentPickerDelegate {
         sendCancelled(pendingRequestType)
     }
 }
+
+// MARK: - PHPickerViewControllerDelegate
+
+extension StorageHandler: PHPickerViewControllerDelegate {
+    func picker(_ picker: PHPickerViewController, didFinishPicking results: [PHPickerResult]) {
+        picker.dismiss(animated: true)
+        if results.isEmpty {
+            sendCancelled("pickFile")
+            return
+        }
+
+        let requestId = pendingRequestId
+        let reportProgress = pendingReportProgress
+        let cacheDir = FileManager.default.urls(for: .cachesDirectory, in: .userDomainMask)[0]
+            .appendingPathComponent("drift_picked")
+            .appendingPathComponent(UUID().uuidString)
+        try? FileManager.default.createDirectory(at: cacheDir, withIntermediateDirectories: true)
+
+        var files = [[String: Any]?](repeating: nil, count: results.count)
+        var observers: [NSKeyValueObservation] = []
+        let group = DispatchGroup()
+
+        for (index, result) in results.enumerated() {
+            let provider = result.itemProvider
+            let name = provider.suggestedName ?? "image"
+            group.enter()
+            // The provided file is deleted when the handler returns, so copy it into the cache.
+            let progress = provider.loadFileRepresentation(forTypeIdentifier: UTType.image.identifier) { url, _ in
+                defer { group.leave() }
+                guard let url = url else { return }
+                let target = cacheDir.appendingPathComponent("\(index)_\(name).\(url.pathExtension)")
+                do {
+                    try FileManager.default.copyItem(at: url, to: target)
+                    let size = (try? FileManager.default.attributesOfItem(atPath: target.path)[.size] as? Int64) ?? 0
+                    let mimeType = UTType(filenameExtension: target.pathExtension)?.preferredMIMEType ?? "image/*"
+                    DispatchQueue.main.async {
+                        files[index] = [
+                            "name": target.lastPathComponent,
+                            "path": target.path,
+                            "uri": target.absoluteString,
+                            "mimeType": mimeType,
+                            "size": size
+                        ]
+                    }
+                } catch {
+                    // Skip images we can't copy
+                }
+            }
+            if reportProgress {
+                observers.append(progress.observe(\.fractionCompleted) { [weak self] progress, _ in
+                    self?.sendProgress(name: name, index: index, count: results.count,
+                                       bytesCopied: progress.completedUnitCount, totalBytes: progress.totalUnitCount,
+                                       requestId: requestId)
+                })
+            }
+        }
+
+        group.notify(queue: .main) {
+            observers.forEach { $0.invalidate() }
+            self.sendPickFileResult(files.compactMap { $0 })
+        }
+    }
+}
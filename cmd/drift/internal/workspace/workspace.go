@@ -12,6 +12,7 @@ import (
 
 	"github.com/go-drift/drift/cmd/drift/internal/cache"
 	"github.com/go-drift/drift/cmd/drift/internal/config"
+	"github.com/go-drift/drift/cmd/drift/internal/plugins"
 	"github.com/go-drift/drift/cmd/drift/internal/scaffold"
 	"github.com/go-drift/drift/cmd/drift/internal/templates"
 )
@@ -167,6 +168,19 @@ func Prepare(root string, cfg *config.Resolved, platform string) (*Workspace, er
 		return nil, fmt.Errorf("failed to create bridge directory: %w", err)
 	}
 
+	found, err := plugins.Discover(root)
+	if err != nil {
+		return nil, err
+	}
+	if ejected {
+		// Ejected projects are user-owned, so plugin sources are not copied in.
+		for _, p := range found {
+			if (platform == "android" && p.Android.Class != "") || (platform != "android" && p.IOS.Class != "") {
+				fmt.Printf("Plugin %s has native code to add to the ejected project: %s\n", p.Name, p.Dir)
+			}
+		}
+	}
+
 	settings := scaffold.Settings{
 		AppName:        cfg.AppName,
 		AppID:          cfg.AppID,
@@ -177,6 +191,7 @@ func Prepare(root string, cfg *config.Resolved, platform string) (*Workspace, er
 		ProjectRoot:    root,
 		Icon:           cfg.Icon,
 		IconBackground: cfg.IconBackground,
+		Plugins:        found,
 	}
 
 	switch platform {
@@ -0,0 +1,110 @@
+package icu
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMessage_Args(t *testing.T) {
+	m, err := Parse("{name} has {count, plural, one{# {kind}} other{# {kind}s}} from {name}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Arg{{"name", ArgSimple}, {"count", ArgPlural}, {"kind", ArgSimple}}
+	if got := m.Args(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		tag                      string
+		language, script, region string
+	}{
+		{"en", "en", "", ""},
+		{"pt_br", "pt", "", "BR"},
+		{"zh-hant-tw", "zh", "Hant", "TW"},
+		{"es-419", "es", "", "419"},
+		{"de-DE-u-co-phonebk", "de", "", "DE"},
+		{"", "", "", ""},
+		{"1234", "", "", ""},
+	}
+	for _, tt := range tests {
+		language, script, region := ParseTag(tt.tag)
+		if language != tt.language || script != tt.script || region != tt.region {
+			t.Errorf("ParseTag(%q) = %q, %q, %q, want %q, %q, %q", tt.tag, language, script, region, tt.language, tt.script, tt.region)
+		}
+	}
+}
+
+func TestPluralCategory(t *testing.T) {
+	tests := []struct {
+		language string
+		n        float64
+		want     string
+	}{
+		{"en", 1, pluralOne},
+		{"en", 0, pluralOther},
+		{"en", 1.5, pluralOther},
+		{"fr", 0, pluralOne},
+		{"fr", 1.5, pluralOne},
+		{"fr", 2, pluralOther},
+		{"ja", 1, pluralOther},
+		{"ru", 21, pluralOne},
+		{"ru", 11, pluralMany},
+		{"ru", 23, pluralFew},
+		{"ru", 25, pluralMany},
+		{"pl", 22, pluralFew},
+		{"pl", 21, pluralMany},
+		{"ar", 0, pluralZero},
+		{"ar", 2, pluralTwo},
+		{"ar", 105, pluralFew},
+		{"ar", 111, pluralMany},
+		{"xx", 1, pluralOne},
+	}
+	for _, tt := range tests {
+		if got := PluralCategory(tt.language, tt.n); got != tt.want {
+			t.Errorf("PluralCategory(%q, %v) = %q, want %q", tt.language, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFormatDecimal(t *testing.T) {
+	en, de, hi := NumberSymbols("en", ""), NumberSymbols("de", ""), NumberSymbols("hi", "")
+	tests := []struct {
+		s                        Symbols
+		n                        float64
+		minInt, minFrac, maxFrac int
+		grouping                 bool
+		want                     string
+	}{
+		{en, 1234567.891, 1, 0, 2, true, "1,234,567.89"},
+		{en, 2.5, 1, 0, 0, false, "2"},
+		{en, 3.5, 1, 0, 0, false, "4"},
+		{en, 1.5, 1, 3, 3, false, "1.500"},
+		{en, 0.5, 3, 0, 1, false, "000.5"},
+		{en, -0.001, 1, 0, 2, false, "0"},
+		{de, -1234.5, 1, 0, 1, true, "-1.234,5"},
+		{hi, 1234567, 1, 0, 0, true, "12,34,567"},
+		{NumberSymbols("es", ""), 1234, 1, 0, 0, true, "1234"},
+		{NumberSymbols("es", "MX"), 1234, 1, 0, 0, true, "1,234"},
+	}
+	for _, tt := range tests {
+		if got := FormatDecimal(tt.s, tt.n, tt.minInt, tt.minFrac, tt.maxFrac, tt.grouping); got != tt.want {
+			t.Errorf("FormatDecimal(%v) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestMessage_FormatUsesLocaleNumbers(t *testing.T) {
+	m, err := Parse("{n, plural, =1000{exactly} other{# items}} for {total, number}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Format("de-DE", map[string]any{"n": 1234, "total": 9.5}); got != "1.234 items for 9,5" {
+		t.Errorf("got %q", got)
+	}
+	if got := m.Format("en", map[string]any{"n": 1000, "total": 1}); got != "exactly for 1" {
+		t.Errorf("expected the exact branch to match unformatted digits, got %q", got)
+	}
+}
@@ -0,0 +1,400 @@
+// Package icu parses and formats the subset of ICU MessageFormat used by
+// the intl package: simple, number, plural, and select arguments, with
+// apostrophe quoting. It has no framework dependencies, so the drift CLI can
+// validate message files with it.
+package icu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ArgKind is how an ICU message argument is formatted.
+type ArgKind string
+
+const (
+	// ArgSimple is a plain placeholder, such as {name}.
+	ArgSimple ArgKind = ""
+	// ArgNumber is a number placeholder, such as {total, number}.
+	ArgNumber ArgKind = "number"
+	// ArgPlural selects a branch by plural category, such as
+	// {count, plural, one{# item} other{# items}}.
+	ArgPlural ArgKind = "plural"
+	// ArgSelect selects a branch by value, such as
+	// {gender, select, female{her} male{his} other{their}}.
+	ArgSelect ArgKind = "select"
+)
+
+// Arg describes an argument referenced by a message.
+type Arg struct {
+	Name string
+	Kind ArgKind
+}
+
+// Message is a parsed ICU message.
+type Message struct {
+	parts []part
+}
+
+// Parse parses an ICU message.
+func Parse(pattern string) (*Message, error) {
+	p := &messageParser{src: pattern}
+	parts, err := p.parseParts(false)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unmatched '}'")
+	}
+	return &Message{parts: parts}, nil
+}
+
+// Args returns the arguments m references, in order of first appearance.
+func (m *Message) Args() []Arg {
+	var args []Arg
+	seen := map[string]bool{}
+	var walk func([]part)
+	walk = func(parts []part) {
+		for _, p := range parts {
+			if p.arg == nil {
+				continue
+			}
+			if !seen[p.arg.name] {
+				seen[p.arg.name] = true
+				args = append(args, Arg{Name: p.arg.name, Kind: p.arg.kind})
+			}
+			for _, b := range p.arg.branches {
+				walk(b.parts)
+			}
+		}
+	}
+	walk(m.parts)
+	return args
+}
+
+// Format returns m with args substituted for locale (a BCP 47 tag such as
+// "de-CH"), choosing plural branches by the locale's language rules and
+// writing numbers with its separators. Plural and number arguments take any
+// Go integer or float type; other arguments are formatted with fmt.Sprint.
+// Missing arguments are left as {name}.
+func (m *Message) Format(locale string, args map[string]any) string {
+	language, _, region := ParseTag(locale)
+	loc := messageLocale{language: language, symbols: NumberSymbols(language, region)}
+	var b strings.Builder
+	format(&b, m.parts, loc, args, "")
+	return b.String()
+}
+
+// messageLocale is the locale a message is formatted for.
+type messageLocale struct {
+	language string
+	symbols  Symbols
+}
+
+// number writes n with the locale's separators and up to three fraction
+// digits, as ICU's default number format does.
+func (l messageLocale) number(n float64) string {
+	return FormatDecimal(l.symbols, n, 1, 0, 3, true)
+}
+
+// part is a literal run of text or an argument.
+type part struct {
+	text  string
+	arg   *argument
+	pound bool // # inside a plural branch
+}
+
+type argument struct {
+	name     string
+	kind     ArgKind
+	branches []branch
+}
+
+type branch struct {
+	selector string
+	parts    []part
+}
+
+type messageParser struct {
+	src string
+	pos int
+}
+
+func (p *messageParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%s at offset %d in %q", fmt.Sprintf(format, args...), p.pos, p.src)
+}
+
+// parseParts reads text and arguments until an unmatched '}' or the end.
+func (p *messageParser) parseParts(inPlural bool) ([]part, error) {
+	var parts []part
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			parts = append(parts, part{text: text.String()})
+			text.Reset()
+		}
+	}
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '}':
+			flush()
+			return parts, nil
+		case c == '{':
+			flush()
+			p.pos++
+			arg, err := p.parseArgument(inPlural)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, part{arg: arg})
+		case c == '#' && inPlural:
+			flush()
+			p.pos++
+			parts = append(parts, part{pound: true})
+		case c == '\'':
+			p.pos++
+			text.WriteString(p.quoted(inPlural))
+		default:
+			text.WriteByte(c)
+			p.pos++
+		}
+	}
+	flush()
+	return parts, nil
+}
+
+// quoted handles an apostrophe just consumed: ” is a literal apostrophe,
+// and an apostrophe before a special character quotes text up to the next
+// single apostrophe. Any other apostrophe is literal.
+func (p *messageParser) quoted(inPlural bool) string {
+	if p.pos >= len(p.src) {
+		return "'"
+	}
+	c := p.src[p.pos]
+	if c == '\'' {
+		p.pos++
+		return "'"
+	}
+	if c != '{' && c != '}' && !(c == '#' && inPlural) {
+		return "'"
+	}
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		p.pos++
+		if c != '\'' {
+			b.WriteByte(c)
+			continue
+		}
+		if p.pos < len(p.src) && p.src[p.pos] == '\'' {
+			b.WriteByte('\'')
+			p.pos++
+			continue
+		}
+		break
+	}
+	return b.String()
+}
+
+// parseArgument reads an argument after its opening '{', through its '}'.
+// A # in a select nested inside a plural still stands for the plural's
+// number.
+func (p *messageParser) parseArgument(inPlural bool) (*argument, error) {
+	name := p.word()
+	if name == "" {
+		return nil, p.errorf("expected argument name")
+	}
+	arg := &argument{name: name}
+	p.skipSpace()
+	if p.consume('}') {
+		return arg, nil
+	}
+	if !p.consume(',') {
+		return nil, p.errorf("expected ',' or '}' after %q", name)
+	}
+	p.skipSpace()
+	kind := ArgKind(p.word())
+	switch kind {
+	case ArgNumber:
+		p.skipSpace()
+		if !p.consume('}') {
+			return nil, p.errorf("number styles are not supported")
+		}
+		arg.kind = kind
+		return arg, nil
+	case ArgPlural, ArgSelect:
+		arg.kind = kind
+	default:
+		return nil, p.errorf("unsupported argument type %q", kind)
+	}
+	p.skipSpace()
+	if !p.consume(',') {
+		return nil, p.errorf("expected ',' after %s", kind)
+	}
+	for {
+		p.skipSpace()
+		if p.consume('}') {
+			break
+		}
+		selector := p.word()
+		if selector == "" {
+			return nil, p.errorf("expected selector in %s", kind)
+		}
+		if strings.HasPrefix(selector, "offset:") {
+			return nil, p.errorf("plural offsets are not supported")
+		}
+		p.skipSpace()
+		if !p.consume('{') {
+			return nil, p.errorf("expected '{' after selector %q", selector)
+		}
+		parts, err := p.parseParts(inPlural || kind == ArgPlural)
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume('}') {
+			return nil, p.errorf("unterminated branch %q", selector)
+		}
+		arg.branches = append(arg.branches, branch{selector: selector, parts: parts})
+	}
+	if !arg.hasBranch("other") {
+		return nil, p.errorf("%s argument %q needs an \"other\" branch", kind, name)
+	}
+	return arg, nil
+}
+
+func (p *messageParser) word() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == '{' || c == '}' || c == ',' || c == ' ' || c == '\t' || c == '\n' || c == '\r' {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *messageParser) skipSpace() {
+	for p.pos < len(p.src) && strings.IndexByte(" \t\n\r", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *messageParser) consume(c byte) bool {
+	if p.pos < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (a *argument) hasBranch(selector string) bool {
+	for _, b := range a.branches {
+		if b.selector == selector {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *argument) branch(selector string) []part {
+	for _, b := range a.branches {
+		if b.selector == selector {
+			return b.parts
+		}
+	}
+	return nil
+}
+
+// format writes parts with args substituted. number is the value # stands
+// for inside a plural branch.
+func format(b *strings.Builder, parts []part, loc messageLocale, args map[string]any, number string) {
+	for _, p := range parts {
+		switch {
+		case p.pound:
+			b.WriteString(number)
+		case p.arg == nil:
+			b.WriteString(p.text)
+		default:
+			formatArgument(b, p.arg, loc, args, number)
+		}
+	}
+}
+
+func formatArgument(b *strings.Builder, arg *argument, loc messageLocale, args map[string]any, number string) {
+	value, ok := args[arg.name]
+	if !ok {
+		// Leave the placeholder visible so missing arguments are easy to spot.
+		b.WriteString("{" + arg.name + "}")
+		return
+	}
+	switch arg.kind {
+	case ArgPlural:
+		n, ok := toFloat(value)
+		if !ok {
+			format(b, arg.branch("other"), loc, args, fmt.Sprint(value))
+			return
+		}
+		formatted := loc.number(n)
+		exact := "=" + formatNumber(n)
+		if arg.hasBranch(exact) {
+			format(b, arg.branch(exact), loc, args, formatted)
+			return
+		}
+		category := PluralCategory(loc.language, n)
+		if !arg.hasBranch(category) {
+			category = "other"
+		}
+		format(b, arg.branch(category), loc, args, formatted)
+	case ArgSelect:
+		selector := fmt.Sprint(value)
+		if !arg.hasBranch(selector) {
+			selector = "other"
+		}
+		format(b, arg.branch(selector), loc, args, number)
+	case ArgNumber:
+		if n, ok := toFloat(value); ok {
+			b.WriteString(loc.number(n))
+			return
+		}
+		fmt.Fprint(b, value)
+	default:
+		fmt.Fprint(b, value)
+	}
+}
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
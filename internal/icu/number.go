@@ -0,0 +1,192 @@
+package icu
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Non-breaking spaces used by CLDR number patterns.
+const (
+	nbsp       = "\u00a0"
+	narrowNBSP = "\u202f"
+)
+
+// Symbols holds a locale's conventions for writing numbers with Latin
+// digits: separators, the minus sign, and how digits are grouped.
+type Symbols struct {
+	// Decimal separates the integer and fraction digits.
+	Decimal string
+	// Group separates groups of integer digits.
+	Group string
+	// Minus precedes negative numbers.
+	Minus string
+	// PercentPrefix and PercentSuffix surround percentages, including any
+	// space, such as "%" or " %".
+	PercentPrefix, PercentSuffix string
+	// PrimaryGroup is the size of the rightmost digit group, and
+	// SecondaryGroup the size of the groups to its left. Indian English
+	// and Hindi use 3 and 2 (12,34,567).
+	PrimaryGroup, SecondaryGroup int
+	// MinGrouping is how many digits the leftmost group needs before
+	// grouping applies. Spanish and Polish use 2, so 1000 is not grouped
+	// but 10 000 is.
+	MinGrouping int
+}
+
+var defaultSymbols = Symbols{
+	Decimal: ".", Group: ",", Minus: "-", PercentSuffix: "%",
+	PrimaryGroup: 3, SecondaryGroup: 3, MinGrouping: 1,
+}
+
+// numberSymbols holds each language's symbols as changes from
+// defaultSymbols, and regionSymbols the regional variants that differ
+// from their language.
+var (
+	numberSymbols = map[string]func(*Symbols){
+		"de": commaDecimal(".", nbsp+"%"),
+		"es": func(s *Symbols) {
+			commaDecimal(".", nbsp+"%")(s)
+			s.MinGrouping = 2
+		},
+		"fr": commaDecimal(narrowNBSP, narrowNBSP+"%"),
+		"hi": indianGrouping,
+		"it": commaDecimal(".", "%"),
+		"nl": commaDecimal(".", "%"),
+		"pl": func(s *Symbols) {
+			commaDecimal(nbsp, "%")(s)
+			s.MinGrouping = 2
+		},
+		"pt": commaDecimal(".", "%"),
+		"ru": commaDecimal(nbsp, nbsp+"%"),
+		"sv": func(s *Symbols) {
+			commaDecimal(nbsp, nbsp+"%")(s)
+			s.Minus = "−"
+		},
+		"tr": func(s *Symbols) {
+			commaDecimal(".", "")(s)
+			s.PercentPrefix = "%"
+		},
+	}
+	regionSymbols = map[string]func(*Symbols){
+		"de-AT": commaDecimal(nbsp, nbsp+"%"),
+		"de-CH": pointDecimal("’"),
+		"en-IN": indianGrouping,
+		"es-419": func(s *Symbols) {
+			latinAmericanSpanish(s)
+			s.PercentSuffix = nbsp + "%"
+		},
+		"es-MX": latinAmericanSpanish,
+		"es-US": latinAmericanSpanish,
+		"it-CH": pointDecimal("’"),
+		"pt-PT": func(s *Symbols) {
+			commaDecimal(nbsp, "%")(s)
+			s.MinGrouping = 2
+		},
+	}
+)
+
+func commaDecimal(group, percentSuffix string) func(*Symbols) {
+	return func(s *Symbols) {
+		s.Decimal, s.Group, s.PercentSuffix = ",", group, percentSuffix
+	}
+}
+
+func pointDecimal(group string) func(*Symbols) {
+	return func(s *Symbols) {
+		s.Decimal, s.Group = ".", group
+	}
+}
+
+// latinAmericanSpanish switches to point decimals, which also group four
+// digit numbers.
+func latinAmericanSpanish(s *Symbols) {
+	pointDecimal(",")(s)
+	s.MinGrouping = 1
+}
+
+func indianGrouping(s *Symbols) {
+	s.SecondaryGroup = 2
+}
+
+// NumberSymbols returns the number symbols for a language (a lowercase ISO
+// 639 code) and optional region. Languages without data here use English
+// symbols.
+func NumberSymbols(language, region string) Symbols {
+	s := defaultSymbols
+	if apply := numberSymbols[language]; apply != nil {
+		apply(&s)
+	}
+	if apply := regionSymbols[language+"-"+region]; region != "" && apply != nil {
+		apply(&s)
+	}
+	return s
+}
+
+// FormatDecimal writes n with s's symbols, rounded half-even to at most
+// maxFraction fraction digits and padded to at least minFraction fraction
+// and minInteger integer digits. With grouping, integer digits are
+// separated into groups.
+func FormatDecimal(s Symbols, n float64, minInteger, minFraction, maxFraction int, grouping bool) string {
+	if math.IsNaN(n) {
+		return "NaN"
+	}
+	negative := math.Signbit(n)
+	if math.IsInf(n, 0) {
+		if negative {
+			return s.Minus + "∞"
+		}
+		return "∞"
+	}
+	maxFraction = max(maxFraction, minFraction)
+	digits := strconv.FormatFloat(math.Abs(n), 'f', maxFraction, 64)
+	integer, fraction, _ := strings.Cut(digits, ".")
+	for len(fraction) > minFraction && strings.HasSuffix(fraction, "0") {
+		fraction = fraction[:len(fraction)-1]
+	}
+	if strings.Trim(integer+fraction, "0") == "" {
+		// Don't write -0 for small negatives that round to zero.
+		negative = false
+	}
+	if len(integer) < minInteger {
+		integer = strings.Repeat("0", minInteger-len(integer)) + integer
+	}
+	var b strings.Builder
+	if negative {
+		b.WriteString(s.Minus)
+	}
+	if grouping {
+		writeGrouped(&b, integer, s)
+	} else {
+		b.WriteString(integer)
+	}
+	if fraction != "" {
+		b.WriteString(s.Decimal)
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
+
+// writeGrouped writes integer digits separated into s's groups.
+func writeGrouped(b *strings.Builder, integer string, s Symbols) {
+	primary, secondary := s.PrimaryGroup, s.SecondaryGroup
+	if primary <= 0 || len(integer)-primary < max(s.MinGrouping, 1) {
+		b.WriteString(integer)
+		return
+	}
+	if secondary <= 0 {
+		secondary = primary
+	}
+	head := integer[:len(integer)-primary]
+	lead := len(head) % secondary
+	if lead == 0 {
+		lead = secondary
+	}
+	b.WriteString(head[:lead])
+	for i := lead; i < len(head); i += secondary {
+		b.WriteString(s.Group)
+		b.WriteString(head[i : i+secondary])
+	}
+	b.WriteString(s.Group)
+	b.WriteString(integer[len(integer)-primary:])
+}
@@ -0,0 +1,117 @@
+package icu
+
+import "math"
+
+// CLDR plural categories.
+const (
+	pluralZero  = "zero"
+	pluralOne   = "one"
+	pluralTwo   = "two"
+	pluralFew   = "few"
+	pluralMany  = "many"
+	pluralOther = "other"
+)
+
+// PluralCategory returns the cardinal plural category of n in language
+// (a lowercase ISO 639 code). Rules follow CLDR for whole numbers in the
+// most widely used languages, and languages without a rule here use English
+// rules. Numbers with a fraction are "other", except below 2 in languages
+// that, like French, treat 0 and 1 as singular.
+func PluralCategory(language string, n float64) string {
+	if n != math.Trunc(n) {
+		if pluralRules[language] == nil {
+			return pluralOther
+		}
+		if frenchStyle[language] && n >= 0 && n < 2 {
+			return pluralOne
+		}
+		return pluralOther
+	}
+	i := int64(math.Abs(n))
+	if rule := pluralRules[language]; rule != nil {
+		return rule(i)
+	}
+	if i == 1 {
+		return pluralOne
+	}
+	return pluralOther
+}
+
+// frenchStyle languages treat 0 and 1 as singular.
+var frenchStyle = map[string]bool{"fr": true, "pt": true, "hi": true, "fa": true, "bn": true, "hy": true}
+
+var pluralRules = map[string]func(n int64) string{}
+
+func init() {
+	for _, lang := range []string{"ja", "zh", "ko", "vi", "th", "id", "ms", "lo", "my", "km"} {
+		pluralRules[lang] = func(int64) string { return pluralOther }
+	}
+	for lang := range frenchStyle {
+		pluralRules[lang] = func(n int64) string {
+			if n <= 1 {
+				return pluralOne
+			}
+			return pluralOther
+		}
+	}
+	for _, lang := range []string{"ru", "uk", "be"} {
+		pluralRules[lang] = func(n int64) string {
+			switch {
+			case n%10 == 1 && n%100 != 11:
+				return pluralOne
+			case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+				return pluralFew
+			default:
+				return pluralMany
+			}
+		}
+	}
+	pluralRules["pl"] = func(n int64) string {
+		switch {
+		case n == 1:
+			return pluralOne
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return pluralFew
+		default:
+			return pluralMany
+		}
+	}
+	for _, lang := range []string{"cs", "sk"} {
+		pluralRules[lang] = func(n int64) string {
+			switch {
+			case n == 1:
+				return pluralOne
+			case n >= 2 && n <= 4:
+				return pluralFew
+			default:
+				return pluralOther
+			}
+		}
+	}
+	pluralRules["he"] = func(n int64) string {
+		switch n {
+		case 1:
+			return pluralOne
+		case 2:
+			return pluralTwo
+		default:
+			return pluralOther
+		}
+	}
+	pluralRules["ar"] = func(n int64) string {
+		switch {
+		case n == 0:
+			return pluralZero
+		case n == 1:
+			return pluralOne
+		case n == 2:
+			return pluralTwo
+		case n%100 >= 3 && n%100 <= 10:
+			return pluralFew
+		case n%100 >= 11:
+			return pluralMany
+		default:
+			return pluralOther
+		}
+	}
+}
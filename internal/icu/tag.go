@@ -0,0 +1,60 @@
+package icu
+
+import "strings"
+
+// ParseTag splits a BCP 47 language tag such as "en-US", "zh-Hant-TW", or
+// "pt_BR" into its normalized language, script, and region. Underscores are
+// accepted as separators, and subtags after the region (variants and
+// extensions) are ignored. An empty or malformed language yields empty
+// results.
+func ParseTag(tag string) (language, script, region string) {
+	parts := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	if len(parts) == 0 || !isAlpha(parts[0]) || len(parts[0]) < 2 || len(parts[0]) > 8 {
+		return "", "", ""
+	}
+	language = strings.ToLower(parts[0])
+	for _, part := range parts[1:] {
+		switch {
+		case script == "" && region == "" && len(part) == 4 && isAlpha(part):
+			script = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		case region == "" && (len(part) == 2 && isAlpha(part) || len(part) == 3 && isDigits(part)):
+			region = strings.ToUpper(part)
+		default:
+			return language, script, region
+		}
+	}
+	return language, script, region
+}
+
+// FormatTag joins a language, script, and region into a BCP 47 tag.
+func FormatTag(language, script, region string) string {
+	if language == "" {
+		return ""
+	}
+	tag := language
+	if script != "" {
+		tag += "-" + script
+	}
+	if region != "" {
+		tag += "-" + region
+	}
+	return tag
+}
+
+func isAlpha(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,15 @@
+package accessibility
+
+// Politeness indicates how urgently a screen reader should speak an
+// announcement.
+type Politeness int
+
+const (
+	// PolitenessPolite queues the announcement after current speech.
+	// Use it for status updates such as "3 items loaded".
+	PolitenessPolite Politeness = iota
+
+	// PolitenessAssertive interrupts current speech. Reserve it for errors
+	// and other time-critical information.
+	PolitenessAssertive
+)
@@ -5,6 +5,7 @@ package accessibility
 import (
 	"sync"
 
+	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
 	"github.com/go-drift/drift/pkg/platform"
@@ -20,8 +21,19 @@ type Service struct {
 	initialized bool
 	deviceScale float64
 	mu          sync.RWMutex
+
+	// pendingFocus resolves the render object of a focus request that is
+	// waiting for its semantics node to reach the platform.
+	pendingFocus func() layout.RenderObject
 }
 
+// active is the most recently initialized service, used by the package-level
+// [RequestFocus].
+var (
+	activeMu sync.RWMutex
+	active   *Service
+)
+
 // NewService creates a new accessibility service.
 func NewService() *Service {
 	return &Service{
@@ -40,6 +52,10 @@ func (s *Service) Initialize() {
 	}
 	s.initialized = true
 
+	activeMu.Lock()
+	active = s
+	activeMu.Unlock()
+
 	// Create semantics owner
 	s.owner = semantics.NewSemanticsOwner()
 
@@ -139,6 +155,7 @@ func (s *Service) fullRebuild(rootRender layout.RenderObject) {
 	}
 
 	s.lastRoot = syntheticRoot
+	s.focusPending()
 }
 
 // incrementalUpdate rebuilds only the dirty portions of the semantics tree.
@@ -292,6 +309,104 @@ func (s *Service) collectLabels(renderObj layout.RenderObject) []string {
 	return labels
 }
 
+// Announce asks the screen reader to speak message, for example to report the
+// result of an asynchronous operation that has no visible focus change.
+// Announcements are ignored by the platform when no screen reader is running.
+func Announce(message string, politeness Politeness) error {
+	return platform.Accessibility.Announce(message, platform.AnnouncePoliteness(politeness))
+}
+
+// RequestFocus moves screen reader focus to the semantics node of the widget
+// at ctx, or to the first node below it. Use it after navigation to start
+// reading at a page heading rather than the top of the screen:
+//
+//	func (s *pageTitleState) InitState() {
+//	    accessibility.RequestFocus(s.Element())
+//	}
+//
+// The node is resolved at the next semantics update, so the widget may be
+// requested before it is laid out, as for a page that was just pushed. A later
+// request replaces an earlier one that has not been applied. The request is
+// ignored when accessibility is disabled. Must be called on the UI thread.
+func RequestFocus(ctx core.BuildContext) error {
+	if ctx == nil {
+		return nil
+	}
+	activeMu.RLock()
+	s := active
+	activeMu.RUnlock()
+	if s == nil {
+		return nil
+	}
+	return s.requestFocus(func() layout.RenderObject { return renderObjectOf(ctx) })
+}
+
+// renderObjectOf returns the render object for ctx, or nil if it has none yet.
+func renderObjectOf(ctx core.BuildContext) layout.RenderObject {
+	if element, ok := ctx.(interface{ RenderObject() layout.RenderObject }); ok {
+		return element.RenderObject()
+	}
+	return nil
+}
+
+// RequestFocus moves screen reader focus to the semantics node of target, or
+// of its first descendant that has one. See the package-level [RequestFocus].
+func (s *Service) RequestFocus(target layout.RenderObject) error {
+	return s.requestFocus(func() layout.RenderObject { return target })
+}
+
+func (s *Service) requestFocus(resolve func() layout.RenderObject) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.initialized || s.binding == nil || !s.binding.IsEnabled() {
+		return nil
+	}
+	s.pendingFocus = resolve
+	return s.focusPending()
+}
+
+// focusPending sends the pending focus request once its node is in the tree
+// the platform knows about. Callers must hold s.mu.
+func (s *Service) focusPending() error {
+	if s.pendingFocus == nil || s.lastRoot == nil {
+		return nil
+	}
+	target := s.pendingFocus()
+	if target == nil {
+		return nil
+	}
+	id, ok := s.findNodeID(target)
+	if !ok {
+		return nil
+	}
+	s.pendingFocus = nil
+	return platform.Accessibility.SetAccessibilityFocus(id)
+}
+
+// findNodeID returns the ID of the first semantics node at or below renderObj
+// in the current tree.
+func (s *Service) findNodeID(renderObj layout.RenderObject) (int64, bool) {
+	if id, ok := s.owner.LookupStableID(renderObj); ok && s.owner.FindNodeByID(id) != nil {
+		return id, true
+	}
+	var (
+		found int64
+		ok    bool
+	)
+	visit := func(child layout.RenderObject) {
+		if !ok {
+			found, ok = s.findNodeID(child)
+		}
+	}
+	if semVisitor, isSem := renderObj.(layout.SemanticsChildVisitor); isSem {
+		semVisitor.VisitChildrenForSemantics(visit)
+	} else if visitor, isVisitor := renderObj.(layout.ChildVisitor); isVisitor {
+		visitor.VisitChildren(visit)
+	}
+	return found, ok
+}
+
 // Owner returns the semantics owner.
 func (s *Service) Owner() *semantics.SemanticsOwner {
 	s.mu.RLock()
@@ -3,6 +3,7 @@
 package accessibility
 
 import (
+	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/layout"
 	"github.com/go-drift/drift/pkg/semantics"
 )
@@ -32,6 +33,21 @@ func (s *Service) SetEnabled(enabled bool) {}
 // FlushSemantics is a no-op on non-mobile platforms.
 func (s *Service) FlushSemantics(rootRender layout.RenderObject) {}
 
+// Announce is a no-op on non-mobile platforms.
+func Announce(message string, politeness Politeness) error {
+	return nil
+}
+
+// RequestFocus is a no-op on non-mobile platforms.
+func RequestFocus(ctx core.BuildContext) error {
+	return nil
+}
+
+// RequestFocus is a no-op on non-mobile platforms.
+func (s *Service) RequestFocus(target layout.RenderObject) error {
+	return nil
+}
+
 // Owner returns nil on non-mobile platforms.
 func (s *Service) Owner() *semantics.SemanticsOwner {
 	return nil
@@ -1,6 +1,9 @@
 package animation
 
-import "time"
+import (
+	"sync/atomic"
+	"time"
+)
 
 // Clock provides time for animations. The default implementation uses
 // system time. Tests can inject a fake clock via SetClock to control
@@ -27,3 +30,37 @@ func SetClock(c Clock) Clock {
 
 // Now returns the current time from the active clock.
 func Now() time.Time { return clock.Now() }
+
+// DefaultFrameInterval is the time between frames assumed until the engine
+// reports the display's refresh rate: one frame at 60Hz.
+const DefaultFrameInterval = time.Second / 60
+
+// frameInterval holds the display's vsync interval in nanoseconds. It is
+// written by the engine when the embedder reports its display, and read by
+// animation code on the UI thread.
+var frameInterval atomic.Int64
+
+func init() {
+	frameInterval.Store(int64(DefaultFrameInterval))
+}
+
+// SetFrameInterval sets the time between frames, as reported by the
+// display the app is drawn on: about 8.3ms at 120Hz. Values <= 0 reset it
+// to [DefaultFrameInterval]. Returns the previous interval so callers can
+// restore it.
+//
+// The engine calls it when the display's refresh rate becomes known or
+// changes. Tickers still receive the real elapsed time; the interval is for
+// code that works in frames, such as simulations that pick a step size or
+// jank detection that compares frame times against a budget.
+func SetFrameInterval(interval time.Duration) time.Duration {
+	if interval <= 0 {
+		interval = DefaultFrameInterval
+	}
+	return time.Duration(frameInterval.Swap(int64(interval)))
+}
+
+// FrameInterval returns the time between frames set by [SetFrameInterval].
+func FrameInterval() time.Duration {
+	return time.Duration(frameInterval.Load())
+}
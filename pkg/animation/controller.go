@@ -3,6 +3,8 @@ package animation
 import (
 	"fmt"
 	"time"
+
+	"github.com/go-drift/drift/pkg/errors"
 )
 
 // AnimationStatus represents the current state of an animation.
@@ -72,19 +74,28 @@ type AnimationController struct {
 	// UpperBound is the maximum value (default 1.0).
 	UpperBound float64
 
+	// Behavior controls whether the controller still animates while
+	// animations are disabled (default AnimationBehaviorNormal, which jumps
+	// to the target). See [SetAnimationsDisabled].
+	Behavior AnimationBehavior
+
 	status          AnimationStatus
 	ticker          *Ticker
 	target          float64
 	startValue      float64
 	scaledDuration  time.Duration
+	spring          *SpringSimulation
+	velocity        float64
+	lastElapsed     time.Duration
 	listeners       map[int]func()
 	statusListeners map[int]func(AnimationStatus)
 	nextListenerID  int
+	untrack         func()
 }
 
 // NewAnimationController creates an animation controller with the given duration.
 func NewAnimationController(duration time.Duration) *AnimationController {
-	return &AnimationController{
+	c := &AnimationController{
 		Value:           0,
 		Duration:        duration,
 		LowerBound:      0,
@@ -94,6 +105,8 @@ func NewAnimationController(duration time.Duration) *AnimationController {
 		listeners:       make(map[int]func()),
 		statusListeners: make(map[int]func(AnimationStatus)),
 	}
+	c.untrack = errors.TrackDisposable("*animation.AnimationController", nil)
+	return c
 }
 
 // Forward animates from the current value to the upper bound (1.0).
@@ -116,12 +129,15 @@ func (c *AnimationController) AnimateTo(target float64) {
 }
 
 func (c *AnimationController) animateTo(target float64, direction AnimationStatus) {
+	errors.CheckUIThread("*animation.AnimationController", "animateTo")
 	if c.ticker != nil {
 		c.ticker.Stop()
 	}
 
 	c.target = target
 	c.startValue = c.Value
+	c.spring = nil
+	c.lastElapsed = 0
 
 	// Scale duration proportionally to the fraction of the full range being
 	// animated so that resuming from a mid-point maintains consistent speed.
@@ -136,9 +152,13 @@ func (c *AnimationController) animateTo(target float64, direction AnimationStatu
 		c.scaledDuration = c.Duration
 	}
 
-	if c.scaledDuration <= 0 {
+	if c.scaledDuration <= 0 || c.skipsAnimation() {
+		prev := c.Value
 		c.Value = target
 		c.setStatus(direction)
+		if prev != target {
+			c.notifyListeners()
+		}
 		c.stop()
 		return
 	}
@@ -151,7 +171,105 @@ func (c *AnimationController) animateTo(target float64, direction AnimationStatu
 	c.ticker.Start()
 }
 
+// AnimateWithSpring animates toward target using a spring simulation that
+// starts from the current value and the given velocity (in value units per
+// second). Unlike AnimateTo, the motion has no fixed duration and keeps the
+// momentum of whatever was driving the value before, so it can take over
+// mid-flight from another animation or from a drag without a visible jump
+// in speed. Values are clamped to the bounds while the spring settles.
+func (c *AnimationController) AnimateWithSpring(spring SpringDescription, target, velocity float64) {
+	errors.CheckUIThread("*animation.AnimationController", "AnimateWithSpring")
+	if c.ticker != nil {
+		c.ticker.Stop()
+	}
+
+	c.target = target
+	c.lastElapsed = 0
+	c.spring = NewSpringSimulation(spring, c.Value, velocity, target)
+	// Settle within a thousandth of the range rather than the pixel-scale
+	// defaults.
+	if fullRange := c.UpperBound - c.LowerBound; fullRange > 0 {
+		c.spring.SetTolerance(fullRange*1e-3, fullRange*1e-2)
+	}
+	if c.spring.IsDone() || c.skipsAnimation() {
+		c.Value = target
+		c.spring = nil
+		c.notifyListeners()
+		c.stop()
+		return
+	}
+
+	if target >= c.Value {
+		c.setStatus(AnimationForward)
+	} else {
+		c.setStatus(AnimationReverse)
+	}
+
+	c.ticker = NewTicker(func(elapsed time.Duration) {
+		c.tick(elapsed)
+	})
+	c.ticker.Start()
+}
+
+// skipsAnimation reports whether animations are disabled for this controller.
+func (c *AnimationController) skipsAnimation() bool {
+	return animationsDisabled && c.Behavior == AnimationBehaviorNormal
+}
+
+// SetValue stops any running animation and jumps to value, clamped to the
+// bounds. It is meant for driving the controller directly, such as from a
+// drag: between the bounds the status follows the direction of the change,
+// and at a bound it becomes dismissed or completed.
+func (c *AnimationController) SetValue(value float64) {
+	errors.CheckUIThread("*animation.AnimationController", "SetValue")
+	c.Stop()
+	value = max(c.LowerBound, min(value, c.UpperBound))
+	prev := c.Value
+	c.Value = value
+	switch {
+	case value <= c.LowerBound:
+		c.setStatus(AnimationDismissed)
+	case value >= c.UpperBound:
+		c.setStatus(AnimationCompleted)
+	case value < prev:
+		c.setStatus(AnimationReverse)
+	case value > prev:
+		c.setStatus(AnimationForward)
+	}
+	if value != prev {
+		c.notifyListeners()
+	}
+}
+
+// Velocity returns the rate of change of Value in units per second, or 0
+// when no animation is running.
+func (c *AnimationController) Velocity() float64 {
+	return c.velocity
+}
+
 func (c *AnimationController) tick(elapsed time.Duration) {
+	dt := elapsed - c.lastElapsed
+	c.lastElapsed = elapsed
+	prev := c.Value
+	defer func() {
+		if c.ticker != nil && dt > 0 {
+			c.velocity = (c.Value - prev) / dt.Seconds()
+		}
+	}()
+
+	if c.spring != nil {
+		done := c.spring.Step(dt.Seconds())
+		c.Value = max(c.LowerBound, min(c.spring.Position(), c.UpperBound))
+		if done {
+			c.Value = c.target
+		}
+		c.notifyListeners()
+		if done {
+			c.stop()
+		}
+		return
+	}
+
 	if c.Duration <= 0 {
 		c.Value = c.target
 		c.stop()
@@ -178,10 +296,7 @@ func (c *AnimationController) tick(elapsed time.Duration) {
 }
 
 func (c *AnimationController) stop() {
-	if c.ticker != nil {
-		c.ticker.Stop()
-		c.ticker = nil
-	}
+	c.Stop()
 
 	// Update status based on final value
 	if c.Value <= c.LowerBound {
@@ -193,6 +308,7 @@ func (c *AnimationController) stop() {
 
 // Reset immediately sets the value to the lower bound.
 func (c *AnimationController) Reset() {
+	errors.CheckUIThread("*animation.AnimationController", "Reset")
 	c.Stop()
 	c.Value = c.LowerBound
 	c.setStatus(AnimationDismissed)
@@ -205,6 +321,8 @@ func (c *AnimationController) Stop() {
 		c.ticker.Stop()
 		c.ticker = nil
 	}
+	c.spring = nil
+	c.velocity = 0
 }
 
 // Status returns the current animation status.
@@ -270,4 +388,8 @@ func (c *AnimationController) Dispose() {
 	c.Stop()
 	c.listeners = nil
 	c.statusListeners = nil
+	if c.untrack != nil {
+		c.untrack()
+		c.untrack = nil
+	}
 }
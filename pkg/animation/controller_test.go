@@ -1,6 +1,7 @@
 package animation
 
 import (
+	"math"
 	"testing"
 	"time"
 )
@@ -194,3 +195,134 @@ type disposable interface {
 
 var _ listenable = &AnimationController{}
 var _ disposable = &AnimationController{}
+
+type stepClock struct{ now time.Time }
+
+func (c *stepClock) Now() time.Time { return c.now }
+
+// useStepClock installs a fake clock and returns a function that advances it
+// by one 16ms frame and steps tickers.
+func useStepClock(t *testing.T) func() {
+	t.Helper()
+	clk := &stepClock{now: time.Unix(0, 0)}
+	prev := SetClock(clk)
+	t.Cleanup(func() { SetClock(prev) })
+	return func() {
+		clk.now = clk.now.Add(16 * time.Millisecond)
+		StepTickers()
+	}
+}
+
+func TestAnimationController_SetValue(t *testing.T) {
+	c := NewAnimationController(time.Second)
+	defer c.Dispose()
+	c.Value = 1
+	c.setStatus(AnimationCompleted)
+
+	notified := 0
+	c.AddListener(func() { notified++ })
+
+	c.SetValue(0.6)
+	if c.Value != 0.6 || c.Status() != AnimationReverse {
+		t.Errorf("after drag back: value %v status %v", c.Value, c.Status())
+	}
+	c.SetValue(0.7)
+	if c.Status() != AnimationForward {
+		t.Errorf("after drag forward: status %v", c.Status())
+	}
+	c.SetValue(-1)
+	if c.Value != 0 || c.Status() != AnimationDismissed {
+		t.Errorf("expected clamp to dismissed, got value %v status %v", c.Value, c.Status())
+	}
+	if notified != 3 {
+		t.Errorf("expected 3 notifications, got %d", notified)
+	}
+}
+
+func TestAnimationController_AnimateWithSpringContinuesMidFlight(t *testing.T) {
+	frame := useStepClock(t)
+	c := NewAnimationController(time.Second)
+	defer c.Dispose()
+
+	c.Forward()
+	for range 10 {
+		frame()
+	}
+	value, velocity := c.Value, c.Velocity()
+	if velocity <= 0 {
+		t.Fatalf("expected forward velocity mid-flight, got %v", velocity)
+	}
+
+	c.AnimateWithSpring(IOSSpring(), 0, velocity)
+	if c.Status() != AnimationReverse {
+		t.Errorf("expected reverse status, got %v", c.Status())
+	}
+	frame()
+	if diff := math.Abs(c.Value - value); diff > 0.05 {
+		t.Errorf("expected to continue from %v, got %v", value, c.Value)
+	}
+
+	for i := 0; i < 120 && c.IsAnimating(); i++ {
+		frame()
+	}
+	if c.Status() != AnimationDismissed || c.Value != 0 || c.Velocity() != 0 {
+		t.Errorf("expected spring to settle dismissed, got value %v status %v velocity %v",
+			c.Value, c.Status(), c.Velocity())
+	}
+}
+
+func TestAnimationController_AnimationsDisabled(t *testing.T) {
+	prev := SetAnimationsDisabled(true)
+	t.Cleanup(func() { SetAnimationsDisabled(prev) })
+
+	c := NewAnimationController(time.Second)
+	defer c.Dispose()
+	notified := 0
+	c.AddListener(func() { notified++ })
+
+	c.Forward()
+	if c.Value != 1 || c.Status() != AnimationCompleted || HasActiveTickers() {
+		t.Errorf("Forward: value %v status %v, want an immediate jump to completed", c.Value, c.Status())
+	}
+	c.AnimateWithSpring(IOSSpring(), 0, 2)
+	if c.Value != 0 || c.Status() != AnimationDismissed {
+		t.Errorf("AnimateWithSpring: value %v status %v, want an immediate jump to dismissed", c.Value, c.Status())
+	}
+	if notified != 2 {
+		t.Errorf("expected 2 notifications, got %d", notified)
+	}
+
+	preserved := NewAnimationController(time.Second)
+	defer preserved.Dispose()
+	preserved.Behavior = AnimationBehaviorPreserve
+	preserved.Forward()
+	if !preserved.IsAnimating() {
+		t.Error("a preserving controller should keep animating")
+	}
+}
+
+func TestTimeDilation_SlowsAnimations(t *testing.T) {
+	frame := useStepClock(t)
+	prev := SetTimeDilation(2)
+	t.Cleanup(func() { SetTimeDilation(prev) })
+
+	c := NewAnimationController(160 * time.Millisecond)
+	defer c.Dispose()
+	c.Forward()
+	for range 5 {
+		frame()
+	}
+	if math.Abs(c.Value-0.25) > 1e-9 {
+		t.Errorf("after 80ms at 2x dilation: value %v, want 0.25", c.Value)
+	}
+	for range 15 {
+		frame()
+	}
+	if !c.IsCompleted() {
+		t.Errorf("expected completion after 320ms, got status %v", c.Status())
+	}
+
+	if SetTimeDilation(0); TimeDilation() != 1 {
+		t.Errorf("non-positive dilation should reset to 1, got %v", TimeDilation())
+	}
+}
@@ -0,0 +1,73 @@
+package animation
+
+import "time"
+
+// AnimationBehavior controls how an [AnimationController] responds to
+// [SetAnimationsDisabled].
+type AnimationBehavior int
+
+const (
+	// AnimationBehaviorNormal jumps straight to the target value while
+	// animations are disabled. Use it for transitions and other decorative
+	// motion.
+	AnimationBehaviorNormal AnimationBehavior = iota
+
+	// AnimationBehaviorPreserve keeps animating while animations are
+	// disabled. Use it for motion that conveys information, such as progress
+	// indicators, and for controllers that restart themselves on completion.
+	AnimationBehaviorPreserve
+)
+
+var (
+	animationsDisabled bool
+	timeDilation       = 1.0
+)
+
+// SetAnimationsDisabled turns animations off or on for every
+// [AnimationController] with [AnimationBehaviorNormal]. While disabled,
+// Forward, Reverse, AnimateTo, and AnimateWithSpring complete immediately,
+// so implicit animation widgets and page transitions snap to their end
+// state. Returns the previous setting so callers can restore it.
+//
+// The engine calls it with the platform's reduce motion setting whenever
+// that setting changes. Tests can call it to skip animations without
+// pumping frames.
+func SetAnimationsDisabled(disabled bool) bool {
+	prev := animationsDisabled
+	animationsDisabled = disabled
+	return prev
+}
+
+// AnimationsDisabled reports whether animations are disabled.
+func AnimationsDisabled() bool {
+	return animationsDisabled
+}
+
+// SetTimeDilation slows down (factor > 1) or speeds up (factor < 1) all
+// tickers, and with them every animation, by dividing elapsed time by
+// factor. Values <= 0 reset it to 1. Returns the previous factor so callers
+// can restore it.
+//
+// Changing the factor while animations run makes them jump, since elapsed
+// time is rescaled from each ticker's start.
+func SetTimeDilation(factor float64) float64 {
+	prev := timeDilation
+	if factor <= 0 {
+		factor = 1
+	}
+	timeDilation = factor
+	return prev
+}
+
+// TimeDilation returns the current time dilation factor.
+func TimeDilation() float64 {
+	return timeDilation
+}
+
+// dilate scales a wall-clock duration by the time dilation factor.
+func dilate(d time.Duration) time.Duration {
+	if timeDilation == 1 {
+		return d
+	}
+	return time.Duration(float64(d) / timeDilation)
+}
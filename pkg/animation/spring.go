@@ -55,6 +55,9 @@ type SpringSimulation struct {
 	velocity float64
 	omega    float64 // Natural frequency
 	damping  float64 // Damping coefficient
+
+	distanceTolerance float64
+	velocityTolerance float64
 }
 
 // NewSpringSimulation creates a spring simulation from current position/velocity to target.
@@ -68,9 +71,21 @@ func NewSpringSimulation(spring SpringDescription, position, velocity, target fl
 		velocity: velocity,
 		omega:    omega,
 		damping:  damping,
+
+		distanceTolerance: 0.5,
+		velocityTolerance: 5,
 	}
 }
 
+// SetTolerance sets how close to the target (distance) and how slow
+// (velocity, per second) the spring must be to count as settled. The
+// defaults of 0.5 and 5 suit pixel positions; use smaller values for
+// normalized ranges such as an [AnimationController] value.
+func (s *SpringSimulation) SetTolerance(distance, velocity float64) {
+	s.distanceTolerance = distance
+	s.velocityTolerance = velocity
+}
+
 // Step advances the simulation by dt seconds.
 // Returns true if the simulation has settled (is done).
 func (s *SpringSimulation) Step(dt float64) bool {
@@ -109,7 +124,7 @@ func (s *SpringSimulation) stepOnce(dt float64) bool {
 	// Check if settled (close to target with low velocity)
 	// Use updated displacement to avoid oscillation when velocity is high near target
 	newDisplacement := s.position - s.target
-	if math.Abs(newDisplacement) < 0.5 && math.Abs(s.velocity) < 5 {
+	if math.Abs(newDisplacement) < s.distanceTolerance && math.Abs(s.velocity) < s.velocityTolerance {
 		s.position = s.target
 		s.velocity = 0
 		return true
@@ -136,5 +151,5 @@ func (s *SpringSimulation) Target() float64 {
 // IsDone returns true if the simulation has settled at the target.
 func (s *SpringSimulation) IsDone() bool {
 	displacement := s.position - s.target
-	return math.Abs(displacement) < 0.5 && math.Abs(s.velocity) < 5
+	return math.Abs(displacement) < s.distanceTolerance && math.Abs(s.velocity) < s.velocityTolerance
 }
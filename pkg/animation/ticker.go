@@ -46,6 +46,8 @@ package animation
 import (
 	"sync"
 	"time"
+
+	"github.com/go-drift/drift/pkg/errors"
 )
 
 var (
@@ -59,8 +61,9 @@ var (
 // Ticker is the low-level timing primitive used by [AnimationController].
 // Most code should use AnimationController directly rather than Ticker.
 //
-// The callback receives the elapsed time since Start was called. Tickers are
-// driven by the engine's frame loop via [StepTickers].
+// The callback receives the elapsed time since Start was called, scaled by
+// [SetTimeDilation]. Tickers are driven by the engine's frame loop via
+// [StepTickers].
 type Ticker struct {
 	callback func(elapsed time.Duration)
 	isActive bool
@@ -69,9 +72,11 @@ type Ticker struct {
 
 // NewTicker creates a new ticker with the given callback.
 func NewTicker(callback func(elapsed time.Duration)) *Ticker {
-	return &Ticker{
+	t := &Ticker{
 		callback: callback,
 	}
+	errors.TrackDisposable("*animation.Ticker", t.IsActive)
+	return t
 }
 
 // Start activates the ticker.
@@ -107,7 +112,7 @@ func (t *Ticker) Elapsed() time.Duration {
 	if !t.isActive {
 		return 0
 	}
-	return Now().Sub(t.start)
+	return dilate(Now().Sub(t.start))
 }
 
 // TickerProvider creates tickers.
@@ -132,12 +137,19 @@ func StepTickers() {
 
 	for _, ticker := range tickers {
 		if ticker.isActive && ticker.callback != nil {
-			elapsed := Now().Sub(ticker.start)
+			elapsed := dilate(Now().Sub(ticker.start))
 			ticker.callback(elapsed)
 		}
 	}
 }
 
+// ActiveTickerCount returns the number of active tickers.
+func ActiveTickerCount() int {
+	tickerMu.Lock()
+	defer tickerMu.Unlock()
+	return len(activeTickers)
+}
+
 // HasActiveTickers returns true if any tickers are active.
 func HasActiveTickers() bool {
 	tickerMu.Lock()
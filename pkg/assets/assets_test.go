@@ -0,0 +1,147 @@
+package assets
+
+import (
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-drift/drift/pkg/intl"
+)
+
+func testFS() fstest.MapFS {
+	files := fstest.MapFS{}
+	for _, name := range []string{
+		"images/logo.png",
+		"images/logo@2x.png",
+		"images/logo@3x.png",
+		"images/logo@dark.png",
+		"images/logo@fr.png",
+		"images/logo@fr@2x.png",
+		"images/logo@pt-BR.png",
+		"data/terms.txt",
+		"data/terms@fr.txt",
+		"misc/user@example.txt",
+	} {
+		files[name] = &fstest.MapFile{Data: []byte(name)}
+	}
+	return files
+}
+
+func TestScanManifest_ParsesQualifiers(t *testing.T) {
+	manifest, err := ScanManifest(testFS())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(manifest["images/logo.png"]); got != 7 {
+		t.Fatalf("expected 7 logo variants, got %d: %v", got, manifest["images/logo.png"])
+	}
+	if _, ok := manifest["misc/user@example.txt"]; !ok {
+		t.Error("expected a file with an unrecognized qualifier to be its own key")
+	}
+
+	want := map[string]Variant{
+		"images/logo@fr@2x.png": {Path: "images/logo@fr@2x.png", Scale: 2, Locale: "fr"},
+		"images/logo@dark.png":  {Path: "images/logo@dark.png", Dark: true},
+		"images/logo@pt-BR.png": {Path: "images/logo@pt-BR.png", Locale: "pt-BR"},
+	}
+	for _, v := range manifest["images/logo.png"] {
+		if w, ok := want[v.Path]; ok && v != w {
+			t.Errorf("variant %s: got %+v, want %+v", v.Path, v, w)
+		}
+	}
+}
+
+func TestBundle_ResolvesVariants(t *testing.T) {
+	bundle, err := NewBundle(testFS())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		config Config
+		want   string
+	}{
+		{"default", Config{}, "images/logo.png"},
+		{"exact density", Config{Scale: 2}, "images/logo@2x.png"},
+		{"next density up", Config{Scale: 2.5}, "images/logo@3x.png"},
+		{"largest density", Config{Scale: 4}, "images/logo@3x.png"},
+		{"dark", Config{Dark: true}, "images/logo@dark.png"},
+		{"dark without a dense variant", Config{Dark: true, Scale: 3}, "images/logo@dark.png"},
+		{"locale by language", Config{Locale: intl.ParseLocale("fr-CA")}, "images/logo@fr.png"},
+		{"locale and density", Config{Locale: intl.ParseLocale("fr"), Scale: 3}, "images/logo@fr@2x.png"},
+		{"locale with region", Config{Locale: intl.ParseLocale("pt-BR")}, "images/logo@pt-BR.png"},
+		{"other region", Config{Locale: intl.ParseLocale("pt-PT")}, "images/logo@pt-BR.png"},
+		{"unmatched locale", Config{Locale: intl.ParseLocale("de"), Scale: 2}, "images/logo@2x.png"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := bundle.With(tt.config).Resolve("images/logo.png")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Path != tt.want {
+				t.Errorf("got %s, want %s", got.Path, tt.want)
+			}
+		})
+	}
+}
+
+func TestBundle_LoadAndCache(t *testing.T) {
+	files := testFS()
+	bundle, err := NewBundle(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	french := bundle.With(Config{Locale: intl.ParseLocale("fr")})
+	if french != bundle.With(Config{Locale: intl.ParseLocale("fr")}) {
+		t.Error("expected equal configs to share a bundle")
+	}
+
+	got, err := french.LoadString("data/terms.txt")
+	if err != nil || got != "data/terms@fr.txt" {
+		t.Fatalf("got %q, %v", got, err)
+	}
+
+	// Cached contents are served even after the file changes.
+	files["data/terms@fr.txt"] = &fstest.MapFile{Data: []byte("changed")}
+	if got, _ := french.LoadString("data/terms.txt"); got != "data/terms@fr.txt" {
+		t.Errorf("expected cached contents, got %q", got)
+	}
+	bundle.SetCacheLimit(0)
+	if got, _ := french.LoadString("data/terms.txt"); got != "changed" {
+		t.Errorf("expected contents to be read again without a cache, got %q", got)
+	}
+
+	if _, err := bundle.Load("missing.png"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected fs.ErrNotExist for a missing key, got %v", err)
+	}
+}
+
+func TestNewBundle_ReadsManifest(t *testing.T) {
+	files := fstest.MapFS{
+		ManifestName: {Data: []byte(`{
+			"logo": [
+				{"path": "low/logo.png"},
+				{"path": "high/logo.png", "scale": 2}
+			]
+		}`)},
+		"low/logo.png":  {Data: []byte("low")},
+		"high/logo.png": {Data: []byte("high")},
+	}
+	bundle, err := NewBundle(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys := bundle.Keys(); len(keys) != 1 || keys[0] != "logo" {
+		t.Fatalf("expected only the manifest's key, got %v", keys)
+	}
+	if got, _ := bundle.With(Config{Scale: 2}).LoadString("logo"); got != "high" {
+		t.Errorf("got %q, want high", got)
+	}
+
+	files[ManifestName] = &fstest.MapFile{Data: []byte(`{"logo": [{"scale": 2}]}`)}
+	if _, err := NewBundle(files); err == nil {
+		t.Error("expected an error for a variant without a path")
+	}
+}
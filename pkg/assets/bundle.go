@@ -0,0 +1,236 @@
+package assets
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"sync"
+
+	"github.com/go-drift/drift/pkg/intl"
+)
+
+// DefaultCacheLimit is the default memory budget, in bytes, of the file
+// contents a [Bundle] keeps after loading them.
+const DefaultCacheLimit = 4 << 20
+
+// Config describes the device an asset variant is picked for.
+type Config struct {
+	// Scale is the device pixel ratio. Zero means 1.
+	Scale float64
+	// Locale is the user's locale. The zero Locale prefers variants that
+	// suit any locale.
+	Locale intl.Locale
+	// Dark prefers dark-mode variants.
+	Dark bool
+}
+
+// scale returns the config's scale, treating zero as 1.
+func (c Config) scale() float64 {
+	if c.Scale > 0 {
+		return c.Scale
+	}
+	return 1
+}
+
+// Bundle loads assets by key from a file system, resolving each key to the
+// variant that suits its [Config]. Bundles made by [Bundle.With] share the
+// manifest and the cache of loaded files with the bundle they came from.
+//
+// Bundle implements [widgets.AssetBundle], so it can be passed to
+// widgets.DefaultAssetBundle or widgets.SetRootAssetBundle directly; [Scope]
+// does so with the config for the current device.
+//
+// Bundles are safe for concurrent use.
+type Bundle struct {
+	shared *bundleShared
+	config Config
+}
+
+// bundleShared is the state shared by a bundle and its configured copies.
+type bundleShared struct {
+	fsys     fs.FS
+	manifest Manifest
+	files    *fileCache
+
+	mu      sync.Mutex
+	configs map[Config]*Bundle
+}
+
+// NewBundle returns a bundle that reads assets from fsys, described by the
+// manifest at [ManifestName] if fsys has one, or by [ScanManifest]
+// otherwise. The bundle uses the zero Config; see [Bundle.With].
+func NewBundle(fsys fs.FS) (*Bundle, error) {
+	data, err := fs.ReadFile(fsys, ManifestName)
+	var manifest Manifest
+	switch {
+	case err == nil:
+		manifest, err = ParseManifest(data)
+	case errors.Is(err, fs.ErrNotExist):
+		manifest, err = ScanManifest(fsys)
+	default:
+		err = fmt.Errorf("assets: read manifest: %w", err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewBundleWithManifest(fsys, manifest), nil
+}
+
+// NewBundleWithManifest returns a bundle that reads assets from fsys as
+// described by manifest.
+func NewBundleWithManifest(fsys fs.FS, manifest Manifest) *Bundle {
+	shared := &bundleShared{
+		fsys:     fsys,
+		manifest: manifest,
+		files:    newFileCache(DefaultCacheLimit),
+		configs:  make(map[Config]*Bundle),
+	}
+	b := &Bundle{shared: shared}
+	shared.configs[Config{}] = b
+	return b
+}
+
+// With returns the bundle that resolves keys for config. It returns the
+// same *Bundle for equal configs, so widgets that cache decoded assets by
+// bundle keep hitting their cache.
+func (b *Bundle) With(config Config) *Bundle {
+	b.shared.mu.Lock()
+	defer b.shared.mu.Unlock()
+	if configured, ok := b.shared.configs[config]; ok {
+		return configured
+	}
+	configured := &Bundle{shared: b.shared, config: config}
+	b.shared.configs[config] = configured
+	return configured
+}
+
+// Config returns the config the bundle resolves keys for.
+func (b *Bundle) Config() Config {
+	return b.config
+}
+
+// Keys returns the asset keys in the manifest, sorted.
+func (b *Bundle) Keys() []string {
+	keys := make([]string, 0, len(b.shared.manifest))
+	for key := range b.shared.manifest {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Resolve returns the variant of key that suits the bundle's config. The
+// error wraps [fs.ErrNotExist] if the manifest has no such key.
+func (b *Bundle) Resolve(key string) (Variant, error) {
+	variant, ok := resolve(b.shared.manifest[key], b.config)
+	if !ok {
+		return Variant{}, fmt.Errorf("assets: %q: %w", key, fs.ErrNotExist)
+	}
+	return variant, nil
+}
+
+// Load returns the contents of the variant of key that suits the bundle's
+// config. Contents are cached and shared between callers, so they must
+// not be modified.
+func (b *Bundle) Load(key string) ([]byte, error) {
+	variant, err := b.Resolve(key)
+	if err != nil {
+		return nil, err
+	}
+	if data, ok := b.shared.files.get(variant.Path); ok {
+		return data, nil
+	}
+	data, err := fs.ReadFile(b.shared.fsys, variant.Path)
+	if err != nil {
+		return nil, fmt.Errorf("assets: %q: %w", key, err)
+	}
+	b.shared.files.put(variant.Path, data)
+	return data, nil
+}
+
+// LoadString returns the contents of the variant of key as a string.
+func (b *Bundle) LoadString(key string) (string, error) {
+	data, err := b.Load(key)
+	return string(data), err
+}
+
+// SetCacheLimit sets the memory budget of the files the bundle keeps,
+// shared with the bundles made by [Bundle.With], evicting the least
+// recently used files until the cache fits. Zero or less disables caching.
+func (b *Bundle) SetCacheLimit(bytes int64) {
+	b.shared.files.setLimit(bytes)
+}
+
+// fileCache is a memory-bounded LRU cache of file contents by path.
+type fileCache struct {
+	mu      sync.Mutex
+	limit   int64
+	bytes   int64
+	order   *list.List // of *fileEntry, most recently used first
+	entries map[string]*list.Element
+}
+
+type fileEntry struct {
+	path string
+	data []byte
+}
+
+func newFileCache(limit int64) *fileCache {
+	return &fileCache{
+		limit:   limit,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// get returns the cached contents of path and marks them recently used.
+func (c *fileCache) get(path string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[path]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*fileEntry).data, true
+}
+
+// put caches the contents of path, evicting old files to stay in budget.
+// Files larger than the whole budget are not cached.
+func (c *fileCache) put(path string, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if int64(len(data)) > c.limit {
+		return
+	}
+	if element, ok := c.entries[path]; ok {
+		c.remove(element)
+	}
+	c.entries[path] = c.order.PushFront(&fileEntry{path: path, data: data})
+	c.bytes += int64(len(data))
+	c.evict()
+}
+
+func (c *fileCache) setLimit(limit int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.limit = limit
+	c.evict()
+}
+
+// evict drops least recently used files until the cache fits its limit.
+// Caller must hold mu.
+func (c *fileCache) evict() {
+	for c.bytes > max(c.limit, 0) {
+		c.remove(c.order.Back())
+	}
+}
+
+// remove drops element from the cache. Caller must hold mu.
+func (c *fileCache) remove(element *list.Element) {
+	entry := c.order.Remove(element).(*fileEntry)
+	delete(c.entries, entry.path)
+	c.bytes -= int64(len(entry.data))
+}
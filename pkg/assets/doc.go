@@ -0,0 +1,28 @@
+// Package assets loads the files an app ships with, such as images, SVGs,
+// and data files, by logical key, picking the variant that suits the
+// device: a 2x or 3x image for high-density screens, a dark-mode version
+// when the theme is dark, or a translated version for the user's locale.
+//
+// A [Bundle] reads from any file system, usually an embedded one. Variants
+// are described by an AssetManifest.json at its root or, without one, by
+// qualifiers in file names:
+//
+//	images/logo.png        key "images/logo.png"
+//	images/logo@2x.png     2x density
+//	images/logo@3x.png     3x density
+//	images/logo@dark.png   dark mode
+//	images/logo@fr.png     French
+//	images/logo@fr@2x.png  French, 2x density
+//
+// Wrap the app in a [Scope] so [widgets.Image] and [widgets.SvgPicture]
+// load their Asset through the bundle with the variant for the current
+// device scale, locale, and theme brightness:
+//
+//	//go:embed assets
+//	var files embed.FS
+//
+//	sub, _ := fs.Sub(files, "assets")
+//	bundle, err := assets.NewBundle(sub)
+//	...
+//	assets.Scope{Bundle: bundle, Child: app}
+package assets
@@ -0,0 +1,196 @@
+package assets
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-drift/drift/pkg/intl"
+)
+
+// ManifestName is the name of the manifest [NewBundle] reads from the root
+// of the bundle's file system.
+const ManifestName = "AssetManifest.json"
+
+// Variant is one file that can be loaded for an asset key.
+type Variant struct {
+	// Path is the file's slash-separated path in the bundle's file system.
+	Path string `json:"path"`
+	// Scale is the device pixel ratio the file was made for. Zero means 1.
+	Scale float64 `json:"scale,omitempty"`
+	// Locale is the BCP 47 tag of the file's language, or empty for a file
+	// that suits any locale.
+	Locale string `json:"locale,omitempty"`
+	// Dark marks a file for dark themes.
+	Dark bool `json:"dark,omitempty"`
+}
+
+// scale returns the variant's scale, treating zero as 1.
+func (v Variant) scale() float64 {
+	if v.Scale > 0 {
+		return v.Scale
+	}
+	return 1
+}
+
+// Manifest maps asset keys to their variants. In JSON it is an object
+// keyed by asset key:
+//
+//	{
+//	  "images/logo.png": [
+//	    {"path": "images/logo.png"},
+//	    {"path": "images/hires/logo.png", "scale": 2},
+//	    {"path": "images/night/logo.png", "dark": true}
+//	  ]
+//	}
+type Manifest map[string][]Variant
+
+// ParseManifest decodes a JSON manifest. Variants without a path are
+// rejected.
+func ParseManifest(data []byte) (Manifest, error) {
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("assets: parse manifest: %w", err)
+	}
+	for key, variants := range manifest {
+		for _, v := range variants {
+			if v.Path == "" {
+				return nil, fmt.Errorf("assets: manifest entry %q has a variant without a path", key)
+			}
+		}
+	}
+	return manifest, nil
+}
+
+// ScanManifest builds a manifest from the files in fsys, reading variant
+// qualifiers from their names. Qualifiers follow the base name, each
+// introduced by "@", before the extension:
+//
+//   - "2x" or "1.5x" sets the density scale.
+//   - "dark" marks a dark-mode variant.
+//   - A BCP 47 tag with a two- or three-letter language, such as "fr" or
+//     "pt-BR", sets the locale.
+//
+// So "icons/back@ar@2x.png" is the 2x Arabic variant of "icons/back.png".
+// Files with an unrecognized qualifier are their own key. The manifest
+// file itself is skipped.
+func ScanManifest(fsys fs.FS) (Manifest, error) {
+	manifest := Manifest{}
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || name == ManifestName {
+			return nil
+		}
+		key, variant := parseVariant(name)
+		manifest[key] = append(manifest[key], variant)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("assets: scan: %w", err)
+	}
+	for _, variants := range manifest {
+		slices.SortFunc(variants, func(a, b Variant) int { return strings.Compare(a.Path, b.Path) })
+	}
+	return manifest, nil
+}
+
+// parseVariant splits a file path into its asset key and the variant its
+// name qualifiers describe.
+func parseVariant(name string) (string, Variant) {
+	variant := Variant{Path: name}
+	dir, file := path.Split(name)
+	ext := path.Ext(file)
+	base, qualifiers, ok := strings.Cut(strings.TrimSuffix(file, ext), "@")
+	if !ok || base == "" {
+		return name, variant
+	}
+	for qualifier := range strings.SplitSeq(qualifiers, "@") {
+		if qualifier == "dark" {
+			variant.Dark = true
+			continue
+		}
+		if number, found := strings.CutSuffix(qualifier, "x"); found {
+			if scale, err := strconv.ParseFloat(number, 64); err == nil && scale > 0 {
+				variant.Scale = scale
+				continue
+			}
+		}
+		// Only two- and three-letter languages, so names like
+		// "user@example.txt" aren't taken for locale variants.
+		locale := intl.ParseLocale(qualifier)
+		if locale.IsZero() || len(locale.Language) > 3 {
+			return name, Variant{Path: name}
+		}
+		variant.Locale = locale.String()
+	}
+	return dir + base + ext, variant
+}
+
+// resolve picks the variant of variants that best suits config. Locale is
+// matched first, then brightness, then density, so a translated image at
+// 1x wins over an untranslated one at the device's density.
+func resolve(variants []Variant, config Config) (Variant, bool) {
+	if len(variants) == 0 {
+		return Variant{}, false
+	}
+
+	best := -1
+	var candidates []Variant
+	for _, v := range variants {
+		rank := localeRank(v.Locale, config.Locale)
+		if rank > best {
+			best = rank
+			candidates = candidates[:0]
+		}
+		if rank == best {
+			candidates = append(candidates, v)
+		}
+	}
+
+	if slices.ContainsFunc(candidates, func(v Variant) bool { return v.Dark == config.Dark }) {
+		candidates = slices.DeleteFunc(candidates, func(v Variant) bool { return v.Dark != config.Dark })
+	}
+
+	// The smallest scale at or above the device's, so images are only
+	// scaled down, or the largest available.
+	want := config.scale()
+	pick := candidates[0]
+	for _, v := range candidates[1:] {
+		s := v.scale()
+		switch {
+		case s >= want && (pick.scale() < want || s < pick.scale()):
+			pick = v
+		case s < want && pick.scale() < want && s > pick.scale():
+			pick = v
+		}
+	}
+	return pick, true
+}
+
+// localeRank scores how well a variant's locale tag suits want: an exact
+// match, then the same language with no region, then the same language,
+// then a variant for any locale. Variants for another language rank last.
+func localeRank(tag string, want intl.Locale) int {
+	if tag == "" {
+		return 1
+	}
+	have := intl.ParseLocale(tag)
+	switch {
+	case want.IsZero() || have.Language != want.Language:
+		return 0
+	case have == want:
+		return 4
+	case have.Script != "" && want.Script != "" && have.Script != want.Script:
+		return 0
+	case have.Region == "":
+		return 3
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,46 @@
+package assets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/intl"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// Scope makes Bundle the asset bundle of the widgets below it, configured
+// for the current device: the scale from [widgets.DeviceScaleOf], the
+// locale from [intl.LocaleOf], and dark mode from the [theme.AppTheme]
+// brightness. Descendants rebuild with the matching variants when any of
+// them changes. Place it below Localizations and AppTheme so it can see
+// them.
+//
+//	assets.Scope{Bundle: bundle, Child: home}
+type Scope struct {
+	core.StatelessBase
+	// Bundle is the bundle to load assets from.
+	Bundle *Bundle
+	// Child is the widget below this one in the tree.
+	Child core.Widget
+}
+
+func (s Scope) Build(ctx core.BuildContext) core.Widget {
+	if s.Bundle == nil {
+		return s.Child
+	}
+	return widgets.DefaultAssetBundle{
+		Bundle: s.Bundle.With(ConfigOf(ctx)),
+		Child:  s.Child,
+	}
+}
+
+// ConfigOf returns the asset config for the device and theme at ctx.
+func ConfigOf(ctx core.BuildContext) Config {
+	config := Config{
+		Scale:  widgets.DeviceScaleOf(ctx),
+		Locale: intl.LocaleOf(ctx),
+	}
+	if data := theme.AppThemeMaybeOf(ctx); data != nil {
+		config.Dark = data.Brightness() == theme.BrightnessDark
+	}
+	return config
+}
@@ -0,0 +1,93 @@
+package core
+
+import "sync"
+
+// batch collects notifications deferred by Batch.
+var batch struct {
+	mu      sync.Mutex
+	depth   int
+	pending []func()
+	queued  map[any]bool
+}
+
+// Batch runs fn and holds back notifications from every [Signal] and
+// [Derived] changed inside it until fn returns. Each changed value then
+// notifies its listeners once, however many times it was set, and a
+// Derived that depends on several of them recomputes with all the changes
+// in place and notifies at most once. Use it to update several fields of a
+// view model without a rebuild per field:
+//
+//	core.Batch(func() {
+//	    vm.Name.Set(user.Name)
+//	    vm.Email.Set(user.Email)
+//	    vm.Loading.Set(false)
+//	})
+//
+// Values read inside fn are already updated. Batches may be nested; the
+// notifications run when the outermost one returns. Call Batch on the UI
+// thread, like Set; changes made by other goroutines while it runs are held
+// back too.
+func Batch(fn func()) {
+	batch.mu.Lock()
+	batch.depth++
+	batch.mu.Unlock()
+
+	defer func() {
+		batch.mu.Lock()
+		if batch.depth > 1 {
+			batch.depth--
+			batch.mu.Unlock()
+			return
+		}
+		batch.mu.Unlock()
+		flushBatch()
+	}()
+	fn()
+}
+
+// flushBatch runs deferred notifications, including those queued by the
+// listeners it calls, then ends the batch.
+func flushBatch() {
+	// End the batch even if a listener panics, so later changes still notify.
+	defer func() {
+		batch.mu.Lock()
+		batch.depth = 0
+		batch.pending = nil
+		batch.queued = nil
+		batch.mu.Unlock()
+	}()
+	for {
+		batch.mu.Lock()
+		pending := batch.pending
+		if len(pending) == 0 {
+			batch.mu.Unlock()
+			return
+		}
+		batch.pending = nil
+		clear(batch.queued)
+		batch.mu.Unlock()
+
+		for _, notify := range pending {
+			notify()
+		}
+	}
+}
+
+// notifyOrDefer calls notify now, or once at the end of the current batch.
+// key identifies the notifying value, so repeated changes notify once.
+func notifyOrDefer(key any, notify func()) {
+	batch.mu.Lock()
+	if batch.depth == 0 {
+		batch.mu.Unlock()
+		notify()
+		return
+	}
+	if !batch.queued[key] {
+		if batch.queued == nil {
+			batch.queued = make(map[any]bool)
+		}
+		batch.queued[key] = true
+		batch.pending = append(batch.pending, notify)
+	}
+	batch.mu.Unlock()
+}
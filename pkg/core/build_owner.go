@@ -15,6 +15,13 @@ type BuildOwner struct {
 	globalKeys map[any]Element
 	mu         sync.Mutex
 
+	// building counts nested FlushBuild calls. Elements holding a global
+	// key that are removed while it is positive are parked in inactive, so
+	// the key can move them to a new parent, and unmounted when the build
+	// finishes. Both are accessed on the UI thread.
+	building int
+	inactive []Element
+
 	// OnNeedsFrame is called when a new element is scheduled for rebuild,
 	// signalling the platform that a frame should be rendered. This is
 	// necessary for on-demand frame scheduling where the display link is
@@ -57,6 +64,16 @@ func NewBuildOwner() *BuildOwner {
 	}
 }
 
+// BuildOwnerOf returns the BuildOwner managing the element behind ctx, or nil
+// if ctx is not an element or has not been mounted. Each engine instance has
+// its own BuildOwner, so this identifies which engine a widget belongs to.
+func BuildOwnerOf(ctx BuildContext) *BuildOwner {
+	if e, ok := ctx.(interface{ owner() *BuildOwner }); ok {
+		return e.owner()
+	}
+	return nil
+}
+
 // Pipeline returns the PipelineOwner for render object scheduling.
 func (b *BuildOwner) Pipeline() *layout.PipelineOwner {
 	return b.pipeline
@@ -94,8 +111,16 @@ func (b *BuildOwner) NeedsWork() bool {
 	return b.pipeline.NeedsLayout() || b.pipeline.NeedsPaint()
 }
 
-// FlushBuild rebuilds all dirty elements in depth order.
+// FlushBuild rebuilds all dirty elements in depth order, then unmounts the
+// elements removed during the build that no [GlobalKey] moved elsewhere.
 func (b *BuildOwner) FlushBuild() {
+	b.building++
+	defer func() {
+		b.building--
+		if b.building == 0 {
+			b.finalizeInactive()
+		}
+	}()
 	for {
 		b.mu.Lock()
 		if len(b.dirty) == 0 {
@@ -0,0 +1,205 @@
+package core
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// computeSlots bounds how many Compute functions run at once, so a burst of
+// tasks can't starve the UI goroutine of CPU.
+var computeSlots = make(chan struct{}, max(1, runtime.GOMAXPROCS(0)-1))
+
+// Task is the state of work started with [Compute]. It is a [Listenable]
+// that notifies on the UI thread when progress is reported and when the
+// work finishes, so a widget can watch it with [UseListenable] and read it
+// in Build:
+//
+//	func (s *feedState) InitState() {
+//	    s.task = core.Compute(decodeFeed, s.body)
+//	    core.UseListenable(s, s.task)
+//	    core.UseDisposable(s, s.task)
+//	}
+//
+//	func (s *feedState) Build(ctx core.BuildContext) core.Widget {
+//	    if !s.task.Done() {
+//	        return loadingView(s.task.Progress())
+//	    }
+//	    if err := s.task.Err(); err != nil {
+//	        return errorView(err)
+//	    }
+//	    return feedView(s.task.Value())
+//	}
+//
+// Value, Err, Done, and Progress only change on the UI thread, between
+// notifications, so reading them in Build is safe.
+type Task[R any] struct {
+	Notifier
+
+	cancel context.CancelFunc
+
+	// Written on the UI thread.
+	done     bool
+	value    R
+	err      error
+	progress float64
+
+	// pendingProgress coalesces progress reports made between frames.
+	progressMu      sync.Mutex
+	pendingProgress float64
+	progressQueued  bool
+}
+
+// Compute runs fn(ctx, input) on a background worker and delivers the result
+// to the UI thread. Use it for work too slow for Build or an event
+// handler, such as decoding a large JSON document or resizing an image:
+//
+//	task := core.Compute(func(ctx context.Context, data []byte) (*Feed, error) {
+//	    var feed Feed
+//	    err := json.Unmarshal(data, &feed)
+//	    return &feed, err
+//	}, body)
+//
+// Workers are shared and limited to one fewer than GOMAXPROCS, so extra
+// tasks wait their turn. fn runs off the UI thread: it must not touch
+// widgets, state, or render objects, and should only use its input and its
+// own data. Report progress with [ReportProgress] on ctx, and stop early when
+// ctx is cancelled by [Task.Cancel] or [Task.Dispose]. A panic in fn is
+// reported and becomes the task's error.
+func Compute[T, R any](fn func(ctx context.Context, input T) (R, error), input T) *Task[R] {
+	ctx, cancel := context.WithCancel(context.Background())
+	task := &Task[R]{cancel: cancel}
+	ctx = context.WithValue(ctx, progressKey{}, progressReporter(task.reportProgress))
+
+	go func() {
+		select {
+		case computeSlots <- struct{}{}:
+		case <-ctx.Done():
+			var zero R
+			task.finish(zero, ctx.Err())
+			return
+		}
+		value, err := runCompute(ctx, fn, input)
+		<-computeSlots
+		if err == nil && ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		task.finish(value, err)
+	}()
+	return task
+}
+
+func runCompute[T, R any](ctx context.Context, fn func(context.Context, T) (R, error), input T) (value R, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicErr := &errors.PanicError{
+				Op:         "core.Compute",
+				Value:      r,
+				StackTrace: errors.CaptureStack(),
+				Timestamp:  time.Now(),
+			}
+			errors.ReportPanic(panicErr)
+			err = panicErr
+		}
+	}()
+	return fn(ctx, input)
+}
+
+// progressKey is the context key for the function that reports progress.
+type progressKey struct{}
+
+type progressReporter func(fraction float64)
+
+// ReportProgress reports how far the work running under ctx has got, from 0
+// to 1. Inside a [Compute] function it updates [Task.Progress] and notifies
+// the task's listeners on the UI thread. Reports made faster than frames are
+// drawn are coalesced, so it is cheap to call often. Elsewhere it does
+// nothing.
+func ReportProgress(ctx context.Context, fraction float64) {
+	if report, ok := ctx.Value(progressKey{}).(progressReporter); ok {
+		report(min(max(fraction, 0), 1))
+	}
+}
+
+func (t *Task[R]) reportProgress(fraction float64) {
+	t.progressMu.Lock()
+	t.pendingProgress = fraction
+	queued := t.progressQueued
+	t.progressQueued = true
+	t.progressMu.Unlock()
+	if queued {
+		return
+	}
+	runOnUIThread(func() {
+		t.progressMu.Lock()
+		fraction := t.pendingProgress
+		t.progressQueued = false
+		t.progressMu.Unlock()
+		if t.done || fraction == t.progress {
+			return
+		}
+		t.progress = fraction
+		t.Notify()
+	})
+}
+
+func (t *Task[R]) finish(value R, err error) {
+	runOnUIThread(func() {
+		t.value = value
+		t.err = err
+		if err == nil {
+			t.progress = 1
+		}
+		t.done = true
+		t.cancel()
+		t.Notify()
+	})
+}
+
+// runOnUIThread dispatches fn to the UI thread, or runs it directly when no
+// engine is running, as in plain unit tests.
+func runOnUIThread(fn func()) {
+	if !platform.Dispatch(fn) {
+		fn()
+	}
+}
+
+// Done reports whether the work has finished, successfully or not.
+func (t *Task[R]) Done() bool {
+	return t.done
+}
+
+// Value returns the result, or the zero value until the work succeeds.
+func (t *Task[R]) Value() R {
+	return t.value
+}
+
+// Err returns the error from the work, or nil. A cancelled task reports
+// [context.Canceled].
+func (t *Task[R]) Err() error {
+	return t.err
+}
+
+// Progress returns the last progress reported with [ReportProgress], from 0
+// to 1. It is 1 once the work has succeeded.
+func (t *Task[R]) Progress() float64 {
+	return t.progress
+}
+
+// Cancel cancels the context passed to the work. The task finishes with
+// [context.Canceled] unless the work has already returned.
+func (t *Task[R]) Cancel() {
+	t.cancel()
+}
+
+// Dispose cancels the work and removes all listeners, so the result is
+// dropped. Call it from the owning State's Dispose, or register the task
+// with [UseDisposable].
+func (t *Task[R]) Dispose() {
+	t.cancel()
+	t.Notifier.Dispose()
+}
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// uiQueue stands in for the engine's dispatch queue.
+func uiQueue(t *testing.T) chan func() {
+	t.Helper()
+	queue := make(chan func(), 64)
+	platform.RegisterDispatch(func(cb func()) { queue <- cb })
+	t.Cleanup(func() { platform.RegisterDispatch(nil) })
+	return queue
+}
+
+// runUntilDone runs dispatched callbacks until task finishes.
+func runUntilDone[R any](t *testing.T, queue chan func(), task *Task[R]) {
+	t.Helper()
+	deadline := time.After(2 * time.Second)
+	for !task.Done() {
+		select {
+		case cb := <-queue:
+			cb()
+		case <-deadline:
+			t.Fatal("timed out waiting for the task")
+		}
+	}
+}
+
+func TestCompute_DeliversResultOnUIThread(t *testing.T) {
+	queue := uiQueue(t)
+	task := Compute(func(ctx context.Context, n int) (int, error) {
+		ReportProgress(ctx, 0.5)
+		return n * 2, nil
+	}, 21)
+	notified := 0
+	task.AddListener(func() { notified++ })
+
+	if task.Done() {
+		t.Fatal("expected the result to wait for the UI thread")
+	}
+	runUntilDone(t, queue, task)
+	if task.Value() != 42 || task.Err() != nil {
+		t.Errorf("got %v, %v; want 42, nil", task.Value(), task.Err())
+	}
+	if task.Progress() != 1 {
+		t.Errorf("expected progress 1 after success, got %v", task.Progress())
+	}
+	if notified == 0 {
+		t.Error("expected listeners to be notified")
+	}
+}
+
+func TestCompute_Cancel(t *testing.T) {
+	queue := uiQueue(t)
+	started := make(chan struct{})
+	task := Compute(func(ctx context.Context, _ struct{}) (int, error) {
+		close(started)
+		<-ctx.Done()
+		return 1, nil
+	}, struct{}{})
+	<-started
+	task.Cancel()
+	runUntilDone(t, queue, task)
+	if !stderrors.Is(task.Err(), context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", task.Err())
+	}
+}
+
+func TestCompute_PanicBecomesError(t *testing.T) {
+	queue := uiQueue(t)
+	task := Compute(func(ctx context.Context, _ int) (int, error) {
+		panic("boom")
+	}, 0)
+	runUntilDone(t, queue, task)
+	var panicErr *errors.PanicError
+	if !stderrors.As(task.Err(), &panicErr) || panicErr.Value != "boom" {
+		t.Errorf("expected a PanicError, got %v", task.Err())
+	}
+}
+
+func TestReportProgress_OutsideCompute(t *testing.T) {
+	ReportProgress(context.Background(), 0.5) // must not panic
+}
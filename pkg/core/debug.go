@@ -1,11 +1,37 @@
 package core
 
+import (
+	"sync/atomic"
+	"time"
+)
+
 // DebugMode controls whether debug information is displayed in error widgets.
 // When true, error widgets show detailed error messages and stack traces.
 // When false, error widgets show minimal information.
 var DebugMode = true
 
 // SetDebugMode enables or disables debug mode for the framework.
+//
+// Deprecated: Pass drift.WithDebugMode to drift.NewApp instead.
 func SetDebugMode(debug bool) {
 	DebugMode = debug
 }
+
+// BuildObserver is called after each widget's Build method runs with the
+// widget and the time Build took. The duration excludes building the
+// returned children, which are reported separately.
+type BuildObserver func(widget Widget, duration time.Duration)
+
+var buildObserver atomic.Pointer[BuildObserver]
+
+// SetBuildObserver installs fn to observe widget builds, replacing any
+// previous observer. Pass nil to remove it. Builds are only timed while an
+// observer is installed. The observer runs on the UI thread during the build
+// phase and should be cheap.
+func SetBuildObserver(fn BuildObserver) {
+	if fn == nil {
+		buildObserver.Store(nil)
+		return
+	}
+	buildObserver.Store(&fn)
+}
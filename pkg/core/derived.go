@@ -177,12 +177,18 @@ func (d *Derived[T]) recompute() {
 	}
 
 	d.value = newValue
+	d.mu.Unlock()
+	notifyOrDefer(d, d.notifyListeners)
+}
 
+// notifyListeners calls the listeners registered when it runs.
+func (d *Derived[T]) notifyListeners() {
+	d.mu.RLock()
 	listeners := make([]func(), 0, len(d.listeners))
 	for _, fn := range d.listeners {
 		listeners = append(listeners, fn)
 	}
-	d.mu.Unlock()
+	d.mu.RUnlock()
 
 	for _, fn := range listeners {
 		fn()
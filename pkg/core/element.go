@@ -34,6 +34,8 @@ type elementBase struct {
 	self         Element
 	mounted      bool
 	renderParent renderObjectHost // nearest ancestor that owns a render object
+	built        bool             // set once Build has run
+	rebuilds     *rebuildRecord   // allocated while rebuild tracking is enabled
 }
 
 func (e *elementBase) Widget() Widget {
@@ -87,6 +89,7 @@ func (e *elementBase) NeedsBuild() bool {
 }
 
 func (e *elementBase) MarkNeedsBuild() {
+	checkMarkNeedsBuild(e.self)
 	if e.dirty {
 		return
 	}
@@ -112,6 +115,10 @@ func (e *elementBase) setBuildOwner(owner *BuildOwner) {
 	e.buildOwner = owner
 }
 
+func (e *elementBase) owner() *BuildOwner {
+	return e.buildOwner
+}
+
 func (e *elementBase) isMounted() bool {
 	return e.mounted
 }
@@ -138,6 +145,14 @@ func (e *elementBase) safeBuild(buildFn func() Widget) Widget {
 	var built Widget
 	var buildErr *errors.BoundaryError
 
+	e.recordBuild()
+
+	observer := buildObserver.Load()
+	var buildStart time.Time
+	if observer != nil {
+		buildStart = time.Now()
+	}
+
 	func() {
 		defer func() {
 			if r := recover(); r != nil {
@@ -150,9 +165,15 @@ func (e *elementBase) safeBuild(buildFn func() Widget) Widget {
 				}
 			}
 		}()
+		defer enterStrictBuild(e.self)()
+		buildEpoch++
 		built = buildFn()
 	}()
 
+	if observer != nil {
+		(*observer)(e.widget, time.Since(buildStart))
+	}
+
 	if buildErr != nil {
 		// Report to global error handler
 		errors.ReportBoundaryError(buildErr)
@@ -230,6 +251,7 @@ func (e *StatelessElement) Mount(parent Element, slot any) {
 
 func (e *StatelessElement) Update(newWidget Widget) {
 	e.widget = newWidget
+	noteRebuild(e, RebuildReasonParent, widgetTypeName(e.parent))
 	e.MarkNeedsBuild()
 }
 
@@ -295,9 +317,13 @@ func (e *StatefulElement) Mount(parent Element, slot any) {
 	if setter, ok := e.state.(interface{ SetElement(*StatefulElement) }); ok {
 		setter.SetElement(e)
 	}
+	exitOwner := e.enterOwnerScope()
 	e.state.InitState()
 	registerGlobalKeyIfNeeded(e.widget, e.self, e.buildOwner)
+	exitScope := enterDependencyScope()
 	e.state.DidChangeDependencies()
+	exitScope()
+	exitOwner()
 	e.dirty = true
 	e.RebuildIfNeeded()
 }
@@ -305,7 +331,10 @@ func (e *StatefulElement) Mount(parent Element, slot any) {
 func (e *StatefulElement) Update(newWidget Widget) {
 	oldWidget := e.widget.(StatefulWidget)
 	e.widget = newWidget
+	noteRebuild(e, RebuildReasonParent, widgetTypeName(e.parent))
+	exitOwner := e.enterOwnerScope()
 	e.state.DidUpdateWidget(oldWidget)
+	exitOwner()
 	e.MarkNeedsBuild()
 }
 
@@ -318,6 +347,7 @@ func (e *StatefulElement) Unmount() {
 	}
 	if e.state != nil {
 		e.state.Dispose()
+		errors.ReleaseDisposableOwner(e.state)
 	}
 }
 
@@ -327,11 +357,21 @@ func (e *StatefulElement) RebuildIfNeeded() {
 	}
 	e.dirty = false
 	built := e.safeBuild(func() Widget {
+		defer e.enterOwnerScope()()
 		return e.state.Build(e)
 	})
 	e.child = updateChild(e.child, built, e, e.buildOwner, nil)
 }
 
+// enterOwnerScope attributes disposable objects created until the returned
+// function is called to this element's state, for disposal auditing.
+func (e *StatefulElement) enterOwnerScope() (exit func()) {
+	if !errors.DisposalAuditingEnabled() {
+		return func() {}
+	}
+	return errors.EnterDisposableOwner(e.state, reflect.TypeOf(e.state).String())
+}
+
 func (e *StatefulElement) VisitChildren(visitor func(Element) bool) {
 	if e.child != nil {
 		visitor(e.child)
@@ -370,8 +410,13 @@ func (e *RenderObjectElement) Mount(parent Element, slot any) {
 	registerGlobalKeyIfNeeded(e.widget, e.self, e.buildOwner)
 
 	// Create render object
+	// Render object widgets may depend on inherited widgets while creating
+	// and updating their render object; a change marks this element dirty,
+	// which reruns UpdateRenderObject.
 	widget := e.widget.(RenderObjectWidget)
+	exitScope := enterDependencyScope()
 	e.renderObject = widget.CreateRenderObject(e)
+	exitScope()
 	if e.buildOwner != nil {
 		e.renderObject.SetOwner(e.buildOwner.Pipeline())
 	}
@@ -410,7 +455,9 @@ func (e *RenderObjectElement) RebuildIfNeeded() {
 	e.dirty = false
 
 	widget := e.widget.(RenderObjectWidget)
+	exitScope := enterDependencyScope()
 	widget.UpdateRenderObject(e, e.renderObject)
+	exitScope()
 
 	switch typed := e.widget.(type) {
 	case interface{ ChildWidget() Widget }:
@@ -569,7 +616,7 @@ func slotEqual(a, b any) bool {
 func updateChild(existing Element, widget Widget, parent Element, owner *BuildOwner, slot any) Element {
 	if widget == nil {
 		if existing != nil {
-			existing.Unmount()
+			removeChild(parent, existing, owner)
 		}
 		return nil
 	}
@@ -581,7 +628,10 @@ func updateChild(existing Element, widget Widget, parent Element, owner *BuildOw
 		return existing
 	}
 	if existing != nil {
-		existing.Unmount()
+		removeChild(parent, existing, owner)
+	}
+	if element := owner.retakeGlobalKey(widget, parent, slot); element != nil {
+		return element
 	}
 	element := inflateWidget(widget, owner)
 	element.Mount(parent, slot)
@@ -685,13 +735,13 @@ func updateChildren(
 		newEndScan++
 	}
 
-	// 6. Unmount unused old children
+	// 6. Remove unused old children
 	for _, remaining := range keyedOld {
-		remaining.Unmount()
+		removeChild(parent, remaining, owner)
 	}
 	for _, remaining := range nonKeyedOld {
 		if remaining != nil {
-			remaining.Unmount()
+			removeChild(parent, remaining, owner)
 		}
 	}
 
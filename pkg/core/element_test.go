@@ -2,6 +2,7 @@ package core
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-drift/drift/pkg/errors"
 	"github.com/go-drift/drift/pkg/graphics"
@@ -1078,6 +1079,95 @@ func TestInheritedElement_Update_NonAspectAware_NotifiesAll(t *testing.T) {
 	}
 }
 
+// pairInherited is a test inherited widget with two independent fields.
+type pairInherited struct {
+	InheritedBase
+	a, b  int
+	child Widget
+}
+
+func (p pairInherited) ChildWidget() Widget { return p.child }
+func (p pairInherited) ShouldRebuildDependents(old InheritedWidget) bool {
+	return p != old.(pairInherited)
+}
+
+func TestSelectInherited_RebuildsOnlyWhenSelectionChanges(t *testing.T) {
+	owner := NewBuildOwner()
+
+	var selected []int
+	consumer := testStatelessWidget{
+		buildFn: func(ctx BuildContext) Widget {
+			a, ok := SelectInherited(ctx, func(p pairInherited) int { return p.a })
+			if !ok {
+				t.Error("expected SelectInherited to find the ancestor")
+			}
+			selected = append(selected, a)
+			return nil
+		},
+	}
+	element := newTestInheritedElement(pairInherited{a: 1, b: 1, child: consumer}, owner)
+	element.Mount(nil, nil)
+	dep := element.child.(*StatelessElement)
+
+	element.Update(pairInherited{a: 1, b: 2, child: consumer})
+	if dep.dirty {
+		t.Error("expected a change to an unselected field not to notify")
+	}
+
+	element.Update(pairInherited{a: 2, b: 2, child: consumer})
+	if !dep.dirty {
+		t.Fatal("expected a change to the selected field to notify")
+	}
+	dep.RebuildIfNeeded()
+	dep.dirty = true
+	dep.RebuildIfNeeded()
+
+	if got := selected[len(selected)-1]; got != 2 {
+		t.Errorf("expected selected value 2, got %d", got)
+	}
+	if n := len(element.dependents[dep]); n != 1 {
+		t.Errorf("expected rebuilds to replace the selector, got %d aspects", n)
+	}
+}
+
+func TestSelectInherited_MixedWithWidgetAspects(t *testing.T) {
+	owner := NewBuildOwner()
+
+	consumer := testStatelessWidget{
+		buildFn: func(ctx BuildContext) Widget {
+			SelectInherited(ctx, func(a aspectInherited) bool { return a.value > 5 })
+			return nil
+		},
+	}
+	element := newTestInheritedElement(aspectInherited{value: 1, child: consumer}, owner)
+	element.Mount(nil, nil)
+	dep := element.child.(*StatelessElement)
+	element.AddDependent(dep, "other")
+
+	// The widget sees only its own aspect, and the selector is unchanged.
+	element.Update(aspectInherited{value: 2, child: consumer})
+	if dep.dirty {
+		t.Error("expected neither the selector nor the widget aspect to notify")
+	}
+
+	element.Update(aspectInherited{value: 6, child: consumer})
+	if !dep.dirty {
+		t.Error("expected the selector to notify when its result changes")
+	}
+}
+
+func TestSelectInherited_NotFound(t *testing.T) {
+	element := newTestStatelessElement(testStatelessWidget{
+		buildFn: func(ctx BuildContext) Widget {
+			if v, ok := SelectInherited(ctx, func(p pairInherited) int { return p.a }); ok || v != 0 {
+				t.Errorf("expected zero value and false, got %d, %v", v, ok)
+			}
+			return nil
+		},
+	}, NewBuildOwner())
+	element.Mount(nil, nil)
+}
+
 // lifecycleState tracks the order of lifecycle method calls.
 type lifecycleState struct {
 	StateBase
@@ -1146,3 +1236,70 @@ func TestStatefulElement_Mount_LifecycleOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestSetBuildObserver_ReportsEachBuild(t *testing.T) {
+	var observed []Widget
+	SetBuildObserver(func(widget Widget, duration time.Duration) {
+		if duration < 0 {
+			t.Errorf("negative build duration %v", duration)
+		}
+		observed = append(observed, widget)
+	})
+	defer SetBuildObserver(nil)
+
+	leaf := testStatelessWidget{buildFn: func(ctx BuildContext) Widget { return nil }}
+	parent := testStatelessWidget{buildFn: func(ctx BuildContext) Widget { return leaf }}
+
+	owner := NewBuildOwner()
+	element := newTestStatelessElement(parent, owner)
+	element.Mount(nil, nil)
+
+	if len(observed) != 2 {
+		t.Fatalf("expected parent and child builds, got %d", len(observed))
+	}
+
+	SetBuildObserver(nil)
+	element.MarkNeedsBuild()
+	element.RebuildIfNeeded()
+	if len(observed) != 2 {
+		t.Errorf("expected no builds observed after removing the observer, got %d", len(observed))
+	}
+}
+
+func TestStatefulElement_DisposalAuditReportsLeaks(t *testing.T) {
+	errors.SetHandler(&strictTestHandler{})
+	errors.SetDisposalAuditing(true)
+	errors.ClearLeaks()
+	defer func() {
+		errors.SetDisposalAuditing(false)
+		errors.ClearLeaks()
+		errors.SetHandler(nil)
+	}()
+
+	var untrackBuilt func()
+	state := &strictLifecycleState{
+		initState: func(ctx BuildContext) {
+			errors.TrackDisposable("*test.Controller", nil)
+		},
+		build: func(s *strictLifecycleState, ctx BuildContext) {
+			if untrackBuilt == nil {
+				untrackBuilt = errors.TrackDisposable("*test.Ticker", nil)
+			}
+		},
+	}
+	// A disposable created outside any State is not tracked.
+	errors.TrackDisposable("*test.Global", nil)
+
+	element := newTestStatefulElement(testStatefulWidget{createStateFn: func() State { return state }}, NewBuildOwner())
+	element.Mount(nil, nil)
+	untrackBuilt()
+	element.Unmount()
+
+	leaks := errors.Leaks()
+	if len(leaks) != 1 {
+		t.Fatalf("expected 1 leak, got %d: %+v", len(leaks), leaks)
+	}
+	if leaks[0].Kind != "*test.Controller" || leaks[0].Owner != "*core.strictLifecycleState" {
+		t.Errorf("unexpected leak %+v", leaks[0])
+	}
+}
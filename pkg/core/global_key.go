@@ -1,6 +1,10 @@
 package core
 
-import "sync/atomic"
+import (
+	"sync/atomic"
+
+	"github.com/go-drift/drift/pkg/layout"
+)
 
 var globalKeyNextID atomic.Uint64
 
@@ -23,10 +27,30 @@ var globalKeyNextID atomic.Uint64
 // inherited). However, [GlobalKey.CurrentState] only returns a non-zero value
 // for stateful elements whose State satisfies the type parameter S.
 //
+// # Reparenting
+//
+// A keyed widget can move to a different parent without losing its State:
+// when a build removes it from one place and inserts a widget of the same
+// type with the same key elsewhere, the framework moves the existing element
+// and its subtree, including render objects, instead of recreating them.
+// This keeps text field contents, scroll positions, and running animations
+// when a widget is wrapped in, or unwrapped from, another widget:
+//
+//	var playerKey = core.NewGlobalKey[*playerState]()
+//
+//	if s.fullscreen {
+//	    return widgets.Center{Child: VideoPlayer{GlobalKey: playerKey}}
+//	}
+//	return widgets.Padding{Padding: inset, Child: VideoPlayer{GlobalKey: playerKey}}
+//
+// The move happens within a single build. A keyed widget removed in one
+// frame and inserted again in a later one starts with a new State, and a
+// key may only appear once in the tree at a time.
+//
 // # Thread Safety
 //
-// GlobalKey's accessor methods (CurrentState, CurrentElement, CurrentContext)
-// read from fields that are written during mount/unmount on the UI thread.
+// GlobalKey's accessor methods (CurrentState, CurrentElement, CurrentContext,
+// CurrentRenderObject) read from fields that are written during mount/unmount on the UI thread.
 // Call these methods from the UI thread only.
 //
 // # Example
@@ -115,6 +139,21 @@ func (k GlobalKey[S]) CurrentContext() BuildContext {
 	return nil
 }
 
+// CurrentRenderObject returns the render object of this key's element, or
+// nil. For elements that don't own one (stateless, stateful, inherited),
+// it is the render object of the nearest descendant that does, so it
+// covers the widget's area on screen. Use it after layout to read the
+// widget's size and position, for example to scroll it into view.
+func (k GlobalKey[S]) CurrentRenderObject() layout.RenderObject {
+	if k.inner == nil || k.inner.element == nil {
+		return nil
+	}
+	if ro, ok := k.inner.element.(interface{ RenderObject() layout.RenderObject }); ok {
+		return ro.RenderObject()
+	}
+	return nil
+}
+
 // globalKeyRegistry is the internal interface used by the framework to register
 // and unregister elements for global keys. It is unexported to prevent external
 // implementations.
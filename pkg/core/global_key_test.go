@@ -204,3 +204,90 @@ func TestGlobalKey_StatelessElement(t *testing.T) {
 		t.Error("GlobalKey element should be nil after unmount")
 	}
 }
+
+// reparentHost places keyed either directly or inside a wrapper, or drops
+// it, depending on mode.
+type reparentHost struct {
+	StatefulBase
+	keyed Widget
+}
+
+func (w reparentHost) CreateState() State { return &reparentHostState{} }
+
+type reparentHostState struct {
+	StateBase
+	mode string // "direct", "wrapped", or "none"
+}
+
+func (s *reparentHostState) Build(ctx BuildContext) Widget {
+	keyed := s.Element().Widget().(reparentHost).keyed
+	switch s.mode {
+	case "wrapped":
+		return testStatelessWidget{buildFn: func(BuildContext) Widget { return keyed }}
+	case "none":
+		return nil
+	}
+	return keyed
+}
+
+func mountReparentHost(t *testing.T, key GlobalKey[*globalKeyTestState]) (*BuildOwner, *reparentHostState) {
+	t.Helper()
+	owner := NewBuildOwner()
+	root := inflateWidget(reparentHost{keyed: globalKeyTestWidget{key: key}}, owner)
+	root.Mount(nil, nil)
+	return owner, root.(*StatefulElement).state.(*reparentHostState)
+}
+
+func TestGlobalKey_ReparentKeepsState(t *testing.T) {
+	key := NewGlobalKey[*globalKeyTestState]()
+	owner, host := mountReparentHost(t, key)
+
+	state := key.CurrentState()
+	state.label = "kept"
+	disposed := false
+	state.OnDispose(func() { disposed = true })
+
+	for _, mode := range []string{"wrapped", "direct"} {
+		host.SetState(func() { host.mode = mode })
+		owner.FlushBuild()
+
+		if key.CurrentState() != state {
+			t.Fatalf("%s: expected the same State after moving, got %p want %p", mode, key.CurrentState(), state)
+		}
+		if disposed {
+			t.Fatalf("%s: State disposed by the move", mode)
+		}
+		want := 1
+		if mode == "wrapped" {
+			want = 2
+		}
+		if depth := key.CurrentElement().Depth(); depth != want {
+			t.Errorf("%s: expected depth %d, got %d", mode, want, depth)
+		}
+	}
+	if state.label != "kept" {
+		t.Errorf("expected state fields to survive, got %q", state.label)
+	}
+}
+
+func TestGlobalKey_RemovedElementUnmountsAfterBuild(t *testing.T) {
+	key := NewGlobalKey[*globalKeyTestState]()
+	owner, host := mountReparentHost(t, key)
+
+	state := key.CurrentState()
+	disposed := false
+	state.OnDispose(func() { disposed = true })
+
+	host.SetState(func() { host.mode = "none" })
+	owner.FlushBuild()
+
+	if !disposed {
+		t.Error("expected a keyed State that was not reinserted to be disposed")
+	}
+	if key.CurrentElement() != nil {
+		t.Error("expected the key to be released")
+	}
+	if len(owner.inactive) != 0 {
+		t.Errorf("expected no inactive elements after the build, got %d", len(owner.inactive))
+	}
+}
@@ -163,6 +163,7 @@ func (s *inlineStatefulState[S]) Build(ctx BuildContext) Widget {
 	return s.buildFn(s.value, ctx, func(update func(S) S) {
 		s.value = update(s.value)
 		if s.element != nil {
+			noteRebuild(s.element, RebuildReasonSetState, "")
 			s.element.MarkNeedsBuild()
 		}
 	})
@@ -173,6 +174,7 @@ func (s *inlineStatefulState[S]) SetState(fn func()) {
 		fn()
 	}
 	if s.element != nil {
+		noteRebuild(s.element, RebuildReasonSetState, "")
 		s.element.MarkNeedsBuild()
 	}
 }
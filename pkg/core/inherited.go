@@ -74,20 +74,9 @@ func (e *InheritedElement) Update(newWidget Widget) {
 		return
 	}
 
-	// If the widget supports aspect-based filtering, use per-dependent checks.
-	// Otherwise, notify all dependents unconditionally.
-	aspectAware, hasAspects := newInherited.(AspectAwareInheritedWidget)
 	for dependent, aspects := range e.dependents {
-		if !hasAspects {
-			notifyDependent(dependent)
-			continue
-		}
-		// Check for sentinel indicating "all changes" dependency
-		if _, dependsOnAll := aspects[dependOnAllAspects]; dependsOnAll {
-			notifyDependent(dependent)
-			continue
-		}
-		if len(aspects) == 0 || aspectAware.ShouldRebuildDependent(oldWidget, aspects) {
+		if dependentChanged(oldWidget, newInherited, aspects) {
+			noteRebuild(dependent, RebuildReasonDependency, widgetTypeName(e))
 			notifyDependent(dependent)
 		}
 	}
@@ -95,6 +84,45 @@ func (e *InheritedElement) Update(newWidget Widget) {
 	e.MarkNeedsBuild()
 }
 
+// dependentChanged reports whether a dependent that registered aspects should
+// rebuild for an update from oldWidget to newWidget. Selectors registered by
+// [SelectInherited] work with any inherited widget; other aspects are passed
+// to widgets that implement [AspectAwareInheritedWidget], and mean "any
+// change" for widgets that don't.
+func dependentChanged(oldWidget, newWidget InheritedWidget, aspects map[any]struct{}) bool {
+	// Check for sentinel indicating "all changes" dependency
+	if _, dependsOnAll := aspects[dependOnAllAspects]; dependsOnAll || len(aspects) == 0 {
+		return true
+	}
+	selectors := 0
+	for aspect := range aspects {
+		if sel, ok := aspect.(*selectAspect); ok {
+			if sel.changed(oldWidget, newWidget) {
+				return true
+			}
+			selectors++
+		}
+	}
+	if selectors == len(aspects) {
+		return false
+	}
+	aspectAware, ok := newWidget.(AspectAwareInheritedWidget)
+	if !ok {
+		return true
+	}
+	if selectors > 0 {
+		// Widgets only understand their own aspects.
+		own := make(map[any]struct{}, len(aspects)-selectors)
+		for aspect := range aspects {
+			if _, ok := aspect.(*selectAspect); !ok {
+				own[aspect] = struct{}{}
+			}
+		}
+		aspects = own
+	}
+	return aspectAware.ShouldRebuildDependent(oldWidget, aspects)
+}
+
 func (e *InheritedElement) Unmount() {
 	unregisterGlobalKeyIfNeeded(e.widget, e.self, e.buildOwner)
 	e.mounted = false
@@ -147,7 +175,8 @@ func (e *InheritedElement) RenderObject() layout.RenderObject {
 //
 // Note: Aspect sets only grow during an element's lifetime. If a widget changes which
 // aspects it depends on across rebuilds, old aspects remain registered. This may cause
-// extra rebuilds but is safe (over-notification, not under-notification).
+// extra rebuilds but is safe (over-notification, not under-notification). Selectors
+// registered by [SelectInherited] are the exception: each build replaces them.
 func (e *InheritedElement) AddDependent(dependent Element, aspect any) {
 	if e.dependents == nil {
 		e.dependents = make(map[Element]map[any]struct{})
@@ -159,6 +188,15 @@ func (e *InheritedElement) AddDependent(dependent Element, aspect any) {
 		e.dependents[dependent] = aspects
 	}
 
+	if sel, ok := aspect.(*selectAspect); ok {
+		// Selectors are registered afresh by every build, so drop the ones
+		// left from the dependent's previous builds.
+		for old := range aspects {
+			if oldSel, ok := old.(*selectAspect); ok && oldSel.epoch != sel.epoch {
+				delete(aspects, old)
+			}
+		}
+	}
 	if aspect != nil {
 		aspects[aspect] = struct{}{}
 	} else {
@@ -173,10 +211,15 @@ func (e *InheritedElement) RemoveDependent(dependent Element) {
 
 // notifyDependent triggers DidChangeDependencies on the dependent element.
 func notifyDependent(element Element) {
+	noteRebuild(element, RebuildReasonDependency, "")
 	// For StatefulElement, call DidChangeDependencies on the state
 	if stateful, ok := element.(*StatefulElement); ok {
 		if stateful.state != nil {
+			exitScope := enterDependencyScope()
+			exitOwner := stateful.enterOwnerScope()
 			stateful.state.DidChangeDependencies()
+			exitOwner()
+			exitScope()
 		}
 		stateful.MarkNeedsBuild()
 		return
@@ -188,6 +231,7 @@ func notifyDependent(element Element) {
 // dependOnInheritedWithAspects registers multiple aspects in a single tree walk.
 // This is more efficient than calling DependOnInherited multiple times.
 func dependOnInheritedWithAspects(element Element, inheritedType reflect.Type, aspects ...any) any {
+	checkDependOnInherited(element, inheritedType)
 	var current Element
 	if base, ok := element.(interface{ parentElement() Element }); ok {
 		current = base.parentElement()
@@ -217,6 +261,7 @@ func dependOnInheritedWithAspects(element Element, inheritedType reflect.Type, a
 // It walks up the element tree to find the nearest InheritedElement of the requested type.
 // The aspect parameter enables granular dependency tracking for selective rebuilds.
 func dependOnInheritedImpl(element Element, inheritedType reflect.Type, aspect any) any {
+	checkDependOnInherited(element, inheritedType)
 	var current Element
 	if base, ok := element.(interface{ parentElement() Element }); ok {
 		current = base.parentElement()
@@ -239,3 +284,50 @@ func dependOnInheritedImpl(element Element, inheritedType reflect.Type, aspect a
 	}
 	return nil
 }
+
+// buildEpoch counts element builds, so selectors can tell which build
+// registered them. Accessed on the UI thread.
+var buildEpoch uint64
+
+// selectAspect is the aspect registered by SelectInherited.
+type selectAspect struct {
+	epoch   uint64
+	changed func(oldWidget, newWidget InheritedWidget) bool
+}
+
+// SelectInherited finds and depends on the nearest ancestor inherited widget
+// of type W, and returns selector applied to it. Unlike
+// [BuildContext.DependOnInherited], the dependent only rebuilds when an
+// update changes the selected value, so a widget that reads one field of a
+// large inherited value ignores changes to the rest:
+//
+//	name, _ := core.SelectInherited(ctx, func(s SessionScope) string {
+//	    return s.User.Name
+//	})
+//
+// It returns the zero value and false if there is no such ancestor. Call it
+// from Build; selectors are replaced each time the widget builds. selector
+// should be cheap, as it runs for every update of W.
+//
+// Go methods cannot have type parameters, so this is a function rather than
+// a BuildContext method. Inherited widgets with a fixed set of aspects can
+// offer the same filtering through [AspectAwareInheritedWidget].
+func SelectInherited[W InheritedWidget, R comparable](ctx BuildContext, selector func(W) R) (R, bool) {
+	var zero R
+	aspect := &selectAspect{
+		epoch: buildEpoch,
+		changed: func(oldWidget, newWidget InheritedWidget) bool {
+			oldW, ok1 := oldWidget.(W)
+			newW, ok2 := newWidget.(W)
+			if !ok1 || !ok2 {
+				return true
+			}
+			return selector(oldW) != selector(newW)
+		},
+	}
+	w, ok := ctx.DependOnInherited(reflect.TypeFor[W](), aspect).(W)
+	if !ok {
+		return zero, false
+	}
+	return selector(w), true
+}
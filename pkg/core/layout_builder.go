@@ -88,6 +88,7 @@ func (e *LayoutBuilderElement) Mount(parent Element, slot any) {
 // so the layout callback re-invokes the builder with the new widget's function.
 func (e *LayoutBuilderElement) Update(newWidget Widget) {
 	e.widget = newWidget
+	noteRebuild(e, RebuildReasonParent, widgetTypeName(e.parent))
 	// Mark child dirty so next layout rebuilds with the new builder
 	e.childDirty = true
 	// Update render object properties
@@ -137,6 +138,9 @@ func (e *LayoutBuilderElement) layoutCallback(constraints layout.Constraints) {
 	if !e.childDirty && e.hasBuilt && constraints == e.previousConstraints {
 		return
 	}
+	if !e.childDirty && e.hasBuilt {
+		noteRebuild(e, RebuildReasonLayout, "")
+	}
 
 	lbw := e.widget.(LayoutBuilderWidget)
 	builder := lbw.LayoutBuilder()
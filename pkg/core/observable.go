@@ -0,0 +1,39 @@
+package core
+
+// Observable is a value that notifies its listeners when it changes.
+// [Signal] and [Derived] are observables, and the combinators [Map],
+// [Select], and [Combine] build a [Derived] from them.
+type Observable[T any] interface {
+	Listenable
+	Value() T
+}
+
+// Map returns a [Derived] holding fn applied to src's value. It notifies
+// only when the result changes, so listeners of a field of a larger value
+// skip rebuilds when other fields change:
+//
+//	name := core.Map(user, func(u User) string { return u.Name })
+//
+// The result must be disposed when no longer needed; inside a stateful
+// widget, register it with [UseDisposable].
+func Map[T any, R comparable](src Observable[T], fn func(T) R) *Derived[R] {
+	return NewDerived(func() R { return fn(src.Value()) }, src)
+}
+
+// Select is [Map] for results that are not comparable with ==, such as
+// slices, using equal to decide whether the selection changed:
+//
+//	tags := core.Select(post, func(p Post) []string { return p.Tags }, slices.Equal)
+func Select[T, R any](src Observable[T], selector func(T) R, equal func(a, b R) bool) *Derived[R] {
+	return NewDerivedWithEquality(func() R { return selector(src.Value()) }, equal, src)
+}
+
+// Combine returns a [Derived] computed from two observables, recomputed
+// when either changes. Change both inside a [Batch] to notify once:
+//
+//	total := core.Combine(price, quantity, func(p float64, q int) float64 {
+//	    return p * float64(q)
+//	})
+func Combine[A, B any, R comparable](a Observable[A], b Observable[B], fn func(A, B) R) *Derived[R] {
+	return NewDerived(func() R { return fn(a.Value(), b.Value()) }, a, b)
+}
@@ -0,0 +1,118 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMapAndSelect_NotifyOnlyWhenResultChanges(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	src := NewSignal(user{name: "Ada", age: 36})
+	name := Map(src, func(u user) string { return u.name })
+	defer name.Dispose()
+	notified := 0
+	name.AddListener(func() { notified++ })
+
+	src.Set(user{name: "Ada", age: 37})
+	if notified != 0 {
+		t.Errorf("expected no notification for an unrelated field, got %d", notified)
+	}
+	src.Set(user{name: "Grace", age: 37})
+	if notified != 1 || name.Value() != "Grace" {
+		t.Errorf("got %d notifications, value %q", notified, name.Value())
+	}
+
+	list := NewSignalWithEquality([]int{1, 2}, slices.Equal)
+	first := Select(list, func(v []int) []int { return v[:1] }, slices.Equal)
+	defer first.Dispose()
+	selected := 0
+	first.AddListener(func() { selected++ })
+	list.Set([]int{1, 3})
+	if selected != 0 {
+		t.Errorf("expected Select to skip an equal selection, got %d", selected)
+	}
+}
+
+func TestBatch_NotifiesOncePerValue(t *testing.T) {
+	price := NewSignal(2.0)
+	quantity := NewSignal(1)
+	total := Combine(price, quantity, func(p float64, q int) float64 { return p * float64(q) })
+	defer total.Dispose()
+
+	priceNotified, totalNotified := 0, 0
+	price.AddListener(func() { priceNotified++ })
+	total.AddListener(func() { totalNotified++ })
+
+	Batch(func() {
+		price.Set(3)
+		price.Set(4)
+		quantity.Set(2)
+		if price.Value() != 4 {
+			t.Errorf("expected the new value inside the batch, got %v", price.Value())
+		}
+		if priceNotified != 0 || totalNotified != 0 {
+			t.Error("expected notifications to wait for the end of the batch")
+		}
+	})
+	if priceNotified != 1 {
+		t.Errorf("expected one price notification, got %d", priceNotified)
+	}
+	if totalNotified != 1 || total.Value() != 8 {
+		t.Errorf("expected one total notification with 8, got %d with %v", totalNotified, total.Value())
+	}
+
+	// Outside a batch, each change notifies right away.
+	price.Set(5)
+	if priceNotified != 2 || totalNotified != 2 {
+		t.Errorf("expected immediate notifications after the batch, got %d, %d", priceNotified, totalNotified)
+	}
+}
+
+func TestBatch_Nested(t *testing.T) {
+	s := NewSignal(0)
+	notified := 0
+	s.AddListener(func() { notified++ })
+	Batch(func() {
+		Batch(func() { s.Set(1) })
+		if notified != 0 {
+			t.Error("expected the inner batch to defer to the outer one")
+		}
+		s.Set(2)
+	})
+	if notified != 1 {
+		t.Errorf("expected one notification, got %d", notified)
+	}
+}
+
+func TestBatch_RecoversFromPanickingListener(t *testing.T) {
+	s := NewSignal(0)
+	unsub := s.AddListener(func() { panic("listener") })
+	func() {
+		defer func() { _ = recover() }()
+		Batch(func() { s.Set(1) })
+	}()
+	unsub()
+
+	notified := 0
+	s.AddListener(func() { notified++ })
+	s.Set(2)
+	if notified != 1 {
+		t.Error("expected notifications to resume after a panicking batch")
+	}
+}
+
+func TestSignal_SetAsync(t *testing.T) {
+	queue := uiQueue(t)
+	s := NewSignal(0)
+	s.SetAsync(1)
+	if s.Value() != 0 {
+		t.Fatal("expected SetAsync to wait for the UI thread")
+	}
+	(<-queue)()
+	if s.Value() != 1 {
+		t.Errorf("expected 1 after the dispatch ran, got %d", s.Value())
+	}
+}
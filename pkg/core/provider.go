@@ -1,6 +1,10 @@
 package core
 
-import "reflect"
+import (
+	"maps"
+	"reflect"
+	"sync/atomic"
+)
 
 // InheritedProvider is a generic inherited widget that eliminates boilerplate
 // for simple data-down-the-tree patterns.
@@ -82,8 +86,9 @@ func (p InheritedProvider[T]) ShouldRebuildDependents(oldWidget InheritedWidget)
 	return any(old.Value) != any(p.Value)
 }
 
-// Provide finds and depends on the nearest ancestor InheritedProvider[T].
-// Returns the value and true if found, or the zero value and false if not found.
+// Provide finds and depends on the nearest ancestor InheritedProvider[T] or
+// [Provider] of T. Returns the value and true if found, or the zero value and
+// false if not found.
 //
 // Example:
 //
@@ -91,6 +96,16 @@ func (p InheritedProvider[T]) ShouldRebuildDependents(oldWidget InheritedWidget)
 //	    fmt.Println("Hello,", user.Name)
 //	}
 func Provide[T any](ctx BuildContext) (T, bool) {
+	if activeOverrideScopes.Load() > 0 {
+		if value, ok := overriddenValue[T](ctx); ok {
+			return value, true
+		}
+	}
+	if activeProviders.Load() > 0 {
+		if value, ok := providedValue[T](ctx); ok {
+			return value, true
+		}
+	}
 	providerType := reflect.TypeFor[InheritedProvider[T]]()
 	widget := ctx.DependOnInherited(providerType, nil)
 	if widget == nil {
@@ -104,8 +119,8 @@ func Provide[T any](ctx BuildContext) (T, bool) {
 	return zero, false
 }
 
-// MustProvide finds and depends on the nearest ancestor InheritedProvider[T].
-// Panics if not found in the ancestor chain.
+// MustProvide finds and depends on the nearest ancestor InheritedProvider[T]
+// or [Provider] of T. Panics if not found in the ancestor chain.
 //
 // Example:
 //
@@ -114,7 +129,311 @@ func Provide[T any](ctx BuildContext) (T, bool) {
 func MustProvide[T any](ctx BuildContext) T {
 	value, ok := Provide[T](ctx)
 	if !ok {
-		panic("MustProvide: no InheritedProvider[" + reflect.TypeFor[T]().String() + "] found in ancestors")
+		panic("MustProvide: no provider of " + reflect.TypeFor[T]().String() + " found in ancestors")
 	}
 	return value
 }
+
+// Override replaces the value [Provide] returns for one type within a
+// [ProviderOverrides] subtree. Create overrides with [OverrideProvider].
+type Override struct {
+	valueType reflect.Type
+	value     any
+}
+
+// OverrideProvider returns an Override that makes Provide[T] return value.
+// The type parameter ties the replacement to the provided type at compile
+// time:
+//
+//	core.OverrideProvider[api.Client](&fakeClient{})
+func OverrideProvider[T any](value T) Override {
+	return Override{valueType: reflect.TypeFor[T](), value: value}
+}
+
+// ProviderOverrides replaces provided values for its subtree, for tests and
+// previews that need fakes without changing production wiring. An override
+// for T takes precedence over every InheritedProvider[T] and [Provider] of T
+// in the subtree, including providers the app itself mounts below this
+// widget, and a Provider whose type is overridden never creates its value.
+// Nested ProviderOverrides inherit the outer overrides; the nearest override
+// for a type wins.
+//
+// Example:
+//
+//	core.ProviderOverrides{
+//	    Overrides: []core.Override{
+//	        core.OverrideProvider[api.Client](&fakeClient{}),
+//	    },
+//	    Child: App{},
+//	}
+type ProviderOverrides struct {
+	StatefulBase
+
+	// Overrides lists the replaced values.
+	Overrides []Override
+
+	// Child is the child widget tree.
+	Child Widget
+}
+
+// CreateState implements StatefulWidget.
+func (ProviderOverrides) CreateState() State {
+	return &providerOverridesState{}
+}
+
+// activeOverrideScopes counts mounted ProviderOverrides so that Provide only
+// looks for overrides when some exist.
+var activeOverrideScopes atomic.Int32
+
+type providerOverridesState struct {
+	StateBase
+}
+
+func (s *providerOverridesState) InitState() {
+	activeOverrideScopes.Add(1)
+	s.OnDispose(func() { activeOverrideScopes.Add(-1) })
+}
+
+func (s *providerOverridesState) Build(ctx BuildContext) Widget {
+	w := s.Element().Widget().(ProviderOverrides)
+	values := make(map[reflect.Type]any, len(w.Overrides))
+	if parent, ok := ctx.DependOnInherited(overrideScopeType, nil).(overrideScope); ok {
+		maps.Copy(values, parent.values)
+	}
+	for _, o := range w.Overrides {
+		values[o.valueType] = o.value
+	}
+	return overrideScope{values: values, child: w.Child}
+}
+
+// overrideScope publishes the merged overrides of a ProviderOverrides.
+type overrideScope struct {
+	InheritedBase
+	values map[reflect.Type]any
+	child  Widget
+}
+
+var overrideScopeType = reflect.TypeFor[overrideScope]()
+
+func (o overrideScope) ChildWidget() Widget { return o.child }
+
+// ShouldRebuildDependents always returns true: override values need not be
+// comparable, and override scopes only rebuild in tests and previews.
+func (o overrideScope) ShouldRebuildDependents(oldWidget InheritedWidget) bool {
+	return true
+}
+
+// overriddenValue returns the value an enclosing ProviderOverrides sets for T.
+func overriddenValue[T any](ctx BuildContext) (T, bool) {
+	var zero T
+	scope, ok := ctx.DependOnInherited(overrideScopeType, nil).(overrideScope)
+	if !ok {
+		return zero, false
+	}
+	value, ok := scope.values[reflect.TypeFor[T]()]
+	if !ok {
+		return zero, false
+	}
+	if value == nil {
+		return zero, true
+	}
+	return value.(T), true
+}
+
+// Provider creates a value, such as a service, repository, or view model,
+// and provides it to its subtree. Descendants read it with [ProviderOf] or
+// [Provide], so it no longer has to be passed through every constructor in
+// between:
+//
+//	core.Provider[*CartModel]{
+//	    Create: func(ctx core.BuildContext) *CartModel {
+//	        return NewCartModel(core.ProviderOf[api.Client](ctx))
+//	    },
+//	    Child: ShopPage{},
+//	}
+//
+// Create runs once, the first time a descendant reads the value, with the
+// provider's own context, so it can read values provided above it. The value
+// then lives as long as the provider stays mounted: rebuilding the provider
+// with a new Create function keeps it. When the provider is unmounted, the
+// value is passed to Dispose, or has its Dispose method called if it
+// implements [Disposable] and Dispose is nil. A value that is never read is
+// never created or disposed.
+//
+// To provide a value the caller owns, use [InheritedProvider] instead.
+type Provider[T any] struct {
+	StatefulBase
+
+	// Create builds the value. Required.
+	Create func(ctx BuildContext) T
+
+	// Dispose releases the value when the provider is unmounted. If nil, a
+	// value implementing [Disposable] is disposed.
+	Dispose func(value T)
+
+	// Eager creates the value when the provider mounts instead of on first
+	// read, for services that must start work before anything uses them.
+	Eager bool
+
+	// Child is the child widget tree.
+	Child Widget
+}
+
+// CreateState implements StatefulWidget.
+func (Provider[T]) CreateState() State {
+	return &providerState[T]{}
+}
+
+// WithChild implements [NestedProvider].
+func (p Provider[T]) WithChild(child Widget) Widget {
+	p.Child = child
+	return p
+}
+
+// activeProviders counts mounted Provider widgets so that Provide only looks
+// for them when some exist.
+var activeProviders atomic.Int32
+
+type providerState[T any] struct {
+	StateBase
+	created bool
+	val     T
+}
+
+func (s *providerState[T]) InitState() {
+	activeProviders.Add(1)
+	s.OnDispose(func() {
+		activeProviders.Add(-1)
+		if !s.created {
+			return
+		}
+		w := s.Element().Widget().(Provider[T])
+		if w.Dispose != nil {
+			w.Dispose(s.val)
+		} else if d, ok := any(s.val).(Disposable); ok {
+			d.Dispose()
+		}
+	})
+}
+
+// value returns the provided value, creating it on first use.
+func (s *providerState[T]) value() T {
+	if !s.created {
+		w := s.Element().Widget().(Provider[T])
+		s.val = w.Create(s.Element())
+		s.created = true
+	}
+	return s.val
+}
+
+func (s *providerState[T]) Build(ctx BuildContext) Widget {
+	w := s.Element().Widget().(Provider[T])
+	if w.Eager {
+		s.value()
+	}
+	return providerScope[T]{state: s, child: w.Child}
+}
+
+// providerScope publishes the value of a Provider to its subtree.
+type providerScope[T any] struct {
+	InheritedBase
+	state *providerState[T]
+	child Widget
+}
+
+func (p providerScope[T]) ChildWidget() Widget { return p.child }
+
+// ShouldRebuildDependents returns false: a Provider's value never changes
+// once created. Values that change over time should be listenable, like
+// [Signal] or [Notifier], and consumers subscribe to them.
+func (p providerScope[T]) ShouldRebuildDependents(oldWidget InheritedWidget) bool {
+	return false
+}
+
+// providedValue returns the value of the nearest Provider of T, unless an
+// InheritedProvider[T] is nearer.
+func providedValue[T any](ctx BuildContext) (T, bool) {
+	var zero T
+	scopeType := reflect.TypeFor[providerScope[T]]()
+	inheritedType := reflect.TypeFor[InheritedProvider[T]]()
+	nearest := ctx.FindAncestor(func(e Element) bool {
+		inherited, ok := e.(*InheritedElement)
+		if !ok {
+			return false
+		}
+		t := reflect.TypeOf(inherited.widget)
+		return t == scopeType || t == inheritedType
+	})
+	if nearest == nil || reflect.TypeOf(nearest.Widget()) != scopeType {
+		return zero, false
+	}
+	scope := ctx.DependOnInherited(scopeType, nil).(providerScope[T])
+	return scope.state.value(), true
+}
+
+// ProviderOf returns the value provided for T by the nearest [Provider] or
+// [InheritedProvider] above ctx, creating it if this is the first read.
+// It panics if there is none, as a missing service is a wiring bug; use
+// [Provide] when the value is optional.
+//
+//	cart := core.ProviderOf[*CartModel](ctx)
+func ProviderOf[T any](ctx BuildContext) T {
+	return MustProvide[T](ctx)
+}
+
+// NestedProvider is a widget that provides values to a single child and can
+// be nested by [MultiProvider]. [Provider], [InheritedProvider], and
+// [ProviderOverrides] implement it.
+type NestedProvider interface {
+	Widget
+
+	// WithChild returns a copy of the widget with its child set to child.
+	WithChild(child Widget) Widget
+}
+
+// MultiProvider nests several providers around Child, so an app can set up
+// its services in one list instead of a deeply indented tree:
+//
+//	core.MultiProvider{
+//	    Providers: []core.NestedProvider{
+//	        core.InheritedProvider[api.Client]{Value: client},
+//	        core.Provider[*AuthService]{Create: NewAuthService},
+//	        core.Provider[*CartModel]{Create: NewCartModel},
+//	    },
+//	    Child: App{},
+//	}
+//
+// Providers wrap each other in order, so each can read the values of those
+// listed before it. Each provider's own Child is ignored. Keep the list
+// stable across rebuilds: inserting or removing an entry remounts the
+// providers after it and recreates their values.
+type MultiProvider struct {
+	StatelessBase
+
+	// Providers lists the providers, outermost first.
+	Providers []NestedProvider
+
+	// Child is the child widget tree.
+	Child Widget
+}
+
+// Build implements StatelessWidget.
+func (m MultiProvider) Build(ctx BuildContext) Widget {
+	child := m.Child
+	for i := len(m.Providers) - 1; i >= 0; i-- {
+		child = m.Providers[i].WithChild(child)
+	}
+	return child
+}
+
+// WithChild implements [NestedProvider].
+func (p InheritedProvider[T]) WithChild(child Widget) Widget {
+	p.Child = child
+	return p
+}
+
+// WithChild implements [NestedProvider].
+func (o ProviderOverrides) WithChild(child Widget) Widget {
+	o.Child = child
+	return o
+}
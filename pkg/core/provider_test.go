@@ -363,3 +363,285 @@ func TestInheritedProvider_WrongOldWidgetType(t *testing.T) {
 		t.Error("expected ShouldRebuild to return true for different widget types")
 	}
 }
+
+func TestProviderOverrides_ReplacesNestedProvider(t *testing.T) {
+	real := &testUser{ID: 1, Name: "Real"}
+	fake := &testUser{ID: 2, Name: "Fake"}
+	settings := &testSettings{Theme: "dark"}
+	var capturedUser *testUser
+	var capturedSettings *testSettings
+
+	// The app mounts its own providers below the override.
+	widget := ProviderOverrides{
+		Overrides: []Override{OverrideProvider(fake)},
+		Child: InheritedProvider[*testUser]{
+			Value: real,
+			Child: InheritedProvider[*testSettings]{
+				Value: settings,
+				Child: testStatelessWidget{
+					buildFn: func(ctx BuildContext) Widget {
+						capturedUser = MustProvide[*testUser](ctx)
+						capturedSettings = MustProvide[*testSettings](ctx)
+						return nil
+					},
+				},
+			},
+		},
+	}
+
+	element := newTestStatefulElement(widget, NewBuildOwner())
+	element.Mount(nil, nil)
+
+	if capturedUser != fake {
+		t.Errorf("expected overridden user %v, got %v", fake, capturedUser)
+	}
+	if capturedSettings != settings {
+		t.Errorf("expected non-overridden settings to pass through, got %v", capturedSettings)
+	}
+
+	element.Unmount()
+	if n := activeOverrideScopes.Load(); n != 0 {
+		t.Errorf("expected no active override scopes after unmount, got %d", n)
+	}
+}
+
+func TestProviderOverrides_NestedScopesMerge(t *testing.T) {
+	outer := &testUser{ID: 1, Name: "Outer"}
+	inner := &testUser{ID: 2, Name: "Inner"}
+	settings := &testSettings{Theme: "light"}
+	var capturedUser *testUser
+	var capturedSettings *testSettings
+	var settingsOK bool
+
+	widget := ProviderOverrides{
+		Overrides: []Override{
+			OverrideProvider(outer),
+			OverrideProvider(settings),
+		},
+		Child: ProviderOverrides{
+			Overrides: []Override{OverrideProvider(inner)},
+			Child: testStatelessWidget{
+				buildFn: func(ctx BuildContext) Widget {
+					capturedUser, _ = Provide[*testUser](ctx)
+					capturedSettings, settingsOK = Provide[*testSettings](ctx)
+					return nil
+				},
+			},
+		},
+	}
+
+	element := newTestStatefulElement(widget, NewBuildOwner())
+	element.Mount(nil, nil)
+	defer element.Unmount()
+
+	if capturedUser != inner {
+		t.Errorf("expected nearest override %v, got %v", inner, capturedUser)
+	}
+	if !settingsOK || capturedSettings != settings {
+		t.Errorf("expected outer settings override without a provider, got %v, %v", capturedSettings, settingsOK)
+	}
+}
+
+// testService is a Disposable value for Provider tests.
+type testService struct {
+	name     string
+	disposed bool
+}
+
+func (s *testService) Dispose() { s.disposed = true }
+
+func TestProvider_CreatesLazilyAndDisposesOnUnmount(t *testing.T) {
+	var service *testService
+	creates := 0
+	reads := 0
+
+	widget := Provider[*testService]{
+		Create: func(ctx BuildContext) *testService {
+			creates++
+			service = &testService{name: "api"}
+			return service
+		},
+		Child: testStatelessWidget{
+			buildFn: func(ctx BuildContext) Widget {
+				if ProviderOf[*testService](ctx) != service {
+					t.Error("expected ProviderOf to return the created service")
+				}
+				if got := MustProvide[*testService](ctx); got != service {
+					t.Errorf("expected MustProvide to see the same service, got %v", got)
+				}
+				reads++
+				return nil
+			},
+		},
+	}
+
+	element := newTestStatefulElement(widget, NewBuildOwner())
+	element.Mount(nil, nil)
+
+	if reads != 1 || creates != 1 {
+		t.Fatalf("expected one read and one create, got %d reads, %d creates", reads, creates)
+	}
+	if service.disposed {
+		t.Fatal("service disposed while mounted")
+	}
+
+	element.Unmount()
+	if !service.disposed {
+		t.Error("expected service to be disposed on unmount")
+	}
+	if n := activeProviders.Load(); n != 0 {
+		t.Errorf("expected no active providers after unmount, got %d", n)
+	}
+}
+
+func TestProvider_UnreadValueIsNeverCreated(t *testing.T) {
+	created := false
+	widget := Provider[*testService]{
+		Create: func(ctx BuildContext) *testService {
+			created = true
+			return &testService{}
+		},
+		Child: testStatelessWidget{buildFn: func(ctx BuildContext) Widget { return nil }},
+	}
+
+	element := newTestStatefulElement(widget, NewBuildOwner())
+	element.Mount(nil, nil)
+	element.Unmount()
+
+	if created {
+		t.Error("expected an unread value not to be created")
+	}
+
+	widget.Eager = true
+	element = newTestStatefulElement(widget, NewBuildOwner())
+	element.Mount(nil, nil)
+	defer element.Unmount()
+	if !created {
+		t.Error("expected Eager to create the value on mount")
+	}
+}
+
+func TestProvider_CustomDispose(t *testing.T) {
+	var disposed *testUser
+	user := &testUser{ID: 1}
+	widget := Provider[*testUser]{
+		Create:  func(ctx BuildContext) *testUser { return user },
+		Dispose: func(u *testUser) { disposed = u },
+		Eager:   true,
+	}
+
+	element := newTestStatefulElement(widget, NewBuildOwner())
+	element.Mount(nil, nil)
+	element.Unmount()
+
+	if disposed != user {
+		t.Errorf("expected Dispose to receive %v, got %v", user, disposed)
+	}
+}
+
+func TestProvider_NearestProviderWins(t *testing.T) {
+	outer := &testUser{ID: 1, Name: "Outer"}
+	inner := &testUser{ID: 2, Name: "Inner"}
+	var got []*testUser
+
+	consumer := testStatelessWidget{
+		buildFn: func(ctx BuildContext) Widget {
+			got = append(got, ProviderOf[*testUser](ctx))
+			return nil
+		},
+	}
+
+	// An InheritedProvider nearer than a Provider wins, and vice versa.
+	trees := []StatefulWidget{
+		Provider[*testUser]{
+			Create: func(ctx BuildContext) *testUser { return outer },
+			Child:  InheritedProvider[*testUser]{Value: inner, Child: consumer},
+		},
+		ProviderOverrides{
+			Child: InheritedProvider[*testUser]{
+				Value: outer,
+				Child: Provider[*testUser]{
+					Create: func(ctx BuildContext) *testUser { return inner },
+					Child:  consumer,
+				},
+			},
+		},
+	}
+	for _, tree := range trees {
+		element := newTestStatefulElement(tree, NewBuildOwner())
+		element.Mount(nil, nil)
+		element.Unmount()
+	}
+
+	if len(got) != 2 || got[0] != inner || got[1] != inner {
+		t.Errorf("expected the inner value from both trees, got %v", got)
+	}
+}
+
+func TestProvider_OverrideSkipsCreate(t *testing.T) {
+	fake := &testService{name: "fake"}
+	var got *testService
+
+	widget := ProviderOverrides{
+		Overrides: []Override{OverrideProvider(fake)},
+		Child: Provider[*testService]{
+			Create: func(ctx BuildContext) *testService {
+				t.Error("expected Create not to run for an overridden type")
+				return nil
+			},
+			Child: testStatelessWidget{
+				buildFn: func(ctx BuildContext) Widget {
+					got = ProviderOf[*testService](ctx)
+					return nil
+				},
+			},
+		},
+	}
+
+	element := newTestStatefulElement(widget, NewBuildOwner())
+	element.Mount(nil, nil)
+	element.Unmount()
+
+	if got != fake {
+		t.Errorf("expected override %v, got %v", fake, got)
+	}
+	if fake.disposed {
+		t.Error("expected the override not to be disposed by the provider")
+	}
+}
+
+func TestMultiProvider_NestsInOrder(t *testing.T) {
+	settings := &testSettings{Theme: "dark"}
+	var gotUser *testUser
+	var gotSettings *testSettings
+
+	widget := MultiProvider{
+		Providers: []NestedProvider{
+			InheritedProvider[*testSettings]{Value: settings},
+			Provider[*testUser]{
+				// Later providers can read earlier ones.
+				Create: func(ctx BuildContext) *testUser {
+					return &testUser{Name: ProviderOf[*testSettings](ctx).Theme}
+				},
+			},
+		},
+		Child: testStatelessWidget{
+			buildFn: func(ctx BuildContext) Widget {
+				gotUser = ProviderOf[*testUser](ctx)
+				gotSettings = ProviderOf[*testSettings](ctx)
+				return nil
+			},
+		},
+	}
+
+	element := newTestStatelessElement(widget, NewBuildOwner())
+	element.Mount(nil, nil)
+	defer element.Unmount()
+
+	if gotSettings != settings {
+		t.Errorf("expected settings %v, got %v", settings, gotSettings)
+	}
+	if gotUser == nil || gotUser.Name != "dark" {
+		t.Errorf("expected user created from settings, got %v", gotUser)
+	}
+}
@@ -0,0 +1,38 @@
+package core
+
+// Reassembler is implemented by a [State] that caches values derived from
+// code or loaded resources, such as precomputed styles, and must refresh
+// them when the tree is reassembled.
+type Reassembler interface {
+	// Reassemble is called before the state's element is rebuilt by
+	// [Reassemble].
+	Reassemble()
+}
+
+// Reassemble marks root and every element below it for rebuild, so each
+// Build method runs again on the next frame. Elements and their State
+// objects are kept, along with the controllers the states own, so the UI
+// picks up reloaded resources without losing scroll positions, text input,
+// or animation progress. States that implement [Reassembler] are notified
+// first.
+//
+// Call Reassemble on the UI thread between frames. Apps use
+// engine.Reassemble, which schedules it on the running app.
+func Reassemble(root Element) {
+	if root == nil {
+		return
+	}
+	if e, ok := root.(*StatefulElement); ok && e.state != nil {
+		if r, ok := e.state.(Reassembler); ok {
+			exitOwner := e.enterOwnerScope()
+			r.Reassemble()
+			exitOwner()
+		}
+	}
+	noteRebuild(root, RebuildReasonReassemble, "")
+	root.MarkNeedsBuild()
+	root.VisitChildren(func(child Element) bool {
+		Reassemble(child)
+		return true
+	})
+}
@@ -0,0 +1,54 @@
+package core
+
+import "testing"
+
+type reassembleState struct {
+	StateBase
+	builds      *int
+	reassembles int
+	child       Widget
+}
+
+func (s *reassembleState) Build(ctx BuildContext) Widget {
+	*s.builds++
+	return s.child
+}
+
+func (s *reassembleState) Reassemble() {
+	s.reassembles++
+}
+
+func TestReassemble_RebuildsTreeAndKeepsState(t *testing.T) {
+	owner := NewBuildOwner()
+	outerBuilds, leafBuilds := 0, 0
+	var outer *reassembleState
+	widget := testStatefulWidget{
+		createStateFn: func() State {
+			outer = &reassembleState{
+				builds: &outerBuilds,
+				child: testStatelessWidget{buildFn: func(ctx BuildContext) Widget {
+					leafBuilds++
+					return nil
+				}},
+			}
+			return outer
+		},
+	}
+	element := newTestStatefulElement(widget, owner)
+	element.setSelf(element)
+	element.Mount(nil, nil)
+	mounted := outer
+
+	Reassemble(element)
+	owner.FlushBuild()
+
+	if outer != mounted {
+		t.Error("expected the state to be kept")
+	}
+	if outer.reassembles != 1 {
+		t.Errorf("expected Reassemble to be called once, got %d", outer.reassembles)
+	}
+	if outerBuilds != 2 || leafBuilds != 2 {
+		t.Errorf("expected every element to build again, got %d and %d builds", outerBuilds, leafBuilds)
+	}
+}
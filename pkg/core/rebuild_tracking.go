@@ -0,0 +1,173 @@
+package core
+
+import "sync/atomic"
+
+// RebuildReason describes what caused an element to build again.
+type RebuildReason int
+
+const (
+	// RebuildReasonOther means MarkNeedsBuild was called directly, for
+	// example by a listener, hook, or observable.
+	RebuildReasonOther RebuildReason = iota
+	// RebuildReasonSetState means the element's State called SetState.
+	RebuildReasonSetState
+	// RebuildReasonDependency means an inherited widget the element depends
+	// on changed.
+	RebuildReasonDependency
+	// RebuildReasonParent means the parent rebuilt and passed a new widget.
+	RebuildReasonParent
+	// RebuildReasonLayout means a layout builder received new constraints.
+	RebuildReasonLayout
+	// RebuildReasonReassemble means the tree was reassembled for a reload.
+	RebuildReasonReassemble
+
+	rebuildReasonCount
+)
+
+// String returns a short name for the reason, such as "setState".
+func (r RebuildReason) String() string {
+	switch r {
+	case RebuildReasonSetState:
+		return "setState"
+	case RebuildReasonDependency:
+		return "dependency"
+	case RebuildReasonParent:
+		return "parent"
+	case RebuildReasonLayout:
+		return "layout"
+	case RebuildReasonReassemble:
+		return "reassemble"
+	default:
+		return "other"
+	}
+}
+
+// RebuildInfo holds the rebuilds recorded for one element while rebuild
+// tracking is enabled. The initial build when the element is mounted is not
+// counted.
+type RebuildInfo struct {
+	// Count is the number of times the element built again.
+	Count int
+	// ByReason breaks Count down by what triggered each rebuild.
+	ByReason map[RebuildReason]int
+	// LastReason is what triggered the most recent rebuild.
+	LastReason RebuildReason
+	// LastCause names the widget type behind the most recent rebuild: the
+	// inherited widget for RebuildReasonDependency and the parent's widget
+	// for RebuildReasonParent. It is empty for other reasons.
+	LastCause string
+}
+
+// rebuildRecord is the per-element storage behind [RebuildInfo]. It is
+// allocated the first time a tracked element is marked or built.
+type rebuildRecord struct {
+	pending      RebuildReason
+	pendingCause string
+	hasPending   bool
+	count        int
+	byReason     [rebuildReasonCount]int
+	last         RebuildReason
+	lastCause    string
+}
+
+var rebuildTracking atomic.Bool
+
+// SetRebuildTracking enables or disables rebuild tracking. While enabled,
+// each element counts how often it builds again and records whether the
+// rebuild came from SetState, an inherited widget change, its parent, or
+// something else. Read the results with [RebuildInfoOf].
+//
+// Tracking costs a little time and memory per build, so it is meant for
+// debugging and tests. The engine enables it with the debug server, and
+// widget testers enable it for their lifetime.
+func SetRebuildTracking(enabled bool) {
+	rebuildTracking.Store(enabled)
+}
+
+// RebuildTrackingEnabled reports whether rebuild tracking is enabled.
+func RebuildTrackingEnabled() bool {
+	return rebuildTracking.Load()
+}
+
+// RebuildInfoOf returns the rebuilds recorded for e. It returns a zero
+// RebuildInfo for elements that have not rebuilt while tracking was enabled.
+func RebuildInfoOf(e Element) RebuildInfo {
+	eb := baseOf(e)
+	if eb == nil || eb.rebuilds == nil {
+		return RebuildInfo{}
+	}
+	r := eb.rebuilds
+	info := RebuildInfo{
+		Count:      r.count,
+		LastReason: r.last,
+		LastCause:  r.lastCause,
+	}
+	for reason, n := range r.byReason {
+		if n > 0 {
+			if info.ByReason == nil {
+				info.ByReason = make(map[RebuildReason]int)
+			}
+			info.ByReason[RebuildReason(reason)] = n
+		}
+	}
+	return info
+}
+
+// ResetRebuildCounts clears the rebuilds recorded for root and its
+// descendants.
+func ResetRebuildCounts(root Element) {
+	if root == nil {
+		return
+	}
+	visitSubtree(root, func(e Element) {
+		if eb := baseOf(e); eb != nil {
+			eb.rebuilds = nil
+		}
+	})
+}
+
+// noteRebuild records why e is about to be marked for rebuild. The first
+// reason noted before the element builds wins; callers note the reason
+// before calling MarkNeedsBuild.
+func noteRebuild(e Element, reason RebuildReason, cause string) {
+	if !rebuildTracking.Load() {
+		return
+	}
+	eb := baseOf(e)
+	if eb == nil {
+		return
+	}
+	if eb.rebuilds == nil {
+		eb.rebuilds = &rebuildRecord{}
+	}
+	r := eb.rebuilds
+	if r.hasPending {
+		return
+	}
+	r.pending, r.pendingCause, r.hasPending = reason, cause, true
+}
+
+// recordBuild consumes the reason noted for the build about to run and, if
+// the element has built before, counts it as a rebuild.
+func (e *elementBase) recordBuild() {
+	firstBuild := !e.built
+	e.built = true
+	if !rebuildTracking.Load() {
+		if e.rebuilds != nil {
+			e.rebuilds.hasPending = false
+		}
+		return
+	}
+	if e.rebuilds == nil {
+		e.rebuilds = &rebuildRecord{}
+	}
+	r := e.rebuilds
+	reason, cause := r.pending, r.pendingCause
+	r.pending, r.pendingCause, r.hasPending = RebuildReasonOther, "", false
+	if firstBuild {
+		return
+	}
+	r.count++
+	r.byReason[reason]++
+	r.last, r.lastCause = reason, cause
+}
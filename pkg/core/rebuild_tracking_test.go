@@ -0,0 +1,113 @@
+package core
+
+import "testing"
+
+func enableRebuildTrackingForTest(t *testing.T) {
+	t.Helper()
+	prev := RebuildTrackingEnabled()
+	SetRebuildTracking(true)
+	t.Cleanup(func() { SetRebuildTracking(prev) })
+}
+
+func TestRebuildTracking_RecordsDependencyCause(t *testing.T) {
+	enableRebuildTrackingForTest(t)
+	owner := NewBuildOwner()
+
+	consumer := testStatelessWidget{
+		buildFn: func(ctx BuildContext) Widget {
+			SelectInherited(ctx, func(p pairInherited) int { return p.a })
+			return nil
+		},
+	}
+	element := newTestInheritedElement(pairInherited{a: 1, child: consumer}, owner)
+	element.Mount(nil, nil)
+	dep := element.child.(*StatelessElement)
+
+	if info := RebuildInfoOf(dep); info.Count != 0 {
+		t.Fatalf("expected the initial build not to count, got %d", info.Count)
+	}
+
+	element.Update(pairInherited{a: 2, child: consumer})
+	dep.RebuildIfNeeded()
+
+	info := RebuildInfoOf(dep)
+	if info.Count != 1 {
+		t.Fatalf("expected 1 rebuild, got %d", info.Count)
+	}
+	if info.LastReason != RebuildReasonDependency {
+		t.Errorf("expected reason dependency, got %v", info.LastReason)
+	}
+	if info.LastCause != "core.pairInherited" {
+		t.Errorf("expected cause core.pairInherited, got %q", info.LastCause)
+	}
+	if info.ByReason[RebuildReasonDependency] != 1 {
+		t.Errorf("expected 1 dependency rebuild, got %v", info.ByReason)
+	}
+}
+
+func TestRebuildTracking_SetStateAndReset(t *testing.T) {
+	enableRebuildTrackingForTest(t)
+	owner := NewBuildOwner()
+
+	state := &testState{}
+	element := inflateWidget(testStatefulWidget{createStateFn: func() State { return state }}, owner)
+	element.Mount(nil, nil)
+
+	for range 2 {
+		state.SetState(nil)
+		owner.FlushBuild()
+	}
+	info := RebuildInfoOf(element)
+	if info.Count != 2 || info.ByReason[RebuildReasonSetState] != 2 {
+		t.Fatalf("expected 2 setState rebuilds, got %+v", info)
+	}
+
+	ResetRebuildCounts(element)
+	if info := RebuildInfoOf(element); info.Count != 0 {
+		t.Errorf("expected reset to clear counts, got %d", info.Count)
+	}
+
+	element.MarkNeedsBuild()
+	owner.FlushBuild()
+	if info := RebuildInfoOf(element); info.LastReason != RebuildReasonOther {
+		t.Errorf("expected a direct MarkNeedsBuild to be other, got %v", info.LastReason)
+	}
+}
+
+func TestRebuildTracking_RecordsParentCause(t *testing.T) {
+	enableRebuildTrackingForTest(t)
+	owner := NewBuildOwner()
+
+	child := testStatelessWidget{buildFn: func(BuildContext) Widget { return nil }}
+	state := &testState{buildFn: func(BuildContext) Widget { return child }}
+	element := inflateWidget(testStatefulWidget{createStateFn: func() State { return state }}, owner)
+	element.Mount(nil, nil)
+
+	state.SetState(nil)
+	owner.FlushBuild()
+
+	info := RebuildInfoOf(element.(*StatefulElement).child)
+	if info.Count != 1 || info.LastReason != RebuildReasonParent {
+		t.Fatalf("expected 1 parent rebuild, got %+v", info)
+	}
+	if info.LastCause != "core.testStatefulWidget" {
+		t.Errorf("expected cause core.testStatefulWidget, got %q", info.LastCause)
+	}
+}
+
+func TestRebuildTracking_DisabledRecordsNothing(t *testing.T) {
+	prev := RebuildTrackingEnabled()
+	SetRebuildTracking(false)
+	t.Cleanup(func() { SetRebuildTracking(prev) })
+	owner := NewBuildOwner()
+
+	state := &testState{}
+	element := inflateWidget(testStatefulWidget{createStateFn: func() State { return state }}, owner)
+	element.Mount(nil, nil)
+	state.SetState(nil)
+	owner.FlushBuild()
+
+	if info := RebuildInfoOf(element); info.Count != 0 {
+		t.Errorf("expected no rebuilds recorded, got %d", info.Count)
+	}
+}
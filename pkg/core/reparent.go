@@ -0,0 +1,234 @@
+package core
+
+// Reparenting lets an element whose widget has a [GlobalKey] move to a new
+// position in the tree, keeping its State and subtree. When such an element
+// is removed during a build, it is deactivated instead of unmounted: its
+// render objects leave the render tree and it waits in the BuildOwner's
+// inactive list. If a widget with the same key is inflated elsewhere in the
+// same build, the element is taken back, attached under its new parent, and
+// updated with the new widget. Elements left over when the build finishes
+// are unmounted.
+
+// childForgetter is implemented by elements that can drop a child that has
+// moved to another parent, without unmounting it.
+type childForgetter interface {
+	forgetChild(child Element)
+}
+
+func (e *StatelessElement) forgetChild(child Element) {
+	if e.child == child {
+		e.child = nil
+	}
+}
+
+func (e *StatefulElement) forgetChild(child Element) {
+	if e.child == child {
+		e.child = nil
+	}
+}
+
+func (e *InheritedElement) forgetChild(child Element) {
+	if e.child == child {
+		e.child = nil
+	}
+}
+
+func (e *LayoutBuilderElement) forgetChild(child Element) {
+	if e.child == child {
+		e.child = nil
+	}
+}
+
+func (e *RenderObjectElement) forgetChild(child Element) {
+	for i, c := range e.children {
+		if c == child {
+			e.children = append(e.children[:i:i], e.children[i+1:]...)
+			return
+		}
+	}
+}
+
+func (e *elementBase) base() *elementBase {
+	return e
+}
+
+// baseOf returns the shared element fields of e, or nil for element types
+// defined outside this package.
+func baseOf(e Element) *elementBase {
+	if b, ok := e.(interface{ base() *elementBase }); ok {
+		return b.base()
+	}
+	return nil
+}
+
+// removeChild removes child of parent from the tree. While a build is
+// running and child's subtree holds a global key, child is parked for reuse;
+// otherwise it is unmounted right away. A child that a global key has
+// already moved to another parent is left alone.
+func removeChild(parent, child Element, owner *BuildOwner) {
+	if eb := baseOf(child); eb != nil && eb.parent != parent {
+		return
+	}
+	if owner == nil || owner.building == 0 || !owner.holdsGlobalKeyIn(child) {
+		child.Unmount()
+		return
+	}
+	detachRenderObjects(child)
+	setSubtreeMounted(child, false)
+	owner.inactive = append(owner.inactive, child)
+}
+
+// holdsGlobalKeyIn reports whether an element registered with a global key
+// is root or one of its descendants.
+func (b *BuildOwner) holdsGlobalKeyIn(root Element) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, element := range b.globalKeys {
+		if isAncestorOrSelf(root, element) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAncestorOrSelf reports whether ancestor is element or one of its
+// ancestors.
+func isAncestorOrSelf(ancestor, element Element) bool {
+	for current := element; current != nil; {
+		if current == ancestor {
+			return true
+		}
+		base, ok := current.(interface{ parentElement() Element })
+		if !ok {
+			return false
+		}
+		current = base.parentElement()
+	}
+	return false
+}
+
+// retakeGlobalKey returns the element registered for widget's global key,
+// moved under parent at slot and updated with widget, or nil if widget has
+// no global key or its element can't be reused.
+func (b *BuildOwner) retakeGlobalKey(widget Widget, parent Element, slot any) Element {
+	if b == nil || b.building == 0 {
+		return nil
+	}
+	gk, ok := widget.Key().(globalKeyRegistry)
+	if !ok {
+		return nil
+	}
+	b.mu.Lock()
+	element := b.globalKeys[gk.globalKeyImpl()]
+	b.mu.Unlock()
+	if element == nil || !canUpdateWidget(element.Widget(), widget) {
+		return nil
+	}
+	// An element can't move into its own subtree.
+	if parent != nil && isAncestorOrSelf(element, parent) {
+		return nil
+	}
+	eb := baseOf(element)
+	if eb == nil {
+		return nil
+	}
+
+	if oldParent, ok := eb.parent.(childForgetter); ok {
+		oldParent.forgetChild(element)
+	}
+	detachRenderObjects(element)
+	for i, inactive := range b.inactive {
+		if inactive == element {
+			b.inactive = append(b.inactive[:i:i], b.inactive[i+1:]...)
+			break
+		}
+	}
+
+	eb.parent = parent
+	eb.slot = slot
+	setSubtreeMounted(element, true)
+	updateSubtreeDepth(element)
+	attachRenderObjects(element)
+	// Inherited widgets above the new position may differ from the old ones.
+	visitSubtree(element, notifyDependent)
+	element.Update(widget)
+	return element
+}
+
+// finalizeInactive unmounts the elements deactivated during the build that
+// were not moved elsewhere.
+func (b *BuildOwner) finalizeInactive() {
+	inactive := b.inactive
+	b.inactive = nil
+	for _, element := range inactive {
+		element.Unmount()
+	}
+}
+
+// detachRenderObjects removes the top-level render object of e's subtree
+// from its render parent.
+func detachRenderObjects(e Element) {
+	if host, ok := e.(renderObjectHost); ok {
+		if eb := baseOf(e); eb != nil && eb.renderParent != nil {
+			eb.renderParent.removeRenderObjectChild(host.RenderObject(), eb.slot)
+			eb.renderParent = nil
+		}
+		return
+	}
+	e.VisitChildren(func(child Element) bool {
+		detachRenderObjects(child)
+		return true
+	})
+}
+
+// attachRenderObjects inserts the top-level render object of e's subtree
+// into the render parent found above e, refreshing the cached render parent
+// of the component elements on the way.
+func attachRenderObjects(e Element) {
+	eb := baseOf(e)
+	if eb == nil {
+		return
+	}
+	eb.renderParent = eb.findRenderParent()
+	if host, ok := e.(renderObjectHost); ok {
+		if eb.renderParent != nil {
+			eb.renderParent.insertRenderObjectChild(host.RenderObject(), eb.slot)
+		}
+		return
+	}
+	e.VisitChildren(func(child Element) bool {
+		attachRenderObjects(child)
+		return true
+	})
+}
+
+func setSubtreeMounted(e Element, mounted bool) {
+	visitSubtree(e, func(element Element) {
+		if eb := baseOf(element); eb != nil {
+			eb.mounted = mounted
+		}
+	})
+}
+
+func updateSubtreeDepth(e Element) {
+	visitSubtree(e, func(element Element) {
+		eb := baseOf(element)
+		if eb == nil {
+			return
+		}
+		if eb.parent != nil {
+			eb.depth = eb.parent.Depth() + 1
+		} else {
+			eb.depth = 0
+		}
+	})
+}
+
+// visitSubtree calls fn for e and its descendants, parents first.
+func visitSubtree(e Element, fn func(Element)) {
+	fn(e)
+	e.VisitChildren(func(child Element) bool {
+		visitSubtree(child, fn)
+		return true
+	})
+}
@@ -55,7 +55,8 @@ func (s *Signal[T]) Value() T {
 }
 
 // Set updates the value and notifies all listeners. Notification is skipped
-// when the old and new values are equal. By default, values are compared with
+// when the old and new values are equal, and deferred until the end of the
+// enclosing [Batch], if any. By default, values are compared with
 // interface comparison (any(old) == any(new)), which works for all comparable
 // types. For non-comparable types (slices, maps), provide a custom equality
 // function via [NewSignalWithEquality] to avoid a runtime panic.
@@ -75,16 +76,8 @@ func (s *Signal[T]) Set(value T) {
 		return
 	}
 	s.value = value
-	// Copy listeners to avoid holding lock during callbacks
-	listeners := make([]func(), 0, len(s.listeners))
-	for _, fn := range s.listeners {
-		listeners = append(listeners, fn)
-	}
 	s.mu.Unlock()
-
-	for _, fn := range listeners {
-		fn()
-	}
+	notifyOrDefer(s, s.notifyListeners)
 }
 
 // Update applies a transformation to the current value.
@@ -107,12 +100,27 @@ func (s *Signal[T]) Update(transform func(T) T) {
 		return
 	}
 	s.value = newValue
+	s.mu.Unlock()
+	notifyOrDefer(s, s.notifyListeners)
+}
+
+// SetAsync is [Signal.Set] for goroutines other than the UI thread. The
+// value is set on the UI thread during the next frame, so listeners that
+// rebuild widgets run where they must. Sets from the same goroutine are
+// applied in order.
+func (s *Signal[T]) SetAsync(value T) {
+	runOnUIThread(func() { s.Set(value) })
+}
+
+// notifyListeners calls the listeners registered when it runs.
+func (s *Signal[T]) notifyListeners() {
+	s.mu.RLock()
 	// Copy listeners to avoid holding lock during callbacks
 	listeners := make([]func(), 0, len(s.listeners))
 	for _, fn := range s.listeners {
 		listeners = append(listeners, fn)
 	}
-	s.mu.Unlock()
+	s.mu.RUnlock()
 
 	for _, fn := range listeners {
 		fn()
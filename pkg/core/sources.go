@@ -0,0 +1,59 @@
+package core
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// Clock provides the current time to widgets. Provide one with
+// InheritedProvider[Clock] (or override it with [OverrideProvider]) to
+// control what [ClockOf] returns in a subtree.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock reads the system time.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// ClockOf returns the nearest provided [Clock], or the system clock if none
+// is provided. Widgets that show or compare times should read the time from
+// it instead of calling time.Now, so tests and previews can fix the time.
+//
+//	now := core.ClockOf(ctx).Now()
+func ClockOf(ctx BuildContext) Clock {
+	if c, ok := Provide[Clock](ctx); ok && c != nil {
+		return c
+	}
+	return systemClock{}
+}
+
+// runtimeSource draws from the runtime's random generator, which is safe
+// for concurrent use.
+type runtimeSource struct{}
+
+func (runtimeSource) Uint64() uint64 { return rand.Uint64() }
+
+// RandOf returns a random generator backed by the nearest provided
+// rand.Source, or by the runtime's generator if none is provided. Widgets
+// that use randomness, such as jittered animations or placeholder content,
+// should draw from it so tests and previews can make it deterministic:
+//
+//	delay := time.Duration(core.RandOf(ctx).IntN(200)) * time.Millisecond
+//
+// Provide a seeded source with InheritedProvider[rand.Source]:
+//
+//	core.InheritedProvider[rand.Source]{
+//	    Value: rand.NewPCG(1, 2),
+//	    Child: child,
+//	}
+//
+// Seeded sources from math/rand/v2 are not safe for concurrent use, so only
+// draw from a provided source on the UI thread.
+func RandOf(ctx BuildContext) *rand.Rand {
+	if src, ok := Provide[rand.Source](ctx); ok && src != nil {
+		return rand.New(src)
+	}
+	return rand.New(runtimeSource{})
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time { return time.Time(c) }
+
+func TestClockOf_DefaultsToSystemClock(t *testing.T) {
+	var clock Clock
+	element := newTestStatelessElement(testStatelessWidget{
+		buildFn: func(ctx BuildContext) Widget {
+			clock = ClockOf(ctx)
+			return nil
+		},
+	}, NewBuildOwner())
+	element.Mount(nil, nil)
+
+	if since := time.Since(clock.Now()); since < 0 || since > time.Minute {
+		t.Errorf("expected the system time, got %v", clock.Now())
+	}
+}
+
+func TestClockOf_Provided(t *testing.T) {
+	fixed := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	var now time.Time
+	element := newTestInheritedElement(InheritedProvider[Clock]{
+		Value: fixedClock(fixed),
+		Child: testStatelessWidget{
+			buildFn: func(ctx BuildContext) Widget {
+				now = ClockOf(ctx).Now()
+				return nil
+			},
+		},
+	}, NewBuildOwner())
+	element.Mount(nil, nil)
+
+	if !now.Equal(fixed) {
+		t.Errorf("expected %v, got %v", fixed, now)
+	}
+}
+
+func TestRandOf_ProvidedSourceIsDeterministic(t *testing.T) {
+	draw := func() []int {
+		var values []int
+		element := newTestInheritedElement(InheritedProvider[rand.Source]{
+			Value: rand.NewPCG(7, 7),
+			Child: testStatelessWidget{
+				buildFn: func(ctx BuildContext) Widget {
+					r := RandOf(ctx)
+					for range 5 {
+						values = append(values, r.IntN(1000))
+					}
+					return nil
+				},
+			},
+		}, NewBuildOwner())
+		element.Mount(nil, nil)
+		return values
+	}
+
+	first, second := draw(), draw()
+	if len(first) != 5 {
+		t.Fatalf("expected 5 values, got %v", first)
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("expected the same sequence, got %v and %v", first, second)
+		}
+	}
+}
+
+func TestRandOf_DefaultsToRuntimeSource(t *testing.T) {
+	var r *rand.Rand
+	element := newTestStatelessElement(testStatelessWidget{
+		buildFn: func(ctx BuildContext) Widget {
+			r = RandOf(ctx)
+			return nil
+		},
+	}, NewBuildOwner())
+	element.Mount(nil, nil)
+
+	if r == nil {
+		t.Fatal("expected a random generator")
+	}
+	if v := r.Float64(); v < 0 || v >= 1 {
+		t.Errorf("expected a value in [0, 1), got %v", v)
+	}
+}
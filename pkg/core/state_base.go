@@ -52,6 +52,7 @@ func (s *StateBase) Element() *StatefulElement {
 // SetState is NOT thread-safe. It must only be called from the UI thread.
 // To update state from a background goroutine, use drift.Dispatch.
 func (s *StateBase) SetState(fn func()) {
+	checkSetStateThread(s.element)
 	if s.disposed {
 		return
 	}
@@ -59,6 +60,7 @@ func (s *StateBase) SetState(fn func()) {
 		fn()
 	}
 	if s.element != nil {
+		noteRebuild(s.element, RebuildReasonSetState, "")
 		s.element.MarkNeedsBuild()
 	}
 }
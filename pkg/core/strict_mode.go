@@ -0,0 +1,81 @@
+package core
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+// strictBuild tracks the element whose Build is running and whether
+// inherited lookups are allowed, for strict mode checks. It is only
+// maintained while strict mode is enabled and is accessed on the UI thread.
+var strictBuild struct {
+	building        Element
+	dependencyScope int
+}
+
+// enterStrictBuild records element as building until the returned function
+// is called.
+func enterStrictBuild(element Element) (exit func()) {
+	if !errors.StrictModeEnabled() {
+		return func() {}
+	}
+	prev := strictBuild.building
+	strictBuild.building = element
+	return func() {
+		strictBuild.building = prev
+	}
+}
+
+// enterDependencyScope allows DependOnInherited outside Build, for
+// DidChangeDependencies and render object creation and updates.
+func enterDependencyScope() (exit func()) {
+	if !errors.StrictModeEnabled() {
+		return func() {}
+	}
+	strictBuild.dependencyScope++
+	return func() {
+		strictBuild.dependencyScope--
+	}
+}
+
+// checkMarkNeedsBuild reports target being marked dirty while another
+// element's Build is running.
+func checkMarkNeedsBuild(target Element) {
+	if !errors.StrictModeEnabled() || strictBuild.building == nil {
+		return
+	}
+	errors.ReportStrictModeViolation(errors.RuleSetStateDuringBuild,
+		widgetTypeName(strictBuild.building),
+		"SetState marked "+widgetTypeName(target)+" dirty during Build; move the change to an event handler or InitState")
+}
+
+// checkSetStateThread reports SetState called off the UI thread.
+func checkSetStateThread(element *StatefulElement) {
+	if !errors.StrictModeEnabled() {
+		return
+	}
+	owner := "core.State"
+	if element != nil {
+		owner = widgetTypeName(element)
+	}
+	errors.CheckUIThread(owner, "SetState")
+}
+
+// checkDependOnInherited reports an inherited lookup outside Build and
+// DidChangeDependencies.
+func checkDependOnInherited(element Element, inheritedType reflect.Type) {
+	if !errors.StrictModeEnabled() || strictBuild.building != nil || strictBuild.dependencyScope > 0 {
+		return
+	}
+	errors.ReportStrictModeViolation(errors.RuleDependOutsideBuild,
+		widgetTypeName(element),
+		"DependOnInherited("+inheritedType.String()+") called outside Build; the dependency will not trigger rebuilds")
+}
+
+func widgetTypeName(element Element) string {
+	if element == nil || element.Widget() == nil {
+		return ""
+	}
+	return reflect.TypeOf(element.Widget()).String()
+}
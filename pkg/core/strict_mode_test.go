@@ -0,0 +1,146 @@
+package core
+
+import (
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+// strictTestHandler captures strict mode violations.
+type strictTestHandler struct {
+	errors.LogHandler
+	violations []*errors.StrictModeViolation
+}
+
+func (h *strictTestHandler) HandleError(err *errors.DriftError) {
+	var v *errors.StrictModeViolation
+	if stderrors.As(err, &v) {
+		h.violations = append(h.violations, v)
+	}
+}
+
+func enableStrictModeForTest(t *testing.T) *strictTestHandler {
+	t.Helper()
+	handler := &strictTestHandler{}
+	errors.SetHandler(handler)
+	errors.SetStrictMode(true)
+	t.Cleanup(func() {
+		errors.SetStrictMode(false)
+		errors.SetHandler(nil)
+	})
+	return handler
+}
+
+type strictLifecycleState struct {
+	StateBase
+	initState    func(ctx BuildContext)
+	dependencies func(ctx BuildContext)
+	build        func(s *strictLifecycleState, ctx BuildContext)
+}
+
+func (s *strictLifecycleState) InitState() {
+	if s.initState != nil {
+		s.initState(s.Element())
+	}
+}
+
+func (s *strictLifecycleState) DidChangeDependencies() {
+	if s.dependencies != nil {
+		s.dependencies(s.Element())
+	}
+}
+
+func (s *strictLifecycleState) Build(ctx BuildContext) Widget {
+	if s.build != nil {
+		s.build(s, ctx)
+	}
+	return nil
+}
+
+func mountUnderProvider(state *strictLifecycleState) {
+	widget := InheritedProvider[*testUser]{
+		Value: &testUser{ID: 1},
+		Child: testStatefulWidget{createStateFn: func() State { return state }},
+	}
+	newTestInheritedElement(widget, NewBuildOwner()).Mount(nil, nil)
+}
+
+var testUserProviderType = reflect.TypeFor[InheritedProvider[*testUser]]()
+
+func TestStrictMode_SetStateDuringBuild(t *testing.T) {
+	handler := enableStrictModeForTest(t)
+
+	mountUnderProvider(&strictLifecycleState{
+		build: func(s *strictLifecycleState, ctx BuildContext) {
+			s.SetState(nil)
+		},
+	})
+
+	if len(handler.violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(handler.violations))
+	}
+	v := handler.violations[0]
+	if v.Rule != errors.RuleSetStateDuringBuild {
+		t.Errorf("expected %s, got %s", errors.RuleSetStateDuringBuild, v.Rule)
+	}
+	if v.Widget != "core.testStatefulWidget" {
+		t.Errorf("expected offending widget core.testStatefulWidget, got %q", v.Widget)
+	}
+	if v.StackTrace == "" {
+		t.Error("expected stack trace")
+	}
+}
+
+func TestStrictMode_DependOnInheritedInInitState(t *testing.T) {
+	handler := enableStrictModeForTest(t)
+
+	mountUnderProvider(&strictLifecycleState{
+		initState: func(ctx BuildContext) {
+			ctx.DependOnInherited(testUserProviderType, nil)
+		},
+	})
+
+	if len(handler.violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(handler.violations))
+	}
+	if v := handler.violations[0]; v.Rule != errors.RuleDependOutsideBuild {
+		t.Errorf("expected %s, got %s", errors.RuleDependOutsideBuild, v.Rule)
+	}
+}
+
+func TestStrictMode_DependOnInheritedAllowedInBuildAndDependencies(t *testing.T) {
+	handler := enableStrictModeForTest(t)
+
+	mountUnderProvider(&strictLifecycleState{
+		dependencies: func(ctx BuildContext) {
+			ctx.DependOnInherited(testUserProviderType, nil)
+		},
+		build: func(s *strictLifecycleState, ctx BuildContext) {
+			ctx.DependOnInherited(testUserProviderType, nil)
+		},
+	})
+
+	if len(handler.violations) != 0 {
+		t.Errorf("expected no violations, got %v", handler.violations)
+	}
+}
+
+func TestStrictMode_DisabledReportsNothing(t *testing.T) {
+	handler := enableStrictModeForTest(t)
+	errors.SetStrictMode(false)
+
+	mountUnderProvider(&strictLifecycleState{
+		initState: func(ctx BuildContext) {
+			ctx.DependOnInherited(testUserProviderType, nil)
+		},
+		build: func(s *strictLifecycleState, ctx BuildContext) {
+			s.SetState(nil)
+		},
+	})
+
+	if len(handler.violations) != 0 {
+		t.Errorf("expected no violations, got %d", len(handler.violations))
+	}
+}
@@ -0,0 +1,258 @@
+package cupertino
+
+import (
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/navigation"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// ActionSheetAction is one button of an [ActionSheet].
+type ActionSheetAction struct {
+	// Label is the button text.
+	Label string
+	// OnPressed is called when the action is chosen. Optional.
+	OnPressed func()
+	// IsDefault shows the label in bold, marking the expected choice.
+	IsDefault bool
+	// IsDestructive shows the label in the sheet's DestructiveColor, for
+	// actions that delete or discard something.
+	IsDestructive bool
+}
+
+// ActionSheet is an iOS-style action sheet: a rounded group of actions,
+// under an optional title and message, with a separate cancel button below.
+// Show it from the bottom of the screen with [ShowActionSheet].
+//
+// ActionSheet is explicit by default: zero colors are transparent. For a
+// themed sheet, use [ActionSheetOf].
+type ActionSheet struct {
+	core.StatelessBase
+
+	// Title is shown above the actions. Optional.
+	Title string
+	// Message is shown under the title in a smaller font. Optional.
+	Message string
+	// Actions are the choices, from top to bottom.
+	Actions []ActionSheetAction
+	// CancelAction, if set, is shown in its own group below the actions.
+	CancelAction *ActionSheetAction
+	// BackgroundColor fills the action groups.
+	BackgroundColor graphics.Color
+	// SeparatorColor is the color of the hairlines between actions.
+	SeparatorColor graphics.Color
+	// ActionColor colors the action labels.
+	ActionColor graphics.Color
+	// DestructiveColor colors the labels of destructive actions.
+	DestructiveColor graphics.Color
+	// MessageColor colors the title and message.
+	MessageColor graphics.Color
+	// BorderRadius is the corner radius of the action groups.
+	BorderRadius float64
+}
+
+func (a ActionSheet) Build(ctx core.BuildContext) core.Widget {
+	var items []core.Widget
+	if a.Title != "" || a.Message != "" {
+		items = append(items, a.header())
+	}
+	for _, action := range a.Actions {
+		if len(items) > 0 {
+			items = append(items, a.separator())
+		}
+		items = append(items, a.button(action))
+	}
+
+	children := []core.Widget{a.group(items)}
+	if a.CancelAction != nil {
+		cancel := *a.CancelAction
+		cancel.IsDefault = true
+		children = append(children, widgets.SizedBox{Height: 8}, a.group([]core.Widget{a.button(cancel)}))
+	}
+	return widgets.Padding{
+		Padding: layout.EdgeInsets{Left: 8, Top: 8, Right: 8, Bottom: 8 + widgets.SafeAreaBottomOf(ctx)},
+		Child: widgets.Column{
+			Children:           children,
+			MainAxisSize:       widgets.MainAxisSizeMin,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+		},
+	}
+}
+
+func (a ActionSheet) group(items []core.Widget) core.Widget {
+	return widgets.DecoratedBox{
+		Color:        a.BackgroundColor,
+		BorderRadius: a.BorderRadius,
+		Overflow:     widgets.OverflowClip,
+		Child: widgets.Column{
+			Children:           items,
+			MainAxisSize:       widgets.MainAxisSizeMin,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+		},
+	}
+}
+
+func (a ActionSheet) header() core.Widget {
+	var lines []core.Widget
+	if a.Title != "" {
+		lines = append(lines, widgets.Text{
+			Content: a.Title,
+			Style:   graphics.TextStyle{Color: a.MessageColor, FontSize: 13, FontWeight: graphics.FontWeightSemibold},
+			Align:   graphics.TextAlignCenter,
+		})
+	}
+	if a.Message != "" {
+		if len(lines) > 0 {
+			lines = append(lines, widgets.SizedBox{Height: 4})
+		}
+		lines = append(lines, widgets.Text{
+			Content: a.Message,
+			Style:   graphics.TextStyle{Color: a.MessageColor, FontSize: 13},
+			Align:   graphics.TextAlignCenter,
+		})
+	}
+	return widgets.Padding{
+		Padding: layout.EdgeInsetsSymmetric(16, 14),
+		Child: widgets.Column{
+			Children:           lines,
+			MainAxisSize:       widgets.MainAxisSizeMin,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+		},
+	}
+}
+
+func (a ActionSheet) separator() core.Widget {
+	return widgets.Divider{Height: 0.5, Thickness: 0.5, Color: a.SeparatorColor}
+}
+
+func (a ActionSheet) button(action ActionSheetAction) core.Widget {
+	style := graphics.TextStyle{Color: a.ActionColor, FontSize: 20}
+	if action.IsDestructive {
+		style.Color = a.DestructiveColor
+	}
+	if action.IsDefault {
+		style.FontWeight = graphics.FontWeightSemibold
+	}
+	return Button{
+		OnTap:          action.OnPressed,
+		Padding:        layout.EdgeInsetsSymmetric(16, 16),
+		PressedOpacity: 0.4,
+		Child: widgets.Center{Child: widgets.Text{
+			Content:  action.Label,
+			Style:    style,
+			MaxLines: 1,
+		}},
+	}
+}
+
+// ActionSheetOf returns an action sheet with actions in the theme's iOS
+// colors. Set Title, Message, and CancelAction on the result as needed.
+//
+//   - BackgroundColor: SecondarySystemGroupedBackground
+//   - SeparatorColor: Separator
+//   - ActionColor: PrimaryColor
+//   - DestructiveColor: SystemRed
+//   - MessageColor: SecondaryLabel
+//   - BorderRadius: 13
+func ActionSheetOf(ctx core.BuildContext, actions ...ActionSheetAction) ActionSheet {
+	data, colors, _ := theme.UseCupertinoTheme(ctx)
+	return ActionSheet{
+		Actions:          actions,
+		BackgroundColor:  colors.SecondarySystemGroupedBackground,
+		SeparatorColor:   colors.Separator,
+		ActionColor:      data.PrimaryColor,
+		DestructiveColor: colors.SystemRed,
+		MessageColor:     colors.SecondaryLabel,
+		BorderRadius:     13,
+	}
+}
+
+// actionSheetDuration is the length of the action sheet's slide in.
+const actionSheetDuration = 300 * time.Millisecond
+
+// ShowActionSheet slides sheet up from the bottom of the screen above a
+// barrier, as a dialog route on the nearest navigator. Choosing an action
+// closes the sheet before calling the action's OnPressed; so does the back
+// button or a tap on the barrier.
+//
+// The returned channel receives the Label of the chosen action, or nil if
+// the sheet was dismissed without one, and is then closed.
+//
+//	sheet := cupertino.ActionSheetOf(ctx,
+//	    cupertino.ActionSheetAction{Label: "Delete", IsDestructive: true, OnPressed: deletePhoto},
+//	)
+//	sheet.CancelAction = &cupertino.ActionSheetAction{Label: "Cancel"}
+//	cupertino.ShowActionSheet(ctx, sheet)
+func ShowActionSheet(ctx core.BuildContext, sheet ActionSheet) <-chan any {
+	return navigation.ShowDialog(ctx, navigation.DialogOptions{
+		Builder: func(ctx core.BuildContext) core.Widget {
+			nav := navigation.NavigatorOf(ctx)
+			choose := func(action ActionSheetAction) ActionSheetAction {
+				onPressed := action.OnPressed
+				action.OnPressed = func() {
+					nav.Pop(action.Label)
+					if onPressed != nil {
+						onPressed()
+					}
+				}
+				return action
+			}
+			shown := sheet
+			shown.Actions = make([]ActionSheetAction, len(sheet.Actions))
+			for i, action := range sheet.Actions {
+				shown.Actions[i] = choose(action)
+			}
+			if sheet.CancelAction != nil {
+				cancel := choose(*sheet.CancelAction)
+				shown.CancelAction = &cancel
+			}
+			// The dialog route centers its content; filling the screen with
+			// an Align keeps the sheet at the bottom while taps above it
+			// still reach the barrier.
+			return widgets.Align{
+				Alignment: layout.AlignmentBottomCenter,
+				Child:     actionSheetTransition{child: shown},
+			}
+		},
+	})
+}
+
+// actionSheetTransition slides its child up from below its resting place
+// when it is first shown.
+type actionSheetTransition struct {
+	core.StatefulBase
+	child core.Widget
+}
+
+func (t actionSheetTransition) CreateState() core.State {
+	return &actionSheetTransitionState{}
+}
+
+type actionSheetTransitionState struct {
+	core.StateBase
+	animation *animation.AnimationController
+}
+
+func (s *actionSheetTransitionState) InitState() {
+	s.animation = animation.NewAnimationController(actionSheetDuration)
+	s.animation.Curve = animation.EaseOut
+	s.animation.Forward()
+}
+
+func (s *actionSheetTransitionState) Dispose() {
+	s.animation.Dispose()
+	s.StateBase.Dispose()
+}
+
+func (s *actionSheetTransitionState) Build(ctx core.BuildContext) core.Widget {
+	return navigation.SlideTransition{
+		Animation: s.animation,
+		Direction: navigation.SlideFromBottom,
+		Child:     s.Element().Widget().(actionSheetTransition).child,
+	}
+}
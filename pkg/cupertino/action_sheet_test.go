@@ -0,0 +1,85 @@
+package cupertino_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/cupertino"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/navigation"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func pumpSheetHost(t *testing.T, tester *drifttest.WidgetTester) core.BuildContext {
+	t.Helper()
+	var routeCtx core.BuildContext
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+	err := tester.PumpWidget(navigation.Navigator{
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings navigation.RouteSettings) navigation.Route {
+			return navigation.NewPageRoute(func(ctx core.BuildContext) core.Widget {
+				routeCtx = ctx
+				return widgets.Text{Content: "photos"}
+			}, settings)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return routeCtx
+}
+
+func TestShowActionSheet_ChoosesAction(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	ctx := pumpSheetHost(t, tester)
+
+	deleted := false
+	sheet := cupertino.ActionSheet{
+		Title: "Delete this photo?",
+		Actions: []cupertino.ActionSheetAction{
+			{Label: "Delete", IsDestructive: true, OnPressed: func() { deleted = true }},
+		},
+		CancelAction: &cupertino.ActionSheetAction{Label: "Cancel"},
+	}
+	result := cupertino.ShowActionSheet(ctx, sheet)
+	tester.PumpAndSettle(time.Second)
+
+	if !tester.Find(drifttest.ByText("Delete this photo?")).Exists() {
+		t.Fatal("expected the sheet to show")
+	}
+	if err := tester.Tap(drifttest.ByText("Delete")); err != nil {
+		t.Fatal(err)
+	}
+	tester.PumpAndSettle(time.Second)
+
+	if !deleted {
+		t.Error("expected the action's OnPressed to run")
+	}
+	if value := <-result; value != "Delete" {
+		t.Errorf("expected the result %q, got %v", "Delete", value)
+	}
+	if tester.Find(drifttest.ByText("Cancel")).Exists() {
+		t.Error("expected the sheet to close")
+	}
+}
+
+func TestShowActionSheet_BarrierDismisses(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	ctx := pumpSheetHost(t, tester)
+
+	result := cupertino.ShowActionSheet(ctx, cupertino.ActionSheet{
+		Actions: []cupertino.ActionSheetAction{{Label: "Share"}},
+	})
+	tester.PumpAndSettle(time.Second)
+
+	tester.TapAt(graphics.Offset{X: 200, Y: 100})
+	tester.PumpAndSettle(time.Second)
+	if value := <-result; value != nil {
+		t.Errorf("expected a nil result, got %v", value)
+	}
+	if tester.Find(drifttest.ByText("Share")).Exists() {
+		t.Error("expected a tap above the sheet to close it")
+	}
+}
@@ -0,0 +1,255 @@
+package cupertino
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/semantics"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// Button is an iOS-style button: a text label, optionally on a filled
+// rounded background, that dims while a finger is on it.
+//
+// Button is explicit by default: a zero Color draws no background, a zero
+// TextStyle color is transparent, and a zero PressedOpacity hides the label
+// while pressed. For themed buttons, use [ButtonOf] and [FilledButtonOf].
+//
+//	cupertino.Button{
+//	    Label:          "Done",
+//	    OnTap:          save,
+//	    TextStyle:      graphics.TextStyle{Color: colors.SystemBlue, FontSize: 17},
+//	    Padding:        layout.EdgeInsetsSymmetric(16, 14),
+//	    PressedOpacity: 0.4,
+//	}
+type Button struct {
+	core.StatelessBase
+
+	// Label is the button text. Ignored when Child is set.
+	Label string
+	// Child replaces the label, for icon buttons. Optional.
+	Child core.Widget
+	// OnTap is called when the button is tapped. Ignored when Disabled.
+	OnTap func()
+	// Disabled prevents interaction and fades the button to half opacity.
+	Disabled bool
+	// Color fills the button. Zero draws a plain button with no background.
+	Color graphics.Color
+	// TextStyle styles the label.
+	TextStyle graphics.TextStyle
+	// Padding is the space between the button edge and the label.
+	Padding layout.EdgeInsets
+	// BorderRadius is the corner radius of the background.
+	BorderRadius float64
+	// PressedOpacity is the opacity of the button while pressed, from 0 to 1.
+	PressedOpacity float64
+	// Haptic plays a light impact when the button is tapped.
+	Haptic bool
+}
+
+func (b Button) Build(ctx core.BuildContext) core.Widget {
+	var onTap func()
+	if !b.Disabled && b.OnTap != nil {
+		onTap = b.OnTap
+		if b.Haptic {
+			onTap = func() {
+				platform.Haptics.LightImpact()
+				b.OnTap()
+			}
+		}
+	}
+
+	child := b.Child
+	if child == nil {
+		child = widgets.Text{Content: b.Label, Style: b.TextStyle, MaxLines: 1}
+	}
+	var box core.Widget = widgets.DecoratedBox{
+		Color:        b.Color,
+		BorderRadius: b.BorderRadius,
+		Child:        widgets.Padding{Padding: b.Padding, Child: child},
+	}
+	if b.Disabled {
+		box = widgets.Opacity{Opacity: 0.5, Child: box}
+	}
+
+	flags := semantics.SemanticsIsButton | semantics.SemanticsHasEnabledState
+	var hint string
+	if onTap != nil {
+		flags = flags.Set(semantics.SemanticsIsEnabled)
+		hint = "Double tap to activate"
+	}
+	return widgets.Semantics{
+		Hint:             hint,
+		Role:             semantics.SemanticsRoleButton,
+		Flags:            flags,
+		Container:        true,
+		MergeDescendants: true,
+		OnTap:            onTap,
+		Child: widgets.GestureDetector{
+			OnTap: onTap,
+			Child: pressedOpacity{
+				enabled: onTap != nil,
+				opacity: b.PressedOpacity,
+				child:   box,
+			},
+		},
+	}
+}
+
+// ButtonOf returns a plain button in the theme's primary color, for the
+// text buttons of toolbars, lists, and navigation bars.
+//
+//   - Label: ActionTextStyle in PrimaryColor
+//   - Padding: 16 horizontal, 14 vertical
+//   - PressedOpacity: 0.4
+func ButtonOf(ctx core.BuildContext, label string, onTap func()) Button {
+	data, _, textTheme := theme.UseCupertinoTheme(ctx)
+	style := textTheme.ActionTextStyle
+	style.Color = data.PrimaryColor
+	return Button{
+		Label:          label,
+		OnTap:          onTap,
+		TextStyle:      style,
+		Padding:        layout.EdgeInsetsSymmetric(16, 14),
+		PressedOpacity: 0.4,
+	}
+}
+
+// FilledButtonOf returns a button filled with the theme's primary color,
+// for the main action of a screen.
+//
+//   - Color: PrimaryColor
+//   - Label: ActionTextStyle in PrimaryContrastingColor
+//   - Padding: 16 horizontal, 14 vertical
+//   - BorderRadius: 8
+//   - PressedOpacity: 0.4
+func FilledButtonOf(ctx core.BuildContext, label string, onTap func()) Button {
+	data, _, textTheme := theme.UseCupertinoTheme(ctx)
+	style := textTheme.ActionTextStyle
+	style.Color = data.PrimaryContrastingColor
+	return Button{
+		Label:          label,
+		OnTap:          onTap,
+		Color:          data.PrimaryColor,
+		TextStyle:      style,
+		Padding:        layout.EdgeInsetsSymmetric(16, 14),
+		BorderRadius:   8,
+		PressedOpacity: 0.4,
+	}
+}
+
+// pressedOpacity paints its child at opacity while a pointer is down on it.
+// It only watches pointers, so the tap itself is left to the gesture
+// detector around it.
+type pressedOpacity struct {
+	core.RenderObjectBase
+	enabled bool
+	opacity float64
+	child   core.Widget
+}
+
+func (p pressedOpacity) ChildWidget() core.Widget {
+	return p.child
+}
+
+func (p pressedOpacity) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderPressedOpacity{pointers: map[int64]struct{}{}}
+	r.SetSelf(r)
+	p.UpdateRenderObject(ctx, r)
+	return r
+}
+
+func (p pressedOpacity) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	r, ok := renderObject.(*renderPressedOpacity)
+	if !ok {
+		return
+	}
+	r.enabled = p.enabled
+	r.opacity = p.opacity
+	if !r.enabled {
+		clear(r.pointers)
+	}
+	r.MarkNeedsPaint()
+}
+
+type renderPressedOpacity struct {
+	layout.RenderBoxBase
+	child    layout.RenderBox
+	enabled  bool
+	opacity  float64
+	pointers map[int64]struct{}
+}
+
+func (r *renderPressedOpacity) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderPressedOpacity) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderPressedOpacity) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child == nil {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+		return
+	}
+	r.child.Layout(constraints, true)
+	r.child.SetParentData(&layout.BoxParentData{})
+	r.SetSize(r.child.Size())
+}
+
+func (r *renderPressedOpacity) pressed() bool {
+	return r.enabled && len(r.pointers) > 0
+}
+
+func (r *renderPressedOpacity) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	if !r.pressed() || r.opacity >= 1 {
+		ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+		return
+	}
+	if r.opacity <= 0 {
+		return
+	}
+	size := r.Size()
+	ctx.Canvas.SaveLayerAlpha(graphics.RectFromLTWH(0, 0, size.Width, size.Height), r.opacity)
+	ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+	ctx.Canvas.Restore()
+}
+
+// HitTest adds the button behind its child so it sees every pointer that
+// lands on the child, including those claimed by the tap recognizer.
+func (r *renderPressedOpacity) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.child == nil || !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	r.child.HitTest(position, result)
+	result.Add(r)
+	return true
+}
+
+func (r *renderPressedOpacity) HandlePointer(event gestures.PointerEvent) {
+	if !r.enabled {
+		return
+	}
+	wasPressed := r.pressed()
+	switch event.Phase {
+	case gestures.PointerPhaseDown:
+		r.pointers[event.PointerID] = struct{}{}
+	case gestures.PointerPhaseUp, gestures.PointerPhaseCancel:
+		delete(r.pointers, event.PointerID)
+	}
+	if r.pressed() != wasPressed {
+		r.MarkNeedsPaint()
+	}
+}
@@ -0,0 +1,57 @@
+package cupertino_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/cupertino"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestButton_Taps(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	taps := 0
+	tester.PumpWidget(widgets.Center{Child: cupertino.Button{
+		Label:          "Edit",
+		OnTap:          func() { taps++ },
+		PressedOpacity: 0.4,
+	}})
+
+	if err := tester.Tap(drifttest.ByText("Edit")); err != nil {
+		t.Fatal(err)
+	}
+	if taps != 1 {
+		t.Errorf("expected one tap, got %d", taps)
+	}
+}
+
+func TestButton_DisabledIgnoresTaps(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	taps := 0
+	tester.PumpWidget(widgets.Center{Child: cupertino.Button{
+		Label:    "Edit",
+		OnTap:    func() { taps++ },
+		Disabled: true,
+	}})
+
+	tester.Tap(drifttest.ByText("Edit"))
+	if taps != 0 {
+		t.Errorf("expected a disabled button to ignore taps, got %d", taps)
+	}
+}
+
+func TestSwitch_TogglesValue(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var got []bool
+	tester.PumpWidget(widgets.Center{Child: cupertino.Switch{
+		Value:     false,
+		OnChanged: func(v bool) { got = append(got, v) },
+	}})
+
+	if err := tester.Tap(drifttest.ByType[widgets.Toggle]()); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || !got[0] {
+		t.Errorf("expected OnChanged(true), got %v", got)
+	}
+}
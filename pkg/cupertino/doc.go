@@ -0,0 +1,20 @@
+// Package cupertino provides widgets that follow Apple's iOS conventions:
+// a [Button] that dims while pressed, a [Switch], a [NavigationBar] with a
+// centered title and a labeled back button, an [ActionSheet], and page
+// routes with the iOS parallax transition.
+//
+// The widgets are built from the same render objects as their counterparts
+// in package widgets, so they lay out, hit test, and report semantics the
+// same way. Like those widgets they are explicit by default: a zero color
+// is transparent and a zero size is zero. The XxxOf constructors fill them
+// in from the nearest [theme.CupertinoThemeData]:
+//
+//	cupertino.NavigationBarOf(ctx, "Settings")
+//	cupertino.ButtonOf(ctx, "Edit", startEditing)
+//	cupertino.SwitchOf(ctx, s.wifi, s.setWifi)
+//
+// Push pages with [NewPageRoute] to slide them in from the trailing edge
+// over a parallax-shifted page underneath, with an edge swipe to go back:
+//
+//	navigation.NavigatorOf(ctx).Push(cupertino.NewPageRoute(buildDetail, settings))
+package cupertino
@@ -0,0 +1,168 @@
+package cupertino
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// NavigationBar is an iOS-style navigation bar: a title centered across the
+// whole bar, a back button or other leading widget at the start, trailing
+// actions at the end, and a hairline along the bottom. It extends under the
+// top safe area inset, like [widgets.AppBar].
+//
+// NavigationBar is explicit by default: zero colors are transparent and a
+// zero BorderColor draws no hairline. For a themed bar, use
+// [NavigationBarOf].
+//
+//	cupertino.NavigationBar{
+//	    Middle:                    widgets.Text{Content: "Settings", Style: titleStyle},
+//	    Trailing:                  cupertino.ButtonOf(ctx, "Edit", startEditing),
+//	    AutomaticallyImplyLeading: true,
+//	    PreviousPageTitle:         "General",
+//	    BackgroundColor:           data.BarBackgroundColor,
+//	    BorderColor:               colors.Separator,
+//	    ForegroundColor:           data.PrimaryColor,
+//	    Height:                    44,
+//	}
+type NavigationBar struct {
+	core.StatelessBase
+
+	// Leading is shown at the start of the bar. Optional.
+	Leading core.Widget
+	// AutomaticallyImplyLeading shows a back button when Leading is nil and
+	// the nearest [widgets.BackNavigationScope] can go back.
+	AutomaticallyImplyLeading bool
+	// PreviousPageTitle labels the implied back button. Defaults to "Back".
+	PreviousPageTitle string
+	// Middle is centered in the bar, typically the page title. Optional.
+	Middle core.Widget
+	// Trailing is shown at the end of the bar. Optional.
+	Trailing core.Widget
+	// BackgroundColor fills the bar, including the area under the status bar.
+	BackgroundColor graphics.Color
+	// BorderColor is the color of the hairline along the bottom edge.
+	BorderColor graphics.Color
+	// ForegroundColor colors the implied back button.
+	ForegroundColor graphics.Color
+	// Height is the height of the bar below the top safe area inset.
+	Height float64
+	// Padding insets Leading and Trailing from the bar's edges.
+	Padding layout.EdgeInsets
+}
+
+func (n NavigationBar) Build(ctx core.BuildContext) core.Widget {
+	leading := n.Leading
+	if leading == nil && n.AutomaticallyImplyLeading {
+		if onBack := widgets.BackNavigationOf(ctx); onBack != nil {
+			leading = backButton{title: n.PreviousPageTitle, color: n.ForegroundColor, onTap: onBack}
+		}
+	}
+	row := []core.Widget{}
+	if leading != nil {
+		row = append(row, leading)
+	}
+	row = append(row, widgets.Expanded{Child: widgets.SizedBox{}})
+	if n.Trailing != nil {
+		row = append(row, n.Trailing)
+	}
+	middle := n.Middle
+	if middle == nil {
+		middle = widgets.SizedBox{}
+	}
+
+	children := []core.Widget{
+		widgets.Padding{
+			Padding: layout.EdgeInsets{Top: widgets.SafeAreaTopOf(ctx)},
+			Child: widgets.SizedBox{
+				Height: n.Height,
+				Child: widgets.Stack{
+					Fit: widgets.StackFitExpand,
+					Children: []core.Widget{
+						widgets.Center{Child: middle},
+						widgets.Padding{
+							Padding: n.Padding,
+							Child: widgets.Row{
+								Children:           row,
+								CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if n.BorderColor != graphics.ColorTransparent {
+		children = append(children, widgets.Divider{Height: 0.5, Thickness: 0.5, Color: n.BorderColor})
+	}
+	return widgets.DecoratedBox{
+		Color: n.BackgroundColor,
+		Child: widgets.Column{
+			Children:           children,
+			MainAxisSize:       widgets.MainAxisSizeMin,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+		},
+	}
+}
+
+// NavigationBarOf returns a navigation bar titled title, with an implied
+// back button, in the theme's bar colors.
+//
+//   - Middle: title in NavTitleTextStyle
+//   - BackgroundColor: BarBackgroundColor
+//   - BorderColor: Separator
+//   - ForegroundColor: PrimaryColor
+//   - Height: 44
+//   - Padding: 8 horizontal
+func NavigationBarOf(ctx core.BuildContext, title string) NavigationBar {
+	data, colors, textTheme := theme.UseCupertinoTheme(ctx)
+	return NavigationBar{
+		Middle: widgets.Text{
+			Content:  title,
+			Style:    textTheme.NavTitleTextStyle,
+			MaxLines: 1,
+		},
+		AutomaticallyImplyLeading: true,
+		BackgroundColor:           data.BarBackgroundColor,
+		BorderColor:               colors.Separator,
+		ForegroundColor:           data.PrimaryColor,
+		Height:                    44,
+		Padding:                   layout.EdgeInsetsSymmetric(8, 0),
+	}
+}
+
+// backButton is the chevron and label a navigation bar implies when it can
+// go back.
+type backButton struct {
+	core.StatelessBase
+	title string
+	color graphics.Color
+	onTap func()
+}
+
+func (b backButton) Build(ctx core.BuildContext) core.Widget {
+	title := b.title
+	if title == "" {
+		title = "Back"
+	}
+	return Button{
+		OnTap:          b.onTap,
+		PressedOpacity: 0.4,
+		Padding:        layout.EdgeInsetsSymmetric(0, 8),
+		Child: widgets.Row{
+			MainAxisSize:       widgets.MainAxisSizeMin,
+			CrossAxisAlignment: widgets.CrossAxisAlignmentCenter,
+			Children: []core.Widget{
+				widgets.Icon{Glyph: "‹", Size: 30, Color: b.color, MatchTextDirection: true},
+				widgets.SizedBox{Width: 4},
+				widgets.Text{
+					Content:  title,
+					Style:    graphics.TextStyle{Color: b.color, FontSize: 17},
+					MaxLines: 1,
+				},
+			},
+		},
+	}
+}
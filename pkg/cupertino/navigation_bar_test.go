@@ -0,0 +1,47 @@
+package cupertino_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/cupertino"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestNavigationBar_ImpliesBackButton(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	backs := 0
+	bar := cupertino.NavigationBar{
+		Middle:                    widgets.Text{Content: "Wi-Fi"},
+		AutomaticallyImplyLeading: true,
+		PreviousPageTitle:         "Settings",
+		Height:                    44,
+	}
+	tester.PumpWidget(widgets.BackNavigationScope{OnBack: func() { backs++ }, Child: bar})
+
+	if !tester.Find(drifttest.ByText("Wi-Fi")).Exists() {
+		t.Error("expected the title")
+	}
+	if !tester.Find(drifttest.ByText("Settings")).Exists() {
+		t.Error("expected the back button to show the previous page's title")
+	}
+	if err := tester.Tap(drifttest.ByType[cupertino.Button]()); err != nil {
+		t.Fatal(err)
+	}
+	if backs != 1 {
+		t.Errorf("expected the back button to go back, got %d", backs)
+	}
+}
+
+func TestNavigationBar_NoBackButtonAtRoot(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(cupertino.NavigationBar{
+		Middle:                    widgets.Text{Content: "Settings"},
+		AutomaticallyImplyLeading: true,
+		Height:                    44,
+	})
+
+	if tester.Find(drifttest.ByText("Back")).Exists() {
+		t.Error("expected no back button without somewhere to go back to")
+	}
+}
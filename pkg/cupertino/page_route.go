@@ -0,0 +1,50 @@
+package cupertino
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/navigation"
+)
+
+// PageTransition returns the iOS push transition: the page slides in from
+// the trailing edge while the page underneath shifts a third of its width
+// the other way, and [navigation.Hero] widgets fly between the two.
+func PageTransition() navigation.PageTransition {
+	transition := navigation.SlidePageTransition()
+	transition.Heroes = true
+	return transition
+}
+
+// FullscreenDialogTransition returns the iOS modal transition: the page
+// slides up from the bottom edge over the page underneath, which stays put.
+func FullscreenDialogTransition() navigation.PageTransition {
+	return navigation.PageTransition{
+		Enter: func(t float64) navigation.PageTransform {
+			tr := navigation.IdentityPageTransform()
+			tr.Offset.Y = 1 - t
+			return tr
+		},
+	}
+}
+
+// NewPageRoute creates a page route with the iOS push transition, from
+// [PageTransition], that the user can pop by swiping from the leading edge.
+// It ignores the app's [navigation.PageTransitionsTheme].
+//
+//	navigation.NavigatorOf(ctx).Push(cupertino.NewPageRoute(buildDetail, settings))
+func NewPageRoute(builder func(core.BuildContext) core.Widget, settings navigation.RouteSettings) *navigation.AnimatedPageRoute {
+	route := navigation.NewAnimatedPageRoute(builder, settings)
+	transition := PageTransition()
+	route.Transition = &transition
+	route.BackGesture = true
+	return route
+}
+
+// NewFullscreenDialogRoute creates a page route that slides up from the
+// bottom edge, for screens that create or edit content and are dismissed
+// with a Cancel or Done button rather than a back swipe.
+func NewFullscreenDialogRoute(builder func(core.BuildContext) core.Widget, settings navigation.RouteSettings) *navigation.AnimatedPageRoute {
+	route := navigation.NewAnimatedPageRoute(builder, settings)
+	transition := FullscreenDialogTransition()
+	route.Transition = &transition
+	return route
+}
@@ -0,0 +1,33 @@
+package cupertino_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/cupertino"
+	"github.com/go-drift/drift/pkg/navigation"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestNewPageRoute(t *testing.T) {
+	build := func(core.BuildContext) core.Widget { return widgets.SizedBox{} }
+	route := cupertino.NewPageRoute(build, navigation.RouteSettings{Name: "/detail"})
+
+	if !route.BackGesture {
+		t.Error("expected an edge swipe to pop the page")
+	}
+	if route.Transition == nil || route.Transition.Secondary == nil || !route.Transition.Heroes {
+		t.Fatal("expected the parallax push transition with heroes")
+	}
+	if tr := route.Transition.Secondary(1); tr.Offset.X >= 0 {
+		t.Errorf("expected the page underneath to shift toward the leading edge, got %v", tr.Offset)
+	}
+
+	dialog := cupertino.NewFullscreenDialogRoute(build, navigation.RouteSettings{Name: "/compose"})
+	if dialog.BackGesture {
+		t.Error("expected fullscreen dialogs to have no back swipe")
+	}
+	if tr := dialog.Transition.Enter(0); tr.Offset.Y != 1 {
+		t.Errorf("expected the dialog to start below the screen, got %v", tr.Offset)
+	}
+}
@@ -0,0 +1,68 @@
+package cupertino
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// Switch is an iOS-style on/off switch at the standard 51x31 size. It draws
+// with the same render object as [widgets.Toggle].
+//
+// Switch is explicit by default: zero colors are transparent. For a themed
+// switch, use [SwitchOf].
+//
+// Switch is a controlled component: it shows Value and calls OnChanged
+// when tapped. Update Value in response to OnChanged.
+type Switch struct {
+	core.StatelessBase
+
+	// Value indicates the current on/off state.
+	Value bool
+	// OnChanged is called with the new value when the switch is tapped.
+	OnChanged func(bool)
+	// Disabled disables interaction and fades the switch when true.
+	Disabled bool
+	// ActiveColor is the track color when on.
+	ActiveColor graphics.Color
+	// TrackColor is the track color when off.
+	TrackColor graphics.Color
+	// ThumbColor is the thumb fill color.
+	ThumbColor graphics.Color
+}
+
+// Standard iOS switch dimensions.
+const (
+	switchWidth  = 51
+	switchHeight = 31
+)
+
+func (s Switch) Build(ctx core.BuildContext) core.Widget {
+	return widgets.Toggle{
+		Value:         s.Value,
+		OnChanged:     s.OnChanged,
+		Disabled:      s.Disabled,
+		Width:         switchWidth,
+		Height:        switchHeight,
+		ActiveColor:   s.ActiveColor,
+		InactiveColor: s.TrackColor,
+		ThumbColor:    s.ThumbColor,
+	}
+}
+
+// SwitchOf returns a switch in the theme's iOS colors.
+//
+//   - ActiveColor: SystemGreen
+//   - TrackColor: SystemFill
+//   - ThumbColor: white
+func SwitchOf(ctx core.BuildContext, value bool, onChanged func(bool)) Switch {
+	colors := theme.CupertinoColorsOf(ctx)
+	return Switch{
+		Value:       value,
+		OnChanged:   onChanged,
+		ActiveColor: colors.SystemGreen,
+		TrackColor:  colors.SystemFill,
+		ThumbColor:  graphics.ColorWhite,
+	}
+}
@@ -3,9 +3,11 @@ package drift
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/engine"
+	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/theme"
 )
 
@@ -15,11 +17,25 @@ type App struct {
 	Root core.Widget
 	// Theme is the application theme. Defaults to DefaultLightTheme if nil.
 	Theme *theme.ThemeData
+	// DarkTheme is used instead of Theme when ThemeMode selects dark mode.
+	// If nil, Theme is used in both modes.
+	DarkTheme *theme.ThemeData
+	// HighContrastTheme and HighContrastDarkTheme replace Theme and
+	// DarkTheme when the user asks for increased contrast. Optional.
+	HighContrastTheme     *theme.ThemeData
+	HighContrastDarkTheme *theme.ThemeData
+	// ThemeMode selects between Theme and DarkTheme. The default,
+	// theme.ThemeModeSystem, follows the platform's dark mode setting and
+	// switches themes when it changes.
+	ThemeMode theme.ThemeMode
 	// DeviceScale is the device pixel ratio. Defaults to 1.0 if zero.
 	DeviceScale float64
 	// Diagnostics enables the performance diagnostics HUD overlay.
 	// Use engine.DefaultDiagnosticsConfig() for sensible defaults.
 	Diagnostics *engine.DiagnosticsConfig
+	// Watchdog reports frames that block the UI thread for longer than its
+	// budget, with the stacks of all goroutines, through the error handler.
+	Watchdog *engine.WatchdogConfig
 	// OnInit is called once in a background goroutine before the root widget
 	// is mounted. Use it for one-time setup such as opening a database,
 	// loading configuration, or restoring authentication state.
@@ -46,11 +62,57 @@ type App struct {
 	// OnDispose is guaranteed to run at most once, even if the
 	// lifecycle transitions to Detached multiple times.
 	OnDispose func()
+
+	// Set by options; nil keeps the engine's current value.
+	backgroundColor *graphics.Color
+	viewWarmUp      *bool
+	debugMode       *bool
+}
+
+// NewApp creates an App with the given root widget and applies opts in
+// order.
+func NewApp(root core.Widget, opts ...Option) App {
+	app := App{Root: root}
+	for _, opt := range opts {
+		opt(&app)
+	}
+	return app
+}
+
+// Validate reports whether the app configuration is usable by [Run].
+func (app App) Validate() error {
+	if app.DeviceScale < 0 {
+		return fmt.Errorf("drift: negative device scale %v", app.DeviceScale)
+	}
+	if app.ThemeMode < theme.ThemeModeSystem || app.ThemeMode > theme.ThemeModeDark {
+		return fmt.Errorf("drift: unknown theme mode %d", app.ThemeMode)
+	}
+	if err := app.engineConfig().Validate(); err != nil {
+		return fmt.Errorf("drift: %w", err)
+	}
+	return nil
 }
 
-// NewApp creates a default App with the given root widget.
-func NewApp(root core.Widget) App {
-	return App{Root: root}
+// engineConfig merges the app's settings over the engine's current
+// configuration, so values set through the deprecated engine setters are kept.
+func (app App) engineConfig() engine.Config {
+	cfg := engine.CurrentConfig()
+	if app.backgroundColor != nil {
+		cfg.BackgroundColor = *app.backgroundColor
+	}
+	if app.viewWarmUp != nil {
+		cfg.ViewWarmUp = *app.viewWarmUp
+	}
+	if app.debugMode != nil {
+		cfg.DebugMode = *app.debugMode
+	}
+	if app.Diagnostics != nil {
+		cfg.Diagnostics = app.Diagnostics
+	}
+	if app.Watchdog != nil {
+		cfg.Watchdog = app.Watchdog
+	}
+	return cfg
 }
 
 // Run starts the app using the package-level runtime.
@@ -59,7 +121,11 @@ func (app App) Run() {
 }
 
 // Run initializes the Drift engine with the given App configuration.
+// It panics if [App.Validate] reports an error.
 func Run(app App) {
+	if err := app.Validate(); err != nil {
+		panic(err)
+	}
 	if app.DeviceScale <= 0 {
 		app.DeviceScale = 1.0
 	}
@@ -72,14 +138,16 @@ func Run(app App) {
 	if app.OnDispose != nil {
 		engine.SetOnDispose(app.OnDispose)
 	}
-	if app.Diagnostics != nil {
-		engine.SetDiagnostics(app.Diagnostics)
-	}
+	engine.Configure(app.engineConfig())
 	if app.Root != nil {
-		// Wrap the root widget with the theme
-		themedRoot := theme.Theme{
-			Data:  app.Theme,
-			Child: app.Root,
+		// Wrap the root widget with the theme for the current system appearance
+		themedRoot := theme.SystemTheme{
+			Light:             app.Theme,
+			Dark:              app.DarkTheme,
+			HighContrastLight: app.HighContrastTheme,
+			HighContrastDark:  app.HighContrastDarkTheme,
+			Mode:              app.ThemeMode,
+			Child:             app.Root,
 		}
 		engine.SetApp(themedRoot)
 	}
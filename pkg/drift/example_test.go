@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/go-drift/drift/pkg/drift"
+	"github.com/go-drift/drift/pkg/engine"
+	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/theme"
 	"github.com/go-drift/drift/pkg/widgets"
 )
@@ -66,3 +68,25 @@ func ExampleDispatch() {
 		})
 	}()
 }
+
+// This example shows how to configure startup settings with options.
+// Read the active settings at runtime with drift.Config.
+func ExampleNewApp_withOptions() {
+	root := widgets.Center{
+		Child: widgets.Text{Content: "My App"},
+	}
+
+	app := drift.NewApp(root,
+		drift.WithTheme(theme.DefaultLightTheme()),
+		drift.WithDarkTheme(theme.DefaultDarkTheme()),
+		drift.WithThemeMode(theme.ThemeModeSystem),
+		drift.WithBackgroundColor(graphics.RGB(18, 18, 18)),
+		drift.WithDiagnostics(engine.DefaultDiagnosticsConfig()),
+		drift.WithDebugMode(false),
+		drift.WithoutViewWarmUp(),
+	)
+	if err := app.Validate(); err != nil {
+		panic(err)
+	}
+	_ = app
+}
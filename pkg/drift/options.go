@@ -0,0 +1,112 @@
+package drift
+
+import (
+	"context"
+
+	"github.com/go-drift/drift/pkg/engine"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/theme"
+)
+
+// Option configures an [App] created by [NewApp].
+type Option func(*App)
+
+// WithTheme sets the application theme.
+func WithTheme(data *theme.ThemeData) Option {
+	return func(app *App) {
+		app.Theme = data
+	}
+}
+
+// WithDarkTheme sets the theme used in dark mode.
+func WithDarkTheme(data *theme.ThemeData) Option {
+	return func(app *App) {
+		app.DarkTheme = data
+	}
+}
+
+// WithHighContrastThemes sets the themes used when the user asks for
+// increased contrast. Either may be nil.
+func WithHighContrastThemes(light, dark *theme.ThemeData) Option {
+	return func(app *App) {
+		app.HighContrastTheme = light
+		app.HighContrastDarkTheme = dark
+	}
+}
+
+// WithThemeMode selects the light theme, the dark theme, or the one matching
+// the platform's dark mode setting. Defaults to theme.ThemeModeSystem.
+func WithThemeMode(mode theme.ThemeMode) Option {
+	return func(app *App) {
+		app.ThemeMode = mode
+	}
+}
+
+// WithDeviceScale sets the device pixel ratio.
+func WithDeviceScale(scale float64) Option {
+	return func(app *App) {
+		app.DeviceScale = scale
+	}
+}
+
+// WithBackgroundColor sets the color used to clear the canvas before each
+// frame. Defaults to black. Use [engine.SetBackgroundColor] to change it
+// after startup, for example when the theme changes.
+func WithBackgroundColor(color graphics.Color) Option {
+	return func(app *App) {
+		app.backgroundColor = &color
+	}
+}
+
+// WithoutViewWarmUp skips pre-warming WebView, VideoPlayer, and TextInput
+// platform views at startup. Use it when the app has no platform views.
+func WithoutViewWarmUp() Option {
+	return func(app *App) {
+		warmUp := false
+		app.viewWarmUp = &warmUp
+	}
+}
+
+// WithDebugMode enables or disables detailed error widgets with stack
+// traces. Debug mode is on by default.
+func WithDebugMode(debug bool) Option {
+	return func(app *App) {
+		app.debugMode = &debug
+	}
+}
+
+// WithDiagnostics enables the diagnostics HUD and debug server.
+// Use [engine.DefaultDiagnosticsConfig] for sensible defaults.
+func WithDiagnostics(config *engine.DiagnosticsConfig) Option {
+	return func(app *App) {
+		app.Diagnostics = config
+	}
+}
+
+// WithWatchdog enables the UI thread watchdog. Pass
+// &engine.WatchdogConfig{} for the default two second budget.
+func WithWatchdog(config *engine.WatchdogConfig) Option {
+	return func(app *App) {
+		app.Watchdog = config
+	}
+}
+
+// WithOnInit sets the [App.OnInit] callback.
+func WithOnInit(fn func(ctx context.Context) error) Option {
+	return func(app *App) {
+		app.OnInit = fn
+	}
+}
+
+// WithOnDispose sets the [App.OnDispose] callback.
+func WithOnDispose(fn func()) Option {
+	return func(app *App) {
+		app.OnDispose = fn
+	}
+}
+
+// Config returns the active engine configuration. The result is a copy;
+// changing it does not affect the running app.
+func Config() engine.Config {
+	return engine.CurrentConfig()
+}
@@ -0,0 +1,73 @@
+package drift
+
+import (
+	"fmt"
+	"image"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/engine"
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/navigation"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// PrecacheImage converts source to the pixel format [widgets.Image] draws
+// once the app is idle, so the first Image that shows the same source
+// instance skips the conversion. Call the Precache functions when a screen is
+// likely to be opened next, such as from InitState of the screen that links
+// to it, so its first frame is not delayed by the work.
+//
+// ctx selects the engine whose idle frames run the work; nil uses the
+// default engine. See [widgets.PrecacheImage].
+func PrecacheImage(source image.Image, ctx core.BuildContext) {
+	if source == nil {
+		return
+	}
+	dispatchIdle(ctx, func() {
+		widgets.PrecacheImage(source)
+	})
+}
+
+// PrecacheFont loads the font family and shapes sample text in it once the
+// app is idle, so the first text in the family does not wait for the
+// typeface to load. Empty family warms the default font. Failures are
+// reported to the error handler.
+func PrecacheFont(family string) {
+	engine.DispatchIdle(func() {
+		manager, err := graphics.DefaultFontManagerErr()
+		if err == nil {
+			err = manager.PrecacheFont(family)
+		}
+		if err != nil {
+			errors.Report(&errors.DriftError{
+				Op:        "drift.PrecacheFont",
+				Kind:      errors.KindRender,
+				Err:       fmt.Errorf("font %q: %w", family, err),
+				Timestamp: time.Now(),
+			})
+		}
+	})
+}
+
+// PrecacheRoute builds the root navigator's route for name offstage once the
+// app is idle, so a later PushNamed(name, nil) shows an already built page.
+// See [navigation.PrecacheRoute] for which routes can be precached.
+func PrecacheRoute(name string) {
+	engine.DispatchIdle(func() {
+		navigation.PrecacheRoute(name)
+	})
+}
+
+// dispatchIdle schedules callback on the idle frames of ctx's engine, or the
+// default engine if ctx is nil or not mounted by one.
+func dispatchIdle(ctx core.BuildContext, callback func()) {
+	if ctx != nil {
+		if h := engine.HandleOf(ctx); h != nil {
+			h.DispatchIdle(callback)
+			return
+		}
+	}
+	engine.DispatchIdle(callback)
+}
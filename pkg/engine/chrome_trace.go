@@ -0,0 +1,190 @@
+package engine
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// Thread IDs used in exported traces. Frames and their phases run on the UI
+// thread; runtime samples and GC pauses go on their own track.
+const (
+	chromeTracePid        = 1
+	chromeTraceUITid      = 1
+	chromeTraceRuntimeTid = 2
+)
+
+// ChromeTraceEvent is an event in the Chrome trace-event format, understood
+// by Perfetto and chrome://tracing. Timestamps and durations are in
+// microseconds.
+type ChromeTraceEvent struct {
+	Name string         `json:"name"`
+	Cat  string         `json:"cat,omitempty"`
+	Ph   string         `json:"ph"`
+	Ts   float64        `json:"ts"`
+	Dur  float64        `json:"dur,omitempty"`
+	Pid  int            `json:"pid"`
+	Tid  int            `json:"tid"`
+	Args map[string]any `json:"args,omitempty"`
+}
+
+// ChromeTrace is a trace file in the Chrome trace-event JSON object format.
+type ChromeTrace struct {
+	TraceEvents     []ChromeTraceEvent `json:"traceEvents"`
+	DisplayTimeUnit string             `json:"displayTimeUnit"`
+}
+
+// NewChromeTrace converts frame samples and runtime samples to a Chrome
+// trace. Each frame becomes a slice on the UI track with its phases nested
+// inside, laid out in the order they run. Active tickers and heap usage are
+// counters, and each GC pause seen between runtime samples is a slice on
+// the runtime track.
+//
+// Phases are measured as durations, so their positions within a frame are
+// reconstructed by placing them back to back from the frame start.
+func NewChromeTrace(timeline FrameTimeline, runtimeSamples []RuntimeSample) ChromeTrace {
+	events := []ChromeTraceEvent{
+		chromeTraceMetadata("process_name", 0, "Drift"),
+		chromeTraceMetadata("thread_name", chromeTraceUITid, "UI"),
+		chromeTraceMetadata("thread_name", chromeTraceRuntimeTid, "Runtime"),
+	}
+
+	threshold := timeline.ThresholdMs
+	for _, sample := range timeline.Samples {
+		start := float64(sample.StartUs)
+		if sample.StartUs == 0 {
+			start = float64(sample.Timestamp) * 1000
+		}
+
+		args := map[string]any{
+			"counts": sample.Counts,
+			"flags":  sample.Flags,
+		}
+		if sample.InputLatencyMs > 0 {
+			args["inputLatencyMs"] = sample.InputLatencyMs
+		}
+		if threshold > 0 && sample.FrameMs > threshold {
+			args["dropped"] = true
+		}
+		events = append(events, ChromeTraceEvent{
+			Name: "Frame",
+			Cat:  "frame",
+			Ph:   "X",
+			Ts:   start,
+			Dur:  sample.FrameMs * 1000,
+			Pid:  chromeTracePid,
+			Tid:  chromeTraceUITid,
+			Args: args,
+		})
+
+		phases := []struct {
+			name string
+			ms   float64
+			args map[string]any
+		}{
+			{"Dispatch", sample.Phases.DispatchMs, map[string]any{"callbacks": sample.Counts.DispatchCallbacks}},
+			{"Animate", sample.Phases.AnimateMs, map[string]any{"tickers": sample.Counts.ActiveTickers}},
+			{"Build", sample.Phases.BuildMs, nil},
+			{"Trace overhead", sample.Phases.TraceOverheadMs, nil},
+			{"Layout", sample.Phases.LayoutMs, chromeTraceDirtyArgs(sample.Counts.DirtyLayout, sample.DirtyTypes.Layout)},
+			{"Semantics", sample.Phases.SemanticsMs, chromeTraceDirtyArgs(sample.Counts.DirtySemantics, sample.DirtyTypes.Semantics)},
+			{"Record", sample.Phases.RecordMs, chromeTraceDirtyArgs(sample.Counts.DirtyPaintBoundaries, sample.DirtyTypes.Paint)},
+			{"Geometry", sample.Phases.GeometryMs, nil},
+		}
+		at := start
+		for _, phase := range phases {
+			if phase.ms <= 0 {
+				continue
+			}
+			events = append(events, ChromeTraceEvent{
+				Name: phase.name,
+				Cat:  "phase",
+				Ph:   "X",
+				Ts:   at,
+				Dur:  phase.ms * 1000,
+				Pid:  chromeTracePid,
+				Tid:  chromeTraceUITid,
+				Args: phase.args,
+			})
+			at += phase.ms * 1000
+		}
+
+		events = append(events, ChromeTraceEvent{
+			Name: "Active tickers",
+			Ph:   "C",
+			Ts:   start,
+			Pid:  chromeTracePid,
+			Tid:  chromeTraceUITid,
+			Args: map[string]any{"tickers": sample.Counts.ActiveTickers},
+		})
+	}
+
+	for i, sample := range runtimeSamples {
+		ts := float64(sample.Timestamp) * 1000
+		events = append(events, ChromeTraceEvent{
+			Name: "Heap",
+			Ph:   "C",
+			Ts:   ts,
+			Pid:  chromeTracePid,
+			Tid:  chromeTraceRuntimeTid,
+			Args: map[string]any{"heapAlloc": sample.HeapAlloc, "heapInuse": sample.HeapInuse},
+		})
+		if i == 0 || sample.NumGC <= runtimeSamples[i-1].NumGC || sample.LastGCTime == 0 {
+			continue
+		}
+		// Samples only keep the most recent pause, so earlier collections
+		// since the previous sample are counted but not drawn.
+		events = append(events, ChromeTraceEvent{
+			Name: "GC",
+			Cat:  "gc",
+			Ph:   "X",
+			Ts:   float64(sample.LastGCTime) * 1000,
+			Dur:  float64(sample.LastPauseNs) / float64(time.Microsecond),
+			Pid:  chromeTracePid,
+			Tid:  chromeTraceRuntimeTid,
+			Args: map[string]any{
+				"numGC":       sample.NumGC,
+				"collections": sample.NumGC - runtimeSamples[i-1].NumGC,
+			},
+		})
+	}
+
+	return ChromeTrace{TraceEvents: events, DisplayTimeUnit: "ms"}
+}
+
+// WriteChromeTrace writes the app's recorded frames and runtime samples as
+// Chrome trace JSON, for loading into Perfetto (ui.perfetto.dev) or
+// chrome://tracing. Frame tracing is enabled with the debug server; runtime
+// samples are included when runtime sampling is on.
+func WriteChromeTrace(w io.Writer) error {
+	frameLock.Lock()
+	trace := app.frameTrace
+	runtimeBuffer := app.runtimeSamples
+	frameLock.Unlock()
+
+	var timeline FrameTimeline
+	if trace != nil {
+		timeline = trace.Snapshot()
+	}
+	var runtimeSamples []RuntimeSample
+	if runtimeBuffer != nil {
+		runtimeSamples = runtimeBuffer.Snapshot()
+	}
+	return json.NewEncoder(w).Encode(NewChromeTrace(timeline, runtimeSamples))
+}
+
+func chromeTraceMetadata(name string, tid int, value string) ChromeTraceEvent {
+	return ChromeTraceEvent{
+		Name: name,
+		Ph:   "M",
+		Pid:  chromeTracePid,
+		Tid:  tid,
+		Args: map[string]any{"name": value},
+	}
+}
+
+func chromeTraceDirtyArgs(count int, types []layout.TypeCount) map[string]any {
+	return map[string]any{"dirty": count, "dirtyTypes": types}
+}
@@ -0,0 +1,145 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func findTraceEvents(trace ChromeTrace, name string) []ChromeTraceEvent {
+	var events []ChromeTraceEvent
+	for _, e := range trace.TraceEvents {
+		if e.Name == name {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func TestNewChromeTrace_FramePhases(t *testing.T) {
+	timeline := FrameTimeline{
+		ThresholdMs: 16,
+		Samples: []FrameSample{{
+			Timestamp: 1000,
+			StartUs:   1_000_250,
+			FrameMs:   20,
+			Phases:    FramePhaseTimings{DispatchMs: 1, AnimateMs: 2, BuildMs: 5, LayoutMs: 4, RecordMs: 3},
+			Counts:    FrameCounts{DispatchCallbacks: 3, ActiveTickers: 2},
+		}},
+	}
+	trace := NewChromeTrace(timeline, nil)
+
+	frames := findTraceEvents(trace, "Frame")
+	if len(frames) != 1 {
+		t.Fatalf("expected one frame, got %d", len(frames))
+	}
+	if frames[0].Ts != 1_000_250 || frames[0].Dur != 20_000 || frames[0].Ph != "X" {
+		t.Errorf("unexpected frame event %+v", frames[0])
+	}
+	if frames[0].Args["dropped"] != true {
+		t.Error("expected a frame over the threshold to be marked dropped")
+	}
+
+	// Phases are laid out back to back from the frame start.
+	want := []struct {
+		name string
+		ts   float64
+		dur  float64
+	}{
+		{"Dispatch", 1_000_250, 1000},
+		{"Animate", 1_001_250, 2000},
+		{"Build", 1_003_250, 5000},
+		{"Layout", 1_008_250, 4000},
+		{"Record", 1_012_250, 3000},
+	}
+	for _, w := range want {
+		events := findTraceEvents(trace, w.name)
+		if len(events) != 1 {
+			t.Errorf("%s: expected one event, got %d", w.name, len(events))
+			continue
+		}
+		if events[0].Ts != w.ts || events[0].Dur != w.dur {
+			t.Errorf("%s: expected ts=%v dur=%v, got ts=%v dur=%v", w.name, w.ts, w.dur, events[0].Ts, events[0].Dur)
+		}
+	}
+	if len(findTraceEvents(trace, "Semantics")) != 0 {
+		t.Error("expected phases that took no time to be omitted")
+	}
+	if dispatch := findTraceEvents(trace, "Dispatch"); len(dispatch) == 1 && dispatch[0].Args["callbacks"] != 3 {
+		t.Errorf("expected the dispatch callback count, got %v", dispatch[0].Args)
+	}
+	if tickers := findTraceEvents(trace, "Active tickers"); len(tickers) != 1 || tickers[0].Ph != "C" {
+		t.Errorf("expected an active tickers counter, got %+v", tickers)
+	}
+}
+
+func TestNewChromeTrace_GC(t *testing.T) {
+	samples := []RuntimeSample{
+		{Timestamp: 1000, NumGC: 4, LastGCTime: 990, LastPauseNs: 50_000},
+		{Timestamp: 1500, NumGC: 4, LastGCTime: 990, LastPauseNs: 50_000},
+		{Timestamp: 2000, NumGC: 6, LastGCTime: 1800, LastPauseNs: 120_000},
+	}
+	trace := NewChromeTrace(FrameTimeline{}, samples)
+
+	if heap := findTraceEvents(trace, "Heap"); len(heap) != 3 {
+		t.Errorf("expected a heap counter per sample, got %d", len(heap))
+	}
+	gc := findTraceEvents(trace, "GC")
+	if len(gc) != 1 {
+		t.Fatalf("expected one GC pause, got %d", len(gc))
+	}
+	if gc[0].Ts != 1_800_000 || gc[0].Dur != 120 || gc[0].Tid != chromeTraceRuntimeTid {
+		t.Errorf("unexpected GC event %+v", gc[0])
+	}
+	if gc[0].Args["collections"] != uint32(2) {
+		t.Errorf("expected 2 collections, got %v", gc[0].Args["collections"])
+	}
+}
+
+func TestWriteChromeTrace(t *testing.T) {
+	a := swapApp(t)
+	a.frameTrace = NewFrameTraceBuffer(8, 0)
+	a.frameTrace.Add(FrameSample{Timestamp: 5, StartUs: 5000, FrameMs: 2}, 2*time.Millisecond)
+
+	var buf bytes.Buffer
+	if err := WriteChromeTrace(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var trace ChromeTrace
+	if err := json.Unmarshal(buf.Bytes(), &trace); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(findTraceEvents(trace, "Frame")) != 1 {
+		t.Errorf("expected one frame in %s", buf.String())
+	}
+}
+
+func TestDebugServer_TraceEndpoint(t *testing.T) {
+	a := swapApp(t)
+
+	rec := httptest.NewRecorder()
+	handleChromeTrace(rec, httptest.NewRequest(http.MethodGet, "/trace", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 without frame tracing, got %d", rec.Code)
+	}
+
+	a.frameTrace = NewFrameTraceBuffer(8, 0)
+	for i := range 3 {
+		a.frameTrace.Add(FrameSample{StartUs: int64(i) * 20_000, FrameMs: float64(i + 1)}, 0)
+	}
+	rec = httptest.NewRecorder()
+	handleChromeTrace(rec, httptest.NewRequest(http.MethodGet, "/trace?min_ms=2", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	var trace ChromeTrace
+	if err := json.Unmarshal(rec.Body.Bytes(), &trace); err != nil {
+		t.Fatal(err)
+	}
+	if frames := findTraceEvents(trace, "Frame"); len(frames) != 2 {
+		t.Errorf("expected the min_ms filter to keep 2 frames, got %d", len(frames))
+	}
+}
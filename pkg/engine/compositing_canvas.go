@@ -124,6 +124,10 @@ func (c *CompositingCanvas) DrawPath(path *graphics.Path, paint graphics.Paint)
 	c.inner.DrawPath(path, paint)
 }
 
+func (c *CompositingCanvas) DrawVertices(vertices *graphics.Vertices, mode graphics.BlendMode, paint graphics.Paint) {
+	c.inner.DrawVertices(vertices, mode, paint)
+}
+
 func (c *CompositingCanvas) DrawRectShadow(rect graphics.Rect, shadow graphics.BoxShadow) {
 	c.inner.DrawRectShadow(rect, shadow)
 }
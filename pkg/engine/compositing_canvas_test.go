@@ -59,7 +59,9 @@ func (c *nullCanvas) DrawText(layout *graphics.TextLayout, position graphics.Off
 func (c *nullCanvas) DrawImage(img image.Image, position graphics.Offset)                     {}
 func (c *nullCanvas) DrawImageRect(img image.Image, srcRect, dstRect graphics.Rect, quality graphics.FilterQuality, cacheKey uintptr) {
 }
-func (c *nullCanvas) DrawPath(path *graphics.Path, paint graphics.Paint)              {}
+func (c *nullCanvas) DrawPath(path *graphics.Path, paint graphics.Paint) {}
+func (c *nullCanvas) DrawVertices(vertices *graphics.Vertices, mode graphics.BlendMode, paint graphics.Paint) {
+}
 func (c *nullCanvas) DrawRectShadow(rect graphics.Rect, shadow graphics.BoxShadow)    {}
 func (c *nullCanvas) DrawRRectShadow(rrect graphics.RRect, shadow graphics.BoxShadow) {}
 func (c *nullCanvas) SaveLayerBlur(bounds graphics.Rect, sigmaX, sigmaY float64)      {}
@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// Config is the engine's startup configuration. Apps normally build it with
+// drift.NewApp options rather than directly; read the active configuration
+// with [CurrentConfig].
+type Config struct {
+	// BackgroundColor clears the canvas before each frame. See
+	// [SetBackgroundColor].
+	BackgroundColor graphics.Color
+	// ViewWarmUp pre-warms expensive platform views at startup. See
+	// [ShouldWarmUpViews].
+	ViewWarmUp bool
+	// DebugMode shows detailed messages and stack traces in error widgets.
+	DebugMode bool
+	// Diagnostics configures the diagnostics overlays and debug server, or
+	// nil to disable them.
+	Diagnostics *DiagnosticsConfig
+	// Watchdog reports frames that block the UI thread for too long, or
+	// nil to disable it. See [SetWatchdog].
+	Watchdog *WatchdogConfig
+}
+
+// Validate reports the first invalid setting in cfg, or nil if cfg can be
+// passed to [Configure].
+func (cfg Config) Validate() error {
+	if d := cfg.Diagnostics; d != nil {
+		switch {
+		case d.DebugServerPort < 0 || d.DebugServerPort > 65535:
+			return fmt.Errorf("diagnostics: debug server port %d out of range", d.DebugServerPort)
+		case d.GraphSamples < 0:
+			return fmt.Errorf("diagnostics: negative graph samples %d", d.GraphSamples)
+		case d.TargetFrameTime < 0:
+			return fmt.Errorf("diagnostics: negative target frame time %v", d.TargetFrameTime)
+		case d.RebuildStatsWindow < 0:
+			return fmt.Errorf("diagnostics: negative rebuild stats window %v", d.RebuildStatsWindow)
+		case d.RuntimeSampleInterval < 0 || d.RuntimeSampleWindow < 0:
+			return fmt.Errorf("diagnostics: negative runtime sample interval or window")
+		}
+	}
+	if w := cfg.Watchdog; w != nil && w.Budget < 0 {
+		return fmt.Errorf("watchdog: negative budget %v", w.Budget)
+	}
+	return nil
+}
+
+// Configure applies cfg to the engine. Call it once at startup, before the
+// first frame; drift.Run does this for you. Diagnostics and Watchdog are
+// copied, so later changes to the caller's value have no effect.
+func Configure(cfg Config) {
+	backgroundColor.Store(uint32(cfg.BackgroundColor))
+	viewWarmupDisabled.Store(!cfg.ViewWarmUp)
+	core.DebugMode = cfg.DebugMode
+	if cfg.Diagnostics != nil {
+		diagnostics := *cfg.Diagnostics
+		cfg.Diagnostics = &diagnostics
+	}
+	setDiagnostics(cfg.Diagnostics)
+	SetWatchdog(cfg.Watchdog)
+}
+
+// CurrentConfig returns a snapshot of the active configuration. The result,
+// including Diagnostics and Watchdog, is a copy: changing it does not affect
+// the engine.
+func CurrentConfig() Config {
+	cfg := Config{
+		BackgroundColor: graphics.Color(backgroundColor.Load()),
+		ViewWarmUp:      !viewWarmupDisabled.Load(),
+		DebugMode:       core.DebugMode,
+	}
+	frameLock.Lock()
+	if app.diagnosticsConfig != nil {
+		diagnostics := *app.diagnosticsConfig
+		cfg.Diagnostics = &diagnostics
+	}
+	if app.watchdog != nil {
+		watchdog := app.watchdog.config
+		cfg.Watchdog = &watchdog
+	}
+	frameLock.Unlock()
+	return cfg
+}
@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestConfigure_CurrentConfigRoundTrip(t *testing.T) {
+	original := CurrentConfig()
+	defer Configure(original)
+
+	diagnostics := &DiagnosticsConfig{ShowFPS: true, GraphSamples: 30}
+	Configure(Config{
+		BackgroundColor: graphics.RGB(10, 20, 30),
+		ViewWarmUp:      false,
+		DebugMode:       false,
+		Diagnostics:     diagnostics,
+	})
+
+	cfg := CurrentConfig()
+	if cfg.BackgroundColor != graphics.RGB(10, 20, 30) {
+		t.Errorf("BackgroundColor = %v, want %v", cfg.BackgroundColor, graphics.RGB(10, 20, 30))
+	}
+	if cfg.ViewWarmUp || ShouldWarmUpViews() {
+		t.Error("expected view warm-up disabled")
+	}
+	if cfg.DebugMode {
+		t.Error("expected debug mode disabled")
+	}
+	if cfg.Diagnostics == nil || !cfg.Diagnostics.ShowFPS || cfg.Diagnostics.GraphSamples != 30 {
+		t.Fatalf("Diagnostics = %+v, want ShowFPS with 30 samples", cfg.Diagnostics)
+	}
+
+	// Neither the caller's value nor the snapshot aliases engine state.
+	diagnostics.ShowFPS = false
+	cfg.Diagnostics.GraphSamples = 90
+	again := CurrentConfig()
+	if !again.Diagnostics.ShowFPS || again.Diagnostics.GraphSamples != 30 {
+		t.Errorf("engine diagnostics changed through a copy: %+v", again.Diagnostics)
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{"empty", Config{}, false},
+		{"defaults", Config{Diagnostics: DefaultDiagnosticsConfig()}, false},
+		{"negative port", Config{Diagnostics: &DiagnosticsConfig{DebugServerPort: -1}}, true},
+		{"port too large", Config{Diagnostics: &DiagnosticsConfig{DebugServerPort: 70000}}, true},
+		{"negative samples", Config{Diagnostics: &DiagnosticsConfig{GraphSamples: -5}}, true},
+		{"negative frame time", Config{Diagnostics: &DiagnosticsConfig{TargetFrameTime: -1}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/errors"
 	"github.com/go-drift/drift/pkg/layout"
 )
 
@@ -84,6 +85,7 @@ type WidgetTreeNode struct {
 	Depth       int              `json:"depth"`
 	NeedsBuild  bool             `json:"needsBuild"`
 	HasState    bool             `json:"hasState,omitempty"`
+	Rebuilds    int              `json:"rebuilds,omitempty"`
 	Children    []WidgetTreeNode `json:"children,omitempty"`
 }
 
@@ -115,6 +117,16 @@ func startDebugServer(port int) (int, error) {
 	mux.HandleFunc("/frames", handleFrameTimeline)
 	mux.HandleFunc("/runtime", handleRuntime)
 	mux.HandleFunc("/jank", handleJankSnapshot)
+	mux.HandleFunc("/trace", handleChromeTrace)
+	mux.HandleFunc("/rebuilds", handleRebuildStats)
+	mux.HandleFunc("/rebuilds/elements", handleElementRebuilds)
+	mux.HandleFunc("/leaks", handleLeaks)
+	mux.HandleFunc("/memory", handleMemory)
+	mux.HandleFunc("/inspector/tree", handleInspectorTree)
+	mux.HandleFunc("/inspector/node", handleInspectorNode)
+	mux.HandleFunc("/inspector/selection", handleInspectorSelection)
+	mux.HandleFunc("/inspector/select-mode", handleInspectorSelectMode)
+	mux.HandleFunc("/reassemble", handleReassemble)
 	mux.HandleFunc("/health", handleHealth)
 	mux.HandleFunc("/debug", handleDebug)
 
@@ -208,6 +220,22 @@ func handleHealth(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(`{"status":"ok"}`))
 }
 
+// handleReassemble schedules a state-preserving rebuild of the widget tree.
+// It returns without waiting for the frame, so file watchers can call it on
+// every change; repeated calls before the rebuild runs are coalesced.
+func handleReassemble(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	Reassemble()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	w.Write([]byte(`{"status":"scheduled"}`))
+}
+
 // handleDebug returns diagnostic info about the render tree state.
 func handleDebug(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -298,6 +326,142 @@ func handleFrameTimeline(w http.ResponseWriter, r *http.Request) {
 	w.Write(data)
 }
 
+// handleRebuildStats returns the widget types with the most build time as JSON.
+func handleRebuildStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	frameLock.Lock()
+	stats := app.rebuildStats
+	frameLock.Unlock()
+	if stats == nil {
+		http.Error(w, "rebuild stats disabled", http.StatusServiceUnavailable)
+		return
+	}
+
+	limit := 20
+	if v := parseFloatQuery(r, "limit"); v > 0 {
+		limit = int(v)
+	}
+	window := stats.Window()
+	if v := parseFloatQuery(r, "window"); v > 0 {
+		window = min(time.Duration(v*float64(time.Second)), window)
+	}
+
+	resp := struct {
+		WindowMs float64       `json:"windowMs"`
+		Widgets  []RebuildStat `json:"widgets"`
+	}{
+		WindowMs: durationToMillis(window),
+		Widgets:  stats.Top(limit, window),
+	}
+
+	data, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("json encode error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// handleElementRebuilds returns the elements that rebuilt most often, with
+// what triggered their rebuilds, as JSON. POST clears the counts.
+func handleElementRebuilds(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !core.RebuildTrackingEnabled() {
+		http.Error(w, "rebuild tracking disabled", http.StatusServiceUnavailable)
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		frameLock.Lock()
+		core.ResetRebuildCounts(app.root)
+		frameLock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"reset"}`))
+		return
+	}
+
+	limit := 20
+	if v := parseFloatQuery(r, "limit"); v > 0 {
+		limit = int(v)
+	}
+
+	frameLock.Lock()
+	elements := TopElementRebuilds(app.root, limit)
+	frameLock.Unlock()
+
+	resp := struct {
+		Elements []ElementRebuildStat `json:"elements"`
+	}{
+		Elements: elements,
+	}
+
+	data, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("json encode error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// handleLeaks returns objects that outlived the State that created them as JSON.
+func handleLeaks(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !errors.DisposalAuditingEnabled() {
+		http.Error(w, "disposal auditing disabled", http.StatusServiceUnavailable)
+		return
+	}
+
+	resp := struct {
+		Leaks []errors.LeakReport `json:"leaks"`
+	}{
+		Leaks: errors.Leaks(),
+	}
+	if resp.Leaks == nil {
+		resp.Leaks = []errors.LeakReport{}
+	}
+
+	data, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("json encode error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// handleMemory returns a snapshot of image cache bytes, live Skia handles,
+// layer count, and Go memory stats as JSON.
+func handleMemory(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := json.MarshalIndent(ReadMemorySnapshot(), "", "  ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("json encode error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
 // handleRuntime returns recent runtime/GC samples as JSON.
 func handleRuntime(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -368,6 +532,9 @@ func applyFrameFilters(r *http.Request, resp *FrameTimeline) {
 	if v := parseFloatQuery(r, "trace_overhead_ms"); v > 0 {
 		filters = append(filters, func(s FrameSample) bool { return s.Phases.TraceOverheadMs >= v })
 	}
+	if v := parseFloatQuery(r, "input_ms"); v > 0 {
+		filters = append(filters, func(s FrameSample) bool { return s.InputLatencyMs >= v })
+	}
 	if value := r.URL.Query().Get("resumed"); value != "" {
 		if parsed, err := strconv.ParseBool(value); err == nil && parsed {
 			filters = append(filters, func(s FrameSample) bool { return s.Flags.ResumedThisFrame })
@@ -463,6 +630,43 @@ func handleJankSnapshot(w http.ResponseWriter, r *http.Request) {
 	w.Write(data)
 }
 
+// handleChromeTrace returns recent frames and runtime samples as Chrome
+// trace JSON. It accepts the same filters as /frames and /runtime.
+func handleChromeTrace(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	frameLock.Lock()
+	trace := app.frameTrace
+	runtimeBuffer := app.runtimeSamples
+	frameLock.Unlock()
+
+	if trace == nil {
+		http.Error(w, "frame tracing disabled", http.StatusServiceUnavailable)
+		return
+	}
+
+	frames := trace.Snapshot()
+	applyFrameFilters(r, &frames)
+
+	var runtimeSamples []RuntimeSample
+	if runtimeBuffer != nil {
+		runtimeSamples = applyRuntimeFilters(r, runtimeBuffer.Snapshot())
+	}
+
+	data, err := json.Marshal(NewChromeTrace(frames, runtimeSamples))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("json encode error: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="drift-trace.json"`)
+	w.Write(data)
+}
+
 func parseFloatQuery(r *http.Request, key string) float64 {
 	value := r.URL.Query().Get(key)
 	if value == "" {
@@ -487,6 +691,7 @@ func serializeWidgetTree(elem core.Element, depth int) WidgetTreeNode {
 		ElementType: reflect.TypeOf(elem).String(),
 		Depth:       elem.Depth(),
 		NeedsBuild:  getNeedsBuild(elem),
+		Rebuilds:    core.RebuildInfoOf(elem).Count,
 	}
 
 	if widget != nil {
@@ -541,6 +746,23 @@ func getNeedsBuild(elem core.Element) bool {
 // serializeRenderTreeWithDepth recursively converts a render object tree to JSON-serializable form.
 // The depth parameter limits recursion to prevent stack overflow.
 func serializeRenderTreeWithDepth(obj layout.RenderObject, depth int) RenderTreeNode {
+	node := describeRenderObject(obj)
+
+	// Recurse into children (with depth limit)
+	if depth < maxTreeDepth {
+		if cv, ok := obj.(layout.ChildVisitor); ok {
+			cv.VisitChildren(func(child layout.RenderObject) {
+				node.Children = append(node.Children, serializeRenderTreeWithDepth(child, depth+1))
+			})
+		}
+	}
+
+	return node
+}
+
+// describeRenderObject converts a single render object, without its
+// children, to JSON-serializable form.
+func describeRenderObject(obj layout.RenderObject) RenderTreeNode {
 	size := obj.Size()
 	node := RenderTreeNode{
 		Type: reflect.TypeOf(obj).String(),
@@ -577,15 +799,6 @@ func serializeRenderTreeWithDepth(obj layout.RenderObject, depth int) RenderTree
 		}
 	}
 
-	// Recurse into children (with depth limit)
-	if depth < maxTreeDepth {
-		if cv, ok := obj.(layout.ChildVisitor); ok {
-			cv.VisitChildren(func(child layout.RenderObject) {
-				node.Children = append(node.Children, serializeRenderTreeWithDepth(child, depth+1))
-			})
-		}
-	}
-
 	return node
 }
 
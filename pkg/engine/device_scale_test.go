@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// scaleProbe is a leaf render widget that records layouts and scale
+// notifications.
+type scaleProbe struct {
+	core.RenderObjectBase
+	render *renderScaleProbe
+}
+
+func (p scaleProbe) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	p.render.SetSelf(p.render)
+	return p.render
+}
+
+func (p scaleProbe) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {}
+
+type renderScaleProbe struct {
+	layout.RenderBoxBase
+	layouts int
+	scales  []float64
+}
+
+func (r *renderScaleProbe) PerformLayout() {
+	r.layouts++
+	r.SetSize(r.Constraints().Constrain(graphics.Size{}))
+}
+
+func (r *renderScaleProbe) Paint(ctx *layout.PaintContext) {}
+
+func (r *renderScaleProbe) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
+
+func (r *renderScaleProbe) DeviceScaleChanged(scale float64) {
+	r.scales = append(r.scales, scale)
+}
+
+func TestSetDeviceScale_InvalidatesRenderTree(t *testing.T) {
+	a := swapApp(t)
+	probe := &renderScaleProbe{}
+	a.userApp = scaleProbe{render: probe}
+
+	if _, err := a.StepFrame(testSize); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.StepFrame(testSize); err != nil {
+		t.Fatal(err)
+	}
+	if probe.layouts != 1 {
+		t.Fatalf("expected one layout before the scale change, got %d", probe.layouts)
+	}
+
+	a.SetDeviceScale(2)
+	if len(probe.scales) != 1 || probe.scales[0] != 2 {
+		t.Errorf("expected DeviceScaleChanged(2), got %v", probe.scales)
+	}
+	if !a.scalePurgePending.Load() {
+		t.Error("expected a GPU cache purge to be pending")
+	}
+	// A window moved to a 2x monitor keeps its logical size, so only the
+	// invalidation forces the relayout.
+	if _, err := a.StepFrame(graphics.Size{Width: 200, Height: 200}); err != nil {
+		t.Fatal(err)
+	}
+	if probe.layouts != 2 {
+		t.Errorf("expected a relayout after the scale change, got %d layouts", probe.layouts)
+	}
+	if got := probe.Size(); got != testSize {
+		t.Errorf("expected logical size %v at scale 2, got %v", testSize, got)
+	}
+	rootLayer := a.rootRender.(interface{ Layer() *graphics.Layer }).Layer()
+	if rootLayer == nil || rootLayer.Dirty {
+		t.Error("expected the root layer to be recorded again")
+	}
+
+	a.SetDeviceScale(2)
+	if len(probe.scales) != 1 {
+		t.Errorf("expected no notification when the scale is unchanged, got %v", probe.scales)
+	}
+}
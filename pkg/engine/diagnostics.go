@@ -25,15 +25,54 @@ type DiagnosticsConfig struct {
 	ShowFPS bool
 	// ShowFrameGraph displays a graph of frame times.
 	ShowFrameGraph bool
+	// ShowPerformanceOverlay displays two bar charts of per-frame time: the
+	// UI track is the time to build, lay out, and record a frame, and the
+	// raster track is the time to composite it and flush it to the GPU.
+	// Bars over TargetFrameTime are highlighted as jank.
+	ShowPerformanceOverlay bool
 	// ShowLayoutBounds draws colored borders around all widget bounds.
 	ShowLayoutBounds bool
+	// ShowInputLatency displays the average time from a pointer event
+	// arriving to the next frame being produced.
+	ShowInputLatency bool
+	// ShowTouches draws a ripple at each touch point, which is useful for
+	// screen recordings and demos. Touches are drawn above the app and the
+	// HUD and never intercept pointers.
+	ShowTouches bool
+	// ShowRebuildStats lists the widget types with the most build time over
+	// the last RebuildStatsWindow, with their rebuild counts.
+	ShowRebuildStats bool
+	// RebuildStatsWindow controls how much rebuild history is aggregated for
+	// the HUD and the debug server's /rebuilds endpoint. Defaults to 5s if zero.
+	RebuildStatsWindow time.Duration
+	// ShowMemory displays the Go heap, decoded image bytes, layer count, and
+	// live Skia surface, paragraph, and path counts, refreshed every second.
+	// The debug server serves the same figures at /memory.
+	ShowMemory bool
+	// AuditDisposal tracks animation controllers, tickers, and scroll
+	// controllers created by each State and reports those still active after
+	// the State is disposed. Always on when DebugServerPort is set, which
+	// serves the reports at /leaks.
+	AuditDisposal bool
+	// TrackRebuilds counts how often each element builds again and records
+	// what triggered each rebuild: SetState, an inherited widget change, or
+	// the parent. Always on when DebugServerPort is set, which serves the
+	// counts at /rebuilds/elements. See core.SetRebuildTracking.
+	TrackRebuilds bool
+	// TrackNativeLeaks records where each Skia surface, paragraph, path,
+	// SVG, and Lottie animation is created and reports those garbage
+	// collected without Destroy, with their creation stacks, at /leaks.
+	// Creating these objects is slower while it is on. See
+	// skia.SetLeakTracking.
+	TrackNativeLeaks bool
 	// Position controls where the HUD is displayed.
 	Position DiagnosticsPosition
 	// GraphSamples is the number of frame samples to display in the graph.
 	// Defaults to 60 if zero.
 	GraphSamples int
 	// TargetFrameTime is the target frame duration for coloring the graph.
-	// Defaults to 16.67ms (60fps) if zero.
+	// Defaults to the display's frame interval, such as 16.67ms at 60Hz or
+	// 8.33ms at 120Hz, if zero.
 	TargetFrameTime time.Duration
 	// DebugServerPort enables an HTTP debug server on the specified port.
 	// 0 = disabled, >0 = port number (e.g., 9999).
@@ -141,6 +180,21 @@ func (b *FrameTimingBuffer) SamplesInto(dst []time.Duration) int {
 	return n
 }
 
+// Average returns the mean of the samples in the buffer, or 0 when empty.
+func (b *FrameTimingBuffer) Average() time.Duration {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.count == 0 {
+		return 0
+	}
+	var total time.Duration
+	for i := 0; i < b.count; i++ {
+		total += b.samples[i]
+	}
+	return total / time.Duration(b.count)
+}
+
 // Count returns the number of samples currently in the buffer.
 func (b *FrameTimingBuffer) Count() int {
 	b.mu.RLock()
@@ -1,8 +1,11 @@
 package engine
 
 import (
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
 )
 
 func TestFrameTimingBuffer_Add(t *testing.T) {
@@ -148,3 +151,129 @@ func TestFrameTimingBuffer_SamplesInto(t *testing.T) {
 		}
 	}
 }
+
+func TestFrameTimingBuffer_Average(t *testing.T) {
+	buf := NewFrameTimingBuffer(2)
+	if got := buf.Average(); got != 0 {
+		t.Errorf("expected 0 for empty buffer, got %v", got)
+	}
+	buf.Add(10 * time.Millisecond)
+	buf.Add(20 * time.Millisecond)
+	buf.Add(40 * time.Millisecond)
+	if got := buf.Average(); got != 30*time.Millisecond {
+		t.Errorf("expected 30ms average of newest samples, got %v", got)
+	}
+}
+
+func TestInputLatency_MeasuresEarliestPendingEvent(t *testing.T) {
+	a := &appRunner{inputLatency: NewFrameTimingBuffer(10)}
+
+	if _, ok := a.takeInputLatencyLocked(); ok {
+		t.Fatal("expected no latency without input")
+	}
+
+	earliest := time.Now().Add(-50 * time.Millisecond)
+	a.recordInputLocked(PointerEvent{Phase: PointerPhaseDown, Timestamp: earliest.Add(20 * time.Millisecond)}, graphics.Offset{})
+	a.recordInputLocked(PointerEvent{Phase: PointerPhaseMove, Timestamp: earliest}, graphics.Offset{})
+
+	latency, ok := a.takeInputLatencyLocked()
+	if !ok {
+		t.Fatal("expected latency after input")
+	}
+	if latency < 50*time.Millisecond {
+		t.Errorf("expected latency from earliest event (>= 50ms), got %v", latency)
+	}
+	if a.inputLatency.Count() != 1 {
+		t.Errorf("expected 1 latency sample, got %d", a.inputLatency.Count())
+	}
+	if !strings.HasPrefix(a.inputLatencyLabel, "Input: ") {
+		t.Errorf("unexpected label %q", a.inputLatencyLabel)
+	}
+	if _, ok := a.takeInputLatencyLocked(); ok {
+		t.Error("expected pending input to be cleared after a frame")
+	}
+}
+
+func TestInputLatency_DisabledSkipsRecording(t *testing.T) {
+	a := &appRunner{}
+	a.recordInputLocked(PointerEvent{Phase: PointerPhaseDown}, graphics.Offset{})
+	if _, ok := a.takeInputLatencyLocked(); ok {
+		t.Error("expected no latency when neither the HUD nor tracing is enabled")
+	}
+}
+
+func TestTouchMarks_FadeAfterPointerUp(t *testing.T) {
+	a := &appRunner{diagnosticsConfig: &DiagnosticsConfig{ShowTouches: true}}
+
+	if !a.recordInputLocked(PointerEvent{PointerID: 1, Phase: PointerPhaseDown}, graphics.Offset{X: 10, Y: 10}) {
+		t.Fatal("expected touch marks to change on pointer down")
+	}
+	a.recordInputLocked(PointerEvent{PointerID: 1, Phase: PointerPhaseMove}, graphics.Offset{X: 30, Y: 40})
+	if len(a.touchMarks) != 1 || a.touchMarks[0].position != (graphics.Offset{X: 30, Y: 40}) {
+		t.Fatalf("expected one mark following the pointer, got %+v", a.touchMarks)
+	}
+
+	a.recordInputLocked(PointerEvent{PointerID: 1, Phase: PointerPhaseUp}, graphics.Offset{X: 30, Y: 40})
+	upAt := a.touchMarks[0].upAt
+	if upAt.IsZero() {
+		t.Fatal("expected mark to record pointer up")
+	}
+
+	a.stepTouchMarksLocked(upAt.Add(touchFadeDuration / 2))
+	if len(a.touchMarks) != 1 {
+		t.Errorf("expected mark to remain while fading, got %d", len(a.touchMarks))
+	}
+	a.stepTouchMarksLocked(upAt.Add(touchFadeDuration))
+	if len(a.touchMarks) != 0 {
+		t.Errorf("expected mark removed after fade, got %d", len(a.touchMarks))
+	}
+}
+
+func TestTouchMarks_DisabledIgnoresPointers(t *testing.T) {
+	a := &appRunner{diagnosticsConfig: &DiagnosticsConfig{}}
+	if a.recordInputLocked(PointerEvent{PointerID: 1, Phase: PointerPhaseDown}, graphics.Offset{}) {
+		t.Error("expected no touch marks when ShowTouches is false")
+	}
+	if len(a.touchMarks) != 0 {
+		t.Errorf("expected no marks, got %d", len(a.touchMarks))
+	}
+}
+
+func TestPerformanceOverlay_RecordsUIAndRasterTimes(t *testing.T) {
+	a := swapApp(t)
+	a.diagnosticsConfig = &DiagnosticsConfig{ShowPerformanceOverlay: true}
+	a.uiTiming = NewFrameTimingBuffer(10)
+	a.rasterTiming = NewFrameTimingBuffer(10)
+
+	for range 2 {
+		if _, err := a.StepFrame(testSize); err != nil {
+			t.Fatalf("StepFrame: %v", err)
+		}
+	}
+	app.recordRasterTime(5 * time.Millisecond)
+
+	source := &diagnosticsDataSource{runner: a}
+	if got := source.ThreadSampleCount(); got != 2 {
+		t.Errorf("expected 2 UI samples, got %d", got)
+	}
+	dst := make([]time.Duration, 10)
+	if n := source.RasterSamplesInto(dst); n != 1 || dst[0] != 5*time.Millisecond {
+		t.Errorf("expected one 5ms raster sample, got %v", dst[:n])
+	}
+	if a.hudRenderObject == nil {
+		t.Error("expected the HUD to be built for the performance overlay alone")
+	}
+}
+
+func TestPerformanceOverlay_DisabledSkipsRecording(t *testing.T) {
+	a := swapApp(t)
+	if _, err := a.StepFrame(testSize); err != nil {
+		t.Fatalf("StepFrame: %v", err)
+	}
+	app.recordRasterTime(5 * time.Millisecond)
+
+	source := &diagnosticsDataSource{runner: a}
+	if source.ThreadSampleCount() != 0 || source.RasterSamplesInto(make([]time.Duration, 1)) != 0 {
+		t.Error("expected no samples with the overlay off")
+	}
+}
@@ -17,5 +17,18 @@
 //   - App-facing types: [DiagnosticsConfig], [DiagnosticsPosition], and
 //     [DefaultDiagnosticsConfig] are used by applications to configure the
 //     diagnostics overlay HUD. [SetBackgroundColor] is called by app code
-//     to set the root background color.
+//     to set the root background color. [Config] holds the startup settings
+//     that drift.NewApp options feed into [Configure].
+//
+// # Engine Handles
+//
+// The package-level functions drive a single default [EngineHandle]. Hosts
+// that need several independent widget trees, such as multi-window or
+// add-to-app embeddings, create further handles with [NewEngineHandle].
+//
+// Embedders that host several windows or displays give each a [ViewID] and
+// create its handle with [CreateView]. The default handle is
+// [DefaultViewID]; the others are driven through view-keyed entry points
+// such as [SetViewApp], [SetViewDeviceScale], and [HandleViewPointerEvent],
+// each with its own device scale, frame size, and pointer stream.
 package engine
@@ -15,6 +15,7 @@ import (
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
 	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/skia"
 	"github.com/go-drift/drift/pkg/theme"
 	"github.com/go-drift/drift/pkg/widgets"
 )
@@ -39,6 +40,8 @@ var viewWarmupDisabled atomic.Bool
 //
 // Call this before engine.Run() if your app does not use any platform views
 // and you want to skip the warmup cost (~300-500ms absorbed during startup).
+//
+// Deprecated: Pass drift.WithoutViewWarmUp to drift.NewApp instead.
 func DisableViewWarmUp() {
 	viewWarmupDisabled.Store(true)
 }
@@ -77,7 +80,6 @@ const semanticsDeferralTimeout = 500 * time.Millisecond
 // frameLock protects access to shared UI state across the engine package.
 var frameLock sync.Mutex
 
-var app = newAppRunner()
 var platformFrameScheduled atomic.Bool
 
 // SetDeviceScale updates the device pixel scale factor used for rendering and input.
@@ -87,13 +89,17 @@ func SetDeviceScale(scale float64) {
 
 // RequestFrame marks the render tree as needing paint.
 func RequestFrame() {
+	app.requestFrame()
+}
+
+func (a *appRunner) requestFrame() {
 	if frameLock.TryLock() {
 		defer frameLock.Unlock()
-		app.requestFrameLocked()
+		a.requestFrameLocked()
 		schedulePlatformFrame()
 		return
 	}
-	app.pendingFrameRequest.Store(true)
+	a.pendingFrameRequest.Store(true)
 	schedulePlatformFrame()
 }
 
@@ -110,6 +116,10 @@ func schedulePlatformFrame() {
 // RenderFrame holds the lock. If the lock is held, a frame is actively being
 // processed so we return true to keep the render loop alive.
 func NeedsFrame() bool {
+	return app.needsFrame()
+}
+
+func (a *appRunner) needsFrame() bool {
 	if !frameLock.TryLock() {
 		// The lock is held (typically by StepFrame/RenderFrame), so return
 		// true rather than blocking the caller. At worst this schedules one
@@ -117,7 +127,12 @@ func NeedsFrame() bool {
 		return true
 	}
 	defer frameLock.Unlock()
-	return app.needsFrameLocked()
+	needs := a.needsFrameLocked()
+	if !needs {
+		// Input that produced no frame has nothing to measure against.
+		a.pendingInputAt = time.Time{}
+	}
+	return needs
 }
 
 func (a *appRunner) needsFrameLocked() bool {
@@ -133,6 +148,13 @@ func (a *appRunner) needsFrameLocked() bool {
 	if hasCallbacks {
 		return true
 	}
+	// Need frame to run idle callbacks once the app settles
+	a.dispatchMu.Lock()
+	hasIdle := len(a.idleQueue) > 0
+	a.dispatchMu.Unlock()
+	if hasIdle {
+		return true
+	}
 	// Need frame if explicitly requested
 	if a.pendingFrameRequest.Load() {
 		return true
@@ -145,6 +167,10 @@ func (a *appRunner) needsFrameLocked() bool {
 	if widgets.HasActiveBallistics() {
 		return true
 	}
+	// Need frame while touch ripples are fading out
+	if len(a.touchMarks) > 0 {
+		return true
+	}
 	// Need frame if build/layout/paint is needed
 	if a.buildOwner != nil && a.buildOwner.NeedsWork() {
 		return true
@@ -184,7 +210,13 @@ func SetShowLayoutBounds(show bool) {
 
 // SetDiagnostics configures the diagnostics overlays.
 // Pass nil to disable all diagnostics.
+//
+// Deprecated: Pass drift.WithDiagnostics to drift.NewApp instead.
 func SetDiagnostics(config *DiagnosticsConfig) {
+	setDiagnostics(config)
+}
+
+func setDiagnostics(config *DiagnosticsConfig) {
 	// Determine port changes outside the lock to avoid blocking frame/paint
 	frameLock.Lock()
 	oldPort := 0
@@ -224,6 +256,45 @@ func SetDiagnostics(config *DiagnosticsConfig) {
 			}
 			app.frameTiming = NewFrameTimingBuffer(samples)
 		}
+		if config.ShowInputLatency {
+			if app.inputLatency == nil {
+				app.inputLatency = NewFrameTimingBuffer(config.GraphSamples)
+			}
+		} else {
+			app.inputLatency = nil
+		}
+		if config.ShowPerformanceOverlay {
+			if app.uiTiming == nil {
+				app.uiTiming = NewFrameTimingBuffer(config.GraphSamples)
+				app.rasterTiming = NewFrameTimingBuffer(config.GraphSamples)
+			}
+		} else {
+			app.uiTiming = nil
+			app.rasterTiming = nil
+		}
+		if !config.ShowTouches {
+			app.touchMarks = nil
+		}
+
+		if config.ShowRebuildStats || config.DebugServerPort > 0 {
+			window := config.RebuildStatsWindow
+			if window <= 0 {
+				window = defaultRebuildStatsWindow
+			}
+			if app.rebuildStats == nil || app.rebuildStats.Window() != window {
+				app.rebuildStats = NewRebuildStatsBuffer(window)
+			}
+			core.SetBuildObserver(app.rebuildStats.Record)
+		} else {
+			app.rebuildStats = nil
+			core.SetBuildObserver(nil)
+		}
+		app.rebuildLabels = nil
+		app.memoryLabels = nil
+
+		errors.SetDisposalAuditing(config.AuditDisposal || config.DebugServerPort > 0)
+		core.SetRebuildTracking(config.TrackRebuilds || config.DebugServerPort > 0)
+		skia.SetLeakTracking(config.TrackNativeLeaks)
 
 		app.frameTraceEnabled = config.DebugServerPort > 0
 		if app.frameTraceEnabled {
@@ -249,10 +320,24 @@ func SetDiagnostics(config *DiagnosticsConfig) {
 		// Clear state when diagnostics disabled
 		app.showLayoutBounds = false
 		app.hudRenderObject = nil
+		app.inputLatency = nil
+		app.uiTiming = nil
+		app.rasterTiming = nil
+		app.touchMarks = nil
+		app.rebuildStats = nil
+		app.rebuildLabels = nil
+		app.memoryLabels = nil
+		core.SetBuildObserver(nil)
+		errors.SetDisposalAuditing(false)
+		core.SetRebuildTracking(false)
+		skia.SetLeakTracking(false)
 		app.frameTraceEnabled = false
 		app.frameTrace = nil
 		app.runtimeSamples = nil
 	}
+	if config == nil || config.DebugServerPort == 0 {
+		app.inspector = inspectorState{}
+	}
 	if app.root != nil {
 		app.root.MarkNeedsBuild()
 	}
@@ -297,34 +382,116 @@ func (d *diagnosticsDataSource) RegisterRenderObject(ro layout.RenderObject) {
 	d.runner.hudRenderObject = ro
 }
 
+func (d *diagnosticsDataSource) InputLatencyLabel() string {
+	return d.runner.inputLatencyLabel
+}
+
+func (d *diagnosticsDataSource) ThreadSampleCount() int {
+	if d.runner.uiTiming == nil {
+		return 0
+	}
+	return d.runner.uiTiming.Count()
+}
+
+func (d *diagnosticsDataSource) UISamplesInto(dst []time.Duration) int {
+	if d.runner.uiTiming == nil {
+		return 0
+	}
+	return d.runner.uiTiming.SamplesInto(dst)
+}
+
+func (d *diagnosticsDataSource) RasterSamplesInto(dst []time.Duration) int {
+	if d.runner.rasterTiming == nil {
+		return 0
+	}
+	return d.runner.rasterTiming.SamplesInto(dst)
+}
+
+func (d *diagnosticsDataSource) RebuildStatLabels() []string {
+	r := d.runner
+	if r.rebuildStats == nil {
+		return nil
+	}
+	// Aggregating the window is cheap but not free; refresh a few times a
+	// second rather than every frame.
+	now := time.Now()
+	if r.rebuildLabels == nil || now.Sub(r.rebuildLabelsAt) >= rebuildHUDRefresh {
+		stats := r.rebuildStats.Top(rebuildHUDLimit, 0)
+		labels := make([]string, len(stats))
+		for i, stat := range stats {
+			labels[i] = rebuildStatLabel(stat)
+		}
+		r.rebuildLabels = labels
+		r.rebuildLabelsAt = now
+	}
+	return r.rebuildLabels
+}
+
+func (d *diagnosticsDataSource) MemoryLabels() []string {
+	r := d.runner
+	now := time.Now()
+	if r.memoryLabels == nil || now.Sub(r.memoryLabelsAt) >= memoryHUDRefresh {
+		r.memoryLabels = memoryLabels(newMemorySnapshot(countLayers(r.rootRender)))
+		r.memoryLabelsAt = now
+	}
+	return r.memoryLabels
+}
+
 // RestartApp unmounts the entire widget tree and re-mounts from scratch.
 // Use this for recovery from catastrophic errors. All state will be lost.
 // This is safe to call from any goroutine.
 func RestartApp() {
+	app.restart()
+}
+
+func (a *appRunner) restart() {
 	// Dispatch runs inside StepFrame() which already holds frameLock,
 	// so we don't need to acquire it here.
-	Dispatch(func() {
+	a.dispatch(func() {
 		// Clear captured error and reset error screen state
-		app.capturedError.Store(nil)
-		app.errorScreenMounted = false
+		a.capturedError.Store(nil)
+		a.errorScreenMounted = false
 
 		// If init failed, skip to done so restart doesn't re-run OnInit
-		app.lifecycle.resetFailure()
+		a.lifecycle.resetFailure()
 
 		// Unmount existing tree
-		if app.root != nil {
-			app.root.Unmount()
-			app.root = nil
+		if a.root != nil {
+			a.root.Unmount()
+			a.root = nil
 		}
-		app.rootRender = nil
+		a.rootRender = nil
 
 		// Next frame will re-mount the userApp
-		app.pendingFrameRequest.Store(true)
+		a.pendingFrameRequest.Store(true)
+	})
+}
+
+// Reassemble rebuilds the entire widget tree on the next frame while keeping
+// State objects and the controllers they own, so scroll positions, text
+// input, and animations survive. Use it after reloading resources that
+// widgets read in Build, such as assets, design tokens, or localized
+// strings. Go code changes still require a rebuild and restart.
+//
+// This is safe to call from any goroutine. Calls made before a pending
+// reassemble runs are coalesced into one.
+func Reassemble() {
+	app.reassemble()
+}
+
+func (a *appRunner) reassemble() {
+	if a.reassemblePending.Swap(true) {
+		return
+	}
+	a.dispatch(func() {
+		a.reassemblePending.Store(false)
+		core.Reassemble(a.root)
 	})
 }
 
 type appRunner struct {
 	buildOwner          *core.BuildOwner
+	arena               *gestures.GestureArena
 	root                core.Element
 	rootRender          layout.RenderObject
 	deviceScale         float64
@@ -335,7 +502,12 @@ type appRunner struct {
 	fpsLabel            string
 	dispatchMu          sync.Mutex
 	dispatchQueue       []func()
+	idleQueue           []func() // guarded by dispatchMu
 	pendingFrameRequest atomic.Bool
+	reassemblePending   atomic.Bool
+	// scalePurgePending asks the renderer to drop GPU caches, such as glyph
+	// atlases, rasterized at the previous device scale.
+	scalePurgePending atomic.Bool
 
 	// Semantics deferral state for animation optimization
 	semanticsDeferred   bool      // true if we skipped a semantics flush
@@ -359,9 +531,29 @@ type appRunner struct {
 	treeCountFrame        int
 	cachedRenderNodeCount int
 	cachedWidgetNodeCount int
+	inputLatency          *FrameTimingBuffer
+	uiTiming              *FrameTimingBuffer // StepFrame time, for the performance overlay
+	rasterTiming          *FrameTimingBuffer // RenderFrame and flush time, for the performance overlay
+	inputLatencyLabel     string
+	pendingInputAt        time.Time // Earliest pointer event since the last frame
+	touchMarks            []touchMark
+	touchOverlay          layout.RenderObject
+	rebuildStats          *RebuildStatsBuffer
+	rebuildLabels         []string
+	rebuildLabelsAt       time.Time
+	memoryLabels          []string
+	memoryLabelsAt        time.Time
+	inspector             inspectorState
+	watchdog              *watchdog // nil when disabled
+
+	// Fixed-timestep animation clock set by SetFrameRateOverride, and the
+	// clock it replaced
+	vsync     *SyntheticVsync
+	prevClock animation.Clock
 
 	// App init/dispose lifecycle
-	lifecycle appInit
+	lifecycle          appInit
+	pluginsInitialized bool
 }
 
 func init() {
@@ -371,8 +563,15 @@ func init() {
 	platform.RegisterDispatch(Dispatch)
 	// Register RestartApp for error widget
 	widgets.RegisterRestartAppFn(RestartApp)
-	// Wire up frame scheduling so SetState triggers a render under on-demand scheduling
-	app.buildOwner.OnNeedsFrame = RequestFrame
+	// Skip animations while the user asks for reduced motion. Only changes
+	// are applied, so an app can still override the setting.
+	reduceMotion := false
+	platform.Appearance.AddListener(func() {
+		if current := platform.Appearance.Current().ReduceMotion; current != reduceMotion {
+			reduceMotion = current
+			animation.SetAnimationsDisabled(current)
+		}
+	})
 	// Run OnDispose when the platform detaches
 	platform.Lifecycle.AddHandler(func(state platform.LifecycleState) {
 		if state == platform.LifecycleStateDetached {
@@ -383,13 +582,18 @@ func init() {
 	})
 }
 
-func newAppRunner() *appRunner {
-	return &appRunner{
+func newAppRunner(arena *gestures.GestureArena) *appRunner {
+	a := &appRunner{
 		buildOwner:       core.NewBuildOwner(),
+		arena:            arena,
 		deviceScale:      1,
 		pointerHandlers:  make(map[int64][]layout.PointerHandler),
 		pointerPositions: make(map[int64]graphics.Offset),
 	}
+	a.buildOwner.Pipeline().SetGestureArena(arena)
+	// Wire up frame scheduling so SetState triggers a render under on-demand scheduling
+	a.buildOwner.OnNeedsFrame = a.requestFrame
+	return a
 }
 
 func (a *appRunner) SetDeviceScale(scale float64) {
@@ -402,11 +606,36 @@ func (a *appRunner) SetDeviceScale(scale float64) {
 		return
 	}
 	a.deviceScale = scale
+	a.scalePurgePending.Store(true)
+	graphics.PurgeTextLayoutCache()
+	errors.SetDeviceContext("device_scale", strconv.FormatFloat(scale, 'g', -1, 64))
+	if a.rootRender != nil {
+		invalidateForScale(a.rootRender, scale)
+	}
 	if a.root != nil {
 		a.root.MarkNeedsBuild()
 	}
 }
 
+// invalidateForScale prepares the render tree for a new device scale, as
+// when a window moves to a monitor with a different DPI. Layers are recorded
+// in logical pixels but can hold scale-dependent content, such as hairline
+// debug strokes, and render objects may cache state for the old scale. So
+// every render object is notified, then laid out and painted again on the
+// next frame instead of reusing what was recorded at the old scale.
+func invalidateForScale(node layout.RenderObject, scale float64) {
+	if listener, ok := node.(layout.DeviceScaleListener); ok {
+		listener.DeviceScaleChanged(scale)
+	}
+	node.MarkNeedsLayout()
+	node.MarkNeedsPaint()
+	if visitor, ok := node.(layout.ChildVisitor); ok {
+		visitor.VisitChildren(func(child layout.RenderObject) {
+			invalidateForScale(child, scale)
+		})
+	}
+}
+
 func (a *appRunner) setUserApp(root core.Widget) {
 	frameLock.Lock()
 	defer frameLock.Unlock()
@@ -444,7 +673,7 @@ func (a *appRunner) dispatch(callback func()) {
 	a.dispatchMu.Lock()
 	a.dispatchQueue = append(a.dispatchQueue, callback)
 	a.dispatchMu.Unlock()
-	RequestFrame()
+	a.requestFrame()
 }
 
 func (a *appRunner) drainDispatchQueue() []func() {
@@ -500,8 +729,9 @@ func (a *appRunner) flushSemanticsIfNeeded(pipeline *layout.PipelineOwner, scale
 }
 
 // runPipeline executes the shared engine pipeline phases: error handling, frame
-// timing, dispatch, animate, root mounting, build, layout, semantics, geometry
-// batch setup, and dirty layer recording. Must be called with frameLock held.
+// timing, dispatch, animate, root mounting, idle callbacks, build, layout,
+// semantics, geometry batch setup, dirty layer recording, and visibility
+// checks. Must be called with frameLock held.
 //
 // If traceSample is non-nil, per-phase timing is recorded into it.
 //
@@ -517,6 +747,10 @@ func (a *appRunner) runPipeline(size graphics.Size, traceSample *FrameSample) bo
 		a.errorScreenMounted = true
 	}
 
+	// The frame is idle if the tree was mounted and clean when it started,
+	// before diagnostics below mark their overlays for repaint.
+	idle := a.root != nil && !a.buildOwner.NeedsWork()
+
 	// Frame timing
 	frameStart := time.Now()
 	frameInterval := time.Duration(0)
@@ -525,8 +759,16 @@ func (a *appRunner) runPipeline(size graphics.Size, traceSample *FrameSample) bo
 	}
 	if a.frameTiming != nil && frameInterval > 0 {
 		a.frameTiming.Add(frameInterval)
-		if a.hudRenderObject != nil {
-			a.hudRenderObject.MarkNeedsPaint()
+	}
+	if a.hudRenderObject != nil {
+		a.hudRenderObject.MarkNeedsPaint()
+	}
+	if a.inspector.selected != nil {
+		// The selection may have moved or been unmounted since the last
+		// frame, so refresh and repaint its highlight.
+		a.inspector.sync(a.root)
+		if a.inspector.overlay != nil {
+			a.inspector.overlay.MarkNeedsPaint()
 		}
 	}
 	a.lastFrameStart = frameStart
@@ -537,28 +779,39 @@ func (a *appRunner) runPipeline(size graphics.Size, traceSample *FrameSample) bo
 		Height: size.Height / scale,
 	}
 
+	// Synthetic time advances one interval per frame, before anything
+	// reads it.
+	if a.vsync != nil {
+		a.vsync.Tick()
+	}
+
 	// Dispatch
 	var phaseStart time.Time
 	if tracing {
 		phaseStart = time.Now()
 	}
 	callbacks := a.drainDispatchQueue()
+	a.watchdog.setPhase("dispatch")
 	for _, callback := range callbacks {
-		callback()
+		a.runDispatchCallback(callback)
 	}
+	a.watchdog.setPhase("")
 	if a.consumePendingFrameRequest() {
 		a.requestFrameLocked()
 	}
 	if tracing {
 		traceSample.Phases.DispatchMs = durationToMillis(time.Since(phaseStart))
+		traceSample.Counts.DispatchCallbacks = len(callbacks)
 	}
 
 	// Animate
 	if tracing {
 		phaseStart = time.Now()
+		traceSample.Counts.ActiveTickers = animation.ActiveTickerCount()
 	}
 	widgets.StepBallistics()
 	animation.StepTickers()
+	a.stepTouchMarksLocked(time.Now())
 	if tracing {
 		traceSample.Phases.AnimateMs = durationToMillis(time.Since(phaseStart))
 	}
@@ -566,7 +819,19 @@ func (a *appRunner) runPipeline(size graphics.Size, traceSample *FrameSample) bo
 
 	// Mount root (deferred while OnInit is Pending or Running)
 	if a.root == nil {
-		if a.lifecycle.start(Dispatch) || a.lifecycle.phase == initPhaseRunning {
+		// Plugins register their channels before OnInit so it can use them.
+		if !a.pluginsInitialized {
+			a.pluginsInitialized = true
+			if err := platform.InitializePlugins(); err != nil {
+				errors.Report(&errors.DriftError{
+					Op:        "engine.InitializePlugins",
+					Kind:      errors.KindInit,
+					Err:       err,
+					Timestamp: time.Now(),
+				})
+			}
+		}
+		if a.lifecycle.start(a.dispatch) || a.lifecycle.phase == initPhaseRunning {
 			return false
 		}
 		if err := a.lifecycle.initError(); err != nil {
@@ -586,6 +851,14 @@ func (a *appRunner) runPipeline(size graphics.Size, traceSample *FrameSample) bo
 		initializeAccessibility()
 	}
 
+	// Idle work, only when the frame would otherwise do nothing
+	if idle && len(callbacks) == 0 && len(a.touchMarks) == 0 &&
+		!animation.HasActiveTickers() && !widgets.HasActiveBallistics() {
+		a.watchdog.setPhase("idle")
+		a.runIdleCallbacks()
+		a.watchdog.setPhase("")
+	}
+
 	// Build
 	if tracing {
 		phaseStart = time.Now()
@@ -655,6 +928,10 @@ func (a *appRunner) runPipeline(size graphics.Size, traceSample *FrameSample) bo
 		traceSample.Counts.DirtyPaintBoundaries = len(dirtyBoundaries)
 	}
 
+	// Visibility callbacks see this frame's layout; changes they make land
+	// in the next frame.
+	widgets.FlushVisibility()
+
 	return true
 }
 
@@ -685,6 +962,8 @@ func (a *appRunner) recoverFromFramePanic() func() {
 }
 
 func (a *appRunner) HandlePointer(event PointerEvent) {
+	defer errors.EnterUIScope()()
+
 	// In debug mode, recover panics and show error screen
 	// In prod mode, let panics crash the app (unless user adds ErrorBoundary)
 	if core.DebugMode {
@@ -718,6 +997,12 @@ func (a *appRunner) HandlePointer(event PointerEvent) {
 	}
 	scale := a.deviceScale
 	position := graphics.Offset{X: event.X / scale, Y: event.Y / scale}
+	touchesChanged := a.recordInputLocked(event, position)
+	if a.handleInspectorPointerLocked(event, position) {
+		frameLock.Unlock()
+		schedulePlatformFrame()
+		return
+	}
 
 	if event.Phase != PointerPhaseDown {
 		if last, ok := a.pointerPositions[pointerID]; ok {
@@ -728,7 +1013,11 @@ func (a *appRunner) HandlePointer(event PointerEvent) {
 
 	if event.Phase == PointerPhaseDown {
 		result := &layout.HitTestResult{}
-		if rootRender.HitTest(position, result) && len(result.Entries) > 0 {
+		// Translucent targets record entries without reporting a hit, so
+		// dispatch to whatever was recorded.
+		rootRender.HitTest(position, result)
+		if len(result.Entries) > 0 {
+			recordTapBreadcrumb(position, result.Entries[0])
 			handlers = collectPointerHandlers(result.Entries)
 			if len(handlers) > 0 {
 				a.pointerHandlers[pointerID] = handlers
@@ -754,6 +1043,10 @@ func (a *appRunner) HandlePointer(event PointerEvent) {
 	}
 	frameLock.Unlock()
 
+	if touchesChanged {
+		schedulePlatformFrame()
+	}
+
 	if len(handlers) == 0 {
 		return
 	}
@@ -770,10 +1063,10 @@ func (a *appRunner) HandlePointer(event PointerEvent) {
 	}
 
 	if event.Phase == PointerPhaseDown {
-		gestures.DefaultArena.Close(pointerID)
+		a.arena.Close(pointerID)
 	}
 	if event.Phase == PointerPhaseUp || event.Phase == PointerPhaseCancel {
-		gestures.DefaultArena.Sweep(pointerID)
+		a.arena.Sweep(pointerID)
 	}
 }
 
@@ -827,6 +1120,19 @@ func collectPointerHandlers(entries []layout.RenderObject) []layout.PointerHandl
 	return handlers
 }
 
+// recordTapBreadcrumb records a pointer down on target, the innermost hit
+// render object, for error reports.
+func recordTapBreadcrumb(position graphics.Offset, target layout.RenderObject) {
+	errors.AddBreadcrumb(errors.Breadcrumb{
+		Category: errors.BreadcrumbTap,
+		Message:  fmt.Sprintf("%T", target),
+		Data: map[string]string{
+			"x": strconv.FormatFloat(position.X, 'f', 0, 64),
+			"y": strconv.FormatFloat(position.Y, 'f', 0, 64),
+		},
+	})
+}
+
 func containsEntry(entries []layout.RenderObject, target any) bool {
 	for _, entry := range entries {
 		if entry == target {
@@ -860,11 +1166,15 @@ func (e engineApp) Build(ctx core.BuildContext) core.Widget {
 		child = defaultPlaceholder{}
 	}
 
-	// Wrap with diagnostics HUD if FPS or frame graph is enabled
-	if diagnosticsConfig != nil && (diagnosticsConfig.ShowFPS || diagnosticsConfig.ShowFrameGraph) {
+	var overlays []core.Widget
+
+	// Wrap with diagnostics HUD if any of its panels is enabled
+	if diagnosticsConfig != nil && (diagnosticsConfig.ShowFPS || diagnosticsConfig.ShowFrameGraph ||
+		diagnosticsConfig.ShowInputLatency || diagnosticsConfig.ShowRebuildStats ||
+		diagnosticsConfig.ShowPerformanceOverlay || diagnosticsConfig.ShowMemory) {
 		targetTime := diagnosticsConfig.TargetFrameTime
 		if targetTime == 0 {
-			targetTime = 16667 * time.Microsecond
+			targetTime = animation.FrameInterval()
 		}
 
 		graphWidth := 120.0
@@ -874,12 +1184,17 @@ func (e engineApp) Build(ctx core.BuildContext) core.Widget {
 		dataSource := &diagnosticsDataSource{runner: e.runner}
 
 		hud := widgets.DiagnosticsHUD{
-			DataSource:     dataSource,
-			TargetTime:     targetTime,
-			GraphWidth:     graphWidth,
-			GraphHeight:    graphHeight,
-			ShowFPS:        diagnosticsConfig.ShowFPS,
-			ShowFrameGraph: diagnosticsConfig.ShowFrameGraph,
+			DataSource:       dataSource,
+			TargetTime:       targetTime,
+			GraphWidth:       graphWidth,
+			GraphHeight:      graphHeight,
+			ShowFPS:          diagnosticsConfig.ShowFPS,
+			ShowFrameGraph:   diagnosticsConfig.ShowFrameGraph,
+			ShowInputLatency: diagnosticsConfig.ShowInputLatency,
+			ShowRebuildStats: diagnosticsConfig.ShowRebuildStats,
+
+			ShowPerformanceOverlay: diagnosticsConfig.ShowPerformanceOverlay,
+			ShowMemory:             diagnosticsConfig.ShowMemory,
 		}
 
 		// Wrap HUD in a positioner that reads safe area from context
@@ -888,18 +1203,29 @@ func (e engineApp) Build(ctx core.BuildContext) core.Widget {
 			hud:      hud,
 		}
 
+		overlays = append(overlays, hudPositioner)
+	}
+
+	if diagnosticsConfig != nil && diagnosticsConfig.ShowTouches {
+		overlays = append(overlays, widgets.Positioned(touchOverlay{runner: e.runner}).Fill(0))
+	}
+
+	if diagnosticsConfig != nil && diagnosticsConfig.DebugServerPort > 0 {
+		overlays = append(overlays, widgets.Positioned(inspectorOverlay{runner: e.runner}).Fill(0))
+	}
+
+	if len(overlays) > 0 {
 		child = widgets.Stack{
-			Children: []core.Widget{
-				child,
-				hudPositioner,
-			},
+			Children: append([]core.Widget{child}, overlays...),
 		}
 	}
 
 	return widgets.DeviceScale{
 		Scale: scale,
 		Child: widgets.SafeAreaProvider{
-			Child: child,
+			Child: widgets.MediaQueryProvider{
+				Child: child,
+			},
 		},
 	}
 }
@@ -1066,6 +1392,9 @@ func compositeLayerTree(canvas graphics.Canvas, root layout.RenderObject) {
 func (a *appRunner) StepFrame(size graphics.Size) (*FrameSnapshot, error) {
 	frameLock.Lock()
 	defer frameLock.Unlock()
+	defer errors.EnterUIScope()()
+	a.watchdog.begin("StepFrame")
+	defer a.watchdog.end()
 	// A frame callback is now running, so allow scheduling of a future callback.
 	platformFrameScheduled.Store(false)
 
@@ -1076,9 +1405,12 @@ func (a *appRunner) StepFrame(size graphics.Size) (*FrameSnapshot, error) {
 	traceEnabled := a.frameTraceEnabled && a.frameTrace != nil
 	var traceSample FrameSample
 	var frameWorkStart time.Time
-	if traceEnabled {
+	if traceEnabled || a.uiTiming != nil {
 		frameWorkStart = time.Now()
+	}
+	if traceEnabled {
 		traceSample.Timestamp = frameWorkStart.UnixMilli()
+		traceSample.StartUs = frameWorkStart.UnixMicro()
 		currentState := platform.Lifecycle.State()
 		traceSample.Flags.LifecycleState = string(currentState)
 		traceSample.Flags.ResumedThisFrame = a.lastLifecycleState != platform.LifecycleStateResumed && currentState == platform.LifecycleStateResumed
@@ -1101,8 +1433,11 @@ func (a *appRunner) StepFrame(size graphics.Size) (*FrameSnapshot, error) {
 
 		// Skip the geometry pass entirely when no platform views are registered.
 		// This avoids per-frame Path allocations from occlusion ops (emitted by
-		// every opaque Container/DecoratedBox) in the common case.
-		if reg.ViewCount() > 0 {
+		// every opaque Container/DecoratedBox) in the common case. The registry
+		// is shared and positions views in the default view's surface, so
+		// other handles never run the pass; a batch from their trees would
+		// mark the default view's platform views as hidden.
+		if a == app && reg.ViewCount() > 0 {
 			// Begin/Flush geometry batch brackets the compositing pass.
 			// Both calls live in StepFrame so the batch is always paired,
 			// even when runPipeline returns nil on an earlier frame.
@@ -1135,6 +1470,13 @@ func (a *appRunner) StepFrame(size graphics.Size) (*FrameSnapshot, error) {
 		}
 	}
 
+	if latency, ok := a.takeInputLatencyLocked(); ok && traceEnabled {
+		traceSample.InputLatencyMs = durationToMillis(latency)
+	}
+
+	if a.uiTiming != nil {
+		a.uiTiming.Add(time.Since(frameWorkStart))
+	}
 	if traceEnabled {
 		traceSample.Flags.SemanticsDeferred = a.semanticsDeferred
 		frameWorkDuration := time.Since(frameWorkStart)
@@ -1145,11 +1487,25 @@ func (a *appRunner) StepFrame(size graphics.Size) (*FrameSnapshot, error) {
 	return snapshot, nil
 }
 
+// recordRasterTime records how long the last frame took to composite and
+// flush to the GPU, for the performance overlay. Called by the embedder
+// render functions once the surface is flushed.
+func (a *appRunner) recordRasterTime(d time.Duration) {
+	frameLock.Lock()
+	timing := a.rasterTiming
+	frameLock.Unlock()
+	if timing != nil {
+		timing.Add(d)
+	}
+}
+
 // RenderFrame composites the layer tree into the provided canvas.
 // Must be called after a successful StepFrame.
 func (a *appRunner) RenderFrame(canvas graphics.Canvas) error {
 	frameLock.Lock()
 	defer frameLock.Unlock()
+	a.watchdog.begin("RenderFrame")
+	defer a.watchdog.end()
 
 	canvas.Clear(graphics.Color(backgroundColor.Load()))
 
@@ -1167,5 +1523,14 @@ func (a *appRunner) RenderFrame(canvas graphics.Canvas) error {
 	compositeLayerTree(canvas, a.rootRender)
 
 	canvas.Restore()
+	if a == app {
+		// The native splash and display settings belong to the default view.
+		if !firstFrameRendered.Load() {
+			// The embedder is surely connected by now, so send any frame
+			// rate set during startup and learn the display's rate.
+			go syncFrameRate()
+		}
+		markFirstFrameRendered()
+	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 	"sync"
 	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/go-drift/drift/pkg/graphics"
@@ -25,6 +26,7 @@ var skiaState skiaStateTracker
 var (
 	errInvalidSize = errors.New("skia: invalid surface size")
 	errNilBuffer   = errors.New("skia: nil texture buffer")
+	errUnknownView = errors.New("engine: unknown view")
 )
 
 // InitSkiaMetal initializes the Skia Metal context using the provided device/queue.
@@ -91,24 +93,36 @@ func InitSkiaVulkan(instance, physDevice, device, queue uintptr, queueFamilyInde
 // split pipeline (composite only). Geometry is applied synchronously by the
 // Android UI thread between StepAndSnapshot and this call.
 func RenderSkiaVulkanSync(width, height int, vkImage uintptr, vkFormat uint32) error {
+	return RenderSkiaVulkanViewSync(DefaultViewID, width, height, vkImage, vkFormat)
+}
+
+// RenderSkiaVulkanViewSync is [RenderSkiaVulkanSync] for view id.
+func RenderSkiaVulkanViewSync(id ViewID, width, height int, vkImage uintptr, vkFormat uint32) error {
 	if width <= 0 || height <= 0 {
 		return skiaState.setError(errInvalidSize)
 	}
+	h := View(id)
+	if h == nil {
+		return skiaState.setError(errUnknownView)
+	}
 	ctx, err := currentSkiaContext("vulkan")
 	if err != nil {
 		return skiaState.setError(err)
 	}
+	purgeAfterScaleChange(ctx, h.runner)
 	surface, err := ctx.MakeVulkanSurface(width, height, vkImage, vkFormat)
 	if err != nil {
 		return skiaState.setError(err)
 	}
 	defer surface.Destroy()
 
+	rasterStart := time.Now()
 	canvas := graphics.NewSkiaCanvas(surface.Canvas(), graphics.Size{Width: float64(width), Height: float64(height)})
-	if err := app.RenderFrame(canvas); err != nil {
+	if err := h.runner.RenderFrame(canvas); err != nil {
 		return skiaState.setError(err)
 	}
 	surface.Flush()
+	h.runner.recordRasterTime(time.Since(rasterStart))
 	skiaState.clearError()
 	return nil
 }
@@ -120,11 +134,21 @@ func RenderSkiaVulkanSync(width, height int, vkImage uintptr, vkFormat uint32) e
 // Returns (data, pooledBuffer, error). The caller must call
 // PutSnapshotBuffer(pooledBuffer) after copying data (e.g. via C.CBytes).
 func StepAndSnapshot(width, height int) ([]byte, *[]byte, error) {
+	return StepViewAndSnapshot(DefaultViewID, width, height)
+}
+
+// StepViewAndSnapshot is [StepAndSnapshot] for view id. Width and height
+// are the size of that view's surface in device pixels.
+func StepViewAndSnapshot(id ViewID, width, height int) ([]byte, *[]byte, error) {
 	if width <= 0 || height <= 0 {
 		return nil, nil, errInvalidSize
 	}
+	h := View(id)
+	if h == nil {
+		return nil, nil, errUnknownView
+	}
 	size := graphics.Size{Width: float64(width), Height: float64(height)}
-	snapshot, err := app.StepFrame(size)
+	snapshot, err := h.runner.StepFrame(size)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -139,27 +163,39 @@ func StepAndSnapshot(width, height int) ([]byte, *[]byte, error) {
 // split pipeline (composite only). Geometry is applied synchronously by the iOS
 // main thread between StepAndSnapshot and this call.
 func RenderSkiaMetalSync(width, height int, texture unsafe.Pointer) error {
+	return RenderSkiaMetalViewSync(DefaultViewID, width, height, texture)
+}
+
+// RenderSkiaMetalViewSync is [RenderSkiaMetalSync] for view id.
+func RenderSkiaMetalViewSync(id ViewID, width, height int, texture unsafe.Pointer) error {
 	if width <= 0 || height <= 0 {
 		return skiaState.setError(errInvalidSize)
 	}
 	if texture == nil {
 		return skiaState.setError(errNilBuffer)
 	}
+	h := View(id)
+	if h == nil {
+		return skiaState.setError(errUnknownView)
+	}
 	ctx, err := currentSkiaContext("metal")
 	if err != nil {
 		return skiaState.setError(err)
 	}
+	purgeAfterScaleChange(ctx, h.runner)
 	surface, err := ctx.MakeMetalSurface(texture, width, height)
 	if err != nil {
 		return skiaState.setError(err)
 	}
 	defer surface.Destroy()
 
+	rasterStart := time.Now()
 	canvas := graphics.NewSkiaCanvas(surface.Canvas(), graphics.Size{Width: float64(width), Height: float64(height)})
-	if err := app.RenderFrame(canvas); err != nil {
+	if err := h.runner.RenderFrame(canvas); err != nil {
 		return skiaState.setError(err)
 	}
 	surface.Flush()
+	h.runner.recordRasterTime(time.Since(rasterStart))
 	skiaState.clearError()
 	return nil
 }
@@ -176,6 +212,15 @@ func PurgeSkiaResources() {
 	}
 }
 
+// purgeAfterScaleChange drops GPU caches after the device scale changes,
+// so glyphs and textures rasterized at the old scale don't linger. It runs
+// on the render thread, where purging can't race with drawing.
+func purgeAfterScaleChange(ctx *skia.Context, a *appRunner) {
+	if a.scalePurgePending.Swap(false) {
+		ctx.PurgeGpuResources()
+	}
+}
+
 func currentSkiaContext(backend string) (*skia.Context, error) {
 	skiaState.mu.Lock()
 	defer skiaState.mu.Unlock()
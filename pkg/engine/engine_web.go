@@ -0,0 +1,31 @@
+//go:build js && wasm
+
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+var errInvalidWebSize = errors.New("engine: invalid canvas size")
+
+// RenderWebFrame runs the engine pipeline and composites the frame into
+// canvas. Width and height are the canvas size in device pixels. Called by
+// the web embedder from its requestAnimationFrame callback.
+func RenderWebFrame(canvas graphics.Canvas, width, height int) error {
+	if width <= 0 || height <= 0 {
+		return errInvalidWebSize
+	}
+	size := graphics.Size{Width: float64(width), Height: float64(height)}
+	if _, err := app.StepFrame(size); err != nil {
+		return err
+	}
+	rasterStart := time.Now()
+	if err := app.RenderFrame(canvas); err != nil {
+		return err
+	}
+	app.recordRasterTime(time.Since(rasterStart))
+	return nil
+}
@@ -0,0 +1,122 @@
+package engine
+
+import (
+	stderrors "errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+var (
+	displayChannel = platform.NewMethodChannel("drift/display")
+
+	// preferredFrameRate holds the rate set by SetPreferredFrameRate as
+	// float64 bits; zero asks for the display's highest rate.
+	preferredFrameRate atomic.Uint64
+
+	// vsyncInterval is the display's refresh interval reported by the
+	// embedder, or zero if unknown.
+	vsyncInterval atomic.Int64
+
+	// frameRateSyncMu orders syncFrameRate calls, so the embedder ends up
+	// with the latest preference.
+	frameRateSyncMu sync.Mutex
+)
+
+// SetPreferredFrameRate asks the display to refresh at fps frames per
+// second. Pass 0, the default, for the highest rate the display supports,
+// such as 120Hz on ProMotion iPhones and high-refresh Android devices.
+//
+// Higher rates make scrolling and animation smoother but cost battery, so
+// apps that mostly show static content can cap them, for example to 60 on
+// a reading screen and back to 0 on an animated one. The display may pick
+// a different rate it supports, and the system can lower it further, such
+// as in low power mode; [DisplayRefreshRate] reports the result.
+//
+// Frames are still produced only when something changes. Safe to call from
+// any goroutine, including before the first frame.
+func SetPreferredFrameRate(fps float64) {
+	if fps < 0 || math.IsNaN(fps) || math.IsInf(fps, 0) {
+		fps = 0
+	}
+	if math.Float64frombits(preferredFrameRate.Swap(math.Float64bits(fps))) == fps {
+		return
+	}
+	if FirstFrameRendered() {
+		// Sent in the background: the reply takes the frame lock, which the
+		// caller may hold.
+		go syncFrameRate()
+	}
+}
+
+// PreferredFrameRate returns the rate set by [SetPreferredFrameRate], or 0
+// for the display's highest rate.
+func PreferredFrameRate() float64 {
+	return math.Float64frombits(preferredFrameRate.Load())
+}
+
+// DisplayRefreshRate returns the refresh rate the display is running at, in
+// frames per second, or 0 if the embedder has not reported it.
+func DisplayRefreshRate() float64 {
+	interval := vsyncInterval.Load()
+	if interval <= 0 {
+		return 0
+	}
+	return float64(time.Second) / float64(interval)
+}
+
+// SetVsyncInterval records the time between display refreshes. Embedders
+// call it when the display's refresh rate becomes known or changes, such as
+// after [SetPreferredFrameRate] or when the window moves to another screen.
+// The interval is passed on to animations through
+// [animation.SetFrameInterval] unless a frame rate override is set.
+func SetVsyncInterval(interval time.Duration) {
+	if interval < 0 {
+		interval = 0
+	}
+	vsyncInterval.Store(int64(interval))
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	if app.vsync == nil {
+		animation.SetFrameInterval(interval)
+	}
+}
+
+// syncFrameRate sends the preferred frame rate to the embedder, which
+// replies with the display's refresh rate in frames per second.
+func syncFrameRate() {
+	frameRateSyncMu.Lock()
+	defer frameRateSyncMu.Unlock()
+	result, err := displayChannel.Invoke("setPreferredFrameRate", map[string]any{
+		"fps": PreferredFrameRate(),
+	})
+	if err != nil {
+		if !stderrors.Is(err, platform.ErrMethodNotFound) &&
+			!stderrors.Is(err, platform.ErrNotConnected) && !stderrors.Is(err, platform.ErrPlatformUnavailable) {
+			errors.Report(&errors.DriftError{
+				Op:        "engine.SetPreferredFrameRate",
+				Kind:      errors.KindPlatform,
+				Err:       err,
+				Timestamp: time.Now(),
+			})
+		}
+		return
+	}
+	var hz float64
+	switch v := result.(type) {
+	case float64:
+		hz = v
+	case int64:
+		hz = float64(v)
+	case int:
+		hz = float64(v)
+	}
+	if hz > 0 {
+		SetVsyncInterval(time.Duration(float64(time.Second) / hz))
+	}
+}
@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+)
+
+func resetFrameRateForTest(t *testing.T) {
+	t.Helper()
+	reset := func() {
+		preferredFrameRate.Store(0)
+		vsyncInterval.Store(0)
+		animation.SetFrameInterval(0)
+	}
+	reset()
+	t.Cleanup(reset)
+}
+
+func TestSetVsyncInterval_ReachesAnimations(t *testing.T) {
+	resetFrameRateForTest(t)
+
+	if DisplayRefreshRate() != 0 {
+		t.Error("expected no refresh rate before the embedder reports one")
+	}
+	if got := animation.FrameInterval(); got != animation.DefaultFrameInterval {
+		t.Errorf("expected the default frame interval, got %v", got)
+	}
+
+	SetVsyncInterval(time.Second / 120)
+	if got := DisplayRefreshRate(); got < 119.9 || got > 120.1 {
+		t.Errorf("expected 120Hz, got %v", got)
+	}
+	if got := animation.FrameInterval(); got != time.Second/120 {
+		t.Errorf("expected animations to see the 120Hz interval, got %v", got)
+	}
+}
+
+func TestSetVsyncInterval_FrameRateOverrideWins(t *testing.T) {
+	swapApp(t)
+	resetFrameRateForTest(t)
+	t.Cleanup(func() { SetFrameRateOverride(0) })
+
+	SetVsyncInterval(time.Second / 120)
+	SetFrameRateOverride(50)
+	if got := animation.FrameInterval(); got != 20*time.Millisecond {
+		t.Errorf("expected the override's interval, got %v", got)
+	}
+	SetVsyncInterval(time.Second / 90)
+	if got := animation.FrameInterval(); got != 20*time.Millisecond {
+		t.Errorf("expected the override to keep its interval, got %v", got)
+	}
+
+	SetFrameRateOverride(0)
+	if got := animation.FrameInterval(); got != time.Second/90 {
+		t.Errorf("expected the display interval after clearing the override, got %v", got)
+	}
+}
+
+func TestSetPreferredFrameRate(t *testing.T) {
+	resetFrameRateForTest(t)
+
+	SetPreferredFrameRate(60)
+	if got := PreferredFrameRate(); got != 60 {
+		t.Errorf("expected 60, got %v", got)
+	}
+	SetPreferredFrameRate(-1)
+	if got := PreferredFrameRate(); got != 0 {
+		t.Errorf("expected invalid rates to mean the display's highest, got %v", got)
+	}
+}
@@ -33,6 +33,8 @@ type FrameCounts struct {
 	RenderNodeCount      int `json:"renderNodeCount"`
 	WidgetNodeCount      int `json:"widgetNodeCount"`
 	PlatformViewCount    int `json:"platformViewCount"`
+	DispatchCallbacks    int `json:"dispatchCallbacks"`
+	ActiveTickers        int `json:"activeTickers"`
 }
 
 // FrameFlags captures contextual flags for a frame.
@@ -43,13 +45,21 @@ type FrameFlags struct {
 }
 
 // FrameSample is a single frame trace sample.
+//
+// Timestamp is the frame start in Unix milliseconds; StartUs is the same
+// instant in Unix microseconds, for trace export.
+//
+// InputLatencyMs is the time from the earliest pointer event received since
+// the previous frame to the end of this frame, or 0 if no input arrived.
 type FrameSample struct {
-	Timestamp  int64             `json:"ts"`
-	FrameMs    float64           `json:"frameMs"`
-	Phases     FramePhaseTimings `json:"phases"`
-	Counts     FrameCounts       `json:"counts"`
-	Flags      FrameFlags        `json:"flags"`
-	DirtyTypes FrameDirtyTypes   `json:"dirtyTypes"`
+	Timestamp      int64             `json:"ts"`
+	StartUs        int64             `json:"startUs"`
+	FrameMs        float64           `json:"frameMs"`
+	InputLatencyMs float64           `json:"inputLatencyMs,omitempty"`
+	Phases         FramePhaseTimings `json:"phases"`
+	Counts         FrameCounts       `json:"counts"`
+	Flags          FrameFlags        `json:"flags"`
+	DirtyTypes     FrameDirtyTypes   `json:"dirtyTypes"`
 }
 
 // FrameDirtyTypes provides the most common dirty types per phase.
@@ -74,12 +74,13 @@ func (c *GeometryCanvas) DrawText(_ *graphics.TextLayout, _ graphics.Offset)
 func (c *GeometryCanvas) DrawImage(_ image.Image, _ graphics.Offset)                {}
 func (c *GeometryCanvas) DrawImageRect(_ image.Image, _, _ graphics.Rect, _ graphics.FilterQuality, _ uintptr) {
 }
-func (c *GeometryCanvas) DrawPath(_ *graphics.Path, _ graphics.Paint)                       {}
-func (c *GeometryCanvas) DrawRectShadow(_ graphics.Rect, _ graphics.BoxShadow)              {}
-func (c *GeometryCanvas) DrawRRectShadow(_ graphics.RRect, _ graphics.BoxShadow)            {}
-func (c *GeometryCanvas) DrawSVG(_ unsafe.Pointer, _ graphics.Rect)                         {}
-func (c *GeometryCanvas) DrawSVGTinted(_ unsafe.Pointer, _ graphics.Rect, _ graphics.Color) {}
-func (c *GeometryCanvas) DrawLottie(_ unsafe.Pointer, _ graphics.Rect, _ float64)           {}
+func (c *GeometryCanvas) DrawPath(_ *graphics.Path, _ graphics.Paint)                               {}
+func (c *GeometryCanvas) DrawVertices(_ *graphics.Vertices, _ graphics.BlendMode, _ graphics.Paint) {}
+func (c *GeometryCanvas) DrawRectShadow(_ graphics.Rect, _ graphics.BoxShadow)                      {}
+func (c *GeometryCanvas) DrawRRectShadow(_ graphics.RRect, _ graphics.BoxShadow)                    {}
+func (c *GeometryCanvas) DrawSVG(_ unsafe.Pointer, _ graphics.Rect)                                 {}
+func (c *GeometryCanvas) DrawSVGTinted(_ unsafe.Pointer, _ graphics.Rect, _ graphics.Color)         {}
+func (c *GeometryCanvas) DrawLottie(_ unsafe.Pointer, _ graphics.Rect, _ float64)                   {}
 
 // EmbedPlatformView resolves transform+clip and buffers the view geometry with
 // a z-order sequence index for later occlusion processing.
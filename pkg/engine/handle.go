@@ -0,0 +1,174 @@
+package engine
+
+import (
+	"sync"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// EngineHandle is one engine instance: a widget tree with its own build
+// owner, render pipeline, gesture arena, and dispatch queue.
+//
+// Most apps never see a handle; the package-level functions such as [SetApp],
+// [Dispatch], and [HandlePointerEvent] act on the default handle returned by
+// [DefaultHandle]. Embedders that host several independent trees, such as
+// multi-window desktop apps or add-to-app screens, create one handle per tree
+// with [NewEngineHandle] and drive each through its methods.
+//
+// Handles share the frame lock, the animation clock, and platform channels,
+// so frames from different handles are serialized rather than concurrent.
+type EngineHandle struct {
+	runner *appRunner
+	view   ViewID
+
+	mu     sync.Mutex
+	scoped map[any]any
+}
+
+var (
+	defaultHandle = newEngineHandle(gestures.DefaultArena)
+
+	// app is the default handle's runner, used by the package-level facade.
+	app = defaultHandle.runner
+
+	handlesMu sync.Mutex
+	handles   = map[*core.BuildOwner]*EngineHandle{}
+)
+
+// NewEngineHandle creates an engine instance with its own widget tree and
+// gesture arena. It renders nothing until [EngineHandle.SetApp] is called.
+func NewEngineHandle() *EngineHandle {
+	return newEngineHandle(gestures.NewGestureArena())
+}
+
+func newEngineHandle(arena *gestures.GestureArena) *EngineHandle {
+	h := &EngineHandle{runner: newAppRunner(arena)}
+	handlesMu.Lock()
+	handles[h.runner.buildOwner] = h
+	handlesMu.Unlock()
+	return h
+}
+
+// DefaultHandle returns the handle behind the package-level functions.
+func DefaultHandle() *EngineHandle {
+	return defaultHandle
+}
+
+// HandleOf returns the handle whose tree contains ctx, or nil if ctx was not
+// mounted by an engine (for example, in widget tests).
+func HandleOf(ctx core.BuildContext) *EngineHandle {
+	owner := core.BuildOwnerOf(ctx)
+	if owner == nil {
+		return nil
+	}
+	handlesMu.Lock()
+	defer handlesMu.Unlock()
+	return handles[owner]
+}
+
+// SetApp sets the root widget of this handle's tree.
+func (h *EngineHandle) SetApp(root core.Widget) {
+	h.runner.setUserApp(root)
+}
+
+// SetDeviceScale updates the device pixel ratio used for this handle's
+// rendering and input.
+func (h *EngineHandle) SetDeviceScale(scale float64) {
+	h.runner.SetDeviceScale(scale)
+}
+
+// Dispatch schedules a callback to run on the UI thread during this handle's
+// next frame. Safe to call from any goroutine.
+func (h *EngineHandle) Dispatch(callback func()) {
+	h.runner.dispatch(callback)
+}
+
+// DispatchIdle schedules a callback to run on the UI thread once this
+// handle's tree is idle. See the package-level [DispatchIdle].
+func (h *EngineHandle) DispatchIdle(callback func()) {
+	h.runner.dispatchIdle(callback)
+}
+
+// RequestFrame marks this handle's render tree as needing paint.
+func (h *EngineHandle) RequestFrame() {
+	h.runner.requestFrame()
+}
+
+// NeedsFrame reports whether this handle has work for a new frame.
+func (h *EngineHandle) NeedsFrame() bool {
+	return h.runner.needsFrame()
+}
+
+// HandlePointerEvent routes a raw pointer event to this handle's tree.
+func (h *EngineHandle) HandlePointerEvent(event PointerEvent) {
+	h.runner.HandlePointer(event)
+}
+
+// StepFrame runs build, layout, and paint recording for this handle at the
+// given logical size. Follow it with [EngineHandle.RenderFrame].
+func (h *EngineHandle) StepFrame(size graphics.Size) (*FrameSnapshot, error) {
+	return h.runner.StepFrame(size)
+}
+
+// RenderFrame composites the layers recorded by the last
+// [EngineHandle.StepFrame] onto canvas.
+func (h *EngineHandle) RenderFrame(canvas graphics.Canvas) error {
+	return h.runner.RenderFrame(canvas)
+}
+
+// Restart unmounts this handle's tree and mounts it again from scratch.
+func (h *EngineHandle) Restart() {
+	h.runner.restart()
+}
+
+// Dispose unmounts this handle's tree and releases the handle. Calling
+// Dispose on the default handle has no effect.
+func (h *EngineHandle) Dispose() {
+	if h == defaultHandle {
+		return
+	}
+	frameLock.Lock()
+	if h.runner.root != nil {
+		h.runner.root.Unmount()
+		h.runner.root = nil
+	}
+	h.runner.rootRender = nil
+	frameLock.Unlock()
+
+	handlesMu.Lock()
+	delete(handles, h.runner.buildOwner)
+	if views[h.view] == h {
+		delete(views, h.view)
+	}
+	handlesMu.Unlock()
+}
+
+// BuildOwner returns the build owner of this handle's tree.
+func (h *EngineHandle) BuildOwner() *core.BuildOwner {
+	return h.runner.buildOwner
+}
+
+// GestureArena returns the arena that this handle's gesture recognizers join.
+func (h *EngineHandle) GestureArena() *gestures.GestureArena {
+	return h.runner.arena
+}
+
+// Scoped returns the value stored under key for this handle, calling create
+// to make it on first use. Packages that keep per-engine state, such as the
+// navigation scope, use it instead of package-level singletons. Keys should
+// be unexported types to avoid collisions.
+func (h *EngineHandle) Scoped(key any, create func() any) any {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if v, ok := h.scoped[key]; ok {
+		return v
+	}
+	if h.scoped == nil {
+		h.scoped = make(map[any]any)
+	}
+	v := create()
+	h.scoped[key] = v
+	return v
+}
@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+type contextProbe struct {
+	core.StatelessBase
+	ctx *core.BuildContext
+}
+
+func (p contextProbe) Build(ctx core.BuildContext) core.Widget {
+	*p.ctx = ctx
+	return widgets.SizedBox{}
+}
+
+func stepHandle(h *EngineHandle) {
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	h.runner.runPipeline(testSize, nil)
+}
+
+func TestEngineHandle_IndependentTrees(t *testing.T) {
+	first := NewEngineHandle()
+	second := NewEngineHandle()
+	defer first.Dispose()
+	defer second.Dispose()
+
+	var firstCtx, secondCtx core.BuildContext
+	first.SetApp(contextProbe{ctx: &firstCtx})
+	second.SetApp(contextProbe{ctx: &secondCtx})
+	stepHandle(first)
+	stepHandle(second)
+
+	if firstCtx == nil || secondCtx == nil {
+		t.Fatal("expected both trees to build")
+	}
+	if got := HandleOf(firstCtx); got != first {
+		t.Errorf("HandleOf(first tree) = %p, want %p", got, first)
+	}
+	if got := HandleOf(secondCtx); got != second {
+		t.Errorf("HandleOf(second tree) = %p, want %p", got, second)
+	}
+	if first.GestureArena() == second.GestureArena() {
+		t.Error("expected each handle to have its own gesture arena")
+	}
+	if first.GestureArena() == gestures.DefaultArena {
+		t.Error("expected new handles not to share the default arena")
+	}
+	if first.BuildOwner().Pipeline().GestureArena() != first.GestureArena() {
+		t.Error("expected the pipeline owner to expose the handle's arena")
+	}
+}
+
+func TestEngineHandle_DispatchIsPerHandle(t *testing.T) {
+	h := NewEngineHandle()
+	defer h.Dispose()
+
+	h.Dispatch(func() {})
+	if n := len(h.runner.drainDispatchQueue()); n != 1 {
+		t.Errorf("handle queue length = %d, want 1", n)
+	}
+	app.dispatchMu.Lock()
+	n := len(app.dispatchQueue)
+	app.dispatchMu.Unlock()
+	if n != 0 {
+		t.Errorf("default queue length = %d, want 0", n)
+	}
+}
+
+func TestEngineHandle_Dispose(t *testing.T) {
+	h := NewEngineHandle()
+	var ctx core.BuildContext
+	h.SetApp(contextProbe{ctx: &ctx})
+	stepHandle(h)
+
+	h.Dispose()
+	if h.runner.root != nil {
+		t.Error("expected Dispose to unmount the tree")
+	}
+	if HandleOf(ctx) != nil {
+		t.Error("expected Dispose to unregister the handle")
+	}
+
+	DefaultHandle().Dispose()
+	if HandleOf(nil) != nil {
+		t.Error("expected nil context to have no handle")
+	}
+}
+
+func TestEngineHandle_Scoped(t *testing.T) {
+	h := NewEngineHandle()
+	defer h.Dispose()
+
+	type key struct{}
+	calls := 0
+	create := func() any { calls++; return &calls }
+	a := h.Scoped(key{}, create)
+	b := h.Scoped(key{}, create)
+	if a != b || calls != 1 {
+		t.Errorf("Scoped created %d values, want 1", calls)
+	}
+	if DefaultHandle().Scoped(key{}, func() any { return "default" }) != "default" {
+		t.Error("expected handles to keep separate scoped values")
+	}
+}
@@ -27,7 +27,8 @@ func HitTestPlatformView(viewID int64, x, y float64) bool {
 	position := graphics.Offset{X: x / scale, Y: y / scale}
 
 	result := &layout.HitTestResult{}
-	if !rootRender.HitTest(position, result) || len(result.Entries) == 0 {
+	rootRender.HitTest(position, result)
+	if len(result.Entries) == 0 {
 		return false
 	}
 
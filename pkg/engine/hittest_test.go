@@ -134,7 +134,7 @@ func TestHitTestPlatformView(t *testing.T) {
 			saved := app
 			defer func() { app = saved }()
 
-			app = newAppRunner()
+			app = newAppRunner(gestures.NewGestureArena())
 			app.deviceScale = 1.0
 
 			if tt.name == "nil root render" {
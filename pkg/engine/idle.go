@@ -0,0 +1,50 @@
+package engine
+
+import "time"
+
+// DispatchIdle schedules a callback to run on the UI thread once the app is
+// idle: no animation or scroll is running and the frame has nothing else to
+// build. Use it for speculative work, such as decoding an image the next
+// screen will show, that should not delay frames the user is watching.
+//
+// Idle callbacks run in the order they were scheduled. Each idle frame runs
+// callbacks until its time budget is spent, so a long queue is spread over
+// several frames. Safe to call from any goroutine.
+func DispatchIdle(callback func()) {
+	app.dispatchIdle(callback)
+}
+
+func (a *appRunner) dispatchIdle(callback func()) {
+	if callback == nil {
+		return
+	}
+	a.dispatchMu.Lock()
+	a.idleQueue = append(a.idleQueue, callback)
+	a.dispatchMu.Unlock()
+	schedulePlatformFrame()
+}
+
+// idleFrameBudget is how long idle callbacks may run in one frame. At least
+// one callback runs per idle frame, however long it takes.
+const idleFrameBudget = 8 * time.Millisecond
+
+// runIdleCallbacks runs queued idle callbacks until the budget is spent.
+// Must be called with frameLock held.
+func (a *appRunner) runIdleCallbacks() int {
+	start := time.Now()
+	ran := 0
+	for ran == 0 || time.Since(start) < idleFrameBudget {
+		a.dispatchMu.Lock()
+		if len(a.idleQueue) == 0 {
+			a.dispatchMu.Unlock()
+			break
+		}
+		callback := a.idleQueue[0]
+		a.idleQueue[0] = nil
+		a.idleQueue = a.idleQueue[1:]
+		a.dispatchMu.Unlock()
+		callback()
+		ran++
+	}
+	return ran
+}
@@ -0,0 +1,58 @@
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDispatchIdle_WaitsForIdleFrame(t *testing.T) {
+	swapApp(t)
+
+	ran := 0
+	app.dispatchIdle(func() { ran++ })
+	if !app.needsFrame() {
+		t.Fatal("expected a queued idle callback to need a frame")
+	}
+
+	// The frame that mounts the root has work of its own.
+	runPipelineLocked()
+	if ran != 0 {
+		t.Fatal("expected idle callback to wait while the root mounts")
+	}
+
+	// A frame with dispatch callbacks isn't idle either.
+	app.dispatch(func() {})
+	runPipelineLocked()
+	if ran != 0 {
+		t.Fatal("expected idle callback to wait while dispatch callbacks run")
+	}
+
+	runPipelineLocked()
+	if ran != 1 {
+		t.Fatalf("expected idle callback to run once in an idle frame, ran %d times", ran)
+	}
+	if app.needsFrame() {
+		t.Fatal("expected no frame needed after the idle queue drains")
+	}
+}
+
+func TestDispatchIdle_SpreadsOverFrames(t *testing.T) {
+	swapApp(t)
+	runPipelineLocked()
+
+	var order []int
+	app.dispatchIdle(func() {
+		order = append(order, 1)
+		time.Sleep(idleFrameBudget)
+	})
+	app.dispatchIdle(func() { order = append(order, 2) })
+
+	runPipelineLocked()
+	if len(order) != 1 {
+		t.Fatalf("expected one callback in the first idle frame, got %v", order)
+	}
+	runPipelineLocked()
+	if len(order) != 2 || order[1] != 2 {
+		t.Fatalf("expected callbacks in order across frames, got %v", order)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-drift/drift/pkg/gestures"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/platform"
 )
@@ -14,7 +15,7 @@ import (
 var testSize = graphics.Size{Width: 100, Height: 100}
 
 func newTestRunner() *appRunner {
-	r := newAppRunner()
+	r := newAppRunner(gestures.NewGestureArena())
 	r.buildOwner.OnNeedsFrame = func() {}
 	return r
 }
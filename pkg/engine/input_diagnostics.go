@@ -0,0 +1,187 @@
+package engine
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+const (
+	// touchMarkRadius is the radius of the dot drawn under a touch point.
+	touchMarkRadius = 22.0
+	// touchRippleDuration is how long the ring expands after a pointer down.
+	touchRippleDuration = 350 * time.Millisecond
+	// touchFadeDuration is how long a touch mark fades after the pointer lifts.
+	touchFadeDuration = 300 * time.Millisecond
+)
+
+// touchMark is a pointer drawn by the touch overlay. upAt is zero while the
+// pointer is still down.
+type touchMark struct {
+	pointerID int64
+	position  graphics.Offset
+	downAt    time.Time
+	upAt      time.Time
+}
+
+// recordInputLocked notes the arrival of a pointer event for latency
+// measurement and updates the touch overlay. Returns true if touch marks
+// changed and a frame is needed to show them. Must be called with frameLock held.
+func (a *appRunner) recordInputLocked(event PointerEvent, position graphics.Offset) bool {
+	if a.inputLatency != nil || a.frameTraceEnabled {
+		at := event.Timestamp
+		if at.IsZero() {
+			at = time.Now()
+		}
+		if a.pendingInputAt.IsZero() || at.Before(a.pendingInputAt) {
+			a.pendingInputAt = at
+		}
+	}
+
+	if a.diagnosticsConfig == nil || !a.diagnosticsConfig.ShowTouches {
+		return false
+	}
+	now := time.Now()
+	switch event.Phase {
+	case PointerPhaseDown:
+		a.touchMarks = append(a.touchMarks, touchMark{
+			pointerID: event.PointerID,
+			position:  position,
+			downAt:    now,
+		})
+	default:
+		for i := range a.touchMarks {
+			mark := &a.touchMarks[i]
+			if mark.pointerID != event.PointerID || !mark.upAt.IsZero() {
+				continue
+			}
+			mark.position = position
+			if event.Phase == PointerPhaseUp || event.Phase == PointerPhaseCancel {
+				mark.upAt = now
+			}
+		}
+	}
+	if a.touchOverlay != nil {
+		a.touchOverlay.MarkNeedsPaint()
+	}
+	return true
+}
+
+// takeInputLatencyLocked returns the time since the earliest pointer event
+// received after the previous frame and clears it. Returns false if no input
+// arrived. Must be called with frameLock held.
+func (a *appRunner) takeInputLatencyLocked() (time.Duration, bool) {
+	if a.pendingInputAt.IsZero() {
+		return 0, false
+	}
+	latency := max(time.Since(a.pendingInputAt), 0)
+	a.pendingInputAt = time.Time{}
+
+	if a.inputLatency != nil {
+		a.inputLatency.Add(latency)
+		a.inputLatencyLabel = "Input: " + strconv.FormatFloat(durationToMillis(a.inputLatency.Average()), 'f', 1, 64) + " ms"
+	}
+	return latency, true
+}
+
+// stepTouchMarksLocked drops touch marks that have finished fading and
+// repaints the overlay while any remain. Must be called with frameLock held.
+func (a *appRunner) stepTouchMarksLocked(now time.Time) {
+	if len(a.touchMarks) == 0 {
+		return
+	}
+	kept := a.touchMarks[:0]
+	for _, mark := range a.touchMarks {
+		if mark.upAt.IsZero() || now.Sub(mark.upAt) < touchFadeDuration {
+			kept = append(kept, mark)
+		}
+	}
+	clear(a.touchMarks[len(kept):])
+	a.touchMarks = kept
+	if a.touchOverlay != nil {
+		a.touchOverlay.MarkNeedsPaint()
+	}
+}
+
+// touchOverlay draws the runner's touch marks above the app.
+type touchOverlay struct {
+	core.RenderObjectBase
+	runner *appRunner
+}
+
+func (t touchOverlay) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderTouchOverlay{runner: t.runner}
+	r.SetSelf(r)
+	if t.runner != nil {
+		t.runner.touchOverlay = r
+	}
+	return r
+}
+
+func (t touchOverlay) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderTouchOverlay); ok {
+		r.runner = t.runner
+		if t.runner != nil {
+			t.runner.touchOverlay = r
+		}
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderTouchOverlay struct {
+	layout.RenderBoxBase
+	runner *appRunner
+}
+
+// IsRepaintBoundary returns true so ripple frames only repaint the overlay.
+func (r *renderTouchOverlay) IsRepaintBoundary() bool {
+	return true
+}
+
+func (r *renderTouchOverlay) PerformLayout() {
+	constraints := r.Constraints()
+	r.SetSize(constraints.Constrain(graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight}))
+}
+
+func (r *renderTouchOverlay) Paint(ctx *layout.PaintContext) {
+	if r.runner == nil {
+		return
+	}
+	now := time.Now()
+	for _, mark := range r.runner.touchMarks {
+		opacity := 1.0
+		if !mark.upAt.IsZero() {
+			opacity = 1 - float64(now.Sub(mark.upAt))/float64(touchFadeDuration)
+			if opacity <= 0 {
+				continue
+			}
+		}
+
+		fill := graphics.DefaultPaint()
+		fill.Color = graphics.RGBA(255, 255, 255, 0.4*opacity)
+		ctx.Canvas.DrawCircle(mark.position, touchMarkRadius, fill)
+
+		// A dark outline keeps the dot visible on light backgrounds.
+		outline := graphics.DefaultPaint()
+		outline.Style = graphics.PaintStyleStroke
+		outline.StrokeWidth = 1
+		outline.Color = graphics.RGBA(0, 0, 0, 0.3*opacity)
+		ctx.Canvas.DrawCircle(mark.position, touchMarkRadius, outline)
+
+		if progress := float64(now.Sub(mark.downAt)) / float64(touchRippleDuration); progress < 1 {
+			ring := graphics.DefaultPaint()
+			ring.Style = graphics.PaintStyleStroke
+			ring.StrokeWidth = 2
+			ring.Color = graphics.RGBA(255, 255, 255, 0.6*(1-progress)*opacity)
+			ctx.Canvas.DrawCircle(mark.position, touchMarkRadius*(1+progress), ring)
+		}
+	}
+}
+
+// HitTest returns false so touches pass through to the app below.
+func (r *renderTouchOverlay) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
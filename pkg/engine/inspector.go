@@ -0,0 +1,680 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+const (
+	// inspectorMaxValueLength caps the length of a described property value.
+	inspectorMaxValueLength = 200
+	// inspectorMaxListItems caps the number of slice items described inline.
+	inspectorMaxListItems = 5
+)
+
+// InspectorNode is a node in the tree returned by /inspector/tree.
+type InspectorNode struct {
+	ID         int                 `json:"id"`
+	WidgetType string              `json:"widgetType"`
+	Key        any                 `json:"key,omitempty"`
+	Bounds     *SafeRect           `json:"bounds,omitempty"`
+	Selected   bool                `json:"selected,omitempty"`
+	Properties []InspectorProperty `json:"properties,omitempty"`
+	Children   []InspectorNode     `json:"children,omitempty"`
+}
+
+// InspectorNodeDetails describes one element, its widget's properties, and
+// the render object it paints with. Returned by /inspector/node and
+// /inspector/selection.
+type InspectorNodeDetails struct {
+	ID           int                 `json:"id"`
+	WidgetType   string              `json:"widgetType"`
+	ElementType  string              `json:"elementType"`
+	Key          any                 `json:"key,omitempty"`
+	Depth        int                 `json:"depth"`
+	Bounds       *SafeRect           `json:"bounds,omitempty"`
+	Properties   []InspectorProperty `json:"properties"`
+	RenderObject *RenderTreeNode     `json:"renderObject,omitempty"`
+	Ancestors    []InspectorNodeRef  `json:"ancestors"`
+	Children     []InspectorNodeRef  `json:"children"`
+}
+
+// InspectorNodeRef identifies a node without its details.
+type InspectorNodeRef struct {
+	ID         int    `json:"id"`
+	WidgetType string `json:"widgetType"`
+}
+
+// InspectorProperty is an exported field of a widget, with its value
+// summarized as a string.
+type InspectorProperty struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// InspectorSelection is the inspector's selection state. Version changes
+// whenever the selection or select mode changes, so a client can poll
+// /inspector/selection and refresh only when it differs.
+type InspectorSelection struct {
+	Version    int                   `json:"version"`
+	SelectMode bool                  `json:"selectMode"`
+	Node       *InspectorNodeDetails `json:"node"`
+}
+
+// SafeRect is a JSON-safe rectangle in logical pixels, relative to the
+// top-left of the app.
+type SafeRect struct {
+	X      SafeFloat `json:"x"`
+	Y      SafeFloat `json:"y"`
+	Width  SafeFloat `json:"width"`
+	Height SafeFloat `json:"height"`
+}
+
+// inspectorState tracks node IDs, the selected element, and on-device
+// selection. It is guarded by frameLock.
+type inspectorState struct {
+	ids        map[core.Element]int
+	elements   map[int]core.Element
+	nextID     int
+	selected   core.Element
+	selectMode bool
+	version    int
+	// pointers holds the pointers that went down in select mode. Their
+	// events are consumed by the inspector instead of reaching the app.
+	pointers map[int64]struct{}
+	overlay  layout.RenderObject
+}
+
+// idFor returns the stable ID for element, assigning one if needed.
+func (s *inspectorState) idFor(element core.Element) int {
+	if id, ok := s.ids[element]; ok {
+		return id
+	}
+	if s.ids == nil {
+		s.ids = make(map[core.Element]int)
+		s.elements = make(map[int]core.Element)
+	}
+	s.nextID++
+	s.ids[element] = s.nextID
+	s.elements[s.nextID] = element
+	return s.nextID
+}
+
+// sync walks the tree from root, drops IDs of elements that are no longer
+// mounted, and clears the selection if its element is gone.
+func (s *inspectorState) sync(root core.Element) {
+	live := make(map[core.Element]struct{}, len(s.ids))
+	var visit func(element core.Element, depth int)
+	visit = func(element core.Element, depth int) {
+		live[element] = struct{}{}
+		if depth >= maxTreeDepth {
+			return
+		}
+		element.VisitChildren(func(child core.Element) bool {
+			visit(child, depth+1)
+			return true
+		})
+	}
+	if root != nil {
+		visit(root, 0)
+	}
+	for element, id := range s.ids {
+		if _, ok := live[element]; !ok {
+			delete(s.ids, element)
+			delete(s.elements, id)
+		}
+	}
+	if s.selected != nil {
+		if _, ok := live[s.selected]; !ok {
+			s.setSelected(nil)
+		}
+	}
+}
+
+// setSelected changes the selection and repaints the highlight.
+func (s *inspectorState) setSelected(element core.Element) {
+	if s.selected == element {
+		return
+	}
+	s.selected = element
+	s.version++
+	if s.overlay != nil {
+		s.overlay.MarkNeedsPaint()
+	}
+}
+
+// setSelectMode turns on-device selection on or off.
+func (s *inspectorState) setSelectMode(enabled bool) {
+	if s.selectMode == enabled {
+		return
+	}
+	s.selectMode = enabled
+	s.version++
+}
+
+// handleInspectorPointerLocked consumes pointer events while select mode is
+// on, selecting the deepest element under each pointer down. Returns true if
+// the event was consumed. Must be called with frameLock held.
+func (a *appRunner) handleInspectorPointerLocked(event PointerEvent, position graphics.Offset) bool {
+	s := &a.inspector
+	switch event.Phase {
+	case PointerPhaseDown:
+		if !s.selectMode {
+			return false
+		}
+		if s.pointers == nil {
+			s.pointers = make(map[int64]struct{})
+		}
+		s.pointers[event.PointerID] = struct{}{}
+		if element := a.elementAtLocked(position); element != nil {
+			s.setSelected(element)
+		}
+		return true
+	case PointerPhaseUp, PointerPhaseCancel:
+		if _, ok := s.pointers[event.PointerID]; !ok {
+			return false
+		}
+		delete(s.pointers, event.PointerID)
+		return true
+	default:
+		_, ok := s.pointers[event.PointerID]
+		return ok
+	}
+}
+
+// elementAtLocked returns the deepest element whose render object is hit at
+// position, or nil. Must be called with frameLock held.
+func (a *appRunner) elementAtLocked(position graphics.Offset) core.Element {
+	if a.root == nil || a.rootRender == nil {
+		return nil
+	}
+	result := &layout.HitTestResult{}
+	a.rootRender.HitTest(position, result)
+	if len(result.Entries) == 0 {
+		return nil
+	}
+
+	// Later (deeper) elements overwrite their ancestors, so each render
+	// object maps to the element that created it.
+	owners := make(map[layout.RenderObject]core.Element)
+	var visit func(element core.Element, depth int)
+	visit = func(element core.Element, depth int) {
+		if ro := inspectorRenderObject(element); ro != nil {
+			owners[ro] = element
+		}
+		if depth >= maxTreeDepth {
+			return
+		}
+		element.VisitChildren(func(child core.Element) bool {
+			visit(child, depth+1)
+			return true
+		})
+	}
+	visit(a.root, 0)
+
+	// Hit test entries are ordered deepest first. Skip the inspector's own
+	// overlays so they never shadow the app.
+	for _, entry := range result.Entries {
+		if entry == a.inspector.overlay || entry == a.touchOverlay {
+			continue
+		}
+		if element, ok := owners[entry]; ok {
+			return element
+		}
+	}
+	return nil
+}
+
+// inspectorRenderObject returns the render object an element paints with,
+// or nil.
+func inspectorRenderObject(element core.Element) layout.RenderObject {
+	if getter, ok := element.(interface{ RenderObject() layout.RenderObject }); ok {
+		return getter.RenderObject()
+	}
+	return nil
+}
+
+// inspectorBounds returns the element's bounds relative to the app, or nil
+// if it has no render object.
+func inspectorBounds(element core.Element) *SafeRect {
+	ro := inspectorRenderObject(element)
+	if ro == nil {
+		return nil
+	}
+	offset := core.GlobalOffsetOf(element)
+	size := ro.Size()
+	return &SafeRect{
+		X:      SafeFloat(offset.X),
+		Y:      SafeFloat(offset.Y),
+		Width:  SafeFloat(size.Width),
+		Height: SafeFloat(size.Height),
+	}
+}
+
+// inspectorTree serializes the element tree for /inspector/tree.
+func (s *inspectorState) tree(element core.Element, depth int, withProperties bool) InspectorNode {
+	node := InspectorNode{
+		ID:       s.idFor(element),
+		Bounds:   inspectorBounds(element),
+		Selected: element == s.selected,
+	}
+	if widget := element.Widget(); widget != nil {
+		node.WidgetType = reflect.TypeOf(widget).String()
+		node.Key = safeKey(widget.Key())
+		if withProperties {
+			node.Properties = inspectorProperties(widget)
+		}
+	}
+	if depth < maxTreeDepth {
+		element.VisitChildren(func(child core.Element) bool {
+			node.Children = append(node.Children, s.tree(child, depth+1, withProperties))
+			return true
+		})
+	}
+	return node
+}
+
+// details describes element for /inspector/node and /inspector/selection.
+func (s *inspectorState) details(element core.Element) *InspectorNodeDetails {
+	d := &InspectorNodeDetails{
+		ID:          s.idFor(element),
+		WidgetType:  inspectorWidgetType(element),
+		ElementType: reflect.TypeOf(element).String(),
+		Depth:       element.Depth(),
+		Bounds:      inspectorBounds(element),
+		Properties:  []InspectorProperty{},
+		Ancestors:   []InspectorNodeRef{},
+		Children:    []InspectorNodeRef{},
+	}
+	if widget := element.Widget(); widget != nil {
+		d.Key = safeKey(widget.Key())
+		if props := inspectorProperties(widget); props != nil {
+			d.Properties = props
+		}
+	}
+	if ro := inspectorRenderObject(element); ro != nil {
+		node := describeRenderObject(ro)
+		d.RenderObject = &node
+	}
+
+	// FindAncestor visits ancestors nearest first; report them root first.
+	if ctx, ok := element.(core.BuildContext); ok {
+		ctx.FindAncestor(func(ancestor core.Element) bool {
+			d.Ancestors = append(d.Ancestors, InspectorNodeRef{ID: s.idFor(ancestor), WidgetType: inspectorWidgetType(ancestor)})
+			return false
+		})
+	}
+	for i, j := 0, len(d.Ancestors)-1; i < j; i, j = i+1, j-1 {
+		d.Ancestors[i], d.Ancestors[j] = d.Ancestors[j], d.Ancestors[i]
+	}
+	element.VisitChildren(func(child core.Element) bool {
+		d.Children = append(d.Children, InspectorNodeRef{ID: s.idFor(child), WidgetType: inspectorWidgetType(child)})
+		return true
+	})
+	return d
+}
+
+// selection returns the current selection state.
+func (s *inspectorState) selection() InspectorSelection {
+	sel := InspectorSelection{Version: s.version, SelectMode: s.selectMode}
+	if s.selected != nil {
+		sel.Node = s.details(s.selected)
+	}
+	return sel
+}
+
+func inspectorWidgetType(element core.Element) string {
+	if widget := element.Widget(); widget != nil {
+		return reflect.TypeOf(widget).String()
+	}
+	return ""
+}
+
+// inspectorProperties lists the exported fields of a widget struct.
+// Embedded fields, such as core.StatelessBase, are skipped.
+func inspectorProperties(widget any) []InspectorProperty {
+	v := reflect.ValueOf(widget)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	var props []InspectorProperty
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		props = append(props, InspectorProperty{
+			Name:  field.Name,
+			Type:  field.Type.String(),
+			Value: truncateInspectorValue(describeInspectorValue(v.Field(i))),
+		})
+	}
+	return props
+}
+
+var (
+	widgetInterface   = reflect.TypeFor[core.Widget]()
+	stringerInterface = reflect.TypeFor[fmt.Stringer]()
+)
+
+// describeInspectorValue summarizes a property value. Child widgets are
+// reported by type, since the tree already shows them.
+func describeInspectorValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	switch v.Kind() {
+	case reflect.Func:
+		if v.IsNil() {
+			return "nil"
+		}
+		return "func"
+	case reflect.Chan, reflect.Map, reflect.Slice, reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return "nil"
+		}
+	}
+	if v.Kind() == reflect.Interface {
+		return describeInspectorValue(v.Elem())
+	}
+	if v.Type().Implements(widgetInterface) {
+		return v.Type().String()
+	}
+	if v.CanInterface() && v.Type().Implements(stringerInterface) {
+		return v.Interface().(fmt.Stringer).String()
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Pointer:
+		return "&" + describeInspectorValue(v.Elem())
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Implements(widgetInterface) {
+			return fmt.Sprintf("[%d widgets]", v.Len())
+		}
+		items := make([]string, 0, min(v.Len(), inspectorMaxListItems+1))
+		for i := range v.Len() {
+			if i == inspectorMaxListItems {
+				items = append(items, fmt.Sprintf("… %d more", v.Len()-i))
+				break
+			}
+			items = append(items, describeInspectorValue(v.Index(i)))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case reflect.Map:
+		return fmt.Sprintf("map[%d entries]", v.Len())
+	case reflect.Chan:
+		return "chan"
+	}
+	if v.CanInterface() {
+		return fmt.Sprintf("%+v", v.Interface())
+	}
+	return v.Type().String()
+}
+
+func truncateInspectorValue(s string) string {
+	if len(s) <= inspectorMaxValueLength {
+		return s
+	}
+	return s[:inspectorMaxValueLength] + "…"
+}
+
+// handleInspectorTree returns the element tree with node IDs and bounds.
+// Pass properties=1 to include each widget's properties.
+func handleInspectorTree(w http.ResponseWriter, r *http.Request) {
+	if !inspectorPreflight(w, r, http.MethodGet) {
+		return
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			http.Error(w, fmt.Sprintf("panic: %v", rec), http.StatusInternalServerError)
+		}
+	}()
+
+	withProperties, _ := strconv.ParseBool(r.URL.Query().Get("properties"))
+
+	frameLock.Lock()
+	root := app.root
+	if root == nil {
+		frameLock.Unlock()
+		http.Error(w, "no widget tree", http.StatusServiceUnavailable)
+		return
+	}
+	app.inspector.sync(root)
+	tree := app.inspector.tree(root, 0, withProperties)
+	frameLock.Unlock()
+
+	writeInspectorJSON(w, tree)
+}
+
+// handleInspectorNode returns the details of the node with the given id.
+func handleInspectorNode(w http.ResponseWriter, r *http.Request) {
+	if !inspectorPreflight(w, r, http.MethodGet) {
+		return
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			http.Error(w, fmt.Sprintf("panic: %v", rec), http.StatusInternalServerError)
+		}
+	}()
+
+	id, err := strconv.Atoi(r.URL.Query().Get("id"))
+	if err != nil {
+		http.Error(w, "missing or invalid id", http.StatusBadRequest)
+		return
+	}
+
+	frameLock.Lock()
+	app.inspector.sync(app.root)
+	element, ok := app.inspector.elements[id]
+	if !ok {
+		frameLock.Unlock()
+		http.Error(w, "node not found", http.StatusNotFound)
+		return
+	}
+	details := app.inspector.details(element)
+	frameLock.Unlock()
+
+	writeInspectorJSON(w, details)
+}
+
+// handleInspectorSelection reads (GET), changes (POST), or clears (DELETE)
+// the selected node. POST takes {"id": n} to select a node by ID, or
+// {"x": x, "y": y} to select the deepest node at a point in logical pixels.
+func handleInspectorSelection(w http.ResponseWriter, r *http.Request) {
+	if !inspectorPreflight(w, r, http.MethodGet, http.MethodPost, http.MethodDelete) {
+		return
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			http.Error(w, fmt.Sprintf("panic: %v", rec), http.StatusInternalServerError)
+		}
+	}()
+
+	var req struct {
+		ID *int     `json:"id"`
+		X  *float64 `json:"x"`
+		Y  *float64 `json:"y"`
+	}
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+			return
+		}
+		if req.ID == nil && (req.X == nil || req.Y == nil) {
+			http.Error(w, "expected id or x and y", http.StatusBadRequest)
+			return
+		}
+	}
+
+	frameLock.Lock()
+	app.inspector.sync(app.root)
+	switch r.Method {
+	case http.MethodPost:
+		var element core.Element
+		if req.ID != nil {
+			element = app.inspector.elements[*req.ID]
+		} else {
+			element = app.elementAtLocked(graphics.Offset{X: *req.X, Y: *req.Y})
+		}
+		if element == nil {
+			frameLock.Unlock()
+			http.Error(w, "node not found", http.StatusNotFound)
+			return
+		}
+		app.inspector.setSelected(element)
+	case http.MethodDelete:
+		app.inspector.setSelected(nil)
+	}
+	selection := app.inspector.selection()
+	frameLock.Unlock()
+
+	if r.Method != http.MethodGet {
+		schedulePlatformFrame()
+	}
+	writeInspectorJSON(w, selection)
+}
+
+// handleInspectorSelectMode turns on-device selection on or off. While it is
+// on, taps select the widget under them instead of reaching the app. Takes
+// {"enabled": bool}.
+func handleInspectorSelectMode(w http.ResponseWriter, r *http.Request) {
+	if !inspectorPreflight(w, r, http.MethodPost) {
+		return
+	}
+
+	var req struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	frameLock.Lock()
+	app.inspector.sync(app.root)
+	app.inspector.setSelectMode(req.Enabled)
+	selection := app.inspector.selection()
+	frameLock.Unlock()
+
+	writeInspectorJSON(w, selection)
+}
+
+// inspectorPreflight sets CORS headers so browser-based tools can use the
+// inspector, answers OPTIONS preflight requests, and rejects other methods.
+// Returns true if the handler should continue.
+func inspectorPreflight(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	allowed := strings.Join(methods, ", ")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", allowed+", OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return false
+	}
+	for _, m := range methods {
+		if r.Method == m {
+			return true
+		}
+	}
+	w.Header().Set("Allow", allowed)
+	http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	return false
+}
+
+func writeInspectorJSON(w http.ResponseWriter, v any) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("json encode error: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// inspectorOverlay highlights the inspector's selected element above the
+// app.
+type inspectorOverlay struct {
+	core.RenderObjectBase
+	runner *appRunner
+}
+
+func (o inspectorOverlay) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderInspectorOverlay{runner: o.runner}
+	r.SetSelf(r)
+	if o.runner != nil {
+		o.runner.inspector.overlay = r
+	}
+	return r
+}
+
+func (o inspectorOverlay) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderInspectorOverlay); ok {
+		r.runner = o.runner
+		if o.runner != nil {
+			o.runner.inspector.overlay = r
+		}
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderInspectorOverlay struct {
+	layout.RenderBoxBase
+	runner *appRunner
+}
+
+// IsRepaintBoundary returns true so moving the highlight only repaints the
+// overlay.
+func (r *renderInspectorOverlay) IsRepaintBoundary() bool {
+	return true
+}
+
+func (r *renderInspectorOverlay) PerformLayout() {
+	constraints := r.Constraints()
+	r.SetSize(constraints.Constrain(graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight}))
+}
+
+func (r *renderInspectorOverlay) Paint(ctx *layout.PaintContext) {
+	if r.runner == nil || r.runner.inspector.selected == nil {
+		return
+	}
+	bounds := inspectorBounds(r.runner.inspector.selected)
+	if bounds == nil {
+		return
+	}
+	rect := graphics.RectFromLTWH(float64(bounds.X), float64(bounds.Y), float64(bounds.Width), float64(bounds.Height))
+
+	fill := graphics.DefaultPaint()
+	fill.Color = graphics.RGBA(66, 133, 244, 0.25)
+	ctx.Canvas.DrawRect(rect, fill)
+
+	border := graphics.DefaultPaint()
+	border.Style = graphics.PaintStyleStroke
+	border.StrokeWidth = 2
+	border.Color = graphics.RGBA(66, 133, 244, 0.9)
+	ctx.Canvas.DrawRect(rect, border)
+}
+
+// HitTest returns false so touches pass through to the app below.
+func (r *renderInspectorOverlay) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
@@ -0,0 +1,265 @@
+package engine
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// swapInspectorApp mounts a centered 40x40 tap target, with the debug
+// server enabled so the inspector overlay is built. Returns a pointer to
+// the number of taps the app received.
+func swapInspectorApp(t *testing.T) *int {
+	t.Helper()
+	a := swapApp(t)
+	a.diagnosticsConfig = &DiagnosticsConfig{DebugServerPort: 1}
+	taps := new(int)
+	a.userApp = widgets.Center{
+		Child: widgets.GestureDetector{
+			OnTap: func() { *taps++ },
+			Child: widgets.SizedBox{Width: 40, Height: 40, Child: widgets.Text{Content: "target"}},
+		},
+	}
+	if !runPipelineLocked() {
+		t.Fatal("expected the app to mount")
+	}
+	return taps
+}
+
+func inspectorRequest(t *testing.T, handler http.HandlerFunc, method, target, body string, out any) int {
+	t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+	if rec.Code == http.StatusOK && out != nil {
+		if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+			t.Fatalf("decode %s: %v", target, err)
+		}
+	}
+	return rec.Code
+}
+
+func findInspectorNode(node InspectorNode, widgetType string) *InspectorNode {
+	if node.WidgetType == widgetType {
+		return &node
+	}
+	for _, child := range node.Children {
+		if found := findInspectorNode(child, widgetType); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func TestInspector_Tree(t *testing.T) {
+	swapInspectorApp(t)
+
+	var tree InspectorNode
+	if code := inspectorRequest(t, handleInspectorTree, http.MethodGet, "/inspector/tree?properties=1", "", &tree); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	text := findInspectorNode(tree, "widgets.Text")
+	if text == nil {
+		t.Fatal("expected a widgets.Text node")
+	}
+	if text.ID == 0 {
+		t.Error("expected a node ID")
+	}
+	if text.Bounds == nil || text.Bounds.X != 30 || text.Bounds.Y != 30 {
+		t.Errorf("expected bounds at (30, 30), got %+v", text.Bounds)
+	}
+	var content string
+	for _, p := range text.Properties {
+		if p.Name == "Content" {
+			content = p.Value
+		}
+	}
+	if content != `"target"` {
+		t.Errorf("expected Content property %q, got %q", `"target"`, content)
+	}
+
+	// IDs are stable across requests.
+	var again InspectorNode
+	inspectorRequest(t, handleInspectorTree, http.MethodGet, "/inspector/tree", "", &again)
+	if node := findInspectorNode(again, "widgets.Text"); node == nil || node.ID != text.ID {
+		t.Errorf("expected ID %d on the second request, got %+v", text.ID, node)
+	}
+	if node := findInspectorNode(again, "widgets.Text"); node != nil && node.Properties != nil {
+		t.Error("expected no properties without properties=1")
+	}
+}
+
+func TestInspector_Node(t *testing.T) {
+	swapInspectorApp(t)
+
+	var tree InspectorNode
+	inspectorRequest(t, handleInspectorTree, http.MethodGet, "/inspector/tree", "", &tree)
+	sizedBox := findInspectorNode(tree, "widgets.SizedBox")
+	if sizedBox == nil {
+		t.Fatal("expected a widgets.SizedBox node")
+	}
+
+	var details InspectorNodeDetails
+	target := "/inspector/node?id=" + strconv.Itoa(sizedBox.ID)
+	if code := inspectorRequest(t, handleInspectorNode, http.MethodGet, target, "", &details); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if details.RenderObject == nil || details.RenderObject.Size.Width != 40 {
+		t.Errorf("expected a 40 wide render object, got %+v", details.RenderObject)
+	}
+	if len(details.Ancestors) == 0 || details.Ancestors[len(details.Ancestors)-1].WidgetType != "widgets.GestureDetector" {
+		t.Errorf("expected the GestureDetector as the nearest ancestor, got %+v", details.Ancestors)
+	}
+	if len(details.Children) != 1 || details.Children[0].WidgetType != "widgets.Text" {
+		t.Errorf("expected the Text child, got %+v", details.Children)
+	}
+
+	if code := inspectorRequest(t, handleInspectorNode, http.MethodGet, "/inspector/node?id=99999", "", nil); code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown node, got %d", code)
+	}
+}
+
+func TestInspector_SelectByPoint(t *testing.T) {
+	swapInspectorApp(t)
+
+	var sel InspectorSelection
+	if code := inspectorRequest(t, handleInspectorSelection, http.MethodPost, "/inspector/selection", `{"x": 50, "y": 50}`, &sel); code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if sel.Node == nil || sel.Node.WidgetType != "widgets.Text" {
+		t.Fatalf("expected the Text to be selected, got %+v", sel.Node)
+	}
+	if sel.Version == 0 {
+		t.Error("expected the version to change")
+	}
+	if app.inspector.overlay == nil {
+		t.Error("expected the inspector overlay to be mounted")
+	}
+
+	// Selecting outside the app is a 404 and keeps the selection.
+	if code := inspectorRequest(t, handleInspectorSelection, http.MethodPost, "/inspector/selection", `{"x": 500, "y": 500}`, nil); code != http.StatusNotFound {
+		t.Errorf("expected 404 outside the app, got %d", code)
+	}
+	if app.inspector.selected == nil {
+		t.Error("expected the selection to be kept")
+	}
+
+	var cleared InspectorSelection
+	inspectorRequest(t, handleInspectorSelection, http.MethodDelete, "/inspector/selection", "", &cleared)
+	if cleared.Node != nil || cleared.Version <= sel.Version {
+		t.Errorf("expected a cleared selection with a new version, got %+v", cleared)
+	}
+}
+
+func TestInspector_SelectMode(t *testing.T) {
+	taps := swapInspectorApp(t)
+
+	var sel InspectorSelection
+	inspectorRequest(t, handleInspectorSelectMode, http.MethodPost, "/inspector/select-mode", `{"enabled": true}`, &sel)
+	if !sel.SelectMode {
+		t.Fatal("expected select mode to be on")
+	}
+
+	app.HandlePointer(PointerEvent{PointerID: 1, X: 50, Y: 50, Phase: PointerPhaseDown})
+	app.HandlePointer(PointerEvent{PointerID: 1, X: 50, Y: 50, Phase: PointerPhaseUp})
+	runPipelineLocked()
+
+	if *taps != 0 {
+		t.Errorf("expected the tap to be consumed by the inspector, got %d taps", *taps)
+	}
+	inspectorRequest(t, handleInspectorSelection, http.MethodGet, "/inspector/selection", "", &sel)
+	if sel.Node == nil || sel.Node.WidgetType != "widgets.Text" {
+		t.Fatalf("expected the tapped Text to be selected, got %+v", sel.Node)
+	}
+
+	inspectorRequest(t, handleInspectorSelectMode, http.MethodPost, "/inspector/select-mode", `{"enabled": false}`, &sel)
+	app.HandlePointer(PointerEvent{PointerID: 2, X: 50, Y: 50, Phase: PointerPhaseDown})
+	app.HandlePointer(PointerEvent{PointerID: 2, X: 50, Y: 50, Phase: PointerPhaseUp})
+	if *taps != 1 {
+		t.Errorf("expected the tap to reach the app after select mode ends, got %d taps", *taps)
+	}
+}
+
+func TestInspector_SelectionClearedWhenUnmounted(t *testing.T) {
+	swapInspectorApp(t)
+
+	inspectorRequest(t, handleInspectorSelection, http.MethodPost, "/inspector/selection", `{"x": 50, "y": 50}`, nil)
+	if app.inspector.selected == nil {
+		t.Fatal("expected a selection")
+	}
+
+	frameLock.Lock()
+	app.userApp = defaultPlaceholder{}
+	app.root.MarkNeedsBuild()
+	frameLock.Unlock()
+	runPipelineLocked()
+	runPipelineLocked()
+
+	var sel InspectorSelection
+	inspectorRequest(t, handleInspectorSelection, http.MethodGet, "/inspector/selection", "", &sel)
+	if sel.Node != nil {
+		t.Errorf("expected the selection to be cleared, got %+v", sel.Node)
+	}
+}
+
+func TestInspector_CORSPreflight(t *testing.T) {
+	req := httptest.NewRequest(http.MethodOptions, "/inspector/selection", nil)
+	rec := httptest.NewRecorder()
+	handleInspectorSelection(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", rec.Code)
+	}
+	if rec.Header().Get("Access-Control-Allow-Origin") != "*" {
+		t.Error("expected CORS headers")
+	}
+}
+
+func TestDescribeInspectorValue(t *testing.T) {
+	type point struct{ X, Y int }
+	type props struct {
+		core.StatelessBase
+		Label    string
+		Count    int
+		Child    core.Widget
+		Children []core.Widget
+		Values   []int
+		OnTap    func()
+		Pos      *point
+		hidden   bool
+	}
+	got := map[string]string{}
+	for _, p := range inspectorProperties(props{
+		Label:    "hi",
+		Count:    3,
+		Child:    widgets.SizedBox{},
+		Children: []core.Widget{widgets.SizedBox{}, widgets.SizedBox{}},
+		Values:   []int{1, 2, 3, 4, 5, 6, 7},
+		OnTap:    func() {},
+		Pos:      &point{1, 2},
+	}) {
+		got[p.Name] = p.Value
+	}
+	want := map[string]string{
+		"Label":    `"hi"`,
+		"Count":    "3",
+		"Child":    "widgets.SizedBox",
+		"Children": "[2 widgets]",
+		"Values":   "[1, 2, 3, 4, 5, … 2 more]",
+		"OnTap":    "func",
+		"Pos":      "&{X:1 Y:2}",
+	}
+	if len(got) != len(want) {
+		t.Errorf("expected %d properties, got %v", len(want), got)
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("%s: expected %q, got %q", name, value, got[name])
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/skia"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// memoryHUDRefresh limits how often the HUD reads memory stats, since
+// runtime.ReadMemStats briefly stops the world.
+const memoryHUDRefresh = time.Second
+
+// GoMemStats is the subset of runtime.MemStats useful for spotting leaks.
+type GoMemStats struct {
+	HeapAlloc    uint64 `json:"heapAlloc"`
+	HeapInuse    uint64 `json:"heapInuse"`
+	HeapSys      uint64 `json:"heapSys"`
+	HeapObjects  uint64 `json:"heapObjects"`
+	StackInuse   uint64 `json:"stackInuse"`
+	Sys          uint64 `json:"sys"`
+	Mallocs      uint64 `json:"mallocs"`
+	Frees        uint64 `json:"frees"`
+	NumGC        uint32 `json:"numGC"`
+	PauseTotalNs uint64 `json:"pauseTotalNs"`
+	Goroutines   int    `json:"goroutines"`
+}
+
+// MemorySnapshot reports memory held by the app, including native memory
+// the Go heap does not show. ImageCacheBytes is the decoded pixel data held
+// by Image widgets, TextLayoutCacheBytes estimates the paragraphs kept by the
+// text layout cache, Handles counts live Skia objects, Leaked counts those
+// garbage collected without Destroy while native leak tracking is on, and
+// Layers counts the repaint boundaries holding a compositing layer.
+type MemorySnapshot struct {
+	Timestamp            int64             `json:"ts"`
+	ImageCacheBytes      int64             `json:"imageCacheBytes"`
+	TextLayoutCacheBytes int64             `json:"textLayoutCacheBytes"`
+	Handles              skia.HandleCounts `json:"handles"`
+	Leaked               skia.HandleCounts `json:"leaked"`
+	Layers               int               `json:"layers"`
+	Runtime              GoMemStats        `json:"runtime"`
+}
+
+// ReadMemorySnapshot returns the app's current memory usage. It is safe to
+// call from any goroutine.
+func ReadMemorySnapshot() MemorySnapshot {
+	frameLock.Lock()
+	layers := countLayers(app.rootRender)
+	frameLock.Unlock()
+	return newMemorySnapshot(layers)
+}
+
+func newMemorySnapshot(layers int) MemorySnapshot {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return MemorySnapshot{
+		Timestamp:            time.Now().UnixMilli(),
+		ImageCacheBytes:      widgets.ImageCacheBytes(),
+		TextLayoutCacheBytes: graphics.TextLayoutCacheBytes(),
+		Handles:              skia.LiveHandles(),
+		Leaked:               skia.LeakedHandles(),
+		Layers:               layers,
+		Runtime: GoMemStats{
+			HeapAlloc:    stats.HeapAlloc,
+			HeapInuse:    stats.HeapInuse,
+			HeapSys:      stats.HeapSys,
+			HeapObjects:  stats.HeapObjects,
+			StackInuse:   stats.StackInuse,
+			Sys:          stats.Sys,
+			Mallocs:      stats.Mallocs,
+			Frees:        stats.Frees,
+			NumGC:        stats.NumGC,
+			PauseTotalNs: stats.PauseTotalNs,
+			Goroutines:   runtime.NumGoroutine(),
+		},
+	}
+}
+
+// countLayers counts the render objects below root that hold a layer.
+func countLayers(root layout.RenderObject) int {
+	if root == nil {
+		return 0
+	}
+	count := 0
+	if holder, ok := root.(interface{ Layer() *graphics.Layer }); ok && holder.Layer() != nil {
+		count = 1
+	}
+	if cv, ok := root.(layout.ChildVisitor); ok {
+		cv.VisitChildren(func(child layout.RenderObject) {
+			count += countLayers(child)
+		})
+	}
+	return count
+}
+
+// memoryLabels formats a snapshot as HUD lines.
+func memoryLabels(s MemorySnapshot) []string {
+	h := s.Handles
+	return []string{
+		"Heap " + formatBytes(int64(s.Runtime.HeapInuse)) + " / " + formatBytes(int64(s.Runtime.Sys)),
+		"Images " + formatBytes(s.ImageCacheBytes),
+		"Layers " + strconv.Itoa(s.Layers),
+		"Surf " + strconv.FormatInt(h.Surfaces, 10) +
+			" Para " + strconv.FormatInt(h.Paragraphs, 10) +
+			" Path " + strconv.FormatInt(h.Paths, 10),
+	}
+}
+
+// formatBytes formats n with a binary unit, e.g. "1.5 MB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return strconv.FormatInt(n, 10) + " B"
+	}
+	value := float64(n)
+	suffixes := []string{"KB", "MB", "GB", "TB"}
+	i := -1
+	for value >= unit && i < len(suffixes)-1 {
+		value /= unit
+		i++
+	}
+	return strconv.FormatFloat(value, 'f', 1, 64) + " " + suffixes[i]
+}
@@ -0,0 +1,113 @@
+package engine
+
+import (
+	"encoding/json"
+	"image"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/skia"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+type memoryTestApp struct {
+	core.StatelessBase
+}
+
+func (memoryTestApp) Build(ctx core.BuildContext) core.Widget {
+	return widgets.Image{Source: image.NewNRGBA(image.Rect(0, 0, 8, 8))}
+}
+
+func TestMemory_EndpointReportsImagesAndLayers(t *testing.T) {
+	a := swapApp(t)
+	a.userApp = memoryTestApp{}
+	if _, err := a.StepFrame(testSize); err != nil {
+		t.Fatalf("StepFrame: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	handleMemory(rec, httptest.NewRequest(http.MethodGet, "/memory", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("expected JSON content type, got %q", ct)
+	}
+	var snapshot MemorySnapshot
+	if err := json.Unmarshal(rec.Body.Bytes(), &snapshot); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	// The image is its own repaint boundary, under the root's layer.
+	if snapshot.Layers < 2 {
+		t.Errorf("expected at least 2 layers, got %d", snapshot.Layers)
+	}
+	if snapshot.ImageCacheBytes < 8*8*4 {
+		t.Errorf("expected the 8x8 image in the cache, got %d bytes", snapshot.ImageCacheBytes)
+	}
+	if snapshot.Runtime.HeapAlloc == 0 || snapshot.Runtime.Goroutines == 0 {
+		t.Errorf("expected runtime stats, got %+v", snapshot.Runtime)
+	}
+}
+
+func TestMemory_EndpointRejectsPost(t *testing.T) {
+	rec := httptest.NewRecorder()
+	handleMemory(rec, httptest.NewRequest(http.MethodPost, "/memory", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405, got %d", rec.Code)
+	}
+}
+
+func TestMemory_HUDLabelsRefreshOnInterval(t *testing.T) {
+	a := swapApp(t)
+	if _, err := a.StepFrame(testSize); err != nil {
+		t.Fatalf("StepFrame: %v", err)
+	}
+
+	source := &diagnosticsDataSource{runner: a}
+	labels := source.MemoryLabels()
+	if len(labels) != 4 {
+		t.Fatalf("expected 4 memory lines, got %v", labels)
+	}
+	if again := source.MemoryLabels(); &again[0] != &labels[0] {
+		t.Error("expected labels to be reused within the refresh interval")
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1536, "1.5 KB"},
+		{5 << 20, "5.0 MB"},
+		{3 << 30, "3.0 GB"},
+	}
+	for _, tt := range tests {
+		if got := formatBytes(tt.n); got != tt.want {
+			t.Errorf("formatBytes(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestSetDiagnostics_TrackNativeLeaks(t *testing.T) {
+	swapApp(t)
+	t.Cleanup(func() { SetDiagnostics(nil) })
+
+	SetDiagnostics(&DiagnosticsConfig{TrackNativeLeaks: true})
+	if !skia.LeakTrackingEnabled() {
+		t.Error("expected native leak tracking on")
+	}
+	SetDiagnostics(&DiagnosticsConfig{ShowFPS: true})
+	if skia.LeakTrackingEnabled() {
+		t.Error("expected native leak tracking off when not requested")
+	}
+	SetDiagnostics(&DiagnosticsConfig{TrackNativeLeaks: true})
+	SetDiagnostics(nil)
+	if skia.LeakTrackingEnabled() {
+		t.Error("expected native leak tracking off after clearing diagnostics")
+	}
+}
@@ -1,5 +1,7 @@
 package engine
 
+import "time"
+
 // PointerPhase represents the phase of a pointer/touch event.
 type PointerPhase int
 
@@ -13,11 +15,15 @@ const (
 // PointerEvent represents a raw pointer/touch event from the native embedder.
 // This is a simplified event type with screen coordinates; the engine converts
 // it to gestures.PointerEvent for internal routing.
+//
+// Timestamp is when the embedder received the event and is used to measure
+// input latency. When zero, the time HandlePointerEvent is called is used.
 type PointerEvent struct {
 	PointerID int64
 	X         float64
 	Y         float64
 	Phase     PointerPhase
+	Timestamp time.Time
 }
 
 // HandlePointerEvent receives a pointer event from the native layer and
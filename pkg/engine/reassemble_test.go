@@ -0,0 +1,73 @@
+package engine
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+type reassembleCounter struct {
+	core.StatefulBase
+	inits, builds *int
+}
+
+func (w reassembleCounter) CreateState() core.State {
+	return &reassembleCounterState{}
+}
+
+type reassembleCounterState struct {
+	core.StateBase
+	taps int
+}
+
+func (s *reassembleCounterState) InitState() {
+	*s.Element().Widget().(reassembleCounter).inits++
+}
+
+func (s *reassembleCounterState) Build(ctx core.BuildContext) core.Widget {
+	*ctx.Widget().(reassembleCounter).builds++
+	return widgets.SizedBox{Width: 10, Height: 10}
+}
+
+func TestReassemble_KeepsState(t *testing.T) {
+	a := swapApp(t)
+	inits, builds := 0, 0
+	a.userApp = reassembleCounter{inits: &inits, builds: &builds}
+	if !runPipelineLocked() {
+		t.Fatal("expected the app to mount")
+	}
+	before := builds
+
+	for range 2 {
+		rec := httptest.NewRecorder()
+		handleReassemble(rec, httptest.NewRequest(http.MethodPost, "/reassemble", nil))
+		if rec.Code != http.StatusAccepted {
+			t.Fatalf("expected 202, got %d", rec.Code)
+		}
+	}
+	a.dispatchMu.Lock()
+	queued := len(a.dispatchQueue)
+	a.dispatchMu.Unlock()
+	if queued != 1 {
+		t.Errorf("expected repeated requests to be coalesced, got %d queued", queued)
+	}
+
+	runPipelineLocked()
+	if builds != before+1 {
+		t.Errorf("expected one more build, got %d (was %d)", builds, before)
+	}
+	if inits != 1 {
+		t.Errorf("expected the state to be kept, got %d InitState calls", inits)
+	}
+}
+
+func TestReassemble_MethodNotAllowed(t *testing.T) {
+	rec := httptest.NewRecorder()
+	handleReassemble(rec, httptest.NewRequest(http.MethodGet, "/reassemble", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405, got %d", rec.Code)
+	}
+}
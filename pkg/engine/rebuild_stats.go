@@ -0,0 +1,210 @@
+package engine
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+const (
+	defaultRebuildStatsWindow = 5 * time.Second
+	rebuildStatsBucket        = time.Second
+	rebuildHUDLimit           = 5
+	rebuildHUDRefresh         = 500 * time.Millisecond
+)
+
+// RebuildStat summarizes the builds of one widget type.
+type RebuildStat struct {
+	Type    string  `json:"type"`
+	Count   int     `json:"count"`
+	TotalMs float64 `json:"totalMs"`
+}
+
+type rebuildAccum struct {
+	count int
+	total time.Duration
+}
+
+type rebuildBucket struct {
+	start time.Time
+	stats map[reflect.Type]*rebuildAccum
+}
+
+// RebuildStatsBuffer aggregates widget build counts and build time by widget
+// type over a sliding window, in one-second buckets.
+type RebuildStatsBuffer struct {
+	mu      sync.Mutex
+	window  time.Duration
+	buckets []rebuildBucket
+}
+
+// NewRebuildStatsBuffer creates a buffer that keeps window of history.
+// Defaults to 5s if window is not positive.
+func NewRebuildStatsBuffer(window time.Duration) *RebuildStatsBuffer {
+	if window <= 0 {
+		window = defaultRebuildStatsWindow
+	}
+	count := int((window + rebuildStatsBucket - 1) / rebuildStatsBucket)
+	return &RebuildStatsBuffer{
+		window:  window,
+		buckets: make([]rebuildBucket, count+1),
+	}
+}
+
+// Window returns the history window.
+func (b *RebuildStatsBuffer) Window() time.Duration {
+	return b.window
+}
+
+// Record adds a build of widget that took duration. It matches
+// [core.BuildObserver] so it can be installed directly.
+func (b *RebuildStatsBuffer) Record(widget core.Widget, duration time.Duration) {
+	b.record(reflect.TypeOf(widget), duration, time.Now())
+}
+
+func (b *RebuildStatsBuffer) record(typ reflect.Type, duration time.Duration, now time.Time) {
+	start := now.Truncate(rebuildStatsBucket)
+	index := int(start.Unix() % int64(len(b.buckets)))
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	bucket := &b.buckets[index]
+	if !bucket.start.Equal(start) {
+		bucket.start = start
+		if bucket.stats == nil {
+			bucket.stats = make(map[reflect.Type]*rebuildAccum)
+		} else {
+			clear(bucket.stats)
+		}
+	}
+	accum := bucket.stats[typ]
+	if accum == nil {
+		accum = &rebuildAccum{}
+		bucket.stats[typ] = accum
+	}
+	accum.count++
+	accum.total += duration
+}
+
+// Top returns up to limit widget types with the most build time over the
+// last window (capped at the buffer's window), worst first. Ties are broken
+// by build count. A non-positive limit returns every type.
+func (b *RebuildStatsBuffer) Top(limit int, window time.Duration) []RebuildStat {
+	return b.top(limit, window, time.Now())
+}
+
+func (b *RebuildStatsBuffer) top(limit int, window time.Duration, now time.Time) []RebuildStat {
+	if window <= 0 || window > b.window {
+		window = b.window
+	}
+	cutoff := now.Add(-window).Truncate(rebuildStatsBucket)
+
+	merged := make(map[reflect.Type]rebuildAccum)
+	b.mu.Lock()
+	for _, bucket := range b.buckets {
+		if bucket.start.IsZero() || bucket.start.Before(cutoff) || bucket.start.After(now) {
+			continue
+		}
+		for typ, accum := range bucket.stats {
+			m := merged[typ]
+			m.count += accum.count
+			m.total += accum.total
+			merged[typ] = m
+		}
+	}
+	b.mu.Unlock()
+
+	stats := make([]RebuildStat, 0, len(merged))
+	totals := make(map[string]time.Duration, len(merged))
+	for typ, accum := range merged {
+		name := typ.String()
+		stats = append(stats, RebuildStat{
+			Type:    name,
+			Count:   accum.count,
+			TotalMs: durationToMillis(accum.total),
+		})
+		totals[name] = accum.total
+	}
+	slices.SortFunc(stats, func(a, b RebuildStat) int {
+		if c := cmp.Compare(totals[b.Type], totals[a.Type]); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Type, b.Type)
+	})
+	if limit > 0 && len(stats) > limit {
+		stats = stats[:limit]
+	}
+	return stats
+}
+
+// rebuildStatLabel formats a stat for the HUD, dropping the package name to
+// fit the panel.
+func rebuildStatLabel(stat RebuildStat) string {
+	name := stat.Type
+	if i := strings.LastIndexByte(name, '.'); i >= 0 && !strings.Contains(name[:i], "[") {
+		name = name[i+1:]
+	}
+	return name + " " + strconv.Itoa(stat.Count) + "x " + strconv.FormatFloat(stat.TotalMs, 'f', 1, 64) + "ms"
+}
+
+// ElementRebuildStat summarizes the rebuilds of one element, recorded while
+// core rebuild tracking is enabled.
+type ElementRebuildStat struct {
+	WidgetType string         `json:"widgetType"`
+	Key        any            `json:"key,omitempty"`
+	Depth      int            `json:"depth"`
+	Count      int            `json:"count"`
+	ByReason   map[string]int `json:"byReason"`
+	LastReason string         `json:"lastReason"`
+	LastCause  string         `json:"lastCause,omitempty"`
+}
+
+// TopElementRebuilds returns up to limit elements under root that rebuilt
+// at least once, most rebuilt first. Must be called on the UI thread or
+// with the frame lock held.
+func TopElementRebuilds(root core.Element, limit int) []ElementRebuildStat {
+	var out []ElementRebuildStat
+	var visit func(e core.Element)
+	visit = func(e core.Element) {
+		if info := core.RebuildInfoOf(e); info.Count > 0 {
+			stat := ElementRebuildStat{
+				Depth:      e.Depth(),
+				Count:      info.Count,
+				ByReason:   make(map[string]int, len(info.ByReason)),
+				LastReason: info.LastReason.String(),
+				LastCause:  info.LastCause,
+			}
+			if widget := e.Widget(); widget != nil {
+				stat.WidgetType = reflect.TypeOf(widget).String()
+				stat.Key = safeKey(widget.Key())
+			}
+			for reason, n := range info.ByReason {
+				stat.ByReason[reason.String()] = n
+			}
+			out = append(out, stat)
+		}
+		e.VisitChildren(func(child core.Element) bool {
+			visit(child)
+			return true
+		})
+	}
+	if root != nil {
+		visit(root)
+	}
+	slices.SortStableFunc(out, func(a, b ElementRebuildStat) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
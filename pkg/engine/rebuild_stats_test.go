@@ -0,0 +1,131 @@
+package engine
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+type rebuildTestA struct{}
+type rebuildTestB struct{}
+
+func TestRebuildStatsBuffer_TopOrdersByBuildTime(t *testing.T) {
+	buf := NewRebuildStatsBuffer(5 * time.Second)
+	now := time.Unix(1000, 0)
+	typeA := reflect.TypeOf(rebuildTestA{})
+	typeB := reflect.TypeOf(rebuildTestB{})
+
+	for range 10 {
+		buf.record(typeA, time.Millisecond, now)
+	}
+	buf.record(typeB, 20*time.Millisecond, now.Add(time.Second))
+
+	stats := buf.top(0, 0, now.Add(time.Second))
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 stats, got %d", len(stats))
+	}
+	if stats[0].Type != typeB.String() || stats[0].Count != 1 || stats[0].TotalMs != 20 {
+		t.Errorf("unexpected first stat %+v", stats[0])
+	}
+	if stats[1].Type != typeA.String() || stats[1].Count != 10 || stats[1].TotalMs != 10 {
+		t.Errorf("unexpected second stat %+v", stats[1])
+	}
+
+	if limited := buf.top(1, 0, now.Add(time.Second)); len(limited) != 1 {
+		t.Errorf("expected limit to cap results, got %d", len(limited))
+	}
+}
+
+func TestRebuildStatsBuffer_DropsBucketsOutsideWindow(t *testing.T) {
+	buf := NewRebuildStatsBuffer(3 * time.Second)
+	now := time.Unix(2000, 0)
+	typeA := reflect.TypeOf(rebuildTestA{})
+	typeB := reflect.TypeOf(rebuildTestB{})
+
+	buf.record(typeA, time.Millisecond, now)
+	buf.record(typeB, time.Millisecond, now.Add(5*time.Second))
+
+	stats := buf.top(0, 0, now.Add(5*time.Second))
+	if len(stats) != 1 || stats[0].Type != typeB.String() {
+		t.Fatalf("expected only the recent type, got %+v", stats)
+	}
+
+	// A narrower query window excludes older buckets still in the buffer.
+	buf.record(typeA, time.Millisecond, now.Add(3*time.Second))
+	stats = buf.top(0, time.Second, now.Add(5*time.Second))
+	if len(stats) != 1 || stats[0].Type != typeB.String() {
+		t.Errorf("expected window to exclude older builds, got %+v", stats)
+	}
+}
+
+func TestRebuildStatLabel_DropsPackage(t *testing.T) {
+	got := rebuildStatLabel(RebuildStat{Type: "widgets.Text", Count: 42, TotalMs: 3.14})
+	if got != "Text 42x 3.1ms" {
+		t.Errorf("unexpected label %q", got)
+	}
+}
+
+func TestHandleElementRebuilds_ListsAndResets(t *testing.T) {
+	a := swapApp(t)
+	prev := core.RebuildTrackingEnabled()
+	core.SetRebuildTracking(true)
+	t.Cleanup(func() { core.SetRebuildTracking(prev) })
+
+	inits, builds := 0, 0
+	a.userApp = reassembleCounter{inits: &inits, builds: &builds}
+	if !runPipelineLocked() {
+		t.Fatal("expected the app to mount")
+	}
+	handleReassemble(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/reassemble", nil))
+	runPipelineLocked()
+
+	rec := httptest.NewRecorder()
+	handleElementRebuilds(rec, httptest.NewRequest(http.MethodGet, "/rebuilds/elements", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	var resp struct {
+		Elements []ElementRebuildStat `json:"elements"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	var found *ElementRebuildStat
+	for i := range resp.Elements {
+		if resp.Elements[i].WidgetType == "engine.reassembleCounter" {
+			found = &resp.Elements[i]
+		}
+	}
+	if found == nil {
+		t.Fatalf("expected reassembleCounter in %+v", resp.Elements)
+	}
+	if found.Count != 1 || found.LastReason != "reassemble" || found.ByReason["reassemble"] != 1 {
+		t.Errorf("unexpected stat %+v", *found)
+	}
+
+	rec = httptest.NewRecorder()
+	handleElementRebuilds(rec, httptest.NewRequest(http.MethodPost, "/rebuilds/elements", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if stats := TopElementRebuilds(a.root, 0); len(stats) != 0 {
+		t.Errorf("expected reset to clear counts, got %+v", stats)
+	}
+}
+
+func TestHandleElementRebuilds_Disabled(t *testing.T) {
+	prev := core.RebuildTrackingEnabled()
+	core.SetRebuildTracking(false)
+	t.Cleanup(func() { core.SetRebuildTracking(prev) })
+
+	rec := httptest.NewRecorder()
+	handleElementRebuilds(rec, httptest.NewRequest(http.MethodGet, "/rebuilds/elements", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,152 @@
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"math"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifterrors "github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// ErrOffscreenUnsupported is returned by [RenderWidgetToImage] and
+// [RenderWidgetToPNG] on platforms built without Skia.
+var ErrOffscreenUnsupported = errors.New("engine: offscreen rendering requires Skia, which this platform is built without")
+
+// RenderWidgetToPNG renders widget into a PNG image of the given size in
+// pixels, using the same build, layout, and paint code as the app. It needs
+// no running app or window, so servers and command-line tools can produce
+// share cards, OpenGraph images, and email images from the widgets the app
+// already has:
+//
+//	png, err := engine.RenderWidgetToPNG(ShareCard{Post: post},
+//	    graphics.Size{Width: 1200, Height: 630}, nil)
+//
+// td is the theme the widget sees; nil means the default light Material
+// theme. Areas the widget doesn't paint are transparent.
+//
+// The widget gets a single frame, so content that arrives later, such as
+// images loaded over the network, must be passed in ready to draw. Calls are
+// serialized with the app's frames, so it must not be called from the UI
+// thread. It returns [ErrOffscreenUnsupported] on platforms without Skia.
+func RenderWidgetToPNG(widget core.Widget, size graphics.Size, td *theme.AppThemeData) ([]byte, error) {
+	img, err := RenderWidgetToImage(widget, size, td)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("engine: encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderWidgetToImage renders widget like [RenderWidgetToPNG] and returns
+// the pixels instead of encoding them.
+func RenderWidgetToImage(widget core.Widget, size graphics.Size, td *theme.AppThemeData) (*image.RGBA, error) {
+	width, height := int(math.Ceil(size.Width)), int(math.Ceil(size.Height))
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("engine: invalid render size %gx%g", size.Width, size.Height)
+	}
+
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	defer drifterrors.EnterUIScope()()
+
+	tree, err := mountOffscreen(widget, graphics.Size{Width: float64(width), Height: float64(height)}, td)
+	if tree != nil {
+		defer tree.unmount()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := tree.frame(); err != nil {
+		return nil, err
+	}
+	return rasterizeLayerTree(tree.rootRender, width, height)
+}
+
+// offscreenTree is a widget tree mounted apart from the app, for rendering
+// into images.
+type offscreenTree struct {
+	owner      *core.BuildOwner
+	root       core.Element
+	rootRender layout.RenderObject
+	size       graphics.Size
+	buildErr   *drifterrors.BoundaryError
+}
+
+// mountOffscreen mounts widget in a tree of its own, laid out at size. The
+// caller unmounts the returned tree, which is non-nil whenever mounting got
+// that far.
+func mountOffscreen(widget core.Widget, size graphics.Size, td *theme.AppThemeData) (tree *offscreenTree, err error) {
+	if td == nil {
+		td = theme.NewAppThemeData(theme.TargetPlatformMaterial, theme.BrightnessLight)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("engine: render widget: %v", r)
+		}
+	}()
+
+	// Catch build errors here rather than rendering an error widget into
+	// the image.
+	tree = &offscreenTree{owner: core.NewBuildOwner(), size: size}
+	tree.root = core.MountRoot(widgets.Root(widgets.DeviceScale{
+		Scale: 1,
+		Child: theme.AppTheme{
+			Data: td,
+			Child: widgets.ErrorBoundary{
+				Child: widget,
+				OnError: func(err *drifterrors.BoundaryError) {
+					if tree.buildErr == nil {
+						tree.buildErr = err
+					}
+				},
+			},
+		},
+	}), tree.owner)
+	if renderElement, ok := tree.root.(interface{ RenderObject() layout.RenderObject }); ok {
+		tree.rootRender = renderElement.RenderObject()
+	}
+	if tree.rootRender == nil {
+		return tree, errors.New("engine: render widget: no render tree")
+	}
+
+	pipeline := tree.owner.Pipeline()
+	pipeline.ScheduleLayout(tree.rootRender)
+	pipeline.SchedulePaint(tree.rootRender)
+	return tree, nil
+}
+
+// frame runs build, layout, and layer recording for whatever changed since
+// the last frame.
+func (t *offscreenTree) frame() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("engine: render widget: %v", r)
+		}
+	}()
+
+	t.owner.FlushBuild()
+	if t.buildErr != nil {
+		return fmt.Errorf("engine: render widget: %w", t.buildErr)
+	}
+	pipeline := t.owner.Pipeline()
+	pipeline.FlushLayoutForRoot(t.rootRender, layout.Tight(t.size))
+	recordDirtyLayers(pipeline.FlushPaint(), false, 1)
+	return nil
+}
+
+func (t *offscreenTree) unmount() {
+	if t.root != nil {
+		t.root.Unmount()
+	}
+}
@@ -0,0 +1,31 @@
+//go:build android || darwin || ios
+
+package engine
+
+import (
+	"image"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/skia"
+)
+
+// rasterizeLayerTree composites the recorded layer tree into a CPU surface
+// and reads back its pixels.
+func rasterizeLayerTree(root layout.RenderObject, width, height int) (*image.RGBA, error) {
+	surface, err := skia.MakeRasterSurface(width, height)
+	if err != nil {
+		return nil, err
+	}
+	defer surface.Destroy()
+
+	canvas := graphics.NewSkiaCanvas(surface.Canvas(), graphics.Size{Width: float64(width), Height: float64(height)})
+	canvas.Clear(graphics.ColorTransparent)
+	compositeLayerTree(canvas, root)
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	if err := surface.ReadPixels(img.Pix, width, height, img.Stride); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
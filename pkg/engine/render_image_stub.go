@@ -0,0 +1,13 @@
+//go:build !(android || darwin || ios)
+
+package engine
+
+import (
+	"image"
+
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+func rasterizeLayerTree(root layout.RenderObject, width, height int) (*image.RGBA, error) {
+	return nil, ErrOffscreenUnsupported
+}
@@ -0,0 +1,80 @@
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+type panickingWidget struct {
+	core.StatelessBase
+}
+
+func (panickingWidget) Build(ctx core.BuildContext) core.Widget {
+	panic("boom")
+}
+
+func renderOffscreen(widget core.Widget, size graphics.Size) (*offscreenTree, error) {
+	tree, err := mountOffscreen(widget, size, nil)
+	if err != nil {
+		return tree, err
+	}
+	return tree, tree.frame()
+}
+
+func TestOffscreenTree_RecordsRootLayer(t *testing.T) {
+	card := widgets.Container{Color: graphics.RGB(10, 20, 30)}
+	tree, err := renderOffscreen(card, graphics.Size{Width: 120, Height: 60})
+	if tree != nil {
+		defer tree.unmount()
+	}
+	if err != nil {
+		t.Fatalf("render offscreen: %v", err)
+	}
+	if got := tree.rootRender.Size(); got != (graphics.Size{Width: 120, Height: 60}) {
+		t.Errorf("expected root laid out at 120x60, got %v", got)
+	}
+	layer := tree.rootRender.(interface{ Layer() *graphics.Layer }).Layer()
+	if layer == nil || layer.Content == nil {
+		t.Fatal("expected the root layer to be recorded")
+	}
+}
+
+func TestOffscreenTree_RecoversBuildPanics(t *testing.T) {
+	tree, err := renderOffscreen(panickingWidget{}, graphics.Size{Width: 10, Height: 10})
+	if tree != nil {
+		tree.unmount()
+	}
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("expected the panic as an error, got %v", err)
+	}
+}
+
+func TestRenderWidgetToPNG(t *testing.T) {
+	data, err := RenderWidgetToPNG(widgets.Container{Color: graphics.RGB(10, 20, 30)}, graphics.Size{Width: 40, Height: 20}, nil)
+	if errors.Is(err, ErrOffscreenUnsupported) {
+		t.Skip("offscreen rendering needs Skia")
+	}
+	if err != nil {
+		t.Fatalf("RenderWidgetToPNG: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 40 || b.Dy() != 20 {
+		t.Errorf("expected 40x20 image, got %v", b)
+	}
+}
+
+func TestRenderWidgetToPNG_InvalidSize(t *testing.T) {
+	if _, err := RenderWidgetToPNG(widgets.Container{}, graphics.Size{}, nil); err == nil {
+		t.Error("expected an error for an empty size")
+	}
+}
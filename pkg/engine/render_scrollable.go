@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"math"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifterrors "github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// maxScrollableImageHeight bounds the images [RenderScrollableToImage]
+// produces, so an endless feed fails instead of exhausting memory.
+const maxScrollableImageHeight = 1 << 15
+
+// RenderScrollableToPNG renders the whole scroll extent of a vertically
+// scrolling widget into one tall PNG, for documentation screenshots and
+// "save conversation as image" features:
+//
+//	controller := &widgets.ScrollController{}
+//	png, err := engine.RenderScrollableToPNG(Conversation{
+//	    Messages:   messages,
+//	    Controller: controller,
+//	}, controller, graphics.Size{Width: 390, Height: 844}, nil)
+//
+// The widget is laid out at viewport, scrolled through its content one
+// viewport at a time using controller, which must be attached to its scroll
+// view, and the slices are stitched together. The image is viewport wide and
+// as tall as the viewport plus the scroll extent. Anything that doesn't
+// scroll with the content, such as a pinned header, appears in every slice,
+// so pass the scrollable itself rather than a whole screen.
+//
+// Use a controller of its own rather than one the app is showing. Otherwise
+// it behaves like [RenderWidgetToPNG], and returns [ErrOffscreenUnsupported]
+// on platforms without Skia.
+func RenderScrollableToPNG(widget core.Widget, controller *widgets.ScrollController, viewport graphics.Size, td *theme.AppThemeData) ([]byte, error) {
+	img, err := RenderScrollableToImage(widget, controller, viewport, td)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("engine: encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderScrollableToImage renders like [RenderScrollableToPNG] and returns
+// the pixels instead of encoding them.
+func RenderScrollableToImage(widget core.Widget, controller *widgets.ScrollController, viewport graphics.Size, td *theme.AppThemeData) (*image.RGBA, error) {
+	if controller == nil {
+		return nil, errors.New("engine: render scrollable: nil scroll controller")
+	}
+	width, height := int(math.Ceil(viewport.Width)), int(math.Ceil(viewport.Height))
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("engine: invalid render size %gx%g", viewport.Width, viewport.Height)
+	}
+
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	defer drifterrors.EnterUIScope()()
+
+	initialOffset := controller.InitialScrollOffset
+	defer func() { controller.InitialScrollOffset = initialOffset }()
+	controller.InitialScrollOffset = 0
+
+	tree, err := mountOffscreen(widget, graphics.Size{Width: float64(width), Height: float64(height)}, td)
+	if tree != nil {
+		defer tree.unmount()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := tree.frame(); err != nil {
+		return nil, err
+	}
+
+	extent := int(math.Round(controller.MaxScrollExtent()))
+	if height+extent > maxScrollableImageHeight {
+		return nil, fmt.Errorf("engine: render scrollable: content is %dpx tall, more than the %dpx limit", height+extent, maxScrollableImageHeight)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height+extent))
+	for _, slice := range scrollSlices(height, extent) {
+		controller.JumpTo(float64(slice.offset))
+		if err := tree.frame(); err != nil {
+			return nil, err
+		}
+		viewportImg, err := rasterizeLayerTree(tree.rootRender, width, height)
+		if err != nil {
+			return nil, err
+		}
+		dst := image.Rect(0, slice.dstY, width, slice.dstY+slice.rows)
+		draw.Draw(img, dst, viewportImg, image.Pt(0, slice.srcY), draw.Src)
+	}
+	return img, nil
+}
+
+// scrollSlice is one viewport capture in a long screenshot: rows starting
+// at srcY in the viewport scrolled to offset go to dstY in the image.
+type scrollSlice struct {
+	offset int
+	srcY   int
+	dstY   int
+	rows   int
+}
+
+// scrollSlices plans the captures that cover a viewport of the given height
+// scrolled through extent. Each slice starts where the last one ended; the
+// final one scrolls to the end and keeps only the rows not yet captured.
+func scrollSlices(height, extent int) []scrollSlice {
+	var slices []scrollSlice
+	for y := 0; y < height+extent; {
+		offset := min(y, extent)
+		srcY := y - offset
+		slices = append(slices, scrollSlice{offset: offset, srcY: srcY, dstY: y, rows: height - srcY})
+		y += height - srcY
+	}
+	return slices
+}
@@ -0,0 +1,90 @@
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"reflect"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestScrollSlices(t *testing.T) {
+	tests := []struct {
+		name           string
+		height, extent int
+		want           []scrollSlice
+	}{
+		{"fits", 100, 0, []scrollSlice{{0, 0, 0, 100}}},
+		{"exact pages", 100, 100, []scrollSlice{{0, 0, 0, 100}, {100, 0, 100, 100}}},
+		{"partial last page", 100, 130, []scrollSlice{
+			{0, 0, 0, 100},
+			{100, 0, 100, 100},
+			{130, 70, 200, 30},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scrollSlices(tt.height, tt.extent); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("scrollSlices(%d, %d) = %v, want %v", tt.height, tt.extent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOffscreenTree_ScrollsController(t *testing.T) {
+	controller := &widgets.ScrollController{}
+	feed := widgets.ScrollView{
+		Controller: controller,
+		Child:      widgets.SizedBox{Width: 50, Height: 250},
+	}
+	tree, err := renderOffscreen(feed, graphics.Size{Width: 50, Height: 100})
+	if tree == nil {
+		t.Fatalf("render offscreen: %v", err)
+	}
+	if err != nil {
+		tree.unmount()
+		t.Fatalf("render offscreen: %v", err)
+	}
+	if got := controller.MaxScrollExtent(); got != 150 {
+		t.Errorf("expected max scroll extent 150, got %v", got)
+	}
+
+	tree.unmount()
+	if got := controller.MaxScrollExtent(); got != 0 {
+		t.Errorf("expected controller to be released after unmount, got extent %v", got)
+	}
+}
+
+func TestRenderScrollableToPNG(t *testing.T) {
+	controller := &widgets.ScrollController{InitialScrollOffset: 40}
+	feed := widgets.ScrollView{
+		Controller: controller,
+		Child:      widgets.Container{Width: 50, Height: 250, Color: graphics.RGB(10, 20, 30)},
+	}
+	data, err := RenderScrollableToPNG(feed, controller, graphics.Size{Width: 50, Height: 100}, nil)
+	if controller.InitialScrollOffset != 40 {
+		t.Errorf("expected the controller's initial offset restored, got %v", controller.InitialScrollOffset)
+	}
+	if errors.Is(err, ErrOffscreenUnsupported) {
+		t.Skip("offscreen rendering needs Skia")
+	}
+	if err != nil {
+		t.Fatalf("RenderScrollableToPNG: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if b := img.Bounds(); b.Dx() != 50 || b.Dy() != 250 {
+		t.Errorf("expected 50x250 image, got %v", b)
+	}
+}
+
+func TestRenderScrollableToPNG_NilController(t *testing.T) {
+	if _, err := RenderScrollableToPNG(widgets.ScrollView{}, nil, graphics.Size{Width: 10, Height: 10}, nil); err == nil {
+		t.Error("expected an error without a controller")
+	}
+}
@@ -0,0 +1,91 @@
+package engine
+
+import (
+	stderrors "errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// The native splash screen stays up until the engine tells the embedder the
+// first frame is on screen, so a cold start never shows the blank surface
+// behind it. Apps can hold it longer with DeferFirstFrame.
+var (
+	splashChannel = platform.NewMethodChannel("drift/splash")
+
+	// firstFrameRendered is set once a frame with the root widget has been
+	// composited.
+	firstFrameRendered atomic.Bool
+	// firstFrameDeferrals counts DeferFirstFrame calls not yet allowed.
+	firstFrameDeferrals atomic.Int32
+	// splashHidden is set once the embedder has been told to hide the
+	// native splash.
+	splashHidden atomic.Bool
+
+	// hideNativeSplash tells the embedder to remove its splash screen.
+	// Embedders without one, such as the web, don't implement the channel.
+	// Replaced in tests.
+	hideNativeSplash = func() {
+		_, err := splashChannel.Invoke("hide", nil)
+		if err != nil && !stderrors.Is(err, platform.ErrMethodNotFound) &&
+			!stderrors.Is(err, platform.ErrNotConnected) && !stderrors.Is(err, platform.ErrPlatformUnavailable) {
+			errors.Report(&errors.DriftError{
+				Op:        "engine.HideSplash",
+				Kind:      errors.KindPlatform,
+				Err:       err,
+				Timestamp: time.Now(),
+			})
+		}
+	}
+)
+
+// DeferFirstFrame keeps the native splash screen visible after the first
+// frame renders, until a matching [AllowFirstFrame]. Use it when the first
+// screen would otherwise appear half loaded, such as before its hero image
+// has decoded. Frames still render underneath the splash.
+//
+// Call it before the first frame, typically from OnInit or the root
+// widget's InitState; once the splash is hidden it has no effect. Safe to
+// call from any goroutine.
+func DeferFirstFrame() {
+	firstFrameDeferrals.Add(1)
+}
+
+// AllowFirstFrame releases a [DeferFirstFrame]. When the last deferral is
+// released and a frame has rendered, the native splash is hidden.
+func AllowFirstFrame() {
+	if firstFrameDeferrals.Add(-1) < 0 {
+		firstFrameDeferrals.Store(0)
+	}
+	maybeHideSplash()
+}
+
+// FirstFrameRendered reports whether a frame showing the root widget has
+// been rendered.
+func FirstFrameRendered() bool {
+	return firstFrameRendered.Load()
+}
+
+// markFirstFrameRendered records that a frame with the root widget was
+// composited and hides the native splash unless it is deferred.
+func markFirstFrameRendered() {
+	if firstFrameRendered.Swap(true) {
+		return
+	}
+	maybeHideSplash()
+}
+
+// maybeHideSplash hides the native splash once, after the first frame and
+// with no deferrals outstanding. The embedder is told in the background so
+// the frame that triggered it is not delayed by the platform call.
+func maybeHideSplash() {
+	if !firstFrameRendered.Load() || firstFrameDeferrals.Load() > 0 {
+		return
+	}
+	if splashHidden.Swap(true) {
+		return
+	}
+	go hideNativeSplash()
+}
@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func resetSplashForTest(t *testing.T) chan struct{} {
+	t.Helper()
+	hidden := make(chan struct{}, 2)
+	prev := hideNativeSplash
+	hideNativeSplash = func() { hidden <- struct{}{} }
+	reset := func() {
+		firstFrameRendered.Store(false)
+		firstFrameDeferrals.Store(0)
+		splashHidden.Store(false)
+	}
+	reset()
+	t.Cleanup(func() {
+		hideNativeSplash = prev
+		reset()
+	})
+	return hidden
+}
+
+func expectHidden(t *testing.T, hidden chan struct{}, want bool) {
+	t.Helper()
+	select {
+	case <-hidden:
+		if !want {
+			t.Fatal("expected the splash to stay visible")
+		}
+	case <-time.After(50 * time.Millisecond):
+		if want {
+			t.Fatal("expected the splash to be hidden")
+		}
+	}
+}
+
+func TestSplash_HiddenAfterFirstFrame(t *testing.T) {
+	hidden := resetSplashForTest(t)
+
+	expectHidden(t, hidden, false)
+	markFirstFrameRendered()
+	if !FirstFrameRendered() {
+		t.Fatal("expected FirstFrameRendered after the first frame")
+	}
+	expectHidden(t, hidden, true)
+
+	markFirstFrameRendered()
+	AllowFirstFrame()
+	expectHidden(t, hidden, false)
+}
+
+func TestSplash_DeferFirstFrame(t *testing.T) {
+	hidden := resetSplashForTest(t)
+
+	DeferFirstFrame()
+	DeferFirstFrame()
+	markFirstFrameRendered()
+	expectHidden(t, hidden, false)
+
+	AllowFirstFrame()
+	expectHidden(t, hidden, false)
+	AllowFirstFrame()
+	expectHidden(t, hidden, true)
+}
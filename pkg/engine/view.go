@@ -0,0 +1,115 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// ViewID identifies a native window or display surface that hosts a widget
+// tree, such as a desktop window or the secondary screen of a foldable. It
+// is unrelated to the IDs of platform views embedded inside a tree.
+type ViewID int64
+
+// DefaultViewID is the view the embedder creates at startup. It is backed by
+// [DefaultHandle], so the package-level functions act on it.
+const DefaultViewID ViewID = 0
+
+// views maps embedder view IDs to their handles. Guarded by handlesMu.
+var views = map[ViewID]*EngineHandle{DefaultViewID: defaultHandle}
+
+// CreateView returns the handle for id, creating an engine instance for it
+// if the view is new. Each view has its own widget tree, device scale, frame
+// size, and pointer stream, and renders nothing until an app is set with
+// [SetViewApp] or [EngineHandle.SetApp].
+//
+// Embedders call it when a window or display is attached. Apps may call it
+// first to set up the tree a window will show before it opens.
+func CreateView(id ViewID) *EngineHandle {
+	handlesMu.Lock()
+	if h, ok := views[id]; ok {
+		handlesMu.Unlock()
+		return h
+	}
+	handlesMu.Unlock()
+
+	h := NewEngineHandle()
+	handlesMu.Lock()
+	defer handlesMu.Unlock()
+	if existing, ok := views[id]; ok {
+		// Another goroutine created the view first.
+		delete(handles, h.runner.buildOwner)
+		return existing
+	}
+	h.view = id
+	views[id] = h
+	return h
+}
+
+// View returns the handle for id, or nil if no such view exists.
+func View(id ViewID) *EngineHandle {
+	handlesMu.Lock()
+	defer handlesMu.Unlock()
+	return views[id]
+}
+
+// Views returns the IDs of all views in ascending order, starting with
+// [DefaultViewID].
+func Views() []ViewID {
+	handlesMu.Lock()
+	ids := make([]ViewID, 0, len(views))
+	for id := range views {
+		ids = append(ids, id)
+	}
+	handlesMu.Unlock()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// DestroyView unmounts the tree of view id and releases it. Embedders call
+// it when the window or display goes away. The default view cannot be
+// destroyed.
+func DestroyView(id ViewID) {
+	if h := View(id); h != nil {
+		h.Dispose()
+	}
+}
+
+// ViewID returns the embedder view this handle is attached to. Handles made
+// with [NewEngineHandle] rather than [CreateView] report [DefaultViewID].
+func (h *EngineHandle) ViewID() ViewID {
+	return h.view
+}
+
+// SetViewApp sets the root widget of view id, creating the view if needed.
+func SetViewApp(id ViewID, root core.Widget) {
+	CreateView(id).SetApp(root)
+}
+
+// The functions below are the embedder entry points for views other than
+// the default one. Calls for an unknown view are ignored, since input and
+// frame callbacks can race with the view being destroyed.
+
+// SetViewDeviceScale updates the device pixel scale of view id, as when its
+// window moves to a monitor with a different DPI.
+func SetViewDeviceScale(id ViewID, scale float64) {
+	if h := View(id); h != nil {
+		h.SetDeviceScale(scale)
+	}
+}
+
+// HandleViewPointerEvent routes a pointer event in device pixels to the tree
+// of view id. Pointer IDs only need to be unique within a view.
+func HandleViewPointerEvent(id ViewID, event PointerEvent) {
+	if h := View(id); h != nil {
+		h.HandlePointerEvent(event)
+	}
+}
+
+// NeedsViewFrame reports whether view id has work for a new frame.
+func NeedsViewFrame(id ViewID) bool {
+	if h := View(id); h != nil {
+		return h.NeedsFrame()
+	}
+	return false
+}
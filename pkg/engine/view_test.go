@@ -0,0 +1,91 @@
+package engine
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+type viewScaleProbe struct {
+	core.StatelessBase
+	scale *float64
+}
+
+func (p viewScaleProbe) Build(ctx core.BuildContext) core.Widget {
+	*p.scale = widgets.DeviceScaleOf(ctx)
+	return widgets.SizedBox{}
+}
+
+func TestViews_CreateAndDestroy(t *testing.T) {
+	if View(DefaultViewID) != DefaultHandle() {
+		t.Fatal("expected the default view to be the default handle")
+	}
+
+	h := CreateView(7)
+	if CreateView(7) != h {
+		t.Error("expected CreateView to return the existing view")
+	}
+	if View(7) != h || h.ViewID() != 7 {
+		t.Error("expected the view to be registered under its ID")
+	}
+	if !slices.Equal(Views(), []ViewID{DefaultViewID, 7}) {
+		t.Errorf("Views() = %v", Views())
+	}
+
+	var ctx core.BuildContext
+	SetViewApp(7, contextProbe{ctx: &ctx})
+	stepHandle(h)
+	if HandleOf(ctx) != h {
+		t.Error("expected the view's tree to belong to its handle")
+	}
+
+	DestroyView(7)
+	if View(7) != nil || HandleOf(ctx) != nil {
+		t.Error("expected DestroyView to release the view")
+	}
+	DestroyView(DefaultViewID)
+	if View(DefaultViewID) == nil {
+		t.Error("expected the default view to survive DestroyView")
+	}
+
+	// Input and frames for a destroyed view are ignored.
+	HandleViewPointerEvent(7, PointerEvent{Phase: PointerPhaseDown})
+	SetViewDeviceScale(7, 2)
+	if NeedsViewFrame(7) {
+		t.Error("expected no frame for a destroyed view")
+	}
+}
+
+func TestViews_IndependentScaleAndPointers(t *testing.T) {
+	first := CreateView(1)
+	second := CreateView(2)
+	defer DestroyView(1)
+	defer DestroyView(2)
+
+	var firstScale, secondScale float64
+	first.SetApp(viewScaleProbe{scale: &firstScale})
+	SetViewDeviceScale(1, 1)
+	SetViewApp(2, viewScaleProbe{scale: &secondScale})
+	SetViewDeviceScale(2, 3)
+	stepHandle(first)
+	stepHandle(second)
+
+	if firstScale != 1 || secondScale != 3 {
+		t.Fatalf("scales = %v, %v, want 1, 3", firstScale, secondScale)
+	}
+
+	// Same pointer ID in both views: each view tracks its own pointer.
+	HandleViewPointerEvent(2, PointerEvent{PointerID: 1, X: 0, Y: 0, Phase: PointerPhaseDown})
+	if _, ok := second.runner.pointerPositions[1]; !ok {
+		t.Error("expected the second view to track the pointer")
+	}
+	if _, ok := first.runner.pointerPositions[1]; ok {
+		t.Error("expected the first view not to see the second view's pointer")
+	}
+	HandleViewPointerEvent(2, PointerEvent{PointerID: 1, X: 0, Y: 0, Phase: PointerPhaseUp})
+	if len(second.runner.pointerPositions) != 0 {
+		t.Error("expected the pointer to be released")
+	}
+}
@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// ErrNoFrameRateOverride is returned by [StepSyntheticFrames] when no frame
+// rate override is set.
+var ErrNoFrameRateOverride = errors.New("engine: no frame rate override set")
+
+// syntheticEpoch is where synthetic time starts, so runs line up exactly.
+var syntheticEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// SyntheticVsync is a fixed-timestep frame clock. Its time only moves when
+// Tick is called, by exactly one frame interval, so animations advance the
+// same amount every frame no matter how long frames take to produce. It
+// implements [animation.Clock].
+type SyntheticVsync struct {
+	mu       sync.Mutex
+	interval time.Duration
+	frame    int64
+}
+
+// NewSyntheticVsync returns a clock that ticks fps times per second of
+// animation time. It panics if fps is not positive.
+func NewSyntheticVsync(fps float64) *SyntheticVsync {
+	if fps <= 0 {
+		panic("engine: synthetic vsync rate must be positive")
+	}
+	return &SyntheticVsync{interval: time.Duration(float64(time.Second) / fps)}
+}
+
+// Now returns the time of the current frame.
+func (v *SyntheticVsync) Now() time.Time {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return syntheticEpoch.Add(time.Duration(v.frame) * v.interval)
+}
+
+// Tick advances to the next frame and returns its time.
+func (v *SyntheticVsync) Tick() time.Time {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.frame++
+	return syntheticEpoch.Add(time.Duration(v.frame) * v.interval)
+}
+
+// Frame returns the number of ticks so far.
+func (v *SyntheticVsync) Frame() int64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.frame
+}
+
+// Interval returns the time between frames.
+func (v *SyntheticVsync) Interval() time.Duration {
+	return v.interval
+}
+
+// SetFrameRateOverride makes animations advance by exactly 1/fps seconds
+// per frame instead of following the wall clock. Frames still arrive when
+// the platform's vsync delivers them, but each one shows the same animation
+// progress on every run and every machine, which keeps screen recordings
+// and golden captures reproducible. Pass 0 to restore the real clock.
+//
+// Setting a rate starts synthetic time over, so call it before starting
+// the interaction being captured. This is safe to call from any goroutine.
+func SetFrameRateOverride(fps float64) {
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	app.setFrameRateOverride(fps)
+}
+
+// FrameRateOverride returns the rate set by [SetFrameRateOverride], or 0 if
+// frames follow the wall clock.
+func FrameRateOverride() float64 {
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	if app.vsync == nil {
+		return 0
+	}
+	return float64(time.Second) / float64(app.vsync.Interval())
+}
+
+func (a *appRunner) setFrameRateOverride(fps float64) {
+	if a.vsync != nil {
+		animation.SetClock(a.prevClock)
+		a.vsync = nil
+		a.prevClock = nil
+		animation.SetFrameInterval(time.Duration(vsyncInterval.Load()))
+	}
+	if fps > 0 {
+		a.vsync = NewSyntheticVsync(fps)
+		a.prevClock = animation.SetClock(a.vsync)
+		animation.SetFrameInterval(a.vsync.Interval())
+	}
+}
+
+// StepSyntheticFrames produces frames back to back without waiting for the
+// platform, for headless capture and integration drivers. It requires a
+// frame rate override, so each frame is one synthetic interval apart. After
+// each frame it calls after, if non-nil, with the frame number so the
+// caller can capture or render it; an error from after stops the run.
+func StepSyntheticFrames(size graphics.Size, frames int, after func(frame int64) error) error {
+	frameLock.Lock()
+	vsync := app.vsync
+	frameLock.Unlock()
+	if vsync == nil {
+		return ErrNoFrameRateOverride
+	}
+
+	for range frames {
+		if _, err := app.StepFrame(size); err != nil {
+			return err
+		}
+		if after != nil {
+			if err := after(vsync.Frame()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+)
+
+func TestSyntheticVsync_Tick(t *testing.T) {
+	v := NewSyntheticVsync(50)
+	start := v.Now()
+	if got := v.Tick().Sub(start); got != 20*time.Millisecond {
+		t.Errorf("expected a 20ms step, got %v", got)
+	}
+	v.Tick()
+	if v.Frame() != 2 || v.Now().Sub(start) != 40*time.Millisecond {
+		t.Errorf("expected frame 2 at 40ms, got frame %d at %v", v.Frame(), v.Now().Sub(start))
+	}
+}
+
+func TestFrameRateOverride_AdvancesAnimationClockPerFrame(t *testing.T) {
+	swapApp(t)
+	t.Cleanup(func() { SetFrameRateOverride(0) })
+
+	SetFrameRateOverride(25)
+	if got := FrameRateOverride(); got != 25 {
+		t.Errorf("expected override 25, got %v", got)
+	}
+
+	start := animation.Now()
+	for range 3 {
+		runPipelineLocked()
+	}
+	if got := animation.Now().Sub(start); got != 120*time.Millisecond {
+		t.Errorf("expected 3 frames to advance 120ms, got %v", got)
+	}
+
+	SetFrameRateOverride(0)
+	if FrameRateOverride() != 0 {
+		t.Error("expected the override to be cleared")
+	}
+	if d := time.Since(animation.Now()); d < -time.Second || d > time.Second {
+		t.Errorf("expected the real clock to be restored, off by %v", d)
+	}
+}
+
+func TestStepSyntheticFrames(t *testing.T) {
+	swapApp(t)
+	t.Cleanup(func() { SetFrameRateOverride(0) })
+
+	if err := StepSyntheticFrames(testSize, 1, nil); !errors.Is(err, ErrNoFrameRateOverride) {
+		t.Fatalf("expected ErrNoFrameRateOverride, got %v", err)
+	}
+
+	SetFrameRateOverride(60)
+	var frames []int64
+	err := StepSyntheticFrames(testSize, 3, func(frame int64) error {
+		frames = append(frames, frame)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StepSyntheticFrames: %v", err)
+	}
+	if len(frames) != 3 || frames[0] != 1 || frames[2] != 3 {
+		t.Errorf("expected frames 1 to 3, got %v", frames)
+	}
+	if app.root == nil {
+		t.Error("expected the app to be mounted")
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err = StepSyntheticFrames(testSize, 5, func(int64) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Errorf("expected the run to stop after the first error, got %v after %d calls", err, calls)
+	}
+}
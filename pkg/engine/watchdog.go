@@ -0,0 +1,256 @@
+package engine
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+// DefaultWatchdogBudget is the stall budget used when
+// [WatchdogConfig.Budget] is zero.
+const DefaultWatchdogBudget = 2 * time.Second
+
+// WatchdogConfig configures the UI thread watchdog. Platforms kill or flag
+// apps whose UI thread stops responding for a few seconds (Android reports
+// an ANR after five), so the watchdog reports work that blocks it for too
+// long, while it is still blocked.
+type WatchdogConfig struct {
+	// Budget is how long a frame (StepFrame or RenderFrame) may run before
+	// it is reported. Dispatch callbacks run inside StepFrame and count
+	// toward its budget. Zero uses DefaultWatchdogBudget.
+	Budget time.Duration
+	// AbortDispatch stops waiting for a Dispatch callback that is running
+	// when the budget runs out, so the frame can finish. Callbacks run on a
+	// helper goroutine while the UI thread waits for them; an abandoned
+	// callback keeps running in the background and may race with the UI
+	// thread. Use it to keep a debug build responsive while tracking down a
+	// blocking call, not as a fix.
+	AbortDispatch bool
+}
+
+// budget returns the configured budget, or the default if zero.
+func (c WatchdogConfig) budget() time.Duration {
+	if c.Budget > 0 {
+		return c.Budget
+	}
+	return DefaultWatchdogBudget
+}
+
+// StallError describes UI thread work that exceeded the watchdog budget. It
+// is reported as the Err of an [errors.DriftError] with Kind
+// [errors.KindStall], whose StackTrace holds the stacks of all goroutines
+// at the time the budget ran out.
+type StallError struct {
+	// Op is the frame that stalled: "StepFrame" or "RenderFrame".
+	Op string
+	// Phase is what the frame was doing when the budget ran out, such as
+	// "dispatch" while running a Dispatch callback. Empty for other work.
+	Phase string
+	// Budget is the budget that was exceeded.
+	Budget time.Duration
+	// Aborted reports whether the engine stopped waiting for the running
+	// Dispatch callback. See [WatchdogConfig.AbortDispatch].
+	Aborted bool
+}
+
+func (e *StallError) Error() string {
+	msg := fmt.Sprintf("%s blocked the UI thread for more than %v", e.Op, e.Budget)
+	if e.Phase != "" {
+		msg += " in " + e.Phase
+	}
+	if e.Aborted {
+		msg += "; abandoned the dispatch callback"
+	}
+	return msg
+}
+
+// SetWatchdog enables the UI thread watchdog with the given configuration,
+// or disables it if config is nil. config is copied.
+func SetWatchdog(config *WatchdogConfig) {
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	app.setWatchdog(config)
+}
+
+// setWatchdog replaces the watchdog. Caller must hold frameLock.
+func (a *appRunner) setWatchdog(config *WatchdogConfig) {
+	if a.watchdog != nil {
+		a.watchdog.stop()
+		a.watchdog = nil
+	}
+	if config != nil {
+		a.watchdog = &watchdog{config: *config}
+	}
+}
+
+// watchdog times the frame running on the UI thread and reports it once it
+// exceeds the budget. Frames don't nest, so it tracks one at a time.
+type watchdog struct {
+	config WatchdogConfig
+
+	mu       sync.Mutex
+	timer    *time.Timer
+	op       string
+	phase    string
+	start    time.Time
+	active   bool
+	reported bool
+	// abort is closed when the budget runs out during an abortable
+	// dispatch callback.
+	abort chan struct{}
+}
+
+// begin starts timing op. A nil watchdog does nothing.
+func (w *watchdog) begin(op string) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.op = op
+	w.phase = ""
+	w.start = time.Now()
+	w.active = true
+	w.reported = false
+	if w.timer == nil {
+		w.timer = time.AfterFunc(w.config.budget(), w.fire)
+	} else {
+		w.timer.Reset(w.config.budget())
+	}
+}
+
+// end stops timing the current frame.
+func (w *watchdog) end() {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.active = false
+	w.timer.Stop()
+}
+
+// stop releases the timer when the watchdog is replaced.
+func (w *watchdog) stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.active = false
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+}
+
+// setPhase labels what the current frame is doing, for the report.
+func (w *watchdog) setPhase(phase string) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	w.phase = phase
+	w.mu.Unlock()
+}
+
+// fire reports the current frame if it is still running past the budget.
+func (w *watchdog) fire() {
+	w.mu.Lock()
+	if !w.active || w.reported {
+		w.mu.Unlock()
+		return
+	}
+	budget := w.config.budget()
+	if remaining := budget - time.Since(w.start); remaining > 0 {
+		// The timer was armed for an earlier frame.
+		w.timer.Reset(remaining)
+		w.mu.Unlock()
+		return
+	}
+	w.reported = true
+	stall := &StallError{Op: w.op, Phase: w.phase, Budget: budget}
+	if w.abort != nil {
+		close(w.abort)
+		w.abort = nil
+		stall.Aborted = true
+	}
+	w.mu.Unlock()
+
+	errors.Report(&errors.DriftError{
+		Op:         "engine.Watchdog",
+		Kind:       errors.KindStall,
+		Err:        stall,
+		StackTrace: allGoroutineStacks(),
+		Timestamp:  time.Now(),
+	})
+}
+
+// runDispatchCallback runs a Dispatch callback on the UI thread, or, with
+// AbortDispatch, on a helper goroutine that the UI thread stops waiting for
+// once the watchdog fires. Panics in the helper are re-raised on the UI
+// thread so frame recovery still sees them.
+func (a *appRunner) runDispatchCallback(callback func()) {
+	w := a.watchdog
+	if w == nil || !w.config.AbortDispatch {
+		callback()
+		return
+	}
+	w.mu.Lock()
+	if w.reported {
+		// This frame already ran out of budget; don't wait on anything else.
+		w.mu.Unlock()
+		callback()
+		return
+	}
+	abort := make(chan struct{})
+	w.abort = abort
+	w.mu.Unlock()
+
+	done := make(chan any, 1)
+	go func() {
+		defer errors.EnterUIScope()()
+		panicked := true
+		defer func() {
+			if panicked {
+				done <- recover()
+			}
+		}()
+		callback()
+		panicked = false
+		done <- nil
+	}()
+
+	select {
+	case value := <-done:
+		w.mu.Lock()
+		w.abort = nil
+		w.mu.Unlock()
+		if value != nil {
+			panic(value)
+		}
+	case <-abort:
+		go func() {
+			if value := <-done; value != nil {
+				errors.ReportPanic(&errors.PanicError{
+					Op:        "engine.Dispatch",
+					Value:     value,
+					Timestamp: time.Now(),
+				})
+			}
+		}()
+	}
+}
+
+// allGoroutineStacks returns the stacks of all goroutines, growing the
+// buffer until they fit or reach 8 MiB.
+func allGoroutineStacks() string {
+	const maxSize = 8 << 20
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) || len(buf) >= maxSize {
+			return string(buf[:n])
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
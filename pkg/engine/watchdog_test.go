@@ -0,0 +1,129 @@
+package engine
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+type stallHandler struct {
+	errors.LogHandler
+	mu     sync.Mutex
+	stalls []*errors.DriftError
+}
+
+func (h *stallHandler) HandleError(err *errors.DriftError) {
+	if err.Kind != errors.KindStall {
+		return
+	}
+	h.mu.Lock()
+	h.stalls = append(h.stalls, err)
+	h.mu.Unlock()
+}
+
+func (h *stallHandler) reported() []*errors.DriftError {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*errors.DriftError(nil), h.stalls...)
+}
+
+func watchStalls(t *testing.T, config *WatchdogConfig) *stallHandler {
+	t.Helper()
+	a := swapApp(t)
+	a.setWatchdog(config)
+	t.Cleanup(func() { a.setWatchdog(nil) })
+	handler := &stallHandler{}
+	errors.SetHandler(handler)
+	t.Cleanup(func() { errors.SetHandler(nil) })
+	return handler
+}
+
+func TestWatchdog_ReportsStalledDispatch(t *testing.T) {
+	handler := watchStalls(t, &WatchdogConfig{Budget: 10 * time.Millisecond})
+	app.dispatch(func() { time.Sleep(50 * time.Millisecond) })
+	if _, err := app.StepFrame(testSize); err != nil {
+		t.Fatal(err)
+	}
+
+	stalls := handler.reported()
+	if len(stalls) != 1 {
+		t.Fatalf("expected one stall report, got %d", len(stalls))
+	}
+	stall, ok := stalls[0].Err.(*StallError)
+	if !ok {
+		t.Fatalf("expected a *StallError, got %T", stalls[0].Err)
+	}
+	if stall.Op != "StepFrame" || stall.Phase != "dispatch" || stall.Aborted {
+		t.Errorf("stall = %+v, want StepFrame in dispatch, not aborted", stall)
+	}
+	if !strings.Contains(stalls[0].StackTrace, "goroutine ") {
+		t.Error("expected the report to carry goroutine stacks")
+	}
+
+	// A fast frame is not reported.
+	if _, err := app.StepFrame(testSize); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if n := len(handler.reported()); n != 1 {
+		t.Errorf("expected no report for a fast frame, got %d reports", n)
+	}
+}
+
+func TestWatchdog_AbortDispatchUnblocksFrame(t *testing.T) {
+	handler := watchStalls(t, &WatchdogConfig{Budget: 10 * time.Millisecond, AbortDispatch: true})
+	release := make(chan struct{})
+	defer close(release)
+	ran := false
+	app.dispatch(func() { <-release })
+	app.dispatch(func() { ran = true })
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		app.StepFrame(testSize)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("frame still blocked by the stuck dispatch callback")
+	}
+
+	stalls := handler.reported()
+	if len(stalls) != 1 || !stalls[0].Err.(*StallError).Aborted {
+		t.Fatalf("expected one aborted stall report, got %v", stalls)
+	}
+	if !ran {
+		t.Error("expected the callbacks queued after the stuck one to run")
+	}
+}
+
+func TestWatchdog_AbortDispatchKeepsPanics(t *testing.T) {
+	watchStalls(t, &WatchdogConfig{AbortDispatch: true})
+	app.dispatch(func() { panic("boom") })
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("recovered %v, want the callback's panic", r)
+		}
+	}()
+	frameLock.Lock()
+	defer frameLock.Unlock()
+	app.runPipeline(testSize, nil)
+}
+
+func TestConfig_WatchdogRoundTrip(t *testing.T) {
+	original := CurrentConfig()
+	defer Configure(original)
+
+	Configure(Config{Watchdog: &WatchdogConfig{Budget: time.Second}})
+	cfg := CurrentConfig()
+	if cfg.Watchdog == nil || cfg.Watchdog.Budget != time.Second {
+		t.Fatalf("Watchdog = %+v, want a one second budget", cfg.Watchdog)
+	}
+	if err := (Config{Watchdog: &WatchdogConfig{Budget: -1}}).Validate(); err == nil {
+		t.Error("expected a negative budget to be rejected")
+	}
+}
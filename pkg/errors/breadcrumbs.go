@@ -0,0 +1,118 @@
+package errors
+
+import (
+	"maps"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// BreadcrumbCategory identifies what recorded a breadcrumb.
+type BreadcrumbCategory string
+
+const (
+	// BreadcrumbNavigation is recorded when a route is pushed, popped,
+	// removed, or replaced.
+	BreadcrumbNavigation BreadcrumbCategory = "navigation"
+	// BreadcrumbTap is recorded when a pointer goes down on the app.
+	BreadcrumbTap BreadcrumbCategory = "tap"
+	// BreadcrumbLifecycle is recorded when the app lifecycle state changes.
+	BreadcrumbLifecycle BreadcrumbCategory = "lifecycle"
+	// BreadcrumbApp is for breadcrumbs recorded by app code.
+	BreadcrumbApp BreadcrumbCategory = "app"
+)
+
+// Breadcrumb is a recent event attached to error reports, so a report shows
+// what the user did before the error.
+type Breadcrumb struct {
+	Timestamp time.Time          `json:"timestamp"`
+	Category  BreadcrumbCategory `json:"category"`
+	Message   string             `json:"message"`
+	Data      map[string]string  `json:"data,omitempty"`
+}
+
+// DefaultMaxBreadcrumbs is how many breadcrumbs are kept unless changed with
+// [SetMaxBreadcrumbs].
+const DefaultMaxBreadcrumbs = 100
+
+var (
+	breadcrumbMu   sync.Mutex
+	breadcrumbRing = make([]Breadcrumb, 0, DefaultMaxBreadcrumbs)
+	breadcrumbNext int // index of the oldest breadcrumb once the ring is full
+	breadcrumbMax  = DefaultMaxBreadcrumbs
+
+	deviceContextMu sync.RWMutex
+	deviceContext   = map[string]string{
+		"os":   runtime.GOOS,
+		"arch": runtime.GOARCH,
+	}
+)
+
+// AddBreadcrumb records a breadcrumb, dropping the oldest one when the limit
+// is reached. If b.Timestamp is zero, it is set to the current time. The
+// framework records navigation, tap, and lifecycle breadcrumbs itself; use
+// [BreadcrumbApp] for app events. Safe to call from any goroutine.
+func AddBreadcrumb(b Breadcrumb) {
+	if b.Timestamp.IsZero() {
+		b.Timestamp = time.Now()
+	}
+	breadcrumbMu.Lock()
+	defer breadcrumbMu.Unlock()
+	if breadcrumbMax <= 0 {
+		return
+	}
+	if len(breadcrumbRing) < breadcrumbMax {
+		breadcrumbRing = append(breadcrumbRing, b)
+		return
+	}
+	breadcrumbRing[breadcrumbNext] = b
+	breadcrumbNext = (breadcrumbNext + 1) % len(breadcrumbRing)
+}
+
+// Breadcrumbs returns the recorded breadcrumbs, oldest first.
+func Breadcrumbs() []Breadcrumb {
+	breadcrumbMu.Lock()
+	defer breadcrumbMu.Unlock()
+	out := make([]Breadcrumb, 0, len(breadcrumbRing))
+	out = append(out, breadcrumbRing[breadcrumbNext:]...)
+	return append(out, breadcrumbRing[:breadcrumbNext]...)
+}
+
+// ClearBreadcrumbs discards the recorded breadcrumbs.
+func ClearBreadcrumbs() {
+	breadcrumbMu.Lock()
+	defer breadcrumbMu.Unlock()
+	breadcrumbRing = breadcrumbRing[:0]
+	breadcrumbNext = 0
+}
+
+// SetMaxBreadcrumbs sets how many breadcrumbs are kept. Zero or less stops
+// recording. Changing the limit discards the recorded breadcrumbs.
+func SetMaxBreadcrumbs(n int) {
+	breadcrumbMu.Lock()
+	defer breadcrumbMu.Unlock()
+	breadcrumbMax = n
+	breadcrumbRing = make([]Breadcrumb, 0, max(n, 0))
+	breadcrumbNext = 0
+}
+
+// SetDeviceContext sets a key attached to every error report, such as the
+// app version or a user ID. The framework sets "os", "arch", and
+// "device_scale". An empty value removes the key. Safe to call from any
+// goroutine.
+func SetDeviceContext(key, value string) {
+	deviceContextMu.Lock()
+	defer deviceContextMu.Unlock()
+	if value == "" {
+		delete(deviceContext, key)
+		return
+	}
+	deviceContext[key] = value
+}
+
+// DeviceContext returns a copy of the keys set with [SetDeviceContext].
+func DeviceContext() map[string]string {
+	deviceContextMu.RLock()
+	defer deviceContextMu.RUnlock()
+	return maps.Clone(deviceContext)
+}
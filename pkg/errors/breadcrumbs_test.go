@@ -0,0 +1,70 @@
+package errors
+
+import (
+	"fmt"
+	"testing"
+)
+
+func resetBreadcrumbsForTest(t *testing.T, limit int) {
+	t.Helper()
+	SetMaxBreadcrumbs(limit)
+	t.Cleanup(func() { SetMaxBreadcrumbs(DefaultMaxBreadcrumbs) })
+}
+
+func TestBreadcrumbs_KeepsNewestOldestFirst(t *testing.T) {
+	resetBreadcrumbsForTest(t, 3)
+
+	for i := range 5 {
+		AddBreadcrumb(Breadcrumb{Category: BreadcrumbApp, Message: fmt.Sprint(i)})
+	}
+
+	got := Breadcrumbs()
+	if len(got) != 3 {
+		t.Fatalf("expected 3 breadcrumbs, got %d", len(got))
+	}
+	for i, want := range []string{"2", "3", "4"} {
+		if got[i].Message != want {
+			t.Errorf("breadcrumb %d: expected %q, got %q", i, want, got[i].Message)
+		}
+		if got[i].Timestamp.IsZero() {
+			t.Errorf("breadcrumb %d: expected a timestamp", i)
+		}
+	}
+
+	ClearBreadcrumbs()
+	if got := Breadcrumbs(); len(got) != 0 {
+		t.Errorf("expected no breadcrumbs after clear, got %v", got)
+	}
+}
+
+func TestBreadcrumbs_ZeroLimitDisablesRecording(t *testing.T) {
+	resetBreadcrumbsForTest(t, 0)
+
+	AddBreadcrumb(Breadcrumb{Category: BreadcrumbApp, Message: "ignored"})
+	if got := Breadcrumbs(); len(got) != 0 {
+		t.Errorf("expected no breadcrumbs, got %v", got)
+	}
+}
+
+func TestDeviceContext(t *testing.T) {
+	t.Cleanup(func() { SetDeviceContext("app_version", "") })
+
+	SetDeviceContext("app_version", "1.2.3")
+	ctx := DeviceContext()
+	if ctx["app_version"] != "1.2.3" {
+		t.Errorf("expected app_version 1.2.3, got %q", ctx["app_version"])
+	}
+	if ctx["os"] == "" {
+		t.Error("expected os to be set by default")
+	}
+
+	ctx["app_version"] = "mutated"
+	if DeviceContext()["app_version"] != "1.2.3" {
+		t.Error("expected DeviceContext to return a copy")
+	}
+
+	SetDeviceContext("app_version", "")
+	if _, ok := DeviceContext()["app_version"]; ok {
+		t.Error("expected an empty value to remove the key")
+	}
+}
@@ -0,0 +1,191 @@
+package errors
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// maxLeakReports bounds the leak history kept for [Leaks].
+const maxLeakReports = 100
+
+// LeakReport describes a disposable object, such as an animation controller
+// or ticker, that was still active after the State that created it was
+// disposed, or a native resource garbage collected without being destroyed.
+type LeakReport struct {
+	// Kind is the type of the leaked object (e.g., "*animation.AnimationController").
+	Kind string `json:"kind"`
+	// Owner is the type name of the State that created the object. It is
+	// empty for native resources found by the garbage collector.
+	Owner string `json:"owner,omitempty"`
+	// CreationStack is the call stack where the object was created.
+	CreationStack string `json:"creationStack"`
+	// Timestamp is when the owner was disposed.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func (l *LeakReport) Error() string {
+	if l.Owner == "" {
+		return fmt.Sprintf("%s was garbage collected without being destroyed", l.Kind)
+	}
+	return fmt.Sprintf("%s created by %s was not disposed", l.Kind, l.Owner)
+}
+
+type trackedDisposable struct {
+	kind   string
+	stack  string
+	active func() bool
+}
+
+type disposableOwner struct {
+	name      string
+	resources map[*trackedDisposable]struct{}
+}
+
+var (
+	disposalAuditing atomic.Bool
+
+	disposalMu sync.Mutex
+	// currentOwner is the owner whose lifecycle method is running. Owner
+	// scopes are entered on the UI thread only.
+	currentOwner *disposableOwner
+	owners       = map[any]*disposableOwner{}
+	leaks        []LeakReport
+)
+
+// SetDisposalAuditing enables or disables disposal auditing. While enabled,
+// disposable objects created during a State's lifecycle methods are
+// associated with that State, and any still active when the State is
+// disposed are reported through [Report] and recorded for [Leaks].
+// Disabling forgets tracked objects but keeps the leak history.
+func SetDisposalAuditing(enabled bool) {
+	disposalAuditing.Store(enabled)
+	if !enabled {
+		disposalMu.Lock()
+		currentOwner = nil
+		clear(owners)
+		disposalMu.Unlock()
+	}
+}
+
+// DisposalAuditingEnabled reports whether disposal auditing is enabled.
+func DisposalAuditingEnabled() bool {
+	return disposalAuditing.Load()
+}
+
+// EnterDisposableOwner makes owner the State that objects created until the
+// returned function is called belong to. name is the owner's type name.
+// The framework calls this around State lifecycle methods.
+func EnterDisposableOwner(owner any, name string) (exit func()) {
+	if !disposalAuditing.Load() {
+		return func() {}
+	}
+	disposalMu.Lock()
+	entry := owners[owner]
+	if entry == nil {
+		entry = &disposableOwner{name: name}
+		owners[owner] = entry
+	}
+	prev := currentOwner
+	currentOwner = entry
+	disposalMu.Unlock()
+	return func() {
+		disposalMu.Lock()
+		currentOwner = prev
+		disposalMu.Unlock()
+	}
+}
+
+// TrackDisposable associates a newly created object of type kind with the
+// current owner. active reports whether the object still holds resources;
+// it is checked when the owner is disposed. Call the returned function when
+// the object is disposed to stop tracking it. Objects created outside an
+// owner scope are not tracked.
+func TrackDisposable(kind string, active func() bool) (untrack func()) {
+	if !disposalAuditing.Load() {
+		return func() {}
+	}
+	disposalMu.Lock()
+	owner := currentOwner
+	if owner == nil {
+		disposalMu.Unlock()
+		return func() {}
+	}
+	resource := &trackedDisposable{kind: kind, stack: CaptureStack(), active: active}
+	if owner.resources == nil {
+		owner.resources = make(map[*trackedDisposable]struct{})
+	}
+	owner.resources[resource] = struct{}{}
+	disposalMu.Unlock()
+	return func() {
+		disposalMu.Lock()
+		delete(owner.resources, resource)
+		disposalMu.Unlock()
+	}
+}
+
+// ReleaseDisposableOwner reports every object created by owner that is
+// still active and stops tracking the owner. The framework calls this after
+// State.Dispose.
+func ReleaseDisposableOwner(owner any) {
+	if !disposalAuditing.Load() {
+		return
+	}
+	disposalMu.Lock()
+	entry := owners[owner]
+	delete(owners, owner)
+	disposalMu.Unlock()
+	if entry == nil {
+		return
+	}
+
+	now := time.Now()
+	for resource := range entry.resources {
+		if resource.active != nil && !resource.active() {
+			continue
+		}
+		RecordLeak(LeakReport{
+			Kind:          resource.kind,
+			Owner:         entry.name,
+			CreationStack: resource.stack,
+			Timestamp:     now,
+		})
+	}
+}
+
+// RecordLeak adds leak to the history returned by [Leaks] and reports it
+// through [Report]. If leak.Timestamp is zero, it is set to the current
+// time. It is safe to call from any goroutine, including finalizers.
+func RecordLeak(leak LeakReport) {
+	if leak.Timestamp.IsZero() {
+		leak.Timestamp = time.Now()
+	}
+	disposalMu.Lock()
+	if len(leaks) >= maxLeakReports {
+		leaks = append(leaks[:0], leaks[1:]...)
+	}
+	leaks = append(leaks, leak)
+	disposalMu.Unlock()
+	Report(&DriftError{
+		Op:         "disposal.leak",
+		Kind:       KindLeak,
+		Err:        &leak,
+		StackTrace: leak.CreationStack,
+		Timestamp:  leak.Timestamp,
+	})
+}
+
+// Leaks returns the most recent leak reports, oldest first.
+func Leaks() []LeakReport {
+	disposalMu.Lock()
+	defer disposalMu.Unlock()
+	return append([]LeakReport(nil), leaks...)
+}
+
+// ClearLeaks discards the leak history.
+func ClearLeaks() {
+	disposalMu.Lock()
+	leaks = nil
+	disposalMu.Unlock()
+}
@@ -0,0 +1,123 @@
+package errors
+
+import "testing"
+
+func enableDisposalAuditingForTest(t *testing.T) *[]*LeakReport {
+	t.Helper()
+	var reported []*LeakReport
+	oldHandler := DefaultHandler
+	SetHandler(&testHandler{
+		onError: func(err *DriftError) {
+			if leak, ok := err.Err.(*LeakReport); ok && err.Kind == KindLeak {
+				reported = append(reported, leak)
+			}
+		},
+	})
+	SetDisposalAuditing(true)
+	ClearLeaks()
+	t.Cleanup(func() {
+		SetDisposalAuditing(false)
+		ClearLeaks()
+		SetHandler(oldHandler)
+	})
+	return &reported
+}
+
+func TestDisposalAuditing_ReportsActiveResources(t *testing.T) {
+	reported := enableDisposalAuditingForTest(t)
+
+	owner := new(int)
+	exit := EnterDisposableOwner(owner, "*app.pageState")
+	TrackDisposable("*animation.AnimationController", nil)
+	untrack := TrackDisposable("*widgets.ScrollController", nil)
+	active := true
+	TrackDisposable("*animation.Ticker", func() bool { return active })
+	exit()
+
+	untrack()
+	active = false
+	ReleaseDisposableOwner(owner)
+
+	if len(*reported) != 1 {
+		t.Fatalf("expected 1 leak, got %d", len(*reported))
+	}
+	leak := (*reported)[0]
+	if leak.Kind != "*animation.AnimationController" || leak.Owner != "*app.pageState" {
+		t.Errorf("unexpected leak %+v", leak)
+	}
+	if leak.CreationStack == "" {
+		t.Error("expected creation stack")
+	}
+	if got := Leaks(); len(got) != 1 || got[0].Kind != leak.Kind {
+		t.Errorf("Leaks() = %+v", got)
+	}
+
+	// Releasing again reports nothing.
+	ReleaseDisposableOwner(owner)
+	if len(*reported) != 1 {
+		t.Errorf("expected owner to be forgotten, got %d leaks", len(*reported))
+	}
+}
+
+func TestDisposalAuditing_IgnoresResourcesWithoutOwner(t *testing.T) {
+	reported := enableDisposalAuditingForTest(t)
+
+	owner := new(int)
+	TrackDisposable("*animation.AnimationController", nil)
+	EnterDisposableOwner(owner, "*app.pageState")()
+	ReleaseDisposableOwner(owner)
+
+	if len(*reported) != 0 {
+		t.Errorf("expected no leaks, got %d", len(*reported))
+	}
+}
+
+func TestDisposalAuditing_Disabled(t *testing.T) {
+	reported := enableDisposalAuditingForTest(t)
+	SetDisposalAuditing(false)
+
+	owner := new(int)
+	exit := EnterDisposableOwner(owner, "*app.pageState")
+	TrackDisposable("*animation.AnimationController", nil)
+	exit()
+	ReleaseDisposableOwner(owner)
+
+	if len(*reported) != 0 {
+		t.Errorf("expected no leaks while disabled, got %d", len(*reported))
+	}
+}
+
+func TestLeaks_Bounded(t *testing.T) {
+	enableDisposalAuditingForTest(t)
+
+	for range maxLeakReports + 5 {
+		owner := new(int)
+		exit := EnterDisposableOwner(owner, "*app.pageState")
+		TrackDisposable("*animation.AnimationController", nil)
+		exit()
+		ReleaseDisposableOwner(owner)
+	}
+	if got := len(Leaks()); got != maxLeakReports {
+		t.Errorf("expected %d leaks kept, got %d", maxLeakReports, got)
+	}
+}
+
+func TestRecordLeak_WithoutOwner(t *testing.T) {
+	reported := enableDisposalAuditingForTest(t)
+
+	RecordLeak(LeakReport{Kind: "*skia.Path", CreationStack: "skia.NewPath"})
+
+	if len(*reported) != 1 {
+		t.Fatalf("expected 1 reported leak, got %d", len(*reported))
+	}
+	leak := (*reported)[0]
+	if leak.Timestamp.IsZero() {
+		t.Error("expected timestamp to be set")
+	}
+	if got, want := leak.Error(), "*skia.Path was garbage collected without being destroyed"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if got := Leaks(); len(got) != 1 || got[0].CreationStack != "skia.NewPath" {
+		t.Errorf("expected leak in history, got %+v", got)
+	}
+}
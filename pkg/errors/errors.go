@@ -24,6 +24,13 @@ const (
 	KindPanic
 	// KindBuild indicates a build-time widget error.
 	KindBuild
+	// KindStrictMode indicates framework misuse detected by strict mode.
+	KindStrictMode
+	// KindLeak indicates an object that was not disposed with its owner.
+	KindLeak
+	// KindStall indicates work that blocked the UI thread past the
+	// engine watchdog's budget.
+	KindStall
 )
 
 func (k ErrorKind) String() string {
@@ -40,6 +47,12 @@ func (k ErrorKind) String() string {
 		return "panic"
 	case KindBuild:
 		return "build"
+	case KindStrictMode:
+		return "strict_mode"
+	case KindLeak:
+		return "leak"
+	case KindStall:
+		return "stall"
 	default:
 		return "unknown"
 	}
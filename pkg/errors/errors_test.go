@@ -47,6 +47,9 @@ func TestErrorKindString(t *testing.T) {
 		{KindRender, "render"},
 		{KindPanic, "panic"},
 		{KindBuild, "build"},
+		{KindStrictMode, "strict_mode"},
+		{KindLeak, "leak"},
+		{KindStall, "stall"},
 	}
 	for _, tt := range tests {
 		if got := tt.kind.String(); got != tt.want {
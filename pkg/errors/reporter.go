@@ -0,0 +1,322 @@
+package errors
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Event is an error packaged for a crash reporting service, together with
+// the breadcrumbs and device context at the time it was reported.
+type Event struct {
+	// Kind categorizes the error. Panics are KindPanic, and boundary
+	// errors are KindBuild or KindRender depending on their phase.
+	Kind ErrorKind
+	// Message is the error text.
+	Message string
+	// Err is the reported *DriftError, *PanicError, or *BoundaryError.
+	Err error
+	// StackTrace contains the call stack at the time of the error.
+	StackTrace string
+	// Timestamp is when the error occurred.
+	Timestamp time.Time
+	// Breadcrumbs are the events leading up to the error, oldest first.
+	Breadcrumbs []Breadcrumb
+	// Context holds the keys set with SetDeviceContext.
+	Context map[string]string
+}
+
+// Reporter sends events to a crash reporting service such as Sentry or
+// Crashlytics. Send receives events in batches, one batch at a time, from a
+// background goroutine. Returning an error makes the batch be retried.
+type Reporter interface {
+	Send(ctx context.Context, events []Event) error
+}
+
+// ReporterFunc adapts a function to the [Reporter] interface.
+type ReporterFunc func(ctx context.Context, events []Event) error
+
+// Send calls f(ctx, events).
+func (f ReporterFunc) Send(ctx context.Context, events []Event) error {
+	return f(ctx, events)
+}
+
+// ReportingConfig configures a [ReportingHandler]. Zero fields use the
+// defaults noted on each.
+type ReportingConfig struct {
+	// BatchSize is the most events sent in one call to Send. Defaults to 10.
+	BatchSize int
+	// FlushInterval is how long events may wait for a batch to fill.
+	// Defaults to 5 seconds. Panics and boundary errors are sent right away.
+	FlushInterval time.Duration
+	// MaxRetries is how many times a failed batch is retried before it is
+	// dropped. Defaults to 3; negative means never retry.
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry, doubled for each
+	// retry after it. Defaults to 1 second.
+	RetryBackoff time.Duration
+	// QueueSize is the most events waiting to be sent. When it is full,
+	// the oldest event is dropped. Defaults to 100.
+	QueueSize int
+	// Next also receives every error, so reporting doesn't replace local
+	// logging. Defaults to a LogHandler.
+	Next ErrorHandler
+}
+
+// ReportingHandler is an [ErrorHandler] that packages errors as [Event]s and
+// delivers them through a [Reporter] in the background, batching events and
+// retrying failed batches. Install it with SetHandler:
+//
+//	reporting := errors.NewReportingHandler(sentryReporter, errors.ReportingConfig{})
+//	errors.SetHandler(reporting)
+//	defer reporting.Close(context.Background())
+//
+// Panics that crash the app are never reported, so wrap the app in an
+// ErrorBoundary to report them instead.
+type ReportingHandler struct {
+	reporter Reporter
+	config   ReportingConfig
+
+	mu      sync.Mutex
+	queue   []Event
+	sending sync.Mutex // serializes calls to Send
+
+	wake    chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+	closed  atomic.Bool
+	dropped atomic.Int64
+}
+
+// NewReportingHandler returns a handler that sends events through reporter
+// and starts its background delivery. Call Close to stop it.
+func NewReportingHandler(reporter Reporter, config ReportingConfig) *ReportingHandler {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 10
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = 5 * time.Second
+	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = 3
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = time.Second
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = 100
+	}
+	if config.Next == nil {
+		config.Next = &LogHandler{}
+	}
+	h := &ReportingHandler{
+		reporter: reporter,
+		config:   config,
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go h.run()
+	return h
+}
+
+// HandleError queues a DriftError and passes it to Next.
+func (h *ReportingHandler) HandleError(err *DriftError) {
+	if err == nil {
+		return
+	}
+	h.config.Next.HandleError(err)
+	h.enqueue(Event{
+		Kind:       err.Kind,
+		Message:    err.Error(),
+		Err:        err,
+		StackTrace: err.StackTrace,
+		Timestamp:  err.Timestamp,
+	}, false)
+}
+
+// HandlePanic queues a PanicError, passes it to Next, and starts sending.
+func (h *ReportingHandler) HandlePanic(err *PanicError) {
+	if err == nil {
+		return
+	}
+	h.config.Next.HandlePanic(err)
+	h.enqueue(Event{
+		Kind:       KindPanic,
+		Message:    err.Error(),
+		Err:        err,
+		StackTrace: err.StackTrace,
+		Timestamp:  err.Timestamp,
+	}, true)
+}
+
+// HandleBoundaryError queues a BoundaryError, passes it to Next, and
+// starts sending.
+func (h *ReportingHandler) HandleBoundaryError(err *BoundaryError) {
+	if err == nil {
+		return
+	}
+	h.config.Next.HandleBoundaryError(err)
+	kind := KindRender
+	if err.Phase == "build" {
+		kind = KindBuild
+	}
+	h.enqueue(Event{
+		Kind:       kind,
+		Message:    err.Error(),
+		Err:        err,
+		StackTrace: err.StackTrace,
+		Timestamp:  err.Timestamp,
+	}, true)
+}
+
+// Dropped returns how many events were discarded because the queue was full
+// or their batch ran out of retries.
+func (h *ReportingHandler) Dropped() int64 {
+	return h.dropped.Load()
+}
+
+// Flush sends every queued event, retrying failed batches, and returns the
+// last error if any batch was dropped. ctx bounds the whole flush; events
+// not sent when it ends stay queued.
+func (h *ReportingHandler) Flush(ctx context.Context) error {
+	var lastErr error
+	for {
+		batch := h.takeBatch()
+		if len(batch) == 0 {
+			return lastErr
+		}
+		if err := h.send(ctx, batch); err != nil {
+			lastErr = err
+			if ctx.Err() != nil {
+				return lastErr
+			}
+		}
+	}
+}
+
+// Close stops background delivery and flushes the queue. Errors reported
+// after Close are passed to Next but not sent.
+func (h *ReportingHandler) Close(ctx context.Context) error {
+	if h.closed.Swap(true) {
+		return nil
+	}
+	close(h.stop)
+	<-h.done
+	return h.Flush(ctx)
+}
+
+func (h *ReportingHandler) enqueue(event Event, urgent bool) {
+	if h.closed.Load() {
+		return
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	event.Breadcrumbs = Breadcrumbs()
+	event.Context = DeviceContext()
+
+	h.mu.Lock()
+	if len(h.queue) >= h.config.QueueSize {
+		h.queue = h.queue[1:]
+		h.dropped.Add(1)
+	}
+	h.queue = append(h.queue, event)
+	full := len(h.queue) >= h.config.BatchSize
+	h.mu.Unlock()
+
+	if urgent || full {
+		select {
+		case h.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (h *ReportingHandler) takeBatch() []Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	n := min(len(h.queue), h.config.BatchSize)
+	if n == 0 {
+		return nil
+	}
+	batch := append([]Event(nil), h.queue[:n]...)
+	h.queue = h.queue[n:]
+	return batch
+}
+
+// send delivers one batch, retrying with backoff, and drops it if every
+// attempt fails. If ctx ends first, the batch goes back on the queue.
+func (h *ReportingHandler) send(ctx context.Context, batch []Event) error {
+	h.sending.Lock()
+	defer h.sending.Unlock()
+
+	backoff := h.config.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := h.reporter.Send(ctx, batch)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			h.requeue(batch)
+			return err
+		}
+		if attempt >= h.config.MaxRetries {
+			h.dropped.Add(int64(len(batch)))
+			return err
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			h.requeue(batch)
+			return err
+		}
+		backoff *= 2
+	}
+}
+
+// requeue puts an unsent batch back at the front of the queue.
+func (h *ReportingHandler) requeue(batch []Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.queue = append(batch, h.queue...)
+	if excess := len(h.queue) - h.config.QueueSize; excess > 0 {
+		h.queue = h.queue[excess:]
+		h.dropped.Add(int64(excess))
+	}
+}
+
+// run delivers batches until Close.
+func (h *ReportingHandler) run() {
+	defer close(h.done)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-h.stop
+		cancel()
+	}()
+
+	ticker := time.NewTicker(h.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.stop:
+			return
+		case <-h.wake:
+		case <-ticker.C:
+		}
+		for {
+			batch := h.takeBatch()
+			if len(batch) == 0 {
+				break
+			}
+			h.send(ctx, batch)
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}
+}
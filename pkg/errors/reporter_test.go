@@ -0,0 +1,163 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingReporter records batches, failing the first failures sends.
+type recordingReporter struct {
+	mu       sync.Mutex
+	batches  [][]Event
+	failures int
+	sent     chan struct{}
+}
+
+func newRecordingReporter(failures int) *recordingReporter {
+	return &recordingReporter{failures: failures, sent: make(chan struct{}, 16)}
+}
+
+func (r *recordingReporter) Send(ctx context.Context, events []Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures > 0 {
+		r.failures--
+		return stderrors.New("service unavailable")
+	}
+	r.batches = append(r.batches, events)
+	r.sent <- struct{}{}
+	return nil
+}
+
+func (r *recordingReporter) events() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var all []Event
+	for _, batch := range r.batches {
+		all = append(all, batch...)
+	}
+	return all
+}
+
+func quietConfig(config ReportingConfig) ReportingConfig {
+	config.Next = &testHandler{}
+	if config.FlushInterval == 0 {
+		config.FlushInterval = time.Hour
+	}
+	return config
+}
+
+func TestReportingHandler_BatchesErrors(t *testing.T) {
+	reporter := newRecordingReporter(0)
+	h := NewReportingHandler(reporter, quietConfig(ReportingConfig{BatchSize: 2}))
+	defer h.Close(context.Background())
+
+	h.HandleError(&DriftError{Op: "a", Kind: KindPlatform, Err: stderrors.New("one")})
+	select {
+	case <-reporter.sent:
+		t.Fatal("expected the first error to wait for a full batch")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	h.HandleError(&DriftError{Op: "b", Kind: KindPlatform, Err: stderrors.New("two")})
+	select {
+	case <-reporter.sent:
+	case <-time.After(time.Second):
+		t.Fatal("expected a full batch to be sent")
+	}
+	if got := reporter.events(); len(got) != 2 || got[0].Kind != KindPlatform {
+		t.Errorf("expected 2 platform events, got %v", got)
+	}
+}
+
+func TestReportingHandler_SendsPanicsImmediately(t *testing.T) {
+	resetBreadcrumbsForTest(t, 10)
+	AddBreadcrumb(Breadcrumb{Category: BreadcrumbNavigation, Message: "push /checkout"})
+
+	reporter := newRecordingReporter(0)
+	var logged int
+	config := quietConfig(ReportingConfig{})
+	config.Next = &testHandler{onBoundaryError: func(*BoundaryError) { logged++ }}
+	h := NewReportingHandler(reporter, config)
+	defer h.Close(context.Background())
+
+	h.HandleBoundaryError(&BoundaryError{Phase: "build", Widget: "Checkout", Recovered: "nil map"})
+	select {
+	case <-reporter.sent:
+	case <-time.After(time.Second):
+		t.Fatal("expected the boundary error to be sent right away")
+	}
+
+	if logged != 1 {
+		t.Errorf("expected Next to receive the error, got %d calls", logged)
+	}
+	event := reporter.events()[0]
+	if event.Kind != KindBuild {
+		t.Errorf("expected build kind, got %v", event.Kind)
+	}
+	if len(event.Breadcrumbs) != 1 || event.Breadcrumbs[0].Message != "push /checkout" {
+		t.Errorf("expected the navigation breadcrumb, got %v", event.Breadcrumbs)
+	}
+	if event.Context["os"] == "" {
+		t.Error("expected device context")
+	}
+	if event.Timestamp.IsZero() {
+		t.Error("expected a timestamp")
+	}
+}
+
+func TestReportingHandler_RetriesFailedBatches(t *testing.T) {
+	reporter := newRecordingReporter(2)
+	h := NewReportingHandler(reporter, quietConfig(ReportingConfig{RetryBackoff: time.Millisecond}))
+	defer h.Close(context.Background())
+
+	h.HandlePanic(&PanicError{Op: "engine.HandlePointer", Value: "boom"})
+	select {
+	case <-reporter.sent:
+	case <-time.After(time.Second):
+		t.Fatal("expected the panic to be sent after retries")
+	}
+	if h.Dropped() != 0 {
+		t.Errorf("expected nothing dropped, got %d", h.Dropped())
+	}
+}
+
+func TestReportingHandler_DropsAfterMaxRetries(t *testing.T) {
+	reporter := newRecordingReporter(10)
+	h := NewReportingHandler(reporter, quietConfig(ReportingConfig{MaxRetries: -1}))
+	h.HandleError(&DriftError{Op: "a", Err: stderrors.New("one")})
+
+	if err := h.Close(context.Background()); err == nil {
+		t.Error("expected Close to report the failed flush")
+	}
+	if h.Dropped() != 1 {
+		t.Errorf("expected 1 dropped event, got %d", h.Dropped())
+	}
+}
+
+func TestReportingHandler_QueueDropsOldest(t *testing.T) {
+	reporter := newRecordingReporter(0)
+	h := NewReportingHandler(reporter, quietConfig(ReportingConfig{QueueSize: 2, BatchSize: 10}))
+	for _, op := range []string{"a", "b", "c"} {
+		h.HandleError(&DriftError{Op: op, Err: stderrors.New(op)})
+	}
+
+	if err := h.Close(context.Background()); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	events := reporter.events()
+	if len(events) != 2 || events[0].Err.(*DriftError).Op != "b" {
+		t.Errorf("expected the two newest events, got %v", events)
+	}
+	if h.Dropped() != 1 {
+		t.Errorf("expected 1 dropped event, got %d", h.Dropped())
+	}
+
+	h.HandleError(&DriftError{Op: "late", Err: stderrors.New("late")})
+	if err := h.Flush(context.Background()); err != nil || len(reporter.events()) != 2 {
+		t.Error("expected errors after Close not to be sent")
+	}
+}
@@ -0,0 +1,157 @@
+package errors
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// StrictModeRule identifies the kind of misuse a strict mode check detected.
+type StrictModeRule string
+
+// Strict mode rules.
+const (
+	// RuleSetStateDuringBuild fires when SetState (or anything else that marks
+	// an element dirty) runs synchronously inside a widget's Build.
+	RuleSetStateDuringBuild StrictModeRule = "set_state_during_build"
+	// RuleSizeBeforeLayout fires when a render box's Size is read while it
+	// still needs layout, for example from Paint or from a parent that has
+	// not laid the child out yet.
+	RuleSizeBeforeLayout StrictModeRule = "size_before_layout"
+	// RuleOffUIThread fires when a controller is mutated from a goroutine
+	// other than the UI thread. Use drift.Dispatch from background work.
+	RuleOffUIThread StrictModeRule = "off_ui_thread"
+	// RuleDependOutsideBuild fires when DependOnInherited is called outside
+	// Build and DidChangeDependencies, such as from InitState or an event
+	// handler. The dependency would not trigger rebuilds.
+	RuleDependOutsideBuild StrictModeRule = "depend_outside_build"
+)
+
+// StrictModeViolation describes misuse detected by strict mode. It is
+// reported as the Err of a [DriftError] with Kind [KindStrictMode].
+type StrictModeViolation struct {
+	// Rule is the check that fired.
+	Rule StrictModeRule
+	// Widget is the type name of the offending widget, state, render
+	// object, or controller.
+	Widget string
+	// Message describes the misuse.
+	Message string
+	// StackTrace contains the call stack at the time of the violation.
+	StackTrace string
+	// Timestamp is when the violation occurred.
+	Timestamp time.Time
+}
+
+func (v *StrictModeViolation) Error() string {
+	if v.Widget != "" {
+		return fmt.Sprintf("strict mode %s in %s: %s", v.Rule, v.Widget, v.Message)
+	}
+	return fmt.Sprintf("strict mode %s: %s", v.Rule, v.Message)
+}
+
+var (
+	strictMode atomic.Bool
+
+	strictSeenMu sync.Mutex
+	strictSeen   = map[string]struct{}{}
+
+	// uiGoroutine is the goroutine running the current UI scope, 0 if none.
+	uiGoroutine atomic.Int64
+	// uiScopeSeen is set once the engine has entered a UI scope, so that
+	// mutations during app setup and in tests are not flagged.
+	uiScopeSeen atomic.Bool
+)
+
+// SetStrictMode enables or disables strict mode. Strict mode adds checks for
+// common framework misuse and reports each distinct violation once through
+// [Report], with the offending type and a stack trace. The checks cost a
+// little on hot paths, so strict mode is meant for debug builds.
+func SetStrictMode(enabled bool) {
+	strictMode.Store(enabled)
+	strictSeenMu.Lock()
+	clear(strictSeen)
+	strictSeenMu.Unlock()
+}
+
+// StrictModeEnabled reports whether strict mode is enabled.
+func StrictModeEnabled() bool {
+	return strictMode.Load()
+}
+
+// ReportStrictModeViolation reports a violation of rule by the type named
+// widget. Repeats of the same violation are dropped until strict mode is
+// toggled. Does nothing when strict mode is disabled.
+func ReportStrictModeViolation(rule StrictModeRule, widget, message string) {
+	if !strictMode.Load() {
+		return
+	}
+	key := string(rule) + "\x00" + widget + "\x00" + message
+	strictSeenMu.Lock()
+	if _, seen := strictSeen[key]; seen {
+		strictSeenMu.Unlock()
+		return
+	}
+	strictSeen[key] = struct{}{}
+	strictSeenMu.Unlock()
+
+	violation := &StrictModeViolation{
+		Rule:       rule,
+		Widget:     widget,
+		Message:    message,
+		StackTrace: CaptureStack(),
+		Timestamp:  time.Now(),
+	}
+	Report(&DriftError{
+		Op:         "strictmode." + string(rule),
+		Kind:       KindStrictMode,
+		Err:        violation,
+		StackTrace: violation.StackTrace,
+		Timestamp:  violation.Timestamp,
+	})
+}
+
+// EnterUIScope marks the calling goroutine as the UI thread until the
+// returned function is called. The engine calls it around frames and input
+// handling. Does nothing when strict mode is disabled.
+func EnterUIScope() (exit func()) {
+	if !strictMode.Load() {
+		return func() {}
+	}
+	uiScopeSeen.Store(true)
+	prev := uiGoroutine.Swap(goroutineID())
+	return func() {
+		uiGoroutine.Store(prev)
+	}
+}
+
+// CheckUIThread reports a [RuleOffUIThread] violation if strict mode is
+// enabled and the caller is not running in a UI scope. owner is the type
+// name of the controller and op the method being called. Checks are skipped
+// until the engine has entered a UI scope.
+func CheckUIThread(owner, op string) {
+	if !strictMode.Load() || !uiScopeSeen.Load() {
+		return
+	}
+	if id := uiGoroutine.Load(); id != 0 && id == goroutineID() {
+		return
+	}
+	ReportStrictModeViolation(RuleOffUIThread, owner, op+" called off the UI thread; use drift.Dispatch")
+}
+
+// goroutineID parses the current goroutine's ID from its stack header. It is
+// only used by strict mode checks.
+func goroutineID() int64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	header := strings.TrimPrefix(string(buf[:n]), "goroutine ")
+	if i := strings.IndexByte(header, ' '); i > 0 {
+		id, _ := strconv.ParseInt(header[:i], 10, 64)
+		return id
+	}
+	return 0
+}
@@ -0,0 +1,90 @@
+package errors
+
+import "testing"
+
+func captureStrictViolations(t *testing.T) *[]*StrictModeViolation {
+	t.Helper()
+	var violations []*StrictModeViolation
+	oldHandler := DefaultHandler
+	SetHandler(&testHandler{
+		onError: func(err *DriftError) {
+			if v, ok := err.Err.(*StrictModeViolation); ok && err.Kind == KindStrictMode {
+				violations = append(violations, v)
+			}
+		},
+	})
+	SetStrictMode(true)
+	t.Cleanup(func() {
+		SetStrictMode(false)
+		SetHandler(oldHandler)
+		uiScopeSeen.Store(false)
+	})
+	return &violations
+}
+
+func TestReportStrictModeViolation_Dedup(t *testing.T) {
+	violations := captureStrictViolations(t)
+
+	ReportStrictModeViolation(RuleSizeBeforeLayout, "*widgets.renderBox", "Size read before layout")
+	ReportStrictModeViolation(RuleSizeBeforeLayout, "*widgets.renderBox", "Size read before layout")
+	ReportStrictModeViolation(RuleSizeBeforeLayout, "*widgets.renderOther", "Size read before layout")
+
+	if len(*violations) != 2 {
+		t.Fatalf("expected 2 distinct violations, got %d", len(*violations))
+	}
+	v := (*violations)[0]
+	if v.StackTrace == "" || v.Timestamp.IsZero() {
+		t.Error("expected stack trace and timestamp")
+	}
+	if got, want := v.Error(), "strict mode size_before_layout in *widgets.renderBox: Size read before layout"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	// Toggling strict mode forgets reported violations.
+	SetStrictMode(true)
+	ReportStrictModeViolation(RuleSizeBeforeLayout, "*widgets.renderBox", "Size read before layout")
+	if len(*violations) != 3 {
+		t.Errorf("expected violation reported again after toggle, got %d", len(*violations))
+	}
+}
+
+func TestReportStrictModeViolation_Disabled(t *testing.T) {
+	violations := captureStrictViolations(t)
+	SetStrictMode(false)
+
+	ReportStrictModeViolation(RuleOffUIThread, "T", "m")
+	if len(*violations) != 0 {
+		t.Errorf("expected no violations while disabled, got %d", len(*violations))
+	}
+}
+
+func TestCheckUIThread(t *testing.T) {
+	violations := captureStrictViolations(t)
+
+	// Before the engine enters a UI scope, nothing is checked.
+	CheckUIThread("*test.Controller", "Set")
+	if len(*violations) != 0 {
+		t.Fatalf("expected no violations before a UI scope, got %d", len(*violations))
+	}
+
+	exit := EnterUIScope()
+	CheckUIThread("*test.Controller", "Set")
+	if len(*violations) != 0 {
+		t.Fatalf("expected no violations on the UI goroutine, got %d", len(*violations))
+	}
+
+	done := make(chan struct{})
+	go func() {
+		CheckUIThread("*test.Controller", "Set")
+		close(done)
+	}()
+	<-done
+	exit()
+
+	if len(*violations) != 1 {
+		t.Fatalf("expected 1 violation from another goroutine, got %d", len(*violations))
+	}
+	if v := (*violations)[0]; v.Rule != RuleOffUIThread || v.Widget != "*test.Controller" {
+		t.Errorf("unexpected violation %+v", v)
+	}
+}
@@ -1,7 +1,10 @@
 // Package focus provides focus management structures.
 package focus
 
-import "math"
+import (
+	"math"
+	"slices"
+)
 
 // FocusRect represents a rectangle for focus geometry calculations.
 type FocusRect struct {
@@ -40,8 +43,13 @@ const (
 	TraversalDirectionRight
 )
 
-// KeyEvent represents a keyboard event placeholder.
-type KeyEvent struct{}
+// KeyEvent is a key press from a hardware keyboard.
+type KeyEvent struct {
+	// Key is the logical key, named as in the W3C UI Events KeyboardEvent
+	// key values: "ArrowDown", "Enter", "Escape", " ", or the character
+	// typed.
+	Key string
+}
 
 // KeyEventResult indicates how a key event was handled.
 type KeyEventResult int
@@ -77,6 +85,7 @@ type FocusNode struct {
 
 	hasFocus        bool
 	hasPrimaryFocus bool
+	scope           *FocusScopeNode
 }
 
 // canReceiveFocus reports whether the node can receive focus.
@@ -94,12 +103,26 @@ func (n *FocusNode) HasPrimaryFocus() bool {
 	return n.hasPrimaryFocus
 }
 
-// RequestFocus requests that this node receive primary focus.
+// RequestFocus requests that this node receive primary focus. The request
+// is ignored while focus is trapped in a scope that does not contain the
+// node.
 func (n *FocusNode) RequestFocus() {
 	if !n.canReceiveFocus() {
 		return
 	}
-	GetFocusManager().setPrimaryFocus(n)
+	manager := GetFocusManager()
+	if !manager.allowsFocus(n) {
+		return
+	}
+	manager.setPrimaryFocus(n)
+	if n.scope != nil {
+		n.scope.FocusedChild = n
+	}
+}
+
+// Scope returns the scope the node is attached to, or nil.
+func (n *FocusNode) Scope() *FocusScopeNode {
+	return n.scope
 }
 
 // Unfocus removes focus from this node if it has primary focus.
@@ -125,6 +148,73 @@ type FocusScopeNode struct {
 	FocusNode
 	FocusedChild *FocusNode
 	Children     []*FocusNode
+
+	parent *FocusScopeNode
+	scopes []*FocusScopeNode
+}
+
+// Attach adds node to this scope, detaching it from any previous scope.
+func (s *FocusScopeNode) Attach(node *FocusNode) {
+	if node.scope != nil {
+		node.scope.Detach(node)
+	}
+	node.scope = s
+	s.Children = append(s.Children, node)
+}
+
+// Detach removes node from this scope. A node that has primary focus loses
+// it.
+func (s *FocusScopeNode) Detach(node *FocusNode) {
+	if node.scope != s {
+		return
+	}
+	node.scope = nil
+	if s.FocusedChild == node {
+		s.FocusedChild = nil
+	}
+	s.Children = slices.DeleteFunc(s.Children, func(child *FocusNode) bool { return child == node })
+	manager := GetFocusManager()
+	for _, trap := range manager.traps {
+		if trap.restore == node {
+			trap.restore = nil
+		}
+	}
+	if manager.PrimaryFocus == node {
+		manager.setPrimaryFocus(nil)
+	}
+}
+
+// AttachScope nests child inside this scope, so traversal and focus traps
+// on this scope include child's nodes.
+func (s *FocusScopeNode) AttachScope(child *FocusScopeNode) {
+	if child.parent != nil {
+		child.parent.DetachScope(child)
+	}
+	child.parent = s
+	s.scopes = append(s.scopes, child)
+}
+
+// DetachScope removes a nested scope attached with AttachScope.
+func (s *FocusScopeNode) DetachScope(child *FocusScopeNode) {
+	if child.parent != s {
+		return
+	}
+	child.parent = nil
+	s.scopes = slices.DeleteFunc(s.scopes, func(scope *FocusScopeNode) bool { return scope == child })
+}
+
+// Contains reports whether node is attached to this scope or to a scope
+// nested inside it.
+func (s *FocusScopeNode) Contains(node *FocusNode) bool {
+	if s == nil || node == nil {
+		return false
+	}
+	for scope := node.scope; scope != nil; scope = scope.parent {
+		if scope == s {
+			return true
+		}
+	}
+	return false
 }
 
 // SetFirstFocus sets focus to the first focusable child.
@@ -222,14 +312,13 @@ func (s *FocusScopeNode) collectFocusableNodes() []*FocusNode {
 	return nodes
 }
 
-// collectFocusableNodesRecursive collects nodes into the provided slice.
+// collectFocusableNodesRecursive collects nodes into the provided slice,
+// followed by the nodes of nested scopes.
 func (s *FocusScopeNode) collectFocusableNodesRecursive(nodes *[]*FocusNode) {
-	for _, child := range s.Children {
-		*nodes = append(*nodes, child)
+	*nodes = append(*nodes, s.Children...)
+	for _, scope := range s.scopes {
+		scope.collectFocusableNodesRecursive(nodes)
 	}
-	// Note: nested FocusScopeNodes would need to be tracked separately
-	// and traversed here. Currently FocusScopeNode.Children contains FocusNodes,
-	// not nested scopes. When nested scope support is added, traverse them here.
 }
 
 // isInDirection checks if target rect is in the specified direction from source.
@@ -275,6 +364,70 @@ func directionalScore(source, target FocusRect, direction TraversalDirection) fl
 type FocusManager struct {
 	RootScope    *FocusScopeNode
 	PrimaryFocus *FocusNode
+
+	traps []*focusTrap
+}
+
+// focusTrap confines focus to scope and remembers the node to refocus when
+// the trap is released.
+type focusTrap struct {
+	scope   *FocusScopeNode
+	restore *FocusNode
+}
+
+// ActiveScope returns the scope focus is confined to: the most recent trap,
+// or RootScope when nothing traps focus.
+func (m *FocusManager) ActiveScope() *FocusScopeNode {
+	if len(m.traps) > 0 {
+		return m.traps[len(m.traps)-1].scope
+	}
+	return m.RootScope
+}
+
+// allowsFocus reports whether node may take focus under the current trap.
+// Without a trap any node may, including nodes not attached to a scope.
+func (m *FocusManager) allowsFocus(node *FocusNode) bool {
+	return len(m.traps) == 0 || m.ActiveScope().Contains(node)
+}
+
+// TrapFocus confines focus to scope, as modal routes do while open. Primary
+// focus outside the scope is cleared, RequestFocus on nodes outside it is
+// ignored, and traversal stays within it. Calling the returned release
+// function lifts the trap and refocuses the node that had focus when the
+// trap was set, if it can still receive focus.
+func (m *FocusManager) TrapFocus(scope *FocusScopeNode) (release func()) {
+	trap := &focusTrap{scope: scope, restore: m.PrimaryFocus}
+	m.traps = append(m.traps, trap)
+	if !scope.Contains(m.PrimaryFocus) {
+		m.setPrimaryFocus(nil)
+	}
+	return func() { m.releaseTrap(trap) }
+}
+
+func (m *FocusManager) releaseTrap(trap *focusTrap) {
+	i := slices.Index(m.traps, trap)
+	if i < 0 {
+		return
+	}
+	m.traps = slices.Delete(m.traps, i, i+1)
+	if i < len(m.traps) {
+		// A trap opened above this one inherits its restore target, since
+		// the node it would restore was inside the scope going away.
+		m.traps[i].restore = trap.restore
+		return
+	}
+	if m.PrimaryFocus != nil && !trap.scope.Contains(m.PrimaryFocus) && m.allowsFocus(m.PrimaryFocus) {
+		return
+	}
+	restore := trap.restore
+	if restore.canReceiveFocus() && m.allowsFocus(restore) {
+		m.setPrimaryFocus(restore)
+		if restore.scope != nil {
+			restore.scope.FocusedChild = restore
+		}
+	} else {
+		m.setPrimaryFocus(nil)
+	}
 }
 
 var focusManager = &FocusManager{RootScope: &FocusScopeNode{}}
@@ -284,36 +437,62 @@ func GetFocusManager() *FocusManager {
 	return focusManager
 }
 
-// MoveFocus moves focus by delta positions within the root scope.
+// MoveFocus moves focus by delta positions within the active scope,
+// including its nested scopes.
 func (m *FocusManager) MoveFocus(delta int) bool {
-	scope := m.RootScope
-	if scope == nil || len(scope.Children) == 0 {
+	scope := m.ActiveScope()
+	if scope == nil {
+		return false
+	}
+	nodes := scope.collectFocusableNodes()
+	if len(nodes) == 0 {
 		return false
 	}
 
-	currentIndex := m.findCurrentFocusIndex(scope)
-	count := len(scope.Children)
+	currentIndex := slices.Index(nodes, m.PrimaryFocus)
+	if currentIndex < 0 && delta < 0 {
+		// Without focus in the scope, moving backward starts at the end.
+		currentIndex = 0
+	}
+	count := len(nodes)
 
 	for step := 1; step <= count; step++ {
 		nextIndex := wrapIndex(currentIndex+delta*step, count)
-		candidate := scope.Children[nextIndex]
+		candidate := nodes[nextIndex]
 		if candidate.canReceiveFocus() {
 			m.setPrimaryFocus(candidate)
-			scope.FocusedChild = candidate
+			if candidate.scope != nil {
+				candidate.scope.FocusedChild = candidate
+			} else {
+				scope.FocusedChild = candidate
+			}
 			return true
 		}
 	}
 	return false
 }
 
-// findCurrentFocusIndex returns the index of the currently focused node, or -1 if none.
-func (m *FocusManager) findCurrentFocusIndex(scope *FocusScopeNode) int {
-	for i, child := range scope.Children {
-		if child == m.PrimaryFocus {
-			return i
+// HandleKeyEvent delivers a key press to the focused node's OnKeyEvent and,
+// while it is ignored, to the OnKeyEvent of each enclosing scope in turn.
+// Without primary focus the active scope and its ancestors see the event.
+// Embedders call it for each key press; it reports whether a handler
+// consumed the event.
+func (m *FocusManager) HandleKeyEvent(event KeyEvent) KeyEventResult {
+	var scope *FocusScopeNode
+	if node := m.PrimaryFocus; node != nil {
+		if node.OnKeyEvent != nil && node.OnKeyEvent(event) == KeyEventHandled {
+			return KeyEventHandled
+		}
+		scope = node.scope
+	} else {
+		scope = m.ActiveScope()
+	}
+	for ; scope != nil; scope = scope.parent {
+		if scope.OnKeyEvent != nil && scope.OnKeyEvent(event) == KeyEventHandled {
+			return KeyEventHandled
 		}
 	}
-	return -1
+	return KeyEventIgnored
 }
 
 // wrapIndex wraps an index to stay within [0, count).
@@ -70,6 +70,7 @@ func TestFocusNode_canReceiveFocus(t *testing.T) {
 func resetFocusManager() {
 	focusManager.PrimaryFocus = nil
 	focusManager.RootScope = &FocusScopeNode{}
+	focusManager.traps = nil
 }
 
 func TestFocusNode_RequestFocus(t *testing.T) {
@@ -243,6 +244,20 @@ func TestFocusManager_MoveFocus_Forward(t *testing.T) {
 	}
 }
 
+func TestFocusManager_MoveFocus_BackwardWithoutFocus(t *testing.T) {
+	resetFocusManager()
+
+	a := &FocusNode{CanRequestFocus: true}
+	b := &FocusNode{CanRequestFocus: true}
+
+	m := GetFocusManager()
+	m.RootScope.Children = []*FocusNode{a, b}
+
+	if !m.MoveFocus(-1) || !b.HasPrimaryFocus() {
+		t.Error("expected MoveFocus(-1) without focus to focus the last node")
+	}
+}
+
 func TestFocusManager_MoveFocus_Backward(t *testing.T) {
 	resetFocusManager()
 
@@ -479,3 +494,144 @@ func TestWrapIndex(t *testing.T) {
 		}
 	}
 }
+
+// --- Focus traps ---
+
+func TestFocusManager_TrapFocus(t *testing.T) {
+	resetFocusManager()
+	manager := GetFocusManager()
+
+	page := &FocusNode{CanRequestFocus: true, DebugLabel: "page"}
+	manager.RootScope.Attach(page)
+	page.RequestFocus()
+
+	dialog := &FocusScopeNode{}
+	manager.RootScope.AttachScope(dialog)
+	ok := &FocusNode{CanRequestFocus: true, DebugLabel: "ok"}
+	cancel := &FocusNode{CanRequestFocus: true, DebugLabel: "cancel"}
+	dialog.Attach(ok)
+	dialog.Attach(cancel)
+
+	release := manager.TrapFocus(dialog)
+	if page.HasFocus() || manager.PrimaryFocus != nil {
+		t.Fatal("expected trap to clear focus outside the scope")
+	}
+
+	page.RequestFocus()
+	if page.HasFocus() {
+		t.Error("expected RequestFocus outside the trap to be ignored")
+	}
+
+	for _, want := range []*FocusNode{ok, cancel, ok} {
+		manager.MoveFocus(1)
+		if manager.PrimaryFocus != want {
+			t.Fatalf("expected traversal to stay in the dialog, got %q", manager.PrimaryFocus.DebugLabel)
+		}
+	}
+
+	release()
+	if manager.PrimaryFocus != page || dialog.FocusedChild != ok {
+		t.Errorf("expected focus restored to page, got %v", manager.PrimaryFocus)
+	}
+	release()
+}
+
+func TestFocusManager_TrapFocus_Nested(t *testing.T) {
+	resetFocusManager()
+	manager := GetFocusManager()
+
+	page := &FocusNode{CanRequestFocus: true}
+	manager.RootScope.Attach(page)
+	page.RequestFocus()
+
+	first, second := &FocusScopeNode{}, &FocusScopeNode{}
+	firstNode := &FocusNode{CanRequestFocus: true}
+	first.Attach(firstNode)
+
+	releaseFirst := manager.TrapFocus(first)
+	firstNode.RequestFocus()
+	releaseSecond := manager.TrapFocus(second)
+
+	// Closing the lower dialog first hands its restore target up.
+	releaseFirst()
+	first.Detach(firstNode)
+	releaseSecond()
+	if manager.PrimaryFocus != page {
+		t.Errorf("expected focus restored to page, got %v", manager.PrimaryFocus)
+	}
+}
+
+func TestFocusManager_TrapFocus_RestoreDetached(t *testing.T) {
+	resetFocusManager()
+	manager := GetFocusManager()
+
+	page := &FocusNode{CanRequestFocus: true}
+	manager.RootScope.Attach(page)
+	page.RequestFocus()
+
+	release := manager.TrapFocus(&FocusScopeNode{})
+	manager.RootScope.Detach(page)
+	release()
+
+	if manager.PrimaryFocus != nil {
+		t.Errorf("expected no focus after the restore target was detached, got %v", manager.PrimaryFocus)
+	}
+}
+
+// --- Key events ---
+
+func TestFocusManager_HandleKeyEvent_Bubbles(t *testing.T) {
+	resetFocusManager()
+	manager := GetFocusManager()
+
+	var seen []string
+	handler := func(name string, handles string) func(KeyEvent) KeyEventResult {
+		return func(event KeyEvent) KeyEventResult {
+			seen = append(seen, name)
+			if event.Key == handles {
+				return KeyEventHandled
+			}
+			return KeyEventIgnored
+		}
+	}
+	manager.RootScope.OnKeyEvent = handler("root", "Escape")
+	menu := &FocusScopeNode{}
+	menu.OnKeyEvent = handler("menu", "ArrowDown")
+	manager.RootScope.AttachScope(menu)
+	item := &FocusNode{CanRequestFocus: true, OnKeyEvent: handler("item", "Enter")}
+	menu.Attach(item)
+	item.RequestFocus()
+
+	if manager.HandleKeyEvent(KeyEvent{Key: "Enter"}) != KeyEventHandled || len(seen) != 1 {
+		t.Errorf("expected the focused node to handle Enter, saw %v", seen)
+	}
+	seen = nil
+	if manager.HandleKeyEvent(KeyEvent{Key: "Escape"}) != KeyEventHandled {
+		t.Error("expected Escape to bubble to the root scope")
+	}
+	if want := []string{"item", "menu", "root"}; len(seen) != 3 || seen[0] != want[0] || seen[1] != want[1] || seen[2] != want[2] {
+		t.Errorf("expected %v, saw %v", want, seen)
+	}
+	seen = nil
+	if manager.HandleKeyEvent(KeyEvent{Key: "a"}) != KeyEventIgnored {
+		t.Error("expected an unhandled key to be ignored")
+	}
+}
+
+func TestFocusManager_HandleKeyEvent_NoFocusUsesActiveScope(t *testing.T) {
+	resetFocusManager()
+	manager := GetFocusManager()
+
+	trapped := &FocusScopeNode{}
+	handled := false
+	trapped.OnKeyEvent = func(KeyEvent) KeyEventResult {
+		handled = true
+		return KeyEventHandled
+	}
+	release := manager.TrapFocus(trapped)
+	defer release()
+
+	if manager.HandleKeyEvent(KeyEvent{Key: "ArrowDown"}) != KeyEventHandled || !handled {
+		t.Error("expected the trapping scope to see keys while nothing has focus")
+	}
+}
@@ -28,7 +28,9 @@ func NewGestureArena() *GestureArena {
 	return &GestureArena{entries: make(map[int64]*arenaEntry)}
 }
 
-// DefaultArena is the global gesture arena for the app.
+// DefaultArena is the gesture arena of the default engine. Render objects
+// should use their pipeline owner's arena instead, which differs when several
+// engines run in one process.
 var DefaultArena = NewGestureArena()
 
 // Add registers a member for a pointer.
@@ -102,6 +104,17 @@ func (a *GestureArena) Sweep(pointerID int64) {
 	delete(a.entries, pointerID)
 }
 
+// members returns a copy of the members competing for a pointer.
+func (a *GestureArena) members(pointerID int64) []ArenaMember {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	entry := a.entries[pointerID]
+	if entry == nil {
+		return nil
+	}
+	return slices.Clone(entry.members)
+}
+
 // Hold defers auto-resolution for this member. Returns true if the hold was
 // added successfully. The member must already be in the arena.
 func (a *GestureArena) Hold(pointerID int64, member ArenaMember) bool {
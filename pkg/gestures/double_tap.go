@@ -0,0 +1,203 @@
+package gestures
+
+import (
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// DefaultDoubleTapTimeout is the longest gap between lifting the pointer and
+// the next tap that still counts as part of the same multi-tap.
+var DefaultDoubleTapTimeout = 300 * time.Millisecond
+
+// DefaultDoubleTapSlop is the farthest apart, in logical pixels, the taps of
+// a multi-tap may land.
+var DefaultDoubleTapSlop = 100.0
+
+// tapDeferrer is implemented by recognizers that compete with single taps
+// across several pointers. A TapGestureRecognizer that wins a pointer whose
+// arena also holds a tapDeferrer hands its callback over instead of calling
+// it, so the single tap fires only once the multi-tap is ruled out.
+type tapDeferrer interface {
+	// deferTap takes ownership of onTap for pointerID, reporting false if
+	// the deferrer is not tracking that pointer.
+	deferTap(pointerID int64, onTap func()) bool
+}
+
+func findTapDeferrer(members []ArenaMember) tapDeferrer {
+	for _, member := range members {
+		if d, ok := member.(tapDeferrer); ok {
+			return d
+		}
+	}
+	return nil
+}
+
+// afterFunc runs fn on the UI thread after d and returns a function that
+// stops it. Tests replace it to control time.
+var afterFunc = func(d time.Duration, fn func()) (stop func()) {
+	timer := time.AfterFunc(d, func() {
+		if !platform.Dispatch(fn) {
+			fn()
+		}
+	})
+	return func() { timer.Stop() }
+}
+
+// DoubleTapGestureRecognizer detects two taps in quick succession, or Count
+// taps if set.
+//
+// Single taps on the same pointers are only delayed when a double-tap
+// recognizer competes for them: a [TapGestureRecognizer] that wins the first
+// tap waits until the timeout passes without another tap, and is dropped if
+// the double tap completes. Without a double-tap competitor, taps fire
+// immediately.
+type DoubleTapGestureRecognizer struct {
+	Arena *GestureArena
+	// OnDoubleTap is called when the final tap of the sequence lifts.
+	OnDoubleTap func()
+	// Count is the number of taps to detect. Values below 2 mean 2.
+	Count int
+	// Timeout is the longest gap between taps. Zero uses
+	// Settings.DoubleTapTimeout.
+	Timeout time.Duration
+	// Settings tunes the slops and timeout; zero fields use the package
+	// defaults.
+	Settings GestureSettings
+
+	pointer  int64
+	start    graphics.Offset
+	lastUp   graphics.Offset
+	tracking bool
+	taps     int
+	// pending is a single-tap callback deferred until the sequence fails.
+	pending func()
+	// completed is the pointer that finished the last sequence; a tap that
+	// won it is swallowed.
+	completed    int64
+	hasCompleted bool
+	stopTimer    func()
+	generation   int
+}
+
+// NewDoubleTapGestureRecognizer creates a double-tap recognizer.
+func NewDoubleTapGestureRecognizer(arena *GestureArena) *DoubleTapGestureRecognizer {
+	return &DoubleTapGestureRecognizer{Arena: arena}
+}
+
+func (d *DoubleTapGestureRecognizer) count() int {
+	return max(d.Count, 2)
+}
+
+func (d *DoubleTapGestureRecognizer) timeout() time.Duration {
+	if d.Timeout > 0 {
+		return d.Timeout
+	}
+	return d.Settings.doubleTapTimeout()
+}
+
+// AddPointer registers a pointer down event.
+func (d *DoubleTapGestureRecognizer) AddPointer(event PointerEvent) {
+	if d.Arena == nil {
+		return
+	}
+	if d.taps > 0 && distance(graphics.Offset{X: event.Position.X - d.lastUp.X, Y: event.Position.Y - d.lastUp.Y}) > d.Settings.doubleTapSlop() {
+		// Too far from the previous tap: that sequence is over.
+		d.flush()
+	}
+	d.cancelTimer()
+	d.pointer = event.PointerID
+	d.start = event.Position
+	d.tracking = true
+	d.hasCompleted = false
+	d.Arena.Add(event.PointerID, d)
+}
+
+// HandleEvent processes pointer events for multi-tap detection.
+func (d *DoubleTapGestureRecognizer) HandleEvent(event PointerEvent) {
+	if event.PointerID != d.pointer || !d.tracking {
+		return
+	}
+	switch event.Phase {
+	case PointerPhaseMove:
+		if distance(graphics.Offset{X: event.Position.X - d.start.X, Y: event.Position.Y - d.start.Y}) > d.Settings.touchSlop() {
+			d.Arena.Reject(event.PointerID, d)
+			d.flush()
+		}
+	case PointerPhaseUp:
+		d.tracking = false
+		d.taps++
+		d.lastUp = event.Position
+		if d.taps < d.count() {
+			gen := d.generation
+			d.stopTimer = afterFunc(d.timeout(), func() {
+				if gen == d.generation {
+					d.flush()
+				}
+			})
+			return
+		}
+		d.Arena.Resolve(event.PointerID, d)
+		d.reset()
+		d.completed = event.PointerID
+		d.hasCompleted = true
+		if d.OnDoubleTap != nil {
+			d.OnDoubleTap()
+		}
+	case PointerPhaseCancel:
+		d.Arena.Reject(event.PointerID, d)
+		d.flush()
+	}
+}
+
+// AcceptGesture is called by the arena when this recognizer wins.
+func (d *DoubleTapGestureRecognizer) AcceptGesture(pointerID int64) {}
+
+// RejectGesture is called by the arena when this recognizer loses. Losing an
+// intermediate tap to a single-tap recognizer is expected, so the sequence
+// continues; movement and cancellation end it.
+func (d *DoubleTapGestureRecognizer) RejectGesture(pointerID int64) {}
+
+// Dispose stops the timeout and drops any deferred single tap.
+func (d *DoubleTapGestureRecognizer) Dispose() {
+	d.reset()
+}
+
+func (d *DoubleTapGestureRecognizer) deferTap(pointerID int64, onTap func()) bool {
+	if d.hasCompleted && pointerID == d.completed {
+		// The tap that completed the sequence belongs to the double tap.
+		return true
+	}
+	if pointerID != d.pointer || (!d.tracking && d.taps == 0) {
+		return false
+	}
+	if d.pending == nil {
+		d.pending = onTap
+	}
+	return true
+}
+
+// flush ends the sequence and fires any deferred single tap.
+func (d *DoubleTapGestureRecognizer) flush() {
+	pending := d.pending
+	d.reset()
+	if pending != nil {
+		pending()
+	}
+}
+
+func (d *DoubleTapGestureRecognizer) reset() {
+	d.cancelTimer()
+	d.tracking = false
+	d.taps = 0
+	d.pending = nil
+}
+
+func (d *DoubleTapGestureRecognizer) cancelTimer() {
+	d.generation++
+	if d.stopTimer != nil {
+		d.stopTimer()
+		d.stopTimer = nil
+	}
+}
@@ -0,0 +1,168 @@
+package gestures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// fakeTimers replaces afterFunc for the duration of a test and returns a
+// function that fires the most recently scheduled, unstopped timer.
+func fakeTimers(t *testing.T) (fire func() bool) {
+	t.Helper()
+	var pending func()
+	orig := afterFunc
+	afterFunc = func(d time.Duration, fn func()) func() {
+		pending = fn
+		return func() { pending = nil }
+	}
+	t.Cleanup(func() { afterFunc = orig })
+	return func() bool {
+		if pending == nil {
+			return false
+		}
+		fn := pending
+		pending = nil
+		fn()
+		return true
+	}
+}
+
+// tapAt sends a down/up pair for pointer through the recognizers in order,
+// closing and sweeping the arena like the engine does.
+func tapAt(arena *GestureArena, pointer int64, pos graphics.Offset, tap *TapGestureRecognizer, double *DoubleTapGestureRecognizer) {
+	down := PointerEvent{PointerID: pointer, Position: pos, Phase: PointerPhaseDown}
+	up := PointerEvent{PointerID: pointer, Position: pos, Phase: PointerPhaseUp}
+	if double != nil {
+		double.AddPointer(down)
+	}
+	if tap != nil {
+		tap.AddPointer(down)
+	}
+	arena.Close(pointer)
+	if double != nil {
+		double.HandleEvent(up)
+	}
+	if tap != nil {
+		tap.HandleEvent(up)
+	}
+	arena.Sweep(pointer)
+}
+
+func TestDoubleTap_SwallowsSingleTaps(t *testing.T) {
+	fire := fakeTimers(t)
+	arena := NewGestureArena()
+	tap := NewTapGestureRecognizer(arena)
+	double := NewDoubleTapGestureRecognizer(arena)
+	var taps, doubles int
+	tap.OnTap = func() { taps++ }
+	double.OnDoubleTap = func() { doubles++ }
+
+	// Android reuses pointer IDs, so both taps use pointer 0.
+	tapAt(arena, 0, graphics.Offset{X: 10, Y: 10}, tap, double)
+	if taps != 0 {
+		t.Fatal("single tap should wait for a possible second tap")
+	}
+	tapAt(arena, 0, graphics.Offset{X: 14, Y: 12}, tap, double)
+
+	if doubles != 1 || taps != 0 {
+		t.Errorf("doubles = %d, taps = %d; want 1, 0", doubles, taps)
+	}
+	if fire() {
+		t.Error("expected no timer after the double tap completed")
+	}
+}
+
+func TestDoubleTap_TimeoutDeliversSingleTap(t *testing.T) {
+	fire := fakeTimers(t)
+	arena := NewGestureArena()
+	tap := NewTapGestureRecognizer(arena)
+	double := NewDoubleTapGestureRecognizer(arena)
+	var taps, doubles int
+	tap.OnTap = func() { taps++ }
+	double.OnDoubleTap = func() { doubles++ }
+
+	tapAt(arena, 1, graphics.Offset{X: 10, Y: 10}, tap, double)
+	if !fire() {
+		t.Fatal("expected a timeout to be scheduled")
+	}
+	if taps != 1 || doubles != 0 {
+		t.Errorf("taps = %d, doubles = %d; want 1, 0", taps, doubles)
+	}
+}
+
+func TestDoubleTap_DistantSecondTapStartsOver(t *testing.T) {
+	fakeTimers(t)
+	arena := NewGestureArena()
+	tap := NewTapGestureRecognizer(arena)
+	double := NewDoubleTapGestureRecognizer(arena)
+	var taps, doubles int
+	tap.OnTap = func() { taps++ }
+	double.OnDoubleTap = func() { doubles++ }
+
+	tapAt(arena, 1, graphics.Offset{X: 0, Y: 0}, tap, double)
+	tapAt(arena, 2, graphics.Offset{X: DefaultDoubleTapSlop + 50, Y: 0}, tap, double)
+
+	if taps != 1 || doubles != 0 {
+		t.Errorf("taps = %d, doubles = %d; want first tap delivered, no double", taps, doubles)
+	}
+}
+
+func TestDoubleTap_TapOrderIndependent(t *testing.T) {
+	fakeTimers(t)
+	arena := NewGestureArena()
+	tap := NewTapGestureRecognizer(arena)
+	double := NewDoubleTapGestureRecognizer(arena)
+	var taps, doubles int
+	tap.OnTap = func() { taps++ }
+	double.OnDoubleTap = func() { doubles++ }
+
+	// Deliver events to the tap recognizer before the double-tap recognizer,
+	// as happens when they live on different render objects.
+	for _, pointer := range []int64{1, 2} {
+		down := PointerEvent{PointerID: pointer, Phase: PointerPhaseDown}
+		up := PointerEvent{PointerID: pointer, Phase: PointerPhaseUp}
+		tap.AddPointer(down)
+		double.AddPointer(down)
+		arena.Close(pointer)
+		tap.HandleEvent(up)
+		double.HandleEvent(up)
+		arena.Sweep(pointer)
+	}
+
+	if doubles != 1 || taps != 0 {
+		t.Errorf("doubles = %d, taps = %d; want 1, 0", doubles, taps)
+	}
+}
+
+func TestDoubleTap_TripleTapCount(t *testing.T) {
+	fakeTimers(t)
+	arena := NewGestureArena()
+	triple := NewDoubleTapGestureRecognizer(arena)
+	triple.Count = 3
+	var fired int
+	triple.OnDoubleTap = func() { fired++ }
+
+	tapAt(arena, 1, graphics.Offset{}, nil, triple)
+	tapAt(arena, 2, graphics.Offset{}, nil, triple)
+	if fired != 0 {
+		t.Fatal("fired after two taps")
+	}
+	tapAt(arena, 3, graphics.Offset{}, nil, triple)
+	if fired != 1 {
+		t.Errorf("fired = %d, want 1", fired)
+	}
+}
+
+func TestTap_NotDelayedWithoutDoubleTapCompetitor(t *testing.T) {
+	arena := NewGestureArena()
+	tap := NewTapGestureRecognizer(arena)
+	var taps int
+	tap.OnTap = func() { taps++ }
+
+	tapAt(arena, 1, graphics.Offset{}, tap, nil)
+	if taps != 1 {
+		t.Errorf("taps = %d, want immediate tap", taps)
+	}
+}
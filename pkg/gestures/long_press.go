@@ -0,0 +1,141 @@
+package gestures
+
+import "github.com/go-drift/drift/pkg/graphics"
+
+// LongPressDetails describes the pointer during a long press.
+type LongPressDetails struct {
+	Position graphics.Offset
+}
+
+// LongPressGestureRecognizer detects a pointer held down without moving past
+// the touch slop for Settings.LongPressTimeout.
+//
+// It holds the arena until the timeout, so a competing tap wins if the
+// pointer lifts first and a drag wins if it moves away.
+type LongPressGestureRecognizer struct {
+	Arena *GestureArena
+	// OnLongPress is called as soon as the press has lasted long enough.
+	OnLongPress func()
+	// OnLongPressStart is called with the pointer position right after
+	// OnLongPress.
+	OnLongPressStart func(LongPressDetails)
+	// OnLongPressMoveUpdate is called when the pointer moves after the long
+	// press was recognized.
+	OnLongPressMoveUpdate func(LongPressDetails)
+	// OnLongPressEnd is called when the pointer lifts after a long press.
+	OnLongPressEnd func()
+	// Settings tunes the timeout and touch slop; zero fields use the
+	// package defaults.
+	Settings GestureSettings
+
+	pointer    int64
+	start      graphics.Offset
+	last       graphics.Offset
+	tracking   bool
+	accepted   bool
+	stopTimer  func()
+	generation int
+}
+
+// NewLongPressGestureRecognizer creates a long-press recognizer.
+func NewLongPressGestureRecognizer(arena *GestureArena) *LongPressGestureRecognizer {
+	return &LongPressGestureRecognizer{Arena: arena}
+}
+
+// AddPointer registers a pointer down event.
+func (l *LongPressGestureRecognizer) AddPointer(event PointerEvent) {
+	if l.Arena == nil {
+		return
+	}
+	l.cancelTimer()
+	l.pointer = event.PointerID
+	l.start = event.Position
+	l.last = event.Position
+	l.tracking = true
+	l.accepted = false
+	l.Arena.Add(event.PointerID, l)
+	l.Arena.Hold(event.PointerID, l)
+	gen := l.generation
+	pointer := event.PointerID
+	l.stopTimer = afterFunc(l.Settings.longPressTimeout(), func() {
+		if gen == l.generation && l.tracking {
+			l.Arena.Resolve(pointer, l)
+		}
+	})
+}
+
+// HandleEvent processes pointer events for long-press detection.
+func (l *LongPressGestureRecognizer) HandleEvent(event PointerEvent) {
+	if event.PointerID != l.pointer || !l.tracking {
+		return
+	}
+	switch event.Phase {
+	case PointerPhaseMove:
+		l.last = event.Position
+		if l.accepted {
+			if l.OnLongPressMoveUpdate != nil {
+				l.OnLongPressMoveUpdate(LongPressDetails{Position: event.Position})
+			}
+			return
+		}
+		if distance(graphics.Offset{X: event.Position.X - l.start.X, Y: event.Position.Y - l.start.Y}) > l.Settings.touchSlop() {
+			l.stop(event.PointerID)
+		}
+	case PointerPhaseUp:
+		if l.accepted {
+			l.tracking = false
+			if l.OnLongPressEnd != nil {
+				l.OnLongPressEnd()
+			}
+			return
+		}
+		l.stop(event.PointerID)
+	case PointerPhaseCancel:
+		l.stop(event.PointerID)
+	}
+}
+
+// AcceptGesture is called by the arena when this recognizer wins.
+func (l *LongPressGestureRecognizer) AcceptGesture(pointerID int64) {
+	if pointerID != l.pointer || !l.tracking {
+		return
+	}
+	l.cancelTimer()
+	l.accepted = true
+	if l.OnLongPress != nil {
+		l.OnLongPress()
+	}
+	if l.OnLongPressStart != nil {
+		l.OnLongPressStart(LongPressDetails{Position: l.last})
+	}
+}
+
+// RejectGesture is called by the arena when this recognizer loses.
+func (l *LongPressGestureRecognizer) RejectGesture(pointerID int64) {
+	if pointerID != l.pointer {
+		return
+	}
+	l.tracking = false
+	l.cancelTimer()
+}
+
+// Dispose stops the pending timeout.
+func (l *LongPressGestureRecognizer) Dispose() {
+	l.tracking = false
+	l.cancelTimer()
+}
+
+// stop abandons the press and leaves the pointer to other recognizers.
+func (l *LongPressGestureRecognizer) stop(pointerID int64) {
+	l.tracking = false
+	l.cancelTimer()
+	l.Arena.Reject(pointerID, l)
+}
+
+func (l *LongPressGestureRecognizer) cancelTimer() {
+	l.generation++
+	if l.stopTimer != nil {
+		l.stopTimer()
+		l.stopTimer = nil
+	}
+}
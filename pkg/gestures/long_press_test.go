@@ -0,0 +1,117 @@
+package gestures
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestLongPress_FiresAfterTimeout(t *testing.T) {
+	fire := fakeTimers(t)
+	arena := NewGestureArena()
+	tap := NewTapGestureRecognizer(arena)
+	press := NewLongPressGestureRecognizer(arena)
+	var taps, presses, ends int
+	tap.OnTap = func() { taps++ }
+	press.OnLongPress = func() { presses++ }
+	press.OnLongPressEnd = func() { ends++ }
+
+	pos := graphics.Offset{X: 10, Y: 10}
+	down := PointerEvent{PointerID: 1, Position: pos, Phase: PointerPhaseDown}
+	press.AddPointer(down)
+	tap.AddPointer(down)
+	arena.Close(1)
+	if !fire() {
+		t.Fatal("expected a long-press timer")
+	}
+	if presses != 1 {
+		t.Fatalf("presses = %d, want 1", presses)
+	}
+
+	up := PointerEvent{PointerID: 1, Position: pos, Phase: PointerPhaseUp}
+	press.HandleEvent(up)
+	tap.HandleEvent(up)
+	arena.Sweep(1)
+	if taps != 0 || ends != 1 {
+		t.Errorf("taps = %d, ends = %d; want 0, 1", taps, ends)
+	}
+}
+
+func TestLongPress_QuickTapWins(t *testing.T) {
+	fire := fakeTimers(t)
+	arena := NewGestureArena()
+	tap := NewTapGestureRecognizer(arena)
+	press := NewLongPressGestureRecognizer(arena)
+	var taps, presses int
+	tap.OnTap = func() { taps++ }
+	press.OnLongPress = func() { presses++ }
+
+	pos := graphics.Offset{X: 10, Y: 10}
+	down := PointerEvent{PointerID: 1, Position: pos, Phase: PointerPhaseDown}
+	up := PointerEvent{PointerID: 1, Position: pos, Phase: PointerPhaseUp}
+	press.AddPointer(down)
+	tap.AddPointer(down)
+	arena.Close(1)
+	press.HandleEvent(up)
+	tap.HandleEvent(up)
+	arena.Sweep(1)
+
+	if taps != 1 || presses != 0 {
+		t.Errorf("taps = %d, presses = %d; want 1, 0", taps, presses)
+	}
+	if fire() {
+		t.Error("expected the long-press timer to be stopped")
+	}
+}
+
+func TestLongPress_SettingsSlop(t *testing.T) {
+	fire := fakeTimers(t)
+	arena := NewGestureArena()
+	press := NewLongPressGestureRecognizer(arena)
+	press.Settings = GestureSettings{TouchSlop: 4}
+	var presses int
+	press.OnLongPress = func() { presses++ }
+
+	press.AddPointer(PointerEvent{PointerID: 1, Phase: PointerPhaseDown})
+	arena.Close(1)
+	// Within the default slop, but past the configured one.
+	press.HandleEvent(PointerEvent{PointerID: 1, Position: graphics.Offset{X: 6}, Phase: PointerPhaseMove})
+	fire()
+	if presses != 0 {
+		t.Error("expected moving past the configured slop to cancel the long press")
+	}
+}
+
+func TestLongPress_ReportsPositions(t *testing.T) {
+	fire := fakeTimers(t)
+	arena := NewGestureArena()
+	press := NewLongPressGestureRecognizer(arena)
+	var got []graphics.Offset
+	press.OnLongPressStart = func(d LongPressDetails) { got = append(got, d.Position) }
+	press.OnLongPressMoveUpdate = func(d LongPressDetails) { got = append(got, d.Position) }
+
+	press.AddPointer(PointerEvent{PointerID: 1, Position: graphics.Offset{X: 10, Y: 10}, Phase: PointerPhaseDown})
+	arena.Close(1)
+	press.HandleEvent(PointerEvent{PointerID: 1, Position: graphics.Offset{X: 12, Y: 10}, Phase: PointerPhaseMove})
+	fire()
+	// Past the slop is fine once the press is recognized.
+	press.HandleEvent(PointerEvent{PointerID: 1, Position: graphics.Offset{X: 80, Y: 10}, Phase: PointerPhaseMove})
+
+	want := []graphics.Offset{{X: 12, Y: 10}, {X: 80, Y: 10}}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("positions = %v, want %v", got, want)
+	}
+}
+
+func TestGestureSettings_Merge(t *testing.T) {
+	override := GestureSettings{LongPressTimeout: time.Second}
+	merged := override.Merge(GestureSettings{TouchSlop: 8, LongPressTimeout: 400 * time.Millisecond})
+	want := GestureSettings{TouchSlop: 8, LongPressTimeout: time.Second}
+	if merged != want {
+		t.Errorf("Merge = %+v, want %+v", merged, want)
+	}
+	if got := (GestureSettings{}).longPressTimeout(); got != DefaultLongPressTimeout {
+		t.Errorf("zero LongPressTimeout resolves to %v, want %v", got, DefaultLongPressTimeout)
+	}
+}
@@ -34,8 +34,11 @@ type DragEndDetails struct {
 
 // TapGestureRecognizer detects taps.
 type TapGestureRecognizer struct {
-	Arena   *GestureArena
-	OnTap   func()
+	Arena *GestureArena
+	OnTap func()
+	// Settings tunes the touch slop; zero fields use the package defaults.
+	Settings GestureSettings
+
 	pointer int64
 	start   graphics.Offset
 	slop    float64
@@ -43,6 +46,8 @@ type TapGestureRecognizer struct {
 	reject  bool
 	up      bool
 	fired   bool
+	// deferrer is a multi-tap competitor that may delay or swallow OnTap.
+	deferrer tapDeferrer
 }
 
 // NewTapGestureRecognizer creates a tap recognizer.
@@ -57,11 +62,12 @@ func (t *TapGestureRecognizer) AddPointer(event PointerEvent) {
 	}
 	t.pointer = event.PointerID
 	t.start = event.Position
-	t.slop = DefaultTouchSlop
+	t.slop = t.Settings.touchSlop()
 	t.won = false
 	t.reject = false
 	t.up = false
 	t.fired = false
+	t.deferrer = nil
 	t.Arena.Add(event.PointerID, t)
 }
 
@@ -81,6 +87,7 @@ func (t *TapGestureRecognizer) HandleEvent(event PointerEvent) {
 	case PointerPhaseUp:
 		t.up = true
 		if !t.reject {
+			t.deferrer = findTapDeferrer(t.Arena.members(event.PointerID))
 			t.Arena.Resolve(event.PointerID, t)
 			t.tryFire()
 		}
@@ -115,9 +122,13 @@ func (t *TapGestureRecognizer) tryFire() {
 		return
 	}
 	t.fired = true
-	if t.OnTap != nil {
-		t.OnTap()
+	if t.OnTap == nil {
+		return
 	}
+	if t.deferrer != nil && t.deferrer.deferTap(t.pointer, t.OnTap) {
+		return
+	}
+	t.OnTap()
 }
 
 // PanGestureRecognizer detects pan gestures.
@@ -127,6 +138,9 @@ type PanGestureRecognizer struct {
 	OnUpdate func(DragUpdateDetails)
 	OnEnd    func(DragEndDetails)
 	OnCancel func()
+	// Settings tunes the touch slop; zero fields use the package defaults.
+	Settings GestureSettings
+
 	pointer  int64
 	start    graphics.Offset
 	last     graphics.Offset
@@ -153,7 +167,7 @@ func (p *PanGestureRecognizer) AddPointer(event PointerEvent) {
 	p.last = event.Position
 	p.lastTime = time.Now()
 	p.velocity = graphics.Offset{}
-	p.slop = DefaultTouchSlop
+	p.slop = p.Settings.touchSlop()
 	p.accepted = false
 	p.reject = false
 	p.started = false
@@ -261,6 +275,8 @@ type axisDragRecognizer struct {
 	OnUpdate func(DragUpdateDetails)
 	OnEnd    func(DragEndDetails)
 	OnCancel func()
+	// Settings tunes the touch slop; zero fields use the package defaults.
+	Settings GestureSettings
 
 	axis     DragAxis
 	self     ArenaMember // concrete type for arena registration
@@ -298,7 +314,7 @@ func (d *axisDragRecognizer) addPointer(event PointerEvent) {
 	d.last = event.Position
 	d.lastTime = time.Now()
 	d.velocity = 0
-	d.slop = DefaultTouchSlop
+	d.slop = d.Settings.touchSlop()
 	d.accepted = false
 	d.reject = false
 	d.started = false
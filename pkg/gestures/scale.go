@@ -0,0 +1,209 @@
+package gestures
+
+import (
+	"math"
+	"slices"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// ScaleStartDetails describes the pointers when a scale gesture starts.
+type ScaleStartDetails struct {
+	// FocalPoint is the average position of the pointers.
+	FocalPoint graphics.Offset
+	// PointerCount is the number of pointers down.
+	PointerCount int
+}
+
+// ScaleUpdateDetails describes the pointers as a scale gesture moves.
+type ScaleUpdateDetails struct {
+	// FocalPoint is the average position of the pointers.
+	FocalPoint graphics.Offset
+	// FocalDelta is how far FocalPoint moved since the last update.
+	FocalDelta graphics.Offset
+	// Scale is how much the pointers have spread since the gesture started:
+	// 2 means twice as far apart. It stays unchanged while one pointer is
+	// down and when pointers are added or lifted.
+	Scale float64
+	// PointerCount is the number of pointers down.
+	PointerCount int
+}
+
+// ScaleEndDetails describes the end of a scale gesture.
+type ScaleEndDetails struct {
+	// Scale is the final scale of the gesture.
+	Scale float64
+}
+
+// ScaleGestureRecognizer detects pans and pinches with any number of
+// pointers. One pointer pans; two or more also report how far they have
+// spread, for zooming.
+//
+// It wins the arena once the pointers move or spread past the touch slop,
+// and takes every pointer that goes down while it is active.
+type ScaleGestureRecognizer struct {
+	Arena *GestureArena
+	// OnStart is called when the gesture wins the arena.
+	OnStart func(ScaleStartDetails)
+	// OnUpdate is called when the pointers move.
+	OnUpdate func(ScaleUpdateDetails)
+	// OnEnd is called when the last pointer lifts or is cancelled.
+	OnEnd func(ScaleEndDetails)
+	// Settings tunes the touch slop; zero fields use the package defaults.
+	Settings GestureSettings
+
+	pointers  map[int64]graphics.Offset
+	order     []int64 // pointers in the order they went down
+	accepted  bool
+	startSpan float64
+	baseSpan  float64 // span at which scale equals baseScale
+	baseScale float64
+	scale     float64
+	focal     graphics.Offset
+	start     graphics.Offset
+}
+
+// NewScaleGestureRecognizer creates a scale recognizer.
+func NewScaleGestureRecognizer(arena *GestureArena) *ScaleGestureRecognizer {
+	return &ScaleGestureRecognizer{Arena: arena}
+}
+
+// AddPointer registers a pointer down event.
+func (s *ScaleGestureRecognizer) AddPointer(event PointerEvent) {
+	if s.Arena == nil {
+		return
+	}
+	if len(s.pointers) == 0 {
+		s.pointers = make(map[int64]graphics.Offset)
+		s.order = s.order[:0]
+		s.accepted = false
+		s.scale = 1
+	}
+	s.pointers[event.PointerID] = event.Position
+	s.order = append(s.order, event.PointerID)
+	s.rebase()
+	s.Arena.Add(event.PointerID, s)
+	if s.accepted {
+		s.Arena.Resolve(event.PointerID, s)
+	} else {
+		// Hold so the arena doesn't resolve on Close before the slop is
+		// exceeded.
+		s.Arena.Hold(event.PointerID, s)
+	}
+}
+
+// HandleEvent processes pointer events for scale detection.
+func (s *ScaleGestureRecognizer) HandleEvent(event PointerEvent) {
+	if _, ok := s.pointers[event.PointerID]; !ok {
+		return
+	}
+	switch event.Phase {
+	case PointerPhaseMove:
+		s.pointers[event.PointerID] = event.Position
+		focal, span := s.measure()
+		delta := graphics.Offset{X: focal.X - s.focal.X, Y: focal.Y - s.focal.Y}
+		s.focal = focal
+		if len(s.pointers) > 1 && s.baseSpan > 0 {
+			s.scale = s.baseScale * span / s.baseSpan
+		}
+		if !s.accepted {
+			moved := distance(graphics.Offset{X: focal.X - s.start.X, Y: focal.Y - s.start.Y})
+			slop := s.Settings.touchSlop()
+			if moved <= slop && math.Abs(span-s.startSpan) <= slop {
+				return
+			}
+			s.accept()
+			if !s.accepted {
+				return
+			}
+			// Report the movement since the pointers went down, so none of
+			// it is lost to the slop.
+			delta = graphics.Offset{X: focal.X - s.start.X, Y: focal.Y - s.start.Y}
+		}
+		if s.OnUpdate != nil {
+			s.OnUpdate(ScaleUpdateDetails{
+				FocalPoint:   focal,
+				FocalDelta:   delta,
+				Scale:        s.scale,
+				PointerCount: len(s.pointers),
+			})
+		}
+	case PointerPhaseUp, PointerPhaseCancel:
+		if !s.accepted {
+			s.Arena.Reject(event.PointerID, s)
+		}
+		s.removePointer(event.PointerID)
+	}
+}
+
+// AcceptGesture is called by the arena when this recognizer wins.
+func (s *ScaleGestureRecognizer) AcceptGesture(pointerID int64) {
+	if _, ok := s.pointers[pointerID]; !ok || s.accepted {
+		return
+	}
+	s.accepted = true
+	if s.OnStart != nil {
+		s.OnStart(ScaleStartDetails{FocalPoint: s.focal, PointerCount: len(s.pointers)})
+	}
+}
+
+// RejectGesture is called by the arena when this recognizer loses.
+func (s *ScaleGestureRecognizer) RejectGesture(pointerID int64) {
+	if _, ok := s.pointers[pointerID]; ok {
+		s.removePointer(pointerID)
+	}
+}
+
+// Dispose releases resources for the recognizer.
+func (s *ScaleGestureRecognizer) Dispose() {}
+
+// accept claims every pointer down.
+func (s *ScaleGestureRecognizer) accept() {
+	for _, pointer := range slices.Clone(s.order) {
+		s.Arena.Resolve(pointer, s)
+	}
+}
+
+func (s *ScaleGestureRecognizer) removePointer(pointerID int64) {
+	delete(s.pointers, pointerID)
+	s.order = slices.DeleteFunc(s.order, func(id int64) bool { return id == pointerID })
+	if len(s.pointers) > 0 {
+		s.rebase()
+		return
+	}
+	if s.accepted {
+		s.accepted = false
+		if s.OnEnd != nil {
+			s.OnEnd(ScaleEndDetails{Scale: s.scale})
+		}
+	}
+}
+
+// rebase restarts focal and span tracking after the pointer set changes,
+// so neither jumps. Before the gesture is accepted, the slop is measured
+// from here too.
+func (s *ScaleGestureRecognizer) rebase() {
+	s.focal, s.baseSpan = s.measure()
+	s.baseScale = s.scale
+	if !s.accepted {
+		s.start = s.focal
+		s.startSpan = s.baseSpan
+	}
+}
+
+// measure returns the average pointer position and the average distance of
+// the pointers from it.
+func (s *ScaleGestureRecognizer) measure() (graphics.Offset, float64) {
+	var focal graphics.Offset
+	for _, position := range s.pointers {
+		focal.X += position.X
+		focal.Y += position.Y
+	}
+	n := float64(len(s.pointers))
+	focal = graphics.Offset{X: focal.X / n, Y: focal.Y / n}
+	var span float64
+	for _, position := range s.pointers {
+		span += distance(graphics.Offset{X: position.X - focal.X, Y: position.Y - focal.Y})
+	}
+	return focal, span / n
+}
@@ -0,0 +1,111 @@
+package gestures
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func scaleEvent(id int64, x, y float64, phase PointerPhase) PointerEvent {
+	return PointerEvent{PointerID: id, Position: graphics.Offset{X: x, Y: y}, Phase: phase}
+}
+
+func TestScale_Pan(t *testing.T) {
+	arena := NewGestureArena()
+	recognizer := NewScaleGestureRecognizer(arena)
+
+	var started, ended bool
+	var updates []ScaleUpdateDetails
+	recognizer.OnStart = func(ScaleStartDetails) { started = true }
+	recognizer.OnUpdate = func(d ScaleUpdateDetails) { updates = append(updates, d) }
+	recognizer.OnEnd = func(ScaleEndDetails) { ended = true }
+
+	recognizer.AddPointer(scaleEvent(1, 100, 100, PointerPhaseDown))
+	arena.Close(1)
+	recognizer.HandleEvent(scaleEvent(1, 105, 100, PointerPhaseMove))
+	if started {
+		t.Fatal("OnStart should wait for the touch slop")
+	}
+
+	recognizer.HandleEvent(scaleEvent(1, 100+DefaultTouchSlop+5, 100, PointerPhaseMove))
+	if !started {
+		t.Fatal("OnStart should be called once the pointer passes the slop")
+	}
+	recognizer.HandleEvent(scaleEvent(1, 100+DefaultTouchSlop+15, 110, PointerPhaseMove))
+	if len(updates) != 2 {
+		t.Fatalf("expected two updates, got %d", len(updates))
+	}
+	if d := updates[0]; d.FocalDelta != (graphics.Offset{X: DefaultTouchSlop + 5}) {
+		t.Errorf("expected the first update to include the slop, got %+v", d)
+	}
+	if d := updates[1]; d.FocalDelta != (graphics.Offset{X: 10, Y: 10}) || d.Scale != 1 {
+		t.Errorf("expected a pan by (10, 10) at scale 1, got %+v", d)
+	}
+
+	recognizer.HandleEvent(scaleEvent(1, 130, 110, PointerPhaseUp))
+	if !ended {
+		t.Error("OnEnd should be called when the pointer lifts")
+	}
+}
+
+func TestScale_Pinch(t *testing.T) {
+	arena := NewGestureArena()
+	recognizer := NewScaleGestureRecognizer(arena)
+
+	var last ScaleUpdateDetails
+	var end ScaleEndDetails
+	recognizer.OnUpdate = func(d ScaleUpdateDetails) { last = d }
+	recognizer.OnEnd = func(d ScaleEndDetails) { end = d }
+
+	recognizer.AddPointer(scaleEvent(1, 100, 100, PointerPhaseDown))
+	arena.Close(1)
+	recognizer.AddPointer(scaleEvent(2, 200, 100, PointerPhaseDown))
+	arena.Close(2)
+
+	// Spread the pointers to twice the distance around the same focal point.
+	recognizer.HandleEvent(scaleEvent(1, 75, 100, PointerPhaseMove))
+	recognizer.HandleEvent(scaleEvent(2, 225, 100, PointerPhaseMove))
+	recognizer.HandleEvent(scaleEvent(1, 50, 100, PointerPhaseMove))
+	recognizer.HandleEvent(scaleEvent(2, 250, 100, PointerPhaseMove))
+	if last.Scale != 2 || last.PointerCount != 2 {
+		t.Fatalf("expected scale 2 with two pointers, got %+v", last)
+	}
+	if last.FocalPoint != (graphics.Offset{X: 150, Y: 100}) {
+		t.Errorf("expected the focal point to stay at (150, 100), got %v", last.FocalPoint)
+	}
+
+	// Lifting one pointer keeps the scale and doesn't jump the focal point.
+	recognizer.HandleEvent(scaleEvent(2, 250, 100, PointerPhaseUp))
+	recognizer.HandleEvent(scaleEvent(1, 60, 100, PointerPhaseMove))
+	if last.Scale != 2 || last.FocalDelta != (graphics.Offset{X: 10}) {
+		t.Errorf("expected a pan by 10 at scale 2 after lifting a pointer, got %+v", last)
+	}
+
+	recognizer.HandleEvent(scaleEvent(1, 60, 100, PointerPhaseUp))
+	if end.Scale != 2 {
+		t.Errorf("expected OnEnd with scale 2, got %v", end.Scale)
+	}
+}
+
+func TestScale_LosesToEarlierWinner(t *testing.T) {
+	arena := NewGestureArena()
+	tap := NewTapGestureRecognizer(arena)
+	tapped := false
+	tap.OnTap = func() { tapped = true }
+	recognizer := NewScaleGestureRecognizer(arena)
+	started := false
+	recognizer.OnStart = func(ScaleStartDetails) { started = true }
+
+	down := scaleEvent(1, 100, 100, PointerPhaseDown)
+	tap.AddPointer(down)
+	recognizer.AddPointer(down)
+	arena.Close(1)
+	up := scaleEvent(1, 102, 100, PointerPhaseUp)
+	tap.HandleEvent(up)
+	recognizer.HandleEvent(up)
+	arena.Sweep(1)
+
+	if !tapped || started {
+		t.Errorf("expected a tap without a scale gesture, got tapped=%v started=%v", tapped, started)
+	}
+}
@@ -0,0 +1,105 @@
+package gestures
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// DefaultLongPressTimeout is how long a pointer must stay down, without
+// moving past the touch slop, to count as a long press.
+var DefaultLongPressTimeout = 500 * time.Millisecond
+
+// GestureSettings holds the thresholds recognizers use to tell gestures
+// apart. Zero fields fall back to the package defaults, such as
+// [DefaultTouchSlop] and [DefaultLongPressTimeout].
+//
+// Longer long-press timeouts help users with motor impairments; kiosks and
+// large touch screens often need a bigger touch slop.
+type GestureSettings struct {
+	// TouchSlop is how far, in logical pixels, a pointer may move before a
+	// tap or long press is abandoned and a drag can win.
+	TouchSlop float64
+	// LongPressTimeout is how long a pointer must stay down to count as a
+	// long press.
+	LongPressTimeout time.Duration
+	// DoubleTapTimeout is the longest gap between taps of a double tap.
+	DoubleTapTimeout time.Duration
+	// DoubleTapSlop is the farthest apart, in logical pixels, the taps of a
+	// double tap may land.
+	DoubleTapSlop float64
+}
+
+// Merge returns s with its zero fields taken from fallback.
+func (s GestureSettings) Merge(fallback GestureSettings) GestureSettings {
+	if s.TouchSlop <= 0 {
+		s.TouchSlop = fallback.TouchSlop
+	}
+	if s.LongPressTimeout <= 0 {
+		s.LongPressTimeout = fallback.LongPressTimeout
+	}
+	if s.DoubleTapTimeout <= 0 {
+		s.DoubleTapTimeout = fallback.DoubleTapTimeout
+	}
+	if s.DoubleTapSlop <= 0 {
+		s.DoubleTapSlop = fallback.DoubleTapSlop
+	}
+	return s
+}
+
+func (s GestureSettings) touchSlop() float64 {
+	if s.TouchSlop > 0 {
+		return s.TouchSlop
+	}
+	return DefaultTouchSlop
+}
+
+func (s GestureSettings) longPressTimeout() time.Duration {
+	if s.LongPressTimeout > 0 {
+		return s.LongPressTimeout
+	}
+	return DefaultLongPressTimeout
+}
+
+func (s GestureSettings) doubleTapTimeout() time.Duration {
+	if s.DoubleTapTimeout > 0 {
+		return s.DoubleTapTimeout
+	}
+	return DefaultDoubleTapTimeout
+}
+
+func (s GestureSettings) doubleTapSlop() float64 {
+	if s.DoubleTapSlop > 0 {
+		return s.DoubleTapSlop
+	}
+	return DefaultDoubleTapSlop
+}
+
+var (
+	platformSettingsMu     sync.Mutex
+	platformSettings       GestureSettings
+	platformSettingsLoaded bool
+)
+
+// PlatformGestureSettings returns the device's gesture settings from
+// [platform.GetViewConfiguration]. The platform is asked once; fields it
+// doesn't report are left zero so recognizers use the package defaults.
+func PlatformGestureSettings() GestureSettings {
+	platformSettingsMu.Lock()
+	defer platformSettingsMu.Unlock()
+	if !platformSettingsLoaded {
+		config, err := platform.GetViewConfiguration()
+		if err != nil {
+			// Try again next time rather than caching a transient failure.
+			return GestureSettings{}
+		}
+		platformSettings = GestureSettings{
+			TouchSlop:        config.TouchSlop,
+			LongPressTimeout: config.LongPressTimeout,
+			DoubleTapTimeout: config.DoubleTapTimeout,
+		}
+		platformSettingsLoaded = true
+	}
+	return platformSettings
+}
@@ -136,6 +136,13 @@ type Canvas interface {
 	// DrawPath draws a path with the provided paint.
 	DrawPath(path *Path, paint Paint)
 
+	// DrawVertices draws a triangle mesh in a single call. The paint's color
+	// or gradient is the source and the vertex colors, if any, are the
+	// destination of mode; BlendModeDst uses the vertex colors alone and
+	// BlendModeModulate multiplies them with the paint. The paint's style
+	// and stroke settings are ignored. No-op if vertices fail Validate.
+	DrawVertices(vertices *Vertices, mode BlendMode, paint Paint)
+
 	// DrawRectShadow draws a shadow behind a rectangle.
 	DrawRectShadow(rect Rect, shadow BoxShadow)
 
@@ -0,0 +1,110 @@
+package graphics
+
+import "math"
+
+// Lighten returns the color with its perceived lightness raised by amount
+// (0-1, where 1 is the full range from black to white). Lightness is
+// adjusted in the Oklab space, so equal amounts look equally strong across
+// hues and the hue doesn't drift. Alpha is kept.
+func (c Color) Lighten(amount float64) Color {
+	l, a, b := c.oklab()
+	return colorFromOklab(clamp01(l+amount), a, b, c.Alpha())
+}
+
+// Darken returns the color with its perceived lightness lowered by amount
+// (0-1). See [Color.Lighten].
+func (c Color) Darken(amount float64) Color {
+	return c.Lighten(-amount)
+}
+
+// Blend mixes c toward other by t (0 returns c, 1 returns other).
+// Components are interpolated in the Oklab space, which avoids the muddy
+// midpoints of mixing sRGB values; alpha is interpolated linearly.
+func (c Color) Blend(other Color, t float64) Color {
+	t = clamp01(t)
+	l1, a1, b1 := c.oklab()
+	l2, a2, b2 := other.oklab()
+	return colorFromOklab(
+		l1+(l2-l1)*t,
+		a1+(a2-a1)*t,
+		b1+(b2-b1)*t,
+		c.Alpha()+(other.Alpha()-c.Alpha())*t,
+	)
+}
+
+// Over composites c on top of background with source-over blending, the
+// way the canvas draws it, and returns the resulting color. Use it to find
+// the color a translucent overlay actually shows before measuring contrast.
+func (c Color) Over(background Color) Color {
+	r, g, b, a := c.RGBAF()
+	br, bg, bb, ba := background.RGBAF()
+	outA := a + ba*(1-a)
+	if outA == 0 {
+		return ColorTransparent
+	}
+	mix := func(fg, bgc float64) uint8 {
+		return uint8(math.Round(clamp01((fg*a+bgc*ba*(1-a))/outA) * maxByte))
+	}
+	return RGBA8(mix(r, br), mix(g, bg), mix(b, bb), alpha01ToByte(outA))
+}
+
+// oklab returns the color's Oklab lightness (0-1) and a, b components.
+// See https://bottosson.github.io/posts/oklab/.
+func (c Color) oklab() (l, a, b float64) {
+	r, g, bl, _ := c.RGBAF()
+	r, g, bl = srgbToLinear(r), srgbToLinear(g), srgbToLinear(bl)
+
+	lms0 := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*bl)
+	lms1 := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*bl)
+	lms2 := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*bl)
+
+	return 0.2104542553*lms0 + 0.7936177850*lms1 - 0.0040720468*lms2,
+		1.9779984951*lms0 - 2.4285922050*lms1 + 0.4505937099*lms2,
+		0.0259040371*lms0 + 0.7827717662*lms1 - 0.8086757660*lms2
+}
+
+// colorFromOklab converts Oklab components to an sRGB color. Colors outside
+// the sRGB gamut keep their lightness and hue and lose chroma until they fit,
+// so lightening a saturated color all the way reaches white.
+func colorFromOklab(l, a, b, alpha float64) Color {
+	if l <= 0 || l >= 1 {
+		a, b = 0, 0
+	}
+	r, g, bl := oklabToLinear(l, a, b)
+	if !inGamut(r, g, bl) {
+		lo, hi := 0.0, 1.0
+		for range 20 {
+			mid := (lo + hi) / 2
+			if r2, g2, b2 := oklabToLinear(l, a*mid, b*mid); inGamut(r2, g2, b2) {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		r, g, bl = oklabToLinear(l, a*lo, b*lo)
+	}
+
+	toByte := func(v float64) uint8 {
+		return uint8(math.Round(clamp01(linearToSRGB(clamp01(v))) * maxByte))
+	}
+	return RGBA8(toByte(r), toByte(g), toByte(bl), alpha01ToByte(alpha))
+}
+
+// oklabToLinear converts Oklab components to linear sRGB.
+func oklabToLinear(l, a, b float64) (r, g, bl float64) {
+	lms0 := l + 0.3963377774*a + 0.2158037573*b
+	lms1 := l - 0.1055613458*a - 0.0638541728*b
+	lms2 := l - 0.0894841775*a - 1.2914855480*b
+	lms0, lms1, lms2 = lms0*lms0*lms0, lms1*lms1*lms1, lms2*lms2*lms2
+
+	return 4.0767416621*lms0 - 3.3077115913*lms1 + 0.2309699292*lms2,
+		-1.2684380046*lms0 + 2.6097574011*lms1 - 0.3413193965*lms2,
+		-0.0041960863*lms0 - 0.7034186147*lms1 + 1.7076147010*lms2
+}
+
+// inGamut reports whether linear sRGB components are displayable, allowing
+// for rounding error.
+func inGamut(r, g, b float64) bool {
+	const eps = 1e-4
+	return r >= -eps && r <= 1+eps && g >= -eps && g <= 1+eps && b >= -eps && b <= 1+eps
+}
@@ -0,0 +1,59 @@
+package graphics
+
+import "math"
+
+// Luminance returns the WCAG relative luminance of the color, from 0.0
+// (black) to 1.0 (white). Alpha is ignored; composite translucent colors
+// with [Color.Over] first.
+func (c Color) Luminance() float64 {
+	r, g, b, _ := c.RGBAF()
+	return 0.2126*srgbToLinear(r) + 0.7152*srgbToLinear(g) + 0.0722*srgbToLinear(b)
+}
+
+// ContrastRatio returns the WCAG 2.1 contrast ratio between two colors,
+// from 1 (identical luminance) to 21 (black on white). The order of the
+// arguments doesn't matter. WCAG AA asks for 4.5 for normal text and 3 for
+// large text.
+func ContrastRatio(a, b Color) float64 {
+	la, lb := a.Luminance(), b.Luminance()
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// BestOnColor returns the candidate with the most contrast against
+// background, for text and icons drawn on it. With no candidates it picks
+// black or white. Pass a scheme's on-colors to stay on theme:
+//
+//	fg := graphics.BestOnColor(avatarColor, colors.OnPrimary, colors.OnSurface)
+//
+// Ties go to the earlier candidate.
+func BestOnColor(background Color, candidates ...Color) Color {
+	if len(candidates) == 0 {
+		candidates = []Color{ColorBlack, ColorWhite}
+	}
+	best, bestRatio := candidates[0], ContrastRatio(candidates[0], background)
+	for _, c := range candidates[1:] {
+		if ratio := ContrastRatio(c, background); ratio > bestRatio {
+			best, bestRatio = c, ratio
+		}
+	}
+	return best
+}
+
+// srgbToLinear converts a gamma-encoded sRGB component (0-1) to linear light.
+func srgbToLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB converts a linear light component (0-1) to gamma-encoded sRGB.
+func linearToSRGB(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
@@ -0,0 +1,101 @@
+package graphics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestContrastRatio_Extremes(t *testing.T) {
+	if got := ContrastRatio(ColorBlack, ColorWhite); math.Abs(got-21) > 0.01 {
+		t.Errorf("black on white: expected 21, got %.3f", got)
+	}
+	if got := ContrastRatio(ColorWhite, ColorBlack); math.Abs(got-21) > 0.01 {
+		t.Errorf("white on black: expected 21, got %.3f", got)
+	}
+	if got := ContrastRatio(ColorRed, ColorRed); got != 1 {
+		t.Errorf("same color: expected 1, got %.3f", got)
+	}
+	// #767676 is the lightest gray that passes AA on white.
+	if got := ContrastRatio(RGB(0x76, 0x76, 0x76), ColorWhite); got < 4.5 || got > 4.6 {
+		t.Errorf("#767676 on white: expected ~4.54, got %.3f", got)
+	}
+}
+
+func TestBestOnColor(t *testing.T) {
+	tests := []struct {
+		name       string
+		background Color
+		candidates []Color
+		want       Color
+	}{
+		{"dark background", RGB(0x1E, 0x3A, 0x8A), nil, ColorWhite},
+		{"light background", RGB(0xFD, 0xE6, 0x8A), nil, ColorBlack},
+		{"saturated yellow", RGB(0xFF, 0xEB, 0x3B), nil, ColorBlack},
+		{"scheme on-colors", RGB(0x20, 0x20, 0x20), []Color{RGB(0x30, 0x30, 0x30), RGB(0xEE, 0xEE, 0xEE)}, RGB(0xEE, 0xEE, 0xEE)},
+		{"tie keeps first", ColorBlack, []Color{ColorWhite, ColorWhite.WithAlpha8(0x80)}, ColorWhite},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BestOnColor(tt.background, tt.candidates...); got != tt.want {
+				t.Errorf("expected %#08x, got %#08x", uint32(tt.want), uint32(got))
+			}
+		})
+	}
+}
+
+func TestColor_LightenDarken(t *testing.T) {
+	blue := RGB(0x21, 0x96, 0xF3).WithAlpha(0.5)
+
+	lighter := blue.Lighten(0.1)
+	if lighter.Luminance() <= blue.Luminance() {
+		t.Errorf("expected Lighten to raise luminance: %#08x -> %#08x", uint32(blue), uint32(lighter))
+	}
+	darker := blue.Darken(0.1)
+	if darker.Luminance() >= blue.Luminance() {
+		t.Errorf("expected Darken to lower luminance: %#08x -> %#08x", uint32(blue), uint32(darker))
+	}
+	if lighter.Alpha() != blue.Alpha() || darker.Alpha() != blue.Alpha() {
+		t.Error("expected alpha to be kept")
+	}
+	if got := blue.Lighten(0); got != blue {
+		t.Errorf("expected Lighten(0) to round-trip, got %#08x", uint32(got))
+	}
+	if got := blue.Lighten(1); got != ColorWhite.WithAlpha(0.5) {
+		t.Errorf("expected Lighten(1) to reach white, got %#08x", uint32(got))
+	}
+	if got := blue.Darken(1); got != ColorBlack.WithAlpha(0.5) {
+		t.Errorf("expected Darken(1) to reach black, got %#08x", uint32(got))
+	}
+}
+
+func TestColor_Blend(t *testing.T) {
+	if got := ColorRed.Blend(ColorBlue, 0); got != ColorRed {
+		t.Errorf("expected t=0 to return c, got %#08x", uint32(got))
+	}
+	if got := ColorRed.Blend(ColorBlue, 1); got != ColorBlue {
+		t.Errorf("expected t=1 to return other, got %#08x", uint32(got))
+	}
+	// Black and white meet at a neutral gray, with no hue introduced.
+	mid := ColorBlack.Blend(ColorWhite, 0.5)
+	if r, g, b, _ := mid.RGBAF(); r != g || g != b || r == 0 || r == 1 {
+		t.Errorf("expected a neutral gray midpoint, got %#08x", uint32(mid))
+	}
+	if got := ColorBlack.Blend(ColorTransparent, 0.5).Alpha(); math.Abs(got-0.5) > 0.01 {
+		t.Errorf("expected alpha 0.5, got %.3f", got)
+	}
+}
+
+func TestColor_Over(t *testing.T) {
+	if got := ColorBlack.WithAlpha8(0x80).Over(ColorWhite); got != RGB(0x7F, 0x7F, 0x7F) {
+		t.Errorf("expected #7F7F7F, got %#08x", uint32(got))
+	}
+	if got := ColorRed.Over(ColorBlue); got != ColorRed {
+		t.Errorf("expected an opaque color to cover the background, got %#08x", uint32(got))
+	}
+	if got := ColorTransparent.Over(ColorTransparent); got != ColorTransparent {
+		t.Errorf("expected transparent, got %#08x", uint32(got))
+	}
+	if got := ColorWhite.WithAlpha(0.5).Over(ColorTransparent); got != ColorWhite.WithAlpha(0.5) {
+		t.Errorf("expected the color over nothing to be unchanged, got %#08x", uint32(got))
+	}
+}
@@ -184,6 +184,13 @@ func (c *recordingCanvas) DrawPath(path *Path, paint Paint) {
 	c.recorder.append(opPath{path: CopyPath(path), paint: paint})
 }
 
+func (c *recordingCanvas) DrawVertices(vertices *Vertices, mode BlendMode, paint Paint) {
+	if vertices.Validate() != nil {
+		return
+	}
+	c.recorder.append(opVertices{vertices: copyVertices(vertices), mode: mode, paint: paint})
+}
+
 func (c *recordingCanvas) DrawRectShadow(rect Rect, shadow BoxShadow) {
 	c.recorder.append(opRectShadow{rect: rect, shadow: shadow})
 }
@@ -437,6 +444,16 @@ func (op opPath) execute(canvas Canvas) {
 	canvas.DrawPath(op.path, op.paint)
 }
 
+type opVertices struct {
+	vertices *Vertices
+	mode     BlendMode
+	paint    Paint
+}
+
+func (op opVertices) execute(canvas Canvas) {
+	canvas.DrawVertices(op.vertices, op.mode, op.paint)
+}
+
 type opRectShadow struct {
 	rect   Rect
 	shadow BoxShadow
@@ -106,9 +106,14 @@ func (d *DisplayList) replayBatched(sc *SkiaCanvas) {
 		case opPath:
 			flush()
 			sc.DrawPath(o.path, o.paint)
+		case opVertices:
+			flush()
+			sc.DrawVertices(o.vertices, o.mode, o.paint)
 
-		// Platform view ops: no-op on SkiaCanvas, skip entirely
+		// Platform view ops: only texture-composited views draw on SkiaCanvas
 		case opEmbedPlatformView:
+			flush()
+			sc.EmbedPlatformView(o.viewID, o.size)
 		case opOccludePlatformViews:
 
 		default:
@@ -33,4 +33,13 @@
 //	paint.Color = graphics.ColorBlue
 //	paint.Style = graphics.PaintStyleStroke
 //	paint.StrokeWidth = 2
+//
+// # Meshes
+//
+// DrawVertices draws a triangle mesh with per-vertex colors in one call,
+// for heatmaps, mesh gradients, and projected 3D shapes. NewMeshGradient
+// builds a mesh from a grid of colors:
+//
+//	mesh := graphics.NewMeshGradient(rect, columns, colors)
+//	canvas.DrawVertices(mesh, graphics.BlendModeDst, graphics.DefaultPaint())
 package graphics
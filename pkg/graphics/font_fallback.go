@@ -0,0 +1,110 @@
+package graphics
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/go-drift/drift/pkg/skia"
+)
+
+// FontScript identifies the writing system a fallback font family is
+// registered for with [FontManager.SetFontFallback].
+type FontScript int
+
+const (
+	// FontScriptDefault fallbacks are tried first, for text in any script.
+	FontScriptDefault FontScript = iota
+	// FontScriptCJK covers Chinese, Japanese, and Korean text.
+	FontScriptCJK
+	// FontScriptArabic covers Arabic, Persian, and Urdu text.
+	FontScriptArabic
+	// FontScriptHebrew covers Hebrew text.
+	FontScriptHebrew
+	// FontScriptDevanagari covers Hindi, Marathi, and Nepali text.
+	FontScriptDevanagari
+	// FontScriptThai covers Thai text.
+	FontScriptThai
+	// FontScriptEmoji covers emoji. Its fallbacks are tried last, before the
+	// platform color emoji font (Apple Color Emoji on iOS, Noto Color Emoji
+	// on Android), which is always available as the final fallback.
+	FontScriptEmoji
+)
+
+// String returns a human-readable representation of the font script.
+func (s FontScript) String() string {
+	switch s {
+	case FontScriptDefault:
+		return "default"
+	case FontScriptCJK:
+		return "cjk"
+	case FontScriptArabic:
+		return "arabic"
+	case FontScriptHebrew:
+		return "hebrew"
+	case FontScriptDevanagari:
+		return "devanagari"
+	case FontScriptThai:
+		return "thai"
+	case FontScriptEmoji:
+		return "emoji"
+	default:
+		return fmt.Sprintf("FontScript(%d)", int(s))
+	}
+}
+
+// SetFontFallback sets the font families tried, in order, for characters
+// of script that the text's own font family has no glyph for, instead of
+// drawing them as empty boxes. Families may be system families or fonts
+// added with [FontManager.RegisterFont]. Calling it again for the same
+// script replaces its families; no families removes them.
+//
+//	manager.SetFontFallback(graphics.FontScriptCJK, "Noto Sans JP")
+//	manager.SetFontFallback(graphics.FontScriptArabic, "Noto Naskh Arabic")
+//
+// The fallback chain is the same for all text: default fallbacks first,
+// then each script in the order of the FontScript constants, and emoji
+// last. A text style can also list its own fallbacks in FontFamily,
+// separated by commas, which are tried before the chain.
+func (m *FontManager) SetFontFallback(script FontScript, families ...string) {
+	m.mu.Lock()
+	if m.fallbacks == nil {
+		m.fallbacks = make(map[FontScript][]string)
+	}
+	if len(families) == 0 {
+		delete(m.fallbacks, script)
+	} else {
+		m.fallbacks[script] = slices.Clone(families)
+	}
+	chain := m.fallbackChainLocked()
+	m.mu.Unlock()
+
+	skia.SetFontFallbacks(chain)
+	PurgeTextLayoutCache()
+}
+
+// FontFallbacks returns the fallback font families in the order they are
+// tried. It does not include the platform color emoji font.
+func (m *FontManager) FontFallbacks() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.fallbackChainLocked()
+}
+
+// fallbackChainLocked flattens the per-script fallbacks into the order they
+// are tried. Caller must hold mu.
+func (m *FontManager) fallbackChainLocked() []string {
+	scripts := make([]FontScript, 0, len(m.fallbacks))
+	for script := range m.fallbacks {
+		scripts = append(scripts, script)
+	}
+	slices.Sort(scripts)
+	var chain []string
+	for _, script := range scripts {
+		for _, family := range m.fallbacks[script] {
+			if !slices.Contains(chain, family) {
+				chain = append(chain, family)
+			}
+		}
+	}
+	return chain
+}
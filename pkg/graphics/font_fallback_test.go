@@ -0,0 +1,26 @@
+package graphics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFontManager_FontFallbacks(t *testing.T) {
+	manager, _ := NewFontManager()
+
+	manager.SetFontFallback(FontScriptEmoji, "Noto Emoji")
+	manager.SetFontFallback(FontScriptArabic, "Noto Naskh Arabic")
+	manager.SetFontFallback(FontScriptCJK, "Noto Sans JP", "Noto Sans SC")
+	manager.SetFontFallback(FontScriptDefault, "Noto Sans", "Noto Sans JP")
+
+	want := []string{"Noto Sans", "Noto Sans JP", "Noto Sans SC", "Noto Naskh Arabic", "Noto Emoji"}
+	if got := manager.FontFallbacks(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FontFallbacks() = %v, want %v", got, want)
+	}
+
+	manager.SetFontFallback(FontScriptCJK)
+	want = []string{"Noto Sans", "Noto Sans JP", "Noto Naskh Arabic", "Noto Emoji"}
+	if got := manager.FontFallbacks(); !reflect.DeepEqual(got, want) {
+		t.Errorf("after clearing CJK, FontFallbacks() = %v, want %v", got, want)
+	}
+}
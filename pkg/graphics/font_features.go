@@ -0,0 +1,145 @@
+package graphics
+
+import (
+	"iter"
+	"strconv"
+	"strings"
+)
+
+// FontVariation sets a variable font axis, such as "wght" (weight), "wdth"
+// (width), or "slnt" (slant), to a value within the range the font supports.
+type FontVariation struct {
+	// Axis is the four-letter axis tag.
+	Axis string
+	// Value is the axis coordinate, for example 450 for "wght".
+	Value float64
+}
+
+// FontFeature sets an OpenType feature, such as "tnum" (tabular figures),
+// "liga" (standard ligatures), or "smcp" (small capitals).
+type FontFeature struct {
+	// Tag is the four-letter feature tag.
+	Tag string
+	// Value is 1 to enable the feature and 0 to disable it. Features with
+	// alternates, such as "salt" or "ss01", take the alternate's index.
+	Value int
+}
+
+// FeatureOn returns a feature setting that enables tag.
+func FeatureOn(tag string) FontFeature {
+	return FontFeature{Tag: tag, Value: 1}
+}
+
+// FeatureOff returns a feature setting that disables tag, for features a
+// font turns on by default such as "liga".
+func FeatureOff(tag string) FontFeature {
+	return FontFeature{Tag: tag, Value: 0}
+}
+
+// FontVariations holds variable font axis settings in a comparable form,
+// so text styles that use them can still be compared and cached. Build it
+// with [Variations]; the zero value uses the font's default instance.
+type FontVariations string
+
+// Variations encodes variable font axis settings. Settings with a tag that
+// is not four characters long are ignored.
+//
+//	graphics.TextStyle{
+//	    FontFamily:     "Roboto Flex",
+//	    FontVariations: graphics.Variations(graphics.FontVariation{Axis: "wght", Value: 450}),
+//	}
+func Variations(axes ...FontVariation) FontVariations {
+	var b strings.Builder
+	for _, axis := range axes {
+		if !validFontTag(axis.Axis) {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(axis.Axis)
+		b.WriteByte('=')
+		b.WriteString(strconv.FormatFloat(axis.Value, 'g', -1, 64))
+	}
+	return FontVariations(b.String())
+}
+
+// Axes returns the decoded axis settings.
+func (v FontVariations) Axes() []FontVariation {
+	var axes []FontVariation
+	for tag, value := range fontSettings(string(v)) {
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			axes = append(axes, FontVariation{Axis: tag, Value: number})
+		}
+	}
+	return axes
+}
+
+// FontFeatures holds OpenType feature settings in a comparable form, so text
+// styles that use them can still be compared and cached. Build it with
+// [Features]; the zero value uses the font's default features.
+type FontFeatures string
+
+// Features encodes OpenType feature settings. Settings with a tag that is
+// not four characters long are ignored.
+//
+//	graphics.TextStyle{FontFeatures: graphics.Features(graphics.FeatureOn("tnum"))}
+func Features(features ...FontFeature) FontFeatures {
+	var b strings.Builder
+	for _, feature := range features {
+		if !validFontTag(feature.Tag) {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(feature.Tag)
+		b.WriteByte('=')
+		b.WriteString(strconv.Itoa(feature.Value))
+	}
+	return FontFeatures(b.String())
+}
+
+// List returns the decoded feature settings.
+func (f FontFeatures) List() []FontFeature {
+	var features []FontFeature
+	for tag, value := range fontSettings(string(f)) {
+		if number, err := strconv.Atoi(value); err == nil {
+			features = append(features, FontFeature{Tag: tag, Value: number})
+		}
+	}
+	return features
+}
+
+// fontSettings yields the tag and value of each "tag=value" entry in an
+// encoded settings list.
+func fontSettings(encoded string) iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		if encoded == "" {
+			return
+		}
+		for entry := range strings.SplitSeq(encoded, ",") {
+			tag, value, ok := strings.Cut(entry, "=")
+			if !ok || !validFontTag(tag) {
+				continue
+			}
+			if !yield(tag, value) {
+				return
+			}
+		}
+	}
+}
+
+// validFontTag reports whether tag is a four-character OpenType tag made of
+// printable ASCII that can't be confused with the encoding's separators.
+func validFontTag(tag string) bool {
+	if len(tag) != 4 {
+		return false
+	}
+	for i := range len(tag) {
+		if c := tag[i]; c < 0x20 || c > 0x7e || c == ',' || c == '=' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package graphics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVariations_RoundTrip(t *testing.T) {
+	v := Variations(
+		FontVariation{Axis: "wght", Value: 450},
+		FontVariation{Axis: "bad", Value: 1}, // not a four-letter tag
+		FontVariation{Axis: "slnt", Value: -7.5},
+	)
+	if v != "wght=450,slnt=-7.5" {
+		t.Errorf("Variations = %q", v)
+	}
+	want := []FontVariation{{Axis: "wght", Value: 450}, {Axis: "slnt", Value: -7.5}}
+	if got := v.Axes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Axes() = %v, want %v", got, want)
+	}
+	if Variations() != "" || FontVariations("").Axes() != nil {
+		t.Error("expected no axes to encode and decode as empty")
+	}
+}
+
+func TestFeatures_RoundTrip(t *testing.T) {
+	f := Features(FeatureOn("tnum"), FeatureOff("liga"), FontFeature{Tag: "ss0,", Value: 1}, FontFeature{Tag: "salt", Value: 2})
+	if f != "tnum=1,liga=0,salt=2" {
+		t.Errorf("Features = %q", f)
+	}
+	want := []FontFeature{{Tag: "tnum", Value: 1}, {Tag: "liga", Value: 0}, {Tag: "salt", Value: 2}}
+	if got := f.List(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List() = %v, want %v", got, want)
+	}
+}
+
+func TestSpanStyle_InheritsFontSettings(t *testing.T) {
+	parent := SpanStyle{FontFeatures: Features(FeatureOn("tnum")), FontVariations: Variations(FontVariation{Axis: "wght", Value: 350})}
+	child := SpanStyle{FontFeatures: Features(FeatureOn("smcp"))}.mergeFrom(parent)
+	if child.FontFeatures != Features(FeatureOn("smcp")) {
+		t.Errorf("expected the child's features to replace the parent's, got %q", child.FontFeatures)
+	}
+	if child.FontVariations != parent.FontVariations {
+		t.Errorf("expected variations to be inherited, got %q", child.FontVariations)
+	}
+}
@@ -245,3 +245,34 @@ func (g *Gradient) Bounds(widgetRect Rect) Rect {
 	}
 	return widgetRect.Union(gradientRect)
 }
+
+// Rotated returns a copy of the gradient turned clockwise by radians. A
+// linear gradient's Start and End rotate about their midpoint; a radial
+// gradient is symmetric about its center and is returned unchanged.
+//
+// Rotation happens in Alignment coordinates, which stretch with the box, so
+// on a non-square box the gradient's apparent angle follows the aspect
+// ratio. Rotating a full turn returns to the original gradient, which makes
+// Rotated suitable for looping animations such as a spinning border.
+func (g *Gradient) Rotated(radians float64) *Gradient {
+	if g == nil {
+		return nil
+	}
+	rotated := *g
+	rotated.Linear.Stops = cloneGradientStops(g.Linear.Stops)
+	rotated.Radial.Stops = cloneGradientStops(g.Radial.Stops)
+	if g.Type == GradientTypeLinear {
+		center := Alignment{
+			X: (g.Linear.Start.X + g.Linear.End.X) / 2,
+			Y: (g.Linear.Start.Y + g.Linear.End.Y) / 2,
+		}
+		sin, cos := math.Sincos(radians)
+		rotate := func(a Alignment) Alignment {
+			dx, dy := a.X-center.X, a.Y-center.Y
+			return Alignment{X: center.X + dx*cos - dy*sin, Y: center.Y + dx*sin + dy*cos}
+		}
+		rotated.Linear.Start = rotate(g.Linear.Start)
+		rotated.Linear.End = rotate(g.Linear.End)
+	}
+	return &rotated
+}
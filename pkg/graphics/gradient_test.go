@@ -1,6 +1,7 @@
 package graphics
 
 import (
+	"math"
 	"testing"
 )
 
@@ -258,3 +259,43 @@ func TestPath_Bounds(t *testing.T) {
 		})
 	}
 }
+
+func TestGradient_Rotated(t *testing.T) {
+	stops := []GradientStop{
+		{Position: 0, Color: RGB(255, 0, 0)},
+		{Position: 1, Color: RGB(0, 0, 255)},
+	}
+	near := func(a, b Alignment) bool {
+		return math.Abs(a.X-b.X) < 1e-9 && math.Abs(a.Y-b.Y) < 1e-9
+	}
+
+	g := NewLinearGradient(AlignCenterLeft, AlignCenterRight, stops)
+	quarter := g.Rotated(math.Pi / 2)
+	if !near(quarter.Linear.Start, AlignTopCenter) || !near(quarter.Linear.End, AlignBottomCenter) {
+		t.Errorf("quarter turn = %v -> %v, want top center -> bottom center",
+			quarter.Linear.Start, quarter.Linear.End)
+	}
+	full := g.Rotated(2 * math.Pi)
+	if !near(full.Linear.Start, g.Linear.Start) || !near(full.Linear.End, g.Linear.End) {
+		t.Errorf("full turn = %v -> %v, want the original gradient", full.Linear.Start, full.Linear.End)
+	}
+
+	// Off-center gradients rotate about their own midpoint.
+	offset := NewLinearGradient(Alignment{X: 0, Y: 0}, Alignment{X: 1, Y: 0}, stops).Rotated(math.Pi)
+	if !near(offset.Linear.Start, Alignment{X: 1, Y: 0}) || !near(offset.Linear.End, Alignment{X: 0, Y: 0}) {
+		t.Errorf("half turn = %v -> %v, want endpoints swapped", offset.Linear.Start, offset.Linear.End)
+	}
+
+	quarter.Linear.Stops[0].Color = ColorWhite
+	if g.Linear.Stops[0].Color != RGB(255, 0, 0) {
+		t.Error("Rotated shares stops with the original")
+	}
+
+	radial := NewRadialGradient(AlignCenter, 1, stops)
+	if r := radial.Rotated(1); r.Radial.Center != radial.Radial.Center || r.Radial.Radius != 1 {
+		t.Errorf("radial rotated = %+v, want unchanged", r.Radial)
+	}
+	if (*Gradient)(nil).Rotated(1) != nil {
+		t.Error("nil gradient rotated should be nil")
+	}
+}
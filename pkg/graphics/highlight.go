@@ -0,0 +1,323 @@
+package graphics
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// TextHighlight styles a range of text, for example a search match.
+type TextHighlight struct {
+	// Start is the byte offset of the first highlighted byte.
+	Start int
+	// End is the byte offset just past the highlighted range.
+	End int
+	// BackgroundColor fills behind the highlighted text. Zero draws no fill.
+	BackgroundColor Color
+	// Color replaces the text color of the range. Zero keeps the text color.
+	Color Color
+	// FontWeight replaces the font weight of the range, for example
+	// [FontWeightBold] to embolden search matches. Zero keeps the weight.
+	FontWeight FontWeight
+	// Decoration draws a line through, under, or over the range. Zero keeps
+	// the text decoration.
+	Decoration TextDecoration
+}
+
+// Restyles reports whether the highlight changes how the glyphs in its range
+// are drawn. Text shapes such highlights into its paragraph; highlights that
+// only set BackgroundColor are painted behind the existing paragraph, so
+// changing them does not lay out the text again.
+func (h TextHighlight) Restyles() bool {
+	return h.Color != 0 || h.FontWeight != 0 || h.Decoration != 0
+}
+
+// HighlightsRestyle reports whether any of highlights restyles its range.
+// See [TextHighlight.Restyles].
+func HighlightsRestyle(highlights []TextHighlight) bool {
+	for _, h := range highlights {
+		if h.Restyles() {
+			return true
+		}
+	}
+	return false
+}
+
+// HighlightSpans splits text into style runs so that each highlight is drawn
+// with its style on top of base. Ranges are clamped to the text and snapped
+// to rune boundaries; where highlights overlap, the later one's fields win.
+func HighlightSpans(text string, base SpanStyle, highlights []TextHighlight) TextSpan {
+	root := TextSpan{Style: base}
+	if len(highlights) == 0 {
+		root.Text = text
+		return root
+	}
+
+	root.Children = highlightRuns(text, 0, len(text), highlightBounds(text, highlights), highlights)
+	return root
+}
+
+// HighlightTextSpan applies highlights to a span tree, with ranges given as
+// byte offsets into its [TextSpan.PlainText]. Spans that a highlight touches
+// are split so each highlighted run gets the highlight's style on top of the
+// style it inherits; the plain text is unchanged. Use it with
+// [LayoutRichText] for rich text search results.
+func HighlightTextSpan(span TextSpan, highlights []TextHighlight) TextSpan {
+	if len(highlights) == 0 {
+		return span
+	}
+	text := span.PlainText()
+	span, _ = highlightSpanTree(span, text, 0, highlightBounds(text, highlights), highlights)
+	return span
+}
+
+// highlightSpanTree applies highlights to span, whose text starts at offset
+// in the plain text of the whole tree, and returns the new span and the
+// offset just past it.
+func highlightSpanTree(span TextSpan, text string, offset int, bounds []int, highlights []TextHighlight) (TextSpan, int) {
+	var runs []TextSpan
+	if span.Text != "" {
+		end := offset + len(span.Text)
+		runs = highlightRuns(text, offset, end, bounds, highlights)
+		offset = end
+	}
+	var children []TextSpan
+	if len(runs) == 1 && runs[0].Style == (SpanStyle{}) {
+		runs = nil // untouched; keep the text on span itself
+	} else if len(runs) > 0 {
+		span.Text = ""
+		children = runs
+	}
+	if len(span.Children) > 0 {
+		if children == nil {
+			children = make([]TextSpan, 0, len(span.Children))
+		}
+		for _, child := range span.Children {
+			child, offset = highlightSpanTree(child, text, offset, bounds, highlights)
+			children = append(children, child)
+		}
+	}
+	if children != nil {
+		span.Children = children
+	}
+	return span, offset
+}
+
+// highlightBounds returns the sorted offsets in text where the active
+// highlights can change, including 0 and len(text).
+func highlightBounds(text string, highlights []TextHighlight) []int {
+	bounds := []int{0, len(text)}
+	for _, h := range highlights {
+		start, end := clampHighlight(text, h)
+		if start < end {
+			bounds = append(bounds, start, end)
+		}
+	}
+	slices.Sort(bounds)
+	return slices.Compact(bounds)
+}
+
+// highlightRuns splits text[from:to] at bounds into spans styled by the
+// highlights covering each piece, merging neighbours with equal styles.
+func highlightRuns(text string, from, to int, bounds []int, highlights []TextHighlight) []TextSpan {
+	var runs []TextSpan
+	for i := 0; i+1 < len(bounds); i++ {
+		start, end := max(bounds[i], from), min(bounds[i+1], to)
+		if start >= end {
+			continue
+		}
+		span := TextSpan{Text: text[start:end]}
+		for _, h := range highlights {
+			hs, he := clampHighlight(text, h)
+			if hs <= start && end <= he {
+				span.Style = h.overlay(span.Style)
+			}
+		}
+		// Merge with the previous run when the style did not change.
+		if n := len(runs); n > 0 && runs[n-1].Style == span.Style {
+			runs[n-1].Text += span.Text
+			continue
+		}
+		runs = append(runs, span)
+	}
+	return runs
+}
+
+// overlay returns style with the highlight's non-zero fields applied.
+func (h TextHighlight) overlay(style SpanStyle) SpanStyle {
+	if h.BackgroundColor != 0 {
+		style.BackgroundColor = h.BackgroundColor
+	}
+	if h.Color != 0 {
+		style.Color = h.Color
+	}
+	if h.FontWeight != 0 {
+		style.FontWeight = h.FontWeight
+	}
+	if h.Decoration != 0 {
+		style.Decoration = h.Decoration
+	}
+	return style
+}
+
+// LayoutHighlightedText lays out single-style text with highlighted ranges
+// drawn as separate style runs. Hyphenation and line breaking options apply
+// as in [LayoutTextWithOptions]; like [LayoutRichText], it does not apply
+// the style's Gradient or Shadow, or the ellipsis overflow modes.
+func LayoutHighlightedText(text string, style TextStyle, highlights []TextHighlight, manager *FontManager, opts ParagraphOptions) (*TextLayout, error) {
+	if manager != nil && style.FontFamily == "" {
+		style.FontFamily = manager.defaultName
+	}
+	base := SpanStyle{
+		Color:               style.Color,
+		FontFamily:          style.FontFamily,
+		FontSize:            style.FontSize,
+		FontWeight:          style.FontWeight,
+		FontStyle:           style.FontStyle,
+		LetterSpacing:       style.LetterSpacing,
+		Height:              style.Height,
+		WordSpacing:         style.WordSpacing,
+		Decoration:          style.Decoration,
+		DecorationColor:     style.DecorationColor,
+		DecorationStyle:     style.DecorationStyle,
+		DecorationThickness: style.DecorationThickness,
+		FontVariations:      style.FontVariations,
+		FontFeatures:        style.FontFeatures,
+	}
+	if base.FontSize <= 0 {
+		base.FontSize = defaultFontSize
+	}
+	span := HighlightSpans(text, base, highlights)
+	if opts.MaxWidth > 0 {
+		span.Text = prepareLineBreaks(span.Text, opts)
+		for i := range span.Children {
+			span.Children[i].Text = prepareLineBreaks(span.Children[i].Text, opts)
+		}
+	}
+	layout, err := LayoutRichText(span, SpanStyle{}, manager, opts)
+	if err != nil {
+		return nil, err
+	}
+	layout.Text = text
+	layout.Style = style
+	return layout, nil
+}
+
+// clampHighlight returns the highlight range clamped to text and snapped
+// outward to rune boundaries.
+func clampHighlight(text string, h TextHighlight) (int, int) {
+	start := max(h.Start, 0)
+	end := min(h.End, len(text))
+	for start > 0 && start < len(text) && !utf8.RuneStart(text[start]) {
+		start--
+	}
+	for end < len(text) && end > 0 && !utf8.RuneStart(text[end]) {
+		end++
+	}
+	return start, end
+}
+
+// SearchHighlighter finds matches of a query in text and returns them as
+// highlights, for rendering search results:
+//
+//	search := graphics.SearchHighlighter{Query: query, BackgroundColor: colors.TertiaryContainer}
+//	widgets.Text{Content: title, Highlights: search.Highlights(title)}
+//
+// By default matching ignores case and diacritics, so "cafe" matches "Café".
+type SearchHighlighter struct {
+	// Query is the text to find. An empty query matches nothing.
+	Query string
+	// CaseSensitive requires letter case to match.
+	CaseSensitive bool
+	// DiacriticSensitive requires accents and other combining marks to match.
+	DiacriticSensitive bool
+	// BackgroundColor fills behind each match.
+	BackgroundColor Color
+	// Color replaces the text color of each match. Zero keeps the text color.
+	Color Color
+	// FontWeight replaces the font weight of each match. Zero keeps the
+	// weight.
+	FontWeight FontWeight
+	// Decoration decorates each match, for example
+	// [TextDecorationUnderline]. Zero keeps the text decoration.
+	Decoration TextDecoration
+}
+
+// Highlights returns a highlight for every non-overlapping match of the
+// query in text, in order.
+func (s SearchHighlighter) Highlights(text string) []TextHighlight {
+	var highlights []TextHighlight
+	for _, match := range s.Matches(text) {
+		highlights = append(highlights, TextHighlight{
+			Start:           match[0],
+			End:             match[1],
+			BackgroundColor: s.BackgroundColor,
+			Color:           s.Color,
+			FontWeight:      s.FontWeight,
+			Decoration:      s.Decoration,
+		})
+	}
+	return highlights
+}
+
+// Matches returns the byte ranges [start, end) in text of every
+// non-overlapping match of the query, in order.
+func (s SearchHighlighter) Matches(text string) [][2]int {
+	query, _ := s.fold(s.Query)
+	if query == "" {
+		return nil
+	}
+	folded, offsets := s.fold(text)
+
+	var matches [][2]int
+	for from := 0; from <= len(folded)-len(query); {
+		i := strings.Index(folded[from:], query)
+		if i < 0 {
+			break
+		}
+		start := from + i
+		end := start + len(query)
+		matches = append(matches, [2]int{offsets[start], offsets[end]})
+		from = end
+	}
+	return matches
+}
+
+// fold normalizes text for matching and returns, for each byte of the
+// result plus one past the end, the offset of the original rune it came
+// from. A match in the folded text therefore maps back to whole runes.
+func (s SearchHighlighter) fold(text string) (string, []int) {
+	var b strings.Builder
+	offsets := make([]int, 0, len(text)+1)
+	for i, r := range text {
+		piece := string(r)
+		if !s.DiacriticSensitive {
+			piece = stripMarks(piece)
+		}
+		if !s.CaseSensitive {
+			piece = strings.ToLower(piece)
+		}
+		for range len(piece) {
+			offsets = append(offsets, i)
+		}
+		b.WriteString(piece)
+	}
+	offsets = append(offsets, len(text))
+	return b.String(), offsets
+}
+
+// stripMarks removes combining marks after canonical decomposition, turning
+// "é" into "e".
+func stripMarks(s string) string {
+	decomposed := norm.NFD.String(s)
+	var b strings.Builder
+	for _, r := range decomposed {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,112 @@
+package graphics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHighlightSpans_SplitsRuns(t *testing.T) {
+	base := SpanStyle{Color: RGB(0, 0, 0), FontSize: 14}
+	yellow := RGB(255, 235, 59)
+	red := RGB(255, 0, 0)
+
+	span := HighlightSpans("hello brave new world", base, []TextHighlight{
+		{Start: 6, End: 15, BackgroundColor: yellow},
+		{Start: 12, End: 15, BackgroundColor: yellow, Color: red},
+	})
+
+	if span.Style != base {
+		t.Errorf("root style = %+v, want base", span.Style)
+	}
+	want := []TextSpan{
+		{Text: "hello "},
+		{Text: "brave ", Style: SpanStyle{BackgroundColor: yellow}},
+		{Text: "new", Style: SpanStyle{BackgroundColor: yellow, Color: red}},
+		{Text: " world"},
+	}
+	if !reflect.DeepEqual(span.Children, want) {
+		t.Errorf("children = %+v, want %+v", span.Children, want)
+	}
+	if span.PlainText() != "hello brave new world" {
+		t.Errorf("plain text changed: %q", span.PlainText())
+	}
+}
+
+func TestHighlightSpans_ClampsRanges(t *testing.T) {
+	span := HighlightSpans("héllo", SpanStyle{}, []TextHighlight{
+		{Start: 2, End: 100, BackgroundColor: RGB(1, 2, 3)}, // starts inside "é"
+	})
+	if len(span.Children) != 2 || span.Children[0].Text != "h" || span.Children[1].Text != "éllo" {
+		t.Errorf("unexpected runs: %+v", span.Children)
+	}
+}
+
+func TestSearchHighlighter_Matches(t *testing.T) {
+	text := "Café au lait, CAFE noir"
+
+	got := SearchHighlighter{Query: "cafe"}.Matches(text)
+	want := [][2]int{{0, 5}, {15, 19}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("insensitive matches = %v, want %v", got, want)
+	}
+	if text[got[0][0]:got[0][1]] != "Café" {
+		t.Errorf("first match = %q, want %q", text[got[0][0]:got[0][1]], "Café")
+	}
+
+	if got := (SearchHighlighter{Query: "cafe", DiacriticSensitive: true}).Matches(text); !reflect.DeepEqual(got, [][2]int{{15, 19}}) {
+		t.Errorf("diacritic-sensitive matches = %v", got)
+	}
+	if got := (SearchHighlighter{Query: "Caf", CaseSensitive: true}).Matches(text); !reflect.DeepEqual(got, [][2]int{{0, 3}}) {
+		t.Errorf("case-sensitive matches = %v", got)
+	}
+	if got := (SearchHighlighter{}).Matches(text); got != nil {
+		t.Errorf("empty query matches = %v, want nil", got)
+	}
+}
+
+func TestHighlightTextSpan_SplitsTree(t *testing.T) {
+	red := RGB(255, 0, 0)
+	span := Spans(Span("hello "), Span("brave new").Italic(), Span(" world"))
+
+	got := HighlightTextSpan(span, []TextHighlight{
+		{Start: 3, End: 9, FontWeight: FontWeightBold},
+		{Start: 12, End: 15, Decoration: TextDecorationUnderline, Color: red},
+	})
+
+	bold := SpanStyle{FontWeight: FontWeightBold}
+	want := Spans(
+		TextSpan{Children: []TextSpan{{Text: "hel"}, {Text: "lo ", Style: bold}}},
+		TextSpan{Style: SpanStyle{FontStyle: FontStyleItalic}, Children: []TextSpan{
+			{Text: "bra", Style: bold},
+			{Text: "ve "},
+			{Text: "new", Style: SpanStyle{Decoration: TextDecorationUnderline, Color: red}},
+		}},
+		Span(" world"),
+	)
+	if !got.Equal(want) {
+		t.Errorf("HighlightTextSpan = %+v, want %+v", got, want)
+	}
+	if got.PlainText() != span.PlainText() {
+		t.Errorf("plain text changed: %q", got.PlainText())
+	}
+	if !HighlightTextSpan(span, nil).Equal(span) {
+		t.Error("expected no highlights to leave the span unchanged")
+	}
+}
+
+func TestTextHighlight_Restyles(t *testing.T) {
+	background := TextHighlight{Start: 0, End: 3, BackgroundColor: RGB(1, 2, 3)}
+	if background.Restyles() || HighlightsRestyle([]TextHighlight{background}) {
+		t.Error("expected a background-only highlight not to restyle")
+	}
+	underline := TextHighlight{Start: 0, End: 3, Decoration: TextDecorationUnderline}
+	if !HighlightsRestyle([]TextHighlight{background, underline}) {
+		t.Error("expected an underline highlight to restyle")
+	}
+
+	search := SearchHighlighter{Query: "a", FontWeight: FontWeightBold, Decoration: TextDecorationUnderline}
+	highlights := search.Highlights("banana")
+	if len(highlights) != 3 || highlights[0].FontWeight != FontWeightBold || highlights[0].Decoration != TextDecorationUnderline {
+		t.Errorf("unexpected search highlights %+v", highlights)
+	}
+}
@@ -0,0 +1,265 @@
+package graphics
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LineBreakStrictness controls how strictly line breaking rules are applied
+// to CJK text, following the CSS line-break property.
+type LineBreakStrictness int
+
+const (
+	// LineBreakAuto picks the strictness from the paragraph locale: strict
+	// for Japanese and normal otherwise.
+	LineBreakAuto LineBreakStrictness = iota
+	// LineBreakNormal uses the common line breaking rules.
+	LineBreakNormal
+	// LineBreakStrict forbids lines that start with small kana or the
+	// prolonged sound mark.
+	LineBreakStrict
+	// LineBreakLoose additionally allows breaks before iteration marks and
+	// CJK hyphens, for narrow columns.
+	LineBreakLoose
+)
+
+// String returns a human-readable representation of the line break strictness.
+func (s LineBreakStrictness) String() string {
+	switch s {
+	case LineBreakAuto:
+		return "auto"
+	case LineBreakNormal:
+		return "normal"
+	case LineBreakStrict:
+		return "strict"
+	case LineBreakLoose:
+		return "loose"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	softHyphen     = "\u00ad" // break opportunity shown as a hyphen when taken
+	wordJoiner     = "\u2060" // forbids a break
+	zeroWidthSpace = "\u200b" // allows a break
+)
+
+// Hyphenator finds the points where a single word may be hyphenated.
+type Hyphenator interface {
+	// Hyphenate returns the byte offsets within word, in ascending order,
+	// where a line may break with a hyphen.
+	Hyphenate(word string) []int
+}
+
+// HyphenatorFunc adapts a function to the [Hyphenator] interface.
+type HyphenatorFunc func(word string) []int
+
+// Hyphenate calls f(word).
+func (f HyphenatorFunc) Hyphenate(word string) []int {
+	return f(word)
+}
+
+var (
+	localeMu      sync.RWMutex
+	defaultLocale = "en"
+	hyphenators   = map[string]Hyphenator{}
+)
+
+// DefaultLocale returns the locale used for text layout when a paragraph does
+// not specify one.
+func DefaultLocale() string {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+	return defaultLocale
+}
+
+// SetDefaultLocale sets the BCP 47 language tag (for example "en-US" or
+// "ja") used for text layout when a paragraph does not specify one.
+func SetDefaultLocale(tag string) {
+	localeMu.Lock()
+	defer localeMu.Unlock()
+	defaultLocale = tag
+}
+
+// RegisterHyphenator installs the hyphenator for a language, identified by
+// its primary language subtag (for example "en" or "de"). Languages without
+// a registered hyphenator use a basic syllable heuristic.
+func RegisterHyphenator(language string, h Hyphenator) {
+	localeMu.Lock()
+	defer localeMu.Unlock()
+	if h == nil {
+		delete(hyphenators, strings.ToLower(language))
+		return
+	}
+	hyphenators[strings.ToLower(language)] = h
+}
+
+// localeLanguage returns the lowercase primary language subtag of a tag.
+func localeLanguage(tag string) string {
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	return strings.ToLower(tag)
+}
+
+func hyphenatorFor(language string) Hyphenator {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+	if h, ok := hyphenators[language]; ok {
+		return h
+	}
+	return HyphenatorFunc(syllableHyphenate)
+}
+
+// resolveStrictness resolves LineBreakAuto for the given language.
+func resolveStrictness(strictness LineBreakStrictness, language string) LineBreakStrictness {
+	if strictness != LineBreakAuto {
+		return strictness
+	}
+	if language == "ja" {
+		return LineBreakStrict
+	}
+	return LineBreakNormal
+}
+
+// prepareLineBreaks inserts invisible control characters that steer the
+// line breaker: soft hyphens at hyphenation points, word joiners before
+// characters that must not start a line in strict mode, and zero-width
+// spaces before characters that may start a line in loose mode.
+func prepareLineBreaks(text string, opts ParagraphOptions) string {
+	locale := opts.Locale
+	if locale == "" {
+		locale = DefaultLocale()
+	}
+	language := localeLanguage(locale)
+	strictness := resolveStrictness(opts.LineBreak, language)
+	if !opts.Hyphenate && strictness == LineBreakNormal {
+		return text
+	}
+
+	var hyphenator Hyphenator
+	if opts.Hyphenate {
+		hyphenator = hyphenatorFor(language)
+	}
+
+	var b strings.Builder
+	b.Grow(len(text) + len(text)/4)
+	wordStart := -1
+	flushWord := func(end int) {
+		if wordStart < 0 {
+			return
+		}
+		word := text[wordStart:end]
+		wordStart = -1
+		if hyphenator == nil {
+			b.WriteString(word)
+			return
+		}
+		last := 0
+		for _, at := range hyphenator.Hyphenate(word) {
+			if at <= last || at >= len(word) {
+				continue
+			}
+			b.WriteString(word[last:at])
+			b.WriteString(softHyphen)
+			last = at
+		}
+		b.WriteString(word[last:])
+	}
+
+	for i, r := range text {
+		if hyphenator != nil && isHyphenatableLetter(r) {
+			if wordStart < 0 {
+				wordStart = i
+			}
+			continue
+		}
+		flushWord(i)
+		if i > 0 {
+			switch {
+			case strictness == LineBreakStrict && isConditionalJapaneseStarter(r):
+				b.WriteString(wordJoiner)
+			case strictness == LineBreakLoose && isLooseBreakBefore(r):
+				b.WriteString(zeroWidthSpace)
+			}
+		}
+		b.WriteRune(r)
+	}
+	flushWord(len(text))
+	return b.String()
+}
+
+// isHyphenatableLetter reports whether r can be part of a hyphenated word.
+// CJK scripts break between characters and are never hyphenated.
+func isHyphenatableLetter(r rune) bool {
+	return unicode.IsLetter(r) && !unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// isConditionalJapaneseStarter reports whether r is a small kana or the
+// prolonged sound mark (Unicode line break class CJ), which strict line
+// breaking keeps off the start of a line.
+func isConditionalJapaneseStarter(r rune) bool {
+	switch r {
+	case 'ぁ', 'ぃ', 'ぅ', 'ぇ', 'ぉ', 'っ', 'ゃ', 'ゅ', 'ょ', 'ゎ', 'ゕ', 'ゖ',
+		'ァ', 'ィ', 'ゥ', 'ェ', 'ォ', 'ッ', 'ャ', 'ュ', 'ョ', 'ヮ', 'ヵ', 'ヶ', 'ー':
+		return true
+	}
+	return (r >= 'ㇰ' && r <= 'ㇿ') || (r >= 'ｧ' && r <= 'ｰ')
+}
+
+// isLooseBreakBefore reports whether loose line breaking allows a break
+// before r: iteration marks and CJK hyphens.
+func isLooseBreakBefore(r rune) bool {
+	switch r {
+	case '々', '〻', 'ゝ', 'ゞ', 'ヽ', 'ヾ', '‐', '–', '〜', '゠':
+		return true
+	}
+	return false
+}
+
+// syllableHyphenate is the fallback hyphenator. It splits words of six or
+// more letters between syllables using vowel-consonant patterns: before the
+// consonant in V-CV and between the consonants in VC-CV. At least three
+// letters are kept on each side of a break.
+func syllableHyphenate(word string) []int {
+	const minPrefix, minSuffix = 3, 3
+	n := utf8.RuneCountInString(word)
+	if n < minPrefix+minSuffix {
+		return nil
+	}
+	runes := make([]rune, 0, n)
+	offsets := make([]int, 0, n)
+	for i, r := range word {
+		runes = append(runes, unicode.ToLower(r))
+		offsets = append(offsets, i)
+	}
+
+	var points []int
+	for i := minPrefix; i <= n-minSuffix; i++ {
+		prev, cur := runes[i-1], runes[i]
+		next := runes[i+1]
+		switch {
+		case isVowel(prev) && !isVowel(cur) && isVowel(next):
+			// V-CV: break before a single consonant.
+		case i >= 2 && isVowel(runes[i-2]) && !isVowel(prev) && !isVowel(cur) && isVowel(next):
+			// VC-CV: break between two consonants.
+		default:
+			continue
+		}
+		points = append(points, offsets[i])
+	}
+	return points
+}
+
+func isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y',
+		'à', 'á', 'â', 'ä', 'è', 'é', 'ê', 'ë', 'ì', 'í', 'î', 'ï',
+		'ò', 'ó', 'ô', 'ö', 'ù', 'ú', 'û', 'ü':
+		return true
+	}
+	return false
+}
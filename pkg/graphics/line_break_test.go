@@ -0,0 +1,66 @@
+package graphics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrepareLineBreaks_NormalLeavesTextUnchanged(t *testing.T) {
+	text := "A paragraph of text とっても"
+	if got := prepareLineBreaks(text, ParagraphOptions{Locale: "en"}); got != text {
+		t.Errorf("expected unchanged text, got %q", got)
+	}
+}
+
+func TestPrepareLineBreaks_Hyphenate(t *testing.T) {
+	got := prepareLineBreaks("a remarkable idea", ParagraphOptions{Hyphenate: true, Locale: "en"})
+	if want := "a remar" + softHyphen + "kable idea"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if stripped := strings.ReplaceAll(got, softHyphen, ""); stripped != "a remarkable idea" {
+		t.Errorf("hyphenation changed visible text: %q", stripped)
+	}
+}
+
+func TestPrepareLineBreaks_RegisteredHyphenator(t *testing.T) {
+	RegisterHyphenator("xx", HyphenatorFunc(func(word string) []int {
+		if len(word) > 2 {
+			return []int{2}
+		}
+		return nil
+	}))
+	defer RegisterHyphenator("xx", nil)
+
+	got := prepareLineBreaks("abcd ef", ParagraphOptions{Hyphenate: true, Locale: "xx-YY"})
+	if want := "ab" + softHyphen + "cd ef"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPrepareLineBreaks_Strictness(t *testing.T) {
+	text := "ちょっと々"
+
+	strict := prepareLineBreaks(text, ParagraphOptions{Locale: "ja"})
+	if want := "ち" + wordJoiner + "ょ" + wordJoiner + "っと々"; strict != want {
+		t.Errorf("auto strictness for ja: got %q, want %q", strict, want)
+	}
+
+	loose := prepareLineBreaks(text, ParagraphOptions{Locale: "ja", LineBreak: LineBreakLoose})
+	if want := "ちょっと" + zeroWidthSpace + "々"; loose != want {
+		t.Errorf("loose: got %q, want %q", loose, want)
+	}
+
+	if normal := prepareLineBreaks(text, ParagraphOptions{Locale: "ja", LineBreak: LineBreakNormal}); normal != text {
+		t.Errorf("normal: expected unchanged text, got %q", normal)
+	}
+}
+
+func TestDefaultLocale(t *testing.T) {
+	previous := DefaultLocale()
+	defer SetDefaultLocale(previous)
+
+	SetDefaultLocale("ja-JP")
+	if got := prepareLineBreaks("ちょ", ParagraphOptions{}); got != "ち"+wordJoiner+"ょ" {
+		t.Errorf("expected strict breaking from the default locale, got %q", got)
+	}
+}
@@ -0,0 +1,30 @@
+package graphics
+
+import (
+	"image"
+	"sync/atomic"
+)
+
+var platformViewTextureSource atomic.Pointer[func(viewID int64) image.Image]
+
+// SetPlatformViewTextureSource installs the function canvases use to look up
+// the latest captured frame of a platform view composited as a texture.
+// The function returns nil for views that are not in texture mode, which
+// leaves them to be positioned natively. Pass nil to remove the source.
+func SetPlatformViewTextureSource(fn func(viewID int64) image.Image) {
+	if fn == nil {
+		platformViewTextureSource.Store(nil)
+		return
+	}
+	platformViewTextureSource.Store(&fn)
+}
+
+// PlatformViewTexture returns the latest captured frame of viewID, or nil if
+// the view is not composited as a texture.
+func PlatformViewTexture(viewID int64) image.Image {
+	fn := platformViewTextureSource.Load()
+	if fn == nil {
+		return nil
+	}
+	return (*fn)(viewID)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"math"
 	"runtime"
+	"slices"
 	"strings"
 
 	"github.com/go-drift/drift/pkg/skia"
@@ -54,6 +55,14 @@ const (
 // kNoDecoration=0, kUnderline=0x1, kOverline=0x2, kLineThrough=0x4.
 var decorationToSkia = [5]int{0, 0, 1, 2, 4}
 
+// skiaDecoration converts a TextDecoration to Skia's decoration bit.
+func skiaDecoration(d TextDecoration) int {
+	if int(d) >= 0 && int(d) < len(decorationToSkia) {
+		return decorationToSkia[d]
+	}
+	return 0
+}
+
 // TextDecorationStyle controls the appearance of decoration lines.
 type TextDecorationStyle int
 
@@ -87,7 +96,9 @@ const (
 //     [TextSpan.NoHeight], [TextSpan.NoBackground], [TextSpan.NoDecorationColor])
 //     for resetting inherited values.
 type SpanStyle struct {
-	Color           Color
+	Color Color
+	// FontFamily names the font family, optionally followed by fallback
+	// families separated by commas, as in [TextStyle].
 	FontFamily      string
 	FontSize        float64
 	FontWeight      FontWeight
@@ -98,7 +109,14 @@ type SpanStyle struct {
 	Decoration      TextDecoration
 	DecorationColor Color
 	DecorationStyle TextDecorationStyle
-	BackgroundColor Color
+	// DecorationThickness multiplies the font's default decoration
+	// thickness. 0 inherits; the root default is 1.
+	DecorationThickness float64
+	BackgroundColor     Color
+	// FontVariations and FontFeatures replace, rather than add to, the
+	// settings inherited from the parent, as in [TextStyle].
+	FontVariations FontVariations
+	FontFeatures   FontFeatures
 }
 
 // mergeFrom copies parent field values into s for any field that is zero-valued
@@ -140,6 +158,15 @@ func (s SpanStyle) mergeFrom(parent SpanStyle) SpanStyle {
 	if s.BackgroundColor == 0 {
 		s.BackgroundColor = parent.BackgroundColor
 	}
+	if s.DecorationThickness == 0 {
+		s.DecorationThickness = parent.DecorationThickness
+	}
+	if s.FontVariations == "" {
+		s.FontVariations = parent.FontVariations
+	}
+	if s.FontFeatures == "" {
+		s.FontFeatures = parent.FontFeatures
+	}
 	return s
 }
 
@@ -176,6 +203,13 @@ func Spans(children ...TextSpan) TextSpan {
 	return TextSpan{Children: children}
 }
 
+// Equal reports whether s and other have the same text and styles
+// throughout their trees.
+func (s TextSpan) Equal(other TextSpan) bool {
+	return s.Text == other.Text && s.Style == other.Style &&
+		slices.EqualFunc(s.Children, other.Children, TextSpan.Equal)
+}
+
 // WithChildren returns a copy with the given child spans. Useful for adding
 // children to a span that also carries its own text or style defaults.
 func (s TextSpan) WithChildren(children ...TextSpan) TextSpan {
@@ -274,6 +308,13 @@ func (s TextSpan) DecorationStyle(style TextDecorationStyle) TextSpan {
 	return s
 }
 
+// DecorationThickness returns a copy with the decoration thickness
+// multiplier set, for example 2 for a line twice the font's default.
+func (s TextSpan) DecorationThickness(multiplier float64) TextSpan {
+	s.Style.DecorationThickness = multiplier
+	return s
+}
+
 // LetterSpacing returns a copy with the specified letter spacing.
 func (s TextSpan) LetterSpacing(v float64) TextSpan {
 	s.Style.LetterSpacing = v
@@ -313,6 +354,18 @@ func (s TextSpan) NoHeight() TextSpan {
 	return s
 }
 
+// Features returns a copy with the given OpenType feature settings.
+func (s TextSpan) Features(features ...FontFeature) TextSpan {
+	s.Style.FontFeatures = Features(features...)
+	return s
+}
+
+// Variations returns a copy with the given variable font axis settings.
+func (s TextSpan) Variations(axes ...FontVariation) TextSpan {
+	s.Style.FontVariations = Variations(axes...)
+	return s
+}
+
 // Background returns a copy with the specified background color.
 func (s TextSpan) Background(c Color) TextSpan {
 	s.Style.BackgroundColor = c
@@ -378,29 +431,29 @@ func LayoutRichText(span TextSpan, baseStyle SpanStyle, manager *FontManager, op
 		if s.Height == explicitZero {
 			height = 0
 		}
-		decoration := 0
-		if int(s.Decoration) >= 0 && int(s.Decoration) < len(decorationToSkia) {
-			decoration = decorationToSkia[s.Decoration]
-		}
+		decoration := skiaDecoration(s.Decoration)
 		decorationColor := uint32(s.DecorationColor)
 		if s.DecorationColor == noDecorationColor {
 			decorationColor = 0
 		}
 		skiaSpans[i] = skia.TextSpanData{
-			Text:            f.text,
-			Family:          s.FontFamily,
-			Size:            float32(s.FontSize),
-			Weight:          int(s.FontWeight),
-			Style:           fontStyleBridgeValue(s.FontStyle),
-			Color:           uint32(s.Color),
-			Decoration:      decoration,
-			DecorationColor: decorationColor,
-			DecorationStyle: max(int(s.DecorationStyle)-1, 0),
-			LetterSpacing:   letterSpacing,
-			WordSpacing:     wordSpacing,
-			Height:          height,
-			HasBackground:   s.BackgroundColor != 0 && s.BackgroundColor != noBackgroundColor,
-			BackgroundColor: uint32(s.BackgroundColor),
+			Text:                f.text,
+			Family:              s.FontFamily,
+			Size:                float32(opts.fontSize(s.FontSize)),
+			Weight:              int(s.FontWeight),
+			Style:               fontStyleBridgeValue(s.FontStyle),
+			Color:               uint32(s.Color),
+			Decoration:          decoration,
+			DecorationColor:     decorationColor,
+			DecorationStyle:     max(int(s.DecorationStyle)-1, 0),
+			DecorationThickness: float32(s.DecorationThickness),
+			LetterSpacing:       letterSpacing,
+			WordSpacing:         wordSpacing,
+			Height:              height,
+			HasBackground:       s.BackgroundColor != 0 && s.BackgroundColor != noBackgroundColor,
+			BackgroundColor:     uint32(s.BackgroundColor),
+			FontFeatures:        string(s.FontFeatures),
+			FontVariations:      string(s.FontVariations),
 		}
 	}
 
@@ -458,14 +511,16 @@ func LayoutRichText(span TextSpan, baseStyle SpanStyle, manager *FontManager, op
 		layoutSize.Height = lineHeight * float64(len(lines))
 	}
 
+	text := span.PlainText()
 	layout := &TextLayout{
-		Text:       span.PlainText(),
+		Text:       text,
 		Size:       layoutSize,
 		Ascent:     ascent,
 		Descent:    descent,
 		LineHeight: lineHeight,
 		Lines:      lines,
 		paragraph:  paragraph,
+		shapedText: text,
 	}
 	runtime.SetFinalizer(layout, func(l *TextLayout) {
 		if l != nil && l.paragraph != nil {
@@ -515,3 +515,17 @@ func TestFlattenSpans_ContentStyleOverridesBaseStyle(t *testing.T) {
 		t.Errorf("expected base font size 18 (not overridden), got %v", flat[0].style.FontSize)
 	}
 }
+
+func TestSpanStyle_InheritsDecorationThickness(t *testing.T) {
+	parent := SpanStyle{Decoration: TextDecorationUnderline, DecorationThickness: 2}
+	child := SpanStyle{}.mergeFrom(parent)
+	if child.DecorationThickness != 2 {
+		t.Errorf("expected thickness to be inherited, got %v", child.DecorationThickness)
+	}
+	if got := (Span("x").DecorationThickness(3)).Style.DecorationThickness; got != 3 {
+		t.Errorf("DecorationThickness builder set %v, want 3", got)
+	}
+	if skiaDecoration(TextDecorationLineThrough) != 4 || skiaDecoration(TextDecorationNone) != 0 || skiaDecoration(TextDecoration(9)) != 0 {
+		t.Error("unexpected Skia decoration mapping")
+	}
+}
@@ -491,6 +491,56 @@ func (c *SkiaCanvas) DrawPath(path *Path, paint Paint) {
 	)
 }
 
+func (c *SkiaCanvas) DrawVertices(vertices *Vertices, mode BlendMode, paint Paint) {
+	if vertices.Validate() != nil {
+		return
+	}
+	positions := make([]float32, 0, len(vertices.Positions)*2)
+	for _, p := range vertices.Positions {
+		positions = append(positions, float32(p.X), float32(p.Y))
+	}
+	var texCoords []float32
+	if len(vertices.TexCoords) > 0 {
+		texCoords = make([]float32, 0, len(vertices.TexCoords)*2)
+		for _, p := range vertices.TexCoords {
+			texCoords = append(texCoords, float32(p.X), float32(p.Y))
+		}
+	}
+	var colors []uint32
+	if len(vertices.Colors) > 0 {
+		colors = make([]uint32, len(vertices.Colors))
+		for i, color := range vertices.Colors {
+			colors[i] = uint32(color)
+		}
+	}
+
+	_, _, _, _, _, blend, alpha := paintParams(paint)
+	// Gradients are laid out over the mesh, or over the texture
+	// coordinates when the mesh maps into them.
+	gradientBounds := vertices.Bounds()
+	if len(vertices.TexCoords) > 0 {
+		gradientBounds = (&Vertices{Positions: vertices.TexCoords}).Bounds()
+	}
+	if paint.GradientBounds != nil {
+		gradientBounds = *paint.GradientBounds
+	}
+	var payload gradientPayload
+	if p, ok := buildGradientPayload(paint.Gradient, gradientBounds); ok {
+		payload = p
+	}
+	skia.CanvasDrawVertices(
+		c.canvas, int32(vertices.Mode),
+		positions, texCoords, colors, vertices.Indices,
+		int32(mode),
+		uint32(paint.Color), blend, alpha,
+		payload.gradientType,
+		float32(payload.start.X), float32(payload.start.Y),
+		float32(payload.end.X), float32(payload.end.Y),
+		float32(payload.center.X), float32(payload.center.Y), float32(payload.radius),
+		payload.colors, payload.positions,
+	)
+}
+
 func (c *SkiaCanvas) DrawRectShadow(rect Rect, shadow BoxShadow) {
 	skia.CanvasDrawRectShadow(
 		c.canvas,
@@ -582,7 +632,15 @@ func (c *SkiaCanvas) DrawLottie(animPtr unsafe.Pointer, bounds Rect, t float64)
 }
 
 func (c *SkiaCanvas) EmbedPlatformView(viewID int64, size Size) {
-	// No-op: platform view geometry is resolved by GeometryCanvas in StepFrame
+	// Platform view geometry is resolved by GeometryCanvas in StepFrame.
+	// Views composited as textures also draw their latest captured frame
+	// here so widgets painted afterwards layer on top of them.
+	img := PlatformViewTexture(viewID)
+	if img == nil {
+		return
+	}
+	// Frames change every capture, so caching the SkImage would only leak.
+	c.DrawImageRect(img, Rect{}, RectFromLTWH(0, 0, size.Width, size.Height), FilterQualityLow, 0)
 }
 
 func (c *SkiaCanvas) Size() Size {
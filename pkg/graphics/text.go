@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"runtime"
+	"strings"
 	"sync"
 
 	"github.com/go-drift/drift/pkg/errors"
@@ -118,6 +119,30 @@ func (w TextWrap) String() string {
 	}
 }
 
+// TextDirection is the direction in which text, and layouts that follow
+// reading order, flow.
+type TextDirection int
+
+const (
+	// TextDirectionLTR flows left to right, as in English. This is the zero
+	// value.
+	TextDirectionLTR TextDirection = iota
+	// TextDirectionRTL flows right to left, as in Arabic and Hebrew.
+	TextDirectionRTL
+)
+
+// String returns a human-readable representation of the text direction.
+func (d TextDirection) String() string {
+	switch d {
+	case TextDirectionLTR:
+		return "ltr"
+	case TextDirectionRTL:
+		return "rtl"
+	default:
+		return fmt.Sprintf("TextDirection(%d)", int(d))
+	}
+}
+
 // TextAlign controls paragraph-level horizontal alignment for wrapped text.
 //
 // Alignment only has a visible effect when the text is laid out with a
@@ -138,14 +163,28 @@ const (
 	// TextAlignJustify stretches lines so both edges are flush with the
 	// paragraph bounds. The last line of a paragraph is left-aligned.
 	TextAlignJustify
-	// TextAlignStart aligns lines to the start edge based on text direction.
-	// Currently behaves like [TextAlignLeft] (LTR only).
+	// TextAlignStart aligns lines to the start edge based on text direction:
+	// left for left-to-right text, right for right-to-left text.
 	TextAlignStart
-	// TextAlignEnd aligns lines to the end edge based on text direction.
-	// Currently behaves like [TextAlignRight] (LTR only).
+	// TextAlignEnd aligns lines to the end edge based on text direction:
+	// right for left-to-right text, left for right-to-left text.
 	TextAlignEnd
 )
 
+// Resolve returns the physical alignment for text flowing in direction,
+// mapping [TextAlignStart] and [TextAlignEnd] to left or right. Other
+// alignments are returned unchanged.
+func (a TextAlign) Resolve(direction TextDirection) TextAlign {
+	switch {
+	case a == TextAlignStart && direction == TextDirectionRTL,
+		a == TextAlignEnd && direction != TextDirectionRTL:
+		return TextAlignRight
+	case a == TextAlignStart, a == TextAlignEnd:
+		return TextAlignLeft
+	}
+	return a
+}
+
 // String returns a human-readable representation of the text alignment.
 func (a TextAlign) String() string {
 	switch a {
@@ -166,16 +205,88 @@ func (a TextAlign) String() string {
 	}
 }
 
+// TextOverflow controls how text that does not fit its bounds is presented.
+//
+// The zero value ([TextOverflowVisible]) drops lines past MaxLines and lets
+// unwrapped text paint beyond its bounds, matching the historical behavior.
+type TextOverflow int
+
+const (
+	// TextOverflowVisible paints overflowing text outside the widget bounds.
+	TextOverflowVisible TextOverflow = iota
+	// TextOverflowClip clips overflowing text to the widget bounds.
+	TextOverflowClip
+	// TextOverflowEllipsis ends the last visible line with an ellipsis.
+	TextOverflowEllipsis
+	// TextOverflowMiddleEllipsis replaces the middle of single-line text with
+	// an ellipsis, keeping both ends visible (useful for file names and
+	// paths). Multi-line text behaves like [TextOverflowEllipsis].
+	TextOverflowMiddleEllipsis
+	// TextOverflowFade fades out the trailing edge of the last visible line.
+	TextOverflowFade
+)
+
+// String returns a human-readable representation of the text overflow mode.
+func (o TextOverflow) String() string {
+	switch o {
+	case TextOverflowVisible:
+		return "visible"
+	case TextOverflowClip:
+		return "clip"
+	case TextOverflowEllipsis:
+		return "ellipsis"
+	case TextOverflowMiddleEllipsis:
+		return "middle_ellipsis"
+	case TextOverflowFade:
+		return "fade"
+	default:
+		return fmt.Sprintf("TextOverflow(%d)", int(o))
+	}
+}
+
+// textEllipsis is the string used by the ellipsis overflow modes.
+const textEllipsis = "\u2026"
+
 // TextStyle describes how text should be rendered.
 type TextStyle struct {
-	Color              Color
-	Gradient           *Gradient
+	Color    Color
+	Gradient *Gradient
+	// FontFamily names the font family. It may list fallback families
+	// separated by commas, such as "Inter, Noto Sans JP", which are tried in
+	// order for characters the first family has no glyph for, before the
+	// fallbacks set with [FontManager.SetFontFallback].
 	FontFamily         string
 	FontSize           float64
 	FontWeight         FontWeight
 	FontStyle          FontStyle
 	PreserveWhitespace bool
 	Shadow             *TextShadow
+	// LetterSpacing adds extra space between characters, in logical pixels.
+	// Negative values tighten the text. 0 uses the font's default spacing.
+	LetterSpacing float64
+	// Height sets the line height as a multiple of the font size (e.g. 1.5).
+	// 0 uses the font's default line height.
+	Height float64
+	// WordSpacing adds extra space between words, in logical pixels. 0 uses
+	// the font's default spacing.
+	WordSpacing float64
+	// Decoration draws a line under, over, or through the text. Zero and
+	// [TextDecorationNone] draw no line.
+	Decoration TextDecoration
+	// DecorationColor colors the decoration line. Zero uses the text color.
+	DecorationColor Color
+	// DecorationStyle draws the line solid, double, dotted, dashed, or wavy.
+	// Zero draws a solid line.
+	DecorationStyle TextDecorationStyle
+	// DecorationThickness multiplies the font's default decoration
+	// thickness, for example 2 for a line twice as thick. 0 uses the default.
+	DecorationThickness float64
+	// FontVariations sets variable font axes, such as an exact "wght" that
+	// FontWeight's steps of 100 can't express. See [Variations].
+	FontVariations FontVariations
+	// FontFeatures enables or disables OpenType features, such as tabular
+	// figures. See [Features].
+	FontFeatures FontFeatures
 }
 
 // WithColor returns a copy of the TextStyle with the specified color.
@@ -200,7 +311,14 @@ type TextLayout struct {
 	Face       font.Face
 	LineHeight float64
 	Lines      []TextLine
-	paragraph  *skia.Paragraph
+	// Truncated reports whether the layout could not show all of the text:
+	// lines were dropped because of MaxLines, or an ellipsis replaced part
+	// of the content. Use it to decide whether to offer a "show more" action.
+	Truncated bool
+	paragraph *skia.Paragraph
+	// shapedText is the text given to the paragraph, which may carry
+	// line break hints that Text does not.
+	shapedText string
 }
 
 // FontManager manages font registration for text graphics.
@@ -208,6 +326,7 @@ type FontManager struct {
 	mu          sync.RWMutex
 	fonts       map[string]struct{}
 	defaultName string
+	fallbacks   map[FontScript][]string
 }
 
 var (
@@ -260,8 +379,26 @@ func (m *FontManager) RegisterFont(name string, data []byte) error {
 		return err
 	}
 	m.mu.Lock()
-	defer m.mu.Unlock()
 	m.fonts[name] = struct{}{}
+	m.mu.Unlock()
+	PurgeTextLayoutCache()
+	return nil
+}
+
+// fontWarmUpText covers the letters, digits, and punctuation most text uses.
+const fontWarmUpText = "The quick brown fox jumps over the lazy dog. THE QUICK BROWN FOX JUMPS OVER THE LAZY DOG? 0123456789 (),:;!'\"-%"
+
+// PrecacheFont shapes sample text in family at regular and bold weights, so
+// the typeface is loaded and its common glyphs cached before the first text
+// that uses it. Loading a typeface can take longer than a frame, so call this
+// ahead of showing a screen in a new font. Empty family warms the default.
+func (m *FontManager) PrecacheFont(family string) error {
+	for _, weight := range []FontWeight{FontWeightNormal, FontWeightBold} {
+		style := TextStyle{FontFamily: family, FontWeight: weight}
+		if _, err := LayoutText(fontWarmUpText, style, m); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -284,6 +421,37 @@ type ParagraphOptions struct {
 	// TextAlign controls horizontal alignment of lines within the paragraph.
 	// The zero value ([TextAlignLeft]) aligns lines to the left edge.
 	TextAlign TextAlign
+	// Overflow selects how text that does not fit is shortened. Only the
+	// ellipsis modes change layout; clip and fade are applied when painting.
+	// Ellipsis modes need a MaxWidth to measure against.
+	Overflow TextOverflow
+	// Locale is the BCP 47 language tag of the text, used to pick the
+	// hyphenator and automatic line break strictness. Empty uses
+	// [DefaultLocale].
+	Locale string
+	// Hyphenate allows words to break across lines with a hyphen. Words are
+	// split with the [Hyphenator] registered for the locale's language.
+	// Only applies when the text wraps (MaxWidth > 0).
+	Hyphenate bool
+	// LineBreak controls how strictly CJK line breaking rules are applied.
+	// Only applies when the text wraps (MaxWidth > 0).
+	LineBreak LineBreakStrictness
+	// TextScale multiplies every font size, typically by the user's system
+	// font size preference. Letter spacing is not scaled, and line heights
+	// follow the font size. 0 means 1 (no scaling).
+	TextScale float64
+}
+
+// fontSize resolves size to the default font size when unset and applies
+// TextScale.
+func (o ParagraphOptions) fontSize(size float64) float64 {
+	if size <= 0 {
+		size = defaultFontSize
+	}
+	if o.TextScale > 0 {
+		size *= o.TextScale
+	}
+	return size
 }
 
 // LayoutText measures and shapes text using the provided font manager.
@@ -296,6 +464,10 @@ func LayoutText(text string, style TextStyle, manager *FontManager) (*TextLayout
 // LayoutTextWithOptions measures, wraps, and aligns text according to the
 // given [ParagraphOptions]. The returned [TextLayout] contains computed
 // metrics and a native paragraph handle for rendering.
+//
+// Layouts are cached by text, style, and options (see
+// [SetTextLayoutCacheLimit]), so the result may be shared with other callers
+// and must not be modified.
 func LayoutTextWithOptions(text string, style TextStyle, manager *FontManager, opts ParagraphOptions) (*TextLayout, error) {
 	if manager == nil {
 		return nil, stderrors.New("font manager required")
@@ -305,10 +477,13 @@ func LayoutTextWithOptions(text string, style TextStyle, manager *FontManager, o
 		family = manager.defaultName
 		style.FontFamily = family
 	}
-	size := style.FontSize
-	if size <= 0 {
-		size = defaultFontSize
+	key, cacheable := newTextLayoutKey(manager, text, style, opts)
+	if cacheable {
+		if layout, ok := textLayouts.get(key); ok {
+			return layout, nil
+		}
 	}
+	size := opts.fontSize(style.FontSize)
 	weight := int(style.FontWeight)
 	if weight < 100 {
 		weight = int(FontWeightNormal)
@@ -324,6 +499,9 @@ func LayoutTextWithOptions(text string, style TextStyle, manager *FontManager, o
 			layout.paragraph = nil
 		}
 	})
+	if cacheable {
+		textLayouts.put(key, layout)
+	}
 	return layout, nil
 }
 
@@ -338,6 +516,26 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 	maxLines := opts.MaxLines
 	textAlign := opts.TextAlign
 
+	ellipsis := ""
+	truncated := false
+	switch opts.Overflow {
+	case TextOverflowEllipsis:
+		ellipsis = textEllipsis
+	case TextOverflowMiddleEllipsis:
+		if maxLines == 1 && maxWidth > 0 {
+			text, truncated = middleEllipsize(text, style, family, size, weight, maxWidth)
+		} else {
+			ellipsis = textEllipsis
+		}
+	}
+
+	// The paragraph shapes a copy of the text with line break hints; the
+	// layout keeps reporting the text without them.
+	shapedText := text
+	if maxWidth > 0 {
+		shapedText = prepareLineBreaks(text, opts)
+	}
+
 	var shadow *skia.ParagraphShadow
 	if style.Shadow != nil {
 		shadow = &skia.ParagraphShadow{
@@ -348,6 +546,13 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 		}
 	}
 
+	decoration := skia.ParagraphDecoration{
+		Decoration: skiaDecoration(style.Decoration),
+		Color:      uint32(style.DecorationColor),
+		Style:      max(int(style.DecorationStyle)-1, 0),
+		Thickness:  float32(style.DecorationThickness),
+	}
+
 	// For gradients, we need actual layout dimensions to resolve relative coordinates.
 	// Do a two-pass approach: first layout without gradient to get size, then
 	// recreate with gradient using actual bounds.
@@ -360,7 +565,7 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 	var startX, startY, endX, endY, centerX, centerY, radius float32
 
 	paragraph, err := skia.NewParagraph(
-		text,
+		shapedText,
 		family,
 		float32(size),
 		weight,
@@ -372,6 +577,13 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 		colors, positions,
 		shadow,
 		int(textAlign),
+		float32(style.LetterSpacing),
+		float32(style.Height),
+		float32(style.WordSpacing),
+		decoration,
+		ellipsis,
+		string(style.FontFeatures),
+		string(style.FontVariations),
 	)
 	if err != nil {
 		return nil, err
@@ -401,7 +613,7 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 		// Destroy first paragraph and create new one with gradient
 		paragraph.Destroy()
 		paragraph, err = skia.NewParagraph(
-			text,
+			shapedText,
 			family,
 			float32(size),
 			weight,
@@ -413,6 +625,13 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 			colors, positions,
 			shadow,
 			int(textAlign),
+			float32(style.LetterSpacing),
+			float32(style.Height),
+			float32(style.WordSpacing),
+			decoration,
+			ellipsis,
+			string(style.FontFeatures),
+			string(style.FontVariations),
 		)
 		if err != nil {
 			return nil, err
@@ -486,6 +705,50 @@ func layoutParagraph(text string, style TextStyle, family string, size float64,
 		Face:       nil,
 		LineHeight: lineHeight,
 		Lines:      lines,
+		Truncated:  truncated || paragraph.DidExceedMaxLines(),
 		paragraph:  paragraph,
+		shapedText: shapedText,
 	}, nil
 }
+
+// middleEllipsize shortens single-line text to fit maxWidth by replacing
+// runes from the middle with an ellipsis. It returns the text unchanged if
+// it already fits or cannot be measured.
+func middleEllipsize(text string, style TextStyle, family string, size float64, weight int, maxWidth float64) (string, bool) {
+	fontStyle := fontStyleBridgeValue(style.FontStyle)
+	measure := func(s string) (float64, bool) {
+		width, err := skia.MeasureTextWidth(s, family, size, weight, fontStyle)
+		if err != nil {
+			return 0, false
+		}
+		if style.LetterSpacing != 0 {
+			width += style.LetterSpacing * float64(len([]rune(s)))
+		}
+		if style.WordSpacing != 0 {
+			width += style.WordSpacing * float64(strings.Count(s, " "))
+		}
+		return width, true
+	}
+	if width, ok := measure(text); !ok || width <= maxWidth {
+		return text, false
+	}
+
+	// Binary search the number of runes to keep, split evenly between the
+	// head and the tail so both ends of the text remain recognizable.
+	runes := []rune(text)
+	candidate := func(keep int) string {
+		head := (keep + 1) / 2
+		tail := keep / 2
+		return string(runes[:head]) + textEllipsis + string(runes[len(runes)-tail:])
+	}
+	lo, hi := 0, len(runes)-1
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		if width, _ := measure(candidate(mid)); width <= maxWidth {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return candidate(lo), true
+}
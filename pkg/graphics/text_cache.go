@@ -0,0 +1,158 @@
+package graphics
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultTextLayoutCacheLimit is the default memory budget, in bytes, of the
+// layouts kept by [LayoutTextWithOptions].
+const DefaultTextLayoutCacheLimit = 8 << 20
+
+// textLayouts holds recently shaped paragraphs so that text laid out again
+// with the same content, style, and options, such as list rows rebuilt while
+// scrolling, reuses the paragraph instead of shaping it again.
+var textLayouts = newTextLayoutCache(DefaultTextLayoutCacheLimit)
+
+// SetTextLayoutCacheLimit sets the memory budget of the text layout cache,
+// evicting the least recently used layouts until the cache fits. Zero or
+// less disables caching. Sizes are estimates of the native paragraph memory.
+func SetTextLayoutCacheLimit(bytes int64) {
+	textLayouts.setLimit(bytes)
+}
+
+// PurgeTextLayoutCache drops every cached text layout. Layouts still held by
+// widgets stay valid; they are released when no longer referenced.
+// Registering a font purges the cache, since a new family can change how
+// existing text resolves its typeface.
+func PurgeTextLayoutCache() {
+	textLayouts.purge()
+}
+
+// TextLayoutCacheBytes returns the estimated memory held by cached text
+// layouts, for memory diagnostics.
+func TextLayoutCacheBytes() int64 {
+	return textLayouts.size()
+}
+
+// textLayoutKey identifies a layout by everything that affects shaping.
+// Styles with a gradient are not cached, since the gradient is compared by
+// pointer and may be changed in place. The shadow is compared by value for
+// the same reason.
+type textLayoutKey struct {
+	manager   *FontManager
+	text      string
+	style     TextStyle // with Shadow cleared
+	hasShadow bool
+	shadow    TextShadow
+	opts      ParagraphOptions
+}
+
+// newTextLayoutKey returns the cache key for a layout, or false if the
+// style can't be cached.
+func newTextLayoutKey(manager *FontManager, text string, style TextStyle, opts ParagraphOptions) (textLayoutKey, bool) {
+	if style.Gradient != nil {
+		return textLayoutKey{}, false
+	}
+	key := textLayoutKey{manager: manager, text: text, style: style, opts: opts}
+	if style.Shadow != nil {
+		key.style.Shadow = nil
+		key.hasShadow = true
+		key.shadow = *style.Shadow
+	}
+	return key, true
+}
+
+// textLayoutCost estimates the native memory of a paragraph: a fixed
+// overhead plus the shaped runs and glyph positions for each byte of text.
+func textLayoutCost(layout *TextLayout) int64 {
+	return 1024 + 48*int64(len(layout.shapedText)) + 32*int64(len(layout.Lines))
+}
+
+// textLayoutCache is a memory-bounded LRU cache of text layouts.
+type textLayoutCache struct {
+	mu      sync.Mutex
+	limit   int64
+	bytes   int64
+	order   *list.List // of *textLayoutEntry, most recently used first
+	entries map[textLayoutKey]*list.Element
+}
+
+type textLayoutEntry struct {
+	key    textLayoutKey
+	layout *TextLayout
+	cost   int64
+}
+
+func newTextLayoutCache(limit int64) *textLayoutCache {
+	return &textLayoutCache{
+		limit:   limit,
+		order:   list.New(),
+		entries: make(map[textLayoutKey]*list.Element),
+	}
+}
+
+// get returns the cached layout for key and marks it recently used.
+func (c *textLayoutCache) get(key textLayoutKey) (*TextLayout, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*textLayoutEntry).layout, true
+}
+
+// put caches layout under key, evicting old layouts to stay in budget.
+// Layouts larger than the whole budget are not cached.
+func (c *textLayoutCache) put(key textLayoutKey, layout *TextLayout) {
+	cost := textLayoutCost(layout)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cost > c.limit {
+		return
+	}
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	c.entries[key] = c.order.PushFront(&textLayoutEntry{key: key, layout: layout, cost: cost})
+	c.bytes += cost
+	c.evict()
+}
+
+func (c *textLayoutCache) setLimit(limit int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.limit = limit
+	c.evict()
+}
+
+func (c *textLayoutCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.order.Init()
+	clear(c.entries)
+	c.bytes = 0
+}
+
+func (c *textLayoutCache) size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bytes
+}
+
+// evict drops least recently used layouts until the cache fits its limit.
+// Caller must hold mu.
+func (c *textLayoutCache) evict() {
+	for c.bytes > max(c.limit, 0) {
+		c.remove(c.order.Back())
+	}
+}
+
+// remove drops element from the cache. Caller must hold mu.
+func (c *textLayoutCache) remove(element *list.Element) {
+	entry := c.order.Remove(element).(*textLayoutEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= entry.cost
+}
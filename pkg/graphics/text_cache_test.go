@@ -0,0 +1,68 @@
+package graphics
+
+import "testing"
+
+func TestTextLayoutCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	layout := func(text string) *TextLayout {
+		return &TextLayout{Text: text, shapedText: text, Lines: []TextLine{{}}}
+	}
+	key := func(text string) textLayoutKey {
+		k, _ := newTextLayoutKey(nil, text, TextStyle{FontSize: 14}, ParagraphOptions{MaxWidth: 100})
+		return k
+	}
+	a, b, c := layout("alpha"), layout("bravo"), layout("delta")
+	cache := newTextLayoutCache(textLayoutCost(a) + textLayoutCost(b))
+
+	cache.put(key("alpha"), a)
+	cache.put(key("bravo"), b)
+	if got, ok := cache.get(key("alpha")); !ok || got != a {
+		t.Fatal("expected alpha to be cached")
+	}
+	// bravo is now least recently used and makes room for delta.
+	cache.put(key("delta"), c)
+	if _, ok := cache.get(key("bravo")); ok {
+		t.Error("expected bravo to be evicted")
+	}
+	if _, ok := cache.get(key("alpha")); !ok {
+		t.Error("expected alpha to survive eviction")
+	}
+	if want := textLayoutCost(a) + textLayoutCost(c); cache.size() != want {
+		t.Errorf("size = %d, want %d", cache.size(), want)
+	}
+
+	cache.setLimit(0)
+	if cache.size() != 0 {
+		t.Errorf("expected a zero limit to empty the cache, size %d", cache.size())
+	}
+	cache.put(key("alpha"), a)
+	if _, ok := cache.get(key("alpha")); ok {
+		t.Error("expected a zero limit to disable caching")
+	}
+}
+
+func TestTextLayoutCache_Keys(t *testing.T) {
+	cache := newTextLayoutCache(DefaultTextLayoutCacheLimit)
+	layout := &TextLayout{Text: "hi", shapedText: "hi"}
+
+	style := TextStyle{Shadow: &TextShadow{Color: RGB(0, 0, 0), BlurRadius: 2}}
+	k1, _ := newTextLayoutKey(nil, "hi", style, ParagraphOptions{})
+	cache.put(k1, layout)
+	// An equal shadow at a different address hits the cache.
+	style.Shadow = &TextShadow{Color: RGB(0, 0, 0), BlurRadius: 2}
+	k2, _ := newTextLayoutKey(nil, "hi", style, ParagraphOptions{})
+	if got, ok := cache.get(k2); !ok || got != layout {
+		t.Error("expected an equal shadow to hit the cache")
+	}
+	k3, _ := newTextLayoutKey(nil, "hi", style, ParagraphOptions{MaxWidth: 50})
+	if _, ok := cache.get(k3); ok {
+		t.Error("expected different options to miss the cache")
+	}
+	if _, ok := newTextLayoutKey(nil, "hi", TextStyle{Gradient: &Gradient{}}, ParagraphOptions{}); ok {
+		t.Error("expected gradient styles not to be cacheable")
+	}
+
+	cache.purge()
+	if _, ok := cache.get(k1); ok || cache.size() != 0 {
+		t.Error("expected purge to drop every layout")
+	}
+}
@@ -0,0 +1,149 @@
+package graphics
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// OffsetAt returns the byte offset in l.Text of the character drawn at
+// position, relative to the layout origin. It reports false if position is
+// not over a glyph, for example past the end of a line, or if the layout has
+// no native paragraph.
+func (l *TextLayout) OffsetAt(position Offset) (int, bool) {
+	if l == nil || l.paragraph == nil {
+		return 0, false
+	}
+	index := l.paragraph.GlyphIndexAt(float32(position.X), float32(position.Y))
+	if index < 0 {
+		return 0, false
+	}
+	shaped, ok := utf16IndexToByteOffset(l.shapedText, index)
+	if !ok {
+		return 0, false
+	}
+	return unshapeOffset(l.Text, l.shapedText, shaped)
+}
+
+// CaretOffsetAt returns the byte offset in l.Text of the caret position
+// nearest to position, relative to the layout origin. Unlike [TextLayout.OffsetAt]
+// it never misses: points past the end of a line map to the line end. It
+// reports false if the layout has no native paragraph.
+func (l *TextLayout) CaretOffsetAt(position Offset) (int, bool) {
+	if l == nil || l.paragraph == nil {
+		return 0, false
+	}
+	index := l.paragraph.CaretIndexAt(float32(position.X), float32(position.Y))
+	if index < 0 {
+		return 0, false
+	}
+	shaped, ok := utf16IndexToByteOffset(l.shapedText, index)
+	if !ok {
+		return len(l.Text), true
+	}
+	offset, ok := unshapeOffset(l.Text, l.shapedText, shaped)
+	if !ok {
+		return len(l.Text), true
+	}
+	return offset, true
+}
+
+// RectsForRange returns the boxes covering the byte range [start, end) of
+// l.Text, one per line the range touches, relative to the layout origin.
+// It returns nil for an empty range or a layout without a native paragraph.
+func (l *TextLayout) RectsForRange(start, end int) []Rect {
+	if l == nil || l.paragraph == nil {
+		return nil
+	}
+	start = max(0, min(start, len(l.Text)))
+	end = max(start, min(end, len(l.Text)))
+	if start == end {
+		return nil
+	}
+	from := byteOffsetToUTF16Index(l.shapedText, shapeOffset(l.Text, l.shapedText, start))
+	to := byteOffsetToUTF16Index(l.shapedText, shapeOffset(l.Text, l.shapedText, end))
+	boxes := l.paragraph.RectsForRange(from, to)
+	rects := make([]Rect, 0, len(boxes)/4)
+	for i := 0; i+3 < len(boxes); i += 4 {
+		rects = append(rects, Rect{
+			Left:   float64(boxes[i]),
+			Top:    float64(boxes[i+1]),
+			Right:  float64(boxes[i+2]),
+			Bottom: float64(boxes[i+3]),
+		})
+	}
+	return rects
+}
+
+// utf16IndexToByteOffset converts a UTF-16 code unit index, as reported by
+// Skia, into a byte offset in s.
+func utf16IndexToByteOffset(s string, index int) (int, bool) {
+	units := 0
+	for i, r := range s {
+		if units >= index {
+			return i, true
+		}
+		units += utf16.RuneLen(r)
+	}
+	return 0, false
+}
+
+// unshapeOffset maps a byte offset in shaped, which is original with line
+// break hints inserted, back to original. Offsets that fall on an inserted
+// hint map to the following character.
+func unshapeOffset(original, shaped string, offset int) (int, bool) {
+	if original == shaped {
+		return offset, offset < len(original)
+	}
+	i := 0
+	for j := 0; j < len(shaped); {
+		if i >= len(original) {
+			break
+		}
+		rs, ns := utf8.DecodeRuneInString(shaped[j:])
+		ro, no := utf8.DecodeRuneInString(original[i:])
+		if j >= offset && rs == ro {
+			return i, true
+		}
+		if rs == ro {
+			i += no
+		}
+		j += ns
+	}
+	return 0, false
+}
+
+// shapeOffset maps a byte offset in original to the matching offset in
+// shaped, which is original with line break hints inserted. The end of
+// original maps to the end of shaped.
+func shapeOffset(original, shaped string, offset int) int {
+	if original == shaped {
+		return offset
+	}
+	i := 0
+	for j := 0; j < len(shaped); {
+		if i >= offset {
+			return j
+		}
+		rs, ns := utf8.DecodeRuneInString(shaped[j:])
+		if i < len(original) {
+			if ro, no := utf8.DecodeRuneInString(original[i:]); rs == ro {
+				i += no
+			}
+		}
+		j += ns
+	}
+	return len(shaped)
+}
+
+// byteOffsetToUTF16Index converts a byte offset in s into the UTF-16 code
+// unit index Skia uses.
+func byteOffsetToUTF16Index(s string, offset int) int {
+	units := 0
+	for i, r := range s {
+		if i >= offset {
+			break
+		}
+		units += utf16.RuneLen(r)
+	}
+	return units
+}
@@ -0,0 +1,99 @@
+package graphics
+
+import "testing"
+
+func TestUTF16IndexToByteOffset(t *testing.T) {
+	s := "a😀é b"
+	tests := []struct {
+		index int
+		want  int
+		ok    bool
+	}{
+		{0, 0, true},
+		{1, 1, true}, // emoji, two UTF-16 units
+		{3, 5, true}, // é
+		{5, 8, true}, // b
+		{6, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := utf16IndexToByteOffset(s, tt.index)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("utf16IndexToByteOffset(%d) = %d, %v; want %d, %v", tt.index, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestUnshapeOffset(t *testing.T) {
+	original := "remarkable idea"
+	shaped := "remar\u00adkable idea"
+	tests := []struct {
+		offset int
+		want   int
+	}{
+		{0, 0},
+		{4, 4},
+		{5, 5}, // soft hyphen maps to the following letter
+		{7, 5},
+		{8, 6},
+	}
+	for _, tt := range tests {
+		got, ok := unshapeOffset(original, shaped, tt.offset)
+		if !ok || got != tt.want {
+			t.Errorf("unshapeOffset(%d) = %d, %v; want %d", tt.offset, got, ok, tt.want)
+		}
+	}
+	if _, ok := unshapeOffset(original, shaped, len(shaped)); ok {
+		t.Error("expected offset past the end to fail")
+	}
+}
+
+func TestShapeOffset(t *testing.T) {
+	original := "remarkable idea"
+	shaped := "remar\u00adkable idea"
+	tests := []struct {
+		offset int
+		want   int
+	}{
+		{0, 0},
+		{5, 5}, // the hint precedes the letter it was inserted before
+		{6, 8},
+		{len(original), len(shaped)},
+	}
+	for _, tt := range tests {
+		if got := shapeOffset(original, shaped, tt.offset); got != tt.want {
+			t.Errorf("shapeOffset(%d) = %d, want %d", tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestByteOffsetToUTF16Index(t *testing.T) {
+	s := "a😀é b"
+	tests := []struct{ offset, want int }{
+		{0, 0},
+		{1, 1},
+		{5, 3},
+		{8, 5},
+		{len(s), 6},
+	}
+	for _, tt := range tests {
+		if got := byteOffsetToUTF16Index(s, tt.offset); got != tt.want {
+			t.Errorf("byteOffsetToUTF16Index(%d) = %d, want %d", tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestTextLayout_OffsetAtWithoutParagraph(t *testing.T) {
+	if _, ok := (&TextLayout{Text: "hi"}).OffsetAt(Offset{}); ok {
+		t.Error("expected no offset without a native paragraph")
+	}
+}
+
+func TestTextLayout_SelectionGeometryWithoutParagraph(t *testing.T) {
+	l := &TextLayout{Text: "hi"}
+	if _, ok := l.CaretOffsetAt(Offset{}); ok {
+		t.Error("expected no caret without a native paragraph")
+	}
+	if rects := l.RectsForRange(0, 2); rects != nil {
+		t.Errorf("expected no rects without a native paragraph, got %v", rects)
+	}
+}
@@ -0,0 +1,24 @@
+package graphics
+
+import "testing"
+
+func TestTextAlign_Resolve(t *testing.T) {
+	tests := []struct {
+		align     TextAlign
+		direction TextDirection
+		want      TextAlign
+	}{
+		{TextAlignStart, TextDirectionLTR, TextAlignLeft},
+		{TextAlignEnd, TextDirectionLTR, TextAlignRight},
+		{TextAlignStart, TextDirectionRTL, TextAlignRight},
+		{TextAlignEnd, TextDirectionRTL, TextAlignLeft},
+		{TextAlignLeft, TextDirectionRTL, TextAlignLeft},
+		{TextAlignCenter, TextDirectionRTL, TextAlignCenter},
+		{TextAlignJustify, TextDirectionRTL, TextAlignJustify},
+	}
+	for _, tt := range tests {
+		if got := tt.align.Resolve(tt.direction); got != tt.want {
+			t.Errorf("%v.Resolve(%v) = %v, want %v", tt.align, tt.direction, got, tt.want)
+		}
+	}
+}
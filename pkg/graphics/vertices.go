@@ -0,0 +1,192 @@
+package graphics
+
+import (
+	"errors"
+	"fmt"
+)
+
+// VertexMode controls how [Vertices] are assembled into triangles.
+type VertexMode int
+
+const (
+	// VertexModeTriangles draws each group of three vertices as a triangle.
+	VertexModeTriangles VertexMode = iota
+	// VertexModeTriangleStrip draws a triangle for each vertex after the
+	// second, using it and the two before it.
+	VertexModeTriangleStrip
+	// VertexModeTriangleFan draws a triangle for each vertex after the
+	// second, using it, the one before it, and the first vertex.
+	VertexModeTriangleFan
+)
+
+// String returns a human-readable representation of the vertex mode.
+func (m VertexMode) String() string {
+	switch m {
+	case VertexModeTriangles:
+		return "triangles"
+	case VertexModeTriangleStrip:
+		return "triangle_strip"
+	case VertexModeTriangleFan:
+		return "triangle_fan"
+	default:
+		return fmt.Sprintf("VertexMode(%d)", int(m))
+	}
+}
+
+// Vertices is a triangle mesh drawn in a single call with
+// [Canvas.DrawVertices]. It suits heatmaps, mesh gradients, and projected
+// 3D geometry, which would otherwise take thousands of rects or paths.
+//
+// TexCoords and Colors are optional; when set they need one entry per
+// position. Indices are optional too; when set they pick the vertices that
+// Mode assembles, so shared vertices can be listed once.
+type Vertices struct {
+	Mode      VertexMode
+	Positions []Offset
+	// TexCoords map each vertex to a point in the paint's gradient, so the
+	// gradient is stretched over the mesh. Without them, the gradient is
+	// sampled at the vertex positions.
+	TexCoords []Offset
+	// Colors are interpolated across each triangle.
+	Colors  []Color
+	Indices []uint16
+}
+
+// Validate reports whether the mesh can be drawn.
+func (v *Vertices) Validate() error {
+	if v == nil {
+		return errors.New("vertices: nil")
+	}
+	n := len(v.Positions)
+	if n < 3 {
+		return fmt.Errorf("vertices: need at least 3 positions, got %d", n)
+	}
+	if v.TexCoords != nil && len(v.TexCoords) != n {
+		return fmt.Errorf("vertices: %d tex coords for %d positions", len(v.TexCoords), n)
+	}
+	if v.Colors != nil && len(v.Colors) != n {
+		return fmt.Errorf("vertices: %d colors for %d positions", len(v.Colors), n)
+	}
+	for i, index := range v.Indices {
+		if int(index) >= n {
+			return fmt.Errorf("vertices: index %d at %d is out of range for %d positions", index, i, n)
+		}
+	}
+	return nil
+}
+
+// Bounds returns the smallest rectangle containing all positions.
+func (v *Vertices) Bounds() Rect {
+	if v == nil || len(v.Positions) == 0 {
+		return Rect{}
+	}
+	first := v.Positions[0]
+	bounds := Rect{Left: first.X, Top: first.Y, Right: first.X, Bottom: first.Y}
+	for _, p := range v.Positions[1:] {
+		bounds.Left = min(bounds.Left, p.X)
+		bounds.Top = min(bounds.Top, p.Y)
+		bounds.Right = max(bounds.Right, p.X)
+		bounds.Bottom = max(bounds.Bottom, p.Y)
+	}
+	return bounds
+}
+
+// EachTriangle calls fn with the position indices of each triangle the mesh
+// draws, after applying Mode and Indices. Canvases without native mesh
+// support use it to draw the triangles one by one.
+func (v *Vertices) EachTriangle(fn func(a, b, c int)) {
+	if v == nil {
+		return
+	}
+	count := len(v.Positions)
+	vertex := func(i int) int { return i }
+	if len(v.Indices) > 0 {
+		count = len(v.Indices)
+		vertex = func(i int) int { return int(v.Indices[i]) }
+	}
+	switch v.Mode {
+	case VertexModeTriangleStrip:
+		for i := 2; i < count; i++ {
+			// Alternate the winding so every triangle faces the same way.
+			if i%2 == 0 {
+				fn(vertex(i-2), vertex(i-1), vertex(i))
+			} else {
+				fn(vertex(i-1), vertex(i-2), vertex(i))
+			}
+		}
+	case VertexModeTriangleFan:
+		for i := 2; i < count; i++ {
+			fn(vertex(0), vertex(i-1), vertex(i))
+		}
+	default:
+		for i := 2; i < count; i += 3 {
+			fn(vertex(i-2), vertex(i-1), vertex(i))
+		}
+	}
+}
+
+// copyVertices returns a deep copy so a recorded mesh is not affected by
+// later changes to the caller's slices.
+func copyVertices(v *Vertices) *Vertices {
+	if v == nil {
+		return nil
+	}
+	return &Vertices{
+		Mode:      v.Mode,
+		Positions: append([]Offset(nil), v.Positions...),
+		TexCoords: append([]Offset(nil), v.TexCoords...),
+		Colors:    append([]Color(nil), v.Colors...),
+		Indices:   append([]uint16(nil), v.Indices...),
+	}
+}
+
+// NewMeshGradient returns a mesh that fills rect with a grid of colors,
+// interpolated smoothly between neighboring grid points. colors lists the
+// grid row by row from the top left, columns colors per row, and must hold
+// at least two rows of at least two colors.
+//
+// A 2x2 grid is a four-corner gradient; larger grids give mesh gradients
+// and heatmaps:
+//
+//	canvas.DrawVertices(graphics.NewMeshGradient(rect, 2, []graphics.Color{
+//	    graphics.RGB(255, 0, 0), graphics.RGB(0, 0, 255),
+//	    graphics.RGB(0, 255, 0), graphics.RGB(255, 255, 0),
+//	}), graphics.BlendModeDst, graphics.DefaultPaint())
+//
+// It returns nil if the grid is too small or colors doesn't fill whole rows.
+func NewMeshGradient(rect Rect, columns int, colors []Color) *Vertices {
+	if columns < 2 || len(colors)%columns != 0 || len(colors)/columns < 2 || len(colors) > 1<<16 {
+		return nil
+	}
+	rows := len(colors) / columns
+
+	positions := make([]Offset, 0, len(colors))
+	for row := range rows {
+		y := rect.Top + rect.Height()*float64(row)/float64(rows-1)
+		for col := range columns {
+			x := rect.Left + rect.Width()*float64(col)/float64(columns-1)
+			positions = append(positions, Offset{X: x, Y: y})
+		}
+	}
+
+	indices := make([]uint16, 0, (rows-1)*(columns-1)*6)
+	for row := range rows - 1 {
+		for col := range columns - 1 {
+			topLeft := uint16(row*columns + col)
+			topRight := topLeft + 1
+			bottomLeft := topLeft + uint16(columns)
+			bottomRight := bottomLeft + 1
+			indices = append(indices,
+				topLeft, topRight, bottomLeft,
+				topRight, bottomRight, bottomLeft,
+			)
+		}
+	}
+
+	return &Vertices{
+		Mode:      VertexModeTriangles,
+		Positions: positions,
+		Colors:    append([]Color(nil), colors...),
+		Indices:   indices,
+	}
+}
@@ -0,0 +1,130 @@
+package graphics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVertices_Validate(t *testing.T) {
+	tri := []Offset{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10}}
+	tests := []struct {
+		name     string
+		vertices *Vertices
+		wantErr  string
+	}{
+		{"valid", &Vertices{Positions: tri}, ""},
+		{"nil", nil, "nil"},
+		{"too few positions", &Vertices{Positions: tri[:2]}, "at least 3"},
+		{"color count", &Vertices{Positions: tri, Colors: []Color{ColorWhite}}, "1 colors"},
+		{"tex coord count", &Vertices{Positions: tri, TexCoords: tri[:1]}, "1 tex coords"},
+		{"index out of range", &Vertices{Positions: tri, Indices: []uint16{0, 1, 3}}, "out of range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.vertices.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestVertices_EachTriangle(t *testing.T) {
+	positions := make([]Offset, 5)
+	tests := []struct {
+		name     string
+		vertices *Vertices
+		want     [][3]int
+	}{
+		{"triangles", &Vertices{Positions: positions[:3]}, [][3]int{{0, 1, 2}}},
+		{"strip", &Vertices{Mode: VertexModeTriangleStrip, Positions: positions[:4]}, [][3]int{{0, 1, 2}, {2, 1, 3}}},
+		{"fan", &Vertices{Mode: VertexModeTriangleFan, Positions: positions[:4]}, [][3]int{{0, 1, 2}, {0, 2, 3}}},
+		{"indexed", &Vertices{Positions: positions, Indices: []uint16{4, 3, 2, 2, 1, 0}}, [][3]int{{4, 3, 2}, {2, 1, 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][3]int
+			tt.vertices.EachTriangle(func(a, b, c int) {
+				got = append(got, [3]int{a, b, c})
+			})
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("triangle %d: expected %v, got %v", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNewMeshGradient(t *testing.T) {
+	colors := []Color{
+		RGB(255, 0, 0), RGB(0, 255, 0), RGB(0, 0, 255),
+		RGB(0, 0, 0), RGB(255, 255, 255), RGB(128, 128, 128),
+	}
+	mesh := NewMeshGradient(RectFromLTWH(10, 20, 100, 50), 3, colors)
+	if err := mesh.Validate(); err != nil {
+		t.Fatalf("expected a valid mesh, got %v", err)
+	}
+	if len(mesh.Positions) != 6 || len(mesh.Indices) != 12 {
+		t.Errorf("expected 6 vertices and 4 triangles, got %d and %d indices", len(mesh.Positions), len(mesh.Indices))
+	}
+	if mesh.Positions[5] != (Offset{X: 110, Y: 70}) {
+		t.Errorf("expected the last vertex at the bottom right, got %v", mesh.Positions[5])
+	}
+	if got := mesh.Bounds(); got != RectFromLTWH(10, 20, 100, 50) {
+		t.Errorf("expected bounds to match the rect, got %v", got)
+	}
+
+	colors[0] = ColorWhite
+	if mesh.Colors[0] == ColorWhite {
+		t.Error("expected the mesh to copy its colors")
+	}
+
+	if NewMeshGradient(Rect{}, 2, colors[:3]) != nil {
+		t.Error("expected nil for a partial row")
+	}
+	if NewMeshGradient(Rect{}, 3, colors[:3]) != nil {
+		t.Error("expected nil for a single row")
+	}
+}
+
+type vertexCaptureCanvas struct {
+	Canvas
+	got []*Vertices
+}
+
+func (c *vertexCaptureCanvas) DrawVertices(vertices *Vertices, mode BlendMode, paint Paint) {
+	c.got = append(c.got, vertices)
+}
+
+func TestRecordingCanvas_DrawVerticesCopiesMesh(t *testing.T) {
+	mesh := &Vertices{Positions: []Offset{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10}}}
+
+	var recorder PictureRecorder
+	canvas := recorder.BeginRecording(Size{Width: 10, Height: 10})
+	canvas.DrawVertices(mesh, BlendModeDst, DefaultPaint())
+	canvas.DrawVertices(&Vertices{Positions: mesh.Positions[:2]}, BlendModeDst, DefaultPaint())
+	list := recorder.EndRecording()
+
+	mesh.Positions[0] = Offset{X: 5, Y: 5}
+
+	capture := &vertexCaptureCanvas{}
+	for _, op := range list.ops {
+		op.execute(capture)
+	}
+	if len(capture.got) != 1 {
+		t.Fatalf("expected only the valid mesh to be recorded, got %d", len(capture.got))
+	}
+	if capture.got[0].Positions[0] != (Offset{}) {
+		t.Errorf("expected the recorded mesh to be unaffected by later edits, got %v", capture.got[0].Positions[0])
+	}
+}
@@ -0,0 +1,474 @@
+package intl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateStyle selects how much detail a [DateFormat] writes, following the
+// CLDR short, medium, long, and full formats.
+type DateStyle int
+
+const (
+	// DateStyleShort is numeric: "1/2/26" and "3:04 PM" in en-US.
+	DateStyleShort DateStyle = iota
+	// DateStyleMedium abbreviates the month and adds seconds:
+	// "Jan 2, 2026" and "3:04:05 PM".
+	DateStyleMedium
+	// DateStyleLong spells out the month and adds the time zone:
+	// "January 2, 2026" and "3:04:05 PM MST".
+	DateStyleLong
+	// DateStyleFull adds the weekday: "Friday, January 2, 2026".
+	DateStyleFull
+)
+
+// String returns the style name, such as "medium".
+func (s DateStyle) String() string {
+	switch s {
+	case DateStyleShort:
+		return "short"
+	case DateStyleMedium:
+		return "medium"
+	case DateStyleLong:
+		return "long"
+	case DateStyleFull:
+		return "full"
+	default:
+		return "DateStyle(" + strconv.Itoa(int(s)) + ")"
+	}
+}
+
+// DateFormat formats times the way a locale writes them: its field order,
+// month and weekday names, and 12- or 24-hour clock. Create one with
+// [NewDateFormat], [NewTimeFormat], [NewDateTimeFormat], or
+// [NewDatePattern], usually for the locale from [LocaleOf]:
+//
+//	intl.NewDateFormat(intl.LocaleOf(ctx), intl.DateStyleLong).Format(t)
+//	// "January 2, 2026" in en, "2. Januar 2026" in de, "2026年1月2日" in ja
+//
+// Times are written in their own location; convert them with
+// [time.Time.In] or [time.Time.Local] first. Languages without date data
+// use English. A DateFormat is immutable and safe to share.
+type DateFormat struct {
+	symbols *dateSymbols
+	fields  []dateField
+}
+
+// NewDateFormat returns a format for locale that writes the date only.
+func NewDateFormat(locale Locale, style DateStyle) *DateFormat {
+	s := dateSymbolsFor(locale)
+	return mustDatePattern(s, s.dates[clampStyle(style)])
+}
+
+// NewTimeFormat returns a format for locale that writes the time of day
+// only.
+func NewTimeFormat(locale Locale, style DateStyle) *DateFormat {
+	s := dateSymbolsFor(locale)
+	return mustDatePattern(s, s.times[clampStyle(style)])
+}
+
+// NewDateTimeFormat returns a format for locale that writes the date in
+// dateStyle and the time in timeStyle, joined the way the locale joins
+// them.
+func NewDateTimeFormat(locale Locale, dateStyle, timeStyle DateStyle) *DateFormat {
+	s := dateSymbolsFor(locale)
+	pattern := strings.NewReplacer(
+		"{0}", s.times[clampStyle(timeStyle)],
+		"{1}", s.dates[clampStyle(dateStyle)],
+	).Replace(s.dateTime)
+	return mustDatePattern(s, pattern)
+}
+
+// NewDatePattern returns a format for locale from a CLDR date pattern such
+// as "EEE, d MMM y" or "HH:mm". The pattern letters are:
+//
+//	y     year: "2026"; yy is the last two digits, "26"
+//	M     month: M "1", MM "01", MMM "Jan", MMMM "January"
+//	L     standalone month, for months written without a day
+//	d     day of month: d "2", dd "02"
+//	E     weekday: E to EEE "Fri", EEEE "Friday"
+//	h, H  hour on a 12-hour clock (1-12) and a 24-hour clock (0-23)
+//	m, s  minute and second
+//	a     AM or PM marker
+//	z     time zone abbreviation
+//
+// Repeating a numeric letter pads it with zeros. Text between single quotes
+// is written as is, and two single quotes write one. Other ASCII letters
+// are reserved and return an error.
+func NewDatePattern(locale Locale, pattern string) (*DateFormat, error) {
+	return parseDatePattern(dateSymbolsFor(locale), pattern)
+}
+
+// Format returns t formatted with f.
+func (f *DateFormat) Format(t time.Time) string {
+	var b strings.Builder
+	for _, field := range f.fields {
+		if field.letter == 0 {
+			b.WriteString(field.text)
+			continue
+		}
+		f.writeField(&b, field, t)
+	}
+	return b.String()
+}
+
+func (f *DateFormat) writeField(b *strings.Builder, field dateField, t time.Time) {
+	s, n := f.symbols, field.count
+	switch field.letter {
+	case 'y':
+		if n == 2 {
+			writePadded(b, t.Year()%100, 2)
+		} else {
+			writePadded(b, t.Year(), n)
+		}
+	case 'M', 'L':
+		month := int(t.Month()) - 1
+		switch {
+		case n <= 2:
+			writePadded(b, month+1, n)
+		case field.letter == 'L' && n == 3:
+			b.WriteString(s.standaloneShortMonths()[month])
+		case field.letter == 'L':
+			b.WriteString(s.standaloneMonths()[month])
+		case n == 3:
+			b.WriteString(s.shortMonths[month])
+		default:
+			b.WriteString(s.months[month])
+		}
+	case 'd':
+		writePadded(b, t.Day(), n)
+	case 'E':
+		if n <= 3 {
+			b.WriteString(s.shortWeekdays[t.Weekday()])
+		} else {
+			b.WriteString(s.weekdays[t.Weekday()])
+		}
+	case 'h':
+		hour := t.Hour() % 12
+		if hour == 0 {
+			hour = 12
+		}
+		writePadded(b, hour, n)
+	case 'H':
+		writePadded(b, t.Hour(), n)
+	case 'm':
+		writePadded(b, t.Minute(), n)
+	case 's':
+		writePadded(b, t.Second(), n)
+	case 'a':
+		if t.Hour() < 12 {
+			b.WriteString(s.am)
+		} else {
+			b.WriteString(s.pm)
+		}
+	case 'z':
+		b.WriteString(t.Format("MST"))
+	}
+}
+
+func writePadded(b *strings.Builder, n, width int) {
+	digits := strconv.Itoa(n)
+	for i := len(digits); i < width; i++ {
+		b.WriteByte('0')
+	}
+	b.WriteString(digits)
+}
+
+// dateField is a run of one pattern letter, or literal text when letter
+// is 0.
+type dateField struct {
+	letter byte
+	count  int
+	text   string
+}
+
+const dateLetters = "yMLdEhHmsaz"
+
+func parseDatePattern(s *dateSymbols, pattern string) (*DateFormat, error) {
+	f := &DateFormat{symbols: s}
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			f.fields = append(f.fields, dateField{text: literal.String()})
+			literal.Reset()
+		}
+	}
+	inQuote := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\'' && strings.HasPrefix(pattern[i+1:], "'"):
+			literal.WriteByte(c)
+			i++
+		case c == '\'':
+			inQuote = !inQuote
+		case inQuote || !isASCIILetter(c):
+			literal.WriteByte(c)
+		case strings.IndexByte(dateLetters, c) >= 0:
+			flush()
+			count := 1
+			for i+1 < len(pattern) && pattern[i+1] == c {
+				count++
+				i++
+			}
+			f.fields = append(f.fields, dateField{letter: c, count: count})
+		default:
+			return nil, fmt.Errorf("intl: date pattern %q: unsupported letter %q", pattern, c)
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("intl: date pattern %q: unterminated quote", pattern)
+	}
+	flush()
+	return f, nil
+}
+
+func mustDatePattern(s *dateSymbols, pattern string) *DateFormat {
+	f, err := parseDatePattern(s, pattern)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func clampStyle(style DateStyle) DateStyle {
+	return min(max(style, DateStyleShort), DateStyleFull)
+}
+
+// dateSymbols holds a locale's names and patterns from CLDR. Weekdays
+// start on Sunday, and patterns are indexed by DateStyle.
+type dateSymbols struct {
+	months, shortMonths [12]string
+	// standalone and shortStandalone are the month names used without a
+	// day, for languages where they differ, such as Russian.
+	standalone, shortStandalone *[12]string
+	weekdays, shortWeekdays     [7]string
+	am, pm                      string
+	dates, times                [4]string
+	// dateTime joins a time ({0}) and a date ({1}).
+	dateTime string
+}
+
+func (s *dateSymbols) standaloneMonths() [12]string {
+	if s.standalone != nil {
+		return *s.standalone
+	}
+	return s.months
+}
+
+func (s *dateSymbols) standaloneShortMonths() [12]string {
+	if s.shortStandalone != nil {
+		return *s.shortStandalone
+	}
+	return s.shortMonths
+}
+
+var (
+	times12h = [4]string{"h:mm a", "h:mm:ss a", "h:mm:ss a z", "h:mm:ss a z"}
+	times24h = [4]string{"HH:mm", "HH:mm:ss", "HH:mm:ss z", "HH:mm:ss z"}
+	times24H = [4]string{"H:mm", "H:mm:ss", "H:mm:ss z", "H:mm:ss z"}
+)
+
+func numberedMonths(suffix string) [12]string {
+	var months [12]string
+	for i := range months {
+		months[i] = strconv.Itoa(i+1) + suffix
+	}
+	return months
+}
+
+var englishDates = &dateSymbols{
+	months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	shortMonths:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	weekdays:      [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	shortWeekdays: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	am:            "AM",
+	pm:            "PM",
+	dates:         [4]string{"M/d/yy", "MMM d, y", "MMMM d, y", "EEEE, MMMM d, y"},
+	times:         times12h,
+	dateTime:      "{1}, {0}",
+}
+
+// dateSymbolsByLanguage holds each language's date symbols, and
+// dateSymbolsByRegion the regional variants that differ from their
+// language.
+var (
+	dateSymbolsByLanguage = map[string]*dateSymbols{
+		"ar": {
+			months:        [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+			shortMonths:   [12]string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"},
+			weekdays:      [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+			shortWeekdays: [7]string{"الأحد", "الاثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"},
+			am:            "ص",
+			pm:            "م",
+			dates:         [4]string{"d\u200f/M\u200f/y", "dd\u200f/MM\u200f/y", "d MMMM y", "EEEE، d MMMM y"},
+			times:         times12h,
+			dateTime:      "{1}، {0}",
+		},
+		"de": {
+			months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+			shortMonths:   [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+			weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+			shortWeekdays: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+			am:            "AM",
+			pm:            "PM",
+			dates:         [4]string{"dd.MM.yy", "dd.MM.y", "d. MMMM y", "EEEE, d. MMMM y"},
+			times:         times24h,
+			dateTime:      "{1}, {0}",
+		},
+		"en": englishDates,
+		"es": {
+			months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+			shortMonths:   [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+			weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+			shortWeekdays: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+			am:            "a. m.",
+			pm:            "p. m.",
+			dates:         [4]string{"d/M/yy", "d MMM y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+			times:         times24H,
+			dateTime:      "{1}, {0}",
+		},
+		"fr": {
+			months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+			shortMonths:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+			weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+			shortWeekdays: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+			am:            "AM",
+			pm:            "PM",
+			dates:         [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+			times:         times24h,
+			dateTime:      "{1} {0}",
+		},
+		"he": {
+			months:        [12]string{"ינואר", "פברואר", "מרץ", "אפריל", "מאי", "יוני", "יולי", "אוגוסט", "ספטמבר", "אוקטובר", "נובמבר", "דצמבר"},
+			shortMonths:   [12]string{"ינו׳", "פבר׳", "מרץ", "אפר׳", "מאי", "יוני", "יולי", "אוג׳", "ספט׳", "אוק׳", "נוב׳", "דצמ׳"},
+			weekdays:      [7]string{"יום ראשון", "יום שני", "יום שלישי", "יום רביעי", "יום חמישי", "יום שישי", "יום שבת"},
+			shortWeekdays: [7]string{"יום א׳", "יום ב׳", "יום ג׳", "יום ד׳", "יום ה׳", "יום ו׳", "שבת"},
+			am:            "לפנה״צ",
+			pm:            "אחה״צ",
+			dates:         [4]string{"d.M.y", "d בMMM y", "d בMMMM y", "EEEE, d בMMMM y"},
+			times:         times24H,
+			dateTime:      "{1}, {0}",
+		},
+		"it": {
+			months:        [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+			shortMonths:   [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+			weekdays:      [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+			shortWeekdays: [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+			am:            "AM",
+			pm:            "PM",
+			dates:         [4]string{"dd/MM/yy", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+			times:         times24h,
+			dateTime:      "{1}, {0}",
+		},
+		"ja": {
+			months:        numberedMonths("月"),
+			shortMonths:   numberedMonths("月"),
+			weekdays:      [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+			shortWeekdays: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+			am:            "午前",
+			pm:            "午後",
+			dates:         [4]string{"y/MM/dd", "y/MM/dd", "y年M月d日", "y年M月d日EEEE"},
+			times:         times24H,
+			dateTime:      "{1} {0}",
+		},
+		"ko": {
+			months:        numberedMonths("월"),
+			shortMonths:   numberedMonths("월"),
+			weekdays:      [7]string{"일요일", "월요일", "화요일", "수요일", "목요일", "금요일", "토요일"},
+			shortWeekdays: [7]string{"일", "월", "화", "수", "목", "금", "토"},
+			am:            "오전",
+			pm:            "오후",
+			dates:         [4]string{"yy. M. d.", "y. M. d.", "y년 MMMM d일", "y년 MMMM d일 EEEE"},
+			times:         [4]string{"a h:mm", "a h:mm:ss", "a h:mm:ss z", "a h:mm:ss z"},
+			dateTime:      "{1} {0}",
+		},
+		"nl": {
+			months:        [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+			shortMonths:   [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+			weekdays:      [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+			shortWeekdays: [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+			am:            "a.m.",
+			pm:            "p.m.",
+			dates:         [4]string{"dd-MM-y", "d MMM y", "d MMMM y", "EEEE d MMMM y"},
+			times:         times24h,
+			dateTime:      "{1} {0}",
+		},
+		"pt": {
+			months:        [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+			shortMonths:   [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+			weekdays:      [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+			shortWeekdays: [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+			am:            "AM",
+			pm:            "PM",
+			dates:         [4]string{"dd/MM/y", "d 'de' MMM 'de' y", "d 'de' MMMM 'de' y", "EEEE, d 'de' MMMM 'de' y"},
+			times:         times24h,
+			dateTime:      "{1} {0}",
+		},
+		"ru": {
+			months:          [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+			shortMonths:     [12]string{"янв.", "февр.", "мар.", "апр.", "мая", "июн.", "июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+			standalone:      &[12]string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+			shortStandalone: &[12]string{"янв.", "февр.", "март", "апр.", "май", "июнь", "июль", "авг.", "сент.", "окт.", "нояб.", "дек."},
+			weekdays:        [7]string{"воскресенье", "понедельник", "вторник", "среда", "четверг", "пятница", "суббота"},
+			shortWeekdays:   [7]string{"вс", "пн", "вт", "ср", "чт", "пт", "сб"},
+			am:              "AM",
+			pm:              "PM",
+			dates:           [4]string{"dd.MM.y", "d MMM y 'г'.", "d MMMM y 'г'.", "EEEE, d MMMM y 'г'."},
+			times:           times24h,
+			dateTime:        "{1}, {0}",
+		},
+		"tr": {
+			months:        [12]string{"Ocak", "Şubat", "Mart", "Nisan", "Mayıs", "Haziran", "Temmuz", "Ağustos", "Eylül", "Ekim", "Kasım", "Aralık"},
+			shortMonths:   [12]string{"Oca", "Şub", "Mar", "Nis", "May", "Haz", "Tem", "Ağu", "Eyl", "Eki", "Kas", "Ara"},
+			weekdays:      [7]string{"Pazar", "Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi"},
+			shortWeekdays: [7]string{"Paz", "Pzt", "Sal", "Çar", "Per", "Cum", "Cmt"},
+			am:            "ÖÖ",
+			pm:            "ÖS",
+			dates:         [4]string{"d.MM.y", "d MMM y", "d MMMM y", "d MMMM y EEEE"},
+			times:         times24h,
+			dateTime:      "{1} {0}",
+		},
+		"zh": {
+			months:        [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+			shortMonths:   numberedMonths("月"),
+			weekdays:      [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+			shortWeekdays: [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+			am:            "上午",
+			pm:            "下午",
+			dates:         [4]string{"y/M/d", "y年M月d日", "y年M月d日", "y年M月d日EEEE"},
+			times:         [4]string{"HH:mm", "HH:mm:ss", "z HH:mm:ss", "z HH:mm:ss"},
+			dateTime:      "{1} {0}",
+		},
+	}
+	dateSymbolsByRegion = map[string]*dateSymbols{
+		"en-AU": withDates(englishDates, [4]string{"d/M/yy", "d MMM y", "d MMMM y", "EEEE d MMMM y"}, times12h),
+		"en-GB": withDates(englishDates, [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"}, times24h),
+		"en-IE": withDates(englishDates, [4]string{"dd/MM/y", "d MMM y", "d MMMM y", "EEEE d MMMM y"}, times24h),
+		"en-IN": withDates(englishDates, [4]string{"dd/MM/yy", "dd-MMM-y", "d MMMM y", "EEEE, d MMMM, y"}, times12h),
+		"en-NZ": withDates(englishDates, [4]string{"d/MM/yy", "d/MM/y", "d MMMM y", "EEEE, d MMMM y"}, times12h),
+	}
+)
+
+// withDates returns a copy of s with other date and time patterns.
+func withDates(s *dateSymbols, dates, times [4]string) *dateSymbols {
+	c := *s
+	c.dates, c.times = dates, times
+	return &c
+}
+
+func dateSymbolsFor(locale Locale) *dateSymbols {
+	if s, ok := dateSymbolsByRegion[locale.Language+"-"+locale.Region]; ok {
+		return s
+	}
+	if s, ok := dateSymbolsByLanguage[locale.Language]; ok {
+		return s
+	}
+	return englishDates
+}
@@ -0,0 +1,112 @@
+package intl
+
+import (
+	"testing"
+	"time"
+)
+
+// friday is Friday, January 2, 2026 at 3:04:05 PM UTC.
+var friday = time.Date(2026, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+func TestDateFormat(t *testing.T) {
+	tests := []struct {
+		locale string
+		style  DateStyle
+		want   string
+	}{
+		{"en", DateStyleShort, "1/2/26"},
+		{"en", DateStyleMedium, "Jan 2, 2026"},
+		{"en", DateStyleLong, "January 2, 2026"},
+		{"en", DateStyleFull, "Friday, January 2, 2026"},
+		{"en-GB", DateStyleShort, "02/01/2026"},
+		{"de", DateStyleFull, "Freitag, 2. Januar 2026"},
+		{"de", DateStyleShort, "02.01.26"},
+		{"es", DateStyleLong, "2 de enero de 2026"},
+		{"fr", DateStyleFull, "vendredi 2 janvier 2026"},
+		{"ru", DateStyleLong, "2 января 2026 г."},
+		{"ja", DateStyleFull, "2026年1月2日金曜日"},
+		{"zh", DateStyleShort, "2026/1/2"},
+		{"ko", DateStyleLong, "2026년 1월 2일"},
+		{"tr", DateStyleFull, "2 Ocak 2026 Cuma"},
+		{"xx", DateStyleMedium, "Jan 2, 2026"},
+	}
+	for _, tt := range tests {
+		if got := NewDateFormat(ParseLocale(tt.locale), tt.style).Format(friday); got != tt.want {
+			t.Errorf("%s %v: got %q, want %q", tt.locale, tt.style, got, tt.want)
+		}
+	}
+}
+
+func TestTimeFormat(t *testing.T) {
+	tests := []struct {
+		locale string
+		style  DateStyle
+		want   string
+	}{
+		{"en", DateStyleShort, "3:04 PM"},
+		{"en", DateStyleLong, "3:04:05 PM UTC"},
+		{"en-GB", DateStyleShort, "15:04"},
+		{"de", DateStyleMedium, "15:04:05"},
+		{"ko", DateStyleShort, "오후 3:04"},
+	}
+	for _, tt := range tests {
+		if got := NewTimeFormat(ParseLocale(tt.locale), tt.style).Format(friday); got != tt.want {
+			t.Errorf("%s %v: got %q, want %q", tt.locale, tt.style, got, tt.want)
+		}
+	}
+
+	midnight := time.Date(2026, time.January, 2, 0, 5, 0, 0, time.UTC)
+	if got := NewTimeFormat(ParseLocale("en"), DateStyleShort).Format(midnight); got != "12:05 AM" {
+		t.Errorf("got %q, want 12:05 AM", got)
+	}
+}
+
+func TestDateTimeFormat(t *testing.T) {
+	if got := NewDateTimeFormat(ParseLocale("en"), DateStyleMedium, DateStyleShort).Format(friday); got != "Jan 2, 2026, 3:04 PM" {
+		t.Errorf("en: got %q", got)
+	}
+	if got := NewDateTimeFormat(ParseLocale("fr"), DateStyleShort, DateStyleShort).Format(friday); got != "02/01/2026 15:04" {
+		t.Errorf("fr: got %q", got)
+	}
+}
+
+func TestDatePattern(t *testing.T) {
+	tests := []struct {
+		locale, pattern, want string
+	}{
+		{"en", "EEE, d MMM yy", "Fri, 2 Jan 26"},
+		{"en", "yyyy-MM-dd'T'HH:mm:ss", "2026-01-02T15:04:05"},
+		{"en", "h 'o''clock' a", "3 o'clock PM"},
+		{"ru", "LLLL y", "январь 2026"},
+		{"ru", "d MMMM", "2 января"},
+	}
+	for _, tt := range tests {
+		f, err := NewDatePattern(ParseLocale(tt.locale), tt.pattern)
+		if err != nil {
+			t.Errorf("%q: %v", tt.pattern, err)
+			continue
+		}
+		if got := f.Format(friday); got != tt.want {
+			t.Errorf("%s %q: got %q, want %q", tt.locale, tt.pattern, got, tt.want)
+		}
+	}
+
+	for _, pattern := range []string{"yyyy-MM-dd Q", "'unterminated"} {
+		if _, err := NewDatePattern(ParseLocale("en"), pattern); err == nil {
+			t.Errorf("%q: expected an error", pattern)
+		}
+	}
+}
+
+func TestDateFormat_AllLocalesParse(t *testing.T) {
+	for language := range dateSymbolsByLanguage {
+		for style := DateStyleShort; style <= DateStyleFull; style++ {
+			NewDateTimeFormat(ParseLocale(language), style, style).Format(friday)
+		}
+	}
+	for tag := range dateSymbolsByRegion {
+		for style := DateStyleShort; style <= DateStyleFull; style++ {
+			NewDateTimeFormat(ParseLocale(tag), style, style).Format(friday)
+		}
+	}
+}
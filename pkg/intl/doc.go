@@ -0,0 +1,12 @@
+// Package intl localizes apps: it picks a locale from the user's preferred
+// languages, loads messages for it from ARB or JSON files, and formats them
+// with ICU plural and select support. [NumberFormat] and [DateFormat] write
+// numbers, currencies, and dates the way the locale does.
+//
+// Wrap the app in a [Localizations] widget and read messages with
+// [LocalizationsOf], or generate typed accessors from ARB files with
+// "drift gen-l10n":
+//
+//	title := intl.LocalizationsOf(ctx).Format("cartTitle", map[string]any{"count": n})
+//	title := l10n.Of(ctx).CartTitle(n) // generated
+package intl
@@ -0,0 +1,129 @@
+package intl
+
+import (
+	"github.com/go-drift/drift/internal/icu"
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// Locale identifies a language, optionally narrowed by script and region.
+// The zero Locale means no locale was chosen.
+type Locale struct {
+	// Language is the lowercase ISO 639 code, such as "en" or "zh".
+	Language string
+	// Script is the title-case ISO 15924 code, such as "Hant". Usually empty.
+	Script string
+	// Region is the uppercase ISO 3166 or UN M.49 code, such as "US" or "419".
+	Region string
+}
+
+// ParseLocale parses a BCP 47 language tag such as "en-US", "zh-Hant-TW", or
+// "pt_BR". Underscores are accepted as separators, case is normalized, and
+// subtags after the region (variants and extensions) are ignored. An empty or
+// malformed language yields the zero Locale.
+func ParseLocale(tag string) Locale {
+	language, script, region := icu.ParseTag(tag)
+	return Locale{Language: language, Script: script, Region: region}
+}
+
+// String returns the BCP 47 tag, such as "en-US", or "" for the zero Locale.
+func (l Locale) String() string {
+	return icu.FormatTag(l.Language, l.Script, l.Region)
+}
+
+// IsZero reports whether l is the zero Locale.
+func (l Locale) IsZero() bool {
+	return l == Locale{}
+}
+
+// rtlScripts are the ISO 15924 scripts written right to left.
+var rtlScripts = map[string]bool{
+	"Adlm": true, "Arab": true, "Hebr": true, "Mand": true, "Nkoo": true,
+	"Rohg": true, "Samr": true, "Syrc": true, "Thaa": true,
+}
+
+// rtlLanguages are the languages whose default script is written right to
+// left.
+var rtlLanguages = map[string]bool{
+	"ar": true, "ckb": true, "dv": true, "fa": true, "he": true, "iw": true,
+	"ks": true, "ps": true, "sd": true, "syr": true, "ug": true, "ur": true,
+	"yi": true,
+}
+
+// TextDirection returns the direction the locale's script is written in:
+// right to left for Arabic, Hebrew, Persian, Urdu, and similar languages,
+// and left to right otherwise. An explicit Script wins over the language,
+// so "az-Arab" is right to left and "ku-Latn" left to right.
+func (l Locale) TextDirection() graphics.TextDirection {
+	rtl := rtlLanguages[l.Language]
+	if l.Script != "" {
+		rtl = rtlScripts[l.Script]
+	}
+	if rtl {
+		return graphics.TextDirectionRTL
+	}
+	return graphics.TextDirectionLTR
+}
+
+// ResolveLocale picks the supported locale that best matches the user's
+// preferences, which are tried in order. For each preference, an exact match
+// wins, then a match on language and script, then a match on language alone,
+// preferring a supported locale without a region. When nothing matches, the
+// first supported locale is returned, so list the app's default language
+// first.
+//
+// ResolveLocale returns the zero Locale if supported is empty.
+func ResolveLocale(preferred, supported []Locale) Locale {
+	if len(supported) == 0 {
+		return Locale{}
+	}
+	for _, want := range preferred {
+		if want.IsZero() {
+			continue
+		}
+		if match, ok := bestMatch(want, supported); ok {
+			return match
+		}
+	}
+	return supported[0]
+}
+
+func bestMatch(want Locale, supported []Locale) (Locale, bool) {
+	for _, s := range supported {
+		if s == want {
+			return s, true
+		}
+	}
+	if want.Script != "" {
+		for _, s := range supported {
+			if s.Language == want.Language && s.Script == want.Script && s.Region == "" {
+				return s, true
+			}
+		}
+		for _, s := range supported {
+			if s.Language == want.Language && s.Script == want.Script {
+				return s, true
+			}
+		}
+	}
+	var languageOnly *Locale
+	for i, s := range supported {
+		if s.Language != want.Language {
+			continue
+		}
+		// A script that differs from the wanted one is a different writing
+		// system, such as Traditional vs Simplified Chinese.
+		if want.Script != "" && s.Script != "" && s.Script != want.Script {
+			continue
+		}
+		if s.Region == want.Region || s.Region == "" && s.Script == "" {
+			return s, true
+		}
+		if languageOnly == nil {
+			languageOnly = &supported[i]
+		}
+	}
+	if languageOnly != nil {
+		return *languageOnly, true
+	}
+	return Locale{}, false
+}
@@ -0,0 +1,87 @@
+package intl
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want Locale
+	}{
+		{"en", Locale{Language: "en"}},
+		{"en-US", Locale{Language: "en", Region: "US"}},
+		{"pt_br", Locale{Language: "pt", Region: "BR"}},
+		{"zh-hant-tw", Locale{Language: "zh", Script: "Hant", Region: "TW"}},
+		{"es-419", Locale{Language: "es", Region: "419"}},
+		{"de-DE-u-co-phonebk", Locale{Language: "de", Region: "DE"}},
+		{"", Locale{}},
+		{"1234", Locale{}},
+	}
+	for _, tt := range tests {
+		if got := ParseLocale(tt.tag); got != tt.want {
+			t.Errorf("ParseLocale(%q) = %+v, want %+v", tt.tag, got, tt.want)
+		}
+	}
+	if got := ParseLocale("zh_hant_TW").String(); got != "zh-Hant-TW" {
+		t.Errorf("expected zh-Hant-TW, got %q", got)
+	}
+}
+
+func TestResolveLocale(t *testing.T) {
+	supported := []Locale{
+		ParseLocale("en"),
+		ParseLocale("en-GB"),
+		ParseLocale("fr"),
+		ParseLocale("pt-BR"),
+		ParseLocale("zh-Hans"),
+		ParseLocale("zh-Hant"),
+	}
+	tests := []struct {
+		preferred []string
+		want      string
+	}{
+		{[]string{"en-GB"}, "en-GB"},
+		{[]string{"en-AU"}, "en"},
+		{[]string{"fr-CA"}, "fr"},
+		{[]string{"pt-PT"}, "pt-BR"},
+		{[]string{"zh-Hant-HK"}, "zh-Hant"},
+		{[]string{"ja", "fr-FR"}, "fr"},
+		{[]string{"ja"}, "en"},
+		{nil, "en"},
+	}
+	for _, tt := range tests {
+		var preferred []Locale
+		for _, tag := range tt.preferred {
+			preferred = append(preferred, ParseLocale(tag))
+		}
+		if got := ResolveLocale(preferred, supported).String(); got != tt.want {
+			t.Errorf("ResolveLocale(%v) = %q, want %q", tt.preferred, got, tt.want)
+		}
+	}
+	if got := ResolveLocale([]Locale{ParseLocale("en")}, nil); !got.IsZero() {
+		t.Errorf("expected the zero Locale with nothing supported, got %v", got)
+	}
+}
+
+func TestLocale_TextDirection(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want graphics.TextDirection
+	}{
+		{"en-US", graphics.TextDirectionLTR},
+		{"ar-EG", graphics.TextDirectionRTL},
+		{"he", graphics.TextDirectionRTL},
+		{"fa", graphics.TextDirectionRTL},
+		{"az-Arab", graphics.TextDirectionRTL},
+		{"ku-Latn", graphics.TextDirectionLTR},
+		{"", graphics.TextDirectionLTR},
+	}
+	for _, tt := range tests {
+		if got := ParseLocale(tt.tag).TextDirection(); got != tt.want {
+			t.Errorf("ParseLocale(%q).TextDirection() = %v, want %v", tt.tag, got, tt.want)
+		}
+	}
+}
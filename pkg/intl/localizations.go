@@ -0,0 +1,167 @@
+package intl
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// Localizations picks the app's locale and provides its messages to
+// descendants, which read them with [LocalizationsOf]:
+//
+//	intl.Localizations{
+//	    SupportedLocales: l10n.SupportedLocales,
+//	    Load:             l10n.Load,
+//	    Child:            MyApp{},
+//	}
+//
+// Unless Locale is set, the locale is resolved with [ResolveLocale] from the
+// user's preferred languages, which are read again whenever the app
+// resumes, so changing the system language takes effect on return.
+//
+// The child is wrapped in a [widgets.Directionality] for the locale's
+// [Locale.TextDirection], so layouts mirror for right-to-left languages.
+type Localizations struct {
+	core.StatefulBase
+
+	// SupportedLocales lists the locales the app has messages for. The first
+	// is used when none of the user's languages match.
+	SupportedLocales []Locale
+	// Locale forces a locale instead of following the system, such as a
+	// language chosen in the app's settings. It need not be supported; it
+	// is resolved against SupportedLocales like a system preference.
+	Locale Locale
+	// Load returns the messages for a resolved locale. Load errors are
+	// reported to the error handler and leave the previous messages in
+	// place. The generated Load function from "drift gen-l10n" fits here.
+	Load func(Locale) (*Messages, error)
+	// Child is the widget tree that uses the messages.
+	Child core.Widget
+}
+
+// CreateState creates the state for Localizations.
+func (l Localizations) CreateState() core.State {
+	return &localizationsState{}
+}
+
+type localizationsState struct {
+	core.StateBase
+	locale   Locale
+	messages *Messages
+}
+
+func (s *localizationsState) InitState() {
+	s.resolve()
+	s.OnDispose(platform.Lifecycle.AddListener(func() {
+		if platform.Lifecycle.IsResumed() && !s.IsDisposed() {
+			s.SetState(s.resolve)
+		}
+	}))
+}
+
+func (s *localizationsState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	w := s.Element().Widget().(Localizations)
+	old := oldWidget.(Localizations)
+	if w.Locale != old.Locale || !slices.Equal(w.SupportedLocales, old.SupportedLocales) {
+		s.resolve()
+	}
+}
+
+// resolve picks the locale and loads its messages if it changed.
+func (s *localizationsState) resolve() {
+	w := s.Element().Widget().(Localizations)
+	preferred := []Locale{w.Locale}
+	if w.Locale.IsZero() {
+		preferred = PreferredLocales()
+	}
+	locale := ResolveLocale(preferred, w.SupportedLocales)
+	if locale == s.locale && s.messages != nil {
+		return
+	}
+	if w.Load == nil {
+		s.locale = locale
+		return
+	}
+	messages, err := w.Load(locale)
+	if err != nil {
+		errors.Report(&errors.DriftError{
+			Op:   "intl.Localizations.load",
+			Kind: errors.KindInit,
+			Err:  err,
+		})
+		return
+	}
+	s.locale, s.messages = locale, messages
+}
+
+func (s *localizationsState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(Localizations)
+	return localizationsScope{
+		locale:   s.locale,
+		messages: s.messages,
+		child:    widgets.Directionality{TextDirection: s.locale.TextDirection(), Child: w.Child},
+	}
+}
+
+// PreferredLocales returns the user's preferred languages from the
+// platform, most preferred first, or nil when the platform doesn't report
+// them.
+func PreferredLocales() []Locale {
+	tags, err := platform.GetPreferredLocales()
+	if err != nil {
+		errors.Report(&errors.DriftError{
+			Op:      "intl.PreferredLocales",
+			Kind:    errors.KindPlatform,
+			Channel: "drift/locale",
+			Err:     err,
+		})
+		return nil
+	}
+	locales := make([]Locale, 0, len(tags))
+	for _, tag := range tags {
+		if l := ParseLocale(tag); !l.IsZero() {
+			locales = append(locales, l)
+		}
+	}
+	return locales
+}
+
+type localizationsScope struct {
+	core.InheritedBase
+	locale   Locale
+	messages *Messages
+	child    core.Widget
+}
+
+func (l localizationsScope) ChildWidget() core.Widget { return l.child }
+
+func (l localizationsScope) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	old, ok := oldWidget.(localizationsScope)
+	return !ok || l.locale != old.locale || l.messages != old.messages
+}
+
+var localizationsScopeType = reflect.TypeFor[localizationsScope]()
+
+// LocalizationsOf returns the messages provided by the nearest
+// [Localizations], registering the caller to rebuild when they change.
+// Without one, it returns nil, which formats every key as itself.
+func LocalizationsOf(ctx core.BuildContext) *Messages {
+	if scope, ok := ctx.DependOnInherited(localizationsScopeType, nil).(localizationsScope); ok {
+		return scope.messages
+	}
+	return nil
+}
+
+// LocaleOf returns the locale resolved by the nearest [Localizations], or
+// the zero Locale without one. Pass its String to Text.Locale for
+// language-aware line breaking and hyphenation.
+func LocaleOf(ctx core.BuildContext) Locale {
+	if scope, ok := ctx.DependOnInherited(localizationsScopeType, nil).(localizationsScope); ok {
+		return scope.locale
+	}
+	return Locale{}
+}
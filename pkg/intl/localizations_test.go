@@ -0,0 +1,113 @@
+package intl_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/intl"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// localeBridge reports locales as the user's preferred languages.
+type localeBridge struct {
+	locales []any
+}
+
+func (b *localeBridge) InvokeMethod(channel, method string, args []byte) ([]byte, error) {
+	if channel == "drift/locale" && method == "getPreferredLocales" {
+		return platform.DefaultCodec.Encode(map[string]any{"locales": b.locales})
+	}
+	return platform.DefaultCodec.Encode(nil)
+}
+func (b *localeBridge) StartEventStream(string) error { return nil }
+func (b *localeBridge) StopEventStream(string) error  { return nil }
+
+var catalog = map[string]map[string]string{
+	"en": {"greeting": "Hello"},
+	"fr": {"greeting": "Bonjour"},
+	"de": {"greeting": "Hallo"},
+}
+
+func loadCatalog(locale intl.Locale) (*intl.Messages, error) {
+	return intl.NewMessages(locale, catalog[locale.String()])
+}
+
+var supported = []intl.Locale{intl.ParseLocale("en"), intl.ParseLocale("fr"), intl.ParseLocale("de")}
+
+// greeting shows the localized greeting and the resolved locale.
+type greeting struct {
+	core.StatelessBase
+}
+
+func (greeting) Build(ctx core.BuildContext) core.Widget {
+	return widgets.Text{Content: intl.LocalizationsOf(ctx).Text("greeting") + " " + intl.LocaleOf(ctx).String()}
+}
+
+func TestLocalizations_FollowsPlatformLocales(t *testing.T) {
+	platform.SetupTestBridge(t.Cleanup)
+	bridge := &localeBridge{locales: []any{"ja-JP", "fr-CA"}}
+	platform.SetNativeBridge(bridge)
+
+	tester := drifttest.NewWidgetTesterWithT(t)
+	if err := tester.PumpWidget(intl.Localizations{
+		SupportedLocales: supported,
+		Load:             loadCatalog,
+		Child:            greeting{},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !tester.Find(drifttest.ByText("Bonjour fr")).Exists() {
+		t.Fatal("expected the French greeting")
+	}
+
+	// The user switches the system language while the app is backgrounded.
+	bridge.locales = []any{"de-AT"}
+	platform.Lifecycle.SetStateForTest(platform.LifecycleStatePaused)
+	platform.Lifecycle.SetStateForTest(platform.LifecycleStateResumed)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if !tester.Find(drifttest.ByText("Hallo de")).Exists() {
+		t.Error("expected the German greeting after resuming")
+	}
+}
+
+func TestLocalizations_LocaleOverride(t *testing.T) {
+	platform.SetupTestBridge(t.Cleanup)
+
+	tester := drifttest.NewWidgetTesterWithT(t)
+	pump := func(locale intl.Locale) {
+		t.Helper()
+		if err := tester.PumpWidget(intl.Localizations{
+			SupportedLocales: supported,
+			Locale:           locale,
+			Load:             loadCatalog,
+			Child:            greeting{},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The platform reports nothing, so the first supported locale is used.
+	pump(intl.Locale{})
+	if !tester.Find(drifttest.ByText("Hello en")).Exists() {
+		t.Fatal("expected the default greeting")
+	}
+
+	pump(intl.ParseLocale("de-CH"))
+	if !tester.Find(drifttest.ByText("Hallo de")).Exists() {
+		t.Error("expected the overridden locale to apply")
+	}
+}
+
+func TestLocalizationsOf_WithoutProvider(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	if err := tester.PumpWidget(greeting{}); err != nil {
+		t.Fatal(err)
+	}
+	if !tester.Find(drifttest.ByText("greeting ")).Exists() {
+		t.Error("expected keys to show as is without Localizations")
+	}
+}
@@ -0,0 +1,138 @@
+package intl
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-drift/drift/internal/icu"
+)
+
+// Messages holds one locale's translated messages, keyed by message ID.
+// Messages are written in a subset of ICU MessageFormat:
+//
+//	Hello, {name}!
+//	{count, plural, =0{No items} one{# item} other{# items}}
+//	{gender, select, female{She} male{He} other{They}} replied
+//	It''s free   // '' is an apostrophe; '{' quotes a brace
+//
+// Plural arguments choose a branch by exact value (=0) or by the locale's
+// CLDR plural category (zero, one, two, few, many); # in a branch is the
+// number. Select arguments choose by the argument's string value. Both fall
+// back to the required other branch.
+//
+// A nil *Messages is valid and formats every key as itself.
+type Messages struct {
+	locale   Locale
+	entries  map[string]*icu.Message
+	fallback *Messages
+}
+
+// NewMessages parses source, a map from message ID to ICU message, into
+// the messages for locale. It returns an error naming the first message
+// that doesn't parse.
+func NewMessages(locale Locale, source map[string]string) (*Messages, error) {
+	m := &Messages{locale: locale, entries: make(map[string]*icu.Message, len(source))}
+	keys := make([]string, 0, len(source))
+	for key := range source {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		message, err := icu.Parse(source[key])
+		if err != nil {
+			return nil, fmt.Errorf("intl: message %q: %w", key, err)
+		}
+		m.entries[key] = message
+	}
+	return m, nil
+}
+
+// ParseARB parses an Application Resource Bundle: a flat JSON object of
+// message IDs to ICU messages. Keys starting with "@" hold metadata and are
+// skipped, except "@@locale", which sets the locale. Plain JSON message
+// files, which have no metadata, parse the same way; pass their locale as
+// defaultLocale, which is used when the file has no "@@locale".
+func ParseARB(data []byte, defaultLocale Locale) (*Messages, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("intl: parse ARB: %w", err)
+	}
+	locale := defaultLocale
+	source := make(map[string]string, len(raw))
+	for key, value := range raw {
+		if key == "@@locale" {
+			var tag string
+			if err := json.Unmarshal(value, &tag); err != nil {
+				return nil, fmt.Errorf("intl: parse ARB: @@locale must be a string")
+			}
+			locale = ParseLocale(tag)
+			continue
+		}
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+		var message string
+		if err := json.Unmarshal(value, &message); err != nil {
+			return nil, fmt.Errorf("intl: parse ARB: message %q must be a string", key)
+		}
+		source[key] = message
+	}
+	return NewMessages(locale, source)
+}
+
+// Locale returns the locale the messages are written in.
+func (m *Messages) Locale() Locale {
+	if m == nil {
+		return Locale{}
+	}
+	return m.locale
+}
+
+// WithFallback returns a copy of m that looks up keys it doesn't have in
+// fallback, usually the app's default language, so untranslated messages
+// show in that language instead of as their keys.
+func (m *Messages) WithFallback(fallback *Messages) *Messages {
+	if m == nil {
+		return fallback
+	}
+	copied := *m
+	copied.fallback = fallback
+	return &copied
+}
+
+// Has reports whether key has a message, including in the fallback.
+func (m *Messages) Has(key string) bool {
+	_, _, ok := m.lookup(key)
+	return ok
+}
+
+// Text returns the message for key with no arguments. Missing keys are
+// returned as is, so they are easy to spot in the UI.
+func (m *Messages) Text(key string) string {
+	return m.Format(key, nil)
+}
+
+// Format returns the message for key with args substituted. Plural and
+// number arguments take any Go integer or float type; other arguments are
+// formatted with fmt.Sprint. Missing keys are returned as is, and missing
+// arguments are left as {name}.
+func (m *Messages) Format(key string, args map[string]any) string {
+	message, locale, ok := m.lookup(key)
+	if !ok {
+		return key
+	}
+	return message.Format(locale, args)
+}
+
+// lookup returns the parsed message for key and the locale to format it
+// for, which is the locale of whichever Messages had it.
+func (m *Messages) lookup(key string) (*icu.Message, string, bool) {
+	for ; m != nil; m = m.fallback {
+		if message, ok := m.entries[key]; ok {
+			return message, m.locale.String(), true
+		}
+	}
+	return nil, "", false
+}
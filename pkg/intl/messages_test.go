@@ -0,0 +1,135 @@
+package intl
+
+import (
+	"strings"
+	"testing"
+)
+
+func mustMessages(t *testing.T, tag string, source map[string]string) *Messages {
+	t.Helper()
+	m, err := NewMessages(ParseLocale(tag), source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestMessages_Format(t *testing.T) {
+	m := mustMessages(t, "en", map[string]string{
+		"greeting": "Hello, {name}!",
+		"items":    "{count, plural, =0{No items} one{# item} other{# items}}",
+		"reply":    "{gender, select, female{She} male{He} other{They}} replied",
+		"nested":   "{count, plural, one{{gender, select, female{her # cat} other{their # cat}}} other{# cats}}",
+		"total":    "Total: {amount, number}",
+		"quoted":   "It''s '{literal}' and 'so",
+	})
+	tests := []struct {
+		key  string
+		args map[string]any
+		want string
+	}{
+		{"greeting", map[string]any{"name": "Ada"}, "Hello, Ada!"},
+		{"greeting", nil, "Hello, {name}!"},
+		{"items", map[string]any{"count": 0}, "No items"},
+		{"items", map[string]any{"count": 1}, "1 item"},
+		{"items", map[string]any{"count": int64(42)}, "42 items"},
+		{"items", map[string]any{"count": 2.5}, "2.5 items"},
+		{"reply", map[string]any{"gender": "female"}, "She replied"},
+		{"reply", map[string]any{"gender": "unknown"}, "They replied"},
+		{"nested", map[string]any{"count": 1, "gender": "female"}, "her 1 cat"},
+		{"nested", map[string]any{"count": 3, "gender": "female"}, "3 cats"},
+		{"total", map[string]any{"amount": 9.5}, "Total: 9.5"},
+		{"quoted", nil, "It's {literal} and 'so"},
+		{"missing", nil, "missing"},
+	}
+	for _, tt := range tests {
+		if got := m.Format(tt.key, tt.args); got != tt.want {
+			t.Errorf("Format(%q, %v) = %q, want %q", tt.key, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestMessages_PluralUsesLocaleRules(t *testing.T) {
+	ru := mustMessages(t, "ru", map[string]string{
+		"files": "{n, plural, one{# файл} few{# файла} many{# файлов} other{# файла}}",
+	})
+	for n, want := range map[int]string{1: "1 файл", 3: "3 файла", 5: "5 файлов", 21: "21 файл"} {
+		if got := ru.Format("files", map[string]any{"n": n}); got != want {
+			t.Errorf("n=%d: expected %q, got %q", n, want, got)
+		}
+	}
+}
+
+func TestMessages_NumbersUseLocaleSymbols(t *testing.T) {
+	de := mustMessages(t, "de", map[string]string{"total": "Summe: {amount, number}"})
+	if got := de.Format("total", map[string]any{"amount": 1234.5}); got != "Summe: 1.234,5" {
+		t.Errorf("expected German separators, got %q", got)
+	}
+}
+
+func TestMessages_Fallback(t *testing.T) {
+	en := mustMessages(t, "en", map[string]string{"hello": "Hello", "bye": "Goodbye"})
+	fr := mustMessages(t, "fr", map[string]string{"hello": "Bonjour"}).WithFallback(en)
+
+	if got := fr.Text("hello"); got != "Bonjour" {
+		t.Errorf("expected Bonjour, got %q", got)
+	}
+	if got := fr.Text("bye"); got != "Goodbye" {
+		t.Errorf("expected the fallback Goodbye, got %q", got)
+	}
+	if !fr.Has("bye") || fr.Has("nope") {
+		t.Error("expected Has to include the fallback only")
+	}
+
+	var none *Messages
+	if got := none.Text("title"); got != "title" {
+		t.Errorf("expected nil Messages to return the key, got %q", got)
+	}
+}
+
+func TestNewMessages_Errors(t *testing.T) {
+	tests := map[string]string{
+		"{name":                          "expected",
+		"oops}":                          "unmatched",
+		"{n, plural, one{x}}":            "other",
+		"{n, date}":                      "unsupported",
+		"{n, plural, offset:1 other{x}}": "offsets",
+		"{n, plural, other{x}":           "expected",
+	}
+	for pattern, want := range tests {
+		_, err := NewMessages(ParseLocale("en"), map[string]string{"key": pattern})
+		if err == nil || !strings.Contains(err.Error(), want) || !strings.Contains(err.Error(), `"key"`) {
+			t.Errorf("%q: expected an error mentioning %q and the key, got %v", pattern, want, err)
+		}
+	}
+}
+
+func TestParseARB(t *testing.T) {
+	m, err := ParseARB([]byte(`{
+		"@@locale": "de_DE",
+		"title": "Einkaufswagen",
+		"@title": {"description": "Cart screen title"},
+		"items": "{count, plural, one{# Artikel} other{# Artikel}}"
+	}`), Locale{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Locale().String(); got != "de-DE" {
+		t.Errorf("expected de-DE, got %q", got)
+	}
+	if got := m.Text("title"); got != "Einkaufswagen" {
+		t.Errorf("expected Einkaufswagen, got %q", got)
+	}
+	if m.Has("@title") {
+		t.Error("expected metadata to be skipped")
+	}
+
+	plain, err := ParseARB([]byte(`{"title": "Panier"}`), ParseLocale("fr"))
+	if err != nil || plain.Locale().String() != "fr" {
+		t.Errorf("expected plain JSON to use the default locale, got %v, %v", plain.Locale(), err)
+	}
+
+	if _, err := ParseARB([]byte(`{"count": 3}`), Locale{}); err == nil {
+		t.Error("expected an error for a non-string message")
+	}
+}
@@ -0,0 +1,327 @@
+package intl
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-drift/drift/internal/icu"
+)
+
+const nbsp = "\u00a0"
+
+// NumberFormat formats numbers the way a locale writes them: its decimal
+// and group separators, minus sign, percent sign, currency placement, and
+// compact abbreviations. Create one with [NewNumberFormat],
+// [NewPercentFormat], [NewCurrencyFormat], [NewCompactFormat], or
+// [NewNumberPattern], usually for the locale from [LocaleOf]:
+//
+//	price := intl.NewCurrencyFormat(intl.LocaleOf(ctx), "EUR").Format(9.5)
+//	// "€9.50" in en, "9,50 €" in de
+//
+// Digits are always Latin (0-9). A NumberFormat is immutable and safe to
+// share.
+type NumberFormat struct {
+	symbols        icu.Symbols
+	prefix, suffix string
+	multiplier     float64
+	minInteger     int
+	minFraction    int
+	maxFraction    int
+	grouping       bool
+	compact        []compactUnit
+}
+
+// NewNumberFormat returns a decimal format for locale with grouping and up
+// to three fraction digits, such as "1,234.5" in en or "1.234,5" in de.
+func NewNumberFormat(locale Locale) *NumberFormat {
+	return &NumberFormat{
+		symbols:     numberSymbols(locale),
+		multiplier:  1,
+		minInteger:  1,
+		maxFraction: 3,
+		grouping:    true,
+	}
+}
+
+// NewPercentFormat returns a format for locale that multiplies by 100 and
+// adds the locale's percent sign, such as "25%" in en or "25 %" in de, with
+// no fraction digits.
+func NewPercentFormat(locale Locale) *NumberFormat {
+	f := NewNumberFormat(locale)
+	f.multiplier = 100
+	f.maxFraction = 0
+	f.prefix, f.suffix = f.symbols.PercentPrefix, f.symbols.PercentSuffix
+	return f
+}
+
+// NewCurrencyFormat returns a format for amounts in currency, an ISO 4217
+// code such as "USD" or "EUR". The symbol and its placement follow locale,
+// such as "$1,234.50" in en-US and "1.234,50 $" in de, and the number of
+// fraction digits follows the currency: none for JPY, three for KWD, and
+// two for most others. Currencies without a known symbol are written with
+// their code.
+func NewCurrencyFormat(locale Locale, currency string) *NumberFormat {
+	f := NewNumberFormat(locale)
+	currency = strings.ToUpper(currency)
+	digits := currencyDigits(currency)
+	f.minFraction, f.maxFraction = digits, digits
+	symbol := currencySymbol(locale, currency)
+	switch placement := currencyPlacementFor(locale); placement {
+	case currencyBefore:
+		f.prefix = symbol
+	case currencyBeforeSpaced:
+		f.prefix = symbol + nbsp
+	default:
+		f.suffix = nbsp + symbol
+	}
+	return f
+}
+
+// NewCompactFormat returns a format for locale that abbreviates large
+// numbers, such as "1.2K" and "35M" in en, "1,2 Mio." in de, and "1.2万" in
+// ja. Abbreviated numbers keep one fraction digit below 10 and none above.
+// Languages without compact data use English abbreviations.
+func NewCompactFormat(locale Locale) *NumberFormat {
+	f := NewNumberFormat(locale)
+	// CLDR doesn't group compact numbers shorter than five digits.
+	f.symbols.MinGrouping = max(f.symbols.MinGrouping, 2)
+	f.compact = compactUnits[locale.Language]
+	if f.compact == nil {
+		f.compact = compactUnits["en"]
+	}
+	return f
+}
+
+// NewNumberPattern returns a format for locale from an ICU decimal pattern
+// such as "#,##0.00", "0.###", "000", or "#,##0%". In the pattern:
+//
+//   - "0" is a required digit and "#" an optional one. The zeros before
+//     "." set the minimum integer digits, and the digits after it the
+//     minimum and maximum fraction digits.
+//   - "," turns on the locale's grouping.
+//   - "%" multiplies by 100. Other text before and after the digits is
+//     written as is; quote it with "'" if it contains pattern characters.
+//
+// The separators written are the locale's, not the pattern's. Negative
+// subpatterns (";"), exponents, and currency signs are not supported.
+func NewNumberPattern(locale Locale, pattern string) (*NumberFormat, error) {
+	f := NewNumberFormat(locale)
+	f.minInteger, f.maxFraction, f.grouping = 0, 0, false
+	var prefix, digits, suffix strings.Builder
+	inQuote := false
+	affix := func(c byte) {
+		if digits.Len() == 0 {
+			prefix.WriteByte(c)
+		} else {
+			suffix.WriteByte(c)
+		}
+	}
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\'' && strings.HasPrefix(pattern[i+1:], "'"):
+			affix(c)
+			i++
+		case c == '\'':
+			inQuote = !inQuote
+		case inQuote:
+			affix(c)
+		case strings.IndexByte("#0,.", c) >= 0:
+			if suffix.Len() > 0 {
+				return nil, fmt.Errorf("intl: number pattern %q: digits after the suffix", pattern)
+			}
+			digits.WriteByte(c)
+		case c == ';' || c == 'E' || c == '@' || strings.HasPrefix(pattern[i:], "¤"):
+			r, _ := utf8.DecodeRuneInString(pattern[i:])
+			return nil, fmt.Errorf("intl: number pattern %q: %q is not supported", pattern, r)
+		default:
+			if c == '%' {
+				f.multiplier = 100
+			}
+			affix(c)
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("intl: number pattern %q: unterminated quote", pattern)
+	}
+	integer, fraction, hasPoint := strings.Cut(digits.String(), ".")
+	if integer+fraction == "" || strings.ContainsAny(fraction, ".,") {
+		return nil, fmt.Errorf("intl: number pattern %q: malformed digits", pattern)
+	}
+	if strings.Contains(strings.TrimLeft(strings.ReplaceAll(integer, ",", ""), "#"), "#") {
+		return nil, fmt.Errorf("intl: number pattern %q: '#' after '0'", pattern)
+	}
+	f.grouping = strings.Contains(integer, ",")
+	f.minInteger = strings.Count(integer, "0")
+	if hasPoint {
+		f.minFraction = strings.Count(fraction, "0")
+		f.maxFraction = len(fraction)
+	}
+	f.prefix, f.suffix = prefix.String(), suffix.String()
+	return f, nil
+}
+
+// WithFractionDigits returns a copy of f that writes at least min and at
+// most max fraction digits. Compact formats ignore it.
+func (f *NumberFormat) WithFractionDigits(min, max int) *NumberFormat {
+	c := *f
+	c.minFraction, c.maxFraction = min, max
+	return &c
+}
+
+// Format returns n formatted with f.
+func (f *NumberFormat) Format(n float64) string {
+	if f.compact != nil {
+		return f.formatCompact(n)
+	}
+	return f.affix(icu.FormatDecimal(f.symbols, n*f.multiplier, f.minInteger, f.minFraction, f.maxFraction, f.grouping))
+}
+
+// affix adds f's prefix and suffix to formatted digits, keeping any minus
+// sign in front: "-$5.00" rather than "$-5.00".
+func (f *NumberFormat) affix(digits string) string {
+	sign := ""
+	if rest, ok := strings.CutPrefix(digits, f.symbols.Minus); ok {
+		sign, digits = f.symbols.Minus, rest
+	}
+	return sign + f.prefix + digits + f.suffix
+}
+
+func (f *NumberFormat) formatCompact(n float64) string {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return icu.FormatDecimal(f.symbols, n, 1, 0, 0, false)
+	}
+	abs := math.Abs(n)
+	unit := compactUnit{divisor: 1}
+	next := 0
+	for next < len(f.compact) && abs >= f.compact[next].divisor {
+		unit = f.compact[next]
+		next++
+	}
+	for {
+		value := abs / unit.divisor
+		digits := 0
+		if value < 10 {
+			digits = 1
+		}
+		rounded, _ := strconv.ParseFloat(strconv.FormatFloat(value, 'f', digits, 64), 64)
+		// Move up a unit when rounding reaches it, so 999,999 is "1M"
+		// rather than "1000K".
+		if next < len(f.compact) && rounded*unit.divisor >= f.compact[next].divisor {
+			unit = f.compact[next]
+			next++
+			continue
+		}
+		return icu.FormatDecimal(f.symbols, math.Copysign(value, n), 1, 0, digits, true) + unit.suffix
+	}
+}
+
+func numberSymbols(locale Locale) icu.Symbols {
+	return icu.NumberSymbols(locale.Language, locale.Region)
+}
+
+// compactUnit is an abbreviation for numbers of at least divisor.
+type compactUnit struct {
+	divisor float64
+	suffix  string
+}
+
+// compactUnits holds each language's short compact abbreviations from
+// CLDR, smallest first. German and Italian don't abbreviate thousands.
+var compactUnits = map[string][]compactUnit{
+	"de": {{1e6, nbsp + "Mio."}, {1e9, nbsp + "Mrd."}, {1e12, nbsp + "Bio."}},
+	"en": {{1e3, "K"}, {1e6, "M"}, {1e9, "B"}, {1e12, "T"}},
+	"es": {{1e3, nbsp + "mil"}, {1e6, nbsp + "M"}},
+	"fr": {{1e3, nbsp + "k"}, {1e6, nbsp + "M"}, {1e9, nbsp + "Md"}, {1e12, nbsp + "Bn"}},
+	"it": {{1e6, nbsp + "Mln"}, {1e9, nbsp + "Mrd"}, {1e12, nbsp + "Bln"}},
+	"ja": {{1e4, "万"}, {1e8, "億"}, {1e12, "兆"}},
+	"ko": {{1e3, "천"}, {1e4, "만"}, {1e8, "억"}, {1e12, "조"}},
+	"nl": {{1e3, nbsp + "K"}, {1e6, nbsp + "mln."}, {1e9, nbsp + "mld."}, {1e12, nbsp + "bln."}},
+	"pt": {{1e3, nbsp + "mil"}, {1e6, nbsp + "mi"}, {1e9, nbsp + "bi"}, {1e12, nbsp + "tri"}},
+	"ru": {{1e3, nbsp + "тыс."}, {1e6, nbsp + "млн"}, {1e9, nbsp + "млрд"}, {1e12, nbsp + "трлн"}},
+	"zh": {{1e4, "万"}, {1e8, "亿"}, {1e12, "万亿"}},
+}
+
+// currencySymbols are the symbols used for currencies everywhere, and
+// localCurrencySymbols the ones a language or locale writes differently,
+// usually its own currency.
+var (
+	currencySymbols = map[string]string{
+		"AUD": "A$", "BRL": "R$", "CAD": "CA$", "CNY": "CN¥", "EUR": "€",
+		"GBP": "£", "HKD": "HK$", "ILS": "₪", "INR": "₹", "JPY": "¥",
+		"KRW": "₩", "MXN": "MX$", "NZD": "NZ$", "PHP": "₱", "TWD": "NT$",
+		"USD": "$", "VND": "₫",
+	}
+	localCurrencySymbols = map[string]map[string]string{
+		"ja":    {"JPY": "￥"},
+		"pl":    {"PLN": "zł"},
+		"ru":    {"RUB": "₽"},
+		"sv":    {"SEK": "kr"},
+		"tr":    {"TRY": "₺"},
+		"zh":    {"CNY": "¥"},
+		"en-AU": {"AUD": "$", "USD": "US$"},
+		"en-CA": {"CAD": "$", "USD": "US$"},
+		"en-NZ": {"NZD": "$", "USD": "US$"},
+		"es-MX": {"MXN": "$", "USD": "USD"},
+		"fr-CA": {"CAD": "$", "USD": "$ US"},
+	}
+)
+
+func currencySymbol(locale Locale, currency string) string {
+	if symbol, ok := localCurrencySymbols[locale.Language+"-"+locale.Region][currency]; ok {
+		return symbol
+	}
+	if symbol, ok := localCurrencySymbols[locale.Language][currency]; ok {
+		return symbol
+	}
+	if symbol, ok := currencySymbols[currency]; ok {
+		return symbol
+	}
+	return currency
+}
+
+// currencyDigits returns the number of fraction digits amounts in currency
+// are written with.
+func currencyDigits(currency string) int {
+	switch currency {
+	case "CLP", "ISK", "JPY", "KRW", "VND":
+		return 0
+	case "BHD", "JOD", "KWD", "OMR", "TND":
+		return 3
+	}
+	return 2
+}
+
+type currencyPlacement int
+
+const (
+	currencyAfter currencyPlacement = iota
+	currencyBefore
+	currencyBeforeSpaced
+)
+
+// currencyPlacements holds where each language writes the currency symbol,
+// with regional variants keyed by language and region. Languages without
+// an entry write it before the number, as English does.
+var currencyPlacements = map[string]currencyPlacement{
+	"ar": currencyAfter, "de": currencyAfter, "es": currencyAfter,
+	"fr": currencyAfter, "he": currencyAfter, "it": currencyAfter,
+	"pl": currencyAfter, "pt": currencyBeforeSpaced, "ru": currencyAfter,
+	"sv": currencyAfter, "nl": currencyBeforeSpaced,
+	"de-AT": currencyBeforeSpaced, "de-CH": currencyBeforeSpaced,
+	"es-419": currencyBefore, "es-MX": currencyBefore, "es-US": currencyBefore,
+	"it-CH": currencyBeforeSpaced, "pt-PT": currencyAfter,
+}
+
+func currencyPlacementFor(locale Locale) currencyPlacement {
+	if placement, ok := currencyPlacements[locale.Language+"-"+locale.Region]; ok {
+		return placement
+	}
+	if placement, ok := currencyPlacements[locale.Language]; ok {
+		return placement
+	}
+	return currencyBefore
+}
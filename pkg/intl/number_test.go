@@ -0,0 +1,143 @@
+package intl
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+// spaced replaces "_" with a non-breaking space so expectations stay
+// readable.
+func spaced(s string) string {
+	return strings.ReplaceAll(s, "_", nbsp)
+}
+
+func TestNumberFormat(t *testing.T) {
+	tests := []struct {
+		locale string
+		n      float64
+		want   string
+	}{
+		{"en", 1234567.891, "1,234,567.891"},
+		{"en", 0.12345, "0.123"},
+		{"en", -1234.5, "-1,234.5"},
+		{"en", -0.0001, "0"},
+		{"en", math.Inf(-1), "-∞"},
+		{"de", 1234567.5, "1.234.567,5"},
+		{"de-CH", 1234567.5, "1’234’567.5"},
+		{"es", 1234, "1234"},
+		{"es", 12345, "12.345"},
+		{"fr", 1234.5, "1\u202f234,5"},
+		{"hi", 12345678, "1,23,45,678"},
+		{"sv", -5, "−5"},
+	}
+	for _, tt := range tests {
+		if got := NewNumberFormat(ParseLocale(tt.locale)).Format(tt.n); got != tt.want {
+			t.Errorf("%s: Format(%v) = %q, want %q", tt.locale, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestPercentFormat(t *testing.T) {
+	for locale, want := range map[string]string{"en": "25%", "de": spaced("25_%"), "tr": "%25"} {
+		if got := NewPercentFormat(ParseLocale(locale)).Format(0.25); got != want {
+			t.Errorf("%s: got %q, want %q", locale, got, want)
+		}
+	}
+}
+
+func TestCurrencyFormat(t *testing.T) {
+	tests := []struct {
+		locale, currency string
+		n                float64
+		want             string
+	}{
+		{"en-US", "USD", 1234.5, "$1,234.50"},
+		{"en-US", "USD", -5, "-$5.00"},
+		{"en-CA", "CAD", 5, "$5.00"},
+		{"en-US", "CAD", 5, "CA$5.00"},
+		{"de", "EUR", 1234.5, spaced("1.234,50_€")},
+		{"de-CH", "CHF", 10, spaced("CHF_10.00")},
+		{"nl", "EUR", 10, spaced("€_10,00")},
+		{"pt-BR", "BRL", 10, spaced("R$_10,00")},
+		{"ja", "JPY", 1234.5, "￥1,234"},
+		{"en", "KWD", 1, "KWD1.000"},
+		{"en", "xyz", 1, "XYZ1.00"},
+	}
+	for _, tt := range tests {
+		if got := NewCurrencyFormat(ParseLocale(tt.locale), tt.currency).Format(tt.n); got != tt.want {
+			t.Errorf("%s %s: Format(%v) = %q, want %q", tt.locale, tt.currency, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestCompactFormat(t *testing.T) {
+	tests := []struct {
+		locale string
+		n      float64
+		want   string
+	}{
+		{"en", 999, "999"},
+		{"en", 1234, "1.2K"},
+		{"en", 1000, "1K"},
+		{"en", 56789, "57K"},
+		{"en", 999999, "1M"},
+		{"en", -2500000, "-2.5M"},
+		{"en", 3.14159, "3.1"},
+		{"de", 1234, "1234"},
+		{"de", 12345, "12.345"},
+		{"de", 1200000, spaced("1,2_Mio.")},
+		{"ja", 12345, "1.2万"},
+		{"ja", 123456789, "1.2億"},
+		{"ko", 1234, "1.2천"},
+		{"xx", 1234, "1.2K"},
+	}
+	for _, tt := range tests {
+		if got := NewCompactFormat(ParseLocale(tt.locale)).Format(tt.n); got != tt.want {
+			t.Errorf("%s: Format(%v) = %q, want %q", tt.locale, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestNumberPattern(t *testing.T) {
+	tests := []struct {
+		locale, pattern string
+		n               float64
+		want            string
+	}{
+		{"en", "#,##0.00", 1234.5, "1,234.50"},
+		{"de", "#,##0.00", 1234.5, "1.234,50"},
+		{"en", "0.###", 1234.56789, "1234.568"},
+		{"en", "000", 7, "007"},
+		{"en", "#,##0%", 0.123, "12%"},
+		{"en", "'#'0", 5, "#5"},
+		{"en", "0 'o''clock'", 5, "5 o'clock"},
+		{"en", "$#,##0.00", -3, "-$3.00"},
+	}
+	for _, tt := range tests {
+		f, err := NewNumberPattern(ParseLocale(tt.locale), tt.pattern)
+		if err != nil {
+			t.Errorf("%q: %v", tt.pattern, err)
+			continue
+		}
+		if got := f.Format(tt.n); got != tt.want {
+			t.Errorf("%s %q: Format(%v) = %q, want %q", tt.locale, tt.pattern, tt.n, got, tt.want)
+		}
+	}
+
+	for _, pattern := range []string{"", "%", "0;-0", "0.0E0", "¤0", "0 x 0", "0#", "'0", "0.0.0"} {
+		if _, err := NewNumberPattern(ParseLocale("en"), pattern); err == nil {
+			t.Errorf("%q: expected an error", pattern)
+		}
+	}
+}
+
+func TestNumberFormat_WithFractionDigits(t *testing.T) {
+	f := NewNumberFormat(ParseLocale("en"))
+	if got := f.WithFractionDigits(2, 2).Format(3); got != "3.00" {
+		t.Errorf("got %q, want 3.00", got)
+	}
+	if got := f.Format(3); got != "3" {
+		t.Errorf("expected the original format unchanged, got %q", got)
+	}
+}
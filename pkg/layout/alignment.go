@@ -36,3 +36,35 @@ var (
 	// AlignmentBottomRight aligns to the bottom-right corner.
 	AlignmentBottomRight = Alignment{1, 1}
 )
+
+// AlignmentDirectional is an [Alignment] whose horizontal position follows
+// the reading direction. Start is -1 at the start edge (left in
+// left-to-right text, right in right-to-left text) and 1 at the end edge.
+type AlignmentDirectional struct {
+	Start float64
+	Y     float64
+}
+
+// Resolve returns the physical alignment for the given text direction.
+func (a AlignmentDirectional) Resolve(direction graphics.TextDirection) Alignment {
+	if direction == graphics.TextDirectionRTL {
+		return Alignment{X: -a.Start, Y: a.Y}
+	}
+	return Alignment{X: a.Start, Y: a.Y}
+}
+
+// Common directional alignment presets.
+var (
+	// AlignmentTopStart aligns to the top corner on the start side.
+	AlignmentTopStart = AlignmentDirectional{-1, -1}
+	// AlignmentTopEnd aligns to the top corner on the end side.
+	AlignmentTopEnd = AlignmentDirectional{1, -1}
+	// AlignmentCenterStart aligns to the center of the start edge.
+	AlignmentCenterStart = AlignmentDirectional{-1, 0}
+	// AlignmentCenterEnd aligns to the center of the end edge.
+	AlignmentCenterEnd = AlignmentDirectional{1, 0}
+	// AlignmentBottomStart aligns to the bottom corner on the start side.
+	AlignmentBottomStart = AlignmentDirectional{-1, 1}
+	// AlignmentBottomEnd aligns to the bottom corner on the end side.
+	AlignmentBottomEnd = AlignmentDirectional{1, 1}
+)
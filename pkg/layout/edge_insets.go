@@ -1,5 +1,7 @@
 package layout
 
+import "github.com/go-drift/drift/pkg/graphics"
+
 // EdgeInsets represents padding/margin on four sides.
 type EdgeInsets struct {
 	Left   float64
@@ -132,3 +134,27 @@ func (e EdgeInsets) OnlyHorizontal() EdgeInsets {
 func (e EdgeInsets) OnlyVertical() EdgeInsets {
 	return EdgeInsets{Top: e.Top, Bottom: e.Bottom}
 }
+
+// EdgeInsetsDirectional is padding whose horizontal sides follow the reading
+// direction: Start is the left side in left-to-right text and the right side
+// in right-to-left text. Resolve it against a [graphics.TextDirection], or
+// use widgets.PaddingDirectional, which reads the direction from the tree.
+type EdgeInsetsDirectional struct {
+	Start  float64
+	Top    float64
+	End    float64
+	Bottom float64
+}
+
+// EdgeInsetsDirectionalOnly creates directional padding with explicit values.
+func EdgeInsetsDirectionalOnly(start, top, end, bottom float64) EdgeInsetsDirectional {
+	return EdgeInsetsDirectional{Start: start, Top: top, End: end, Bottom: bottom}
+}
+
+// Resolve returns the physical insets for the given text direction.
+func (e EdgeInsetsDirectional) Resolve(direction graphics.TextDirection) EdgeInsets {
+	if direction == graphics.TextDirectionRTL {
+		return EdgeInsets{Left: e.End, Top: e.Top, Right: e.Start, Bottom: e.Bottom}
+	}
+	return EdgeInsets{Left: e.Start, Top: e.Top, Right: e.End, Bottom: e.Bottom}
+}
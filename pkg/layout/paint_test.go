@@ -283,7 +283,9 @@ func (c *nullPaintCanvas) DrawText(layout *graphics.TextLayout, position graphic
 func (c *nullPaintCanvas) DrawImage(img image.Image, position graphics.Offset)                     {}
 func (c *nullPaintCanvas) DrawImageRect(img image.Image, srcRect, dstRect graphics.Rect, quality graphics.FilterQuality, cacheKey uintptr) {
 }
-func (c *nullPaintCanvas) DrawPath(path *graphics.Path, paint graphics.Paint)              {}
+func (c *nullPaintCanvas) DrawPath(path *graphics.Path, paint graphics.Paint) {}
+func (c *nullPaintCanvas) DrawVertices(vertices *graphics.Vertices, mode graphics.BlendMode, paint graphics.Paint) {
+}
 func (c *nullPaintCanvas) DrawRectShadow(rect graphics.Rect, shadow graphics.BoxShadow)    {}
 func (c *nullPaintCanvas) DrawRRectShadow(rrect graphics.RRect, shadow graphics.BoxShadow) {}
 func (c *nullPaintCanvas) SaveLayerBlur(bounds graphics.Rect, sigmaX, sigmaY float64)      {}
@@ -4,6 +4,8 @@ import (
 	"cmp"
 	"reflect"
 	"slices"
+
+	"github.com/go-drift/drift/pkg/gestures"
 )
 
 const defaultTypeCountLimit = 5
@@ -32,6 +34,22 @@ type PipelineOwner struct {
 	needsSemantics      bool
 	lastRootConstraints Constraints // previous root constraints for change detection
 	hasRootConstraints  bool        // true after the first FlushLayoutForRoot call
+	arena               *gestures.GestureArena
+}
+
+// SetGestureArena sets the arena that gesture recognizers of render objects
+// owned by p join. Each engine instance uses its own arena.
+func (p *PipelineOwner) SetGestureArena(arena *gestures.GestureArena) {
+	p.arena = arena
+}
+
+// GestureArena returns the arena set by [PipelineOwner.SetGestureArena], or
+// [gestures.DefaultArena] if none was set.
+func (p *PipelineOwner) GestureArena() *gestures.GestureArena {
+	if p == nil || p.arena == nil {
+		return gestures.DefaultArena
+	}
+	return p.arena
 }
 
 // ScheduleLayout marks a relayout boundary as needing layout.
@@ -1,6 +1,10 @@
 package layout
 
 import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/gestures"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/semantics"
 )
@@ -51,6 +55,14 @@ type RepaintBoundaryNode interface {
 	NeedsPaint() bool
 }
 
+// DeviceScaleListener is implemented by render objects that cache state
+// tied to the device pixel scale. When the scale changes at runtime, the
+// engine calls DeviceScaleChanged on every render object in the tree that
+// implements it, before laying out and painting the tree again.
+type DeviceScaleListener interface {
+	DeviceScaleChanged(scale float64)
+}
+
 // SemanticScrollOffsetProvider is implemented by scrollable render objects.
 // The accessibility system uses this to adjust child positions for scroll offset.
 type SemanticScrollOffsetProvider interface {
@@ -84,6 +96,10 @@ type RenderBoxBase struct {
 
 // Size returns the current size of the render box.
 func (r *RenderBoxBase) Size() graphics.Size {
+	if r.needsLayout && r.self != nil && errors.StrictModeEnabled() {
+		errors.ReportStrictModeViolation(errors.RuleSizeBeforeLayout,
+			reflect.TypeOf(r.self).String(), "Size read before layout; lay the box out first or read it after layout")
+	}
 	return r.size
 }
 
@@ -222,6 +238,12 @@ func (r *RenderBoxBase) SetOwner(owner *PipelineOwner) {
 	r.owner = owner
 }
 
+// GestureArena returns the gesture arena of the engine that owns this render
+// object, falling back to [gestures.DefaultArena] before it is attached.
+func (r *RenderBoxBase) GestureArena() *gestures.GestureArena {
+	return r.owner.GestureArena()
+}
+
 // SetSelf registers the concrete render object for scheduling.
 func (r *RenderBoxBase) SetSelf(self RenderObject) {
 	r.self = self
@@ -3,6 +3,7 @@ package layout
 import (
 	"testing"
 
+	"github.com/go-drift/drift/pkg/errors"
 	"github.com/go-drift/drift/pkg/graphics"
 )
 
@@ -451,3 +452,38 @@ func TestLayer_Dispose_Idempotent(t *testing.T) {
 		t.Error("Content should be nil after dispose")
 	}
 }
+
+type strictErrorHandler struct {
+	errors.LogHandler
+	rules []errors.StrictModeRule
+}
+
+func (h *strictErrorHandler) HandleError(err *errors.DriftError) {
+	if v, ok := err.Err.(*errors.StrictModeViolation); ok {
+		h.rules = append(h.rules, v.Rule)
+	}
+}
+
+func TestSize_StrictModeReportsReadBeforeLayout(t *testing.T) {
+	handler := &strictErrorHandler{}
+	errors.SetHandler(handler)
+	errors.SetStrictMode(true)
+	defer func() {
+		errors.SetStrictMode(false)
+		errors.SetHandler(nil)
+	}()
+
+	box := &testRenderBox{}
+	box.SetSelf(box)
+	box.Size()
+	if len(handler.rules) != 1 || handler.rules[0] != errors.RuleSizeBeforeLayout {
+		t.Fatalf("expected size_before_layout violation, got %v", handler.rules)
+	}
+
+	errors.SetStrictMode(true) // reset dedup
+	box.Layout(Tight(graphics.Size{Width: 10, Height: 10}), false)
+	box.Size()
+	if len(handler.rules) != 1 {
+		t.Errorf("expected no violation after layout, got %v", handler.rules)
+	}
+}
@@ -149,7 +149,7 @@ func (r *BottomSheetRoute) insertEntries() {
 			HandleBottomPadding: themeData.HandleBottomPadding,
 		}
 
-		return widgets.BottomSheet{
+		sheet := widgets.BottomSheet{
 			Builder:      r.builder,
 			Controller:   r.controller,
 			SnapPoints:   r.SnapPoints,
@@ -163,6 +163,7 @@ func (r *BottomSheetRoute) insertEntries() {
 			// Called when dismiss animation completes
 			OnDismiss: r.onAnimationComplete,
 		}
+		return widgets.FocusScope{Trap: true, Child: sheet}
 	})
 	r.sheetEntry.Opaque = true // Block hit testing below when in sheet area
 
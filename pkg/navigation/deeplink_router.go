@@ -5,7 +5,7 @@ import (
 	"sync"
 	"sync/atomic"
 
-	"github.com/go-drift/drift/pkg/drift"
+	"github.com/go-drift/drift/pkg/engine"
 	"github.com/go-drift/drift/pkg/platform"
 )
 
@@ -96,14 +96,14 @@ func (c *DeepLinkController) handleLink(link platform.DeepLink) {
 	if !ok {
 		return
 	}
-	drift.Dispatch(func() {
+	engine.Dispatch(func() {
 		c.navigate(route)
 	})
 }
 
 func (c *DeepLinkController) handleError(err error) {
 	if c.OnError != nil {
-		drift.Dispatch(func() {
+		engine.Dispatch(func() {
 			c.OnError(err)
 		})
 	}
@@ -123,7 +123,7 @@ func (c *DeepLinkController) navigate(route DeepLinkRoute) {
 	c.retryScheduled = true
 	c.mu.Unlock()
 
-	drift.Dispatch(c.flushPending)
+	engine.Dispatch(c.flushPending)
 }
 
 func (c *DeepLinkController) flushPending() {
@@ -145,7 +145,7 @@ func (c *DeepLinkController) flushPending() {
 	if c.pendingRoute != nil && !c.retryScheduled {
 		c.retryScheduled = true
 		c.mu.Unlock()
-		drift.Dispatch(c.flushPending)
+		engine.Dispatch(c.flushPending)
 		return
 	}
 	c.mu.Unlock()
@@ -0,0 +1,93 @@
+package navigation
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// DialogOptions configures [ShowDialog].
+type DialogOptions struct {
+	// Builder creates the dialog content, which is centered above the
+	// barrier. Required.
+	Builder func(ctx core.BuildContext) core.Widget
+
+	// Persistent prevents the barrier tap from dismissing the dialog.
+	// Default is false (barrier tap dismisses).
+	Persistent bool
+
+	// BarrierColor is the color drawn behind the dialog.
+	// If nil, defaults to DefaultBarrierColor.
+	BarrierColor *graphics.Color
+
+	// BarrierLabel is the accessibility label for the barrier.
+	// Defaults to "Dismiss".
+	BarrierLabel string
+
+	// Settings are passed to the dialog route, for observers and guards.
+	Settings RouteSettings
+}
+
+// ShowDialog pushes a dialog as a [ModalRoute] on the nearest navigator and
+// returns a buffered channel (size 1) that receives the dialog's result.
+//
+// Unlike [overlay.ShowDialog], the dialog is part of the route stack: the
+// back button and barrier tap pop it, route observers see it, and in nested
+// navigators it belongs to the navigator that contains ctx. Close it with a
+// result from the dialog content:
+//
+//	navigation.NavigatorOf(ctx).Pop(true)
+//
+// The channel receives the value the dialog was popped with (nil for a
+// barrier tap) and is then closed. If the dialog is removed without being
+// popped, for example by PopUntil, it receives nil. If there is no
+// navigator or Builder is nil, it receives nil immediately.
+//
+// Example:
+//
+//	confirmed := navigation.ShowDialog(ctx, navigation.DialogOptions{
+//	    Builder: func(ctx core.BuildContext) core.Widget {
+//	        return overlay.AlertDialog{
+//	            Title: widgets.Text{Content: "Delete item?"},
+//	            Actions: []core.Widget{
+//	                widgets.Button{Label: "Delete", OnTap: func() {
+//	                    navigation.NavigatorOf(ctx).Pop(true)
+//	                }},
+//	            },
+//	        }
+//	    },
+//	})
+//	go func() {
+//	    if ok, _ := (<-confirmed).(bool); ok {
+//	        drift.Dispatch(deleteItem)
+//	    }
+//	}()
+func ShowDialog(ctx core.BuildContext, opts DialogOptions) <-chan any {
+	result := make(chan any, 1) // Buffered to prevent blocking
+
+	rn, ok := NavigatorOf(ctx).(resultNavigator)
+	if !ok || opts.Builder == nil {
+		result <- nil
+		close(result)
+		return result
+	}
+
+	route := NewModalRoute(func(ctx core.BuildContext) core.Widget {
+		return widgets.Center{Child: opts.Builder(ctx)}
+	}, opts.Settings)
+	route.BarrierDismissible = !opts.Persistent
+	if opts.BarrierColor != nil {
+		route.BarrierColor = opts.BarrierColor
+	}
+	if opts.BarrierLabel != "" {
+		route.BarrierLabel = opts.BarrierLabel
+	}
+
+	rn.pushForResult(func(nav NavigatorState) {
+		nav.Push(route)
+	}, func(value any, popped bool) {
+		result <- value
+		close(result)
+	})
+	return result
+}
@@ -0,0 +1,66 @@
+package navigation
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/widgets"
+
+	dtesting "github.com/go-drift/drift/pkg/testing"
+)
+
+func TestShowDialog_DeliversPopResult(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+
+	var routeCtx core.BuildContext
+	err := tester.PumpWidget(Navigator{
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewPageRoute(func(ctx core.BuildContext) core.Widget {
+				routeCtx = ctx
+				return widgets.Text{Content: settings.Name}
+			}, settings)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nav := NavigatorOf(routeCtx)
+	ch := ShowDialog(routeCtx, DialogOptions{
+		Builder: func(ctx core.BuildContext) core.Widget {
+			return widgets.Text{Content: "Delete item?"}
+		},
+		Settings: RouteSettings{Name: "/confirm"},
+	})
+	if !nav.CanPop() {
+		t.Fatal("expected the dialog route to be pushed")
+	}
+
+	nav.Pop(true)
+	value, ok := <-ch
+	if !ok || value != true {
+		t.Errorf("expected result true, got %v (ok=%v)", value, ok)
+	}
+	if _, ok := <-ch; ok {
+		t.Error("expected channel to be closed after the result")
+	}
+}
+
+func TestShowDialog_NoNavigator(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+
+	var ch <-chan any
+	err := tester.PumpWidget(transitionProbe{onBuild: func(ctx core.BuildContext) {
+		ch = ShowDialog(ctx, DialogOptions{
+			Builder: func(ctx core.BuildContext) core.Widget { return widgets.SizedBox{} },
+		})
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if value, ok := <-ch; !ok || value != nil {
+		t.Errorf("expected immediate nil result, got %v (ok=%v)", value, ok)
+	}
+}
@@ -0,0 +1,493 @@
+package navigation
+
+import (
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// Hero marks a widget as a shared element between pages. When a route whose
+// [PageTransition] has Heroes set is pushed or popped, each Hero on it that
+// has a Hero with an equal Tag on the route underneath flies from one
+// page's position and size to the other's, above both pages, while the two
+// originals are hidden.
+//
+// The flying widget is the destination page's Child: the pushed page's on
+// push, and the revealed page's on pop. Give both Heroes children that look
+// alike at their own sizes, such as the same image.
+//
+//	// In the list
+//	navigation.Hero{Tag: "photo-" + id, Child: thumbnail}
+//
+//	// On the detail page
+//	navigation.Hero{Tag: "photo-" + id, Child: fullImage}
+//
+// Tags must be comparable and unique within a route.
+type Hero struct {
+	core.StatefulBase
+
+	// Tag pairs this Hero with the Hero of equal tag on the other page.
+	Tag any
+
+	// CrossFade blends the two pages' children at the flying position, the
+	// outgoing one fading out as the incoming one fades in, rather than
+	// flying the destination's child alone. Use it for elements that look
+	// different on each page, such as app bars.
+	CrossFade bool
+
+	// Child is the shared element.
+	Child core.Widget
+}
+
+// CreateState creates the Hero's state.
+func (h Hero) CreateState() core.State {
+	return &heroState{}
+}
+
+// appBarHeroTag is the tag [AppBarHero] gives app bars.
+type appBarHeroTag struct{}
+
+// AppBarHero wraps a page's app bar so that, during a transition with
+// Heroes set such as [SharedElementPageTransition], it cross-fades with the
+// other page's app bar in place instead of fading out and back in with the
+// page content.
+func AppBarHero(appBar core.Widget) Hero {
+	return Hero{Tag: appBarHeroTag{}, CrossFade: true, Child: appBar}
+}
+
+type heroState struct {
+	core.StateBase
+	nav    *navigatorState
+	route  Route
+	tag    any
+	box    *renderHero
+	flying bool
+}
+
+func (s *heroState) Build(ctx core.BuildContext) core.Widget {
+	hero := s.Element().Widget().(Hero)
+	nav, _ := NavigatorOf(ctx).(*navigatorState)
+	route := RouteOf(ctx)
+	if nav != s.nav || route != s.route || hero.Tag != s.tag {
+		s.unregister()
+		if nav != nil && route != nil {
+			nav.registerHero(route, s)
+		}
+		s.nav, s.route, s.tag = nav, route, hero.Tag
+	}
+	return heroBox{state: s, child: hero.Child}
+}
+
+func (s *heroState) Dispose() {
+	s.unregister()
+	s.StateBase.Dispose()
+}
+
+func (s *heroState) unregister() {
+	if s.nav != nil {
+		s.nav.unregisterHero(s.route, s)
+	}
+	s.nav, s.route = nil, nil
+}
+
+// setFlying hides or shows the hero while a flight stands in for it.
+func (s *heroState) setFlying(flying bool) {
+	if s.flying == flying {
+		return
+	}
+	s.flying = flying
+	if s.box != nil {
+		s.box.MarkNeedsPaint()
+	}
+}
+
+// rectIn returns the hero's bounds in the coordinate space of target, or
+// false if the hero isn't laid out in the same tree.
+func (s *heroState) rectIn(target layout.RenderObject) (graphics.Rect, bool) {
+	if s.box == nil {
+		return graphics.Rect{}, false
+	}
+	// Record where target's origin sits in each of its ancestors, then
+	// walk up from the hero until reaching one of them.
+	targetOffsets := make(map[layout.RenderObject]graphics.Offset)
+	var offset graphics.Offset
+	for r := target; r != nil; {
+		targetOffsets[r] = offset
+		parent := renderParent(r)
+		offset = addOffsets(offset, offsetInParent(r, parent))
+		r = parent
+	}
+	offset = graphics.Offset{}
+	for r := layout.RenderObject(s.box); r != nil; {
+		if targetOffset, ok := targetOffsets[r]; ok {
+			size := s.box.Size()
+			return graphics.RectFromLTWH(offset.X-targetOffset.X, offset.Y-targetOffset.Y, size.Width, size.Height), true
+		}
+		parent := renderParent(r)
+		offset = addOffsets(offset, offsetInParent(r, parent))
+		r = parent
+	}
+	return graphics.Rect{}, false
+}
+
+func renderParent(r layout.RenderObject) layout.RenderObject {
+	if child, ok := r.(interface{ Parent() layout.RenderObject }); ok {
+		return child.Parent()
+	}
+	return nil
+}
+
+// offsetInParent returns where r's origin sits in parent, including any
+// paint-time offset parent applies to its children.
+func offsetInParent(r, parent layout.RenderObject) graphics.Offset {
+	var offset graphics.Offset
+	if data, ok := r.ParentData().(*layout.BoxParentData); ok && data != nil {
+		offset = data.Offset
+	}
+	if provider, ok := parent.(core.ScrollOffsetProvider); ok {
+		offset = addOffsets(offset, provider.ScrollOffset())
+	}
+	return offset
+}
+
+func addOffsets(a, b graphics.Offset) graphics.Offset {
+	return graphics.Offset{X: a.X + b.X, Y: a.Y + b.Y}
+}
+
+// registerHero adds hero to route's heroes.
+func (s *navigatorState) registerHero(route Route, hero *heroState) {
+	if s.heroes == nil {
+		s.heroes = make(map[Route][]*heroState)
+	}
+	s.heroes[route] = append(s.heroes[route], hero)
+}
+
+// unregisterHero removes hero from route's heroes.
+func (s *navigatorState) unregisterHero(route Route, hero *heroState) {
+	heroes := s.heroes[route]
+	for i, h := range heroes {
+		if h == hero {
+			heroes = append(heroes[:i], heroes[i+1:]...)
+			break
+		}
+	}
+	if len(heroes) == 0 {
+		delete(s.heroes, route)
+		return
+	}
+	s.heroes[route] = heroes
+}
+
+// heroFor returns route's hero with tag, or nil.
+func (s *navigatorState) heroFor(route Route, tag any) *heroState {
+	for _, h := range s.heroes[route] {
+		if h.tag == tag {
+			return h
+		}
+	}
+	return nil
+}
+
+// heroTransitionRoute is implemented by routes whose transition can fly
+// heroes.
+type heroTransitionRoute interface {
+	// flightsHeroes reports whether the route's transition flies heroes.
+	flightsHeroes(ctx core.BuildContext) bool
+}
+
+// heroBox paints a hero's child unless a flight stands in for it.
+type heroBox struct {
+	core.RenderObjectBase
+	state *heroState
+	child core.Widget
+}
+
+func (h heroBox) ChildWidget() core.Widget { return h.child }
+
+func (h heroBox) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderHero{state: h.state}
+	r.SetSelf(r)
+	h.state.box = r
+	return r
+}
+
+func (h heroBox) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderHero); ok && r.state != h.state {
+		r.state = h.state
+		h.state.box = r
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderHero struct {
+	layout.RenderBoxBase
+	child layout.RenderBox
+	state *heroState
+}
+
+func (r *renderHero) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child, _ = child.(layout.RenderBox)
+	layout.SetParentOnChild(r.child, r.Self())
+}
+
+func (r *renderHero) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderHero) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child == nil {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+		return
+	}
+	r.child.Layout(constraints, true)
+	r.SetSize(r.child.Size())
+	r.child.SetParentData(&layout.BoxParentData{})
+}
+
+func (r *renderHero) Paint(ctx *layout.PaintContext) {
+	if r.child != nil && !r.state.flying {
+		ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+	}
+}
+
+func (r *renderHero) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.child == nil {
+		return false
+	}
+	return r.child.HitTest(position, result)
+}
+
+func (r *renderHero) Dispose() {
+	if r.state != nil && r.state.box == r {
+		r.state.box = nil
+	}
+	r.RenderBoxBase.Dispose()
+}
+
+// heroFlightLayer flies the heroes shared by two routes while animation
+// runs. lower is the route underneath, shown at animation value 0, and
+// upper the route being pushed or popped, shown at 1.
+type heroFlightLayer struct {
+	core.StatefulBase
+	nav          *navigatorState
+	lower, upper Route
+	animation    *animation.AnimationController
+	popping      bool
+}
+
+// Key gives each push and pop its own flights.
+func (l heroFlightLayer) Key() any {
+	return heroFlightKey{upper: l.upper, popping: l.popping}
+}
+
+type heroFlightKey struct {
+	upper   Route
+	popping bool
+}
+
+func (l heroFlightLayer) CreateState() core.State {
+	return &heroFlightLayerState{}
+}
+
+type heroFlightLayerState struct {
+	core.StateBase
+	flights []core.Widget
+	hidden  []*heroState
+}
+
+// Build defers finding the heroes to layout, after both routes have built
+// and laid out, including any content they build lazily.
+func (s *heroFlightLayerState) Build(ctx core.BuildContext) core.Widget {
+	return widgets.ExcludeSemantics{
+		Excluding: true,
+		Child: widgets.IgnorePointer{
+			Ignoring: true,
+			Child: widgets.LayoutBuilder{Builder: func(ctx core.BuildContext, constraints layout.Constraints) core.Widget {
+				if s.flights == nil {
+					s.startFlights()
+				}
+				return widgets.Stack{Fit: widgets.StackFitExpand, Children: s.flights}
+			}},
+		},
+	}
+}
+
+// startFlights pairs the routes' heroes, hides them, and builds a flight
+// for each pair.
+func (s *heroFlightLayerState) startFlights() {
+	layer := s.Element().Widget().(heroFlightLayer)
+	s.flights = []core.Widget{}
+	for _, upper := range layer.nav.heroes[layer.upper] {
+		lower := layer.nav.heroFor(layer.lower, upper.tag)
+		if lower == nil {
+			continue
+		}
+		upperHero := upper.Element().Widget().(Hero)
+		lowerHero := lower.Element().Widget().(Hero)
+		flight := heroFlight{animation: layer.animation, lower: lower, upper: upper}
+		switch {
+		case upperHero.CrossFade || lowerHero.CrossFade:
+			out, in := flight, flight
+			out.opacity = func(t float64) float64 { return 1 - t }
+			out.child = lowerHero.Child
+			in.opacity = func(t float64) float64 { return t }
+			in.child = upperHero.Child
+			s.flights = append(s.flights, out, in)
+		case layer.popping:
+			flight.child = lowerHero.Child
+			s.flights = append(s.flights, flight)
+		default:
+			flight.child = upperHero.Child
+			s.flights = append(s.flights, flight)
+		}
+		lower.setFlying(true)
+		upper.setFlying(true)
+		s.hidden = append(s.hidden, lower, upper)
+	}
+}
+
+func (s *heroFlightLayerState) Dispose() {
+	for _, hero := range s.hidden {
+		hero.setFlying(false)
+	}
+	s.StateBase.Dispose()
+}
+
+// heroFlight paints child over the rect between its heroes' rects for the
+// current animation value.
+type heroFlight struct {
+	core.RenderObjectBase
+	animation    *animation.AnimationController
+	lower, upper *heroState
+	// opacity returns the child's opacity at animation value t. Nil is
+	// opaque.
+	opacity func(t float64) float64
+	child   core.Widget
+}
+
+func (f heroFlight) ChildWidget() core.Widget { return f.child }
+
+func (f heroFlight) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderHeroFlight{}
+	r.SetSelf(r)
+	r.update(f)
+	return r
+}
+
+func (f heroFlight) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderHeroFlight); ok {
+		r.update(f)
+		r.MarkNeedsLayout()
+	}
+}
+
+type renderHeroFlight struct {
+	layout.RenderBoxBase
+	child        layout.RenderBox
+	animation    *animation.AnimationController
+	unsubscribe  func()
+	lower, upper *heroState
+	opacity      func(t float64) float64
+	// rect is where the child is painted, and visible is false when either
+	// hero is missing.
+	rect    graphics.Rect
+	visible bool
+}
+
+func (r *renderHeroFlight) update(f heroFlight) {
+	if r.animation != f.animation {
+		if r.unsubscribe != nil {
+			r.unsubscribe()
+			r.unsubscribe = nil
+		}
+		r.animation = f.animation
+		if r.animation != nil {
+			r.unsubscribe = r.animation.AddListener(r.MarkNeedsLayout)
+		}
+	}
+	r.lower, r.upper, r.opacity = f.lower, f.upper, f.opacity
+}
+
+func (r *renderHeroFlight) value() float64 {
+	if r.animation == nil {
+		return 1
+	}
+	return r.animation.Value
+}
+
+func (r *renderHeroFlight) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child, _ = child.(layout.RenderBox)
+	layout.SetParentOnChild(r.child, r.Self())
+}
+
+func (r *renderHeroFlight) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderHeroFlight) PerformLayout() {
+	constraints := r.Constraints()
+	r.SetSize(constraints.Constrain(graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight}))
+	from, fromOK := r.lower.rectIn(r)
+	to, toOK := r.upper.rectIn(r)
+	r.visible = fromOK && toOK && r.child != nil
+	if !r.visible {
+		return
+	}
+	t := r.value()
+	r.rect = graphics.RectFromLTWH(
+		lerp(from.Left, to.Left, t),
+		lerp(from.Top, to.Top, t),
+		max(lerp(from.Width(), to.Width(), t), 0),
+		max(lerp(from.Height(), to.Height(), t), 0),
+	)
+	r.child.Layout(layout.Tight(r.rect.Size()), false)
+	r.child.SetParentData(&layout.BoxParentData{Offset: graphics.Offset{X: r.rect.Left, Y: r.rect.Top}})
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+func (r *renderHeroFlight) Paint(ctx *layout.PaintContext) {
+	if !r.visible {
+		return
+	}
+	opacity := 1.0
+	if r.opacity != nil {
+		opacity = min(max(r.opacity(r.value()), 0), 1)
+	}
+	if opacity <= 0 {
+		return
+	}
+	origin := graphics.Offset{X: r.rect.Left, Y: r.rect.Top}
+	if opacity >= 1 {
+		ctx.PaintChildWithLayer(r.child, origin)
+		return
+	}
+	ctx.Canvas.SaveLayerAlpha(r.rect, opacity)
+	ctx.PaintChildWithLayer(r.child, origin)
+	ctx.Canvas.Restore()
+}
+
+// HitTest ignores flights; the routes beneath don't take input during a
+// transition anyway.
+func (r *renderHeroFlight) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
+
+func (r *renderHeroFlight) Dispose() {
+	if r.unsubscribe != nil {
+		r.unsubscribe()
+		r.unsubscribe = nil
+	}
+	r.RenderBoxBase.Dispose()
+}
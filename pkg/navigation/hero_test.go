@@ -0,0 +1,144 @@
+package navigation
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/widgets"
+
+	dtesting "github.com/go-drift/drift/pkg/testing"
+)
+
+// pumpHeroNavigator mounts a navigator whose "/" page has a 40x40 hero at
+// the origin and whose "/detail" page has a 200x200 hero 100pt down, both
+// tagged "photo", and returns the navigator.
+func pumpHeroNavigator(t *testing.T, tester *dtesting.WidgetTester, transitions PageTransitionsTheme) NavigatorState {
+	t.Helper()
+	var nav NavigatorState
+	photo := func(size float64) core.Widget {
+		return Hero{Tag: "photo", Child: widgets.SizedBox{Width: size, Height: size}}
+	}
+	transitions.Child = Navigator{
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewAnimatedPageRoute(func(ctx core.BuildContext) core.Widget {
+				nav = NavigatorOf(ctx)
+				if settings.Name == "/" {
+					return widgets.Align{Alignment: layout.AlignmentTopLeft, Child: photo(40)}
+				}
+				return widgets.Padding{
+					Padding: layout.EdgeInsetsOnly(0, 100, 0, 0),
+					Child:   widgets.Align{Alignment: layout.AlignmentTopLeft, Child: photo(200)},
+				}
+			}, settings)
+		},
+	}
+	if err := tester.PumpWidget(transitions); err != nil {
+		t.Fatal(err)
+	}
+	return nav
+}
+
+func flightRects(tester *dtesting.WidgetTester) []graphics.Rect {
+	var rects []graphics.Rect
+	for _, e := range tester.Find(dtesting.ByType[heroFlight]()).All() {
+		if r, ok := e.(interface{ RenderObject() layout.RenderObject }).RenderObject().(*renderHeroFlight); ok && r.visible {
+			rects = append(rects, r.rect)
+		}
+	}
+	return rects
+}
+
+func TestHero_FliesBetweenRoutes(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+	nav := pumpHeroNavigator(t, tester, PageTransitionsTheme{
+		Routes: []RouteTransition{{From: "/", To: "/detail", Transition: SharedElementPageTransition()}},
+	})
+
+	nav.PushNamed("/detail", nil)
+	pumpFrames(t, tester, 1)
+	rects := flightRects(tester)
+	if len(rects) != 1 {
+		t.Fatalf("expected one flight, got %d", len(rects))
+	}
+	if w := rects[0].Width(); w <= 40 || w >= 200 {
+		t.Errorf("expected the flight between the hero sizes, got width %v", w)
+	}
+	var heroes []*heroState
+	for _, e := range tester.Find(dtesting.ByType[heroBox]()).All() {
+		heroes = append(heroes, e.Widget().(heroBox).state)
+	}
+	if len(heroes) != 2 || !heroes[0].flying || !heroes[1].flying {
+		t.Error("expected both heroes hidden during the flight")
+	}
+
+	pumpFrames(t, tester, 40)
+	if len(flightRects(tester)) != 0 {
+		t.Error("expected the flight to end with the transition")
+	}
+	if heroes[0].flying || heroes[1].flying {
+		t.Error("expected the heroes shown after the flight")
+	}
+
+	nav.Pop(nil)
+	pumpFrames(t, tester, 1)
+	if rects := flightRects(tester); len(rects) != 1 || rects[0].Top <= 0 || rects[0].Top >= 100 {
+		t.Errorf("expected a flight back toward the list, got %v", rects)
+	}
+}
+
+func TestHero_NoFlightWithoutHeroTransition(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+	nav := pumpHeroNavigator(t, tester, PageTransitionsTheme{})
+
+	nav.PushNamed("/detail", nil)
+	pumpFrames(t, tester, 1)
+	if len(flightRects(tester)) != 0 {
+		t.Error("expected no flight under the default transition")
+	}
+}
+
+func TestHero_CrossFade(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+	var nav NavigatorState
+	transition := SharedElementPageTransition()
+	if err := tester.PumpWidget(Navigator{
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			route := NewAnimatedPageRoute(func(ctx core.BuildContext) core.Widget {
+				nav = NavigatorOf(ctx)
+				return widgets.Column{Children: []core.Widget{
+					AppBarHero(widgets.SizedBox{Height: 56}),
+				}}
+			}, settings)
+			route.Transition = &transition
+			return route
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	nav.PushNamed("/detail", nil)
+	pumpFrames(t, tester, 1)
+	if got := len(flightRects(tester)); got != 2 {
+		t.Errorf("expected the app bars to cross-fade as two flights, got %d", got)
+	}
+}
+
+func TestRouteTransition_Matches(t *testing.T) {
+	rule := RouteTransition{From: "/products", To: "/products/:id"}
+	if !rule.matches("/products", "/products/42") {
+		t.Error("expected a match for a product detail")
+	}
+	if rule.matches("/", "/products/42") || rule.matches("/products", "/cart") {
+		t.Error("expected other routes not to match")
+	}
+	if !(RouteTransition{To: "/cart"}).matches("/anything", "/cart") {
+		t.Error("expected an empty From to match any route")
+	}
+}
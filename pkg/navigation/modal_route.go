@@ -86,8 +86,10 @@ func (r *ModalRoute) insertEntries() {
 	})
 	r.barrierEntry.Opaque = false // Don't block hit testing everywhere
 
-	// Create content entry
-	r.contentEntry = overlay.NewOverlayEntry(r.builder)
+	// Create content entry; focus stays inside the modal while it is open
+	r.contentEntry = overlay.NewOverlayEntry(func(ctx core.BuildContext) core.Widget {
+		return widgets.FocusScope{Trap: true, Child: r.builder(ctx)}
+	})
 	r.contentEntry.Opaque = true // Block hit testing everywhere below
 	r.contentEntry.MaintainState = false
 
@@ -2,10 +2,12 @@ package navigation
 
 import (
 	"reflect"
+	"slices"
 	"sync"
 
 	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/engine"
 	"github.com/go-drift/drift/pkg/overlay"
 	"github.com/go-drift/drift/pkg/widgets"
 )
@@ -28,8 +30,32 @@ type NavigationScope struct {
 	activeNavigator NavigatorState
 }
 
+// globalScope is the navigation scope of the default engine handle, and of
+// trees not mounted by an engine, such as in widget tests.
 var globalScope = &NavigationScope{}
 
+// scopeKey keys a handle's NavigationScope in [engine.EngineHandle.Scoped].
+type scopeKey struct{}
+
+// ScopeFor returns the navigation scope of an engine handle. Embedders that
+// run several handles use it to route back button events to the right tree;
+// the package-level [HandleBackButton] and [RootNavigator] use the default
+// handle's scope.
+func ScopeFor(h *engine.EngineHandle) *NavigationScope {
+	if h == nil || h == engine.DefaultHandle() {
+		return globalScope
+	}
+	return h.Scoped(scopeKey{}, func() any { return &NavigationScope{} }).(*NavigationScope)
+}
+
+// scopeOf returns the navigation scope of the engine that mounted element.
+func scopeOf(element *core.StatefulElement) *NavigationScope {
+	if element == nil {
+		return globalScope
+	}
+	return ScopeFor(engine.HandleOf(element))
+}
+
 // SetRoot registers the root navigator (called by Navigator with IsRoot=true).
 func (s *NavigationScope) SetRoot(nav NavigatorState) {
 	s.mu.Lock()
@@ -92,10 +118,16 @@ func (s *NavigationScope) ClearRootIf(nav NavigatorState) {
 //	    // At root - exit app or show confirmation
 //	}
 func HandleBackButton() bool {
-	globalScope.mu.Lock()
-	nav := globalScope.activeNavigator
-	root := globalScope.root
-	globalScope.mu.Unlock()
+	return globalScope.HandleBackButton()
+}
+
+// HandleBackButton is like the package-level [HandleBackButton] but acts on
+// this scope's navigators.
+func (s *NavigationScope) HandleBackButton() bool {
+	s.mu.Lock()
+	nav := s.activeNavigator
+	root := s.root
+	s.mu.Unlock()
 
 	if nav == nil {
 		return false
@@ -130,9 +162,14 @@ func HandleBackButton() bool {
 //
 // Returns nil if no root navigator has been registered.
 func RootNavigator() NavigatorState {
-	globalScope.mu.Lock()
-	defer globalScope.mu.Unlock()
-	return globalScope.root
+	return globalScope.Root()
+}
+
+// Root returns the root navigator registered with this scope, or nil.
+func (s *NavigationScope) Root() NavigatorState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.root
 }
 
 // Navigator manages a stack of routes using imperative navigation.
@@ -198,6 +235,18 @@ type Navigator struct {
 	// RefreshListenable triggers redirect re-evaluation when notified.
 	// Use this when auth state changes to re-check if the current route is still accessible.
 	RefreshListenable core.Listenable
+
+	// Pages declaratively describes the route stack, bottom to top. When set,
+	// InitialRoute is ignored and the navigator diffs Pages against its routes
+	// on every rebuild, pushing, popping, and replacing routes to match.
+	// Routes pushed imperatively stay above the page they were pushed on.
+	Pages []Page
+
+	// OnPopPage is called when a route created from Pages is popped
+	// imperatively (back button, Pop, PopUntil). Remove the page from your
+	// state so the next Pages matches the stack; otherwise the next rebuild
+	// pushes it again.
+	OnPopPage func(page Page, result any)
 }
 
 // CreateState creates the NavigatorState.
@@ -235,7 +284,8 @@ type NavigatorState interface {
 
 	// PopUntil removes routes until the predicate returns true for the top route.
 	// Each route's WillPop is checked before removal; removal stops if WillPop
-	// returns false. Routes are removed without animation.
+	// returns false. Routes are removed without animation, and any
+	// [PushForResult] channels waiting on them are closed without a value.
 	PopUntil(predicate func(Route) bool)
 
 	// PushReplacement replaces the current route with a new route.
@@ -265,6 +315,11 @@ type navigatorState struct {
 
 	isRefreshing       bool   // guard against re-entrant refresh
 	unsubscribeRefresh func() // cleanup for RefreshListenable
+
+	resultCallbacks map[Route]func(result any, popped bool) // pending PushForResult callers
+	pages           map[Route]Page                          // routes created from Navigator.Pages
+	heroes          map[Route][]*heroState                  // mounted Heroes by route
+	precached       map[string]Route                        // routes built offstage by PrecacheRoute
 }
 
 func (s *navigatorState) InitState() {
@@ -272,7 +327,7 @@ func (s *navigatorState) InitState() {
 
 	// Register as root navigator if IsRoot is set
 	if s.navigator.IsRoot {
-		globalScope.SetRoot(s)
+		scopeOf(s.Element()).SetRoot(s)
 	}
 
 	// Set up RefreshListenable for auth state changes
@@ -280,6 +335,12 @@ func (s *navigatorState) InitState() {
 		s.unsubscribeRefresh = s.navigator.RefreshListenable.AddListener(s.onRefresh)
 	}
 
+	// Build the declarative stack, if any, without transitions
+	if len(s.navigator.Pages) > 0 {
+		s.applyPages(s.navigator.Pages, false)
+		return
+	}
+
 	// Push the initial route (with redirect support)
 	if s.navigator.InitialRoute != "" && s.navigator.OnGenerateRoute != nil {
 		initialPath := s.navigator.InitialRoute
@@ -308,6 +369,21 @@ func (s *navigatorState) InitState() {
 	}
 }
 
+// secondaryAnimation returns the controller and transform that animate the
+// route underneath route during its transition. The controller is nil when
+// the route leaves the route underneath in place.
+func secondaryAnimation(ctx core.BuildContext, route Route, fc *animation.AnimationController) (*animation.AnimationController, func(t float64) PageTransform) {
+	st, ok := route.(secondaryTransitionRoute)
+	if !ok {
+		return fc, nil // default parallax
+	}
+	secondary := st.secondaryTransition(ctx)
+	if secondary == nil {
+		return nil, nil
+	}
+	return fc, secondary
+}
+
 func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 	// Register with TabNavigator if we're inside one (for active navigator tracking)
 	tryRegisterTabNavigator(ctx, s)
@@ -324,23 +400,26 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 	// When animating, the route below must stay visible for the parallax effect.
 	topIsAnimating := false
 	var topForegroundController *animation.AnimationController
+	var topSecondary func(t float64) PageTransform
 	if len(s.routes) > 0 {
-		if ar, ok := s.routes[len(s.routes)-1].(AnimatedRoute); ok {
+		top := s.routes[len(s.routes)-1]
+		if ar, ok := top.(AnimatedRoute); ok {
 			fc := ar.ForegroundController()
 			if fc != nil && fc.IsAnimating() {
 				topIsAnimating = true
-				topForegroundController = fc
+				topForegroundController, topSecondary = secondaryAnimation(ctx, top, fc)
 			}
 		}
 	}
 
 	// Check if the exiting route has a foreground controller (pop transition in progress).
 	var exitingForegroundController *animation.AnimationController
+	var exitingSecondary func(t float64) PageTransform
 	if s.exitingRoute != nil {
 		if ar, ok := s.exitingRoute.(AnimatedRoute); ok {
 			fc := ar.ForegroundController()
 			if fc != nil && fc.IsAnimating() {
-				exitingForegroundController = fc
+				exitingForegroundController, exitingSecondary = secondaryAnimation(ctx, s.exitingRoute, fc)
 			}
 		}
 	}
@@ -361,10 +440,11 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 		// During push: the route below the top slides left, driven by the top's controller.
 		// During pop: the new top slides back from left, driven by the exiting route's controller.
 		var bgAnimation *animation.AnimationController
+		var bgTransform func(t float64) PageTransform
 		if isSecondFromTop && topForegroundController != nil {
-			bgAnimation = topForegroundController
+			bgAnimation, bgTransform = topForegroundController, topSecondary
 		} else if isTop && exitingForegroundController != nil {
-			bgAnimation = exitingForegroundController
+			bgAnimation, bgTransform = exitingForegroundController, exitingSecondary
 		}
 
 		// Always wrap in BackgroundSlideTransition to keep the widget tree stable.
@@ -373,8 +453,11 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 		// the wrapper is conditionally added/removed.
 		child := BackgroundSlideTransition{
 			Animation: bgAnimation,
+			Transform: bgTransform,
 			Child: routeBuilder{
-				route: route,
+				route:     route,
+				nav:       s,
+				canGoBack: i > 0,
 			},
 		}
 
@@ -382,7 +465,7 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 		// Non-top routes are excluded from accessibility (hidden behind the top route).
 		// IgnorePointer blocks interaction on all routes during transitions.
 		isTransitioning := topIsAnimating || s.exitingRoute != nil
-		children = append(children, widgets.ExcludeSemantics{
+		children = append(children, routeSlot{route: route, child: widgets.ExcludeSemantics{
 			Child: widgets.Offstage{
 				Offstage: !isVisible,
 				Child: widgets.IgnorePointer{
@@ -391,7 +474,24 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 				},
 			},
 			Excluding: !isTop,
-		})
+		}})
+	}
+
+	// Build precached routes offstage with the same wrappers, so pushing one
+	// moves its built page into the stack instead of building it again.
+	for _, route := range s.precachedRoutes() {
+		children = append(children, routeSlot{route: route, child: widgets.ExcludeSemantics{
+			Child: widgets.Offstage{
+				Offstage: true,
+				Child: widgets.IgnorePointer{
+					Ignoring: true,
+					Child: BackgroundSlideTransition{
+						Child: routeBuilder{route: route, nav: s, canGoBack: true},
+					},
+				},
+			},
+			Excluding: true,
+		}})
 	}
 
 	// Add exiting route on top (it's animating out).
@@ -400,7 +500,7 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 	// reconciliation reuses existing elements instead of destroying and recreating
 	// the entire render subtree. This prevents platform view lag during pop animations.
 	if s.exitingRoute != nil {
-		children = append(children, widgets.ExcludeSemantics{
+		children = append(children, routeSlot{route: s.exitingRoute, child: widgets.ExcludeSemantics{
 			Child: widgets.Offstage{
 				Offstage: false, // visible during exit animation
 				Child: widgets.IgnorePointer{
@@ -408,13 +508,20 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 					Child: BackgroundSlideTransition{
 						Animation: nil, // no background parallax for exiting route
 						Child: routeBuilder{
-							route: s.exitingRoute,
+							route:     s.exitingRoute,
+							nav:       s,
+							canGoBack: true,
 						},
 					},
 				},
 			},
 			Excluding: true, // Exclude from accessibility - user is navigating away
-		})
+		}})
+	}
+
+	// Fly heroes above both routes when the transition asks for it.
+	if flight, ok := s.heroFlight(ctx); ok {
+		children = append(children, flight)
 	}
 
 	// Build route stack
@@ -444,11 +551,39 @@ func (s *navigatorState) Build(ctx core.BuildContext) core.Widget {
 
 	// Wrap in inherited widget so descendants can access NavigatorState
 	return navigatorInherited{
-		state: s,
-		child: overlayWidget,
+		state:  s,
+		canPop: s.CanPop(),
+		child:  overlayWidget,
 	}
 }
 
+// heroFlight returns the layer that flies heroes during the current push or
+// pop, if its route's transition uses heroes.
+func (s *navigatorState) heroFlight(ctx core.BuildContext) (heroFlightLayer, bool) {
+	layer := heroFlightLayer{nav: s, popping: s.exitingRoute != nil}
+	if layer.popping {
+		if len(s.routes) == 0 {
+			return layer, false
+		}
+		layer.lower, layer.upper = s.routes[len(s.routes)-1], s.exitingRoute
+	} else {
+		if len(s.routes) < 2 {
+			return layer, false
+		}
+		layer.lower, layer.upper = s.routes[len(s.routes)-2], s.routes[len(s.routes)-1]
+	}
+	ar, ok := layer.upper.(AnimatedRoute)
+	if !ok || ar.ForegroundController() == nil || !ar.ForegroundController().IsAnimating() {
+		return layer, false
+	}
+	hr, ok := layer.upper.(heroTransitionRoute)
+	if !ok || !hr.flightsHeroes(ctx) {
+		return layer, false
+	}
+	layer.animation = ar.ForegroundController()
+	return layer, true
+}
+
 func (s *navigatorState) Dispose() {
 	// Clean up animation listeners
 	s.clearPushListener()
@@ -457,7 +592,12 @@ func (s *navigatorState) Dispose() {
 	// Dispose animation controllers for all remaining routes
 	for _, route := range s.routes {
 		disposeRouteController(route)
+		s.completeRoute(route, nil, false)
+	}
+	for _, route := range s.precached {
+		disposeRouteController(route)
 	}
+	s.precached = nil
 
 	// Unsubscribe from RefreshListenable
 	if s.unsubscribeRefresh != nil {
@@ -466,9 +606,10 @@ func (s *navigatorState) Dispose() {
 	}
 
 	// Clear from NavigationScope
-	globalScope.ClearActiveIf(s)
+	scope := scopeOf(s.Element())
+	scope.ClearActiveIf(s)
 	if s.navigator.IsRoot {
-		globalScope.ClearRootIf(s)
+		scope.ClearRootIf(s)
 	}
 	s.StateBase.Dispose()
 }
@@ -497,6 +638,9 @@ func (s *navigatorState) clearExitingRoute() {
 
 func (s *navigatorState) DidUpdateWidget(oldWidget core.StatefulWidget) {
 	s.navigator = s.Element().Widget().(Navigator)
+	if len(s.navigator.Pages) > 0 {
+		s.applyPages(s.navigator.Pages, true)
+	}
 }
 
 // Push adds a route to the stack.
@@ -558,27 +702,24 @@ func (s *navigatorState) doPush(route Route) {
 		route.DidPush()
 
 		// Listen for push animation completion to unblock interaction.
-		s.clearPushListener()
-		if ar, ok := route.(AnimatedRoute); ok {
-			if fc := ar.ForegroundController(); fc != nil && fc.IsAnimating() {
-				s.pushUnsubscribe = fc.AddStatusListener(func(status animation.AnimationStatus) {
-					if status == animation.AnimationCompleted {
-						s.clearPushListener()
-						// Rebuild to unblock interaction (clears isTransitioning)
-						s.SetState(func() {})
-					}
-				})
-			}
-		}
+		s.listenForPushCompletion(route)
 
 		// Notify observers
-		for _, observer := range s.navigator.Observers {
+		for _, observer := range s.observers() {
 			observer.DidPush(route, previousTop)
 		}
 	})
 }
 
 func (s *navigatorState) routeFromName(name string, args any) Route {
+	if route, ok := s.precached[name]; ok && args == nil {
+		delete(s.precached, name)
+		return route
+	}
+	return s.generateRoute(name, args)
+}
+
+func (s *navigatorState) generateRoute(name string, args any) Route {
 	if s.navigator.OnGenerateRoute == nil {
 		return nil
 	}
@@ -660,24 +801,11 @@ func (s *navigatorState) Pop(result any) {
 
 		// Start the exit animation
 		popped.DidPop(result)
+		s.completeRoute(popped, result, true)
+		s.popPage(popped, result)
 
 		// Set up callback to remove route when animation completes
-		if ar, ok := popped.(AnimatedRoute); ok {
-			if fc := ar.ForegroundController(); fc != nil {
-				s.exitingUnsubscribe = fc.AddStatusListener(func(status animation.AnimationStatus) {
-					if status == animation.AnimationDismissed {
-						s.SetState(func() {
-							s.clearExitingRoute()
-						})
-					}
-				})
-			} else {
-				s.exitingRoute = nil
-			}
-		} else {
-			// No animation, remove immediately
-			s.exitingRoute = nil
-		}
+		s.listenForExitCompletion(popped)
 
 		// Notify new top route
 		if len(s.routes) > 0 {
@@ -689,7 +817,7 @@ func (s *navigatorState) Pop(result any) {
 		if len(s.routes) > 0 {
 			previousRoute = s.routes[len(s.routes)-1]
 		}
-		for _, observer := range s.navigator.Observers {
+		for _, observer := range s.observers() {
 			observer.DidPop(popped, previousRoute)
 		}
 	})
@@ -731,7 +859,9 @@ func (s *navigatorState) PopUntil(predicate func(Route) bool) {
 func (s *navigatorState) removeRoute(route Route, previousRoute Route) {
 	route.DidPop(nil)
 	disposeRouteController(route)
-	for _, observer := range s.navigator.Observers {
+	s.completeRoute(route, nil, false)
+	s.popPage(route, nil)
+	for _, observer := range s.observers() {
 		observer.DidRemove(route, previousRoute)
 	}
 }
@@ -787,6 +917,8 @@ func (s *navigatorState) doPushReplacement(route Route) {
 		s.routes[len(s.routes)-1] = route
 		oldRoute.DidPop(nil)
 		disposeRouteController(oldRoute)
+		s.completeRoute(oldRoute, nil, false)
+		s.popPage(oldRoute, nil)
 
 		// Notify new route of previous
 		route.DidChangePrevious(previousOfOld)
@@ -799,7 +931,7 @@ func (s *navigatorState) doPushReplacement(route Route) {
 		route.DidPush()
 
 		// Notify observers
-		for _, observer := range s.navigator.Observers {
+		for _, observer := range s.observers() {
 			observer.DidReplace(route, oldRoute)
 		}
 	})
@@ -833,10 +965,108 @@ func disposeRouteController(route Route) {
 	}
 }
 
-// routeBuilder wraps a route for building.
-type routeBuilder struct {
+// PrecacheRoute builds the root navigator's route for name offstage, so a
+// later PushNamed(name, nil) shows a page that is already built and laid out
+// instead of doing that work in the first frame of the transition. The page's
+// State is kept, so it starts as it was when precached.
+//
+// The route is created with OnGenerateRoute and stays built, costing memory
+// and layout, until it is pushed or the navigator is disposed, so precache
+// only the few screens the user is likely to open next. PrecacheRoute does
+// nothing and returns false if there is no root navigator, the navigator uses
+// Pages, the name is redirected, or no route is generated for it.
+func PrecacheRoute(name string) bool {
+	nav, ok := RootNavigator().(*navigatorState)
+	if !ok {
+		return false
+	}
+	return nav.precacheRoute(name)
+}
+
+// preparedRoute is implemented by routes that change what they build when
+// pushed. Precaching calls prepareForPush first, so the page built offstage
+// matches the pushed route's and is kept.
+type preparedRoute interface {
+	prepareForPush()
+}
+
+func (s *navigatorState) precacheRoute(name string) bool {
+	if _, ok := s.precached[name]; ok {
+		return true
+	}
+	if len(s.navigator.Pages) > 0 {
+		return false
+	}
+	var top Route
+	fromPath := ""
+	if len(s.routes) > 0 {
+		top = s.routes[len(s.routes)-1]
+		fromPath = top.Settings().Name
+	}
+	if s.navigator.Redirect != nil {
+		if path, args, replace, _ := s.applyRedirect(fromPath, name, nil); path != name || args != nil || replace {
+			return false
+		}
+	}
+	route := s.generateRoute(name, nil)
+	if route == nil {
+		return false
+	}
+	route.DidChangePrevious(top)
+	if prepared, ok := route.(preparedRoute); ok {
+		prepared.prepareForPush()
+	}
+	s.SetState(func() {
+		if s.precached == nil {
+			s.precached = make(map[string]Route)
+		}
+		s.precached[name] = route
+	})
+	return true
+}
+
+// precachedRoutes returns the precached routes sorted by name, so their
+// order in the stack is stable.
+func (s *navigatorState) precachedRoutes() []Route {
+	if len(s.precached) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(s.precached))
+	for name := range s.precached {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	routes := make([]Route, len(names))
+	for i, name := range names {
+		routes[i] = s.precached[name]
+	}
+	return routes
+}
+
+// routeSlot keys a route's entry in the stack by the route, so the route
+// keeps its elements when its position changes, such as when a precached
+// route is pushed or the top route starts exiting.
+type routeSlot struct {
 	core.StatelessBase
 	route Route
+	child core.Widget
+}
+
+func (r routeSlot) Key() any {
+	return r.route
+}
+
+func (r routeSlot) Build(ctx core.BuildContext) core.Widget {
+	return r.child
+}
+
+// routeBuilder wraps a route for building. Routes above the first can go
+// back, which app bars show as a back button.
+type routeBuilder struct {
+	core.StatelessBase
+	route     Route
+	nav       *navigatorState
+	canGoBack bool
 }
 
 func (r routeBuilder) Key() any {
@@ -844,38 +1074,94 @@ func (r routeBuilder) Key() any {
 }
 
 func (r routeBuilder) Build(ctx core.BuildContext) core.Widget {
+	var onBack func()
+	if r.canGoBack {
+		onBack = func() { r.nav.MaybePop(nil) }
+	}
+	return routeScope{route: r.route, child: widgets.BackNavigationScope{
+		OnBack: onBack,
+		Child:  routeContent{route: r.route},
+	}}
+}
+
+// routeContent builds the route below its routeScope so that the route's
+// own build context can find it with RouteOf.
+type routeContent struct {
+	core.StatelessBase
+	route Route
+}
+
+func (r routeContent) Build(ctx core.BuildContext) core.Widget {
 	return r.route.Build(ctx)
 }
 
 // navigatorInherited provides NavigatorState to descendants.
 type navigatorInherited struct {
 	core.InheritedBase
-	state *navigatorState
-	child core.Widget
+	state  *navigatorState
+	canPop bool
+	child  core.Widget
 }
 
 func (n navigatorInherited) ChildWidget() core.Widget { return n.child }
 
 func (n navigatorInherited) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
 	if old, ok := oldWidget.(navigatorInherited); ok {
-		return n.state != old.state
+		return n.state != old.state || n.canPop != old.canPop
 	}
 	return true
 }
 
+// ShouldRebuildDependent implements [core.AspectAwareInheritedWidget], so
+// widgets that only check [CanPopOf] don't rebuild for other changes.
+func (n navigatorInherited) ShouldRebuildDependent(oldWidget core.InheritedWidget, aspects map[any]struct{}) bool {
+	old, ok := oldWidget.(navigatorInherited)
+	if !ok || n.state != old.state {
+		return true
+	}
+	for aspect := range aspects {
+		switch aspect {
+		case navigatorAspectCanPop:
+			if n.canPop != old.canPop {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// navigatorAspect identifies the part of a navigator a widget depends on.
+type navigatorAspect int
+
+const navigatorAspectCanPop navigatorAspect = iota
+
 var navigatorInheritedType = reflect.TypeFor[navigatorInherited]()
 
+// CanPopOf reports whether the nearest Navigator has a route to pop, for
+// showing a back button. Unlike [NavigatorOf], it registers a dependency:
+// widgets calling it rebuild when the answer changes, and not on other
+// navigation.
+func CanPopOf(ctx core.BuildContext) bool {
+	if n, ok := ctx.DependOnInherited(navigatorInheritedType, navigatorAspectCanPop).(navigatorInherited); ok {
+		return n.canPop
+	}
+	return false
+}
+
 // NavigatorOf returns the NavigatorState from the nearest Navigator ancestor.
-// Returns nil if no Navigator is found.
+// Returns nil if no Navigator is found. It does not register a dependency,
+// so it is safe to call from event handlers.
 func NavigatorOf(ctx core.BuildContext) NavigatorState {
-	inherited := ctx.DependOnInherited(navigatorInheritedType, nil)
-	if inherited == nil {
+	element := ctx.FindAncestor(func(e core.Element) bool {
+		_, ok := e.Widget().(navigatorInherited)
+		return ok
+	})
+	if element == nil {
 		return nil
 	}
-	if nav, ok := inherited.(navigatorInherited); ok {
-		return nav.state
-	}
-	return nil
+	return element.Widget().(navigatorInherited).state
 }
 
 // RedirectContext provides information about the navigation being attempted,
@@ -1011,7 +1297,7 @@ func (s *navigatorState) onRefresh() {
 
 	// Guard: only process refresh if this is the active navigator
 	// Prevents duplicate redirects across nested navigators
-	if globalScope.ActiveNavigator() != NavigatorState(s) {
+	if scopeOf(s.Element()).ActiveNavigator() != NavigatorState(s) {
 		return
 	}
 
@@ -2,8 +2,13 @@ package navigation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/engine"
+	"github.com/go-drift/drift/pkg/widgets"
+
+	dtesting "github.com/go-drift/drift/pkg/testing"
 )
 
 // mockNavigatorState implements NavigatorState for testing
@@ -230,6 +235,31 @@ func TestRootNavigator_IndependentOfActive(t *testing.T) {
 	}
 }
 
+func TestScopeFor_SeparatesEngineHandles(t *testing.T) {
+	h := engine.NewEngineHandle()
+	defer h.Dispose()
+
+	if ScopeFor(nil) != globalScope || ScopeFor(engine.DefaultHandle()) != globalScope {
+		t.Error("default handle should use the global scope")
+	}
+	scope := ScopeFor(h)
+	if scope == globalScope {
+		t.Fatal("new handle should have its own scope")
+	}
+	if ScopeFor(h) != scope {
+		t.Error("ScopeFor should return the same scope for a handle")
+	}
+
+	root := &mockNavigatorState{canPopResult: true}
+	scope.SetRoot(root)
+	if RootNavigator() == root {
+		t.Error("RootNavigator should not see another handle's root")
+	}
+	if !scope.HandleBackButton() || !root.popCalled {
+		t.Error("scope.HandleBackButton should pop the handle's navigator")
+	}
+}
+
 func TestRedirectResult_Helpers(t *testing.T) {
 	// NoRedirect
 	r := NoRedirect()
@@ -317,3 +347,155 @@ func TestBaseRoute_Defaults(t *testing.T) {
 	r.DidChangeNext(nil)
 	r.DidChangePrevious(nil)
 }
+
+// initCounter counts how many times its state is created.
+type initCounter struct {
+	core.StatefulBase
+	inits *int
+}
+
+func (c initCounter) CreateState() core.State { return &initCounterState{} }
+
+type initCounterState struct {
+	core.StateBase
+}
+
+func (s *initCounterState) InitState() {
+	*s.Element().Widget().(initCounter).inits++
+}
+
+func (s *initCounterState) Build(ctx core.BuildContext) core.Widget {
+	return widgets.Text{Content: "details"}
+}
+
+func TestPrecacheRoute_KeepsBuiltPageWhenPushed(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+	generated, inits := 0, 0
+	err := tester.PumpWidget(Navigator{
+		IsRoot:       true,
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			generated++
+			if settings.Name == "/missing" {
+				return nil
+			}
+			return NewAnimatedPageRoute(func(ctx core.BuildContext) core.Widget {
+				if settings.Name == "/details" {
+					return initCounter{inits: &inits}
+				}
+				return widgets.Text{Content: "home"}
+			}, settings)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if PrecacheRoute("/missing") {
+		t.Fatal("expected no precache for a name without a route")
+	}
+	if !PrecacheRoute("/details") || !PrecacheRoute("/details") {
+		t.Fatal("expected /details to be precached")
+	}
+	pumpFrames(t, tester, 1)
+	if inits != 1 {
+		t.Fatalf("expected the precached page to be built once, got %d", inits)
+	}
+	box := tester.Find(dtesting.ByType[initCounter]()).RenderObject()
+	if box == nil || box.Size().Width == 0 {
+		t.Fatal("expected the precached page to be laid out")
+	}
+
+	RootNavigator().PushNamed("/details", nil)
+	pumpFrames(t, tester, 30)
+	if inits != 1 {
+		t.Fatalf("expected the push to keep the precached page, got %d builds", inits)
+	}
+	if generated != 3 {
+		t.Fatalf("expected the push to reuse the precached route, generated %d routes", generated)
+	}
+	if got := tester.Find(dtesting.ByType[initCounter]()).RenderObject(); got != box {
+		t.Fatal("expected the pushed page to keep its render object")
+	}
+}
+
+func TestCanPopOf_TracksStack(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+
+	var nav NavigatorState
+	canPop := make(map[string]bool)
+	err := tester.PumpWidget(Navigator{
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewPageRoute(func(ctx core.BuildContext) core.Widget {
+				nav = NavigatorOf(ctx)
+				canPop[settings.Name] = CanPopOf(ctx)
+				return widgets.Text{Content: settings.Name}
+			}, settings)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if canPop["/"] {
+		t.Error("expected the root route not to be poppable")
+	}
+
+	nav.PushNamed("/details", nil)
+	if err := tester.PumpAndSettle(2 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if !canPop["/details"] {
+		t.Error("expected CanPopOf to be true above the root route")
+	}
+	if !canPop["/"] {
+		t.Error("expected the covered root route to rebuild with CanPopOf true")
+	}
+
+	nav.Pop(nil)
+	if err := tester.PumpAndSettle(2 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if canPop["/"] {
+		t.Error("expected CanPopOf to be false again after popping")
+	}
+}
+
+func TestBackNavigationOf_OnlyAboveFirstRoute(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+
+	var nav NavigatorState
+	onBack := make(map[string]func())
+	err := tester.PumpWidget(Navigator{
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewPageRoute(func(ctx core.BuildContext) core.Widget {
+				nav = NavigatorOf(ctx)
+				onBack[settings.Name] = widgets.BackNavigationOf(ctx)
+				return widgets.Text{Content: settings.Name}
+			}, settings)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nav.PushNamed("/details", nil)
+	if err := tester.PumpAndSettle(2 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if onBack["/"] != nil {
+		t.Error("expected the first route to have nowhere to go back to, even when covered")
+	}
+	if onBack["/details"] == nil {
+		t.Fatal("expected a pushed route to be able to go back")
+	}
+
+	onBack["/details"]()
+	if err := tester.PumpAndSettle(2 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if nav.CanPop() {
+		t.Error("expected going back to pop the pushed route")
+	}
+}
@@ -1,5 +1,7 @@
 package navigation
 
+import "github.com/go-drift/drift/pkg/errors"
+
 // NavigatorObserver observes navigation events.
 type NavigatorObserver interface {
 	// DidPush is called when a route is pushed.
@@ -29,3 +31,50 @@ func (b *BaseNavigatorObserver) DidRemove(route, previousRoute Route) {}
 
 // DidReplace is a no-op.
 func (b *BaseNavigatorObserver) DidReplace(newRoute, oldRoute Route) {}
+
+// observers returns the navigator's observers plus the one that records
+// navigation breadcrumbs for error reports.
+func (s *navigatorState) observers() []NavigatorObserver {
+	return append([]NavigatorObserver{breadcrumbObserver{}}, s.navigator.Observers...)
+}
+
+// breadcrumbObserver records navigation events with errors.AddBreadcrumb.
+type breadcrumbObserver struct{}
+
+func (breadcrumbObserver) DidPush(route, previousRoute Route) {
+	recordNavigation("push", route, previousRoute)
+}
+
+func (breadcrumbObserver) DidPop(route, previousRoute Route) {
+	recordNavigation("pop", route, previousRoute)
+}
+
+func (breadcrumbObserver) DidRemove(route, previousRoute Route) {
+	recordNavigation("remove", route, previousRoute)
+}
+
+func (breadcrumbObserver) DidReplace(newRoute, oldRoute Route) {
+	recordNavigation("replace", newRoute, oldRoute)
+}
+
+func recordNavigation(action string, route, other Route) {
+	data := map[string]string{"action": action}
+	if name := routeName(route); name != "" {
+		data["route"] = name
+	}
+	if name := routeName(other); name != "" {
+		data["from"] = name
+	}
+	errors.AddBreadcrumb(errors.Breadcrumb{
+		Category: errors.BreadcrumbNavigation,
+		Message:  action + " " + routeName(route),
+		Data:     data,
+	})
+}
+
+func routeName(route Route) string {
+	if route == nil {
+		return ""
+	}
+	return route.Settings().Name
+}
@@ -0,0 +1,242 @@
+package navigation
+
+import (
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// Page declaratively describes one route in a [Navigator.Pages] stack.
+//
+// On every rebuild the navigator matches pages to its existing routes by key,
+// creating routes for new pages and removing routes whose pages are gone. A
+// page keeps the same route (and its state) for as long as its key stays in
+// the list.
+type Page struct {
+	// Key identifies the page across rebuilds and must be comparable.
+	// Defaults to Name when nil.
+	Key any
+
+	// Name is the route name passed to the route in RouteSettings.
+	Name string
+
+	// Arguments are passed to the route in RouteSettings.
+	Arguments any
+
+	// Builder creates the page content. When CreateRoute is nil, the page is
+	// shown in an [AnimatedPageRoute] built from Builder.
+	Builder func(ctx core.BuildContext) core.Widget
+
+	// CreateRoute creates the route for this page. Optional; use it to show
+	// a page as a modal, bottom sheet, or custom route.
+	CreateRoute func(settings RouteSettings) Route
+}
+
+// key returns the identity used to match the page across rebuilds.
+func (p Page) key() any {
+	if p.Key != nil {
+		return p.Key
+	}
+	return p.Name
+}
+
+// createRoute creates a new route for the page.
+func (p Page) createRoute() Route {
+	settings := RouteSettings{Name: p.Name, Arguments: p.Arguments}
+	if p.CreateRoute != nil {
+		return p.CreateRoute(settings)
+	}
+	return NewAnimatedPageRoute(p.Builder, settings)
+}
+
+// applyPages diffs pages against the current route stack. Routes are reused
+// by page key; pageless routes pushed imperatively stay attached above the
+// page route they were pushed on. When animate is true, a change of top
+// route transitions like a push (new top) or pop (old top removed); other
+// additions and removals happen without animation.
+//
+// Callers are responsible for scheduling a rebuild.
+func (s *navigatorState) applyPages(pages []Page, animate bool) {
+	existing := make(map[any]Route, len(s.routes))
+	attached := make(map[Route][]Route)
+	var owner Route
+	for _, route := range s.routes {
+		if page, ok := s.pages[route]; ok {
+			existing[page.key()] = route
+			owner = route
+		} else if owner != nil {
+			attached[owner] = append(attached[owner], route)
+		}
+	}
+
+	newRoutes := make([]Route, 0, len(pages))
+	newPages := make(map[Route]Page, len(pages))
+	created := make(map[Route]bool)
+	for _, page := range pages {
+		key := page.key()
+		route, ok := existing[key]
+		if ok {
+			delete(existing, key)
+		} else {
+			route = page.createRoute()
+			if route == nil {
+				continue
+			}
+			created[route] = true
+		}
+		newRoutes = append(newRoutes, route)
+		newRoutes = append(newRoutes, attached[route]...)
+		newPages[route] = page
+	}
+	if len(newRoutes) == 0 {
+		return
+	}
+
+	oldRoutes := s.routes
+	oldTop := s.topRoute()
+	newTop := newRoutes[len(newRoutes)-1]
+
+	kept := make(map[Route]bool, len(newRoutes))
+	for _, route := range newRoutes {
+		kept[route] = true
+	}
+
+	// Swap in the new stack before firing callbacks so routes removed here
+	// are not reported to OnPopPage; the app already dropped their pages.
+	s.routes = newRoutes
+	s.pages = newPages
+
+	pushTop := animate && created[newTop] && newTop != oldTop
+	popTop := animate && oldTop != nil && !kept[oldTop] && !created[newTop]
+
+	for i, route := range oldRoutes {
+		if kept[route] || (popTop && route == oldTop) {
+			continue
+		}
+		var previous Route
+		if i > 0 {
+			previous = oldRoutes[i-1]
+		}
+		s.removeRoute(route, previous)
+	}
+
+	var previous Route
+	for _, route := range newRoutes {
+		if created[route] && !(pushTop && route == newTop) {
+			if mr, ok := route.(*AnimatedPageRoute); ok {
+				mr.SetInitialRoute()
+			}
+			s.attachRoute(route, previous)
+		}
+		previous = route
+	}
+
+	switch {
+	case pushTop:
+		var below Route
+		if len(newRoutes) > 1 {
+			below = newRoutes[len(newRoutes)-2]
+			below.DidChangeNext(newTop)
+		}
+		s.attachRoute(newTop, below)
+		s.listenForPushCompletion(newTop)
+	case popTop:
+		s.clearPushListener()
+		s.clearExitingRoute()
+		s.exitingRoute = oldTop
+		oldTop.DidPop(nil)
+		s.completeRoute(oldTop, nil, false)
+		s.listenForExitCompletion(oldTop)
+		newTop.DidChangeNext(nil)
+		for _, observer := range s.observers() {
+			observer.DidPop(oldTop, newTop)
+		}
+	case newTop != oldTop:
+		newTop.DidChangeNext(nil)
+	}
+}
+
+// attachRoute wires a newly created route into the stack: it receives the
+// overlay, its previous route, and DidPush, and observers are notified.
+func (s *navigatorState) attachRoute(route, previous Route) {
+	route.DidChangePrevious(previous)
+	if s.overlayState != nil {
+		route.SetOverlay(s.overlayState)
+	}
+	route.DidPush()
+	for _, observer := range s.observers() {
+		observer.DidPush(route, previous)
+	}
+}
+
+// listenForPushCompletion rebuilds the navigator when route's push animation
+// finishes so interaction is unblocked.
+func (s *navigatorState) listenForPushCompletion(route Route) {
+	s.clearPushListener()
+	if ar, ok := route.(AnimatedRoute); ok {
+		if fc := ar.ForegroundController(); fc != nil && fc.IsAnimating() {
+			s.pushUnsubscribe = fc.AddStatusListener(func(status animation.AnimationStatus) {
+				if status == animation.AnimationCompleted {
+					s.clearPushListener()
+					// Rebuild to unblock interaction (clears isTransitioning)
+					s.SetState(func() {})
+				}
+			})
+		}
+	}
+}
+
+// beginBackGesture reports whether route, the top route, may be swiped back.
+// While the swipe and its settle animation run, the navigator rebuilds on
+// every status change so the route below is revealed as soon as the drag
+// moves and interaction resumes if the route settles back.
+func (s *navigatorState) beginBackGesture(route Route) bool {
+	if len(s.routes) < 2 || s.exitingRoute != nil || s.routes[len(s.routes)-1] != route {
+		return false
+	}
+	ar, ok := route.(AnimatedRoute)
+	if !ok || ar.ForegroundController() == nil {
+		return false
+	}
+	s.clearPushListener()
+	s.pushUnsubscribe = ar.ForegroundController().AddStatusListener(func(status animation.AnimationStatus) {
+		if status == animation.AnimationCompleted {
+			s.clearPushListener()
+		}
+		s.SetState(func() {})
+	})
+	return true
+}
+
+// listenForExitCompletion removes the exiting route once its pop animation
+// is dismissed. Routes without an exit animation, or whose animation already
+// finished because animations are disabled, are removed immediately.
+func (s *navigatorState) listenForExitCompletion(route Route) {
+	if ar, ok := route.(AnimatedRoute); ok {
+		if fc := ar.ForegroundController(); fc != nil && !fc.IsDismissed() {
+			s.exitingUnsubscribe = fc.AddStatusListener(func(status animation.AnimationStatus) {
+				if status == animation.AnimationDismissed {
+					s.SetState(func() {
+						s.clearExitingRoute()
+					})
+				}
+			})
+			return
+		}
+	}
+	// No animation, remove immediately
+	disposeRouteController(route)
+	s.exitingRoute = nil
+}
+
+// popPage reports a page-backed route leaving the stack imperatively so the
+// app can drop the page from its state.
+func (s *navigatorState) popPage(route Route, result any) {
+	page, ok := s.pages[route]
+	if !ok {
+		return
+	}
+	delete(s.pages, route)
+	if s.navigator.OnPopPage != nil {
+		s.navigator.OnPopPage(page, result)
+	}
+}
@@ -5,11 +5,23 @@ import (
 
 	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/widgets"
 )
 
 // TransitionDuration is the default duration for page transitions.
 const TransitionDuration = 450 * time.Millisecond
 
+const (
+	// backGestureEdgeWidth is the width of the strip along the leading edge
+	// that starts a back swipe.
+	backGestureEdgeWidth = 20.0
+
+	// backGestureFlingVelocity is the release speed, in page widths per
+	// second, above which a back swipe pops regardless of how far it got.
+	backGestureFlingVelocity = 1.0
+)
+
 // AnimatedPageRoute provides a route with animated page transitions.
 type AnimatedPageRoute struct {
 	BaseRoute
@@ -17,11 +29,31 @@ type AnimatedPageRoute struct {
 	// Builder creates the page content.
 	Builder func(ctx core.BuildContext) core.Widget
 
+	// Transition controls how the page enters and leaves. Optional; defaults
+	// to the nearest [PageTransitionsTheme] rule for this route and the one
+	// underneath, or [SlidePageTransition].
+	Transition *PageTransition
+
+	// BackGesture enables popping the route by swiping from the leading edge
+	// of the screen. The page tracks the finger and, on release, a spring
+	// either completes the pop or settles the page back, continuing from the
+	// release velocity.
+	BackGesture bool
+
 	// foregroundController drives this route's own slide-in/slide-out animation.
 	foregroundController *animation.AnimationController
 
+	// backGestureActive is set while the user drags the route back, and
+	// backGestureVelocity holds the controller velocity at release.
+	backGestureActive   bool
+	backGestureVelocity float64
+
 	// isInitialRoute tracks if this is the first route (no animation needed)
 	isInitialRoute bool
+
+	// previousRoute is the route underneath, which picks the theme's
+	// transition together with this route.
+	previousRoute Route
 }
 
 // NewAnimatedPageRoute creates an AnimatedPageRoute with the given builder and settings.
@@ -38,20 +70,32 @@ func (m *AnimatedPageRoute) ForegroundController() *animation.AnimationControlle
 	return m.foregroundController
 }
 
-// Build returns the page content wrapped in a foreground slide transition.
-// Background slide animation is handled by the navigator.
+// Build returns the page content wrapped in its foreground transition.
+// The route underneath is animated by the navigator.
 func (m *AnimatedPageRoute) Build(ctx core.BuildContext) core.Widget {
 	if m.Builder == nil {
 		return nil
 	}
 
-	content := m.Builder(ctx)
+	// Each page gets its own primary scroll controller, linking app bars to
+	// the page's scroll view, and its own page storage.
+	content := core.Widget(widgets.PageStorage{
+		Bucket: m.PageStorage(),
+		Child:  widgets.PrimaryScrollScope{Child: m.Builder(ctx)},
+	})
 
-	// Wrap in foreground slide transition if we have an animation
+	// Wrap in the foreground transition if we have an animation
 	if m.foregroundController != nil {
-		content = SlideTransition{
+		if m.BackGesture {
+			content = backGestureDetector{route: m, child: content}
+		}
+		transition := m.transition(ctx)
+		if transition.Builder != nil {
+			return transition.Builder(ctx, m.foregroundController, content)
+		}
+		content = PageTransformTransition{
 			Animation: m.foregroundController,
-			Direction: SlideFromRight,
+			Transform: transition.Enter,
 			Child:     content,
 		}
 	}
@@ -59,13 +103,48 @@ func (m *AnimatedPageRoute) Build(ctx core.BuildContext) core.Widget {
 	return content
 }
 
+// transition resolves the route's transition from its own setting or the theme.
+func (m *AnimatedPageRoute) transition(ctx core.BuildContext) PageTransition {
+	if m.Transition != nil {
+		return *m.Transition
+	}
+	from := ""
+	if m.previousRoute != nil {
+		from = m.previousRoute.Settings().Name
+	}
+	return PageTransitionBetween(ctx, from, m.Settings().Name)
+}
+
+// DidChangePrevious records the route underneath.
+func (m *AnimatedPageRoute) DidChangePrevious(previousRoute Route) {
+	m.previousRoute = previousRoute
+}
+
+// flightsHeroes implements heroTransitionRoute.
+func (m *AnimatedPageRoute) flightsHeroes(ctx core.BuildContext) bool {
+	return m.transition(ctx).Heroes
+}
+
+// secondaryTransition implements secondaryTransitionRoute.
+func (m *AnimatedPageRoute) secondaryTransition(ctx core.BuildContext) func(t float64) PageTransform {
+	return m.transition(ctx).Secondary
+}
+
 // DidPush is called when the route is pushed.
 func (m *AnimatedPageRoute) DidPush() {
 	// Only animate if not the initial route
 	if !m.isInitialRoute {
+		m.prepareForPush()
+		m.foregroundController.Forward()
+	}
+}
+
+// prepareForPush implements preparedRoute. The controller is created before
+// the push, so a precached page is built inside its transition.
+func (m *AnimatedPageRoute) prepareForPush() {
+	if m.foregroundController == nil {
 		m.foregroundController = animation.NewAnimationController(TransitionDuration)
 		m.foregroundController.Curve = animation.IOSNavigationCurve
-		m.foregroundController.Forward()
 	}
 }
 
@@ -75,12 +154,104 @@ func (m *AnimatedPageRoute) SetInitialRoute() {
 }
 
 // DidPop is called when the route is popped.
+//
+// A pop that interrupts the push animation or a back swipe hands off to a
+// spring from the current value and velocity, so the page turns around
+// smoothly instead of restarting or jumping.
 func (m *AnimatedPageRoute) DidPop(result any) {
-	if m.foregroundController != nil {
-		m.foregroundController.Reverse()
+	fc := m.foregroundController
+	if fc == nil {
+		return
+	}
+	switch {
+	case m.backGestureActive:
+		m.backGestureActive = false
+		fc.AnimateWithSpring(animation.IOSSpring(), fc.LowerBound, m.backGestureVelocity)
+	case fc.Status() == animation.AnimationForward:
+		fc.AnimateWithSpring(animation.IOSSpring(), fc.LowerBound, fc.Velocity())
+	default:
+		fc.Reverse()
 	}
 }
 
+// startBackGesture begins tracking a back swipe. It reports false if the
+// navigator cannot pop this route right now.
+func (m *AnimatedPageRoute) startBackGesture(ctx core.BuildContext) bool {
+	nav, ok := NavigatorOf(ctx).(*navigatorState)
+	if !ok || m.foregroundController == nil || !nav.beginBackGesture(m) {
+		return false
+	}
+	m.foregroundController.Stop()
+	m.backGestureActive = true
+	return true
+}
+
+// updateBackGesture moves the page by delta, a fraction of its width.
+func (m *AnimatedPageRoute) updateBackGesture(delta float64) {
+	if !m.backGestureActive {
+		return
+	}
+	fc := m.foregroundController
+	fc.SetValue(fc.Value - delta*(fc.UpperBound-fc.LowerBound))
+}
+
+// endBackGesture pops the route or settles it back depending on how far it
+// was dragged and velocity, the release speed in page widths per second.
+func (m *AnimatedPageRoute) endBackGesture(ctx core.BuildContext, velocity float64) {
+	if !m.backGestureActive {
+		return
+	}
+	fc := m.foregroundController
+	fullRange := fc.UpperBound - fc.LowerBound
+	m.backGestureVelocity = -velocity * fullRange
+
+	pop := velocity > backGestureFlingVelocity ||
+		(velocity >= -backGestureFlingVelocity && fc.Value < fc.LowerBound+fullRange/2)
+	if pop && NavigatorOf(ctx).MaybePop(nil) {
+		return
+	}
+	m.backGestureActive = false
+	fc.AnimateWithSpring(animation.IOSSpring(), fc.UpperBound, m.backGestureVelocity)
+}
+
+// backGestureDetector places a drag strip along the leading edge of a route
+// that drives the route's back swipe.
+type backGestureDetector struct {
+	core.StatelessBase
+	route *AnimatedPageRoute
+	child core.Widget
+}
+
+func (d backGestureDetector) Build(ctx core.BuildContext) core.Widget {
+	return widgets.LayoutBuilder{Builder: func(ctx core.BuildContext, constraints layout.Constraints) core.Widget {
+		width := constraints.MaxWidth
+		return widgets.Stack{
+			Fit: widgets.StackFitExpand,
+			Children: []core.Widget{
+				d.child,
+				widgets.Positioned(widgets.GestureDetector{
+					OnHorizontalDragStart: func(widgets.DragStartDetails) {
+						d.route.startBackGesture(ctx)
+					},
+					OnHorizontalDragUpdate: func(details widgets.DragUpdateDetails) {
+						if width > 0 {
+							d.route.updateBackGesture(details.PrimaryDelta / width)
+						}
+					},
+					OnHorizontalDragEnd: func(details widgets.DragEndDetails) {
+						if width > 0 {
+							d.route.endBackGesture(ctx, details.PrimaryVelocity/width)
+						}
+					},
+					OnHorizontalDragCancel: func() {
+						d.route.endBackGesture(ctx, 0)
+					},
+				}).Left(0).Top(0).Bottom(0).Width(backGestureEdgeWidth),
+			},
+		}
+	}}
+}
+
 // PageRoute is a simpler route without transitions.
 type PageRoute struct {
 	BaseRoute
@@ -97,10 +268,14 @@ func NewPageRoute(builder func(core.BuildContext) core.Widget, settings RouteSet
 	}
 }
 
-// Build returns the page content.
+// Build returns the page content, with its own primary scroll controller
+// and page storage.
 func (p *PageRoute) Build(ctx core.BuildContext) core.Widget {
 	if p.Builder == nil {
 		return nil
 	}
-	return p.Builder(ctx)
+	return widgets.PageStorage{
+		Bucket: p.PageStorage(),
+		Child:  widgets.PrimaryScrollScope{Child: p.Builder(ctx)},
+	}
 }
@@ -0,0 +1,163 @@
+package navigation
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/widgets"
+
+	dtesting "github.com/go-drift/drift/pkg/testing"
+)
+
+// pumpAnimatedNavigator mounts a 400pt wide Navigator of AnimatedPageRoutes
+// with back swipes enabled and returns its state and routes by name.
+func pumpAnimatedNavigator(t *testing.T) (*dtesting.WidgetTester, NavigatorState, map[string]*AnimatedPageRoute) {
+	t.Helper()
+	tester := dtesting.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+
+	var nav NavigatorState
+	routes := make(map[string]*AnimatedPageRoute)
+	err := tester.PumpWidget(Navigator{
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			route := NewAnimatedPageRoute(func(ctx core.BuildContext) core.Widget {
+				nav = NavigatorOf(ctx)
+				return widgets.Text{Content: settings.Name}
+			}, settings)
+			route.BackGesture = true
+			routes[settings.Name] = route
+			return route
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tester, nav, routes
+}
+
+func pumpFrames(t *testing.T, tester *dtesting.WidgetTester, n int) {
+	t.Helper()
+	for range n {
+		tester.Clock().Advance(16 * time.Millisecond)
+		if err := tester.Pump(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestAnimatedPageRoute_PopDuringPushTurnsAround(t *testing.T) {
+	tester, nav, routes := pumpAnimatedNavigator(t)
+
+	nav.PushNamed("/details", nil)
+	pumpFrames(t, tester, 8)
+	fc := routes["/details"].ForegroundController()
+	if fc.Status() != animation.AnimationForward {
+		t.Fatalf("expected push in flight, got %v", fc.Status())
+	}
+	value := fc.Value
+
+	nav.Pop(nil)
+	pumpFrames(t, tester, 1)
+	if math.Abs(fc.Value-value) > 0.1 {
+		t.Errorf("expected pop to continue from %v, got %v", value, fc.Value)
+	}
+
+	if err := tester.PumpAndSettle(2 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if !fc.IsDismissed() || tester.Find(dtesting.ByText("/details")).Exists() {
+		t.Error("expected /details to be removed after the interrupted pop")
+	}
+}
+
+func TestAnimatedPageRoute_AnimationsDisabled(t *testing.T) {
+	prev := animation.SetAnimationsDisabled(true)
+	t.Cleanup(func() { animation.SetAnimationsDisabled(prev) })
+	tester, nav, routes := pumpAnimatedNavigator(t)
+
+	nav.PushNamed("/details", nil)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if fc := routes["/details"].ForegroundController(); !fc.IsCompleted() {
+		t.Fatalf("expected push to complete immediately, got %v", fc.Status())
+	}
+
+	nav.Pop(nil)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if tester.Find(dtesting.ByText("/details")).Exists() {
+		t.Error("expected /details to be removed without pumping the exit animation")
+	}
+}
+
+func TestAnimatedPageRoute_BackGesture(t *testing.T) {
+	tests := []struct {
+		name    string
+		dragTo  float64
+		wantPop bool
+	}{
+		{"past halfway pops", 305, true},
+		{"short drag settles back", 105, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tester, nav, routes := pumpAnimatedNavigator(t)
+			nav.PushNamed("/details", nil)
+			if err := tester.PumpAndSettle(2 * time.Second); err != nil {
+				t.Fatal(err)
+			}
+			fc := routes["/details"].ForegroundController()
+
+			const pointer = 1
+			start := graphics.Offset{X: 5, Y: 400}
+			if err := tester.SendPointerDown(start, pointer); err != nil {
+				t.Fatal(err)
+			}
+			for x := start.X + 25; x <= tt.dragTo; x += 25 {
+				if err := tester.SendPointerMove(graphics.Offset{X: x, Y: 400}, pointer); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if fc.Status() != animation.AnimationReverse || fc.Value >= 1 {
+				t.Errorf("expected the page to follow the drag, got value %v status %v", fc.Value, fc.Status())
+			}
+			if err := tester.SendPointerUp(graphics.Offset{X: tt.dragTo, Y: 400}, pointer); err != nil {
+				t.Fatal(err)
+			}
+			if err := tester.PumpAndSettle(2 * time.Second); err != nil {
+				t.Fatal(err)
+			}
+
+			popped := !tester.Find(dtesting.ByText("/details")).Exists()
+			if popped != tt.wantPop {
+				t.Errorf("popped = %v, want %v", popped, tt.wantPop)
+			}
+			if !tt.wantPop && !fc.IsCompleted() {
+				t.Errorf("expected the page to settle back, got value %v status %v", fc.Value, fc.Status())
+			}
+		})
+	}
+}
+
+func TestPageRoutes_ProvidePageStorage(t *testing.T) {
+	tester, nav, routes := pumpAnimatedNavigator(t)
+	nav.PushNamed("/details", nil)
+	tester.PumpAndSettle(time.Second)
+
+	for name, route := range routes {
+		text := tester.Find(dtesting.ByText(name)).First().(core.BuildContext)
+		if got := widgets.PageStorageOf(text); got != route.PageStorage() {
+			t.Errorf("expected %s to see its route's bucket", name)
+		}
+	}
+	if routes["/"].PageStorage() == routes["/details"].PageStorage() {
+		t.Error("expected each route to have its own bucket")
+	}
+}
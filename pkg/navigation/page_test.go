@@ -0,0 +1,176 @@
+package navigation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/widgets"
+
+	dtesting "github.com/go-drift/drift/pkg/testing"
+)
+
+// pagesHost rebuilds a Navigator from state-held pages.
+type pagesHost struct {
+	core.StatefulBase
+	initial   []Page
+	onPopPage func(page Page, result any)
+	bind      func(setPages func([]Page))
+}
+
+func (h pagesHost) CreateState() core.State { return &pagesHostState{} }
+
+type pagesHostState struct {
+	core.StateBase
+	pages []Page
+}
+
+func (s *pagesHostState) InitState() {
+	host := s.Element().Widget().(pagesHost)
+	s.pages = host.initial
+	host.bind(func(pages []Page) {
+		s.SetState(func() { s.pages = pages })
+	})
+}
+
+func (s *pagesHostState) Build(ctx core.BuildContext) core.Widget {
+	host := s.Element().Widget().(pagesHost)
+	return Navigator{Pages: s.pages, OnPopPage: host.onPopPage}
+}
+
+func textPage(name string) Page {
+	return Page{
+		Name: name,
+		CreateRoute: func(settings RouteSettings) Route {
+			return NewPageRoute(func(ctx core.BuildContext) core.Widget {
+				return widgets.Text{Content: settings.Name}
+			}, settings)
+		},
+	}
+}
+
+func TestNavigatorPages_DiffsStack(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+
+	var setPages func([]Page)
+	err := tester.PumpWidget(pagesHost{
+		initial: []Page{textPage("/login")},
+		bind:    func(fn func([]Page)) { setPages = fn },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tester.Find(dtesting.ByText("/login")).Exists() {
+		t.Fatal("expected /login to be shown")
+	}
+
+	// Auth state change replaces the whole stack.
+	setPages([]Page{textPage("/home"), textPage("/details")})
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if tester.Find(dtesting.ByText("/login")).Exists() {
+		t.Error("/login should have been removed")
+	}
+	if !tester.Find(dtesting.ByText("/details")).Exists() {
+		t.Error("expected /details on top")
+	}
+
+	// Dropping the top page pops it.
+	setPages([]Page{textPage("/home")})
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if tester.Find(dtesting.ByText("/details")).Exists() {
+		t.Error("/details should have been popped")
+	}
+	if !tester.Find(dtesting.ByText("/home")).Exists() {
+		t.Error("expected /home to be shown")
+	}
+}
+
+func TestNavigatorPages_ReusesRoutesByKey(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+
+	var setPages func([]Page)
+	var nav NavigatorState
+	created := 0
+	page := func(name string) Page {
+		return Page{
+			Name: name,
+			CreateRoute: func(settings RouteSettings) Route {
+				created++
+				return NewPageRoute(func(ctx core.BuildContext) core.Widget {
+					nav = NavigatorOf(ctx)
+					return widgets.Text{Content: settings.Name}
+				}, settings)
+			},
+		}
+	}
+
+	err := tester.PumpWidget(pagesHost{
+		initial: []Page{page("/a")},
+		bind:    func(fn func([]Page)) { setPages = fn },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	setPages([]Page{page("/a"), page("/b")})
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if created != 2 {
+		t.Errorf("expected /a to be reused, got %d routes created", created)
+	}
+
+	// Imperatively pushed routes stay above their page.
+	nav.Push(NewPageRoute(func(ctx core.BuildContext) core.Widget {
+		return widgets.Text{Content: "dialog"}
+	}, RouteSettings{}))
+	setPages([]Page{page("/a"), page("/b")})
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if created != 2 {
+		t.Errorf("expected no new routes, got %d created", created)
+	}
+	if !tester.Find(dtesting.ByText("dialog")).Exists() {
+		t.Error("pageless route should survive a rebuild")
+	}
+}
+
+func TestNavigatorPages_OnPopPage(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+
+	var nav NavigatorState
+	var popped []string
+	var results []any
+	err := tester.PumpWidget(pagesHost{
+		initial: []Page{
+			textPage("/home"),
+			{
+				Name: "/edit",
+				CreateRoute: func(settings RouteSettings) Route {
+					return NewPageRoute(func(ctx core.BuildContext) core.Widget {
+						nav = NavigatorOf(ctx)
+						return widgets.Text{Content: settings.Name}
+					}, settings)
+				},
+			},
+		},
+		onPopPage: func(page Page, result any) {
+			popped = append(popped, page.Name)
+			results = append(results, result)
+		},
+		bind: func(func([]Page)) {},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nav.Pop("saved")
+	if len(popped) != 1 || popped[0] != "/edit" || results[0] != "saved" {
+		t.Errorf("expected OnPopPage(/edit, saved), got %v %v", popped, results)
+	}
+}
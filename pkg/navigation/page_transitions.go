@@ -0,0 +1,400 @@
+package navigation
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/theme"
+)
+
+// PageTransform describes how a page is painted at one point of a page
+// transition.
+//
+// Transforms are applied at paint time only; layout and hit testing are not
+// affected. Platform views follow Offset but are not scaled or faded.
+type PageTransform struct {
+	// Offset moves the page as a fraction of its size; {X: 1} is one full
+	// page width to the right.
+	Offset graphics.Offset
+	// Scale scales the page about its center. 1 is the natural size.
+	Scale float64
+	// Opacity ranges from 0 (invisible) to 1 (opaque).
+	Opacity float64
+}
+
+// IdentityPageTransform returns the transform that paints a page unchanged.
+// Start from it when writing custom transitions.
+func IdentityPageTransform() PageTransform {
+	return PageTransform{Scale: 1, Opacity: 1}
+}
+
+// pixelOffset returns the page offset in pixels for a page of the given size.
+func (t PageTransform) pixelOffset(size graphics.Size) graphics.Offset {
+	return graphics.Offset{X: t.Offset.X * size.Width, Y: t.Offset.Y * size.Height}
+}
+
+// PageTransitionBuilder wraps route content in a custom transition. The
+// animation runs from 0 to 1 on push and back to 0 on pop.
+type PageTransitionBuilder func(ctx core.BuildContext, animation *animation.AnimationController, child core.Widget) core.Widget
+
+// PageTransition describes how an [AnimatedPageRoute] enters and leaves, and
+// how the route underneath it moves at the same time.
+//
+// Both functions receive the eased value of the route's animation: 0 when the
+// route is hidden and 1 when it is fully shown. Pops run the same animation
+// in reverse.
+type PageTransition struct {
+	// Enter returns the transform of the route's own page at t.
+	Enter func(t float64) PageTransform
+
+	// Secondary returns the transform of the route underneath while this
+	// route's animation is at t. Nil leaves the route underneath in place.
+	Secondary func(t float64) PageTransform
+
+	// Builder replaces Enter with an arbitrary widget transition. Optional.
+	Builder PageTransitionBuilder
+
+	// Heroes flies [Hero] widgets with matching tags between this route and
+	// the route underneath while the transition runs.
+	Heroes bool
+}
+
+// SlidePageTransition returns the iOS-style transition: the page slides in
+// from the right while the page underneath shifts left by a third of its width.
+// This is the default transition.
+func SlidePageTransition() PageTransition {
+	return PageTransition{
+		Enter: func(t float64) PageTransform {
+			tr := IdentityPageTransform()
+			tr.Offset.X = 1 - t
+			return tr
+		},
+		Secondary: slideSecondary,
+	}
+}
+
+// slideSecondary is the parallax shift of the page under a sliding page.
+func slideSecondary(t float64) PageTransform {
+	tr := IdentityPageTransform()
+	tr.Offset.X = -backgroundParallaxFactor * t
+	return tr
+}
+
+// FadePageTransition returns a transition that cross-fades the page in over
+// the page underneath.
+func FadePageTransition() PageTransition {
+	return PageTransition{
+		Enter: func(t float64) PageTransform {
+			tr := IdentityPageTransform()
+			tr.Opacity = t
+			return tr
+		},
+	}
+}
+
+// SlideUpPageTransition returns the Android-style transition: the page fades
+// in while rising a quarter of its height from below.
+func SlideUpPageTransition() PageTransition {
+	return PageTransition{
+		Enter: func(t float64) PageTransform {
+			tr := IdentityPageTransform()
+			tr.Offset.Y = 0.25 * (1 - t)
+			tr.Opacity = t
+			return tr
+		},
+	}
+}
+
+// SharedAxisPageTransition returns a horizontal shared-axis transition: the
+// page slides in a short distance from the right while fading in, and the
+// page underneath slides out to the left while fading out.
+func SharedAxisPageTransition() PageTransition {
+	const travel = 0.3
+	return PageTransition{
+		Enter: func(t float64) PageTransform {
+			tr := IdentityPageTransform()
+			tr.Offset.X = travel * (1 - t)
+			tr.Opacity = t
+			return tr
+		},
+		Secondary: func(t float64) PageTransform {
+			tr := IdentityPageTransform()
+			tr.Offset.X = -travel * t
+			tr.Opacity = 1 - t
+			return tr
+		},
+	}
+}
+
+// ZoomPageTransition returns the Material zoom transition: the page grows
+// into place while fading in, and the page underneath grows slightly.
+func ZoomPageTransition() PageTransition {
+	return PageTransition{
+		Enter: func(t float64) PageTransform {
+			tr := IdentityPageTransform()
+			tr.Scale = 0.85 + 0.15*t
+			tr.Opacity = t
+			return tr
+		},
+		Secondary: func(t float64) PageTransform {
+			tr := IdentityPageTransform()
+			tr.Scale = 1 + 0.05*t
+			return tr
+		},
+	}
+}
+
+// FadeThroughPageTransition returns the Material fade-through transition:
+// the page underneath fades out over the first third of the animation, then
+// the page fades in while growing slightly into place. Use it between pages
+// that have no spatial relationship.
+func FadeThroughPageTransition() PageTransition {
+	return PageTransition{
+		Enter: func(t float64) PageTransform {
+			in := fadeThroughIn(t)
+			tr := IdentityPageTransform()
+			tr.Scale = 0.92 + 0.08*in
+			tr.Opacity = in
+			return tr
+		},
+		Secondary: func(t float64) PageTransform {
+			tr := IdentityPageTransform()
+			tr.Opacity = 1 - min(t/fadeThroughSplit, 1)
+			return tr
+		},
+	}
+}
+
+// fadeThroughSplit is the point of a fade-through where the outgoing page
+// has faded out and the incoming page starts to fade in.
+const fadeThroughSplit = 0.35
+
+func fadeThroughIn(t float64) float64 {
+	return max(t-fadeThroughSplit, 0) / (1 - fadeThroughSplit)
+}
+
+// SharedElementPageTransition returns the "list item to detail" transition:
+// [Hero] widgets that appear on both pages fly from their place on one page
+// to their place on the other, while the rest of the content fades through
+// as in [FadeThroughPageTransition]. Wrap each page's app bar in
+// [AppBarHero] to cross-fade the app bars in place instead of fading them
+// through with the content.
+//
+//	route := navigation.NewAnimatedPageRoute(buildDetail, settings)
+//	transition := navigation.SharedElementPageTransition()
+//	route.Transition = &transition
+func SharedElementPageTransition() PageTransition {
+	transition := FadeThroughPageTransition()
+	transition.Heroes = true
+	return transition
+}
+
+// RouteTransition overrides the transition between two routes in a
+// [PageTransitionsTheme].
+type RouteTransition struct {
+	// From and To are path patterns, as in [ScreenRoute.Path], matched
+	// against the names of the route underneath and the route being pushed
+	// or popped. An empty pattern matches any route.
+	From, To string
+
+	// Transition is used for pushes from From to To, and for the pops that
+	// reverse them.
+	Transition PageTransition
+}
+
+// matches reports whether the rule applies to a transition between routes
+// named from and to.
+func (r RouteTransition) matches(from, to string) bool {
+	match := func(pattern, name string) bool {
+		if pattern == "" {
+			return true
+		}
+		_, ok := NewPathPattern(pattern).Match(name)
+		return ok
+	}
+	return match(r.From, from) && match(r.To, to)
+}
+
+// PageTransitionsTheme sets the default transition for [AnimatedPageRoute]s
+// below it, per target platform. Routes with their own Transition ignore it.
+//
+// Without a PageTransitionsTheme ancestor, routes use [SlidePageTransition].
+type PageTransitionsTheme struct {
+	core.InheritedBase
+
+	// Material is used when the theme platform is Material.
+	// Defaults to [ZoomPageTransition].
+	Material *PageTransition
+
+	// Cupertino is used when the theme platform is Cupertino.
+	// Defaults to [SlidePageTransition].
+	Cupertino *PageTransition
+
+	// Routes overrides the platform transition between specific routes,
+	// such as [SharedElementPageTransition] from a list to its detail
+	// pages. The first matching rule wins, on every platform:
+	//
+	//	Routes: []navigation.RouteTransition{
+	//	    {From: "/products", To: "/products/:id", Transition: navigation.SharedElementPageTransition()},
+	//	},
+	Routes []RouteTransition
+
+	// Child is the child widget tree.
+	Child core.Widget
+}
+
+// ChildWidget returns the child widget.
+func (t PageTransitionsTheme) ChildWidget() core.Widget { return t.Child }
+
+// ShouldRebuildDependents returns true if either transition or the route
+// rules have changed.
+func (t PageTransitionsTheme) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(PageTransitionsTheme); ok {
+		return t.Material != old.Material || t.Cupertino != old.Cupertino ||
+			len(t.Routes) != len(old.Routes) ||
+			len(t.Routes) > 0 && &t.Routes[0] != &old.Routes[0]
+	}
+	return true
+}
+
+var pageTransitionsThemeType = reflect.TypeFor[PageTransitionsTheme]()
+
+// PageTransitionOf returns the page transition for the current platform from
+// the nearest PageTransitionsTheme, or [SlidePageTransition] if there is none.
+func PageTransitionOf(ctx core.BuildContext) PageTransition {
+	inherited := ctx.DependOnInherited(pageTransitionsThemeType, nil)
+	transitions, ok := inherited.(PageTransitionsTheme)
+	if !ok {
+		return SlidePageTransition()
+	}
+	return transitions.platformTransition(ctx)
+}
+
+// PageTransitionBetween returns the transition for moving between the
+// routes named from and to: the first matching [RouteTransition] of the
+// nearest PageTransitionsTheme, or [PageTransitionOf] if none matches.
+func PageTransitionBetween(ctx core.BuildContext, from, to string) PageTransition {
+	inherited := ctx.DependOnInherited(pageTransitionsThemeType, nil)
+	transitions, ok := inherited.(PageTransitionsTheme)
+	if !ok {
+		return SlidePageTransition()
+	}
+	for _, rule := range transitions.Routes {
+		if rule.matches(from, to) {
+			return rule.Transition
+		}
+	}
+	return transitions.platformTransition(ctx)
+}
+
+// platformTransition returns the theme's transition for the current platform.
+func (t PageTransitionsTheme) platformTransition(ctx core.BuildContext) PageTransition {
+	if theme.PlatformOf(ctx) == theme.TargetPlatformCupertino {
+		if t.Cupertino != nil {
+			return *t.Cupertino
+		}
+		return SlidePageTransition()
+	}
+	if t.Material != nil {
+		return *t.Material
+	}
+	return ZoomPageTransition()
+}
+
+// secondaryTransitionRoute is implemented by routes that choose how the route
+// underneath them animates during their transition.
+type secondaryTransitionRoute interface {
+	// secondaryTransition returns the transform for the route underneath,
+	// or nil to leave it in place.
+	secondaryTransition(ctx core.BuildContext) func(t float64) PageTransform
+}
+
+// PageTransformTransition paints its child with the [PageTransform] returned
+// by Transform for the current animation value.
+type PageTransformTransition struct {
+	core.RenderObjectBase
+	Animation *animation.AnimationController
+	Transform func(t float64) PageTransform
+	Child     core.Widget
+}
+
+// ChildWidget returns the child widget.
+func (p PageTransformTransition) ChildWidget() core.Widget {
+	return p.Child
+}
+
+// CreateRenderObject creates the renderPageTransformTransition.
+func (p PageTransformTransition) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderPageTransformTransition{
+		transitionRenderBase: transitionRenderBase{animation: p.Animation},
+		transform:            p.Transform,
+	}
+	r.SetSelf(r)
+	r.subscribeAnimation()
+	return r
+}
+
+// UpdateRenderObject updates the renderPageTransformTransition.
+func (p PageTransformTransition) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderPageTransformTransition); ok {
+		if r.animation != p.Animation {
+			r.unsubscribeAnimation()
+			r.animation = p.Animation
+			r.subscribeAnimation()
+		}
+		r.transform = p.Transform
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderPageTransformTransition struct {
+	transitionRenderBase
+	transform func(t float64) PageTransform
+}
+
+func (r *renderPageTransformTransition) currentTransform() PageTransform {
+	if r.animation == nil || r.transform == nil {
+		return IdentityPageTransform()
+	}
+	return r.transform(r.animation.Value)
+}
+
+func (r *renderPageTransformTransition) ScrollOffset() graphics.Offset {
+	return r.currentTransform().pixelOffset(r.Size())
+}
+
+func (r *renderPageTransformTransition) Paint(ctx *layout.PaintContext) {
+	r.paintTransformed(ctx, r.currentTransform())
+}
+
+// paintTransformed paints the child with the given transform applied.
+func (r *transitionRenderBase) paintTransformed(ctx *layout.PaintContext, tr PageTransform) {
+	if r.child == nil || tr.Opacity <= 0 || tr.Scale <= 0 {
+		return
+	}
+	size := r.Size()
+	offset := tr.pixelOffset(size)
+	if tr.Opacity >= 1 && tr.Scale == 1 {
+		ctx.PaintChildWithLayer(r.child, offset)
+		return
+	}
+
+	ctx.Canvas.Save()
+	if tr.Opacity < 1 {
+		ctx.Canvas.SaveLayerAlpha(graphics.RectFromLTWH(0, 0, size.Width, size.Height), tr.Opacity)
+	}
+	if tr.Scale != 1 {
+		cx, cy := size.Width/2, size.Height/2
+		ctx.Canvas.Translate(cx, cy)
+		ctx.Canvas.Scale(tr.Scale, tr.Scale)
+		ctx.Canvas.Translate(-cx, -cy)
+	}
+	ctx.PaintChildWithLayer(r.child, offset)
+	if tr.Opacity < 1 {
+		ctx.Canvas.Restore()
+	}
+	ctx.Canvas.Restore()
+}
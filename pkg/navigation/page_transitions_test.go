@@ -0,0 +1,90 @@
+package navigation
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+
+	dtesting "github.com/go-drift/drift/pkg/testing"
+)
+
+// transitionProbe reports its build context to onBuild.
+type transitionProbe struct {
+	core.StatelessBase
+	onBuild func(ctx core.BuildContext)
+}
+
+func (p transitionProbe) Build(ctx core.BuildContext) core.Widget {
+	p.onBuild(ctx)
+	return widgets.SizedBox{}
+}
+
+func TestPageTransitions_EndAtIdentity(t *testing.T) {
+	transitions := map[string]PageTransition{
+		"slide":        SlidePageTransition(),
+		"fade":         FadePageTransition(),
+		"slide-up":     SlideUpPageTransition(),
+		"shared-axis":  SharedAxisPageTransition(),
+		"zoom":         ZoomPageTransition(),
+		"fade-through": FadeThroughPageTransition(),
+		"shared":       SharedElementPageTransition(),
+	}
+	for name, transition := range transitions {
+		if got := transition.Enter(1); got != IdentityPageTransform() {
+			t.Errorf("%s: Enter(1) = %+v, want identity", name, got)
+		}
+		if transition.Secondary != nil {
+			if got := transition.Secondary(0); got != IdentityPageTransform() {
+				t.Errorf("%s: Secondary(0) = %+v, want identity", name, got)
+			}
+		}
+	}
+
+	if got := SlidePageTransition().Enter(0).Offset.X; got != 1 {
+		t.Errorf("slide: Enter(0) offset = %v, want 1", got)
+	}
+	if got := FadePageTransition().Enter(0).Opacity; got != 0 {
+		t.Errorf("fade: Enter(0) opacity = %v, want 0", got)
+	}
+}
+
+func TestPageTransitionOf_PicksPlatformTransition(t *testing.T) {
+	fade := FadePageTransition()
+
+	resolve := func(platform theme.TargetPlatform, transitions *PageTransitionsTheme) PageTransition {
+		t.Helper()
+		var got PageTransition
+		probe := transitionProbe{onBuild: func(ctx core.BuildContext) {
+			got = PageTransitionOf(ctx)
+		}}
+		var child core.Widget = probe
+		if transitions != nil {
+			transitions.Child = probe
+			child = *transitions
+		}
+		tester := dtesting.NewWidgetTesterWithT(t)
+		err := tester.PumpWidget(theme.AppTheme{
+			Data:  theme.NewAppThemeData(platform, theme.BrightnessLight),
+			Child: child,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	if got := resolve(theme.TargetPlatformMaterial, nil); got.Enter(0).Offset.X != 1 {
+		t.Error("expected slide transition without a PageTransitionsTheme")
+	}
+	if got := resolve(theme.TargetPlatformMaterial, &PageTransitionsTheme{}); got.Enter(0).Scale != 0.85 {
+		t.Error("expected zoom transition as the Material default")
+	}
+	if got := resolve(theme.TargetPlatformCupertino, &PageTransitionsTheme{}); got.Enter(0).Offset.X != 1 {
+		t.Error("expected slide transition as the Cupertino default")
+	}
+	if got := resolve(theme.TargetPlatformCupertino, &PageTransitionsTheme{Cupertino: &fade}); got.Enter(0).Opacity != 0 || got.Secondary != nil {
+		t.Error("expected the configured Cupertino transition")
+	}
+}
@@ -70,6 +70,7 @@ import (
 	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/overlay"
+	"github.com/go-drift/drift/pkg/widgets"
 )
 
 // OverlayState is an alias for overlay.OverlayState for convenience.
@@ -175,6 +176,7 @@ type TransparentRoute interface {
 // BaseRoute provides a default implementation of Route lifecycle methods.
 type BaseRoute struct {
 	settings RouteSettings
+	storage  widgets.PageStorageBucket
 }
 
 // NewBaseRoute creates a BaseRoute with the given settings.
@@ -187,6 +189,13 @@ func (r *BaseRoute) Settings() RouteSettings {
 	return r.settings
 }
 
+// PageStorage returns the bucket that keeps the route's page state, such as
+// the offsets of scroll views with a StorageKey, for as long as the route
+// lives. Page routes provide it to their page through [widgets.PageStorage].
+func (r *BaseRoute) PageStorage() *widgets.PageStorageBucket {
+	return &r.storage
+}
+
 // DidPush is a no-op by default.
 func (r *BaseRoute) DidPush() {}
 
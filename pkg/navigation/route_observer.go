@@ -0,0 +1,193 @@
+package navigation
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// RouteAware is implemented by objects, typically page states, that want to
+// know when their route is covered or revealed. Subscribe it to a
+// [RouteObserver] for the route returned by [RouteOf].
+type RouteAware interface {
+	// DidPush is called when the route has been pushed and is current.
+	DidPush()
+
+	// DidPushNext is called when a new route has been pushed on top of the
+	// route, so it is no longer visible.
+	DidPushNext()
+
+	// DidPopNext is called when the route above has been popped and the
+	// route is visible again.
+	DidPopNext()
+
+	// DidPop is called when the route has been popped or removed.
+	DidPop()
+}
+
+// BaseRouteAware provides default no-op implementations of [RouteAware].
+// Embed it to implement only the callbacks you need.
+type BaseRouteAware struct{}
+
+// DidPush is a no-op.
+func (b *BaseRouteAware) DidPush() {}
+
+// DidPushNext is a no-op.
+func (b *BaseRouteAware) DidPushNext() {}
+
+// DidPopNext is a no-op.
+func (b *BaseRouteAware) DidPopNext() {}
+
+// DidPop is a no-op.
+func (b *BaseRouteAware) DidPop() {}
+
+// RouteObserver is a [NavigatorObserver] that forwards navigation events to
+// the [RouteAware] subscribers of each route. Add one to a Navigator's
+// Observers and share it with the pages that need it, for example to pause
+// a video while another route covers the page:
+//
+//	var routeObserver = navigation.NewRouteObserver()
+//
+//	func (s *playerState) InitState() {
+//	    route := navigation.RouteOf(s.Element())
+//	    s.OnDispose(routeObserver.Subscribe(route, s))
+//	}
+//
+//	func (s *playerState) DidPushNext() { s.player.Pause() }
+//	func (s *playerState) DidPopNext()  { s.player.Play() }
+//
+// All methods must be called on the UI thread.
+type RouteObserver struct {
+	subscribers map[Route]map[int]RouteAware
+	nextID      int
+	// above maps a route to the route directly on top of it.
+	above map[Route]Route
+}
+
+// NewRouteObserver creates an empty RouteObserver.
+func NewRouteObserver() *RouteObserver {
+	return &RouteObserver{
+		subscribers: make(map[Route]map[int]RouteAware),
+		above:       make(map[Route]Route),
+	}
+}
+
+// Subscribe registers aware for events about route and immediately calls its
+// DidPush. It returns a function that removes the subscription; call it when
+// the subscriber is disposed.
+func (o *RouteObserver) Subscribe(route Route, aware RouteAware) (unsubscribe func()) {
+	if route == nil || aware == nil {
+		return func() {}
+	}
+	if o.subscribers == nil {
+		o.subscribers = make(map[Route]map[int]RouteAware)
+	}
+	subs := o.subscribers[route]
+	if subs == nil {
+		subs = make(map[int]RouteAware)
+		o.subscribers[route] = subs
+	}
+	id := o.nextID
+	o.nextID++
+	subs[id] = aware
+	aware.DidPush()
+	return func() {
+		if subs, ok := o.subscribers[route]; ok {
+			delete(subs, id)
+			if len(subs) == 0 {
+				delete(o.subscribers, route)
+			}
+		}
+	}
+}
+
+// notify calls fn for every subscriber of route.
+func (o *RouteObserver) notify(route Route, fn func(RouteAware)) {
+	if route == nil {
+		return
+	}
+	for _, aware := range o.subscribers[route] {
+		fn(aware)
+	}
+}
+
+// DidPush notifies the previous route's subscribers that it was covered.
+func (o *RouteObserver) DidPush(route, previousRoute Route) {
+	if previousRoute == nil {
+		return
+	}
+	if o.above == nil {
+		o.above = make(map[Route]Route)
+	}
+	o.above[previousRoute] = route
+	o.notify(previousRoute, RouteAware.DidPushNext)
+}
+
+// DidPop notifies the popped route's subscribers, then the subscribers of
+// the route it revealed.
+func (o *RouteObserver) DidPop(route, previousRoute Route) {
+	o.notify(route, RouteAware.DidPop)
+	delete(o.above, route)
+	if previousRoute != nil && o.above[previousRoute] == route {
+		delete(o.above, previousRoute)
+		o.notify(previousRoute, RouteAware.DidPopNext)
+	}
+}
+
+// DidRemove notifies the removed route's subscribers. If the removed route
+// was on top of the stack, the route below it is notified that it was
+// revealed.
+func (o *RouteObserver) DidRemove(route, previousRoute Route) {
+	o.notify(route, RouteAware.DidPop)
+	next, covered := o.above[route]
+	delete(o.above, route)
+	if previousRoute == nil || o.above[previousRoute] != route {
+		return
+	}
+	if covered {
+		o.above[previousRoute] = next
+		return
+	}
+	delete(o.above, previousRoute)
+	o.notify(previousRoute, RouteAware.DidPopNext)
+}
+
+// DidReplace notifies the replaced route's subscribers that it was popped.
+func (o *RouteObserver) DidReplace(newRoute, oldRoute Route) {
+	o.notify(oldRoute, RouteAware.DidPop)
+	delete(o.above, oldRoute)
+	for below, above := range o.above {
+		if above == oldRoute {
+			o.above[below] = newRoute
+		}
+	}
+}
+
+// routeScope provides the enclosing route to descendants.
+type routeScope struct {
+	core.InheritedBase
+	route Route
+	child core.Widget
+}
+
+func (r routeScope) ChildWidget() core.Widget { return r.child }
+
+func (r routeScope) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(routeScope); ok {
+		return r.route != old.route
+	}
+	return true
+}
+
+var routeScopeType = reflect.TypeFor[routeScope]()
+
+// RouteOf returns the route whose page contains ctx, or nil if ctx is not
+// inside a route built by a Navigator. Content that routes place in the
+// overlay, such as modal and bottom sheet content, has no enclosing route.
+func RouteOf(ctx core.BuildContext) Route {
+	inherited := ctx.DependOnInherited(routeScopeType, nil)
+	if scope, ok := inherited.(routeScope); ok {
+		return scope.route
+	}
+	return nil
+}
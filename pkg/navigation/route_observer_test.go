@@ -0,0 +1,111 @@
+package navigation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/widgets"
+
+	dtesting "github.com/go-drift/drift/pkg/testing"
+)
+
+// recordingRouteAware records RouteAware callbacks in order.
+type recordingRouteAware struct {
+	events []string
+}
+
+func (r *recordingRouteAware) DidPush()     { r.events = append(r.events, "push") }
+func (r *recordingRouteAware) DidPushNext() { r.events = append(r.events, "pushNext") }
+func (r *recordingRouteAware) DidPopNext()  { r.events = append(r.events, "popNext") }
+func (r *recordingRouteAware) DidPop()      { r.events = append(r.events, "pop") }
+
+// pumpObservedNavigator mounts a Navigator with observer and returns its
+// state and the routes built so far, by name.
+func pumpObservedNavigator(t *testing.T, observer *RouteObserver) (NavigatorState, map[string]Route) {
+	t.Helper()
+	tester := dtesting.NewWidgetTesterWithT(t)
+
+	var nav NavigatorState
+	routes := make(map[string]Route)
+	err := tester.PumpWidget(Navigator{
+		InitialRoute: "/",
+		Observers:    []NavigatorObserver{observer},
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewPageRoute(func(ctx core.BuildContext) core.Widget {
+				nav = NavigatorOf(ctx)
+				routes[settings.Name] = RouteOf(ctx)
+				return widgets.Text{Content: settings.Name}
+			}, settings)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if routes["/"] == nil {
+		t.Fatal("expected RouteOf to find the initial route")
+	}
+	return nav, routes
+}
+
+func TestRouteObserver_CoveredAndRevealed(t *testing.T) {
+	observer := NewRouteObserver()
+	nav, routes := pumpObservedNavigator(t, observer)
+
+	home := &recordingRouteAware{}
+	unsubscribe := observer.Subscribe(routes["/"], home)
+
+	nav.PushNamed("/details", nil)
+	nav.Pop(nil)
+
+	want := []string{"push", "pushNext", "popNext"}
+	if !reflect.DeepEqual(home.events, want) {
+		t.Errorf("events = %v, want %v", home.events, want)
+	}
+
+	unsubscribe()
+	nav.PushNamed("/details", nil)
+	if len(home.events) != len(want) {
+		t.Errorf("expected no events after unsubscribe, got %v", home.events)
+	}
+}
+
+func TestRouteObserver_PopUntilRevealsTarget(t *testing.T) {
+	observer := NewRouteObserver()
+	nav, routes := pumpObservedNavigator(t, observer)
+
+	home := &recordingRouteAware{}
+	observer.Subscribe(routes["/"], home)
+
+	nav.PushNamed("/a", nil)
+	nav.PushNamed("/b", nil)
+	nav.PopUntil(func(route Route) bool { return route.Settings().Name == "/" })
+
+	want := []string{"push", "pushNext", "popNext"}
+	if !reflect.DeepEqual(home.events, want) {
+		t.Errorf("events = %v, want %v", home.events, want)
+	}
+}
+
+func TestNavigator_RecordsBreadcrumbs(t *testing.T) {
+	errors.SetMaxBreadcrumbs(10)
+	t.Cleanup(func() { errors.SetMaxBreadcrumbs(errors.DefaultMaxBreadcrumbs) })
+
+	nav, _ := pumpObservedNavigator(t, NewRouteObserver())
+	errors.ClearBreadcrumbs()
+
+	nav.PushNamed("/details", nil)
+	nav.Pop(nil)
+
+	var got []string
+	for _, b := range errors.Breadcrumbs() {
+		if b.Category == errors.BreadcrumbNavigation {
+			got = append(got, b.Message)
+		}
+	}
+	want := []string{"push /details", "pop /details"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected breadcrumbs %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,113 @@
+package navigation
+
+// resultNavigator is implemented by navigators that can report when a pushed
+// route leaves the stack. It backs the typed [PushForResult] helpers.
+type resultNavigator interface {
+	// pushForResult runs push against the navigator and, if it placed a new
+	// route on top of the stack, calls onComplete once that route leaves.
+	// popped is true when the route was popped with Pop/MaybePop, and false
+	// when it was removed another way (PopUntil, replacement, disposal).
+	pushForResult(push func(nav NavigatorState), onComplete func(result any, popped bool))
+}
+
+// PushForResult pushes route and returns a channel that receives the value
+// the route is popped with, already typed as T.
+//
+// The channel is buffered (size 1) and closed after the route leaves the
+// stack. If the route is removed without being popped (for example by
+// PopUntil or PushReplacement), or is popped with a result that is nil or
+// not a T, the channel is closed without a value. Use the two-value receive
+// to tell the cases apart:
+//
+//	ch := navigation.PushForResult[Color](nav, navigation.NewAnimatedPageRoute(buildPicker, settings))
+//	go func() {
+//	    if color, ok := <-ch; ok {
+//	        drift.Dispatch(func() { applyColor(color) })
+//	    }
+//	}()
+//
+// If a Redirect sends the push elsewhere, the channel tracks the route that
+// was actually pushed. If nothing was pushed, the channel is closed
+// immediately.
+func PushForResult[T any](nav NavigatorState, route Route) <-chan T {
+	if route == nil {
+		return closedResult[T]()
+	}
+	return pushForResult[T](nav, func(nav NavigatorState) {
+		nav.Push(route)
+	})
+}
+
+// PushNamedForResult is like [PushForResult] but creates the route by name,
+// as [NavigatorState.PushNamed] does.
+func PushNamedForResult[T any](nav NavigatorState, name string, args any) <-chan T {
+	return pushForResult[T](nav, func(nav NavigatorState) {
+		nav.PushNamed(name, args)
+	})
+}
+
+func pushForResult[T any](nav NavigatorState, push func(nav NavigatorState)) <-chan T {
+	rn, ok := nav.(resultNavigator)
+	if !ok {
+		return closedResult[T]()
+	}
+	result := make(chan T, 1) // Buffered so completion never blocks the UI thread
+	rn.pushForResult(push, func(value any, popped bool) {
+		if popped {
+			if typed, ok := value.(T); ok {
+				result <- typed
+			}
+		}
+		close(result)
+	})
+	return result
+}
+
+func closedResult[T any]() <-chan T {
+	result := make(chan T)
+	close(result)
+	return result
+}
+
+// pushForResult implements resultNavigator.
+func (s *navigatorState) pushForResult(push func(nav NavigatorState), onComplete func(result any, popped bool)) {
+	before := s.topRoute()
+	push(s)
+	top := s.topRoute()
+	if top == nil || top == before {
+		onComplete(nil, false)
+		return
+	}
+	if s.resultCallbacks == nil {
+		s.resultCallbacks = make(map[Route]func(any, bool))
+	}
+	s.resultCallbacks[top] = onComplete
+}
+
+// topRoute returns the route on top of the stack, or nil if it is empty.
+func (s *navigatorState) topRoute() Route {
+	if len(s.routes) == 0 {
+		return nil
+	}
+	return s.routes[len(s.routes)-1]
+}
+
+// completeRoute notifies a pending PushForResult caller that route has left
+// the stack.
+func (s *navigatorState) completeRoute(route Route, result any, popped bool) {
+	onComplete, ok := s.resultCallbacks[route]
+	if !ok {
+		return
+	}
+	delete(s.resultCallbacks, route)
+	onComplete(result, popped)
+}
+
+// pushForResult implements resultNavigator by delegating to the root navigator.
+func (s *routerState) pushForResult(push func(nav NavigatorState), onComplete func(result any, popped bool)) {
+	if rn, ok := RootNavigator().(resultNavigator); ok {
+		rn.pushForResult(push, onComplete)
+		return
+	}
+	onComplete(nil, false)
+}
@@ -0,0 +1,122 @@
+package navigation
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/widgets"
+
+	dtesting "github.com/go-drift/drift/pkg/testing"
+)
+
+// pumpResultNavigator mounts a Navigator with plain page routes and returns
+// its state, captured from the initial route's build context.
+func pumpResultNavigator(t *testing.T) (*dtesting.WidgetTester, NavigatorState) {
+	t.Helper()
+	tester := dtesting.NewWidgetTesterWithT(t)
+
+	var nav NavigatorState
+	err := tester.PumpWidget(Navigator{
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			return NewPageRoute(func(ctx core.BuildContext) core.Widget {
+				if settings.Name == "/" {
+					nav = NavigatorOf(ctx)
+				}
+				return widgets.Text{Content: settings.Name}
+			}, settings)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nav == nil {
+		t.Fatal("expected NavigatorOf to find the navigator")
+	}
+	return tester, nav
+}
+
+func TestPushForResult_DeliversTypedResult(t *testing.T) {
+	_, nav := pumpResultNavigator(t)
+
+	ch := PushNamedForResult[int](nav, "/picker", nil)
+	nav.Pop(42)
+
+	value, ok := <-ch
+	if !ok || value != 42 {
+		t.Errorf("expected (42, true), got (%v, %v)", value, ok)
+	}
+	if _, ok := <-ch; ok {
+		t.Error("channel should be closed after delivering the result")
+	}
+}
+
+func TestPushForResult_MismatchedTypeClosesWithoutValue(t *testing.T) {
+	_, nav := pumpResultNavigator(t)
+
+	ch := PushForResult[string](nav, NewPageRoute(nil, RouteSettings{Name: "/picker"}))
+	nav.Pop(42)
+
+	if value, ok := <-ch; ok {
+		t.Errorf("expected closed channel, got %q", value)
+	}
+}
+
+func TestPushForResult_PopUntilCancels(t *testing.T) {
+	_, nav := pumpResultNavigator(t)
+
+	first := PushNamedForResult[int](nav, "/a", nil)
+	second := PushNamedForResult[int](nav, "/b", nil)
+	nav.PopUntil(func(r Route) bool { return r.Settings().Name == "/" })
+
+	if _, ok := <-first; ok {
+		t.Error("first channel should be closed without a value")
+	}
+	if _, ok := <-second; ok {
+		t.Error("second channel should be closed without a value")
+	}
+}
+
+func TestPushForResult_ReplacementCancels(t *testing.T) {
+	_, nav := pumpResultNavigator(t)
+
+	ch := PushNamedForResult[int](nav, "/a", nil)
+	nav.PushReplacementNamed("/b", nil)
+
+	if _, ok := <-ch; ok {
+		t.Error("replaced route's channel should be closed without a value")
+	}
+}
+
+func TestPushForResult_UnknownRouteClosesImmediately(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+
+	var nav NavigatorState
+	err := tester.PumpWidget(Navigator{
+		InitialRoute: "/",
+		OnGenerateRoute: func(settings RouteSettings) Route {
+			if settings.Name != "/" {
+				return nil
+			}
+			return NewPageRoute(func(ctx core.BuildContext) core.Widget {
+				nav = NavigatorOf(ctx)
+				return widgets.Text{Content: "home"}
+			}, settings)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ch := PushNamedForResult[int](nav, "/missing", nil)
+	if _, ok := <-ch; ok {
+		t.Error("expected closed channel when no route was pushed")
+	}
+}
+
+func TestPushForResult_UnsupportedNavigator(t *testing.T) {
+	ch := PushForResult[int](&mockNavigatorState{}, NewPageRoute(nil, RouteSettings{}))
+	if _, ok := <-ch; ok {
+		t.Error("expected closed channel for navigator without result support")
+	}
+}
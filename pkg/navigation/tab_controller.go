@@ -1,5 +1,7 @@
 package navigation
 
+import "github.com/go-drift/drift/pkg/errors"
+
 // TabController coordinates tab selection state.
 type TabController struct {
 	index     int
@@ -25,6 +27,7 @@ func (c *TabController) SetIndex(index int) {
 	if c == nil || c.index == index {
 		return
 	}
+	errors.CheckUIThread("*navigation.TabController", "SetIndex")
 	c.index = index
 	for _, listener := range c.listeners {
 		listener(index)
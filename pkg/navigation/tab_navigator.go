@@ -91,6 +91,9 @@ func NewTab(item widgets.TabItem, builder func(ctx core.BuildContext) core.Widge
 //	    },
 //	}
 //
+// Set Bar to [TabNavigatorNavigationBar] for a [widgets.NavigationBar], and
+// RailMinWidth to switch to a [widgets.NavigationRail] on wide screens.
+//
 // Accessibility: Inactive tabs are automatically excluded from the accessibility
 // tree using [widgets.ExcludeSemantics].
 type TabNavigator struct {
@@ -102,8 +105,28 @@ type TabNavigator struct {
 	// Controller optionally provides programmatic control over tab selection.
 	// If nil, a default controller starting at index 0 is created.
 	Controller *TabController
+
+	// Bar selects the bar along the bottom that switches tabs. Defaults to
+	// a [widgets.TabBar].
+	Bar TabNavigatorBar
+
+	// RailMinWidth, when positive, replaces the bottom bar with a
+	// [widgets.NavigationRail] along the leading edge once the navigator is
+	// at least this wide, as on tablets and desktop windows. 600 is typical.
+	RailMinWidth float64
 }
 
+// TabNavigatorBar selects the bar a [TabNavigator] shows along the bottom.
+type TabNavigatorBar int
+
+const (
+	// TabNavigatorTabBar shows a [widgets.TabBar] from [theme.TabBarOf].
+	TabNavigatorTabBar TabNavigatorBar = iota
+	// TabNavigatorNavigationBar shows a [widgets.NavigationBar] from
+	// [theme.NavigationBarOf], with badges and an animated indicator.
+	TabNavigatorNavigationBar
+)
+
 func (t TabNavigator) CreateState() core.State {
 	return &tabNavigatorState{}
 }
@@ -151,25 +174,48 @@ func (s *tabNavigatorState) Build(ctx core.BuildContext) core.Widget {
 		}
 	}
 
-	return widgets.Column{
-		Children: []core.Widget{
-			widgets.Expanded{
-				Child: widgets.IndexedStack{
-					Children:  bodies,
-					Alignment: layout.AlignmentTopLeft,
-					Fit:       widgets.StackFitExpand,
-					Index:     index,
-				},
-			},
-			widgets.SafeArea{
+	content := widgets.IndexedStack{
+		Children:  bodies,
+		Alignment: layout.AlignmentTopLeft,
+		Fit:       widgets.StackFitExpand,
+		Index:     index,
+	}
+	onTap := func(i int) { s.controller.SetIndex(i) }
+
+	return widgets.LayoutBuilder{Builder: func(ctx core.BuildContext, constraints layout.Constraints) core.Widget {
+		// The rail and bar swap places with empty boxes rather than
+		// changing the tree's shape, so the tabs' navigators keep their
+		// stacks when the layout crosses RailMinWidth.
+		var rail, bar core.Widget = widgets.SizedBox{}, widgets.SizedBox{}
+		switch {
+		case s.nav.RailMinWidth > 0 && constraints.MaxWidth >= s.nav.RailMinWidth:
+			rail = theme.NavigationRailOf(ctx, tabItems, index, onTap)
+		case s.nav.Bar == TabNavigatorNavigationBar:
+			// NavigationBar pads itself for the safe area so its
+			// background reaches the bottom edge.
+			bar = theme.NavigationBarOf(ctx, tabItems, index, onTap)
+		default:
+			bar = widgets.SafeArea{
 				Bottom: true,
-				Child:  theme.TabBarOf(ctx, tabItems, index, func(i int) { s.controller.SetIndex(i) }),
+				Child:  theme.TabBarOf(ctx, tabItems, index, onTap),
+			}
+		}
+		return widgets.Row{
+			CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+			Children: []core.Widget{
+				rail,
+				widgets.Expanded{Child: widgets.Column{
+					Children: []core.Widget{
+						widgets.Expanded{Child: content},
+						bar,
+					},
+					MainAxisAlignment:  widgets.MainAxisAlignmentStart,
+					MainAxisSize:       widgets.MainAxisSizeMax,
+					CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+				}},
 			},
-		},
-		MainAxisAlignment:  widgets.MainAxisAlignmentStart,
-		MainAxisSize:       widgets.MainAxisSizeMax,
-		CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
-	}
+		}
+	}}
 }
 
 // validatedIndex returns the current tab index, clamping to valid range.
@@ -228,7 +274,7 @@ func (s *tabNavigatorState) DidUpdateWidget(oldWidget core.StatefulWidget) {
 		// Clear scope references for dropped navigators
 		for i := len(newNavigators); i < len(s.navigators); i++ {
 			if s.navigators[i] != nil {
-				globalScope.ClearActiveIf(s.navigators[i])
+				scopeOf(s.Element()).ClearActiveIf(s.navigators[i])
 			}
 		}
 		s.navigators = newNavigators
@@ -269,7 +315,7 @@ func (s *tabNavigatorState) registerNavigator(index int, nav NavigatorState) {
 
 	// If this is the active tab, set it as the active navigator
 	if index == s.currentIndex {
-		globalScope.SetActiveNavigator(nav)
+		scopeOf(s.Element()).SetActiveNavigator(nav)
 	}
 }
 
@@ -278,7 +324,7 @@ func (s *tabNavigatorState) onTabChanged(index int) {
 	s.currentIndex = index
 	// Set the active tab's navigator as the focused one
 	if index >= 0 && index < len(s.navigators) && s.navigators[index] != nil {
-		globalScope.SetActiveNavigator(s.navigators[index])
+		scopeOf(s.Element()).SetActiveNavigator(s.navigators[index])
 	}
 }
 
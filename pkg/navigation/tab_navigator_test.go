@@ -3,6 +3,9 @@ package navigation
 import (
 	"testing"
 
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	dtesting "github.com/go-drift/drift/pkg/testing"
 	"github.com/go-drift/drift/pkg/widgets"
 )
 
@@ -324,3 +327,47 @@ func TestTabNavigatorState_DidUpdateWidget_ShrinksTabs(t *testing.T) {
 		t.Error("Tab 0 navigator should be preserved")
 	}
 }
+
+func TestTabNavigator_RailKeepsTabState(t *testing.T) {
+	oldScope := globalScope
+	globalScope = &NavigationScope{}
+	defer func() { globalScope = oldScope }()
+
+	tester := dtesting.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+	inits := 0
+	nav := TabNavigator{
+		Tabs: []Tab{
+			NewTab(widgets.TabItem{Label: "Home"}, func(ctx core.BuildContext) core.Widget {
+				return initCounter{inits: &inits}
+			}),
+			NewTab(widgets.TabItem{Label: "Profile", Badge: "2"}, func(ctx core.BuildContext) core.Widget {
+				return widgets.Text{Content: "profile"}
+			}),
+		},
+		Bar:          TabNavigatorNavigationBar,
+		RailMinWidth: 600,
+	}
+	if err := tester.PumpWidget(nav); err != nil {
+		t.Fatal(err)
+	}
+	pumpFrames(t, tester, 1)
+	if !tester.Find(dtesting.ByType[widgets.NavigationBar]()).Exists() {
+		t.Fatal("expected a navigation bar on a narrow screen")
+	}
+	if !tester.Find(dtesting.ByText("2")).Exists() {
+		t.Error("expected the profile tab's badge")
+	}
+
+	tester.SetSize(graphics.Size{Width: 800, Height: 400})
+	pumpFrames(t, tester, 2)
+	if tester.Find(dtesting.ByType[widgets.NavigationBar]()).Exists() {
+		t.Error("expected the navigation bar to give way to the rail")
+	}
+	if !tester.Find(dtesting.ByType[widgets.NavigationRail]()).Exists() {
+		t.Fatal("expected a navigation rail on a wide screen")
+	}
+	if inits != 1 {
+		t.Errorf("expected the tabs to keep their state across the switch, got %d builds", inits)
+	}
+}
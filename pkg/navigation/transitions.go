@@ -174,12 +174,16 @@ func (r *renderSlideTransition) Paint(ctx *layout.PaintContext) {
 	ctx.PaintChildWithLayer(r.child, offset)
 }
 
-// BackgroundSlideTransition slides its child to the left as a foreground page
-// enters. At animation value 0 the child is at its normal position; at value 1
-// the child is shifted left by 33% of the width.
+// BackgroundSlideTransition moves its child as a foreground page enters. By
+// default, at animation value 0 the child is at its normal position; at value
+// 1 the child is shifted left by 33% of the width. Set Transform to animate
+// the child differently, for example to match a [PageTransition.Secondary].
 type BackgroundSlideTransition struct {
 	core.RenderObjectBase
 	Animation *animation.AnimationController
+	// Transform returns the child's transform at animation value t. Optional;
+	// defaults to the left parallax slide.
+	Transform func(t float64) PageTransform
 	Child     core.Widget
 }
 
@@ -192,6 +196,7 @@ func (b BackgroundSlideTransition) ChildWidget() core.Widget {
 func (b BackgroundSlideTransition) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 	r := &renderBackgroundSlideTransition{
 		transitionRenderBase: transitionRenderBase{animation: b.Animation},
+		transform:            b.Transform,
 	}
 	r.SetSelf(r)
 	r.subscribeAnimation()
@@ -206,33 +211,32 @@ func (b BackgroundSlideTransition) UpdateRenderObject(ctx core.BuildContext, ren
 			r.animation = b.Animation
 			r.subscribeAnimation()
 		}
+		r.transform = b.Transform
 		r.MarkNeedsPaint()
 	}
 }
 
 type renderBackgroundSlideTransition struct {
 	transitionRenderBase
+	transform func(t float64) PageTransform
 }
 
-func (r *renderBackgroundSlideTransition) slideOffset() graphics.Offset {
+func (r *renderBackgroundSlideTransition) currentTransform() PageTransform {
 	if r.animation == nil {
-		return graphics.Offset{}
+		return IdentityPageTransform()
 	}
-	return graphics.Offset{
-		X: -r.Size().Width * backgroundParallaxFactor * r.animation.Value,
+	if r.transform != nil {
+		return r.transform(r.animation.Value)
 	}
+	return slideSecondary(r.animation.Value)
 }
 
 func (r *renderBackgroundSlideTransition) ScrollOffset() graphics.Offset {
-	return r.slideOffset()
+	return r.currentTransform().pixelOffset(r.Size())
 }
 
 func (r *renderBackgroundSlideTransition) Paint(ctx *layout.PaintContext) {
-	if r.child == nil {
-		return
-	}
-	offset := r.slideOffset()
-	ctx.PaintChildWithLayer(r.child, offset)
+	r.paintTransformed(ctx, r.currentTransform())
 }
 
 // FadeTransition animates the opacity of its child.
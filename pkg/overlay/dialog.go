@@ -2,7 +2,6 @@ package overlay
 
 import (
 	"github.com/go-drift/drift/pkg/core"
-	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/theme"
 	"github.com/go-drift/drift/pkg/widgets"
 )
@@ -45,13 +44,12 @@ type Dialog struct {
 func (d Dialog) Build(ctx core.BuildContext) core.Widget {
 	th := theme.ThemeOf(ctx)
 	dt := th.DialogThemeOf()
-	colors := th.ColorScheme
 
 	c := widgets.Container{
 		Child:        d.Child,
 		Color:        dt.BackgroundColor,
 		BorderRadius: dt.BorderRadius,
-		Shadow:       graphics.BoxShadowElevation(dt.Elevation, colors.Shadow),
+		Shadow:       th.ElevationOf().Shadow(dt.Elevation),
 		Padding:      dt.Padding,
 	}
 	if d.Width > 0 {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/widgets"
 )
 
 // Overlay manages a stack of overlay entries above its child.
@@ -121,13 +122,26 @@ func (s *overlayState) Build(ctx core.BuildContext) core.Widget {
 		s.Element().MarkNeedsBuild()
 	}
 
-	// Build custom overlay render that handles Opaque hit testing
+	// Page content hidden from hits by an opaque entry is hidden from
+	// accessibility too, so screen readers stay inside the modal. The
+	// wrapper is always present to keep the child's element tree stable.
+	child := s.overlay.Child
+	if child != nil {
+		child = widgets.ExcludeSemantics{Excluding: opaqueIndex >= 0, Child: child}
+	}
+
+	// Build custom overlay render that handles Opaque hit testing, and
+	// host popup menus for the page and entries in it.
 	return overlayInherited{
 		state: s,
-		child: overlayRender{
-			child:   s.overlay.Child,
-			entries: entryWidgets,
-			opaque:  opaqueIndex,
+		child: widgets.PopupMenuHost{
+			Show:            s.showPopupMenu,
+			ShowContextMenu: s.showContextMenu,
+			Child: overlayRender{
+				child:   child,
+				entries: entryWidgets,
+				opaque:  opaqueIndex,
+			},
 		},
 	}
 }
@@ -902,3 +902,130 @@ func TestRenderOverlay_HitTest_OutOfBounds(t *testing.T) {
 		t.Error("child should NOT have been hit tested - position out of bounds")
 	}
 }
+
+// menuButton opens a popup menu anchored to itself when tapped.
+type menuButton struct {
+	core.StatelessBase
+	menu widgets.PopupMenu
+}
+
+func (b menuButton) Build(ctx core.BuildContext) core.Widget {
+	return widgets.GestureDetector{
+		OnTap: func() { widgets.ShowPopupMenu(ctx, b.menu) },
+		Child: widgets.SizedBox{Width: 40, Height: 40, Child: widgets.Text{Content: "more"}},
+	}
+}
+
+func TestOverlay_ShowPopupMenu(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+
+	selected := ""
+	menu := widgets.PopupMenu{
+		Items: []widgets.PopupMenuItem{
+			{Label: "Rename", OnSelected: func() { selected = "rename" }},
+			{Label: "Delete", OnSelected: func() { selected = "delete" }, Disabled: true},
+		},
+		ItemHeight: 48,
+		MinWidth:   112,
+	}
+	err := tester.PumpWidget(Overlay{Child: widgets.Stack{Children: []core.Widget{
+		widgets.Positioned(menuButton{menu: menu}).Right(10).Top(20),
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tester.Tap(dtesting.ByText("more"))
+	tester.Pump()
+	items := tester.Find(dtesting.ByType[widgets.PopupMenu]())
+	if !items.Exists() {
+		t.Fatal("expected the menu to open")
+	}
+	// The anchor is on the right, so the menu lines up with its right edge.
+	panel := items.RenderObject()
+	rect, ok := rectIn(panel, tester.Find(dtesting.ByType[Overlay]()).RenderObject())
+	if !ok || rect.Right != 390 || rect.Top != 20 || rect.Width() < 112 || rect.Height() != 96 {
+		t.Errorf("expected a 2-item menu over the anchor's top right corner, got %v", rect)
+	}
+
+	tester.Tap(dtesting.ByText("Delete"))
+	tester.Pump()
+	if selected != "" || !tester.Find(dtesting.ByType[widgets.PopupMenu]()).Exists() {
+		t.Error("expected a disabled item to do nothing")
+	}
+
+	tester.Tap(dtesting.ByText("Rename"))
+	tester.Pump()
+	if selected != "rename" {
+		t.Errorf("expected Rename to be selected, got %q", selected)
+	}
+	if tester.Find(dtesting.ByType[widgets.PopupMenu]()).Exists() {
+		t.Error("expected selecting an item to close the menu")
+	}
+
+	tester.Tap(dtesting.ByText("more"))
+	tester.Pump()
+	tester.TapAt(graphics.Offset{X: 20, Y: 500})
+	tester.Pump()
+	if tester.Find(dtesting.ByType[widgets.PopupMenu]()).Exists() {
+		t.Error("expected a tap outside the menu to close it")
+	}
+}
+
+// contextMenuButton opens a context menu anchored to itself when tapped.
+type contextMenuButton struct {
+	core.StatelessBase
+	menu widgets.ContextMenu
+}
+
+func (b contextMenuButton) Build(ctx core.BuildContext) core.Widget {
+	return widgets.GestureDetector{
+		OnTap: func() { widgets.ShowContextMenu(ctx, b.menu) },
+		Child: widgets.SizedBox{Width: 100, Height: 60},
+	}
+}
+
+func TestOverlay_ShowContextMenuWithPreview(t *testing.T) {
+	tester := dtesting.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+
+	menu := widgets.ContextMenu{
+		Menu: widgets.PopupMenu{
+			Items:      []widgets.PopupMenuItem{{Label: "Share"}},
+			ItemHeight: 48,
+			MinWidth:   112,
+		},
+		Preview:      widgets.DecoratedBox{Color: graphics.ColorWhite},
+		BarrierColor: graphics.ColorBlack.WithAlpha(0.32),
+	}
+	err := tester.PumpWidget(Overlay{Child: widgets.Stack{Children: []core.Widget{
+		widgets.Positioned(contextMenuButton{menu: menu}).Left(20).Bottom(40),
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tester.Tap(dtesting.ByType[contextMenuButton]())
+	tester.Pump()
+	overlayRender := tester.Find(dtesting.ByType[Overlay]()).RenderObject()
+	preview, ok := rectIn(tester.Find(dtesting.ByType[widgets.DecoratedBox]()).RenderObject(), overlayRender)
+	if !ok || preview != graphics.RectFromLTWH(20, 500, 100, 60) {
+		t.Errorf("expected the preview over the anchor, got %v", preview)
+	}
+	// The anchor is in the lower half, so the menu opens above it.
+	panel, ok := rectIn(tester.Find(dtesting.ByType[widgets.PopupMenu]()).RenderObject(), overlayRender)
+	if !ok || panel.Left != 20 || panel.Bottom != 500-contextMenuGap {
+		t.Errorf("expected the menu above the preview, got %v", panel)
+	}
+	barrier := tester.Find(dtesting.ByType[ModalBarrier]()).Widget().(ModalBarrier)
+	if barrier.Color != menu.BarrierColor {
+		t.Errorf("expected the barrier in BarrierColor, got %v", barrier.Color)
+	}
+
+	tester.TapAt(graphics.Offset{X: 300, Y: 100})
+	tester.Pump()
+	if tester.Find(dtesting.ByType[widgets.PopupMenu]()).Exists() {
+		t.Error("expected a tap on the barrier to close the menu")
+	}
+}
@@ -0,0 +1,104 @@
+package overlay
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// showPopupMenu shows menu in a new entry over the anchor, opening toward
+// the middle of the overlay so it stays on screen. Tapping outside the menu
+// closes it.
+func (s *overlayState) showPopupMenu(anchor layout.RenderObject, menu widgets.PopupMenu) {
+	s.showContextMenu(anchor, widgets.ContextMenu{Menu: menu})
+}
+
+// showContextMenu is showPopupMenu with the menu's preview drawn over the
+// anchor above a colored barrier. With a preview, the menu opens beside the
+// anchor, below it or above it, rather than over it.
+func (s *overlayState) showContextMenu(anchor layout.RenderObject, menu widgets.ContextMenu) {
+	target := s.Element().RenderObject()
+	if target == nil {
+		return
+	}
+	rect, ok := rectIn(anchor, target)
+	if !ok {
+		return
+	}
+	size := target.Size()
+
+	var entry *OverlayEntry
+	dismiss := func() { entry.Remove() }
+	menu.Menu.OnDismiss = dismiss
+	positioned := widgets.Positioned(menu.Menu)
+	if rect.Left+rect.Width()/2 > size.Width/2 {
+		positioned = positioned.Right(size.Width - rect.Right)
+	} else {
+		positioned = positioned.Left(rect.Left)
+	}
+	below := rect.Top+rect.Height()/2 <= size.Height/2
+	switch {
+	case menu.Preview != nil && below:
+		positioned = positioned.Top(rect.Bottom + contextMenuGap)
+	case menu.Preview != nil:
+		positioned = positioned.Bottom(size.Height - rect.Top + contextMenuGap)
+	case below:
+		positioned = positioned.Top(rect.Top)
+	default:
+		positioned = positioned.Bottom(size.Height - rect.Bottom)
+	}
+
+	children := []core.Widget{
+		widgets.Positioned(ModalBarrier{
+			Color:         menu.BarrierColor,
+			Dismissible:   true,
+			OnDismiss:     dismiss,
+			SemanticLabel: "Dismiss menu",
+		}).Fill(0),
+	}
+	if menu.Preview != nil {
+		children = append(children, widgets.Positioned(widgets.IgnorePointer{
+			Ignoring: true,
+			Child:    menu.Preview,
+		}).At(rect.Left, rect.Top).Size(rect.Width(), rect.Height()))
+	}
+	children = append(children, positioned)
+	entry = NewOverlayEntry(func(ctx core.BuildContext) core.Widget {
+		return widgets.Stack{Children: children}
+	})
+	entry.Opaque = true
+	s.Insert(entry, nil, nil)
+}
+
+// contextMenuGap separates a context menu from its preview.
+const contextMenuGap = 8
+
+// rectIn returns the bounds of r in the coordinate space of target, or
+// false if r isn't laid out below target.
+func rectIn(r, target layout.RenderObject) (graphics.Rect, bool) {
+	var offset graphics.Offset
+	size := r.Size()
+	for r != nil {
+		if r == target {
+			return graphics.RectFromLTWH(offset.X, offset.Y, size.Width, size.Height), true
+		}
+		parent := renderParent(r)
+		if data, ok := r.ParentData().(*layout.BoxParentData); ok && data != nil {
+			offset = graphics.Offset{X: offset.X + data.Offset.X, Y: offset.Y + data.Offset.Y}
+		}
+		if provider, ok := parent.(core.ScrollOffsetProvider); ok {
+			scroll := provider.ScrollOffset()
+			offset = graphics.Offset{X: offset.X + scroll.X, Y: offset.Y + scroll.Y}
+		}
+		r = parent
+	}
+	return graphics.Rect{}, false
+}
+
+func renderParent(r layout.RenderObject) layout.RenderObject {
+	if child, ok := r.(interface{ Parent() layout.RenderObject }); ok {
+		return child.Parent()
+	}
+	return nil
+}
@@ -116,8 +116,11 @@ func ShowDialog(ctx core.BuildContext, opts DialogOptions) (dismiss func()) {
 	})
 
 	dialogEntry = NewOverlayEntry(func(ctx core.BuildContext) core.Widget {
-		return widgets.Center{
-			Child: opts.Builder(ctx, dismiss),
+		return widgets.FocusScope{
+			Trap: true,
+			Child: widgets.Center{
+				Child: opts.Builder(ctx, dismiss),
+			},
 		}
 	})
 	// Opaque blocks hits from reaching the page content (Overlay.Child) but
@@ -0,0 +1,141 @@
+package platform
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+// Appearance reports the system's dark mode, contrast, font size, and reduce
+// motion settings.
+var Appearance = &AppearanceService{
+	events: NewEventChannel("drift/appearance/events"),
+}
+
+// SystemAppearance holds the user's system-wide display preferences.
+type SystemAppearance struct {
+	// DarkMode reports that the system is using a dark color scheme.
+	DarkMode bool
+	// HighContrast reports that the user asked for increased contrast
+	// ("Increase Contrast" on iOS, "High contrast text" on Android).
+	HighContrast bool
+	// TextScaleFactor is the user's preferred font size relative to the
+	// default, such as 1.3 for "Large" text. Zero means the platform has not
+	// reported one; treat it as 1.
+	TextScaleFactor float64
+	// ReduceMotion reports that the user asked for less motion ("Reduce
+	// Motion" on iOS, "Remove animations" on Android).
+	ReduceMotion bool
+}
+
+// AppearanceService tracks system appearance changes.
+//
+// AppearanceService implements core.Listenable through AddListener.
+type AppearanceService struct {
+	events     *EventChannel
+	appearance SystemAppearance
+	handlers   []appearanceEntry
+	nextID     int
+	mu         sync.RWMutex
+}
+
+type appearanceEntry struct {
+	id      int
+	handler func(SystemAppearance)
+}
+
+func init() {
+	initAppearanceListeners()
+	registerBuiltinInit(initAppearanceListeners)
+}
+
+func initAppearanceListeners() {
+	Appearance.events.Listen(EventHandler{
+		OnEvent: func(data any) {
+			m, ok := data.(map[string]any)
+			if !ok {
+				errors.Report(&errors.DriftError{
+					Op:      "appearance.parseEvent",
+					Kind:    errors.KindParsing,
+					Channel: "drift/appearance/events",
+					Err: &errors.ParseError{
+						Channel:  "drift/appearance/events",
+						DataType: "SystemAppearance",
+						Got:      data,
+					},
+				})
+				return
+			}
+			textScale, _ := toFloat64(m["textScaleFactor"])
+			Appearance.update(SystemAppearance{
+				DarkMode:        parseBool(m["darkMode"]),
+				HighContrast:    parseBool(m["highContrast"]),
+				TextScaleFactor: textScale,
+				ReduceMotion:    parseBool(m["reduceMotion"]),
+			})
+		},
+		OnError: func(err error) {
+			errors.Report(&errors.DriftError{
+				Op:      "appearance.streamError",
+				Kind:    errors.KindPlatform,
+				Channel: "drift/appearance/events",
+				Err:     err,
+			})
+		},
+	})
+}
+
+// Current returns the latest system appearance. Before the platform reports
+// one, it is a light, normal-contrast appearance with motion enabled.
+func (a *AppearanceService) Current() SystemAppearance {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.appearance
+}
+
+// AddHandler registers a handler to be called when the appearance changes.
+// Returns a function that can be called to remove the handler.
+// Handlers run on the goroutine that delivered the change, which is not
+// the UI thread; use [Dispatch] to touch widgets.
+func (a *AppearanceService) AddHandler(handler func(SystemAppearance)) func() {
+	a.mu.Lock()
+	id := a.nextID
+	a.nextID++
+	a.handlers = append(a.handlers, appearanceEntry{id: id, handler: handler})
+	a.mu.Unlock()
+
+	return func() {
+		a.mu.Lock()
+		a.handlers = slices.DeleteFunc(a.handlers, func(e appearanceEntry) bool { return e.id == id })
+		a.mu.Unlock()
+	}
+}
+
+// AddListener registers a listener called on the UI thread after every
+// appearance change, implementing core.Listenable. Read the new appearance
+// with [AppearanceService.Current]. Returns a function that removes the
+// listener.
+func (a *AppearanceService) AddListener(listener func()) func() {
+	return a.AddHandler(func(SystemAppearance) {
+		if !Dispatch(listener) {
+			listener()
+		}
+	})
+}
+
+// update stores the appearance and notifies handlers if it changed.
+func (a *AppearanceService) update(appearance SystemAppearance) {
+	a.mu.Lock()
+	if a.appearance == appearance {
+		a.mu.Unlock()
+		return
+	}
+	a.appearance = appearance
+	handlers := slices.Clone(a.handlers)
+	a.mu.Unlock()
+
+	for _, h := range handlers {
+		h.handler(appearance)
+	}
+}
@@ -0,0 +1,57 @@
+package platform
+
+import "testing"
+
+func TestAppearance_EventUpdatesCurrent(t *testing.T) {
+	SetupTestBridge(t.Cleanup)
+
+	var received []SystemAppearance
+	unsubscribe := Appearance.AddHandler(func(a SystemAppearance) {
+		received = append(received, a)
+	})
+	defer unsubscribe()
+
+	data, err := DefaultCodec.Encode(map[string]any{
+		"darkMode":        true,
+		"highContrast":    true,
+		"textScaleFactor": 1.3,
+		"reduceMotion":    true,
+	})
+	if err != nil {
+		t.Fatalf("encode event: %v", err)
+	}
+	if err := HandleEvent("drift/appearance/events", data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+
+	want := SystemAppearance{DarkMode: true, HighContrast: true, TextScaleFactor: 1.3, ReduceMotion: true}
+	if got := Appearance.Current(); got != want {
+		t.Errorf("Current() = %+v, want %+v", got, want)
+	}
+	if len(received) != 1 || received[0] != want {
+		t.Errorf("handler received %+v, want one %+v", received, want)
+	}
+
+	// Repeating the same appearance does not notify again.
+	if err := HandleEvent("drift/appearance/events", data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+	if len(received) != 1 {
+		t.Errorf("handler called %d times for an unchanged appearance, want 1", len(received))
+	}
+}
+
+func TestAppearance_AddListenerRemove(t *testing.T) {
+	SetupTestBridge(t.Cleanup)
+
+	calls := 0
+	remove := Appearance.AddListener(func() { calls++ })
+
+	Appearance.SetAppearanceForTest(SystemAppearance{DarkMode: true})
+	remove()
+	Appearance.SetAppearanceForTest(SystemAppearance{})
+
+	if calls != 1 {
+		t.Errorf("listener called %d times, want 1", calls)
+	}
+}
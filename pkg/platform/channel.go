@@ -1,9 +1,14 @@
 package platform
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
+
+	drifterrors "github.com/go-drift/drift/pkg/errors"
 )
 
 // MethodHandler handles incoming method calls on a channel.
@@ -14,13 +19,46 @@ type MethodChannel struct {
 	name    string
 	codec   MessageCodec
 	handler MethodHandler
+	timeout atomic.Int64 // time.Duration; zero means no timeout
+}
+
+// CallOptions configures a single method call made with
+// [MethodChannel.InvokeWithOptions].
+type CallOptions struct {
+	// Timeout bounds each attempt. Zero uses the channel's timeout set with
+	// [MethodChannel.SetTimeout].
+	Timeout time.Duration
+
+	// Retries is the number of extra attempts made after a failure that
+	// RetryIf accepts. Only retry methods that are safe to run twice: a
+	// timed-out call may still complete on the native side.
+	Retries int
+
+	// RetryDelay is the wait before each retry.
+	RetryDelay time.Duration
+
+	// RetryIf reports whether a failed attempt should be retried. Nil
+	// retries timeouts only.
+	RetryIf func(err error) bool
 }
 
-// NewMethodChannel creates a new method channel with the given name.
+// NewMethodChannel creates a new method channel with the given name that
+// encodes arguments and results with [DefaultCodec].
 func NewMethodChannel(name string) *MethodChannel {
+	return NewMethodChannelWithCodec(name, DefaultCodec)
+}
+
+// NewMethodChannelWithCodec creates a new method channel that encodes
+// arguments and results with codec. The native handler for the channel must
+// be registered with the matching codec.
+//
+// Use [StandardMessageCodec] to keep integer and byte buffer types intact,
+// or [BinaryCodec] to exchange raw bytes such as images or protobuf
+// messages without JSON overhead.
+func NewMethodChannelWithCodec(name string, codec MessageCodec) *MethodChannel {
 	ch := &MethodChannel{
 		name:  name,
-		codec: DefaultCodec,
+		codec: codec,
 	}
 	registry.registerMethod(name, ch)
 	return ch
@@ -31,15 +69,135 @@ func (c *MethodChannel) Name() string {
 	return c.name
 }
 
+// Codec returns the codec used for arguments and results.
+func (c *MethodChannel) Codec() MessageCodec {
+	return c.codec
+}
+
 // SetHandler sets the handler for incoming method calls from native code.
 func (c *MethodChannel) SetHandler(handler MethodHandler) {
 	c.handler = handler
 }
 
+// SetTimeout sets how long calls on this channel wait for the native side
+// before failing with [ErrTimeout]. Zero, the default, waits indefinitely.
+func (c *MethodChannel) SetTimeout(timeout time.Duration) {
+	c.timeout.Store(int64(timeout))
+}
+
+// Timeout returns the timeout set with [MethodChannel.SetTimeout].
+func (c *MethodChannel) Timeout() time.Duration {
+	return time.Duration(c.timeout.Load())
+}
+
 // Invoke calls a method on the native side and returns the result.
-// This blocks until the native side responds or an error occurs.
+// This blocks until the native side responds, an error occurs, or the
+// channel's timeout elapses.
+//
+// Errors returned by native code are [*ChannelError] values, which match
+// the package's sentinel errors with [errors.Is] where their code has one.
+// Failed calls are reported to the drift error handler, except
+// [ErrPlatformUnavailable], which is expected where a feature is missing.
 func (c *MethodChannel) Invoke(method string, args any) (any, error) {
-	return invokeNative(c.name, method, args)
+	return c.InvokeWithOptions(context.Background(), method, args, CallOptions{})
+}
+
+// InvokeContext is like [MethodChannel.Invoke] but stops waiting when ctx
+// is done, returning [ErrTimeout] if its deadline passed and [ErrCanceled]
+// otherwise. The native call is not interrupted; its result is discarded.
+func (c *MethodChannel) InvokeContext(ctx context.Context, method string, args any) (any, error) {
+	return c.InvokeWithOptions(ctx, method, args, CallOptions{})
+}
+
+// InvokeWithOptions is like [MethodChannel.InvokeContext] with a per-call
+// timeout and retries:
+//
+//	result, err := ch.InvokeWithOptions(ctx, "fetchStatus", nil, platform.CallOptions{
+//	    Timeout: 2 * time.Second,
+//	    Retries: 2,
+//	})
+func (c *MethodChannel) InvokeWithOptions(ctx context.Context, method string, args any, opts CallOptions) (any, error) {
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = c.Timeout()
+	}
+	retryIf := opts.RetryIf
+	if retryIf == nil {
+		retryIf = func(err error) bool { return errors.Is(err, ErrTimeout) }
+	}
+
+	result, err := c.invokeOnce(ctx, method, args, timeout)
+	for attempt := 0; err != nil && attempt < opts.Retries && ctx.Err() == nil && retryIf(err); attempt++ {
+		if opts.RetryDelay > 0 {
+			timer := time.NewTimer(opts.RetryDelay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				err = contextError(ctx.Err())
+				continue
+			}
+		}
+		result, err = c.invokeOnce(ctx, method, args, timeout)
+	}
+	if err != nil {
+		reportCallError(c.name, method, err)
+	}
+	return result, err
+}
+
+// invokeOnce makes one native call. Calls without a deadline or
+// cancellation run on the caller's goroutine; otherwise the call runs on
+// its own goroutine so the caller can stop waiting for it.
+func (c *MethodChannel) invokeOnce(ctx context.Context, method string, args any, timeout time.Duration) (any, error) {
+	if timeout <= 0 && ctx.Done() == nil {
+		return invokeNative(c.codec, c.name, method, args)
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, contextError(err)
+	}
+
+	type reply struct {
+		result any
+		err    error
+	}
+	done := make(chan reply, 1)
+	go func() {
+		result, err := invokeNative(c.codec, c.name, method, args)
+		done <- reply{result, err}
+	}()
+	select {
+	case r := <-done:
+		return r.result, r.err
+	case <-ctx.Done():
+		return nil, contextError(ctx.Err())
+	}
+}
+
+// contextError maps a context error to the package's sentinel errors.
+func contextError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrTimeout
+	}
+	return ErrCanceled
+}
+
+// reportCallError reports a failed method call to the error handler.
+func reportCallError(channel, method string, err error) {
+	if errors.Is(err, ErrPlatformUnavailable) {
+		return
+	}
+	drifterrors.Report(&drifterrors.DriftError{
+		Op:      "platform.Invoke",
+		Kind:    drifterrors.KindPlatform,
+		Channel: channel,
+		Err:     fmt.Errorf("%s: %w", method, err),
+	})
 }
 
 // handleCall processes an incoming method call from native code.
@@ -85,11 +243,19 @@ type EventChannel struct {
 	mu            sync.Mutex
 }
 
-// NewEventChannel creates a new event channel with the given name.
+// NewEventChannel creates a new event channel with the given name that
+// decodes events with [DefaultCodec].
 func NewEventChannel(name string) *EventChannel {
+	return NewEventChannelWithCodec(name, DefaultCodec)
+}
+
+// NewEventChannelWithCodec creates a new event channel that decodes events
+// with codec. The native side must encode events for the channel with the
+// matching codec.
+func NewEventChannelWithCodec(name string, codec MessageCodec) *EventChannel {
 	ch := &EventChannel{
 		name:  name,
-		codec: DefaultCodec,
+		codec: codec,
 	}
 	registry.registerEvent(name, ch)
 	return ch
@@ -100,6 +266,11 @@ func (c *EventChannel) Name() string {
 	return c.name
 }
 
+// Codec returns the codec used for events.
+func (c *EventChannel) Codec() MessageCodec {
+	return c.codec
+}
+
 // Listen subscribes to events on this channel.
 // If the native bridge is not yet available (e.g., during init), the subscription
 // is created but the event stream start is deferred until [SetNativeBridge] is called.
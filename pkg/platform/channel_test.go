@@ -0,0 +1,156 @@
+package platform
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	drifterrors "github.com/go-drift/drift/pkg/errors"
+)
+
+// funcBridge is a NativeBridge whose method calls run invoke.
+type funcBridge struct {
+	invoke func(channel, method string) ([]byte, error)
+}
+
+func (b funcBridge) InvokeMethod(channel, method string, args []byte) ([]byte, error) {
+	return b.invoke(channel, method)
+}
+func (funcBridge) StartEventStream(string) error { return nil }
+func (funcBridge) StopEventStream(string) error  { return nil }
+
+// reportedErrors collects errors reported to the drift error handler.
+type reportedErrors struct {
+	mu     sync.Mutex
+	errors []*drifterrors.DriftError
+}
+
+func (r *reportedErrors) HandleError(err *drifterrors.DriftError) {
+	r.mu.Lock()
+	r.errors = append(r.errors, err)
+	r.mu.Unlock()
+}
+func (r *reportedErrors) HandlePanic(*drifterrors.PanicError)            {}
+func (r *reportedErrors) HandleBoundaryError(*drifterrors.BoundaryError) {}
+
+func setupFuncBridge(t *testing.T, invoke func(channel, method string) ([]byte, error)) *reportedErrors {
+	t.Helper()
+	SetupTestBridge(t.Cleanup)
+	SetNativeBridge(funcBridge{invoke: invoke})
+	reported := &reportedErrors{}
+	drifterrors.SetHandler(reported)
+	t.Cleanup(func() { drifterrors.SetHandler(nil) })
+	return reported
+}
+
+// hangingInvoke returns a method call that blocks until release is
+// called. release waits for the call to return, so the bridge is not reset
+// while it is still running.
+func hangingInvoke() (invoke func(channel, method string) ([]byte, error), release func()) {
+	done := make(chan struct{})
+	var returned sync.WaitGroup
+	returned.Add(1)
+	invoke = func(string, string) ([]byte, error) {
+		defer returned.Done()
+		<-done
+		return DefaultCodec.Encode(nil)
+	}
+	release = func() {
+		close(done)
+		returned.Wait()
+	}
+	return invoke, release
+}
+
+func TestMethodChannel_Timeout(t *testing.T) {
+	invoke, release := hangingInvoke()
+	defer release()
+	reported := setupFuncBridge(t, invoke)
+
+	ch := NewMethodChannel("test/timeout")
+	ch.SetTimeout(10 * time.Millisecond)
+	if _, err := ch.Invoke("hang", nil); !errors.Is(err, ErrTimeout) {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+	if len(reported.errors) != 1 || reported.errors[0].Channel != "test/timeout" || !errors.Is(reported.errors[0].Err, ErrTimeout) {
+		t.Errorf("expected the timeout to be reported, got %+v", reported.errors)
+	}
+}
+
+func TestMethodChannel_InvokeContextCanceled(t *testing.T) {
+	invoke, release := hangingInvoke()
+	defer release()
+	setupFuncBridge(t, invoke)
+
+	ch := NewMethodChannel("test/cancel")
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(5*time.Millisecond, cancel)
+	if _, err := ch.InvokeContext(ctx, "hang", nil); !errors.Is(err, ErrCanceled) {
+		t.Fatalf("expected ErrCanceled, got %v", err)
+	}
+}
+
+func TestMethodChannel_Retries(t *testing.T) {
+	var attempts atomic.Int32
+	setupFuncBridge(t, func(string, string) ([]byte, error) {
+		if attempts.Add(1) < 3 {
+			return nil, NewChannelError("timeout", "native call timed out")
+		}
+		return DefaultCodec.Encode("ok")
+	})
+
+	ch := NewMethodChannel("test/retry")
+	result, err := ch.InvokeWithOptions(context.Background(), "flaky", nil, CallOptions{Retries: 2})
+	if err != nil || result != "ok" {
+		t.Fatalf("expected ok after retries, got %v, %v", result, err)
+	}
+	if attempts.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts.Load())
+	}
+
+	// Errors RetryIf rejects are returned without retrying.
+	attempts.Store(0)
+	setupFuncBridge(t, func(string, string) ([]byte, error) {
+		attempts.Add(1)
+		return nil, NewChannelError("invalid_arguments", "bad id")
+	})
+	if _, err := ch.InvokeWithOptions(context.Background(), "flaky", nil, CallOptions{Retries: 2}); !errors.Is(err, ErrInvalidArguments) {
+		t.Fatalf("expected ErrInvalidArguments, got %v", err)
+	}
+	if attempts.Load() != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts.Load())
+	}
+}
+
+func TestMethodChannel_UnavailableNotReported(t *testing.T) {
+	reported := setupFuncBridge(t, func(string, string) ([]byte, error) {
+		return nil, ErrPlatformUnavailable
+	})
+
+	if _, err := NewMethodChannel("test/unavailable").Invoke("missing", nil); !errors.Is(err, ErrPlatformUnavailable) {
+		t.Fatalf("expected ErrPlatformUnavailable, got %v", err)
+	}
+	if len(reported.errors) != 0 {
+		t.Errorf("expected no reports, got %+v", reported.errors)
+	}
+}
+
+func TestChannelError_Is(t *testing.T) {
+	err := error(NewChannelErrorWithDetails("method_not_found", "no such method", map[string]any{"method": "x"}))
+	if !errors.Is(err, ErrMethodNotFound) {
+		t.Error("expected method_not_found to match ErrMethodNotFound")
+	}
+	if errors.Is(err, ErrInvalidArguments) {
+		t.Error("expected method_not_found not to match ErrInvalidArguments")
+	}
+	if errors.Is(NewChannelError("native_error", "boom"), ErrTimeout) {
+		t.Error("expected an unmapped code not to match a sentinel")
+	}
+	var ce *ChannelError
+	if !errors.As(err, &ce) || ce.Details == nil {
+		t.Error("expected the details to be kept")
+	}
+}
@@ -1,13 +1,25 @@
 package platform
 
+import (
+	"errors"
+	"sync"
+)
+
 // ClipboardService provides access to the system clipboard.
 var Clipboard = &ClipboardService{
 	channel: NewMethodChannel("drift/clipboard"),
 }
 
 // ClipboardService manages clipboard operations.
+//
+// When no native clipboard is available (desktop and tests), the service
+// falls back to an in-process clipboard so copy and paste keep working
+// within the app.
 type ClipboardService struct {
 	channel *MethodChannel
+
+	mu       sync.Mutex
+	fallback string
 }
 
 // ClipboardData represents data on the clipboard.
@@ -19,6 +31,11 @@ type ClipboardData struct {
 // Returns empty string if clipboard is empty or contains non-text data.
 func (c *ClipboardService) GetText() (string, error) {
 	result, err := c.channel.Invoke("getText", nil)
+	if errors.Is(err, ErrPlatformUnavailable) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.fallback, nil
+	}
 	if err != nil {
 		return "", err
 	}
@@ -47,12 +64,21 @@ func (c *ClipboardService) SetText(text string) error {
 	_, err := c.channel.Invoke("setText", map[string]any{
 		"text": text,
 	})
+	if errors.Is(err, ErrPlatformUnavailable) {
+		c.setFallback(text)
+		return nil
+	}
 	return err
 }
 
 // HasText returns true if the clipboard contains text.
 func (c *ClipboardService) HasText() (bool, error) {
 	result, err := c.channel.Invoke("hasText", nil)
+	if errors.Is(err, ErrPlatformUnavailable) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.fallback != "", nil
+	}
 	if err != nil {
 		return false, err
 	}
@@ -67,5 +93,15 @@ func (c *ClipboardService) HasText() (bool, error) {
 // Clear removes all data from the clipboard.
 func (c *ClipboardService) Clear() error {
 	_, err := c.channel.Invoke("clear", nil)
+	if errors.Is(err, ErrPlatformUnavailable) {
+		c.setFallback("")
+		return nil
+	}
 	return err
 }
+
+func (c *ClipboardService) setFallback(text string) {
+	c.mu.Lock()
+	c.fallback = text
+	c.mu.Unlock()
+}
@@ -0,0 +1,58 @@
+package platform
+
+import "testing"
+
+func TestClipboard_FallbackWithoutBridge(t *testing.T) {
+	ResetForTest()
+	t.Cleanup(ResetForTest)
+
+	if err := Clipboard.SetText("copied"); err != nil {
+		t.Fatalf("SetText: %v", err)
+	}
+	text, err := Clipboard.GetText()
+	if err != nil || text != "copied" {
+		t.Fatalf("GetText = %q, %v; want %q", text, err, "copied")
+	}
+	if has, err := Clipboard.HasText(); err != nil || !has {
+		t.Fatalf("HasText = %v, %v; want true", has, err)
+	}
+	if err := Clipboard.Clear(); err != nil {
+		t.Fatalf("Clear: %v", err)
+	}
+	if has, _ := Clipboard.HasText(); has {
+		t.Error("expected clipboard to be empty after Clear")
+	}
+}
+
+func TestHapticsAndShare_NoOpWithoutBridge(t *testing.T) {
+	ResetForTest()
+	t.Cleanup(ResetForTest)
+
+	if err := Haptics.LightImpact(); err != nil {
+		t.Errorf("LightImpact: %v", err)
+	}
+	if err := Haptics.Notification(HapticSuccess); err != nil {
+		t.Errorf("Notification: %v", err)
+	}
+	if err := Haptics.Vibrate(10); err != nil {
+		t.Errorf("Vibrate: %v", err)
+	}
+	result, err := Share.ShareText("hello")
+	if err != nil {
+		t.Errorf("ShareText: %v", err)
+	}
+	if result != ShareResultUnavailable {
+		t.Errorf("ShareText result = %q, want %q", result, ShareResultUnavailable)
+	}
+}
+
+func TestClipboard_UsesNativeBridge(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	if err := Clipboard.SetText("native"); err != nil {
+		t.Fatalf("SetText: %v", err)
+	}
+	if len(bridge.calls) != 1 || bridge.calls[0].channel != "drift/clipboard" || bridge.calls[0].method != "setText" {
+		t.Fatalf("unexpected bridge calls %+v", bridge.calls)
+	}
+}
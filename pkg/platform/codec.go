@@ -3,6 +3,7 @@ package platform
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 )
 
 // MessageCodec encodes and decodes messages for platform channel communication.
@@ -14,17 +15,24 @@ type MessageCodec interface {
 	Decode(data []byte) (any, error)
 }
 
-// JsonCodec implements MessageCodec using JSON encoding.
+// JSONMessageCodec implements MessageCodec using JSON encoding.
 // JSON prioritizes interoperability and minimal native dependencies.
-type JsonCodec struct{}
+// Numbers decode as float64 and byte slices encode as base64 strings; use
+// [StandardMessageCodec] or [BinaryCodec] for binary payloads.
+type JSONMessageCodec struct{}
+
+// JsonCodec is the former name of [JSONMessageCodec].
+//
+// Deprecated: Use JSONMessageCodec.
+type JsonCodec = JSONMessageCodec
 
 // Encode serializes the value to JSON bytes.
-func (c JsonCodec) Encode(value any) ([]byte, error) {
+func (c JSONMessageCodec) Encode(value any) ([]byte, error) {
 	return json.Marshal(value)
 }
 
 // Decode deserializes JSON bytes to a Go value.
-func (c JsonCodec) Decode(data []byte) (any, error) {
+func (c JSONMessageCodec) Decode(data []byte) (any, error) {
 	if len(data) == 0 {
 		return nil, nil
 	}
@@ -36,12 +44,39 @@ func (c JsonCodec) Decode(data []byte) (any, error) {
 }
 
 // DecodeInto deserializes JSON bytes into a specific type.
-func (c JsonCodec) DecodeInto(data []byte, v any) error {
+func (c JSONMessageCodec) DecodeInto(data []byte, v any) error {
 	return json.Unmarshal(data, v)
 }
 
-// DefaultCodec is the codec used by platform channels.
-var DefaultCodec MessageCodec = JsonCodec{}
+// BinaryCodec passes raw bytes through unchanged, for channels that carry
+// images, protobuf messages, or other payloads with their own encoding.
+// Encode accepts []byte or nil; Decode returns []byte, or nil for an empty
+// message.
+type BinaryCodec struct{}
+
+// Encode returns value, which must be []byte or nil.
+func (c BinaryCodec) Encode(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("%w: BinaryCodec cannot encode %T", ErrInvalidArguments, value)
+	}
+}
+
+// Decode returns data unchanged.
+func (c BinaryCodec) Decode(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return data, nil
+}
+
+// DefaultCodec is the codec used by platform channels created without an
+// explicit codec.
+var DefaultCodec MessageCodec = JSONMessageCodec{}
 
 // Standard errors for platform channel operations.
 var (
@@ -83,6 +118,27 @@ func (e *ChannelError) Error() string {
 	return e.Code
 }
 
+// channelErrorCodes maps the error codes used by the native bridges to the
+// matching sentinel errors.
+var channelErrorCodes = map[string]error{
+	"channel_not_found":    ErrChannelNotFound,
+	"method_not_found":     ErrMethodNotFound,
+	"invalid_arguments":    ErrInvalidArguments,
+	"platform_unavailable": ErrPlatformUnavailable,
+	"timeout":              ErrTimeout,
+	"canceled":             ErrCanceled,
+	"view_type_not_found":  ErrViewTypeNotFound,
+}
+
+// Is reports whether the error's code corresponds to target, so native
+// errors can be checked with [errors.Is]:
+//
+//	if errors.Is(err, platform.ErrInvalidArguments) { ... }
+func (e *ChannelError) Is(target error) bool {
+	sentinel, ok := channelErrorCodes[e.Code]
+	return ok && sentinel == target
+}
+
 // NewChannelError creates a new ChannelError with the given code and message.
 func NewChannelError(code, message string) *ChannelError {
 	return &ChannelError{Code: code, Message: message}
@@ -0,0 +1,44 @@
+package platform
+
+import "github.com/go-drift/drift/pkg/graphics"
+
+var dynamicColorChannel = NewMethodChannel("drift/dynamic_color")
+
+// DynamicColors holds the user's system color preferences. Zero colors are
+// not offered by the platform.
+type DynamicColors struct {
+	// Primary is the main accent: the Material You wallpaper accent on
+	// Android 12 and later, or the app's tint color on iOS.
+	Primary graphics.Color
+	// Secondary and Tertiary are the supporting Material You accents.
+	Secondary graphics.Color
+	Tertiary  graphics.Color
+	// Neutral and NeutralVariant are the Material You surface hues.
+	Neutral        graphics.Color
+	NeutralVariant graphics.Color
+	// HighContrast reports that the user asked for increased contrast
+	// ("Increase Contrast" on iOS, "High contrast text" on Android).
+	HighContrast bool
+}
+
+// GetDynamicColors returns the platform's dynamic colors. Platforms without
+// them return a zero DynamicColors and no error.
+func GetDynamicColors() (DynamicColors, error) {
+	result, err := dynamicColorChannel.Invoke("get", nil)
+	if err != nil {
+		return DynamicColors{}, ignoreUnavailable(err)
+	}
+	m := parseMap(result)
+	color := func(key string) graphics.Color {
+		v, _ := toInt64(m[key])
+		return graphics.Color(uint32(v))
+	}
+	return DynamicColors{
+		Primary:        color("primary"),
+		Secondary:      color("secondary"),
+		Tertiary:       color("tertiary"),
+		Neutral:        color("neutral"),
+		NeutralVariant: color("neutralVariant"),
+		HighContrast:   parseBool(m["highContrast"]),
+	}, nil
+}
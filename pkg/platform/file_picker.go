@@ -0,0 +1,148 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+)
+
+// FilePickerSource selects the system picker that FilePicker presents.
+type FilePickerSource string
+
+const (
+	// FilePickerDocuments presents the document browser (Files on iOS, the
+	// storage access framework on Android).
+	FilePickerDocuments FilePickerSource = "documents"
+	// FilePickerImages presents the photo library picker.
+	FilePickerImages FilePickerSource = "images"
+)
+
+// FilePickerOptions configures a FilePicker.Pick call.
+type FilePickerOptions struct {
+	// Source selects the picker UI. Defaults to FilePickerDocuments.
+	Source FilePickerSource
+
+	// AllowMultiple enables selecting more than one file.
+	AllowMultiple bool
+
+	// MimeTypes restricts selectable files (e.g., "application/pdf",
+	// "image/*"). Empty allows any type for documents and any image for
+	// FilePickerImages.
+	MimeTypes []string
+
+	// ReadBytes loads each picked file into PickedFile.Data. Leave false for
+	// large files and read from PickedFile.Path instead.
+	ReadBytes bool
+
+	// OnProgress, if set, is called on the UI thread while picked files are
+	// copied into the app's sandbox.
+	OnProgress func(FilePickerProgress)
+
+	// DialogTitle is shown by pickers that support a title.
+	DialogTitle string
+}
+
+// FilePickerProgress reports how far the copy of a picked file has progressed.
+type FilePickerProgress struct {
+	// Name is the display name of the file being copied.
+	Name string
+
+	// Index is the zero-based position of the file in the selection.
+	Index int
+
+	// Count is the number of files selected.
+	Count int
+
+	// BytesCopied is the number of bytes of this file copied so far.
+	BytesCopied int64
+
+	// TotalBytes is the size of this file, or 0 if unknown.
+	TotalBytes int64
+}
+
+// Fraction returns the overall progress of the selection in [0, 1].
+func (p FilePickerProgress) Fraction() float64 {
+	if p.Count <= 0 {
+		return 0
+	}
+	file := 0.0
+	if p.TotalBytes > 0 {
+		file = min(float64(p.BytesCopied)/float64(p.TotalBytes), 1)
+	}
+	return (float64(p.Index) + file) / float64(p.Count)
+}
+
+// FilePickerService picks documents and images through the system pickers.
+// Picked files are copied into the app's cache directory, so PickedFile.Path
+// is always a plain file path the app may read without extra permissions;
+// PickedFile.URI keeps the original location.
+//
+// Picks share the Storage picker lock: only one picker operation may be in
+// progress at a time.
+type FilePickerService struct{}
+
+// FilePicker is the singleton file picker service.
+var FilePicker = &FilePickerService{}
+
+// Pick presents the picker described by opts and blocks until the user
+// selects files or cancels. It returns nil files with a nil error when the
+// user cancels, and ErrStorageBusy if another picker operation is already in
+// progress.
+func (f *FilePickerService) Pick(ctx context.Context, opts FilePickerOptions) ([]PickedFile, error) {
+	source := opts.Source
+	if source == "" {
+		source = FilePickerDocuments
+	}
+	mimeTypes := opts.MimeTypes
+	if len(mimeTypes) == 0 && source == FilePickerImages {
+		mimeTypes = []string{"image/*"}
+	}
+
+	result, err := Storage.invokePicker(ctx, "pickFile", map[string]any{
+		"source":         string(source),
+		"allowMultiple":  opts.AllowMultiple,
+		"allowedTypes":   mimeTypes,
+		"dialogTitle":    opts.DialogTitle,
+		"copyToCache":    true,
+		"reportProgress": opts.OnProgress != nil,
+	}, opts.OnProgress)
+	if err != nil {
+		return nil, err
+	}
+	if result.Cancelled {
+		return nil, nil
+	}
+
+	if opts.ReadBytes {
+		for i := range result.Files {
+			file := &result.Files[i]
+			data, err := Storage.ReadFile(file.Path)
+			if err != nil {
+				return result.Files, fmt.Errorf("read picked file %q: %w", file.Name, err)
+			}
+			file.Data = data
+		}
+	}
+	return result.Files, nil
+}
+
+// PickImage presents the photo library and returns the selected image, or
+// nil if the user cancels.
+func (f *FilePickerService) PickImage(ctx context.Context) (*PickedFile, error) {
+	files, err := f.Pick(ctx, FilePickerOptions{Source: FilePickerImages})
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	return &files[0], nil
+}
+
+func parseFilePickerProgress(m map[string]any) FilePickerProgress {
+	index, _ := toInt(m["index"])
+	count, _ := toInt(m["count"])
+	return FilePickerProgress{
+		Name:        parseString(m["name"]),
+		Index:       index,
+		Count:       count,
+		BytesCopied: parseInt64(m["bytesCopied"]),
+		TotalBytes:  parseInt64(m["totalBytes"]),
+	}
+}
@@ -0,0 +1,117 @@
+package platform
+
+import (
+	"context"
+	"testing"
+)
+
+// pickerBridge answers storage picker calls with canned progress and result
+// events, like the native handlers do.
+type pickerBridge struct {
+	testBridge
+	result map[string]any
+}
+
+func (b *pickerBridge) InvokeMethod(channel, method string, argsData []byte) ([]byte, error) {
+	b.testBridge.InvokeMethod(channel, method, argsData)
+	b.mu.Lock()
+	args, _ := b.calls[len(b.calls)-1].args.(map[string]any)
+	b.mu.Unlock()
+
+	switch method {
+	case "pickFile":
+		requestID := args["requestId"]
+		go func() {
+			progress, _ := DefaultCodec.Encode(map[string]any{
+				"requestId": requestID, "name": "a.pdf", "index": 0, "count": 2,
+				"bytesCopied": 50, "totalBytes": 100,
+			})
+			HandleEvent("drift/storage/progress", progress)
+			result := map[string]any{"requestId": requestID, "type": "pickFile"}
+			for k, v := range b.result {
+				result[k] = v
+			}
+			data, _ := DefaultCodec.Encode(result)
+			HandleEvent("drift/storage/result", data)
+		}()
+		return DefaultCodec.Encode(map[string]any{"pending": true})
+	case "readFile":
+		return DefaultCodec.Encode(map[string]any{"data": "contents of " + args["path"].(string)})
+	}
+	return DefaultCodec.Encode(nil)
+}
+
+func setupPickerBridge(t *testing.T, result map[string]any) *pickerBridge {
+	t.Helper()
+	bridge := &pickerBridge{result: result}
+	SetupTestBridge(t.Cleanup)
+	SetNativeBridge(bridge)
+	return bridge
+}
+
+func TestFilePickerPick_ImagesWithBytesAndProgress(t *testing.T) {
+	bridge := setupPickerBridge(t, map[string]any{
+		"files": []any{
+			map[string]any{"name": "a.png", "path": "/cache/a.png", "uri": "content://a", "mimeType": "image/png", "size": 3},
+		},
+	})
+
+	var progress []FilePickerProgress
+	files, err := FilePicker.Pick(context.Background(), FilePickerOptions{
+		Source:     FilePickerImages,
+		ReadBytes:  true,
+		OnProgress: func(p FilePickerProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("Pick: %v", err)
+	}
+
+	if len(files) != 1 || files[0].Path != "/cache/a.png" || files[0].URI != "content://a" {
+		t.Fatalf("unexpected files %+v", files)
+	}
+	if got := string(files[0].Data); got != "contents of /cache/a.png" {
+		t.Errorf("Data = %q", got)
+	}
+	if len(progress) != 1 || progress[0].Name != "a.pdf" || progress[0].Fraction() != 0.25 {
+		t.Errorf("unexpected progress %+v", progress)
+	}
+
+	bridge.mu.Lock()
+	defer bridge.mu.Unlock()
+	args := bridge.calls[0].args.(map[string]any)
+	if args["source"] != "images" || args["copyToCache"] != true || args["reportProgress"] != true {
+		t.Errorf("unexpected pickFile args %v", args)
+	}
+	if types, _ := args["allowedTypes"].([]any); len(types) != 1 || types[0] != "image/*" {
+		t.Errorf("expected default image MIME filter, got %v", args["allowedTypes"])
+	}
+}
+
+func TestFilePickerPick_Cancelled(t *testing.T) {
+	setupPickerBridge(t, map[string]any{"cancelled": true})
+
+	files, err := FilePicker.Pick(context.Background(), FilePickerOptions{
+		MimeTypes: []string{"application/pdf"},
+		ReadBytes: true,
+	})
+	if err != nil || files != nil {
+		t.Errorf("expected nil files and error on cancel, got %v, %v", files, err)
+	}
+}
+
+func TestFilePickerProgress_Fraction(t *testing.T) {
+	tests := []struct {
+		p    FilePickerProgress
+		want float64
+	}{
+		{FilePickerProgress{}, 0},
+		{FilePickerProgress{Index: 1, Count: 2}, 0.5},
+		{FilePickerProgress{Index: 1, Count: 2, BytesCopied: 10, TotalBytes: 10}, 1},
+		{FilePickerProgress{Index: 0, Count: 1, BytesCopied: 20, TotalBytes: 10}, 1},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Fraction(); got != tt.want {
+			t.Errorf("%+v.Fraction() = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+}
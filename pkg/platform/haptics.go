@@ -1,11 +1,16 @@
 package platform
 
+import "errors"
+
 // HapticsService provides haptic feedback functionality.
 var Haptics = &HapticsService{
 	channel: NewMethodChannel("drift/haptics"),
 }
 
 // HapticsService manages haptic feedback.
+//
+// Feedback is best effort: on platforms without a haptics engine, such as
+// desktop, every method is a no-op that returns nil.
 type HapticsService struct {
 	channel *MethodChannel
 }
@@ -41,7 +46,7 @@ func (h *HapticsService) Impact(style HapticFeedbackType) error {
 	_, err := h.channel.Invoke("impact", map[string]any{
 		"style": string(style),
 	})
-	return err
+	return ignoreUnavailable(err)
 }
 
 // LightImpact triggers a light impact feedback.
@@ -65,10 +70,24 @@ func (h *HapticsService) SelectionClick() error {
 	return h.Impact(HapticSelection)
 }
 
+// Notification triggers feedback for the outcome of a task. Pass
+// [HapticSuccess], [HapticWarning], or [HapticError].
+func (h *HapticsService) Notification(style HapticFeedbackType) error {
+	return h.Impact(style)
+}
+
 // Vibrate triggers a vibration for the specified duration in milliseconds.
 func (h *HapticsService) Vibrate(durationMs int) error {
 	_, err := h.channel.Invoke("vibrate", map[string]any{
 		"duration": durationMs,
 	})
+	return ignoreUnavailable(err)
+}
+
+// ignoreUnavailable turns a missing platform into a silent no-op.
+func ignoreUnavailable(err error) error {
+	if errors.Is(err, ErrPlatformUnavailable) {
+		return nil
+	}
 	return err
 }
@@ -1,6 +1,7 @@
 package platform
 
 import (
+	"slices"
 	"sync"
 
 	"github.com/go-drift/drift/pkg/errors"
@@ -11,18 +12,27 @@ var Lifecycle = &LifecycleService{
 	channel:  NewMethodChannel("drift/lifecycle"),
 	events:   NewEventChannel("drift/lifecycle/events"),
 	state:    LifecycleStateResumed,
-	handlers: make([]LifecycleHandler, 0),
+	handlers: make([]lifecycleEntry, 0),
 }
 
 // LifecycleService manages app lifecycle events.
+//
+// LifecycleService implements core.Listenable through AddListener, so it can
+// drive a core.ListenableBuilder directly.
 type LifecycleService struct {
 	channel  *MethodChannel
 	events   *EventChannel
 	state    LifecycleState
-	handlers []LifecycleHandler
+	handlers []lifecycleEntry
+	nextID   int
 	mu       sync.RWMutex
 }
 
+type lifecycleEntry struct {
+	id      int
+	handler LifecycleHandler
+}
+
 // LifecycleState represents the current app lifecycle state.
 type LifecycleState string
 
@@ -147,21 +157,34 @@ func (l *LifecycleService) State() LifecycleState {
 
 // AddHandler registers a handler to be called on lifecycle changes.
 // Returns a function that can be called to remove the handler.
+// Handlers run on the goroutine that delivered the change, which is not
+// the UI thread; use [UseLifecycleObserver] or [Dispatch] to touch widgets.
 func (l *LifecycleService) AddHandler(handler LifecycleHandler) func() {
 	l.mu.Lock()
-	l.handlers = append(l.handlers, handler)
-	index := len(l.handlers) - 1
+	id := l.nextID
+	l.nextID++
+	l.handlers = append(l.handlers, lifecycleEntry{id: id, handler: handler})
 	l.mu.Unlock()
 
 	return func() {
 		l.mu.Lock()
-		if index < len(l.handlers) {
-			l.handlers = append(l.handlers[:index], l.handlers[index+1:]...)
-		}
+		l.handlers = slices.DeleteFunc(l.handlers, func(e lifecycleEntry) bool { return e.id == id })
 		l.mu.Unlock()
 	}
 }
 
+// AddListener registers a listener called after every lifecycle change,
+// implementing core.Listenable. Like [UseLifecycleObserver], the listener is
+// dispatched to the UI thread. Read the new state with [LifecycleService.State].
+// Returns a function that removes the listener.
+func (l *LifecycleService) AddListener(listener func()) func() {
+	return l.AddHandler(func(LifecycleState) {
+		if !Dispatch(listener) {
+			listener()
+		}
+	})
+}
+
 // IsResumed returns true if the app is in the resumed state.
 func (l *LifecycleService) IsResumed() bool {
 	return l.State() == LifecycleStateResumed
@@ -179,12 +202,18 @@ func (l *LifecycleService) updateState(newState LifecycleState) {
 		l.mu.Unlock()
 		return
 	}
+	oldState := l.state
 	l.state = newState
-	handlers := make([]LifecycleHandler, len(l.handlers))
-	copy(handlers, l.handlers)
+	handlers := slices.Clone(l.handlers)
 	l.mu.Unlock()
 
+	errors.AddBreadcrumb(errors.Breadcrumb{
+		Category: errors.BreadcrumbLifecycle,
+		Message:  string(newState),
+		Data:     map[string]string{"from": string(oldState)},
+	})
+
 	for _, h := range handlers {
-		h(newState)
+		h.handler(newState)
 	}
 }
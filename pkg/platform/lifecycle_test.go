@@ -83,3 +83,22 @@ func (d *testDisposable) dispose() {
 	}
 	d.cleanups = nil
 }
+
+func TestLifecycle_AddListenerRemovesOnlyItself(t *testing.T) {
+	SetupTestBridge(t.Cleanup)
+
+	var first, second int
+	removeFirst := Lifecycle.AddListener(func() { first++ })
+	removeSecond := Lifecycle.AddListener(func() { second++ })
+	defer removeSecond()
+
+	removeFirst()
+	// Removing twice must not remove another listener.
+	removeFirst()
+	Lifecycle.SetStateForTest(LifecycleStatePaused)
+	Lifecycle.SetStateForTest(LifecycleStateResumed)
+
+	if first != 0 || second != 2 {
+		t.Errorf("expected only the remaining listener to fire, got first=%d second=%d", first, second)
+	}
+}
@@ -0,0 +1,22 @@
+package platform
+
+var localeChannel = NewMethodChannel("drift/locale")
+
+// GetPreferredLocales returns the user's preferred languages as BCP 47 tags
+// (such as "en-US" or "zh-Hant-TW"), most preferred first. Platforms that
+// don't report them return nil and no error.
+func GetPreferredLocales() ([]string, error) {
+	result, err := localeChannel.Invoke("getPreferredLocales", nil)
+	if err != nil {
+		return nil, ignoreUnavailable(err)
+	}
+	var locales []string
+	if tags, ok := parseMap(result)["locales"].([]any); ok {
+		for _, tag := range tags {
+			if s := parseString(tag); s != "" {
+				locales = append(locales, s)
+			}
+		}
+	}
+	return locales, nil
+}
@@ -0,0 +1,87 @@
+package platform
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// memoryKeyValueStore is an in-process stand-in for the native preferences
+// and secure storage handlers. It answers the same methods with the same
+// result shapes, so the services parse its results like native ones.
+type memoryKeyValueStore struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+// UseInMemoryStorage switches Preferences and SecureStorage between their
+// native backends and empty in-process stores. Values in the in-process
+// stores are not persisted or encrypted, and secure storage reports no
+// biometrics. It is intended for tests; pkg/testing enables it for each
+// WidgetTester.
+func UseInMemoryStorage(enabled bool) {
+	Preferences.memory.reset()
+	Preferences.inMemory.Store(enabled)
+	SecureStorage.memory.reset()
+	SecureStorage.inMemory.Store(enabled)
+}
+
+func (m *memoryKeyValueStore) reset() {
+	m.mu.Lock()
+	m.values = nil
+	m.mu.Unlock()
+}
+
+func (m *memoryKeyValueStore) invoke(method string, args map[string]any) (any, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Secure storage namespaces keys by service.
+	prefix := parseString(args["service"]) + "\x00"
+	key := prefix + parseString(args["key"])
+
+	switch method {
+	case "get":
+		if value, ok := m.values[key]; ok {
+			return map[string]any{"value": value}, nil
+		}
+		return map[string]any{"value": nil}, nil
+	case "set":
+		if m.values == nil {
+			m.values = make(map[string]string)
+		}
+		m.values[key] = parseString(args["value"])
+		return nil, nil
+	case "delete":
+		delete(m.values, key)
+		return nil, nil
+	case "contains":
+		_, ok := m.values[key]
+		return map[string]any{"exists": ok}, nil
+	case "getAllKeys":
+		var keys []string
+		for k := range m.values {
+			if name, ok := strings.CutPrefix(k, prefix); ok {
+				keys = append(keys, name)
+			}
+		}
+		slices.Sort(keys)
+		result := make([]any, len(keys))
+		for i, k := range keys {
+			result[i] = k
+		}
+		return map[string]any{"keys": result}, nil
+	case "deleteAll":
+		for k := range m.values {
+			if strings.HasPrefix(k, prefix) {
+				delete(m.values, k)
+			}
+		}
+		return nil, nil
+	case "isBiometricAvailable":
+		return map[string]any{"available": false}, nil
+	case "getBiometricType":
+		return map[string]any{"type": string(BiometricTypeNone)}, nil
+	}
+	return nil, ErrMethodNotFound
+}
@@ -191,6 +191,29 @@ func (n *NotificationsService) Schedule(ctx context.Context, req NotificationReq
 	return err
 }
 
+// Show displays a local notification immediately. The At, IntervalSeconds,
+// and Repeats fields of req are ignored.
+// The ctx parameter is currently unused and reserved for future cancellation support.
+func (n *NotificationsService) Show(ctx context.Context, req NotificationRequest) error {
+	req.At = time.Time{}
+	req.IntervalSeconds = 0
+	req.Repeats = false
+	return n.Schedule(ctx, req)
+}
+
+// OnTap registers a handler called when the user taps a notification. Unlike
+// listeners on [NotificationsService.Opens], the handler is dispatched to the
+// UI thread via [Dispatch] (or called synchronously when no dispatch is
+// registered, e.g. in tests), so it may update widget state directly.
+// Returns an unsubscribe function.
+func (n *NotificationsService) OnTap(handler func(NotificationOpen)) (unsubscribe func()) {
+	return n.opens.Listen(func(open NotificationOpen) {
+		if !Dispatch(func() { handler(open) }) {
+			handler(open)
+		}
+	})
+}
+
 // Cancel cancels a scheduled notification by ID.
 // The ctx parameter is currently unused and reserved for future cancellation support.
 func (n *NotificationsService) Cancel(ctx context.Context, id string) error {
@@ -0,0 +1,119 @@
+package platform
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNotificationsShow_ClearsSchedule(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	err := Notifications.Show(context.Background(), NotificationRequest{
+		ID:              "n1",
+		Title:           "Hello",
+		At:              time.Now().Add(time.Hour),
+		IntervalSeconds: 60,
+		Repeats:         true,
+	})
+	if err != nil {
+		t.Fatalf("Show: %v", err)
+	}
+
+	bridge.mu.Lock()
+	defer bridge.mu.Unlock()
+	if len(bridge.calls) != 1 {
+		t.Fatalf("expected 1 call, got %d", len(bridge.calls))
+	}
+	call := bridge.calls[0]
+	if call.channel != "drift/notifications" || call.method != "schedule" {
+		t.Fatalf("unexpected call %s.%s", call.channel, call.method)
+	}
+	args := call.args.(map[string]any)
+	if _, ok := args["at"]; ok {
+		t.Error("expected no at argument")
+	}
+	if args["intervalSeconds"] != float64(0) || args["repeats"] != false {
+		t.Errorf("expected no repeat, got interval=%v repeats=%v", args["intervalSeconds"], args["repeats"])
+	}
+	if args["title"] != "Hello" {
+		t.Errorf("expected title Hello, got %v", args["title"])
+	}
+}
+
+func TestNotificationsOnTap_Dispatched(t *testing.T) {
+	SetupTestBridge(t.Cleanup)
+
+	dispatched := 0
+	RegisterDispatch(func(cb func()) {
+		dispatched++
+		cb()
+	})
+
+	var got NotificationOpen
+	unsub := Notifications.OnTap(func(open NotificationOpen) {
+		got = open
+	})
+
+	data, _ := DefaultCodec.Encode(map[string]any{"id": "n1", "action": "tap", "source": "local"})
+	if err := HandleEvent("drift/notifications/opened", data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+	if dispatched != 1 {
+		t.Errorf("expected handler dispatched once, got %d", dispatched)
+	}
+	if got.ID != "n1" || got.Action != "tap" {
+		t.Errorf("unexpected open %+v", got)
+	}
+
+	unsub()
+	got = NotificationOpen{}
+	if err := HandleEvent("drift/notifications/opened", data); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+	if got.ID != "" {
+		t.Error("expected no call after unsubscribe")
+	}
+}
+
+func TestPermissionsGet(t *testing.T) {
+	cases := map[PermissionKind]Permission{
+		PermissionKindCamera:         Camera.Permission,
+		PermissionKindLocation:       Location.Permission.WhenInUse,
+		PermissionKindLocationAlways: Location.Permission.Always,
+		PermissionKindNotifications:  Notifications.Permission,
+	}
+	for kind, want := range cases {
+		got, ok := Permissions.Get(kind)
+		if !ok || got != want {
+			t.Errorf("Get(%q) = %v, %v", kind, got, ok)
+		}
+	}
+
+	if _, ok := Permissions.Get("bluetooth"); ok {
+		t.Error("expected unsupported kind")
+	}
+	status, err := Permissions.Status(context.Background(), "bluetooth")
+	if err == nil || status != PermissionResultUnknown {
+		t.Errorf("expected error for unsupported kind, got %v, %v", status, err)
+	}
+}
+
+func TestPermissionsStatus_UsesPermissionName(t *testing.T) {
+	bridge := setupTestBridge(t)
+
+	Permissions.Status(context.Background(), PermissionKindCamera)
+
+	bridge.mu.Lock()
+	defer bridge.mu.Unlock()
+	if len(bridge.calls) != 1 {
+		t.Fatalf("expected 1 call, got %d", len(bridge.calls))
+	}
+	call := bridge.calls[0]
+	if call.channel != "drift/permissions" || call.method != "check" {
+		t.Fatalf("unexpected call %s.%s", call.channel, call.method)
+	}
+	if args := call.args.(map[string]any); args["permission"] != "camera" {
+		t.Errorf("expected camera, got %v", args["permission"])
+	}
+}
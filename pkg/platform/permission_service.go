@@ -0,0 +1,114 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+)
+
+// PermissionKind identifies a runtime permission in the [Permissions] service.
+// The values match the permission names the native embedders use.
+type PermissionKind string
+
+// Permission kinds supported on Android and iOS.
+const (
+	PermissionKindCamera         PermissionKind = "camera"
+	PermissionKindMicrophone     PermissionKind = "microphone"
+	PermissionKindPhotos         PermissionKind = "photos"
+	PermissionKindContacts       PermissionKind = "contacts"
+	PermissionKindCalendar       PermissionKind = "calendar"
+	PermissionKindLocation       PermissionKind = "location"
+	PermissionKindLocationAlways PermissionKind = "location_always"
+	PermissionKindNotifications  PermissionKind = "notifications"
+)
+
+// PermissionsService checks and requests runtime permissions by kind. It is a
+// unified view over the Permission fields of the feature services, so
+// platform.Permissions.Request(ctx, PermissionKindCamera) and
+// platform.Camera.Permission.Request(ctx) are equivalent.
+type PermissionsService struct{}
+
+// Permissions is the singleton permissions service.
+var Permissions = &PermissionsService{}
+
+// Get returns the permission for kind, or false if kind is not supported.
+func (p *PermissionsService) Get(kind PermissionKind) (Permission, bool) {
+	var perm Permission
+	switch kind {
+	case PermissionKindCamera:
+		perm = Camera.Permission
+	case PermissionKindMicrophone:
+		perm = Microphone.Permission
+	case PermissionKindPhotos:
+		perm = Photos.Permission
+	case PermissionKindContacts:
+		perm = Contacts.Permission
+	case PermissionKindCalendar:
+		perm = Calendar.Permission
+	case PermissionKindLocation:
+		perm = Location.Permission.WhenInUse
+	case PermissionKindLocationAlways:
+		perm = Location.Permission.Always
+	case PermissionKindNotifications:
+		perm = Notifications.Permission
+	}
+	return perm, perm != nil
+}
+
+// Status returns the current status of the permission for kind.
+func (p *PermissionsService) Status(ctx context.Context, kind PermissionKind) (PermissionStatus, error) {
+	perm, err := p.lookup(kind)
+	if err != nil {
+		return PermissionResultUnknown, err
+	}
+	return perm.Status(ctx)
+}
+
+// Request prompts the user for the permission for kind and blocks until they
+// respond or ctx is done. If the permission is already in a terminal state,
+// it returns immediately without showing a dialog.
+func (p *PermissionsService) Request(ctx context.Context, kind PermissionKind) (PermissionStatus, error) {
+	perm, err := p.lookup(kind)
+	if err != nil {
+		return PermissionResultUnknown, err
+	}
+	return perm.Request(ctx)
+}
+
+// RequestAll requests each permission in order, since platforms show one
+// dialog at a time. It stops at the first error and returns the statuses
+// gathered so far.
+func (p *PermissionsService) RequestAll(ctx context.Context, kinds ...PermissionKind) (map[PermissionKind]PermissionStatus, error) {
+	results := make(map[PermissionKind]PermissionStatus, len(kinds))
+	for _, kind := range kinds {
+		status, err := p.Request(ctx, kind)
+		if err != nil {
+			return results, err
+		}
+		results[kind] = status
+	}
+	return results, nil
+}
+
+// Listen subscribes to status changes of the permission for kind. Returns a
+// no-op unsubscribe function if kind is not supported.
+func (p *PermissionsService) Listen(kind PermissionKind, handler func(PermissionStatus)) (unsubscribe func()) {
+	perm, ok := p.Get(kind)
+	if !ok {
+		return func() {}
+	}
+	return perm.Listen(handler)
+}
+
+// OpenSettings opens the system settings page for this app. See
+// [OpenAppSettings].
+func (p *PermissionsService) OpenSettings(ctx context.Context) error {
+	return OpenAppSettings(ctx)
+}
+
+func (p *PermissionsService) lookup(kind PermissionKind) (Permission, error) {
+	perm, ok := p.Get(kind)
+	if !ok {
+		return nil, fmt.Errorf("platform: unsupported permission %q", kind)
+	}
+	return perm, nil
+}
@@ -1,6 +1,7 @@
 package platform
 
 import (
+	"image"
 	"maps"
 	"sync"
 	"sync/atomic"
@@ -64,6 +65,11 @@ type PlatformViewRegistry struct {
 	// Views NOT seen get empty clip bounds in FlushGeometryBatch, signaling hidden.
 	viewsSeenThisFrame map[int64]struct{}
 	capturedViews      []CapturedViewGeometry
+
+	// Texture composition state, see SetCompositionMode.
+	textureMu sync.RWMutex
+	modes     map[int64]PlatformViewCompositionMode
+	textures  map[int64]*image.RGBA
 }
 
 var platformViewRegistry *PlatformViewRegistry
@@ -83,7 +89,10 @@ func newPlatformViewRegistry() *PlatformViewRegistry {
 		channel:            NewMethodChannel("drift/platform_views"),
 		geometryCache:      make(map[int64]CapturedViewGeometry),
 		viewsSeenThisFrame: make(map[int64]struct{}),
+		modes:              make(map[int64]PlatformViewCompositionMode),
+		textures:           make(map[int64]*image.RGBA),
 	}
+	graphics.SetPlatformViewTextureSource(r.texture)
 
 	// Handle incoming calls from native
 	r.channel.SetHandler(r.handleMethodCall)
@@ -200,6 +209,7 @@ func (r *PlatformViewRegistry) Dispose(viewID int64) {
 
 	// Clear geometry cache to avoid stale skips if view is recreated
 	r.ClearGeometryCache(viewID)
+	r.clearTexture(viewID)
 
 	if ok {
 		view.Dispose()
@@ -2,6 +2,7 @@ package platform
 
 import (
 	"encoding/json"
+	"image"
 	"sync"
 	"testing"
 
@@ -66,6 +67,8 @@ func newTestRegistry(viewIDs ...int64) *PlatformViewRegistry {
 		channel:            NewMethodChannel("test/platform_views"),
 		geometryCache:      make(map[int64]CapturedViewGeometry),
 		viewsSeenThisFrame: make(map[int64]struct{}),
+		modes:              make(map[int64]PlatformViewCompositionMode),
+		textures:           make(map[int64]*image.RGBA),
 	}
 	for _, id := range viewIDs {
 		r.views[id] = &stubView{id: id}
@@ -0,0 +1,104 @@
+package platform
+
+import (
+	"fmt"
+	"image"
+)
+
+// PlatformViewCompositionMode controls how a platform view is combined with
+// the Drift UI.
+type PlatformViewCompositionMode int
+
+const (
+	// PlatformViewCompositionOverlay positions the native view above the
+	// Drift surface and approximates widgets drawn over it with clip and
+	// occlusion masks. It is the default and has no per-frame copy cost, but
+	// widgets painted above the view can show z-order or clipping artifacts.
+	PlatformViewCompositionOverlay PlatformViewCompositionMode = iota
+
+	// PlatformViewCompositionTexture renders the native view into an
+	// offscreen buffer that Skia draws in paint order, so widgets painted
+	// after the view, rounded clips, opacity, and transforms apply exactly.
+	// The native view stays in place, invisible, to receive touches and
+	// keyboard input.
+	//
+	// Only Android supports texture composition. Each frame the view changes
+	// is copied through the CPU, so prefer overlay mode for video and other
+	// views that redraw continuously. Views that render into their own
+	// SurfaceView, such as the video player, cannot be captured.
+	PlatformViewCompositionTexture
+)
+
+// String returns the name the native side uses for the mode.
+func (m PlatformViewCompositionMode) String() string {
+	switch m {
+	case PlatformViewCompositionOverlay:
+		return "overlay"
+	case PlatformViewCompositionTexture:
+		return "texture"
+	default:
+		return fmt.Sprintf("PlatformViewCompositionMode(%d)", int(m))
+	}
+}
+
+// SetCompositionMode switches how viewID is composited. Platforms without
+// texture composition return an error and keep the view in overlay mode.
+func (r *PlatformViewRegistry) SetCompositionMode(viewID int64, mode PlatformViewCompositionMode) error {
+	if mode != PlatformViewCompositionOverlay && mode != PlatformViewCompositionTexture {
+		return ErrInvalidArguments
+	}
+	_, err := r.channel.Invoke("setCompositionMode", map[string]any{
+		"viewId": viewID,
+		"mode":   mode.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	r.textureMu.Lock()
+	if mode == PlatformViewCompositionOverlay {
+		delete(r.modes, viewID)
+		delete(r.textures, viewID)
+	} else {
+		r.modes[viewID] = mode
+	}
+	r.textureMu.Unlock()
+	return nil
+}
+
+// CompositionMode returns the composition mode of viewID.
+func (r *PlatformViewRegistry) CompositionMode(viewID int64) PlatformViewCompositionMode {
+	r.textureMu.RLock()
+	mode := r.modes[viewID]
+	r.textureMu.RUnlock()
+	return mode
+}
+
+// SetTextureFrame stores the latest captured frame of a texture-composited
+// view. It is called by the platform embedder; frames for views in overlay
+// mode are dropped. The registry takes ownership of frame.
+func (r *PlatformViewRegistry) SetTextureFrame(viewID int64, frame *image.RGBA) {
+	r.textureMu.Lock()
+	if r.modes[viewID] == PlatformViewCompositionTexture {
+		r.textures[viewID] = frame
+	}
+	r.textureMu.Unlock()
+}
+
+// texture is the graphics.SetPlatformViewTextureSource hook.
+func (r *PlatformViewRegistry) texture(viewID int64) image.Image {
+	r.textureMu.RLock()
+	frame := r.textures[viewID]
+	r.textureMu.RUnlock()
+	if frame == nil {
+		return nil
+	}
+	return frame
+}
+
+func (r *PlatformViewRegistry) clearTexture(viewID int64) {
+	r.textureMu.Lock()
+	delete(r.modes, viewID)
+	delete(r.textures, viewID)
+	r.textureMu.Unlock()
+}
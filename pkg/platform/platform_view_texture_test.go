@@ -0,0 +1,73 @@
+package platform
+
+import (
+	"errors"
+	"image"
+	"testing"
+)
+
+func TestSetCompositionMode_TextureStoresFrames(t *testing.T) {
+	bridge := setupTestBridge(t)
+	reg := newTestRegistry(1)
+	frame := image.NewRGBA(image.Rect(0, 0, 4, 4))
+
+	// Frames are ignored until the view is in texture mode.
+	reg.SetTextureFrame(1, frame)
+	if reg.texture(1) != nil {
+		t.Fatal("overlay view should not keep texture frames")
+	}
+
+	if err := reg.SetCompositionMode(1, PlatformViewCompositionTexture); err != nil {
+		t.Fatalf("SetCompositionMode: %v", err)
+	}
+	if len(bridge.calls) != 1 || bridge.calls[0].method != "setCompositionMode" {
+		t.Fatalf("calls = %+v, want one setCompositionMode", bridge.calls)
+	}
+	args := bridge.calls[0].args.(map[string]any)
+	if args["mode"] != "texture" || args["viewId"] != float64(1) {
+		t.Errorf("args = %v, want viewId 1 and mode texture", args)
+	}
+	if got := reg.CompositionMode(1); got != PlatformViewCompositionTexture {
+		t.Errorf("CompositionMode = %v, want texture", got)
+	}
+
+	reg.SetTextureFrame(1, frame)
+	if reg.texture(1) != frame {
+		t.Error("texture should return the latest frame")
+	}
+
+	if err := reg.SetCompositionMode(1, PlatformViewCompositionOverlay); err != nil {
+		t.Fatalf("SetCompositionMode: %v", err)
+	}
+	if reg.texture(1) != nil || reg.CompositionMode(1) != PlatformViewCompositionOverlay {
+		t.Error("switching to overlay should drop the texture")
+	}
+}
+
+func TestSetCompositionMode_DisposeClearsTexture(t *testing.T) {
+	setupTestBridge(t)
+	reg := newTestRegistry(1)
+
+	if err := reg.SetCompositionMode(1, PlatformViewCompositionTexture); err != nil {
+		t.Fatalf("SetCompositionMode: %v", err)
+	}
+	reg.SetTextureFrame(1, image.NewRGBA(image.Rect(0, 0, 1, 1)))
+	reg.Dispose(1)
+
+	if reg.texture(1) != nil || reg.CompositionMode(1) != PlatformViewCompositionOverlay {
+		t.Error("Dispose should clear texture state")
+	}
+}
+
+func TestSetCompositionMode_InvalidMode(t *testing.T) {
+	bridge := setupTestBridge(t)
+	reg := newTestRegistry(1)
+
+	err := reg.SetCompositionMode(1, PlatformViewCompositionMode(7))
+	if !errors.Is(err, ErrInvalidArguments) {
+		t.Errorf("err = %v, want ErrInvalidArguments", err)
+	}
+	if len(bridge.calls) != 0 {
+		t.Errorf("invalid mode should not reach native, got %+v", bridge.calls)
+	}
+}
@@ -0,0 +1,210 @@
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	drifterrors "github.com/go-drift/drift/pkg/errors"
+)
+
+// Plugin is implemented by packages that ship native Android and iOS code
+// together with Go bindings. A plugin registers itself from an init function
+// with [RegisterPlugin], and the engine calls RegisterWithRegistrar once at
+// startup, before the root widget mounts and before App.OnInit runs.
+//
+// The native half of a plugin registers handlers for the same channel names
+// through the embedder's plugin registry, so apps never edit embedder code:
+//
+//	func init() {
+//		platform.RegisterPlugin("example.com/battery", batteryPlugin{})
+//	}
+//
+//	func (batteryPlugin) RegisterWithRegistrar(r *platform.PluginRegistrar) error {
+//		channel = r.MethodChannel("example.com/battery")
+//		return nil
+//	}
+type Plugin interface {
+	// RegisterWithRegistrar creates the plugin's channels and platform view
+	// factories. Returning an error is reported at startup; channels and
+	// factories registered before the error remain registered.
+	RegisterWithRegistrar(registrar *PluginRegistrar) error
+}
+
+// PluginRegistrar creates channels and registers platform view factories on
+// behalf of a single plugin. It records what the plugin registered so that
+// tooling can report it.
+type PluginRegistrar struct {
+	name      string
+	channels  []string
+	viewTypes []string
+}
+
+// Name returns the name the plugin was registered under.
+func (r *PluginRegistrar) Name() string {
+	return r.name
+}
+
+// ChannelName returns the versioned name of one of the plugin's channels,
+// "<plugin name>/v<major>/<channel>":
+//
+//	r.MethodChannel(r.ChannelName(1, "level")) // "example.com/battery/v1/level"
+//
+// Bump major when a channel's methods or payloads change incompatibly. The
+// native half registers the same versioned name, so a Go half paired with
+// a mismatched native half fails with [ErrChannelNotFound] instead of
+// misreading messages.
+func (r *PluginRegistrar) ChannelName(major int, channel string) string {
+	return fmt.Sprintf("%s/v%d/%s", r.name, major, channel)
+}
+
+// MethodChannel creates a method channel that uses [DefaultCodec].
+func (r *PluginRegistrar) MethodChannel(name string) *MethodChannel {
+	return r.MethodChannelWithCodec(name, DefaultCodec)
+}
+
+// MethodChannelWithCodec creates a method channel that uses codec. The
+// native side of the plugin must register the channel with the same codec.
+func (r *PluginRegistrar) MethodChannelWithCodec(name string, codec MessageCodec) *MethodChannel {
+	r.channels = append(r.channels, name)
+	return NewMethodChannelWithCodec(name, codec)
+}
+
+// EventChannel creates an event channel that uses [DefaultCodec].
+func (r *PluginRegistrar) EventChannel(name string) *EventChannel {
+	return r.EventChannelWithCodec(name, DefaultCodec)
+}
+
+// EventChannelWithCodec creates an event channel that uses codec.
+func (r *PluginRegistrar) EventChannelWithCodec(name string, codec MessageCodec) *EventChannel {
+	r.channels = append(r.channels, name)
+	return NewEventChannelWithCodec(name, codec)
+}
+
+// RegisterViewFactory registers a platform view factory. The native side of
+// the plugin must register a view factory for the same view type.
+func (r *PluginRegistrar) RegisterViewFactory(factory PlatformViewFactory) {
+	r.viewTypes = append(r.viewTypes, factory.ViewType())
+	GetPlatformViewRegistry().RegisterFactory(factory)
+}
+
+// Channels returns the names of the channels the plugin created.
+func (r *PluginRegistrar) Channels() []string {
+	return append([]string(nil), r.channels...)
+}
+
+// ViewTypes returns the platform view types the plugin registered.
+func (r *PluginRegistrar) ViewTypes() []string {
+	return append([]string(nil), r.viewTypes...)
+}
+
+type pluginEntry struct {
+	plugin     Plugin
+	registrar  *PluginRegistrar
+	registered bool
+}
+
+var (
+	pluginsMu          sync.Mutex
+	plugins            []*pluginEntry
+	pluginsByName      = map[string]*pluginEntry{}
+	pluginsInitialized bool
+)
+
+// RegisterPlugin makes a plugin available under name, conventionally the
+// plugin's Go import path. It is intended to be called from the plugin
+// package's init function. Plugins registered after the engine has
+// initialized plugins are registered immediately.
+//
+// RegisterPlugin panics if name is empty, plugin is nil, or a plugin with
+// the same name is already registered.
+func RegisterPlugin(name string, plugin Plugin) {
+	if name == "" {
+		panic("platform: RegisterPlugin called with empty name")
+	}
+	if plugin == nil {
+		panic("platform: RegisterPlugin called with nil plugin for " + name)
+	}
+
+	pluginsMu.Lock()
+	if _, exists := pluginsByName[name]; exists {
+		pluginsMu.Unlock()
+		panic("platform: RegisterPlugin called twice for " + name)
+	}
+	entry := &pluginEntry{plugin: plugin, registrar: &PluginRegistrar{name: name}}
+	plugins = append(plugins, entry)
+	pluginsByName[name] = entry
+	initialized := pluginsInitialized
+	pluginsMu.Unlock()
+
+	if initialized {
+		if err := registerPluginEntry(entry); err != nil {
+			reportPluginError(err)
+		}
+	}
+}
+
+// InitializePlugins calls RegisterWithRegistrar on every registered plugin
+// that has not been registered yet, in registration order. The engine calls
+// it at startup; later calls only register plugins added since. Errors from
+// individual plugins are joined and returned; a failing plugin does not
+// prevent the others from registering.
+func InitializePlugins() error {
+	pluginsMu.Lock()
+	pluginsInitialized = true
+	pending := make([]*pluginEntry, 0, len(plugins))
+	for _, entry := range plugins {
+		if !entry.registered {
+			pending = append(pending, entry)
+		}
+	}
+	pluginsMu.Unlock()
+
+	var errs []error
+	for _, entry := range pending {
+		if err := registerPluginEntry(entry); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Plugins returns the registrars of all registered plugins in registration
+// order. Registrars of plugins that have not been initialized yet report no
+// channels or view types.
+func Plugins() []*PluginRegistrar {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+	result := make([]*PluginRegistrar, len(plugins))
+	for i, entry := range plugins {
+		result[i] = entry.registrar
+	}
+	return result
+}
+
+// registerPluginEntry runs the plugin's registration at most once. The
+// plugin runs without pluginsMu held so it may call RegisterPlugin itself.
+func registerPluginEntry(entry *pluginEntry) error {
+	pluginsMu.Lock()
+	if entry.registered {
+		pluginsMu.Unlock()
+		return nil
+	}
+	entry.registered = true
+	pluginsMu.Unlock()
+
+	if err := entry.plugin.RegisterWithRegistrar(entry.registrar); err != nil {
+		return fmt.Errorf("plugin %s: %w", entry.registrar.name, err)
+	}
+	return nil
+}
+
+func reportPluginError(err error) {
+	drifterrors.Report(&drifterrors.DriftError{
+		Op:        "platform.RegisterPlugin",
+		Kind:      drifterrors.KindInit,
+		Err:       err,
+		Timestamp: time.Now(),
+	})
+}
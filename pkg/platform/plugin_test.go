@@ -0,0 +1,142 @@
+package platform
+
+import (
+	"errors"
+	"testing"
+)
+
+type testPlugin struct {
+	calls    int
+	register func(r *PluginRegistrar) error
+}
+
+func (p *testPlugin) RegisterWithRegistrar(r *PluginRegistrar) error {
+	p.calls++
+	if p.register != nil {
+		return p.register(r)
+	}
+	return nil
+}
+
+type testViewFactory struct{}
+
+func (testViewFactory) ViewType() string { return "test/plugin_view" }
+func (testViewFactory) Create(viewID int64, params map[string]any) (PlatformView, error) {
+	return &stubView{id: viewID}, nil
+}
+
+func resetPluginsForTest(t *testing.T) {
+	reset := func() {
+		pluginsMu.Lock()
+		plugins = nil
+		pluginsByName = map[string]*pluginEntry{}
+		pluginsInitialized = false
+		pluginsMu.Unlock()
+	}
+	reset()
+	t.Cleanup(reset)
+}
+
+func TestInitializePlugins_RegistersChannelsAndViews(t *testing.T) {
+	resetPluginsForTest(t)
+
+	plugin := &testPlugin{register: func(r *PluginRegistrar) error {
+		r.MethodChannel("test/plugin")
+		r.EventChannelWithCodec("test/plugin/events", StandardMessageCodec{})
+		r.RegisterViewFactory(testViewFactory{})
+		return nil
+	}}
+	RegisterPlugin("example.com/plugin", plugin)
+
+	if plugin.calls != 0 {
+		t.Fatal("plugin should not register before InitializePlugins")
+	}
+	if err := InitializePlugins(); err != nil {
+		t.Fatalf("InitializePlugins: %v", err)
+	}
+	if err := InitializePlugins(); err != nil {
+		t.Fatalf("second InitializePlugins: %v", err)
+	}
+	if plugin.calls != 1 {
+		t.Fatalf("expected one registration, got %d", plugin.calls)
+	}
+
+	if registry.getMethodChannel("test/plugin") == nil {
+		t.Error("method channel not registered")
+	}
+	if ch := registry.getEventChannel("test/plugin/events"); ch == nil {
+		t.Error("event channel not registered")
+	} else if _, ok := ch.Codec().(StandardMessageCodec); !ok {
+		t.Errorf("expected StandardMessageCodec, got %T", ch.Codec())
+	}
+
+	registered := Plugins()
+	if len(registered) != 1 || registered[0].Name() != "example.com/plugin" {
+		t.Fatalf("unexpected plugins %v", registered)
+	}
+	if got := registered[0].Channels(); len(got) != 2 {
+		t.Errorf("expected 2 channels, got %v", got)
+	}
+	if got := registered[0].ViewTypes(); len(got) != 1 || got[0] != "test/plugin_view" {
+		t.Errorf("unexpected view types %v", got)
+	}
+}
+
+func TestInitializePlugins_JoinsErrors(t *testing.T) {
+	resetPluginsForTest(t)
+
+	errBoom := errors.New("boom")
+	failing := &testPlugin{register: func(*PluginRegistrar) error { return errBoom }}
+	healthy := &testPlugin{}
+	RegisterPlugin("failing", failing)
+	RegisterPlugin("healthy", healthy)
+
+	err := InitializePlugins()
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected joined error to wrap errBoom, got %v", err)
+	}
+	if healthy.calls != 1 {
+		t.Error("a failing plugin should not prevent later plugins from registering")
+	}
+}
+
+func TestRegisterPlugin_AfterInitializeRegistersImmediately(t *testing.T) {
+	resetPluginsForTest(t)
+
+	if err := InitializePlugins(); err != nil {
+		t.Fatalf("InitializePlugins: %v", err)
+	}
+	late := &testPlugin{}
+	RegisterPlugin("late", late)
+	if late.calls != 1 {
+		t.Errorf("expected late plugin to register immediately, got %d calls", late.calls)
+	}
+}
+
+func TestRegisterPlugin_PanicsOnDuplicate(t *testing.T) {
+	resetPluginsForTest(t)
+
+	RegisterPlugin("dup", &testPlugin{})
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic on duplicate registration")
+		}
+	}()
+	RegisterPlugin("dup", &testPlugin{})
+}
+
+func TestPluginRegistrar_ChannelName(t *testing.T) {
+	resetPluginsForTest(t)
+
+	var got string
+	RegisterPlugin("example.com/battery", &testPlugin{register: func(r *PluginRegistrar) error {
+		got = r.ChannelName(2, "level")
+		return nil
+	}})
+	if err := InitializePlugins(); err != nil {
+		t.Fatalf("InitializePlugins: %v", err)
+	}
+	if want := "example.com/battery/v2/level"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
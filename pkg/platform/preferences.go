@@ -1,5 +1,12 @@
 package platform
 
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
 // Preferences provides simple, unencrypted key-value storage using
 // platform-native mechanisms (UserDefaults on iOS, SharedPreferences on Android).
 // For sensitive data, use SecureStorage instead.
@@ -8,15 +15,35 @@ var Preferences = &PreferencesService{
 }
 
 // PreferencesService manages simple key-value preference storage.
+//
+// Values are stored as strings; the typed accessors (GetBool, SetInt, ...)
+// encode and decode them. Listeners registered with OnChange are notified
+// of writes made through this service.
 type PreferencesService struct {
 	channel *MethodChannel
+
+	memory   memoryKeyValueStore
+	inMemory atomic.Bool
+
+	mu        sync.Mutex
+	listeners map[int]func(key string)
+	nextID    int
+}
+
+// invoke calls the native handler, or the in-process store when
+// UseInMemoryStorage is enabled.
+func (p *PreferencesService) invoke(method string, args map[string]any) (any, error) {
+	if p.inMemory.Load() {
+		return p.memory.invoke(method, args)
+	}
+	return p.channel.Invoke(method, args)
 }
 
 // Get retrieves a string value for the given key.
 // Returns empty string and nil error if the key doesn't exist.
 // Use Contains to distinguish a missing key from a key set to "".
 func (p *PreferencesService) Get(key string) (string, error) {
-	result, err := p.channel.Invoke("get", map[string]any{
+	result, err := p.invoke("get", map[string]any{
 		"key": key,
 	})
 	if err != nil {
@@ -38,24 +65,30 @@ func (p *PreferencesService) Get(key string) (string, error) {
 
 // Set stores a string value for the given key.
 func (p *PreferencesService) Set(key, value string) error {
-	_, err := p.channel.Invoke("set", map[string]any{
+	_, err := p.invoke("set", map[string]any{
 		"key":   key,
 		"value": value,
 	})
+	if err == nil {
+		p.notify(key)
+	}
 	return err
 }
 
 // Delete removes the value for the given key.
 func (p *PreferencesService) Delete(key string) error {
-	_, err := p.channel.Invoke("delete", map[string]any{
+	_, err := p.invoke("delete", map[string]any{
 		"key": key,
 	})
+	if err == nil {
+		p.notify(key)
+	}
 	return err
 }
 
 // Contains checks if a key exists in preferences.
 func (p *PreferencesService) Contains(key string) (bool, error) {
-	result, err := p.channel.Invoke("contains", map[string]any{
+	result, err := p.invoke("contains", map[string]any{
 		"key": key,
 	})
 	if err != nil {
@@ -73,7 +106,7 @@ func (p *PreferencesService) Contains(key string) (bool, error) {
 
 // GetAllKeys returns all keys stored in preferences.
 func (p *PreferencesService) GetAllKeys() ([]string, error) {
-	result, err := p.channel.Invoke("getAllKeys", nil)
+	result, err := p.invoke("getAllKeys", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +127,111 @@ func (p *PreferencesService) GetAllKeys() ([]string, error) {
 }
 
 // DeleteAll removes all values from preferences.
+// Listeners are notified once with an empty key.
 func (p *PreferencesService) DeleteAll() error {
-	_, err := p.channel.Invoke("deleteAll", nil)
+	_, err := p.invoke("deleteAll", nil)
+	if err == nil {
+		p.notify("")
+	}
 	return err
 }
+
+// GetBool retrieves a bool value. Returns false and nil error if the key
+// doesn't exist.
+func (p *PreferencesService) GetBool(key string) (bool, error) {
+	value, err := p.Get(key)
+	if err != nil || value == "" {
+		return false, err
+	}
+	return strconv.ParseBool(value)
+}
+
+// SetBool stores a bool value.
+func (p *PreferencesService) SetBool(key string, value bool) error {
+	return p.Set(key, strconv.FormatBool(value))
+}
+
+// GetInt retrieves an int value. Returns 0 and nil error if the key doesn't
+// exist.
+func (p *PreferencesService) GetInt(key string) (int, error) {
+	value, err := p.Get(key)
+	if err != nil || value == "" {
+		return 0, err
+	}
+	return strconv.Atoi(value)
+}
+
+// SetInt stores an int value.
+func (p *PreferencesService) SetInt(key string, value int) error {
+	return p.Set(key, strconv.Itoa(value))
+}
+
+// GetFloat retrieves a float64 value. Returns 0 and nil error if the key
+// doesn't exist.
+func (p *PreferencesService) GetFloat(key string) (float64, error) {
+	value, err := p.Get(key)
+	if err != nil || value == "" {
+		return 0, err
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// SetFloat stores a float64 value.
+func (p *PreferencesService) SetFloat(key string, value float64) error {
+	return p.Set(key, strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// GetStrings retrieves a string list. Returns nil and nil error if the key
+// doesn't exist.
+func (p *PreferencesService) GetStrings(key string) ([]string, error) {
+	value, err := p.Get(key)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(value), &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// SetStrings stores a string list.
+func (p *PreferencesService) SetStrings(key string, values []string) error {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	return p.Set(key, string(data))
+}
+
+// OnChange registers a handler called after a value is set or deleted
+// through this service, with the changed key ("" after DeleteAll). Handlers
+// run synchronously on the goroutine that made the change. Returns a
+// function that unregisters the handler.
+func (p *PreferencesService) OnChange(handler func(key string)) (unsubscribe func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.listeners == nil {
+		p.listeners = make(map[int]func(key string))
+	}
+	id := p.nextID
+	p.nextID++
+	p.listeners[id] = handler
+	return func() {
+		p.mu.Lock()
+		delete(p.listeners, id)
+		p.mu.Unlock()
+	}
+}
+
+func (p *PreferencesService) notify(key string) {
+	p.mu.Lock()
+	handlers := make([]func(string), 0, len(p.listeners))
+	for _, handler := range p.listeners {
+		handlers = append(handlers, handler)
+	}
+	p.mu.Unlock()
+	for _, handler := range handlers {
+		handler(key)
+	}
+}
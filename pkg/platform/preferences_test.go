@@ -0,0 +1,81 @@
+package platform
+
+import (
+	"slices"
+	"testing"
+)
+
+func useInMemoryStorageForTest(t *testing.T) {
+	t.Helper()
+	SetupTestBridge(t.Cleanup)
+	UseInMemoryStorage(true)
+}
+
+func TestPreferences_TypedValues(t *testing.T) {
+	useInMemoryStorageForTest(t)
+
+	if err := Preferences.SetBool("onboarded", true); err != nil {
+		t.Fatalf("SetBool: %v", err)
+	}
+	Preferences.SetInt("launches", 42)
+	Preferences.SetFloat("volume", 0.75)
+	Preferences.SetStrings("recent", []string{"a", "b,c"})
+
+	if got, err := Preferences.GetBool("onboarded"); err != nil || !got {
+		t.Errorf("GetBool = %v, %v", got, err)
+	}
+	if got, err := Preferences.GetInt("launches"); err != nil || got != 42 {
+		t.Errorf("GetInt = %v, %v", got, err)
+	}
+	if got, err := Preferences.GetFloat("volume"); err != nil || got != 0.75 {
+		t.Errorf("GetFloat = %v, %v", got, err)
+	}
+	if got, err := Preferences.GetStrings("recent"); err != nil || !slices.Equal(got, []string{"a", "b,c"}) {
+		t.Errorf("GetStrings = %v, %v", got, err)
+	}
+
+	// Missing keys return zero values.
+	if got, err := Preferences.GetInt("missing"); err != nil || got != 0 {
+		t.Errorf("GetInt(missing) = %v, %v", got, err)
+	}
+
+	// Mistyped values report a parse error.
+	Preferences.Set("name", "drift")
+	if _, err := Preferences.GetInt("name"); err == nil {
+		t.Error("expected parse error for non-integer value")
+	}
+
+	keys, _ := Preferences.GetAllKeys()
+	if !slices.Equal(keys, []string{"launches", "name", "onboarded", "recent", "volume"}) {
+		t.Errorf("GetAllKeys = %v", keys)
+	}
+}
+
+func TestPreferences_OnChange(t *testing.T) {
+	useInMemoryStorageForTest(t)
+
+	var changed []string
+	unsubscribe := Preferences.OnChange(func(key string) {
+		changed = append(changed, key)
+	})
+
+	Preferences.SetBool("a", true)
+	Preferences.Delete("a")
+	Preferences.DeleteAll()
+	unsubscribe()
+	Preferences.Set("b", "x")
+
+	if !slices.Equal(changed, []string{"a", "a", ""}) {
+		t.Errorf("changed = %q", changed)
+	}
+}
+
+func TestUseInMemoryStorage_StartsEmpty(t *testing.T) {
+	useInMemoryStorageForTest(t)
+	Preferences.Set("a", "1")
+
+	UseInMemoryStorage(true)
+	if ok, _ := Preferences.Contains("a"); ok {
+		t.Error("expected a fresh store")
+	}
+}
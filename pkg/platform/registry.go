@@ -2,6 +2,7 @@ package platform
 
 import (
 	"fmt"
+	"image"
 	"sync"
 	"sync/atomic"
 
@@ -127,13 +128,13 @@ func SetNativeBridge(bridge NativeBridge) {
 }
 
 // invokeNative calls a method on the native side.
-func invokeNative(channel, method string, args any) (any, error) {
+func invokeNative(codec MessageCodec, channel, method string, args any) (any, error) {
 	if nativeBridge == nil {
 		return nil, ErrPlatformUnavailable
 	}
 
 	// Encode arguments
-	argsData, err := DefaultCodec.Encode(args)
+	argsData, err := codec.Encode(args)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +146,7 @@ func invokeNative(channel, method string, args any) (any, error) {
 	}
 
 	// Decode result
-	return DefaultCodec.Decode(resultData)
+	return codec.Decode(resultData)
 }
 
 // startEventStream notifies native to start sending events.
@@ -202,7 +203,7 @@ func HandleMethodCall(channel, method string, argsData []byte) ([]byte, error) {
 	}
 
 	// Decode arguments
-	args, err := DefaultCodec.Decode(argsData)
+	args, err := ch.codec.Decode(argsData)
 	if err != nil {
 		return nil, err
 	}
@@ -214,7 +215,7 @@ func HandleMethodCall(channel, method string, argsData []byte) ([]byte, error) {
 	}
 
 	// Encode result
-	return DefaultCodec.Encode(result)
+	return ch.codec.Encode(result)
 }
 
 // ErrChannelNotRegistered is returned when an event is received for an unregistered channel.
@@ -234,7 +235,7 @@ func HandleEvent(channel string, eventData []byte) error {
 		return err
 	}
 
-	data, err := DefaultCodec.Decode(eventData)
+	data, err := ch.codec.Decode(eventData)
 	if err != nil {
 		ch.dispatchError(err)
 		return err
@@ -300,6 +301,12 @@ func ResetForTest() {
 	SafeArea.handlers = SafeArea.handlers[:0]
 	SafeArea.mu.Unlock()
 
+	// Reset appearance
+	Appearance.mu.Lock()
+	Appearance.appearance = SystemAppearance{}
+	Appearance.handlers = Appearance.handlers[:0]
+	Appearance.mu.Unlock()
+
 	// Clear all event channel subscriptions and started flags
 	registry.mu.RLock()
 	channels := make([]*EventChannel, 0, len(registry.eventChannels))
@@ -327,6 +334,15 @@ func ResetForTest() {
 	audioServiceOnce = sync.Once{}
 	audioService = nil
 
+	// Reset the in-process clipboard used without a native bridge
+	Clipboard.setFallback("")
+
+	// Return preferences and secure storage to their native backends
+	UseInMemoryStorage(false)
+	Preferences.mu.Lock()
+	clear(Preferences.listeners)
+	Preferences.mu.Unlock()
+
 	// Reset platform view registry (views, IDs, geometry cache)
 	if platformViewRegistry != nil {
 		platformViewRegistry.mu.Lock()
@@ -338,6 +354,10 @@ func ResetForTest() {
 		platformViewRegistry.viewsSeenThisFrame = make(map[int64]struct{})
 		platformViewRegistry.batchUpdates = nil
 		platformViewRegistry.batchMu.Unlock()
+		platformViewRegistry.textureMu.Lock()
+		platformViewRegistry.modes = make(map[int64]PlatformViewCompositionMode)
+		platformViewRegistry.textures = make(map[int64]*image.RGBA)
+		platformViewRegistry.textureMu.Unlock()
 	}
 
 	// Re-register built-in listeners (lifecycle, safe area, accessibility)
@@ -2,7 +2,10 @@ package platform
 
 import "maps"
 
-import "fmt"
+import (
+	"fmt"
+	"sync/atomic"
+)
 
 // SecureStorage provides secure key-value storage using platform-native encryption.
 // On iOS, this uses the Keychain with optional LocalAuthentication.
@@ -26,6 +29,18 @@ var SecureStorage = &SecureStorageService{
 type SecureStorageService struct {
 	channel *MethodChannel
 	events  *EventChannel
+
+	memory   memoryKeyValueStore
+	inMemory atomic.Bool
+}
+
+// invoke calls the native handler, or the in-process store when
+// UseInMemoryStorage is enabled.
+func (s *SecureStorageService) invoke(method string, args map[string]any) (any, error) {
+	if s.inMemory.Load() {
+		return s.memory.invoke(method, args)
+	}
+	return s.channel.Invoke(method, args)
 }
 
 // KeychainAccessibility determines when a keychain item is accessible (iOS-specific).
@@ -150,7 +165,7 @@ func (s *SecureStorageService) Set(key, value string, opts *SecureStorageOptions
 		maps.Copy(args, opts.toArgs())
 	}
 
-	result, err := s.channel.Invoke("set", args)
+	result, err := s.invoke("set", args)
 	if err != nil {
 		return s.wrapError(err)
 	}
@@ -185,7 +200,7 @@ func (s *SecureStorageService) Get(key string, opts *SecureStorageOptions) (stri
 		}
 	}
 
-	result, err := s.channel.Invoke("get", args)
+	result, err := s.invoke("get", args)
 	if err != nil {
 		return "", s.wrapError(err)
 	}
@@ -227,7 +242,7 @@ func (s *SecureStorageService) Delete(key string, opts *SecureStorageOptions) er
 		args["service"] = opts.Service
 	}
 
-	result, err := s.channel.Invoke("delete", args)
+	result, err := s.invoke("delete", args)
 	if err != nil {
 		return s.wrapError(err)
 	}
@@ -254,7 +269,7 @@ func (s *SecureStorageService) Contains(key string, opts *SecureStorageOptions)
 		args["service"] = opts.Service
 	}
 
-	result, err := s.channel.Invoke("contains", args)
+	result, err := s.invoke("contains", args)
 	if err != nil {
 		return false, s.wrapError(err)
 	}
@@ -282,7 +297,7 @@ func (s *SecureStorageService) GetAllKeys(opts *SecureStorageOptions) ([]string,
 		}
 	}
 
-	result, err := s.channel.Invoke("getAllKeys", args)
+	result, err := s.invoke("getAllKeys", args)
 	if err != nil {
 		return nil, s.wrapError(err)
 	}
@@ -316,7 +331,7 @@ func (s *SecureStorageService) DeleteAll(opts *SecureStorageOptions) error {
 		}
 	}
 
-	result, err := s.channel.Invoke("deleteAll", args)
+	result, err := s.invoke("deleteAll", args)
 	if err != nil {
 		return s.wrapError(err)
 	}
@@ -331,7 +346,7 @@ func (s *SecureStorageService) DeleteAll(opts *SecureStorageOptions) error {
 
 // IsBiometricAvailable checks if biometric authentication is available on the device.
 func (s *SecureStorageService) IsBiometricAvailable() (bool, error) {
-	result, err := s.channel.Invoke("isBiometricAvailable", nil)
+	result, err := s.invoke("isBiometricAvailable", nil)
 	if err != nil {
 		return false, s.wrapError(err)
 	}
@@ -347,7 +362,7 @@ func (s *SecureStorageService) IsBiometricAvailable() (bool, error) {
 
 // GetBiometricType returns the type of biometric authentication available on the device.
 func (s *SecureStorageService) GetBiometricType() (BiometricType, error) {
-	result, err := s.channel.Invoke("getBiometricType", nil)
+	result, err := s.invoke("getBiometricType", nil)
 	if err != nil {
 		return BiometricTypeNone, s.wrapError(err)
 	}
@@ -250,3 +250,30 @@ func TestSecureStorageServiceInitialization(t *testing.T) {
 		t.Error("SecureStorage.events is nil")
 	}
 }
+
+func TestSecureStorage_InMemory(t *testing.T) {
+	useInMemoryStorageForTest(t)
+
+	if err := SecureStorage.Set("token", "secret", nil); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	SecureStorage.Set("token", "other", &SecureStorageOptions{Service: "work"})
+
+	if got, err := SecureStorage.Get("token", nil); err != nil || got != "secret" {
+		t.Errorf("Get = %q, %v", got, err)
+	}
+	if got, _ := SecureStorage.Get("token", &SecureStorageOptions{Service: "work"}); got != "other" {
+		t.Errorf("Get(work) = %q", got)
+	}
+
+	SecureStorage.DeleteAll(nil)
+	if ok, _ := SecureStorage.Contains("token", nil); ok {
+		t.Error("expected token deleted")
+	}
+	if ok, _ := SecureStorage.Contains("token", &SecureStorageOptions{Service: "work"}); !ok {
+		t.Error("expected other service untouched")
+	}
+	if ok, err := SecureStorage.IsBiometricAvailable(); err != nil || ok {
+		t.Errorf("IsBiometricAvailable = %v, %v", ok, err)
+	}
+}
@@ -89,7 +89,9 @@ type ShareFile struct {
 func (s *ShareService) share(data map[string]any) (ShareResult, error) {
 	result, err := s.channel.Invoke("share", data)
 	if err != nil {
-		return ShareResultUnavailable, err
+		// Without a native share sheet (desktop), report unavailability
+		// through the result rather than failing.
+		return ShareResultUnavailable, ignoreUnavailable(err)
 	}
 
 	if r, ok := result.(string); ok {
@@ -0,0 +1,459 @@
+package platform
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Type tags of the standard message encoding.
+const (
+	stdNull        = 0
+	stdTrue        = 1
+	stdFalse       = 2
+	stdInt32       = 3
+	stdInt64       = 4
+	stdFloat64     = 6
+	stdString      = 7
+	stdUint8List   = 8
+	stdInt32List   = 9
+	stdInt64List   = 10
+	stdFloat64List = 11
+	stdList        = 12
+	stdMap         = 13
+	stdFloat32List = 14
+)
+
+// errMalformedMessage is returned when standard-encoded bytes are truncated
+// or use an unknown type tag.
+var errMalformedMessage = errors.New("malformed standard message")
+
+// StandardMessageCodec implements MessageCodec with a compact binary
+// encoding that keeps integer, float, and byte buffer types intact. The
+// Android and iOS embedders implement the same format, which matches the
+// standard message codec used by Flutter plugins.
+//
+// Encode supports nil, bool, all integer and float types, string, []byte,
+// []int32, []int64, []float32, []float64, and slices and maps of supported
+// values. Decode produces nil, bool, int64 for all integers, float64,
+// string, the typed slices above, []any for lists, and map[string]any for
+// maps whose keys are all strings (map[any]any otherwise).
+type StandardMessageCodec struct{}
+
+// Encode serializes value to the standard binary encoding.
+func (c StandardMessageCodec) Encode(value any) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+	var w stdWriter
+	if err := w.writeValue(value); err != nil {
+		return nil, err
+	}
+	return w.buf, nil
+}
+
+// Decode deserializes standard-encoded bytes to a Go value.
+func (c StandardMessageCodec) Decode(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	r := stdReader{buf: data}
+	value, err := r.readValue()
+	if err != nil {
+		return nil, err
+	}
+	if r.pos != len(data) {
+		return nil, fmt.Errorf("%w: %d trailing bytes", errMalformedMessage, len(data)-r.pos)
+	}
+	return value, nil
+}
+
+type stdWriter struct {
+	buf []byte
+}
+
+func (w *stdWriter) align(n int) {
+	for len(w.buf)%n != 0 {
+		w.buf = append(w.buf, 0)
+	}
+}
+
+func (w *stdWriter) writeSize(n int) {
+	switch {
+	case n < 254:
+		w.buf = append(w.buf, byte(n))
+	case n <= math.MaxUint16:
+		w.buf = append(w.buf, 254)
+		w.buf = binary.LittleEndian.AppendUint16(w.buf, uint16(n))
+	default:
+		w.buf = append(w.buf, 255)
+		w.buf = binary.LittleEndian.AppendUint32(w.buf, uint32(n))
+	}
+}
+
+func (w *stdWriter) writeInt(n int64) {
+	if n >= math.MinInt32 && n <= math.MaxInt32 {
+		w.buf = append(w.buf, stdInt32)
+		w.buf = binary.LittleEndian.AppendUint32(w.buf, uint32(int32(n)))
+		return
+	}
+	w.buf = append(w.buf, stdInt64)
+	w.buf = binary.LittleEndian.AppendUint64(w.buf, uint64(n))
+}
+
+func (w *stdWriter) writeValue(value any) error {
+	switch v := value.(type) {
+	case nil:
+		w.buf = append(w.buf, stdNull)
+	case bool:
+		if v {
+			w.buf = append(w.buf, stdTrue)
+		} else {
+			w.buf = append(w.buf, stdFalse)
+		}
+	case int:
+		w.writeInt(int64(v))
+	case int8:
+		w.writeInt(int64(v))
+	case int16:
+		w.writeInt(int64(v))
+	case int32:
+		w.writeInt(int64(v))
+	case int64:
+		w.writeInt(v)
+	case uint8:
+		w.writeInt(int64(v))
+	case uint16:
+		w.writeInt(int64(v))
+	case uint32:
+		w.writeInt(int64(v))
+	case uint:
+		if uint64(v) > math.MaxInt64 {
+			return fmt.Errorf("%w: %d overflows int64", ErrInvalidArguments, v)
+		}
+		w.writeInt(int64(v))
+	case uint64:
+		if v > math.MaxInt64 {
+			return fmt.Errorf("%w: %d overflows int64", ErrInvalidArguments, v)
+		}
+		w.writeInt(int64(v))
+	case float32:
+		w.writeFloat64(float64(v))
+	case float64:
+		w.writeFloat64(v)
+	case string:
+		w.buf = append(w.buf, stdString)
+		w.writeSize(len(v))
+		w.buf = append(w.buf, v...)
+	case []byte:
+		w.buf = append(w.buf, stdUint8List)
+		w.writeSize(len(v))
+		w.buf = append(w.buf, v...)
+	case []int32:
+		w.buf = append(w.buf, stdInt32List)
+		w.writeSize(len(v))
+		w.align(4)
+		for _, n := range v {
+			w.buf = binary.LittleEndian.AppendUint32(w.buf, uint32(n))
+		}
+	case []int64:
+		w.buf = append(w.buf, stdInt64List)
+		w.writeSize(len(v))
+		w.align(8)
+		for _, n := range v {
+			w.buf = binary.LittleEndian.AppendUint64(w.buf, uint64(n))
+		}
+	case []float32:
+		w.buf = append(w.buf, stdFloat32List)
+		w.writeSize(len(v))
+		w.align(4)
+		for _, f := range v {
+			w.buf = binary.LittleEndian.AppendUint32(w.buf, math.Float32bits(f))
+		}
+	case []float64:
+		w.buf = append(w.buf, stdFloat64List)
+		w.writeSize(len(v))
+		w.align(8)
+		for _, f := range v {
+			w.buf = binary.LittleEndian.AppendUint64(w.buf, math.Float64bits(f))
+		}
+	case []any:
+		w.buf = append(w.buf, stdList)
+		w.writeSize(len(v))
+		for _, item := range v {
+			if err := w.writeValue(item); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		w.buf = append(w.buf, stdMap)
+		w.writeSize(len(v))
+		for key, item := range v {
+			w.writeValue(key)
+			if err := w.writeValue(item); err != nil {
+				return err
+			}
+		}
+	default:
+		return w.writeReflect(reflect.ValueOf(value))
+	}
+	return nil
+}
+
+func (w *stdWriter) writeFloat64(f float64) {
+	w.buf = append(w.buf, stdFloat64)
+	w.align(8)
+	w.buf = binary.LittleEndian.AppendUint64(w.buf, math.Float64bits(f))
+}
+
+// writeReflect encodes slices and maps of other element types, such as
+// []string or map[string]int.
+func (w *stdWriter) writeReflect(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		w.buf = append(w.buf, stdList)
+		w.writeSize(v.Len())
+		for i := range v.Len() {
+			if err := w.writeValue(v.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		w.buf = append(w.buf, stdMap)
+		w.writeSize(v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := w.writeValue(iter.Key().Interface()); err != nil {
+				return err
+			}
+			if err := w.writeValue(iter.Value().Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			w.buf = append(w.buf, stdNull)
+			return nil
+		}
+		return w.writeValue(v.Elem().Interface())
+	}
+	return fmt.Errorf("%w: StandardMessageCodec cannot encode %s", ErrInvalidArguments, v.Type())
+}
+
+type stdReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *stdReader) take(n int) ([]byte, error) {
+	if n < 0 || len(r.buf)-r.pos < n {
+		return nil, fmt.Errorf("%w: unexpected end of data", errMalformedMessage)
+	}
+	b := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *stdReader) align(n int) error {
+	if pad := (n - r.pos%n) % n; pad > 0 {
+		_, err := r.take(pad)
+		return err
+	}
+	return nil
+}
+
+func (r *stdReader) readSize() (int, error) {
+	b, err := r.take(1)
+	if err != nil {
+		return 0, err
+	}
+	switch b[0] {
+	case 254:
+		b, err = r.take(2)
+		if err != nil {
+			return 0, err
+		}
+		return int(binary.LittleEndian.Uint16(b)), nil
+	case 255:
+		b, err = r.take(4)
+		if err != nil {
+			return 0, err
+		}
+		return int(binary.LittleEndian.Uint32(b)), nil
+	default:
+		return int(b[0]), nil
+	}
+}
+
+// readElements reads a size-prefixed run of fixed-width elements.
+func (r *stdReader) readElements(width int) ([]byte, int, error) {
+	n, err := r.readSize()
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := r.align(width); err != nil {
+		return nil, 0, err
+	}
+	if n > (len(r.buf)-r.pos)/width {
+		return nil, 0, fmt.Errorf("%w: unexpected end of data", errMalformedMessage)
+	}
+	b, err := r.take(n * width)
+	return b, n, err
+}
+
+func (r *stdReader) readValue() (any, error) {
+	tag, err := r.take(1)
+	if err != nil {
+		return nil, err
+	}
+	switch tag[0] {
+	case stdNull:
+		return nil, nil
+	case stdTrue:
+		return true, nil
+	case stdFalse:
+		return false, nil
+	case stdInt32:
+		b, err := r.take(4)
+		if err != nil {
+			return nil, err
+		}
+		return int64(int32(binary.LittleEndian.Uint32(b))), nil
+	case stdInt64:
+		b, err := r.take(8)
+		if err != nil {
+			return nil, err
+		}
+		return int64(binary.LittleEndian.Uint64(b)), nil
+	case stdFloat64:
+		if err := r.align(8); err != nil {
+			return nil, err
+		}
+		b, err := r.take(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case stdString:
+		n, err := r.readSize()
+		if err != nil {
+			return nil, err
+		}
+		b, err := r.take(n)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case stdUint8List:
+		b, n, err := r.readElements(1)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]byte, n)
+		copy(out, b)
+		return out, nil
+	case stdInt32List:
+		b, n, err := r.readElements(4)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]int32, n)
+		for i := range out {
+			out[i] = int32(binary.LittleEndian.Uint32(b[i*4:]))
+		}
+		return out, nil
+	case stdInt64List:
+		b, n, err := r.readElements(8)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]int64, n)
+		for i := range out {
+			out[i] = int64(binary.LittleEndian.Uint64(b[i*8:]))
+		}
+		return out, nil
+	case stdFloat32List:
+		b, n, err := r.readElements(4)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]float32, n)
+		for i := range out {
+			out[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[i*4:]))
+		}
+		return out, nil
+	case stdFloat64List:
+		b, n, err := r.readElements(8)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]float64, n)
+		for i := range out {
+			out[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[i*8:]))
+		}
+		return out, nil
+	case stdList:
+		n, err := r.readSize()
+		if err != nil {
+			return nil, err
+		}
+		if n > len(r.buf)-r.pos {
+			return nil, fmt.Errorf("%w: unexpected end of data", errMalformedMessage)
+		}
+		out := make([]any, n)
+		for i := range out {
+			if out[i], err = r.readValue(); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	case stdMap:
+		return r.readMap()
+	default:
+		return nil, fmt.Errorf("%w: unknown type tag %d", errMalformedMessage, tag[0])
+	}
+}
+
+func (r *stdReader) readMap() (any, error) {
+	n, err := r.readSize()
+	if err != nil {
+		return nil, err
+	}
+	if n > (len(r.buf)-r.pos)/2 {
+		return nil, fmt.Errorf("%w: unexpected end of data", errMalformedMessage)
+	}
+	keys := make([]any, n)
+	values := make([]any, n)
+	stringKeys := true
+	for i := range n {
+		if keys[i], err = r.readValue(); err != nil {
+			return nil, err
+		}
+		if values[i], err = r.readValue(); err != nil {
+			return nil, err
+		}
+		if _, ok := keys[i].(string); !ok {
+			stringKeys = false
+		}
+	}
+
+	if stringKeys {
+		out := make(map[string]any, n)
+		for i, key := range keys {
+			out[key.(string)] = values[i]
+		}
+		return out, nil
+	}
+	out := make(map[any]any, n)
+	for i, key := range keys {
+		if key != nil && !reflect.TypeOf(key).Comparable() {
+			return nil, fmt.Errorf("%w: map key of type %T", errMalformedMessage, key)
+		}
+		out[key] = values[i]
+	}
+	return out, nil
+}
@@ -0,0 +1,173 @@
+package platform
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestStandardMessageCodec_RoundTrip(t *testing.T) {
+	codec := StandardMessageCodec{}
+	tests := []struct {
+		name string
+		in   any
+		want any
+	}{
+		{"nil", nil, nil},
+		{"true", true, true},
+		{"false", false, false},
+		{"int", 42, int64(42)},
+		{"negative int32", int32(-7), int64(-7)},
+		{"int64", int64(1) << 40, int64(1) << 40},
+		{"float", 3.5, 3.5},
+		{"string", "héllo", "héllo"},
+		{"bytes", []byte{0, 1, 255}, []byte{0, 1, 255}},
+		{"int32 list", []int32{1, -2, 3}, []int32{1, -2, 3}},
+		{"int64 list", []int64{1 << 40, -1}, []int64{1 << 40, -1}},
+		{"float32 list", []float32{0.5, -1}, []float32{0.5, -1}},
+		{"float64 list", []float64{0.25, 1e300}, []float64{0.25, 1e300}},
+		{"list", []any{1, "a", nil, []byte{9}}, []any{int64(1), "a", nil, []byte{9}}},
+		{"string list", []string{"a", "b"}, []any{"a", "b"}},
+		{"map", map[string]any{"w": 2, "data": []byte{1, 2}}, map[string]any{"w": int64(2), "data": []byte{1, 2}}},
+		{"int keys", map[int]string{1: "one"}, map[any]any{int64(1): "one"}},
+		{"long string", string(bytes.Repeat([]byte("x"), 70000)), string(bytes.Repeat([]byte("x"), 70000))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := codec.Encode(tt.in)
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			got, err := codec.Decode(data)
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("round trip = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStandardMessageCodec_WireFormat(t *testing.T) {
+	codec := StandardMessageCodec{}
+	tests := []struct {
+		name string
+		in   any
+		want []byte
+	}{
+		{"int32", 1, []byte{3, 1, 0, 0, 0}},
+		{"int64", int64(1) << 32, []byte{4, 0, 0, 0, 0, 1, 0, 0, 0}},
+		{"float64 aligned", 1.0, []byte{6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xf0, 0x3f}},
+		{"string", "hi", []byte{7, 2, 'h', 'i'}},
+		{"int32 list aligned", []int32{1}, []byte{9, 1, 0, 0, 1, 0, 0, 0}},
+		{"list", []any{true, nil}, []byte{12, 2, 1, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := codec.Encode(tt.in)
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("Encode = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStandardMessageCodec_Errors(t *testing.T) {
+	codec := StandardMessageCodec{}
+
+	if _, err := codec.Encode(struct{}{}); !errors.Is(err, ErrInvalidArguments) {
+		t.Errorf("Encode(struct) err = %v, want ErrInvalidArguments", err)
+	}
+	if _, err := codec.Encode(uint64(1) << 63); !errors.Is(err, ErrInvalidArguments) {
+		t.Errorf("Encode(uint64 overflow) err = %v, want ErrInvalidArguments", err)
+	}
+
+	malformed := [][]byte{
+		{99},                  // unknown tag
+		{3, 1, 0},             // truncated int32
+		{7, 5, 'a'},           // truncated string
+		{12, 255, 255, 255},   // truncated size
+		{12, 200},             // list longer than the data
+		{3, 1, 0, 0, 0, 0},    // trailing bytes
+		{13, 1, 12, 0, 1},     // unhashable list key
+		{10, 255, 0, 0, 0, 1}, // huge int64 list
+	}
+	for _, data := range malformed {
+		if _, err := codec.Decode(data); !errors.Is(err, errMalformedMessage) {
+			t.Errorf("Decode(%v) err = %v, want errMalformedMessage", data, err)
+		}
+	}
+}
+
+func TestBinaryCodec(t *testing.T) {
+	codec := BinaryCodec{}
+	payload := []byte{0x89, 'P', 'N', 'G'}
+
+	data, err := codec.Encode(payload)
+	if err != nil || !bytes.Equal(data, payload) {
+		t.Fatalf("Encode = %v, %v; want payload unchanged", data, err)
+	}
+	got, err := codec.Decode(data)
+	if err != nil || !bytes.Equal(got.([]byte), payload) {
+		t.Errorf("Decode = %v, %v; want payload unchanged", got, err)
+	}
+	if got, _ := codec.Decode(nil); got != nil {
+		t.Errorf("Decode(nil) = %v, want nil", got)
+	}
+	if _, err := codec.Encode("text"); !errors.Is(err, ErrInvalidArguments) {
+		t.Errorf("Encode(string) err = %v, want ErrInvalidArguments", err)
+	}
+}
+
+// rawBridge records the encoded bytes it is sent and echoes them back.
+type rawBridge struct {
+	testBridge
+	args []byte
+}
+
+func (b *rawBridge) InvokeMethod(channel, method string, argsData []byte) ([]byte, error) {
+	b.args = argsData
+	return argsData, nil
+}
+
+func TestMethodChannelWithCodec(t *testing.T) {
+	SetupTestBridge(t.Cleanup)
+	bridge := &rawBridge{}
+	SetNativeBridge(bridge)
+
+	ch := NewMethodChannelWithCodec("test/binary", BinaryCodec{})
+	result, err := ch.Invoke("upload", []byte{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+	if !bytes.Equal(bridge.args, []byte{1, 2, 3}) {
+		t.Errorf("native received %v, want raw bytes", bridge.args)
+	}
+	if !bytes.Equal(result.([]byte), []byte{1, 2, 3}) {
+		t.Errorf("result = %v, want raw bytes", result)
+	}
+
+	ch.SetHandler(func(method string, args any) (any, error) {
+		return args, nil
+	})
+	out, err := HandleMethodCall("test/binary", "echo", []byte{7})
+	if err != nil || !bytes.Equal(out, []byte{7}) {
+		t.Errorf("HandleMethodCall = %v, %v; want [7]", out, err)
+	}
+
+	std := NewEventChannelWithCodec("test/standard_events", StandardMessageCodec{})
+	var got any
+	std.Listen(EventHandler{OnEvent: func(data any) { got = data }})
+	event, _ := StandardMessageCodec{}.Encode(map[string]any{"n": 5})
+	if err := HandleEvent("test/standard_events", event); err != nil {
+		t.Fatalf("HandleEvent: %v", err)
+	}
+	if !reflect.DeepEqual(got, map[string]any{"n": int64(5)}) {
+		t.Errorf("event = %#v, want map with int64 value", got)
+	}
+}
@@ -18,6 +18,7 @@ type PickedFile struct {
 	URI      string
 	MimeType string
 	Size     int64
+	Data     []byte // File contents, set by FilePicker when ReadBytes is true
 }
 
 // FileInfo contains metadata about a file.
@@ -82,14 +83,16 @@ func init() {
 }
 
 type storageServiceState struct {
-	channel *MethodChannel
-	results *EventChannel
+	channel  *MethodChannel
+	results  *EventChannel
+	progress *EventChannel
 }
 
 func newStorageService() *storageServiceState {
 	return &storageServiceState{
-		channel: NewMethodChannel("drift/storage"),
-		results: NewEventChannel("drift/storage/result"),
+		channel:  NewMethodChannel("drift/storage"),
+		results:  NewEventChannel("drift/storage/result"),
+		progress: NewEventChannel("drift/storage/progress"),
 	}
 }
 
@@ -101,13 +104,13 @@ func (s *StorageService) PickFile(ctx context.Context, opts PickFileOptions) (St
 		"allowedTypes":  opts.AllowedTypes,
 		"initialDir":    opts.InitialDir,
 		"dialogTitle":   opts.DialogTitle,
-	})
+	}, nil)
 }
 
 // PickDirectory opens a directory picker dialog and blocks until the user selects a directory or cancels.
 // Returns ErrStorageBusy if another picker operation is already in progress.
 func (s *StorageService) PickDirectory(ctx context.Context) (StorageResult, error) {
-	return s.invokePicker(ctx, "pickDirectory", nil)
+	return s.invokePicker(ctx, "pickDirectory", nil, nil)
 }
 
 // SaveFile saves data to a file chosen by the user and blocks until complete.
@@ -119,13 +122,15 @@ func (s *StorageService) SaveFile(ctx context.Context, data []byte, opts SaveFil
 		"mimeType":      opts.MimeType,
 		"initialDir":    opts.InitialDir,
 		"dialogTitle":   opts.DialogTitle,
-	})
+	}, nil)
 }
 
 // invokePicker serializes picker operations, subscribes to the result event
 // channel filtered by a generated request ID, invokes the native method,
 // and blocks until a matching result arrives or the context is canceled.
-func (s *StorageService) invokePicker(ctx context.Context, method string, args map[string]any) (StorageResult, error) {
+// If onProgress is non-nil, progress events for the request are forwarded
+// to it on the UI thread.
+func (s *StorageService) invokePicker(ctx context.Context, method string, args map[string]any, onProgress func(FilePickerProgress)) (StorageResult, error) {
 	if !s.mu.TryLock() {
 		return StorageResult{}, ErrStorageBusy
 	}
@@ -163,6 +168,22 @@ func (s *StorageService) invokePicker(ctx context.Context, method string, args m
 	})
 	defer sub.Cancel()
 
+	if onProgress != nil {
+		progressSub := s.state.progress.Listen(EventHandler{
+			OnEvent: func(data any) {
+				m, ok := data.(map[string]any)
+				if !ok || parseString(m["requestId"]) != requestID {
+					return
+				}
+				progress := parseFilePickerProgress(m)
+				if !Dispatch(func() { onProgress(progress) }) {
+					onProgress(progress)
+				}
+			},
+		})
+		defer progressSub.Cancel()
+	}
+
 	if args == nil {
 		args = make(map[string]any)
 	}
@@ -25,3 +25,9 @@ func SetupTestBridge(cleanup func(func())) {
 func (l *LifecycleService) SetStateForTest(state LifecycleState) {
 	l.updateState(state)
 }
+
+// SetAppearanceForTest updates the system appearance and notifies handlers.
+// Use only in tests.
+func (a *AppearanceService) SetAppearanceForTest(appearance SystemAppearance) {
+	a.update(appearance)
+}
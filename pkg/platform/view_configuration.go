@@ -0,0 +1,42 @@
+package platform
+
+import "time"
+
+var viewConfigurationChannel = NewMethodChannel("drift/view_configuration")
+
+// ViewConfiguration holds the device's gesture thresholds, which users can
+// change in the platform's accessibility settings (for example a longer
+// touch-and-hold delay on Android). Zero fields are not reported by the
+// platform.
+type ViewConfiguration struct {
+	// TouchSlop is how far, in logical pixels, a pointer may move before it
+	// is treated as a drag rather than a tap.
+	TouchSlop float64
+	// LongPressTimeout is how long a pointer must stay down to count as a
+	// long press.
+	LongPressTimeout time.Duration
+	// DoubleTapTimeout is the longest gap between taps of a double tap.
+	DoubleTapTimeout time.Duration
+}
+
+// GetViewConfiguration returns the platform's gesture thresholds. Platforms
+// that don't report them, such as desktop, return a zero ViewConfiguration
+// and no error.
+func GetViewConfiguration() (ViewConfiguration, error) {
+	result, err := viewConfigurationChannel.Invoke("get", nil)
+	if err != nil {
+		return ViewConfiguration{}, ignoreUnavailable(err)
+	}
+	m := parseMap(result)
+	var config ViewConfiguration
+	if slop, ok := toFloat64(m["touchSlop"]); ok {
+		config.TouchSlop = slop
+	}
+	if ms, ok := toInt64(m["longPressTimeoutMs"]); ok {
+		config.LongPressTimeout = time.Duration(ms) * time.Millisecond
+	}
+	if ms, ok := toInt64(m["doubleTapTimeoutMs"]); ok {
+		config.DoubleTapTimeout = time.Duration(ms) * time.Millisecond
+	}
+	return config, nil
+}
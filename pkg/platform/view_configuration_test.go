@@ -0,0 +1,34 @@
+package platform
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetViewConfiguration(t *testing.T) {
+	SetNativeBridge(&urlLauncherBridge{response: map[string]any{
+		"touchSlop":          8.0,
+		"longPressTimeoutMs": 1000,
+		"doubleTapTimeoutMs": 300,
+	}})
+	t.Cleanup(ResetForTest)
+
+	config, err := GetViewConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ViewConfiguration{TouchSlop: 8, LongPressTimeout: time.Second, DoubleTapTimeout: 300 * time.Millisecond}
+	if config != want {
+		t.Errorf("GetViewConfiguration = %+v, want %+v", config, want)
+	}
+}
+
+func TestGetViewConfiguration_WithoutBridge(t *testing.T) {
+	ResetForTest()
+	t.Cleanup(ResetForTest)
+
+	config, err := GetViewConfiguration()
+	if err != nil || config != (ViewConfiguration{}) {
+		t.Errorf("GetViewConfiguration = %+v, %v; want zero and no error", config, err)
+	}
+}
@@ -150,6 +150,20 @@ func (c *WebViewController) Reload() error {
 	return err
 }
 
+// SetCompositionMode switches between overlay and texture composition. Use
+// [PlatformViewCompositionTexture] when widgets such as dialogs, menus, or
+// rounded clips must draw exactly over the page. Only Android supports
+// texture composition; other platforms return an error.
+func (c *WebViewController) SetCompositionMode(mode PlatformViewCompositionMode) error {
+	c.mu.RLock()
+	id := c.viewID
+	c.mu.RUnlock()
+	if id == 0 {
+		return ErrDisposed
+	}
+	return GetPlatformViewRegistry().SetCompositionMode(id, mode)
+}
+
 // Dispose releases the web view and its native resources. After disposal,
 // this controller must not be reused. Dispose is idempotent; calling it more
 // than once is safe.
@@ -250,6 +250,15 @@ func (o *SemanticsOwner) GetStableID(key any) int64 {
 	return id
 }
 
+// LookupStableID returns the ID previously assigned to key by GetStableID,
+// without assigning a new one.
+func (o *SemanticsOwner) LookupStableID(key any) (int64, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	id, ok := o.stableIDs[key]
+	return id, ok
+}
+
 // SetUpdateCallback sets the callback for semantics updates.
 func (o *SemanticsOwner) SetUpdateCallback(fn func(SemanticsUpdate)) {
 	o.mu.Lock()
@@ -160,6 +160,23 @@ func TestSemanticsOwner_SetRoot(t *testing.T) {
 	}
 }
 
+func TestSemanticsOwner_LookupStableID(t *testing.T) {
+	owner := NewSemanticsOwner()
+	key := new(int)
+
+	if _, ok := owner.LookupStableID(key); ok {
+		t.Fatal("LookupStableID should not find an unassigned key")
+	}
+	if _, ok := owner.LookupStableID(key); ok {
+		t.Fatal("LookupStableID should not assign an ID")
+	}
+
+	id := owner.GetStableID(key)
+	if got, ok := owner.LookupStableID(key); !ok || got != id {
+		t.Errorf("LookupStableID = %d, %v; want %d, true", got, ok, id)
+	}
+}
+
 func TestSemanticsOwner_MarkDirty(t *testing.T) {
 	owner := NewSemanticsOwner()
 	node := NewSemanticsNode()
@@ -5,11 +5,13 @@
 
 #include <algorithm>
 #include <cstddef>
+#include <cstdlib>
 #include <cstring>
 #include <limits>
 #include <mutex>
 #include <string>
 #include <unordered_map>
+#include <utility>
 #include <vector>
 
 #include "core/SkCanvas.h"
@@ -18,6 +20,7 @@
 #include "core/SkColorSpace.h"
 #include "core/SkData.h"
 #include "core/SkFont.h"
+#include "core/SkFontArguments.h"
 #include "core/SkFontMetrics.h"
 #include "core/SkImage.h"
 #include "core/SkImageInfo.h"
@@ -30,6 +33,7 @@
 #include "core/SkSurface.h"
 #include "core/SkSurfaceProps.h"
 #include "core/SkTypeface.h"
+#include "core/SkVertices.h"
 #include "core/SkFontMgr.h"
 #include "core/SkString.h"
 #include "effects/SkGradient.h"
@@ -42,6 +46,7 @@
 #include "modules/skparagraph/include/ParagraphBuilder.h"
 #include "modules/skparagraph/include/ParagraphStyle.h"
 #include "modules/skparagraph/include/TextStyle.h"
+#include "modules/skparagraph/include/TypefaceFontProvider.h"
 #include "modules/skunicode/include/SkUnicode_libgrapheme.h"
 
 #include "skia_common_internal.h"
@@ -215,6 +220,11 @@ sk_sp<SkShader> make_gradient_shader(int gradient_type, float x1, float y1, floa
 struct FontRegistry {
     std::mutex mu;
     std::unordered_map<std::string, sk_sp<SkTypeface>> custom;
+    // provider exposes custom fonts to paragraph font fallback by name.
+    sk_sp<skia::textlayout::TypefaceFontProvider> provider;
+    // fallbacks are the families tried, in order, for glyphs missing from
+    // a style's own families.
+    std::vector<std::string> fallbacks;
 };
 
 struct ParagraphRegistry {
@@ -232,16 +242,188 @@ ParagraphRegistry& paragraph_registry() {
     return registry;
 }
 
+sk_sp<skia::textlayout::TypefaceFontProvider> custom_font_provider() {
+    auto& registry = font_registry();
+    std::lock_guard<std::mutex> lock(registry.mu);
+    if (!registry.provider) {
+        registry.provider = sk_make_sp<skia::textlayout::TypefaceFontProvider>();
+    }
+    return registry.provider;
+}
+
 sk_sp<skia::textlayout::FontCollection> get_paragraph_collection() {
+    auto provider = custom_font_provider();
     auto& registry = paragraph_registry();
     std::lock_guard<std::mutex> lock(registry.mu);
     if (!registry.collection) {
         registry.collection = sk_make_sp<skia::textlayout::FontCollection>();
+        registry.collection->setAssetFontManager(provider);
         registry.collection->setDefaultFontManager(drift_get_font_manager());
+        registry.collection->enableFontFallback();
     }
     return registry.collection;
 }
 
+// Resets the paragraph font caches after the set of fonts changes, so text
+// that fell back to another font picks up the new one.
+void clear_paragraph_font_cache() {
+    auto& registry = paragraph_registry();
+    std::lock_guard<std::mutex> lock(registry.mu);
+    if (registry.collection) {
+        registry.collection->clearCaches();
+    }
+}
+
+// Splits a comma-separated family list into trimmed, non-empty names.
+std::vector<std::string> split_families(const char* family) {
+    std::vector<std::string> names;
+    if (!family) {
+        return names;
+    }
+    std::string list(family);
+    size_t start = 0;
+    while (start <= list.size()) {
+        size_t end = list.find(',', start);
+        if (end == std::string::npos) {
+            end = list.size();
+        }
+        size_t first = list.find_first_not_of(" \t", start);
+        size_t last = list.find_last_not_of(" \t", end == 0 ? 0 : end - 1);
+        if (first != std::string::npos && first < end && last >= first) {
+            names.push_back(list.substr(first, last - first + 1));
+        }
+        start = end + 1;
+    }
+    return names;
+}
+
+// Returns the first family of a comma-separated family list.
+std::string primary_family(const char* family) {
+    auto names = split_families(family);
+    return names.empty() ? std::string() : names.front();
+}
+
+// Makes the platform emoji font available by name, loading it from its file
+// when the font manager doesn't list it.
+void ensure_platform_emoji_font() {
+    static std::once_flag once;
+    std::call_once(once, [] {
+        const char* name = drift_platform_emoji_font();
+        const char* path = drift_platform_emoji_font_path();
+        auto manager = drift_get_font_manager();
+        if (!manager || !path) {
+            return;
+        }
+        if (manager->matchFamilyStyle(name, SkFontStyle())) {
+            return;
+        }
+        auto typeface = manager->makeFromFile(path);
+        if (!typeface) {
+            return;
+        }
+        auto provider = custom_font_provider();
+        auto& registry = font_registry();
+        std::lock_guard<std::mutex> lock(registry.mu);
+        provider->registerTypeface(typeface, SkString(name));
+    });
+}
+
+// Returns the families a paragraph style should search, in order: the
+// style's own families (or Skia's default when empty), the registered
+// fallbacks, and the platform emoji font, without duplicates.
+std::vector<SkString> paragraph_families(const char* family) {
+    ensure_platform_emoji_font();
+    std::vector<std::string> names = split_families(family);
+    if (names.empty()) {
+        for (const auto& name : skia::textlayout::TextStyle().getFontFamilies()) {
+            names.emplace_back(name.c_str());
+        }
+    }
+    {
+        auto& registry = font_registry();
+        std::lock_guard<std::mutex> lock(registry.mu);
+        names.insert(names.end(), registry.fallbacks.begin(), registry.fallbacks.end());
+    }
+    names.emplace_back(drift_platform_emoji_font());
+    std::vector<SkString> families;
+    for (size_t i = 0; i < names.size(); i++) {
+        if (std::find(names.begin(), names.begin() + i, names[i]) == names.begin() + i) {
+            families.emplace_back(names[i].c_str());
+        }
+    }
+    return families;
+}
+
+// Parses "tag=value" settings separated by commas, as encoded by the Go
+// side, skipping entries whose tag isn't four characters.
+std::vector<std::pair<SkFourByteTag, float>> parse_font_settings(const char* settings) {
+    std::vector<std::pair<SkFourByteTag, float>> parsed;
+    if (!settings) {
+        return parsed;
+    }
+    std::string list(settings);
+    size_t start = 0;
+    while (start < list.size()) {
+        size_t end = list.find(',', start);
+        if (end == std::string::npos) {
+            end = list.size();
+        }
+        size_t eq = list.find('=', start);
+        if (eq != std::string::npos && eq < end && eq - start == 4) {
+            const char* tag = list.c_str() + start;
+            float value = std::strtof(list.substr(eq + 1, end - eq - 1).c_str(), nullptr);
+            parsed.emplace_back(SkSetFourByteTag(tag[0], tag[1], tag[2], tag[3]), value);
+        }
+        start = end + 1;
+    }
+    return parsed;
+}
+
+// Applies OpenType features and variable font axes to a paragraph style.
+// The resolved typeface is cloned at the variation coordinates so that
+// shaping uses the requested instance rather than the nearest named one.
+void apply_font_settings(
+    skia::textlayout::TextStyle& text_style,
+    sk_sp<SkTypeface>& typeface,
+    const char* features,
+    const char* variations
+) {
+    for (const auto& [tag, value] : parse_font_settings(features)) {
+        char name[5] = {
+            static_cast<char>(tag >> 24), static_cast<char>(tag >> 16),
+            static_cast<char>(tag >> 8), static_cast<char>(tag), '\0',
+        };
+        text_style.addFontFeature(SkString(name), static_cast<int>(value));
+    }
+    auto axes = parse_font_settings(variations);
+    if (axes.empty()) {
+        return;
+    }
+    std::vector<SkFontArguments::VariationPosition::Coordinate> coordinates;
+    coordinates.reserve(axes.size());
+    for (const auto& [tag, value] : axes) {
+        coordinates.push_back({tag, value});
+    }
+    SkFontArguments arguments;
+    arguments.setVariationDesignPosition({coordinates.data(), static_cast<int>(coordinates.size())});
+    text_style.setFontArguments(arguments);
+    if (typeface) {
+        if (auto clone = typeface->makeClone(arguments)) {
+            typeface = clone;
+        }
+    }
+}
+
+void set_font_fallbacks(const char* families) {
+    auto names = split_families(families);
+    {
+        auto& registry = font_registry();
+        std::lock_guard<std::mutex> lock(registry.mu);
+        registry.fallbacks = std::move(names);
+    }
+    clear_paragraph_font_cache();
+}
+
 sk_sp<SkTypeface> lookup_custom_typeface(const char* family) {
     if (!family || family[0] == '\0') {
         return nullptr;
@@ -268,7 +450,7 @@ sk_sp<SkTypeface> resolve_typeface(const char* family, int weight, int style) {
     static Cache cache;
 
     weight = std::clamp(weight, 100, 900);
-    std::string family_name = (family && family[0] != '\0') ? family : "";
+    std::string family_name = primary_family(family);
     if (cache.typeface && cache.weight == weight && cache.style == style && cache.family == family_name) {
         return cache.typeface;
     }
@@ -276,7 +458,7 @@ sk_sp<SkTypeface> resolve_typeface(const char* family, int weight, int style) {
     SkFontStyle::Slant slant = (style == 1) ? SkFontStyle::kItalic_Slant : SkFontStyle::kUpright_Slant;
     SkFontStyle font_style(weight, SkFontStyle::kNormal_Width, slant);
     auto manager = drift_get_font_manager();
-    sk_sp<SkTypeface> typeface = lookup_custom_typeface(family);
+    sk_sp<SkTypeface> typeface = lookup_custom_typeface(family_name.c_str());
     if (!typeface && manager && !family_name.empty()) {
         typeface = manager->matchFamilyStyle(family_name.c_str(), font_style);
     }
@@ -318,9 +500,14 @@ bool register_font(const char* name, const uint8_t* data, int length) {
     if (!typeface) {
         return false;
     }
-    auto& registry = font_registry();
-    std::lock_guard<std::mutex> lock(registry.mu);
-    registry.custom[name] = typeface;
+    auto provider = custom_font_provider();
+    {
+        auto& registry = font_registry();
+        std::lock_guard<std::mutex> lock(registry.mu);
+        registry.custom[name] = typeface;
+        provider->registerTypeface(typeface, SkString(name));
+    }
+    clear_paragraph_font_cache();
     return true;
 }
 
@@ -547,6 +734,25 @@ void drift_skia_surface_destroy(DriftSkiaSurface surface) {
     reinterpret_cast<SkSurface*>(surface)->unref();
 }
 
+DriftSkiaSurface drift_skia_surface_create_raster(int width, int height) {
+    if (width <= 0 || height <= 0) {
+        return nullptr;
+    }
+    auto surface = SkSurfaces::Raster(SkImageInfo::MakeN32Premul(width, height));
+    if (!surface) {
+        return nullptr;
+    }
+    return surface.release();
+}
+
+int drift_skia_surface_read_pixels(DriftSkiaSurface surface, uint8_t* pixels, int width, int height, int stride) {
+    if (!surface || !pixels || width <= 0 || height <= 0 || stride < width * 4) {
+        return 0;
+    }
+    SkImageInfo info = SkImageInfo::Make(width, height, kRGBA_8888_SkColorType, kUnpremul_SkAlphaType);
+    return reinterpret_cast<SkSurface*>(surface)->readPixels(info, pixels, stride, 0, 0) ? 1 : 0;
+}
+
 void drift_skia_canvas_save(DriftSkiaCanvas canvas) {
     if (!canvas) {
         return;
@@ -1001,6 +1207,10 @@ int drift_skia_register_font(const char* name, const uint8_t* data, int length)
     return register_font(name, data, length) ? 1 : 0;
 }
 
+void drift_skia_set_font_fallbacks(const char* families) {
+    set_font_fallbacks(families);
+}
+
 int drift_skia_measure_text(const char* text, const char* family, float size, int weight, int style, float* width) {
     if (!width) {
         return 0;
@@ -1102,7 +1312,17 @@ DriftSkiaParagraph drift_skia_paragraph_create(
     float shadow_dx,
     float shadow_dy,
     float shadow_sigma,
-    int text_align
+    int text_align,
+    float letter_spacing,
+    float height,
+    float word_spacing,
+    int decoration,
+    uint32_t decoration_argb,
+    int decoration_style,
+    float decoration_thickness,
+    const char* ellipsis,
+    const char* font_features,
+    const char* font_variations
 ) {
     auto collection = get_paragraph_collection();
     if (!collection) {
@@ -1113,16 +1333,36 @@ DriftSkiaParagraph drift_skia_paragraph_create(
         paragraph_style.setMaxLines(static_cast<size_t>(max_lines));
     }
     paragraph_style.setTextAlign(static_cast<skia::textlayout::TextAlign>(text_align));
+    if (ellipsis && ellipsis[0] != '\0') {
+        paragraph_style.setEllipsis(SkString(ellipsis));
+    }
     skia::textlayout::TextStyle text_style;
     text_style.setFontSize(size);
+    if (letter_spacing != 0) {
+        text_style.setLetterSpacing(letter_spacing);
+    }
+    if (height > 0) {
+        text_style.setHeight(height);
+        text_style.setHeightOverride(true);
+    }
+    if (word_spacing != 0) {
+        text_style.setWordSpacing(word_spacing);
+    }
+    if (decoration != 0) {
+        text_style.setDecoration(static_cast<skia::textlayout::TextDecoration>(decoration));
+        if (decoration_argb != 0) {
+            text_style.setDecorationColor(to_sk_color(decoration_argb));
+        }
+        text_style.setDecorationStyle(static_cast<skia::textlayout::TextDecorationStyle>(decoration_style));
+        if (decoration_thickness > 0) {
+            text_style.setDecorationThicknessMultiplier(decoration_thickness);
+        }
+    }
     SkFontStyle::Slant slant = (style == 1) ? SkFontStyle::kItalic_Slant : SkFontStyle::kUpright_Slant;
     text_style.setFontStyle(SkFontStyle(std::clamp(weight, 100, 900), SkFontStyle::kNormal_Width, slant));
-    if (family && family[0] != '\0') {
-        std::vector<SkString> families;
-        families.emplace_back(family);
-        text_style.setFontFamilies(families);
-    }
+    text_style.setFontFamilies(paragraph_families(family));
     auto typeface = resolve_typeface(family, weight, style);
+    apply_font_settings(text_style, typeface, font_features, font_variations);
     if (typeface) {
         text_style.setTypeface(typeface);
     }
@@ -1194,6 +1434,70 @@ int drift_skia_paragraph_get_line_metrics(DriftSkiaParagraph paragraph, float* w
     return 1;
 }
 
+int drift_skia_paragraph_did_exceed_max_lines(DriftSkiaParagraph paragraph) {
+    if (!paragraph) {
+        return 0;
+    }
+    return reinterpret_cast<skia::textlayout::Paragraph*>(paragraph)->didExceedMaxLines() ? 1 : 0;
+}
+
+int drift_skia_paragraph_get_glyph_index_at(DriftSkiaParagraph paragraph, float x, float y) {
+    if (!paragraph) {
+        return -1;
+    }
+    auto sk_paragraph = reinterpret_cast<skia::textlayout::Paragraph*>(paragraph);
+    auto position = sk_paragraph->getGlyphPositionAtCoordinate(x, y);
+    int index = position.position;
+    if (position.affinity == skia::textlayout::Affinity::kUpstream) {
+        index--;
+    }
+    if (index < 0) {
+        return -1;
+    }
+    // The nearest caret position is reported even past the end of a line;
+    // only count the point if it lies on the glyph itself.
+    auto boxes = sk_paragraph->getRectsForRange(
+        index, index + 1,
+        skia::textlayout::RectHeightStyle::kMax,
+        skia::textlayout::RectWidthStyle::kTight);
+    for (const auto& box : boxes) {
+        if (box.rect.contains(x, y)) {
+            return index;
+        }
+    }
+    return -1;
+}
+
+int drift_skia_paragraph_get_caret_index_at(DriftSkiaParagraph paragraph, float x, float y) {
+    if (!paragraph) {
+        return -1;
+    }
+    auto position = reinterpret_cast<skia::textlayout::Paragraph*>(paragraph)->getGlyphPositionAtCoordinate(x, y);
+    return position.position;
+}
+
+int drift_skia_paragraph_get_rects_for_range(DriftSkiaParagraph paragraph, int start, int end, float* out_rects, int max_rects) {
+    if (!paragraph || start >= end) {
+        return 0;
+    }
+    auto boxes = reinterpret_cast<skia::textlayout::Paragraph*>(paragraph)->getRectsForRange(
+        start, end,
+        skia::textlayout::RectHeightStyle::kMax,
+        skia::textlayout::RectWidthStyle::kTight);
+    int count = static_cast<int>(boxes.size());
+    if (!out_rects) {
+        return count;
+    }
+    int written = count < max_rects ? count : max_rects;
+    for (int i = 0; i < written; i++) {
+        out_rects[i * 4] = boxes[i].rect.left();
+        out_rects[i * 4 + 1] = boxes[i].rect.top();
+        out_rects[i * 4 + 2] = boxes[i].rect.right();
+        out_rects[i * 4 + 3] = boxes[i].rect.bottom();
+    }
+    return count;
+}
+
 void drift_skia_paragraph_paint(DriftSkiaParagraph paragraph, DriftSkiaCanvas canvas, float x, float y) {
     if (!paragraph || !canvas) {
         return;
@@ -1262,6 +1566,53 @@ void drift_skia_canvas_draw_path(
     reinterpret_cast<SkCanvas*>(canvas)->drawPath(drift_skia_path_snapshot(path), paint);
 }
 
+void drift_skia_canvas_draw_vertices(
+    DriftSkiaCanvas canvas, int mode,
+    const float* positions, const float* tex_coords, const uint32_t* colors, int vertex_count,
+    const uint16_t* indices, int index_count,
+    int vertex_blend_mode,
+    uint32_t argb, int blend_mode, float alpha,
+    int gradient_type,
+    float x1, float y1, float x2, float y2,
+    float rcx, float rcy, float rradius,
+    const uint32_t* gradient_colors, const float* gradient_positions, int gradient_count
+) {
+    if (!canvas || !positions || vertex_count < 3) {
+        return;
+    }
+    SkVertices::VertexMode vertex_mode;
+    switch (mode) {
+        case 1:
+            vertex_mode = SkVertices::kTriangleStrip_VertexMode;
+            break;
+        case 2:
+            vertex_mode = SkVertices::kTriangleFan_VertexMode;
+            break;
+        default:
+            vertex_mode = SkVertices::kTriangles_VertexMode;
+            break;
+    }
+    // SkPoint is two floats and SkColor is ARGB, matching the Go layout.
+    auto vertices = SkVertices::MakeCopy(
+        vertex_mode, vertex_count,
+        reinterpret_cast<const SkPoint*>(positions),
+        reinterpret_cast<const SkPoint*>(tex_coords),
+        reinterpret_cast<const SkColor*>(colors),
+        indices ? index_count : 0, indices);
+    if (!vertices) {
+        return;
+    }
+    SkPaint paint = make_paint_ext(argb, 0, 0, 1,
+        0, 0, 4, nullptr, 0, 0,
+        blend_mode, alpha);
+    auto shader = make_gradient_shader(gradient_type, x1, y1, x2, y2, rcx, rcy, rradius,
+        gradient_colors, gradient_positions, gradient_count);
+    if (shader) {
+        paint.setShader(shader);
+    }
+    reinterpret_cast<SkCanvas*>(canvas)->drawVertices(vertices, static_cast<SkBlendMode>(vertex_blend_mode), paint);
+}
+
 void drift_skia_canvas_draw_rect_shadow(
     DriftSkiaCanvas canvas,
     float l, float t, float r, float b,
@@ -13,4 +13,12 @@ sk_sp<SkFontMgr> drift_get_font_manager();
 // Returns the platform fallback font name ("SF Pro Text" on Apple, "sans-serif" on Android).
 const char* drift_platform_fallback_font();
 
+// Returns the platform color emoji family ("Apple Color Emoji" on Apple,
+// "Noto Color Emoji" on Android), appended to every paragraph's fallbacks.
+const char* drift_platform_emoji_font();
+
+// Returns the file holding the platform emoji font, loaded when the font
+// manager doesn't list drift_platform_emoji_font() by name, or nullptr.
+const char* drift_platform_emoji_font_path();
+
 #endif  // DRIFT_SKIA_COMMON_INTERNAL_H
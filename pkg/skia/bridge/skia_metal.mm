@@ -49,6 +49,14 @@ const char* drift_platform_fallback_font() {
     return "SF Pro Text";
 }
 
+const char* drift_platform_emoji_font() {
+    return "Apple Color Emoji";
+}
+
+const char* drift_platform_emoji_font_path() {
+    return nullptr;  // Core Text matches the emoji font by name.
+}
+
 // ═══════════════════════════════════════════════════════════════════════════
 // Metal-specific functions
 // ═══════════════════════════════════════════════════════════════════════════
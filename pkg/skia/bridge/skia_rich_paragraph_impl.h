@@ -18,12 +18,9 @@ inline skia::textlayout::TextStyle span_to_text_style_impl(const DriftTextSpan&
     SkFontStyle::Slant slant = (span.style == 1) ? SkFontStyle::kItalic_Slant : SkFontStyle::kUpright_Slant;
     int weight = std::clamp(span.weight > 0 ? span.weight : 400, 100, 900);
     text_style.setFontStyle(SkFontStyle(weight, SkFontStyle::kNormal_Width, slant));
-    if (span.family && span.family[0] != '\0') {
-        std::vector<SkString> families;
-        families.emplace_back(span.family);
-        text_style.setFontFamilies(families);
-    }
+    text_style.setFontFamilies(paragraph_families(span.family));
     auto typeface = resolve_typeface(span.family, weight, span.style);
+    apply_font_settings(text_style, typeface, span.font_features, span.font_variations);
     if (typeface) {
         text_style.setTypeface(typeface);
     }
@@ -44,6 +41,9 @@ inline skia::textlayout::TextStyle span_to_text_style_impl(const DriftTextSpan&
             text_style.setDecorationColor(to_sk_color(span.decoration_color));
         }
         text_style.setDecorationStyle(static_cast<skia::textlayout::TextDecorationStyle>(span.decoration_style));
+        if (span.decoration_thickness > 0) {
+            text_style.setDecorationThicknessMultiplier(span.decoration_thickness);
+        }
     }
     if (span.has_background != 0) {
         SkPaint bg;
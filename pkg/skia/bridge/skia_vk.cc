@@ -60,6 +60,16 @@ const char* drift_platform_fallback_font() {
     return "sans-serif";
 }
 
+const char* drift_platform_emoji_font() {
+    return "Noto Color Emoji";
+}
+
+const char* drift_platform_emoji_font_path() {
+    // Android declares its emoji font as an unnamed fallback family, so the
+    // NDK font manager can't match it by name.
+    return "/system/fonts/NotoColorEmoji.ttf";
+}
+
 // ═══════════════════════════════════════════════════════════════════════════
 // Vulkan-specific functions
 // ═══════════════════════════════════════════════════════════════════════════
@@ -0,0 +1,112 @@
+package skia
+
+import (
+	"runtime"
+	"sync/atomic"
+
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+// HandleCounts reports numbers of native Skia objects by kind.
+type HandleCounts struct {
+	Surfaces   int64 `json:"surfaces"`
+	Paragraphs int64 `json:"paragraphs"`
+	Paths      int64 `json:"paths"`
+	SVGs       int64 `json:"svgs"`
+	Animations int64 `json:"animations"`
+}
+
+// handleKind counts the live and leaked objects of one wrapper type.
+type handleKind struct {
+	name   string
+	live   atomic.Int64
+	leaked atomic.Int64
+}
+
+var (
+	surfaceHandles   = handleKind{name: "*skia.Surface"}
+	paragraphHandles = handleKind{name: "*skia.Paragraph"}
+	pathHandles      = handleKind{name: "*skia.Path"}
+	svgHandles       = handleKind{name: "*skia.SVGDOM"}
+	animationHandles = handleKind{name: "*skia.Skottie"}
+)
+
+var (
+	leakTracking atomic.Bool
+	// leakTrackingUsed stays set once tracking is enabled, so objects
+	// created while it was on still have their finalizers cleared.
+	leakTrackingUsed atomic.Bool
+)
+
+// LiveHandles returns the number of native objects of each kind that were
+// created but not yet destroyed. A count that keeps growing while the app
+// is idle points at a leak of CGO-backed resources, which the Go heap does
+// not show. It is always zero on platforms without Skia.
+func LiveHandles() HandleCounts {
+	return HandleCounts{
+		Surfaces:   surfaceHandles.live.Load(),
+		Paragraphs: paragraphHandles.live.Load(),
+		Paths:      pathHandles.live.Load(),
+		SVGs:       svgHandles.live.Load(),
+		Animations: animationHandles.live.Load(),
+	}
+}
+
+// SetLeakTracking enables or disables leak tracking. While enabled, each
+// Surface, Paragraph, Path, SVGDOM, and Skottie records the stack that
+// created it, and one garbage collected without Destroy is reported through
+// errors.Report with that stack and recorded for errors.Leaks. Its native
+// memory is not freed, since the renderer may still be using it.
+//
+// Capturing stacks makes creating objects noticeably slower, so enable this
+// only while hunting leaks. Objects created while tracking is off are never
+// reported.
+func SetLeakTracking(enabled bool) {
+	leakTracking.Store(enabled)
+	if enabled {
+		leakTrackingUsed.Store(true)
+	}
+}
+
+// LeakTrackingEnabled reports whether leak tracking is enabled.
+func LeakTrackingEnabled() bool {
+	return leakTracking.Load()
+}
+
+// LeakedHandles returns the number of objects of each kind that leak
+// tracking has seen garbage collected without Destroy.
+func LeakedHandles() HandleCounts {
+	return HandleCounts{
+		Surfaces:   surfaceHandles.leaked.Load(),
+		Paragraphs: paragraphHandles.leaked.Load(),
+		Paths:      pathHandles.leaked.Load(),
+		SVGs:       svgHandles.leaked.Load(),
+		Animations: animationHandles.leaked.Load(),
+	}
+}
+
+// trackHandle counts a newly created object and, with leak tracking on,
+// arranges for a report if it is collected without releaseHandle. It
+// returns obj so constructors can return through it.
+func trackHandle[T any](kind *handleKind, obj *T) *T {
+	kind.live.Add(1)
+	if leakTracking.Load() {
+		stack := errors.CaptureStack()
+		runtime.SetFinalizer(obj, func(*T) {
+			kind.leaked.Add(1)
+			errors.RecordLeak(errors.LeakReport{
+				Kind:          kind.name,
+				CreationStack: stack,
+			})
+		})
+	}
+	return obj
+}
+
+// releaseHandle counts an object as destroyed.
+func releaseHandle[T any](kind *handleKind, obj *T) {
+	kind.live.Add(-1)
+	if leakTrackingUsed.Load() {
+		runtime.SetFinalizer(obj, nil)
+	}
+}
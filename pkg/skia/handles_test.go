@@ -0,0 +1,69 @@
+package skia
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+type testHandle struct {
+	_ [16]byte
+}
+
+type quietHandler struct{}
+
+func (quietHandler) HandleError(*errors.DriftError)            {}
+func (quietHandler) HandlePanic(*errors.PanicError)            {}
+func (quietHandler) HandleBoundaryError(*errors.BoundaryError) {}
+
+func TestLeakTracking_ReportsCollectedHandles(t *testing.T) {
+	SetLeakTracking(true)
+	errors.ClearLeaks()
+	errors.SetHandler(quietHandler{})
+	t.Cleanup(func() {
+		SetLeakTracking(false)
+		errors.ClearLeaks()
+		errors.SetHandler(nil)
+	})
+	testHandles := &handleKind{name: "*skia.testHandle"}
+
+	released := trackHandle(testHandles, &testHandle{})
+	releaseHandle(testHandles, released)
+	trackHandle(testHandles, &testHandle{})
+
+	deadline := time.Now().Add(2 * time.Second)
+	for testHandles.leaked.Load() == 0 && time.Now().Before(deadline) {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+	}
+	// Give a wrongly reported released handle a chance to show up too.
+	runtime.GC()
+	time.Sleep(10 * time.Millisecond)
+
+	if got := testHandles.leaked.Load(); got != 1 {
+		t.Fatalf("expected 1 leaked handle, got %d", got)
+	}
+	if got := testHandles.live.Load(); got != 1 {
+		t.Errorf("expected the leaked handle to stay live, got %d", got)
+	}
+	leaks := errors.Leaks()
+	if len(leaks) != 1 || leaks[0].Kind != "*skia.testHandle" || leaks[0].CreationStack == "" {
+		t.Errorf("expected one leak report with a creation stack, got %+v", leaks)
+	}
+}
+
+func TestLeakTracking_DisabledSkipsFinalizers(t *testing.T) {
+	var kind handleKind
+	trackHandle(&kind, &testHandle{})
+	runtime.GC()
+	time.Sleep(10 * time.Millisecond)
+	runtime.GC()
+	if got := kind.leaked.Load(); got != 0 {
+		t.Errorf("expected no leaks while tracking is off, got %d", got)
+	}
+	if got := kind.live.Load(); got != 1 {
+		t.Errorf("expected live count without tracking, got %d", got)
+	}
+}
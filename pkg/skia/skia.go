@@ -35,6 +35,7 @@ import "C"
 
 import (
 	"errors"
+	"strings"
 	"unsafe"
 )
 
@@ -148,7 +149,7 @@ func (c *Context) MakeOffscreenSurfaceMetal(width, height int) (*Surface, error)
 	if surface == nil {
 		return nil, errors.New("skia: failed to create offscreen Metal surface")
 	}
-	return &Surface{ptr: surface, ctx: c}, nil
+	return trackHandle(&surfaceHandles, &Surface{ptr: surface, ctx: c}), nil
 }
 
 // MakeVulkanSurface creates a Skia surface wrapping the provided VkImage.
@@ -160,7 +161,7 @@ func (c *Context) MakeVulkanSurface(width, height int, vkImage uintptr, vkFormat
 	if surface == nil {
 		return nil, errors.New("skia: failed to create Vulkan surface")
 	}
-	return &Surface{ptr: surface, ctx: c}, nil
+	return trackHandle(&surfaceHandles, &Surface{ptr: surface, ctx: c}), nil
 }
 
 // MakeOffscreenSurfaceVulkan creates a GPU-backed offscreen surface for Vulkan.
@@ -172,7 +173,7 @@ func (c *Context) MakeOffscreenSurfaceVulkan(width, height int) (*Surface, error
 	if surface == nil {
 		return nil, errors.New("skia: failed to create offscreen Vulkan surface")
 	}
-	return &Surface{ptr: surface, ctx: c}, nil
+	return trackHandle(&surfaceHandles, &Surface{ptr: surface, ctx: c}), nil
 }
 
 // MakeMetalSurface creates a Skia surface targeting the provided Metal texture.
@@ -187,7 +188,32 @@ func (c *Context) MakeMetalSurface(texture unsafe.Pointer, width, height int) (*
 	if surface == nil {
 		return nil, errors.New("skia: failed to create Metal surface")
 	}
-	return &Surface{ptr: surface, ctx: c}, nil
+	return trackHandle(&surfaceHandles, &Surface{ptr: surface, ctx: c}), nil
+}
+
+// MakeRasterSurface creates a CPU-backed surface that needs no GPU context,
+// for rendering offscreen in programs without a window, such as servers.
+func MakeRasterSurface(width, height int) (*Surface, error) {
+	surface := C.drift_skia_surface_create_raster(C.int(width), C.int(height))
+	if surface == nil {
+		return nil, errors.New("skia: failed to create raster surface")
+	}
+	return trackHandle(&surfaceHandles, &Surface{ptr: surface}), nil
+}
+
+// ReadPixels copies the surface's pixels into dst as unpremultiplied RGBA,
+// with stride bytes per row. dst must hold height rows.
+func (s *Surface) ReadPixels(dst []byte, width, height, stride int) error {
+	if s == nil || s.ptr == nil {
+		return errors.New("skia: nil surface")
+	}
+	if width <= 0 || height <= 0 || stride < width*4 || len(dst) < (height-1)*stride+width*4 {
+		return errors.New("skia: pixel buffer too small")
+	}
+	if C.drift_skia_surface_read_pixels(s.ptr, (*C.uint8_t)(unsafe.Pointer(&dst[0])), C.int(width), C.int(height), C.int(stride)) == 0 {
+		return errors.New("skia: failed to read surface pixels")
+	}
+	return nil
 }
 
 // Canvas returns the underlying Skia canvas pointer.
@@ -214,6 +240,7 @@ func (s *Surface) Destroy() {
 	}
 	C.drift_skia_surface_destroy(s.ptr)
 	s.ptr = nil
+	releaseHandle(&surfaceHandles, s)
 }
 
 // CanvasSave pushes the canvas state.
@@ -615,6 +642,52 @@ func CanvasDrawPathGradient(
 	)
 }
 
+// CanvasDrawVertices draws a triangle mesh. Positions and texCoords hold
+// x, y pairs; texCoords and colors are optional but must have one entry per
+// vertex when set. Vertex colors are combined with the gradient, if any,
+// using vertexBlendMode.
+func CanvasDrawVertices(
+	canvas unsafe.Pointer,
+	mode int32,
+	positions, texCoords []float32, colors []uint32,
+	indices []uint16,
+	vertexBlendMode int32,
+	argb uint32, blendMode int32, alpha float32,
+	gradientType int32,
+	startX, startY, endX, endY float32,
+	centerX, centerY, radius float32,
+	gradientColors []uint32, gradientPositions []float32,
+) {
+	vertexCount := len(positions) / 2
+	if vertexCount < 3 {
+		return
+	}
+	var texPtr *C.float
+	if len(texCoords) == len(positions) {
+		texPtr = (*C.float)(unsafe.Pointer(&texCoords[0]))
+	}
+	var colorPtr *C.uint
+	if len(colors) == vertexCount {
+		colorPtr = (*C.uint)(unsafe.Pointer(&colors[0]))
+	}
+	var indexPtr *C.uint16_t
+	if len(indices) > 0 {
+		indexPtr = (*C.uint16_t)(unsafe.Pointer(&indices[0]))
+	}
+	cColors, cPositions, count := gradientData(gradientColors, gradientPositions)
+	C.drift_skia_canvas_draw_vertices(
+		C.DriftSkiaCanvas(canvas), C.int(mode),
+		(*C.float)(unsafe.Pointer(&positions[0])), texPtr, colorPtr, C.int(vertexCount),
+		indexPtr, C.int(len(indices)),
+		C.int(vertexBlendMode),
+		C.uint(argb), C.int(blendMode), C.float(alpha),
+		C.int(gradientType),
+		C.float(startX), C.float(startY), C.float(endX), C.float(endY),
+		C.float(centerX), C.float(centerY), C.float(radius),
+		cColors, cPositions, count,
+	)
+}
+
 // CanvasDrawTextGradient draws UTF-8 text with a gradient shader.
 func CanvasDrawTextGradient(
 	canvas unsafe.Pointer,
@@ -728,6 +801,13 @@ func NewParagraph(
 	positions []float32,
 	shadow *ParagraphShadow,
 	textAlign int,
+	letterSpacing float32,
+	height float32,
+	wordSpacing float32,
+	decoration ParagraphDecoration,
+	ellipsis string,
+	fontFeatures string,
+	fontVariations string,
 ) (*Paragraph, error) {
 	cstr := C.CString(text)
 	defer C.free(unsafe.Pointer(cstr))
@@ -736,6 +816,20 @@ func NewParagraph(
 		cfamily = C.CString(family)
 		defer C.free(unsafe.Pointer(cfamily))
 	}
+	var cellipsis *C.char
+	if ellipsis != "" {
+		cellipsis = C.CString(ellipsis)
+		defer C.free(unsafe.Pointer(cellipsis))
+	}
+	var cfeatures, cvariations *C.char
+	if fontFeatures != "" {
+		cfeatures = C.CString(fontFeatures)
+		defer C.free(unsafe.Pointer(cfeatures))
+	}
+	if fontVariations != "" {
+		cvariations = C.CString(fontVariations)
+		defer C.free(unsafe.Pointer(cvariations))
+	}
 	var shadowEnabled C.int
 	var shadowColor C.uint
 	var shadowDx C.float
@@ -774,11 +868,21 @@ func NewParagraph(
 		shadowDy,
 		shadowSigma,
 		C.int(textAlign),
+		C.float(letterSpacing),
+		C.float(height),
+		C.float(wordSpacing),
+		C.int(decoration.Decoration),
+		C.uint(decoration.Color),
+		C.int(decoration.Style),
+		C.float(decoration.Thickness),
+		cellipsis,
+		cfeatures,
+		cvariations,
 	)
 	if paragraph == nil {
 		return nil, errors.New("skia: failed to create paragraph")
 	}
-	return &Paragraph{ptr: paragraph}, nil
+	return trackHandle(&paragraphHandles, &Paragraph{ptr: paragraph}), nil
 }
 
 // NewRichParagraph creates a paragraph with multiple styled spans.
@@ -804,6 +908,7 @@ func NewRichParagraph(spans []TextSpanData, maxLines int, textAlign int) (*Parag
 		cSpans[i].decoration = C.int(s.Decoration)
 		cSpans[i].decoration_color = C.uint32_t(s.DecorationColor)
 		cSpans[i].decoration_style = C.int(s.DecorationStyle)
+		cSpans[i].decoration_thickness = C.float(s.DecorationThickness)
 		cSpans[i].letter_spacing = C.float(s.LetterSpacing)
 		cSpans[i].word_spacing = C.float(s.WordSpacing)
 		cSpans[i].height = C.float(s.Height)
@@ -811,6 +916,16 @@ func NewRichParagraph(spans []TextSpanData, maxLines int, textAlign int) (*Parag
 			cSpans[i].has_background = 1
 		}
 		cSpans[i].background_color = C.uint32_t(s.BackgroundColor)
+		if s.FontFeatures != "" {
+			cFeatures := C.CString(s.FontFeatures)
+			cStrings = append(cStrings, cFeatures)
+			cSpans[i].font_features = cFeatures
+		}
+		if s.FontVariations != "" {
+			cVariations := C.CString(s.FontVariations)
+			cStrings = append(cStrings, cVariations)
+			cSpans[i].font_variations = cVariations
+		}
 	}
 	defer func() {
 		for _, cs := range cStrings {
@@ -826,7 +941,7 @@ func NewRichParagraph(spans []TextSpanData, maxLines int, textAlign int) (*Parag
 	if paragraph == nil {
 		return nil, errors.New("skia: failed to create rich paragraph")
 	}
-	return &Paragraph{ptr: paragraph}, nil
+	return trackHandle(&paragraphHandles, &Paragraph{ptr: paragraph}), nil
 }
 
 // Layout lays out the paragraph within the given width.
@@ -897,6 +1012,48 @@ func (p *Paragraph) LineMetrics() (ParagraphLineMetrics, error) {
 	return out, nil
 }
 
+// DidExceedMaxLines reports whether the last layout dropped lines (or
+// ellipsized the last line) because of the paragraph's max lines limit.
+func (p *Paragraph) DidExceedMaxLines() bool {
+	if p == nil || p.ptr == nil {
+		return false
+	}
+	return C.drift_skia_paragraph_did_exceed_max_lines(p.ptr) != 0
+}
+
+// GlyphIndexAt returns the UTF-16 index of the character whose glyph
+// contains the point (x, y), or -1 if the point is not on a glyph.
+func (p *Paragraph) GlyphIndexAt(x, y float32) int {
+	if p == nil || p.ptr == nil {
+		return -1
+	}
+	return int(C.drift_skia_paragraph_get_glyph_index_at(p.ptr, C.float(x), C.float(y)))
+}
+
+// CaretIndexAt returns the UTF-16 index of the caret position nearest to the
+// point (x, y), or -1 if the paragraph is empty.
+func (p *Paragraph) CaretIndexAt(x, y float32) int {
+	if p == nil || p.ptr == nil {
+		return -1
+	}
+	return int(C.drift_skia_paragraph_get_caret_index_at(p.ptr, C.float(x), C.float(y)))
+}
+
+// RectsForRange returns the boxes covering the UTF-16 range [start, end),
+// one per line run, as left, top, right, bottom quadruples.
+func (p *Paragraph) RectsForRange(start, end int) []float32 {
+	if p == nil || p.ptr == nil || start >= end {
+		return nil
+	}
+	count := int(C.drift_skia_paragraph_get_rects_for_range(p.ptr, C.int(start), C.int(end), nil, 0))
+	if count <= 0 {
+		return nil
+	}
+	out := make([]float32, count*4)
+	count = int(C.drift_skia_paragraph_get_rects_for_range(p.ptr, C.int(start), C.int(end), (*C.float)(unsafe.Pointer(&out[0])), C.int(count)))
+	return out[:min(count, len(out)/4)*4]
+}
+
 // Paint renders the paragraph to the canvas at the given position.
 func (p *Paragraph) Paint(canvas unsafe.Pointer, x, y float32) {
 	if p == nil || p.ptr == nil || canvas == nil {
@@ -912,6 +1069,7 @@ func (p *Paragraph) Destroy() {
 	}
 	C.drift_skia_paragraph_destroy(p.ptr)
 	p.ptr = nil
+	releaseHandle(&paragraphHandles, p)
 }
 
 // TextMetrics reports font metrics for a typeface.
@@ -938,6 +1096,15 @@ func RegisterFont(name string, data []byte) error {
 	return nil
 }
 
+// SetFontFallbacks sets the families tried, in order, for glyphs missing
+// from a paragraph's own font families. The platform color emoji font is
+// always tried last.
+func SetFontFallbacks(families []string) {
+	clist := C.CString(strings.Join(families, ","))
+	defer C.free(unsafe.Pointer(clist))
+	C.drift_skia_set_font_fallbacks(clist)
+}
+
 // MeasureTextWidth returns the advance width for the text.
 func MeasureTextWidth(text, family string, size float64, weight int, style int) (float64, error) {
 	var width C.float
@@ -995,7 +1162,11 @@ const (
 // NewPath creates a new empty path with the specified fill type.
 // Use FillTypeWinding (0) for nonzero winding rule, FillTypeEvenOdd (1) for even-odd rule.
 func NewPath(fillType int) *Path {
-	return &Path{ptr: C.drift_skia_path_create(C.int(fillType))}
+	ptr := C.drift_skia_path_create(C.int(fillType))
+	if ptr == nil {
+		return &Path{}
+	}
+	return trackHandle(&pathHandles, &Path{ptr: ptr})
 }
 
 // Destroy releases the path.
@@ -1005,6 +1176,7 @@ func (p *Path) Destroy() {
 	}
 	C.drift_skia_path_destroy(p.ptr)
 	p.ptr = nil
+	releaseHandle(&pathHandles, p)
 }
 
 // MoveTo starts a new subpath at the given point.
@@ -1140,7 +1312,7 @@ func NewSVGDOM(data []byte) *SVGDOM {
 	if ptr == nil {
 		return nil
 	}
-	return &SVGDOM{ptr: ptr}
+	return trackHandle(&svgHandles, &SVGDOM{ptr: ptr})
 }
 
 // NewSVGDOMWithBase creates an SVGDOM with a base path for resolving relative resources.
@@ -1162,7 +1334,7 @@ func NewSVGDOMWithBase(data []byte, basePath string) *SVGDOM {
 	if ptr == nil {
 		return nil
 	}
-	return &SVGDOM{ptr: ptr}
+	return trackHandle(&svgHandles, &SVGDOM{ptr: ptr})
 }
 
 // Destroy releases the SVG DOM resources.
@@ -1176,6 +1348,7 @@ func (s *SVGDOM) Destroy() {
 	}
 	C.drift_skia_svg_dom_destroy(s.ptr)
 	s.ptr = nil
+	releaseHandle(&svgHandles, s)
 }
 
 // Ptr returns the underlying C handle for use in DrawSVG.
@@ -1274,7 +1447,7 @@ func NewSkottie(data []byte) *Skottie {
 	if ptr == nil {
 		return nil
 	}
-	return &Skottie{ptr: ptr}
+	return trackHandle(&animationHandles, &Skottie{ptr: ptr})
 }
 
 // Destroy releases the Skottie animation resources.
@@ -1284,6 +1457,7 @@ func (s *Skottie) Destroy() {
 	}
 	C.drift_skia_skottie_destroy(s.ptr)
 	s.ptr = nil
+	releaseHandle(&animationHandles, s)
 }
 
 // Ptr returns the underlying C handle for use in DrawLottie.
@@ -35,6 +35,8 @@ DriftSkiaSurface drift_skia_surface_create_vulkan(
 DriftSkiaCanvas drift_skia_surface_get_canvas(DriftSkiaSurface surface);
 void drift_skia_surface_flush(DriftSkiaContext ctx, DriftSkiaSurface surface);
 void drift_skia_surface_destroy(DriftSkiaSurface surface);
+DriftSkiaSurface drift_skia_surface_create_raster(int width, int height);
+int drift_skia_surface_read_pixels(DriftSkiaSurface surface, uint8_t* pixels, int width, int height, int stride);
 
 void drift_skia_canvas_save(DriftSkiaCanvas canvas);
 void drift_skia_canvas_save_layer_alpha(DriftSkiaCanvas canvas, float l, float t, float r, float b, uint8_t alpha);
@@ -228,11 +230,25 @@ DriftSkiaParagraph drift_skia_paragraph_create(
     float shadow_dx,
     float shadow_dy,
     float shadow_sigma,
-    int text_align
+    int text_align,
+    float letter_spacing,
+    float height,
+    float word_spacing,
+    int decoration,
+    uint32_t decoration_argb,
+    int decoration_style,
+    float decoration_thickness,
+    const char* ellipsis,
+    const char* font_features,
+    const char* font_variations
 );
 void drift_skia_paragraph_layout(DriftSkiaParagraph paragraph, float width);
 int drift_skia_paragraph_get_metrics(DriftSkiaParagraph paragraph, float* height, float* longest_line, float* max_intrinsic_width, int* line_count);
 int drift_skia_paragraph_get_line_metrics(DriftSkiaParagraph paragraph, float* widths, float* ascents, float* descents, float* heights, int count);
+int drift_skia_paragraph_did_exceed_max_lines(DriftSkiaParagraph paragraph);
+int drift_skia_paragraph_get_glyph_index_at(DriftSkiaParagraph paragraph, float x, float y);
+int drift_skia_paragraph_get_caret_index_at(DriftSkiaParagraph paragraph, float x, float y);
+int drift_skia_paragraph_get_rects_for_range(DriftSkiaParagraph paragraph, int start, int end, float* out_rects, int max_rects);
 void drift_skia_paragraph_paint(DriftSkiaParagraph paragraph, DriftSkiaCanvas canvas, float x, float y);
 void drift_skia_paragraph_destroy(DriftSkiaParagraph paragraph);
 
@@ -246,11 +262,14 @@ typedef struct {
     int decoration;
     uint32_t decoration_color;
     int decoration_style;
+    float decoration_thickness;
     float letter_spacing;
     float word_spacing;
     float height;
     int has_background;
     uint32_t background_color;
+    const char* font_features;
+    const char* font_variations;
 } DriftTextSpan;
 
 DriftSkiaParagraph drift_skia_rich_paragraph_create(
@@ -261,6 +280,7 @@ DriftSkiaParagraph drift_skia_rich_paragraph_create(
 );
 
 int drift_skia_register_font(const char* name, const uint8_t* data, int length);
+void drift_skia_set_font_fallbacks(const char* families);
 int drift_skia_measure_text(const char* text, const char* family, float size, int weight, int style, float* width);
 int drift_skia_font_metrics(const char* family, float size, int weight, int style, float* ascent, float* descent, float* leading);
 
@@ -279,6 +299,18 @@ void drift_skia_canvas_draw_path(
     int blend_mode, float alpha
 );
 
+void drift_skia_canvas_draw_vertices(
+    DriftSkiaCanvas canvas, int mode,
+    const float* positions, const float* tex_coords, const uint32_t* colors, int vertex_count,
+    const uint16_t* indices, int index_count,
+    int vertex_blend_mode,
+    uint32_t argb, int blend_mode, float alpha,
+    int gradient_type,
+    float x1, float y1, float x2, float y2,
+    float rcx, float rcy, float rradius,
+    const uint32_t* gradient_colors, const float* gradient_positions, int gradient_count
+);
+
 void drift_skia_canvas_draw_rect_shadow(
     DriftSkiaCanvas canvas,
     float l, float t, float r, float b,
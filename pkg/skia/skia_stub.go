@@ -99,6 +99,16 @@ func (s *Surface) Flush() {}
 // Destroy releases the surface.
 func (s *Surface) Destroy() {}
 
+// MakeRasterSurface creates a CPU-backed surface (stub).
+func MakeRasterSurface(width, height int) (*Surface, error) {
+	return nil, errStubNotSupported
+}
+
+// ReadPixels copies the surface's pixels into dst (stub).
+func (s *Surface) ReadPixels(dst []byte, width, height, stride int) error {
+	return errStubNotSupported
+}
+
 // CanvasSave pushes the canvas state.
 func CanvasSave(canvas unsafe.Pointer) {}
 
@@ -276,6 +286,24 @@ func CanvasDrawPathGradient(
 ) {
 }
 
+// CanvasDrawVertices draws a triangle mesh. Positions and texCoords hold
+// x, y pairs; texCoords and colors are optional but must have one entry per
+// vertex when set. Vertex colors are combined with the gradient, if any,
+// using vertexBlendMode.
+func CanvasDrawVertices(
+	canvas unsafe.Pointer,
+	mode int32,
+	positions, texCoords []float32, colors []uint32,
+	indices []uint16,
+	vertexBlendMode int32,
+	argb uint32, blendMode int32, alpha float32,
+	gradientType int32,
+	startX, startY, endX, endY float32,
+	centerX, centerY, radius float32,
+	gradientColors []uint32, gradientPositions []float32,
+) {
+}
+
 // CanvasDrawTextGradient draws UTF-8 text with a gradient shader.
 func CanvasDrawTextGradient(
 	canvas unsafe.Pointer,
@@ -330,6 +358,13 @@ func NewParagraph(
 	positions []float32,
 	shadow *ParagraphShadow,
 	textAlign int,
+	letterSpacing float32,
+	height float32,
+	wordSpacing float32,
+	decoration ParagraphDecoration,
+	ellipsis string,
+	fontFeatures string,
+	fontVariations string,
 ) (*Paragraph, error) {
 	return nil, errStubNotSupported
 }
@@ -352,6 +387,18 @@ func (p *Paragraph) LineMetrics() (ParagraphLineMetrics, error) {
 	return ParagraphLineMetrics{}, errStubNotSupported
 }
 
+// DidExceedMaxLines reports whether the last layout exceeded the max lines limit.
+func (p *Paragraph) DidExceedMaxLines() bool { return false }
+
+// GlyphIndexAt returns the UTF-16 index of the character at (x, y).
+func (p *Paragraph) GlyphIndexAt(x, y float32) int { return -1 }
+
+// CaretIndexAt returns the UTF-16 index of the caret nearest to (x, y).
+func (p *Paragraph) CaretIndexAt(x, y float32) int { return -1 }
+
+// RectsForRange returns the boxes covering the UTF-16 range [start, end).
+func (p *Paragraph) RectsForRange(start, end int) []float32 { return nil }
+
 // Paint renders the paragraph to the canvas at the given position.
 func (p *Paragraph) Paint(canvas unsafe.Pointer, x, y float32) {}
 
@@ -370,6 +417,10 @@ func RegisterFont(name string, data []byte) error {
 	return errStubNotSupported
 }
 
+// SetFontFallbacks sets the families tried, in order, for glyphs missing
+// from a paragraph's own font families.
+func SetFontFallbacks(families []string) {}
+
 // MeasureTextWidth returns the advance width for the text.
 func MeasureTextWidth(text, family string, size float64, weight int, style int) (float64, error) {
 	return 0, errStubNotSupported
@@ -11,9 +11,25 @@ type TextSpanData struct {
 	Decoration      int
 	DecorationColor uint32
 	DecorationStyle int
-	LetterSpacing   float32
-	WordSpacing     float32
-	Height          float32
-	HasBackground   bool
-	BackgroundColor uint32
+	// DecorationThickness multiplies the font's default decoration
+	// thickness; 0 uses the default.
+	DecorationThickness float32
+	LetterSpacing       float32
+	WordSpacing         float32
+	Height              float32
+	HasBackground       bool
+	BackgroundColor     uint32
+	// FontFeatures and FontVariations are "tag=value" lists separated by
+	// commas, or empty for the font's defaults.
+	FontFeatures   string
+	FontVariations string
+}
+
+// ParagraphDecoration describes the decoration line of a single-style
+// paragraph. Decoration holds Skia's decoration bits; zero draws none.
+type ParagraphDecoration struct {
+	Decoration int
+	Color      uint32
+	Style      int
+	Thickness  float32
 }
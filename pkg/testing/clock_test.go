@@ -4,8 +4,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/testing/internal/testbed"
+	"github.com/go-drift/drift/pkg/widgets"
 )
 
 func TestFakeClock_Advance(t *testing.T) {
@@ -111,3 +113,42 @@ func TestPumpAndSettle_AnimatedBox(t *testing.T) {
 		t.Errorf("expected settle after animation completes, got: %v", err)
 	}
 }
+
+// sourcesProbe reports its build context so tests can read the injected
+// clock and random source.
+type sourcesProbe struct {
+	core.StatelessBase
+	onBuild func(ctx core.BuildContext)
+}
+
+func (p sourcesProbe) Build(ctx core.BuildContext) core.Widget {
+	p.onBuild(ctx)
+	return widgets.SizedBox{}
+}
+
+func TestWidgetTester_ProvidesClockAndRand(t *testing.T) {
+	draw := func(seed uint64) (time.Time, int) {
+		var now time.Time
+		var n int
+		tester := NewWidgetTesterWithT(t)
+		tester.SetRandSeed(seed)
+		tester.PumpWidget(sourcesProbe{onBuild: func(ctx core.BuildContext) {
+			now = core.ClockOf(ctx).Now()
+			n = core.RandOf(ctx).IntN(1 << 30)
+		}})
+		if !now.Equal(tester.Clock().Now()) {
+			t.Errorf("expected the fake clock time %v, got %v", tester.Clock().Now(), now)
+		}
+		return now, n
+	}
+
+	_, first := draw(1)
+	_, second := draw(1)
+	_, other := draw(2)
+	if first != second {
+		t.Errorf("expected the same value for the same seed, got %d and %d", first, second)
+	}
+	if first == other {
+		t.Errorf("expected different values for different seeds, got %d", first)
+	}
+}
@@ -138,6 +138,23 @@ func (c *serializingCanvas) DrawPath(_ *graphics.Path, paint graphics.Paint) {
 	})
 }
 
+func (c *serializingCanvas) DrawVertices(vertices *graphics.Vertices, mode graphics.BlendMode, paint graphics.Paint) {
+	if vertices.Validate() != nil {
+		return
+	}
+	c.ops = append(c.ops, DisplayOp{
+		Op: "drawVertices",
+		Params: sortedMap(
+			"mode", vertices.Mode.String(),
+			"vertices", len(vertices.Positions),
+			"indices", len(vertices.Indices),
+			"colors", len(vertices.Colors) > 0,
+			"bounds", serializeRect(vertices.Bounds()),
+			"color", serializeColor(paint.Color),
+		),
+	})
+}
+
 func (c *serializingCanvas) DrawText(_ *graphics.TextLayout, position graphics.Offset) {
 	c.ops = append(c.ops, DisplayOp{
 		Op:     "drawText",
@@ -39,6 +39,26 @@
 //	tester.Clock().Advance(100 * time.Millisecond)
 //	tester.Pump()
 //
+// # Stress Testing
+//
+// Run workers on background goroutines while frames are pumped, with strict
+// mode reporting UI calls made off the UI thread:
+//
+//	err := tester.Stress(drifttest.StressOptions{Goroutines: 4},
+//	    func(i int) { counter.Set(i) },
+//	)
+//
+// Run stress tests with go test -race to catch data races.
+//
+// # Frame Budgets
+//
+// Profile a run of frames and check each phase against a budget:
+//
+//	profile, _ := tester.ProfileFrames(120, scroll)
+//	err := profile.CheckBudget(drifttest.FrameBudget{Build: 2 * time.Millisecond})
+//
+// BenchmarkFrames reports the same phase timings from a benchmark.
+//
 // # Import Alias
 //
 // Since this package has the same name as the standard library testing
@@ -0,0 +1,182 @@
+package testing
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// FrameTimings is the wall-clock time one pumped frame spent in each phase.
+type FrameTimings struct {
+	Dispatch time.Duration
+	Animate  time.Duration
+	Build    time.Duration
+	Layout   time.Duration
+	Paint    time.Duration
+	Total    time.Duration
+}
+
+// LastFrame returns the phase timings of the most recent Pump.
+func (t *WidgetTester) LastFrame() FrameTimings {
+	return t.lastFrame
+}
+
+// FrameProfile holds the timings of a run of frames.
+type FrameProfile struct {
+	Frames []FrameTimings
+}
+
+// ProfileFrames pumps the given number of frames and records their timings.
+// Before each frame it calls step (which may be nil) with the frame index,
+// then advances the fake clock by 16ms, so step can drive the widget
+// through the interaction being measured.
+func (t *WidgetTester) ProfileFrames(frames int, step func(frame int)) (FrameProfile, error) {
+	profile := FrameProfile{Frames: make([]FrameTimings, 0, frames)}
+	for i := range frames {
+		if err := t.profileFrame(i, step); err != nil {
+			return profile, err
+		}
+		profile.Frames = append(profile.Frames, t.lastFrame)
+	}
+	return profile, nil
+}
+
+// BenchmarkFrames pumps b.N frames like [WidgetTester.ProfileFrames], so
+// ns/op is the time per frame, and reports the mean time of each phase as
+// extra metrics (build-ns/frame, layout-ns/frame, and so on):
+//
+//	func BenchmarkFeedScroll(b *testing.B) {
+//	    tester := drifttest.NewWidgetTester()
+//	    defer tester.Cleanup()
+//	    tester.PumpWidget(Feed{})
+//	    tester.BenchmarkFrames(b, func(i int) { scrollBy(tester, 10) })
+//	}
+func (t *WidgetTester) BenchmarkFrames(b *testing.B, step func(frame int)) FrameProfile {
+	b.Helper()
+	profile := FrameProfile{Frames: make([]FrameTimings, 0, b.N)}
+	b.ResetTimer()
+	for i := range b.N {
+		if err := t.profileFrame(i, step); err != nil {
+			b.Fatal(err)
+		}
+		profile.Frames = append(profile.Frames, t.lastFrame)
+	}
+	b.StopTimer()
+
+	mean := profile.Mean()
+	b.ReportMetric(float64(mean.Dispatch), "dispatch-ns/frame")
+	b.ReportMetric(float64(mean.Animate), "animate-ns/frame")
+	b.ReportMetric(float64(mean.Build), "build-ns/frame")
+	b.ReportMetric(float64(mean.Layout), "layout-ns/frame")
+	b.ReportMetric(float64(mean.Paint), "paint-ns/frame")
+	return profile
+}
+
+func (t *WidgetTester) profileFrame(frame int, step func(frame int)) error {
+	if step != nil {
+		step(frame)
+	}
+	t.clock.Advance(16 * time.Millisecond)
+	return t.Pump()
+}
+
+// Mean returns the mean time of each phase.
+func (p FrameProfile) Mean() FrameTimings {
+	if len(p.Frames) == 0 {
+		return FrameTimings{}
+	}
+	var sum FrameTimings
+	for _, f := range p.Frames {
+		sum.Dispatch += f.Dispatch
+		sum.Animate += f.Animate
+		sum.Build += f.Build
+		sum.Layout += f.Layout
+		sum.Paint += f.Paint
+		sum.Total += f.Total
+	}
+	n := time.Duration(len(p.Frames))
+	return FrameTimings{
+		Dispatch: sum.Dispatch / n,
+		Animate:  sum.Animate / n,
+		Build:    sum.Build / n,
+		Layout:   sum.Layout / n,
+		Paint:    sum.Paint / n,
+		Total:    sum.Total / n,
+	}
+}
+
+// Percentile returns the time of each phase at quantile q, from 0 (the
+// fastest frame) to 1 (the slowest). Each phase is ranked on its own, so
+// the result need not match any single frame.
+func (p FrameProfile) Percentile(q float64) FrameTimings {
+	pick := func(get func(FrameTimings) time.Duration) time.Duration {
+		if len(p.Frames) == 0 {
+			return 0
+		}
+		values := make([]time.Duration, len(p.Frames))
+		for i, f := range p.Frames {
+			values[i] = get(f)
+		}
+		slices.Sort(values)
+		i := int(math.Ceil(min(max(q, 0), 1)*float64(len(values)))) - 1
+		return values[max(i, 0)]
+	}
+	return FrameTimings{
+		Dispatch: pick(func(f FrameTimings) time.Duration { return f.Dispatch }),
+		Animate:  pick(func(f FrameTimings) time.Duration { return f.Animate }),
+		Build:    pick(func(f FrameTimings) time.Duration { return f.Build }),
+		Layout:   pick(func(f FrameTimings) time.Duration { return f.Layout }),
+		Paint:    pick(func(f FrameTimings) time.Duration { return f.Paint }),
+		Total:    pick(func(f FrameTimings) time.Duration { return f.Total }),
+	}
+}
+
+// FrameBudget is the time each frame phase may take. Zero fields are not
+// checked.
+type FrameBudget struct {
+	Dispatch time.Duration
+	Animate  time.Duration
+	Build    time.Duration
+	Layout   time.Duration
+	Paint    time.Duration
+	Total    time.Duration
+
+	// Percentile is the quantile of frames that must meet the budget. Zero
+	// means 0.9, so a few frames slowed by GC or scheduling noise do not
+	// fail the check.
+	Percentile float64
+}
+
+// CheckBudget compares the profile against budget and returns an error
+// naming every phase over it, or nil if all phases are within budget.
+//
+//	profile, _ := tester.ProfileFrames(120, scroll)
+//	if err := profile.CheckBudget(drifttest.FrameBudget{Build: 2 * time.Millisecond}); err != nil {
+//	    t.Error(err)
+//	}
+func (p FrameProfile) CheckBudget(budget FrameBudget) error {
+	q := budget.Percentile
+	if q <= 0 {
+		q = 0.9
+	}
+	got := p.Percentile(q)
+	var over []string
+	check := func(name string, limit, actual time.Duration) {
+		if limit > 0 && actual > limit {
+			over = append(over, fmt.Sprintf("%s %v > %v", name, actual, limit))
+		}
+	}
+	check("dispatch", budget.Dispatch, got.Dispatch)
+	check("animate", budget.Animate, got.Animate)
+	check("build", budget.Build, got.Build)
+	check("layout", budget.Layout, got.Layout)
+	check("paint", budget.Paint, got.Paint)
+	check("total", budget.Total, got.Total)
+	if len(over) == 0 {
+		return nil
+	}
+	return fmt.Errorf("frame budget exceeded at p%g over %d frames: %s", q*100, len(p.Frames), strings.Join(over, ", "))
+}
@@ -0,0 +1,86 @@
+package testing
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/testing/internal/testbed"
+)
+
+func TestProfileFrames_RecordsPhases(t *testing.T) {
+	tester := NewWidgetTesterWithT(t)
+	if err := tester.PumpWidget(testbed.Counter{}); err != nil {
+		t.Fatal(err)
+	}
+
+	steps := 0
+	profile, err := tester.ProfileFrames(5, func(frame int) { steps++ })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profile.Frames) != 5 || steps != 5 {
+		t.Fatalf("expected 5 frames and steps, got %d and %d", len(profile.Frames), steps)
+	}
+	for _, f := range profile.Frames {
+		if f.Total <= 0 || f.Total < f.Build+f.Layout+f.Paint {
+			t.Errorf("expected the total to cover the phases, got %+v", f)
+		}
+	}
+	if tester.LastFrame() != profile.Frames[4] {
+		t.Error("expected LastFrame to match the final profiled frame")
+	}
+}
+
+func TestFrameProfile_Percentile(t *testing.T) {
+	var profile FrameProfile
+	for i := 1; i <= 10; i++ {
+		profile.Frames = append(profile.Frames, FrameTimings{
+			Build: time.Duration(i) * time.Millisecond,
+			// Layout runs in the opposite order, so each phase is ranked on
+			// its own.
+			Layout: time.Duration(11-i) * time.Millisecond,
+		})
+	}
+
+	p90 := profile.Percentile(0.9)
+	if p90.Build != 9*time.Millisecond || p90.Layout != 9*time.Millisecond {
+		t.Errorf("expected p90 of 9ms for both phases, got %+v", p90)
+	}
+	if got := profile.Percentile(0).Build; got != time.Millisecond {
+		t.Errorf("expected the fastest frame at p0, got %v", got)
+	}
+	if got := profile.Percentile(1).Build; got != 10*time.Millisecond {
+		t.Errorf("expected the slowest frame at p100, got %v", got)
+	}
+	if got := profile.Mean().Build; got != 5500*time.Microsecond {
+		t.Errorf("expected a mean of 5.5ms, got %v", got)
+	}
+}
+
+func TestFrameProfile_CheckBudget(t *testing.T) {
+	var profile FrameProfile
+	for i := 1; i <= 10; i++ {
+		profile.Frames = append(profile.Frames, FrameTimings{Build: time.Duration(i) * time.Millisecond})
+	}
+
+	if err := profile.CheckBudget(FrameBudget{Build: 9 * time.Millisecond}); err != nil {
+		t.Errorf("expected p90 to be within budget, got %v", err)
+	}
+	err := profile.CheckBudget(FrameBudget{Build: 9 * time.Millisecond, Percentile: 1})
+	if err == nil || !strings.Contains(err.Error(), "build 10ms > 9ms") {
+		t.Errorf("expected the slowest frame to exceed the budget, got %v", err)
+	}
+	if err := profile.CheckBudget(FrameBudget{}); err != nil {
+		t.Errorf("expected an empty budget to pass, got %v", err)
+	}
+}
+
+func BenchmarkFrames_Counter(b *testing.B) {
+	tester := NewWidgetTester()
+	defer tester.Cleanup()
+	if err := tester.PumpWidget(testbed.Counter{}); err != nil {
+		b.Fatal(err)
+	}
+	tester.BenchmarkFrames(b, nil)
+}
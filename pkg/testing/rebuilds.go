@@ -0,0 +1,33 @@
+package testing
+
+import "github.com/go-drift/drift/pkg/core"
+
+// RebuildCount returns how many times the elements matched by finder built
+// again since they were mounted or since the last ResetRebuildCounts. The
+// initial build is not counted. Use it to assert that an interaction only
+// rebuilds the widgets it should:
+//
+//	tester.ResetRebuildCounts()
+//	tester.Tap(drifttest.ByKey("increment"))
+//	tester.Pump()
+//	if n := tester.RebuildCount(drifttest.ByType[Header]()); n != 0 {
+//	    t.Errorf("Header rebuilt %d times", n)
+//	}
+func (t *WidgetTester) RebuildCount(finder Finder) int {
+	count := 0
+	for _, e := range t.Find(finder).All() {
+		count += core.RebuildInfoOf(e).Count
+	}
+	return count
+}
+
+// RebuildInfo returns the rebuild counts of the first element matched by
+// finder, broken down by what triggered them. Panics if nothing matches.
+func (t *WidgetTester) RebuildInfo(finder Finder) core.RebuildInfo {
+	return core.RebuildInfoOf(t.Find(finder).First())
+}
+
+// ResetRebuildCounts clears the rebuild counts of every element in the tree.
+func (t *WidgetTester) ResetRebuildCounts() {
+	core.ResetRebuildCounts(t.root)
+}
@@ -0,0 +1,35 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/testing/internal/testbed"
+)
+
+func TestRebuildCount_CountsSetState(t *testing.T) {
+	tester := NewWidgetTesterWithT(t)
+	tester.PumpWidget(testbed.Counter{Initial: 0})
+
+	if n := tester.RebuildCount(ByType[testbed.Counter]()); n != 0 {
+		t.Fatalf("expected no rebuilds after mount, got %d", n)
+	}
+
+	for range 2 {
+		tester.Tap(ByType[testbed.Counter]())
+		tester.Pump()
+	}
+
+	if n := tester.RebuildCount(ByType[testbed.Counter]()); n != 2 {
+		t.Errorf("expected 2 rebuilds, got %d", n)
+	}
+	info := tester.RebuildInfo(ByType[testbed.Counter]())
+	if info.LastReason != core.RebuildReasonSetState {
+		t.Errorf("expected reason setState, got %v", info.LastReason)
+	}
+
+	tester.ResetRebuildCounts()
+	if n := tester.RebuildCount(ByType[testbed.Counter]()); n != 0 {
+		t.Errorf("expected reset counts, got %d", n)
+	}
+}
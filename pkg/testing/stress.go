@@ -0,0 +1,209 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	drifterrors "github.com/go-drift/drift/pkg/errors"
+)
+
+// StressOptions configures [WidgetTester.Stress].
+type StressOptions struct {
+	// Frames is the number of frames pumped while the workers run.
+	// Zero means 100.
+	Frames int
+	// FrameDuration is how far the fake clock advances between frames.
+	// Zero means 16ms.
+	FrameDuration time.Duration
+	// Goroutines is the number of goroutines started for each worker.
+	// Zero means 1.
+	Goroutines int
+	// CallsPerFrame is the number of times each goroutine calls its worker
+	// per frame. Zero means 10.
+	CallsPerFrame int
+}
+
+// StressWorker is one kind of background work for [WidgetTester.Stress]. It
+// is called repeatedly on its own goroutine with an increasing iteration
+// number until the frames are done.
+type StressWorker func(iteration int)
+
+// Stress pumps frames on the calling goroutine, which acts as the UI thread,
+// while workers run concurrently on other goroutines. It checks that the
+// widget tree and the state the workers touch follow Drift's threading
+// rules:
+//
+//   - Work that reaches the UI must go through [WidgetTester.Dispatch] (or
+//     drift.Dispatch / platform.Dispatch, which the tester routes to it).
+//   - Signals, notifiers, and platform services may be used from any
+//     goroutine, but listeners that call SetState must be notified on the
+//     UI thread.
+//   - Controllers and SetState must only be used on the UI thread.
+//
+// Strict mode is enabled for the duration of the run, so controller methods
+// and SetState called from a worker are reported as off-UI-thread
+// violations. Stress returns them, together with any panic from a worker or
+// a frame, as a single error. Data races are not detected by Stress itself:
+// run the test with go test -race so the race detector reports them.
+//
+//	err := tester.Stress(drifttest.StressOptions{Goroutines: 4},
+//	    func(i int) { tester.Dispatch(func() { controller.Forward() }) },
+//	    func(i int) { counter.Set(i) },
+//	)
+//
+// In every frame, each goroutine calls its worker CallsPerFrame times while
+// the frame is being pumped, so frames and workers always interleave. After
+// the workers stop, Stress pumps one more frame to drain the callbacks they
+// dispatched.
+func (t *WidgetTester) Stress(opts StressOptions, workers ...StressWorker) error {
+	frames := opts.Frames
+	if frames <= 0 {
+		frames = 100
+	}
+	frameDuration := opts.FrameDuration
+	if frameDuration <= 0 {
+		frameDuration = 16 * time.Millisecond
+	}
+	goroutines := max(opts.Goroutines, 1)
+	callsPerFrame := opts.CallsPerFrame
+	if callsPerFrame <= 0 {
+		callsPerFrame = 10
+	}
+
+	var (
+		mu       sync.Mutex
+		failures []error
+	)
+	fail := func(err error) {
+		mu.Lock()
+		failures = append(failures, err)
+		mu.Unlock()
+	}
+
+	prevStrict := drifterrors.StrictModeEnabled()
+	drifterrors.SetStrictMode(true)
+	prevHandler := drifterrors.DefaultHandler
+	drifterrors.SetHandler(&stressErrorHandler{ErrorHandler: prevHandler, fail: fail})
+	exitUIScope := drifterrors.EnterUIScope()
+	defer func() {
+		exitUIScope()
+		drifterrors.SetHandler(prevHandler)
+		drifterrors.SetStrictMode(prevStrict)
+	}()
+
+	// Workers run in lockstep with frames: in each frame, every goroutine
+	// makes up to CallsPerFrame calls concurrently with the frame and then
+	// waits for the next one. completed counts the frames each goroutine has
+	// finished its calls for; goroutines that exit early are set to the
+	// maximum so they never hold up a frame.
+	var (
+		frameMu   sync.Mutex
+		nextFrame = make(chan struct{})
+	)
+	currentFrame := func() <-chan struct{} {
+		frameMu.Lock()
+		defer frameMu.Unlock()
+		return nextFrame
+	}
+	startFrame := func() {
+		frameMu.Lock()
+		close(nextFrame)
+		nextFrame = make(chan struct{})
+		frameMu.Unlock()
+	}
+
+	completed := make([]atomic.Int64, len(workers)*goroutines)
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for w, worker := range workers {
+		for g := range goroutines {
+			counter := &completed[w*goroutines+g]
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer counter.Store(math.MaxInt64)
+				defer func() {
+					if r := recover(); r != nil {
+						fail(fmt.Errorf("worker %d goroutine %d panicked: %v", w, g, r))
+					}
+				}()
+				for i := 0; ; {
+					frame := currentFrame()
+					for range callsPerFrame {
+						worker(i)
+						i++
+					}
+					counter.Add(1)
+					select {
+					case <-stop:
+						return
+					case <-frame:
+					}
+				}
+			}()
+		}
+	}
+
+	pump := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("frame panicked: %v", r)
+			}
+		}()
+		return t.Pump()
+	}
+
+	marks := make([]int64, len(completed))
+	var pumpErr error
+	for range frames {
+		for i := range completed {
+			marks[i] = completed[i].Load()
+		}
+		startFrame()
+		if pumpErr = pump(); pumpErr != nil {
+			break
+		}
+		t.clock.Advance(frameDuration)
+		for i := range completed {
+			for marks[i] != math.MaxInt64 && completed[i].Load() <= marks[i] {
+				runtime.Gosched()
+			}
+		}
+	}
+	close(stop)
+	wg.Wait()
+	if pumpErr == nil {
+		pumpErr = pump()
+	}
+	if pumpErr != nil {
+		fail(pumpErr)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	return errors.Join(failures...)
+}
+
+// stressErrorHandler records strict mode violations and panics reported
+// while [WidgetTester.Stress] runs, and forwards everything else.
+type stressErrorHandler struct {
+	drifterrors.ErrorHandler
+	fail func(error)
+}
+
+func (h *stressErrorHandler) HandleError(err *drifterrors.DriftError) {
+	if err.Kind == drifterrors.KindStrictMode {
+		h.fail(err.Err)
+		return
+	}
+	h.ErrorHandler.HandleError(err)
+}
+
+func (h *stressErrorHandler) HandlePanic(err *drifterrors.PanicError) {
+	h.fail(err)
+}
@@ -0,0 +1,128 @@
+package testing
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// stressProbe rebuilds from a signal, a notifier, an animation controller,
+// and the media query, so the stress workers reach every path into the UI.
+type stressProbe struct {
+	core.StatefulBase
+	count    *core.Signal[int]
+	notifier *core.Notifier
+	ready    func(*animation.AnimationController)
+}
+
+func (p stressProbe) CreateState() core.State {
+	return &stressProbeState{}
+}
+
+type stressProbeState struct {
+	core.StateBase
+	controller *animation.AnimationController
+	notified   int
+}
+
+func (s *stressProbeState) InitState() {
+	w := s.Element().Widget().(stressProbe)
+	s.controller = animation.NewAnimationController(100 * time.Millisecond)
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+
+	// The signal and notifier change on worker goroutines, so their
+	// listeners hop to the UI thread before calling SetState.
+	s.OnDispose(w.count.AddListener(func() {
+		platform.Dispatch(func() { s.SetState(nil) })
+	}))
+	s.OnDispose(w.notifier.AddListener(func() {
+		platform.Dispatch(func() { s.SetState(func() { s.notified++ }) })
+	}))
+	w.ready(s.controller)
+}
+
+func (s *stressProbeState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(stressProbe)
+	dark := widgets.MediaQueryOf(ctx).DarkMode
+	return widgets.Text{Content: fmt.Sprintf("%d %d %.2f %v", w.count.Value(), s.notified, s.controller.Value, dark)}
+}
+
+func TestStress_ConcurrentUpdates(t *testing.T) {
+	tester := NewWidgetTesterWithT(t)
+	t.Cleanup(func() { platform.Appearance.SetAppearanceForTest(platform.SystemAppearance{}) })
+
+	count := core.NewSignal(0)
+	notifier := &core.Notifier{}
+	var controller *animation.AnimationController
+	err := tester.PumpWidget(widgets.MediaQueryProvider{Child: stressProbe{
+		count:    count,
+		notifier: notifier,
+		ready:    func(c *animation.AnimationController) { controller = c },
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = tester.Stress(StressOptions{Frames: 50, Goroutines: 4},
+		func(i int) {
+			tester.Dispatch(func() {
+				if i%2 == 0 {
+					controller.Forward()
+				} else {
+					controller.Reverse()
+				}
+			})
+		},
+		func(i int) { count.Set(i) },
+		func(i int) { notifier.Notify() },
+		func(i int) {
+			platform.Appearance.SetAppearanceForTest(platform.SystemAppearance{DarkMode: i%2 == 0})
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStress_ReportsControllerUseOffUIThread(t *testing.T) {
+	tester := NewWidgetTesterWithT(t)
+	if err := tester.PumpWidget(widgets.SizedBox{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The controller is not attached to the tree, so the direct call is a
+	// threading violation but not a data race.
+	controller := animation.NewAnimationController(time.Second)
+	defer controller.Dispose()
+
+	err := tester.Stress(StressOptions{Frames: 5},
+		func(i int) { controller.SetValue(0.5) },
+	)
+	if err == nil || !strings.Contains(err.Error(), "off_ui_thread") {
+		t.Fatalf("expected an off-UI-thread violation, got %v", err)
+	}
+}
+
+func TestStress_ReportsWorkerPanic(t *testing.T) {
+	tester := NewWidgetTesterWithT(t)
+	if err := tester.PumpWidget(widgets.SizedBox{}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := tester.Stress(StressOptions{Frames: 5},
+		func(i int) { panic("boom") },
+	)
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("expected the worker panic, got %v", err)
+	}
+}
@@ -2,11 +2,14 @@ package testing
 
 import (
 	"errors"
+	"math/rand/v2"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/go-drift/drift/pkg/animation"
 	"github.com/go-drift/drift/pkg/core"
+	drifterrors "github.com/go-drift/drift/pkg/errors"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
 	"github.com/go-drift/drift/pkg/platform"
@@ -38,8 +41,19 @@ type WidgetTester struct {
 	size       graphics.Size
 	scale      float64
 	theme      *theme.AppThemeData
-	dispatches []func()
+	randSeed   uint64
 	pointers   map[int]*pointerState
+
+	// dispatches is guarded by dispatchMu because Dispatch may be called
+	// from any goroutine.
+	dispatchMu sync.Mutex
+	dispatches []func()
+
+	prevAuditing        bool
+	prevRebuildTracking bool
+	checkLeaks          bool
+
+	lastFrame FrameTimings
 }
 
 // NewWidgetTester creates a tester with default test environment.
@@ -55,22 +69,52 @@ func NewWidgetTester() *WidgetTester {
 		pointers:   make(map[int]*pointerState),
 	}
 	t.prevClock = animation.SetClock(clk)
+	// Record per-element rebuilds for RebuildCount
+	t.prevRebuildTracking = core.RebuildTrackingEnabled()
+	core.SetRebuildTracking(true)
 	// Register this tester's dispatch function with the platform package
 	// so that platform.Dispatch works during tests
 	platform.RegisterDispatch(t.Dispatch)
+	// Give each tester empty in-memory preferences and secure storage
+	platform.UseInMemoryStorage(true)
 	return t
 }
 
 // NewWidgetTesterWithT creates a tester that auto-cleans up via t.Cleanup().
 // This is the recommended constructor for tests.
+//
+// The tester enables disposal auditing and fails the test if an animation
+// controller, ticker, or scroll controller created by a State is still
+// active after the tree is unmounted. Call IgnoreLeaks to opt out.
 func NewWidgetTesterWithT(t *testing.T) *WidgetTester {
 	tester := NewWidgetTester()
-	t.Cleanup(tester.Cleanup)
+	tester.prevAuditing = drifterrors.DisposalAuditingEnabled()
+	tester.checkLeaks = true
+	drifterrors.SetDisposalAuditing(true)
+	drifterrors.ClearLeaks()
+	t.Cleanup(func() {
+		tester.Cleanup()
+		leaks := drifterrors.Leaks()
+		drifterrors.SetDisposalAuditing(tester.prevAuditing)
+		drifterrors.ClearLeaks()
+		if !tester.checkLeaks {
+			return
+		}
+		for _, leak := range leaks {
+			t.Errorf("%v\ncreated at:\n%s", &leak, leak.CreationStack)
+		}
+	})
 	return tester
 }
 
-// Cleanup restores global state (animation clock). Must be called if
-// not using NewWidgetTesterWithT.
+// IgnoreLeaks disables the leak check that NewWidgetTesterWithT runs at
+// cleanup, for tests that deliberately leave controllers undisposed.
+func (t *WidgetTester) IgnoreLeaks() {
+	t.checkLeaks = false
+}
+
+// Cleanup restores global state (animation clock, platform storage). Must be
+// called if not using NewWidgetTesterWithT.
 func (t *WidgetTester) Cleanup() {
 	if t.root != nil {
 		t.root.Unmount()
@@ -78,6 +122,8 @@ func (t *WidgetTester) Cleanup() {
 		t.rootRender = nil
 	}
 	animation.SetClock(t.prevClock)
+	core.SetRebuildTracking(t.prevRebuildTracking)
+	platform.UseInMemoryStorage(false)
 }
 
 // SetSize sets the logical surface size. Must be called before PumpWidget.
@@ -95,7 +141,15 @@ func (t *WidgetTester) SetTheme(td *theme.AppThemeData) {
 	t.theme = td
 }
 
-// Clock returns the fake clock for advancing time in tests.
+// SetRandSeed sets the seed of the random source that [core.RandOf] returns
+// in the pumped tree. Must be called before PumpWidget. The default seed is
+// 0, so tests are deterministic without calling it.
+func (t *WidgetTester) SetRandSeed(seed uint64) {
+	t.randSeed = seed
+}
+
+// Clock returns the fake clock for advancing time in tests. It is also the
+// clock [core.ClockOf] returns in the pumped tree.
 func (t *WidgetTester) Clock() *FakeClock {
 	return t.clock
 }
@@ -109,12 +163,19 @@ func (t *WidgetTester) PumpWidget(widget core.Widget) error {
 		t.rootRender = nil
 	}
 
-	// Wrap in test scaffold: DeviceScale → AppTheme → user widget
+	// Wrap in test scaffold: DeviceScale → AppTheme → clock → random
+	// source → user widget
 	wrapped := widgets.DeviceScale{
 		Scale: t.scale,
 		Child: theme.AppTheme{
-			Data:  t.theme,
-			Child: widget,
+			Data: t.theme,
+			Child: core.InheritedProvider[core.Clock]{
+				Value: t.clock,
+				Child: core.InheritedProvider[rand.Source]{
+					Value: rand.NewPCG(t.randSeed, t.randSeed),
+					Child: widget,
+				},
+			},
 		},
 	}
 
@@ -135,30 +196,52 @@ func (t *WidgetTester) PumpWidget(widget core.Widget) error {
 }
 
 // Pump runs a single frame cycle: dispatches, tickers, build, layout, paint.
+// The wall-clock time spent in each phase is available from LastFrame.
 func (t *WidgetTester) Pump() error {
+	start := time.Now()
+	mark := start
+	phase := func() time.Duration {
+		now := time.Now()
+		d := now.Sub(mark)
+		mark = now
+		return d
+	}
+
 	// 1. Drain dispatch queue
+	t.dispatchMu.Lock()
 	dispatches := t.dispatches
 	t.dispatches = nil
+	t.dispatchMu.Unlock()
 	for _, fn := range dispatches {
 		fn()
 	}
+	t.lastFrame.Dispatch = phase()
 
 	// 2. Step ballistics and tickers
 	widgets.StepBallistics()
 	animation.StepTickers()
+	t.lastFrame.Animate = phase()
 
 	// 3. Flush build
 	t.buildOwner.FlushBuild()
+	t.lastFrame.Build = phase()
 
 	// 4. Flush layout
+	t.lastFrame.Layout, t.lastFrame.Paint = 0, 0
 	if t.rootRender != nil {
 		pipeline := t.buildOwner.Pipeline()
 		constraints := layout.Tight(t.size)
 		pipeline.FlushLayoutForRoot(t.rootRender, constraints)
+		t.lastFrame.Layout = phase()
 
 		// 5. Flush paint
 		pipeline.FlushPaint()
+		t.lastFrame.Paint = phase()
+
+		// 6. Report visibility changes
+		widgets.FlushVisibility()
 	}
+	t.lastFrame.Total = time.Since(start)
 
 	return nil
 }
@@ -184,15 +267,21 @@ func (t *WidgetTester) PumpAndSettle(timeout time.Duration) error {
 
 // needsWork returns true if the framework has pending work.
 func (t *WidgetTester) needsWork() bool {
-	return t.buildOwner.NeedsWork() ||
+	t.dispatchMu.Lock()
+	pending := len(t.dispatches) > 0
+	t.dispatchMu.Unlock()
+	return pending ||
+		t.buildOwner.NeedsWork() ||
 		animation.HasActiveTickers() ||
-		widgets.HasActiveBallistics() ||
-		len(t.dispatches) > 0
+		widgets.HasActiveBallistics()
 }
 
 // Dispatch queues a callback for the next frame, mirroring engine.Dispatch.
+// It is safe to call from any goroutine.
 func (t *WidgetTester) Dispatch(fn func()) {
+	t.dispatchMu.Lock()
 	t.dispatches = append(t.dispatches, fn)
+	t.dispatchMu.Unlock()
 }
 
 // RootElement returns the root element of the mounted tree.
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
 	"github.com/go-drift/drift/pkg/testing/internal/testbed"
 	"github.com/go-drift/drift/pkg/widgets"
 )
@@ -95,3 +96,14 @@ func TestDispatch(t *testing.T) {
 		t.Error("dispatch should have run after Pump")
 	}
 }
+
+func TestWidgetTester_InMemoryPreferences(t *testing.T) {
+	NewWidgetTesterWithT(t)
+
+	if err := platform.Preferences.SetInt("count", 3); err != nil {
+		t.Fatalf("SetInt: %v", err)
+	}
+	if got, err := platform.Preferences.GetInt("count"); err != nil || got != 3 {
+		t.Errorf("GetInt = %v, %v", got, err)
+	}
+}
@@ -0,0 +1,116 @@
+package theme
+
+import (
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// AnimatedTheme provides Data to its descendants like [Theme], but when Data
+// changes it blends from the old theme to the new one over Duration instead
+// of snapping, using [LerpThemeData]. Use it for dark-mode toggles and other
+// runtime theme switches.
+//
+// Below an [AppTheme], AnimatedTheme replaces the Material theme and keeps
+// the platform and Cupertino theme from the enclosing AppTheme.
+//
+// Example:
+//
+//	theme.AnimatedTheme{
+//	    Data:     data,
+//	    Duration: 300 * time.Millisecond,
+//	    Curve:    animation.EaseInOut,
+//	    Child:    app,
+//	}
+type AnimatedTheme struct {
+	core.StatefulBase
+
+	// Data is the theme to show, animating toward it when it changes.
+	Data *ThemeData
+	// Duration is the length of the animation.
+	Duration time.Duration
+	// Curve transforms the animation progress. If nil, uses linear interpolation.
+	Curve func(float64) float64
+	// OnEnd is called when the animation completes.
+	OnEnd func()
+	// Child is the child widget tree.
+	Child core.Widget
+}
+
+// CreateState implements core.StatefulWidget.
+func (AnimatedTheme) CreateState() core.State {
+	return &animatedThemeState{}
+}
+
+type animatedThemeState struct {
+	core.StateBase
+	controller *animation.AnimationController
+
+	// from is the theme the current animation started at; nil when idle.
+	from *ThemeData
+	// current is the theme shown by the last build.
+	current *ThemeData
+}
+
+func (s *animatedThemeState) InitState() {
+	w := s.Element().Widget().(AnimatedTheme)
+	s.controller = animation.NewAnimationController(w.Duration)
+	if w.Curve != nil {
+		s.controller.Curve = w.Curve
+	}
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+
+	s.controller.AddStatusListener(func(status animation.AnimationStatus) {
+		if status == animation.AnimationCompleted {
+			s.from = nil
+			w := s.Element().Widget().(AnimatedTheme)
+			if w.OnEnd != nil {
+				w.OnEnd()
+			}
+		}
+	})
+
+	s.current = w.Data
+}
+
+func (s *animatedThemeState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(AnimatedTheme)
+	w := s.Element().Widget().(AnimatedTheme)
+
+	s.controller.Duration = w.Duration
+	if w.Curve != nil {
+		s.controller.Curve = w.Curve
+	} else {
+		s.controller.Curve = animation.LinearCurve
+	}
+
+	if w.Data != old.Data {
+		// Start from what is on screen so a change mid-animation stays smooth.
+		s.from = s.current
+		s.controller.Reset()
+		s.controller.Forward()
+	}
+}
+
+func (s *animatedThemeState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(AnimatedTheme)
+	if s.from != nil {
+		s.current = LerpThemeData(s.from, w.Data, s.controller.Value)
+	} else {
+		s.current = w.Data
+	}
+
+	if parent := AppThemeMaybeOf(ctx); parent != nil {
+		return AppTheme{
+			Data: &AppThemeData{
+				Platform:  parent.Platform,
+				Material:  s.current,
+				Cupertino: parent.Cupertino,
+			},
+			Child: w.Child,
+		}
+	}
+	return Theme{Data: s.current, Child: w.Child}
+}
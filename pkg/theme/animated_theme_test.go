@@ -0,0 +1,94 @@
+package theme_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// themeHost shows an AnimatedTheme and lets the test swap its data.
+type themeHost struct {
+	core.StatefulBase
+	initial *theme.ThemeData
+	bind    func(setData func(*theme.ThemeData))
+	probe   func(*theme.ThemeData)
+}
+
+func (h themeHost) CreateState() core.State { return &themeHostState{} }
+
+type themeHostState struct {
+	core.StateBase
+	data *theme.ThemeData
+}
+
+func (s *themeHostState) InitState() {
+	h := s.Element().Widget().(themeHost)
+	s.data = h.initial
+	h.bind(func(data *theme.ThemeData) {
+		s.SetState(func() { s.data = data })
+	})
+}
+
+func (s *themeHostState) Build(ctx core.BuildContext) core.Widget {
+	h := s.Element().Widget().(themeHost)
+	return theme.AnimatedTheme{
+		Data:     s.data,
+		Duration: 200 * time.Millisecond,
+		Child:    themeProbe{probe: h.probe},
+	}
+}
+
+// themeProbe reports the theme it sees on every build.
+type themeProbe struct {
+	core.StatelessBase
+	probe func(*theme.ThemeData)
+}
+
+func (p themeProbe) Build(ctx core.BuildContext) core.Widget {
+	p.probe(theme.ThemeOf(ctx))
+	return widgets.SizedBox{}
+}
+
+func TestAnimatedTheme(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	light, dark := theme.DefaultLightTheme(), theme.DefaultDarkTheme()
+
+	var setData func(*theme.ThemeData)
+	var seen *theme.ThemeData
+	err := tester.PumpWidget(themeHost{
+		initial: light,
+		bind:    func(fn func(*theme.ThemeData)) { setData = fn },
+		probe:   func(data *theme.ThemeData) { seen = data },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seen != light {
+		t.Fatal("expected the initial theme without animating")
+	}
+
+	setData(dark)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	tester.Clock().Advance(100 * time.Millisecond)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	surface := seen.ColorScheme.Surface
+	if surface == light.ColorScheme.Surface || surface == dark.ColorScheme.Surface {
+		t.Errorf("Surface mid-animation = %v, want a blend of %v and %v",
+			surface, light.ColorScheme.Surface, dark.ColorScheme.Surface)
+	}
+
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if seen != dark {
+		t.Errorf("expected the new theme once settled, got surface %v", seen.ColorScheme.Surface)
+	}
+}
@@ -1,6 +1,7 @@
 package theme
 
 import (
+	"maps"
 	"reflect"
 
 	"github.com/go-drift/drift/pkg/core"
@@ -59,6 +60,10 @@ func (a *AppThemeData) Copy() *AppThemeData {
 			st := *a.Material.SwitchTheme
 			mc.SwitchTheme = &st
 		}
+		if a.Material.SliderTheme != nil {
+			sl := *a.Material.SliderTheme
+			mc.SliderTheme = &sl
+		}
 		if a.Material.TextFieldTheme != nil {
 			tf := *a.Material.TextFieldTheme
 			mc.TextFieldTheme = &tf
@@ -75,6 +80,31 @@ func (a *AppThemeData) Copy() *AppThemeData {
 			dt := *a.Material.DropdownTheme
 			mc.DropdownTheme = &dt
 		}
+		if a.Material.AppBarTheme != nil {
+			ab := *a.Material.AppBarTheme
+			mc.AppBarTheme = &ab
+		}
+		if a.Material.NavigationBarTheme != nil {
+			nb := *a.Material.NavigationBarTheme
+			mc.NavigationBarTheme = &nb
+		}
+		if a.Material.Spacing != nil {
+			sp := *a.Material.Spacing
+			mc.Spacing = &sp
+		}
+		if a.Material.Shapes != nil {
+			sh := *a.Material.Shapes
+			mc.Shapes = &sh
+		}
+		if a.Material.Elevation != nil {
+			el := *a.Material.Elevation
+			mc.Elevation = &el
+		}
+		if a.Material.Motion != nil {
+			mo := *a.Material.Motion
+			mc.Motion = &mo
+		}
+		mc.extensions = maps.Clone(a.Material.extensions)
 		c.Material = &mc
 	}
 	if a.Cupertino != nil {
@@ -108,6 +138,19 @@ func (a AppTheme) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
 		a.Data.Cupertino != old.Data.Cupertino
 }
 
+// ShouldRebuildDependent implements [core.AspectAwareInheritedWidget]; see
+// [ThemeAspect].
+func (a AppTheme) ShouldRebuildDependent(oldWidget core.InheritedWidget, aspects map[any]struct{}) bool {
+	old, ok := oldWidget.(AppTheme)
+	if !ok || a.Data == nil || old.Data == nil {
+		return true
+	}
+	if _, ok := aspects[ThemeAspectPlatform]; ok && a.Data.Platform != old.Data.Platform {
+		return true
+	}
+	return themeDataChanged(old.Data.Material, a.Data.Material, aspects)
+}
+
 var appThemeType = reflect.TypeFor[AppTheme]()
 
 // Cached default to avoid repeated allocations when no AppTheme is found.
@@ -129,7 +172,11 @@ func AppThemeOf(ctx core.BuildContext) *AppThemeData {
 // AppThemeMaybeOf returns the nearest AppThemeData, or nil if not found.
 // Returns nil if no AppTheme is found or if Data is nil.
 func AppThemeMaybeOf(ctx core.BuildContext) *AppThemeData {
-	inherited := ctx.DependOnInherited(appThemeType, nil)
+	return appThemeMaybeOf(ctx, nil)
+}
+
+func appThemeMaybeOf(ctx core.BuildContext, aspect any) *AppThemeData {
+	inherited := ctx.DependOnInherited(appThemeType, aspect)
 	if inherited == nil {
 		return nil
 	}
@@ -1,8 +1,11 @@
 package theme
 
 import (
+	"time"
+
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/widgets"
 )
 
 // ButtonThemeData defines default styling for Button widgets.
@@ -63,6 +66,22 @@ type SwitchThemeData struct {
 	Height float64
 }
 
+// SliderThemeData defines default styling for [widgets.Slider] widgets.
+type SliderThemeData struct {
+	// ActiveTrackColor is the track color between the minimum and the thumb.
+	ActiveTrackColor graphics.Color
+	// InactiveTrackColor is the track color between the thumb and the maximum.
+	InactiveTrackColor graphics.Color
+	// ThumbColor is the thumb fill color.
+	ThumbColor graphics.Color
+	// TrackHeight is the default track thickness.
+	TrackHeight float64
+	// ThumbRadius is the default thumb radius.
+	ThumbRadius float64
+	// Height is the default touch target height.
+	Height float64
+}
+
 // TextFieldThemeData defines default styling for TextField widgets.
 type TextFieldThemeData struct {
 	// BackgroundColor is the field background.
@@ -190,6 +209,18 @@ func DefaultSwitchTheme(colors ColorScheme) SwitchThemeData {
 	}
 }
 
+// DefaultSliderTheme returns SliderThemeData derived from a ColorScheme.
+func DefaultSliderTheme(colors ColorScheme) SliderThemeData {
+	return SliderThemeData{
+		ActiveTrackColor:   colors.Primary,
+		InactiveTrackColor: colors.SurfaceVariant,
+		ThumbColor:         colors.Primary,
+		TrackHeight:        4,
+		ThumbRadius:        10,
+		Height:             44,
+	}
+}
+
 // DefaultTextFieldTheme returns TextFieldThemeData derived from a ColorScheme.
 func DefaultTextFieldTheme(colors ColorScheme) TextFieldThemeData {
 	return TextFieldThemeData{
@@ -313,8 +344,8 @@ type DialogThemeData struct {
 	// BorderRadius is the corner radius in pixels.
 	// Default: 28 (Material 3).
 	BorderRadius float64
-	// Elevation is the shadow elevation level (1-5).
-	// Passed to [graphics.BoxShadowElevation]. Default: 3.
+	// Elevation is the shadow elevation level (0-5), drawn with the theme's
+	// [ElevationScheme]. Default: 3.
 	Elevation int
 	// Padding is the inner padding applied to the dialog container.
 	// Default: 24px on all sides.
@@ -361,3 +392,108 @@ func DefaultBottomSheetTheme(colors ColorScheme) BottomSheetThemeData {
 		HandleBottomPadding: 8,
 	}
 }
+
+// AppBarThemeData defines default styling for [widgets.AppBar] widgets,
+// including how the bar reacts when content scrolls underneath it.
+//
+// Override individual fields by setting AppBarTheme on [ThemeData]:
+//
+//	custom := theme.DefaultAppBarTheme(colors)
+//	custom.ScrolledUnderElevation = 0 // keep the bar flat
+//	themeData.AppBarTheme = &custom
+type AppBarThemeData struct {
+	// BackgroundColor is the bar surface color at rest.
+	// Default: ColorScheme.Surface.
+	BackgroundColor graphics.Color
+	// ForegroundColor is the title text color. Default: ColorScheme.OnSurface.
+	ForegroundColor graphics.Color
+	// SurfaceTintColor is blended into the background as the bar rises.
+	// Default: ColorScheme.SurfaceTint.
+	SurfaceTintColor graphics.Color
+	// ShadowColor is the elevation shadow color. Default: ColorScheme.Shadow.
+	ShadowColor graphics.Color
+	// Elevation is the elevation level (0-5) at rest. Default: 0.
+	Elevation float64
+	// ScrolledUnderElevation is the elevation level (0-5) while content is
+	// scrolled under the bar. Default: 2.
+	ScrolledUnderElevation float64
+	// Duration is the length of the elevation change.
+	// Default: [widgets.ScrollUnderDuration].
+	Duration time.Duration
+	// Height is the toolbar height, excluding the safe area. Default: 64.
+	Height float64
+	// Padding is the toolbar padding. Default: 16px horizontally.
+	Padding layout.EdgeInsets
+	// Spacing is the gap between leading widget, title, and actions.
+	// Default: 16.
+	Spacing float64
+}
+
+// DefaultAppBarTheme returns AppBarThemeData derived from a [ColorScheme].
+// Used when [ThemeData.AppBarTheme] is nil.
+func DefaultAppBarTheme(colors ColorScheme) AppBarThemeData {
+	return AppBarThemeData{
+		BackgroundColor:        colors.Surface,
+		ForegroundColor:        colors.OnSurface,
+		SurfaceTintColor:       colors.SurfaceTint,
+		ShadowColor:            colors.Shadow,
+		Elevation:              0,
+		ScrolledUnderElevation: 2,
+		Duration:               widgets.ScrollUnderDuration,
+		Height:                 64,
+		Padding:                layout.EdgeInsetsSymmetric(16, 0),
+		Spacing:                16,
+	}
+}
+
+// NavigationBarThemeData defines default styling for [widgets.NavigationBar]
+// and [widgets.NavigationRail] widgets.
+//
+// Override individual fields by setting NavigationBarTheme on [ThemeData]:
+//
+//	custom := theme.DefaultNavigationBarTheme(colors)
+//	custom.LabelBehavior = widgets.NavigationLabelOnlyShowSelected
+//	themeData.NavigationBarTheme = &custom
+type NavigationBarThemeData struct {
+	// BackgroundColor fills the bar or rail.
+	// Default: ColorScheme.SurfaceContainer.
+	BackgroundColor graphics.Color
+	// IndicatorColor fills the pill behind the selected icon.
+	// Default: ColorScheme.SecondaryContainer.
+	IndicatorColor graphics.Color
+	// SelectedColor is the selected icon and label color.
+	// Default: ColorScheme.OnSecondaryContainer.
+	SelectedColor graphics.Color
+	// UnselectedColor is the other icons' and labels' color.
+	// Default: ColorScheme.OnSurfaceVariant.
+	UnselectedColor graphics.Color
+	// BadgeColor fills badges. Default: ColorScheme.Error.
+	BadgeColor graphics.Color
+	// BadgeTextColor is the badge label color. Default: ColorScheme.OnError.
+	BadgeTextColor graphics.Color
+	// LabelBehavior controls which labels are shown. Default: always.
+	LabelBehavior widgets.NavigationLabelBehavior
+	// Height is the bar height, excluding the safe area. Default: 80.
+	Height float64
+	// RailWidth is the rail width, excluding the safe area. Default: 80.
+	RailWidth float64
+	// Duration is how long the indicator takes to grow in.
+	// Default: [MotionScheme.Short].
+	Duration time.Duration
+}
+
+// DefaultNavigationBarTheme returns NavigationBarThemeData derived from a
+// [ColorScheme]. Used when [ThemeData.NavigationBarTheme] is nil.
+func DefaultNavigationBarTheme(colors ColorScheme) NavigationBarThemeData {
+	return NavigationBarThemeData{
+		BackgroundColor: colors.SurfaceContainer,
+		IndicatorColor:  colors.SecondaryContainer,
+		SelectedColor:   colors.OnSecondaryContainer,
+		UnselectedColor: colors.OnSurfaceVariant,
+		BadgeColor:      colors.Error,
+		BadgeTextColor:  colors.OnError,
+		Height:          80,
+		RailWidth:       80,
+		Duration:        DefaultMotionScheme().Short,
+	}
+}
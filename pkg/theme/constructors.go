@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
 	"github.com/go-drift/drift/pkg/platform"
 	"github.com/go-drift/drift/pkg/widgets"
 )
@@ -272,6 +273,39 @@ func ToggleOf(ctx core.BuildContext, value bool, onChanged func(bool)) widgets.T
 	}
 }
 
+// SliderOf creates a [widgets.Slider] with visual properties filled from the
+// current theme's [SliderThemeData].
+//
+// This is the recommended way to create sliders that follow the app's theme.
+// The returned slider covers the range 0 to 1 and has:
+//   - ActiveColor set to SliderThemeData.ActiveTrackColor
+//   - InactiveColor set to SliderThemeData.InactiveTrackColor
+//   - ThumbColor set to SliderThemeData.ThumbColor
+//   - TrackHeight, ThumbRadius, and Height from SliderThemeData
+//
+// Fields set on the returned slider take precedence over the theme, so a
+// single slider can be restyled without changing the theme.
+//
+// Example:
+//
+//	theme.SliderOf(ctx, s.volume, func(value float64) {
+//	    s.SetState(func() { s.volume = value })
+//	}).WithRange(0, 100).WithDivisions(10)
+func SliderOf(ctx core.BuildContext, value float64, onChanged func(float64)) widgets.Slider {
+	th := ThemeOf(ctx).SliderThemeOf()
+	return widgets.Slider{
+		Value:         value,
+		Max:           1,
+		OnChanged:     onChanged,
+		ActiveColor:   th.ActiveTrackColor,
+		InactiveColor: th.InactiveTrackColor,
+		ThumbColor:    th.ThumbColor,
+		TrackHeight:   th.TrackHeight,
+		ThumbRadius:   th.ThumbRadius,
+		Height:        th.Height,
+	}
+}
+
 // RadioOf creates a [widgets.Radio] with visual properties filled from the
 // current theme's [RadioThemeData].
 //
@@ -305,6 +339,147 @@ func RadioOf[T comparable](ctx core.BuildContext, value, groupValue T, onChanged
 	}
 }
 
+// SegmentedControlOf creates a [widgets.SegmentedControl] with visual
+// properties filled from the current theme.
+//
+// The returned control has:
+//   - BackgroundColor set to ColorScheme.SurfaceVariant
+//   - ThumbColor set to ColorScheme.Surface
+//   - ThumbShadowColor set to ColorScheme.Shadow at 20% opacity
+//   - LabelStyle set to TextTheme.LabelLarge in ColorScheme.OnSurfaceVariant
+//   - SelectedLabelColor set to ColorScheme.OnSurface
+//   - Height 36, BorderRadius 9, ThumbInset 2, and Haptic enabled
+//
+// Example:
+//
+//	theme.SegmentedControlOf(ctx, []widgets.Segment[string]{
+//	    {Value: "day", Label: "Day"},
+//	    {Value: "week", Label: "Week"},
+//	}, s.period, func(value string) {
+//	    s.SetState(func() { s.period = value })
+//	})
+func SegmentedControlOf[T comparable](ctx core.BuildContext, segments []widgets.Segment[T], value T, onChanged func(T)) widgets.SegmentedControl[T] {
+	_, colors, textTheme := UseTheme(ctx)
+	style := textTheme.LabelLarge
+	style.Color = colors.OnSurfaceVariant
+	return widgets.SegmentedControl[T]{
+		Segments:           segments,
+		Value:              value,
+		OnChanged:          onChanged,
+		Haptic:             true,
+		BackgroundColor:    colors.SurfaceVariant,
+		ThumbColor:         colors.Surface,
+		ThumbShadowColor:   colors.Shadow.WithAlpha(0.2),
+		LabelStyle:         style,
+		SelectedLabelColor: colors.OnSurface,
+		Height:             36,
+		BorderRadius:       9,
+		ThumbInset:         2,
+	}
+}
+
+// ToggleButtonsOf creates a [widgets.ToggleButtons] with visual properties
+// filled from the current theme.
+//
+// The returned buttons have:
+//   - SelectedColor set to ColorScheme.SecondaryContainer
+//   - BorderColor set to ColorScheme.Outline, 1 wide
+//   - LabelStyle set to TextTheme.LabelLarge in ColorScheme.OnSurface
+//   - SelectedLabelColor set to ColorScheme.OnSecondaryContainer
+//   - Height 40, BorderRadius 20, Padding 12 horizontally, and Haptic
+//     enabled, following Material 3 segmented buttons
+//
+// Example:
+//
+//	theme.ToggleButtonsOf(ctx, []widgets.Segment[string]{
+//	    {Value: "bold", Label: "B"},
+//	    {Value: "italic", Label: "I"},
+//	}, s.styles, func(styles []string) {
+//	    s.SetState(func() { s.styles = styles })
+//	})
+func ToggleButtonsOf[T comparable](ctx core.BuildContext, segments []widgets.Segment[T], selected []T, onChanged func([]T)) widgets.ToggleButtons[T] {
+	_, colors, textTheme := UseTheme(ctx)
+	style := textTheme.LabelLarge
+	style.Color = colors.OnSurface
+	return widgets.ToggleButtons[T]{
+		Segments:           segments,
+		Selected:           selected,
+		OnChanged:          onChanged,
+		Haptic:             true,
+		SelectedColor:      colors.SecondaryContainer,
+		BorderColor:        colors.Outline,
+		BorderWidth:        1,
+		LabelStyle:         style,
+		SelectedLabelColor: colors.OnSecondaryContainer,
+		Height:             40,
+		BorderRadius:       20,
+		Padding:            layout.EdgeInsetsSymmetric(12, 0),
+	}
+}
+
+// RatingBarOf creates a five-star [widgets.RatingBar] with visual
+// properties filled from the current theme.
+//
+// The returned bar has:
+//   - ItemCount 5
+//   - FilledColor set to ColorScheme.Primary
+//   - EmptyColor set to ColorScheme.OutlineVariant
+//   - ItemSize 32 and Spacing 4
+//
+// Example:
+//
+//	bar := theme.RatingBarOf(ctx, s.rating, func(rating float64) {
+//	    s.SetState(func() { s.rating = rating })
+//	})
+//	bar.AllowHalf = true
+func RatingBarOf(ctx core.BuildContext, value float64, onChanged func(float64)) widgets.RatingBar {
+	_, colors, _ := UseTheme(ctx)
+	return widgets.RatingBar{
+		Value:       value,
+		ItemCount:   5,
+		OnChanged:   onChanged,
+		FilledColor: colors.Primary,
+		EmptyColor:  colors.OutlineVariant,
+		ItemSize:    32,
+		Spacing:     4,
+	}
+}
+
+// NumericStepperOf creates a [widgets.NumericStepper] over the range minValue
+// to maxValue with visual properties filled from the current theme.
+//
+// The returned stepper has:
+//   - BorderColor set to ColorScheme.Outline, 1 wide
+//   - IconColor set to ColorScheme.Primary
+//   - TextStyle set to TextTheme.BodyLarge in ColorScheme.OnSurface
+//   - Height 40, ButtonWidth 40, BorderRadius 8, and ValuePadding 12
+//     horizontally
+//
+// Example:
+//
+//	theme.NumericStepperOf(ctx, float64(s.quantity), 1, 99, func(value float64) {
+//	    s.SetState(func() { s.quantity = int(value) })
+//	})
+func NumericStepperOf(ctx core.BuildContext, value, minValue, maxValue float64, onChanged func(float64)) widgets.NumericStepper {
+	_, colors, textTheme := UseTheme(ctx)
+	style := textTheme.BodyLarge
+	style.Color = colors.OnSurface
+	return widgets.NumericStepper{
+		Value:        value,
+		Min:          minValue,
+		Max:          maxValue,
+		OnChanged:    onChanged,
+		BorderColor:  colors.Outline,
+		BorderWidth:  1,
+		IconColor:    colors.Primary,
+		TextStyle:    style,
+		Height:       40,
+		ButtonWidth:  40,
+		BorderRadius: 8,
+		ValuePadding: layout.EdgeInsetsSymmetric(12, 0),
+	}
+}
+
 // TabBarOf creates a [widgets.TabBar] with visual properties filled from the
 // current theme's [TabBarThemeData].
 //
@@ -349,6 +524,276 @@ func TabBarOf(ctx core.BuildContext, items []widgets.TabItem, currentIndex int,
 	}
 }
 
+// NavigationBarOf creates a [widgets.NavigationBar] with visual properties
+// filled from the current theme's [NavigationBarThemeData].
+//
+// The returned bar has:
+//   - Colors, LabelBehavior, Height, and Duration from NavigationBarThemeData
+//   - LabelStyle set to TextTheme.LabelMedium
+//
+// [navigation.TabNavigator] uses it when its Bar is
+// navigation.TabNavigatorNavigationBar.
+//
+// Example:
+//
+//	theme.NavigationBarOf(ctx, []widgets.TabItem{
+//	    {Label: "Inbox", Icon: inboxIcon, Badge: "3"},
+//	    {Label: "Search", Icon: searchIcon},
+//	}, s.index, func(i int) {
+//	    s.SetState(func() { s.index = i })
+//	})
+func NavigationBarOf(ctx core.BuildContext, items []widgets.TabItem, currentIndex int, onTap func(int)) widgets.NavigationBar {
+	th := ThemeOf(ctx).NavigationBarThemeOf()
+	_, _, textTheme := UseTheme(ctx)
+	return widgets.NavigationBar{
+		Items:           items,
+		CurrentIndex:    currentIndex,
+		OnTap:           onTap,
+		BackgroundColor: th.BackgroundColor,
+		IndicatorColor:  th.IndicatorColor,
+		SelectedColor:   th.SelectedColor,
+		UnselectedColor: th.UnselectedColor,
+		BadgeColor:      th.BadgeColor,
+		BadgeTextColor:  th.BadgeTextColor,
+		LabelStyle:      textTheme.LabelMedium,
+		LabelBehavior:   th.LabelBehavior,
+		Height:          th.Height,
+		Duration:        th.Duration,
+	}
+}
+
+// NavigationRailOf creates a [widgets.NavigationRail] with visual properties
+// filled from the current theme's [NavigationBarThemeData].
+//
+// The returned rail has:
+//   - Colors, LabelBehavior, and Duration from NavigationBarThemeData
+//   - Width set to NavigationBarThemeData.RailWidth
+//   - LabelStyle set to TextTheme.LabelMedium
+//   - Spacing 12
+//
+// Set Leading on the result to add a menu button above the destinations.
+// [navigation.TabNavigator] uses it on wide screens when RailMinWidth is set.
+//
+// Example:
+//
+//	widgets.Row{Children: []core.Widget{
+//	    theme.NavigationRailOf(ctx, items, s.index, s.selectTab),
+//	    widgets.Expanded{Child: pages[s.index]},
+//	}}
+func NavigationRailOf(ctx core.BuildContext, items []widgets.TabItem, currentIndex int, onTap func(int)) widgets.NavigationRail {
+	th := ThemeOf(ctx).NavigationBarThemeOf()
+	_, _, textTheme := UseTheme(ctx)
+	return widgets.NavigationRail{
+		Items:           items,
+		CurrentIndex:    currentIndex,
+		OnTap:           onTap,
+		BackgroundColor: th.BackgroundColor,
+		IndicatorColor:  th.IndicatorColor,
+		SelectedColor:   th.SelectedColor,
+		UnselectedColor: th.UnselectedColor,
+		BadgeColor:      th.BadgeColor,
+		BadgeTextColor:  th.BadgeTextColor,
+		LabelStyle:      textTheme.LabelMedium,
+		LabelBehavior:   th.LabelBehavior,
+		Width:           th.RailWidth,
+		Spacing:         12,
+		Duration:        th.Duration,
+	}
+}
+
+// AppBarOf creates a [widgets.AppBar] with visual properties filled from the
+// current theme's [AppBarThemeData].
+//
+// This is the recommended way to create app bars that follow the app's theme.
+// The returned app bar has:
+//   - Title set to a [widgets.Text] styled with TextTheme.TitleLarge in
+//     AppBarThemeData.ForegroundColor
+//   - BackgroundColor, SurfaceTintColor, and ShadowColor from AppBarThemeData
+//   - Elevation and ScrolledUnderElevation from AppBarThemeData
+//   - Duration, Height, Padding, and Spacing from AppBarThemeData
+//   - ForegroundColor from AppBarThemeData, for the back and overflow buttons
+//   - AutomaticallyImplyLeading set, so pushed pages get a back button
+//   - OverflowMenu from [PopupMenuOf]
+//
+// Set Leading, Actions, and OverflowActions on the result. The bar follows
+// the page's [widgets.PrimaryScrollController], so it rises as soon as the
+// page's scroll view moves.
+//
+// Example:
+//
+//	bar := theme.AppBarOf(ctx, "Inbox")
+//	bar.OverflowActions = []widgets.PopupMenuItem{
+//	    {Label: "Settings", OnSelected: s.openSettings},
+//	    {Label: "Sign out", OnSelected: s.signOut},
+//	}
+//	widgets.Column{Children: []core.Widget{
+//	    bar,
+//	    widgets.Expanded{Child: widgets.ListView{Children: messages}},
+//	}}
+func AppBarOf(ctx core.BuildContext, title string) widgets.AppBar {
+	th := ThemeOf(ctx).AppBarThemeOf()
+	_, _, textTheme := UseTheme(ctx)
+	style := textTheme.TitleLarge
+	style.Color = th.ForegroundColor
+	return widgets.AppBar{
+		Title: widgets.Text{
+			Content:  title,
+			Style:    style,
+			MaxLines: 1,
+			Wrap:     graphics.TextWrapNoWrap,
+			Overflow: graphics.TextOverflowEllipsis,
+		},
+		BackgroundColor:           th.BackgroundColor,
+		SurfaceTintColor:          th.SurfaceTintColor,
+		ShadowColor:               th.ShadowColor,
+		Elevation:                 th.Elevation,
+		ScrolledUnderElevation:    th.ScrolledUnderElevation,
+		Duration:                  th.Duration,
+		Height:                    th.Height,
+		Padding:                   th.Padding,
+		Spacing:                   th.Spacing,
+		ForegroundColor:           th.ForegroundColor,
+		AutomaticallyImplyLeading: true,
+		OverflowMenu:              PopupMenuOf(ctx),
+	}
+}
+
+// PopupMenuOf creates a [widgets.PopupMenu] of items with visual properties
+// filled from the current theme.
+//
+// The returned menu has:
+//   - BackgroundColor set to ColorScheme.SurfaceContainer
+//   - ShadowColor set to ColorScheme.Shadow
+//   - TextStyle set to TextTheme.BodyLarge in ColorScheme.OnSurface
+//   - DisabledTextColor set to ColorScheme.OnSurface at 38% opacity
+//   - DividerColor set to ColorScheme.OutlineVariant
+//   - HighlightColor set to ColorScheme.OnSurface at 12% opacity
+//   - ItemHeight 48, ItemPadding 12 horizontally, BorderRadius 4, and
+//     MinWidth 112, following Material 3 menus
+//
+// Example:
+//
+//	widgets.ShowPopupMenu(ctx, theme.PopupMenuOf(ctx,
+//	    widgets.PopupMenuItem{Label: "Rename", OnSelected: s.rename},
+//	    widgets.PopupMenuItem{Label: "Delete", OnSelected: s.delete},
+//	))
+func PopupMenuOf(ctx core.BuildContext, items ...widgets.PopupMenuItem) widgets.PopupMenu {
+	_, colors, textTheme := UseTheme(ctx)
+	style := textTheme.BodyLarge
+	style.Color = colors.OnSurface
+	return widgets.PopupMenu{
+		Items:             items,
+		BackgroundColor:   colors.SurfaceContainer,
+		ShadowColor:       colors.Shadow,
+		TextStyle:         style,
+		DisabledTextColor: colors.OnSurface.WithAlpha(0.38),
+		DividerColor:      colors.OutlineVariant,
+		HighlightColor:    colors.OnSurface.WithAlpha(0.12),
+		ItemHeight:        48,
+		ItemPadding:       layout.EdgeInsetsSymmetric(12, 0),
+		BorderRadius:      4,
+		MinWidth:          112,
+	}
+}
+
+// PopupMenuButtonOf creates a [widgets.PopupMenuButton] around child that
+// shows items in a menu styled by [PopupMenuOf].
+//
+// Example:
+//
+//	theme.PopupMenuButtonOf(ctx, moreIcon,
+//	    widgets.PopupMenuItem{Label: "Rename", OnSelected: s.rename},
+//	    widgets.PopupMenuItem{Divider: true},
+//	    widgets.PopupMenuItem{Label: "Delete", OnSelected: s.delete},
+//	)
+func PopupMenuButtonOf(ctx core.BuildContext, child core.Widget, items ...widgets.PopupMenuItem) widgets.PopupMenuButton {
+	return widgets.PopupMenuButton{
+		Child: child,
+		Items: items,
+		Menu:  PopupMenuOf(ctx),
+	}
+}
+
+// ContextMenuAreaOf creates a [widgets.ContextMenuArea] around child that
+// shows items in a menu styled by [PopupMenuOf], presented the way the
+// current platform does.
+//
+// The returned area has:
+//   - Haptic enabled
+//   - On [TargetPlatformCupertino], Preview set to child and BarrierColor
+//     set to ColorScheme.Scrim at 32% opacity, so the pressed content is
+//     lifted above the dimmed page with the menu beside it
+//   - Otherwise no preview or barrier color, so the menu opens over the
+//     pressed content
+//
+// Example:
+//
+//	theme.ContextMenuAreaOf(ctx, photo,
+//	    widgets.PopupMenuItem{Label: "Share", OnSelected: s.share},
+//	    widgets.PopupMenuItem{Label: "Favorite", Checkable: true, Checked: s.favorite, OnSelected: s.toggleFavorite},
+//	)
+func ContextMenuAreaOf(ctx core.BuildContext, child core.Widget, items ...widgets.PopupMenuItem) widgets.ContextMenuArea {
+	area := widgets.ContextMenuArea{
+		Child:  child,
+		Items:  items,
+		Menu:   PopupMenuOf(ctx),
+		Haptic: true,
+	}
+	if PlatformOf(ctx) == TargetPlatformCupertino {
+		area.Preview = child
+		area.BarrierColor = ThemeOf(ctx).ColorScheme.Scrim.WithAlpha(0.32)
+	}
+	return area
+}
+
+// ScaffoldOf creates a [widgets.Scaffold] around body with visual
+// properties filled from the current theme.
+//
+// The returned scaffold has:
+//   - BackgroundColor set to ColorScheme.Surface
+//   - ScrimColor set to ColorScheme.Scrim at 32% opacity
+//
+// Set AppBar, Drawer, and EndDrawer on the result.
+//
+// Example:
+//
+//	scaffold := theme.ScaffoldOf(ctx, inbox)
+//	scaffold.AppBar = theme.AppBarOf(ctx, "Inbox")
+//	scaffold.Drawer = theme.DrawerOf(ctx, mailboxes)
+func ScaffoldOf(ctx core.BuildContext, body core.Widget) widgets.Scaffold {
+	_, colors, _ := UseTheme(ctx)
+	return widgets.Scaffold{
+		Body:            body,
+		BackgroundColor: colors.Surface,
+		ScrimColor:      colors.Scrim.WithAlpha(0.32),
+	}
+}
+
+// DrawerOf creates a [widgets.Drawer] holding child with visual properties
+// filled from the current theme.
+//
+// The returned drawer has:
+//   - Width 304, following Material 3 navigation drawers
+//   - BackgroundColor set to ColorScheme.SurfaceContainerLow
+//   - ShadowColor set to ColorScheme.Shadow
+//
+// The drawer covers the status bar, so wrap child in a [widgets.SafeArea].
+//
+// Example:
+//
+//	scaffold.Drawer = theme.DrawerOf(ctx, widgets.SafeArea{
+//	    Child: widgets.ListView{Children: mailboxLinks},
+//	})
+func DrawerOf(ctx core.BuildContext, child core.Widget) widgets.Drawer {
+	_, colors, _ := UseTheme(ctx)
+	return widgets.Drawer{
+		Child:           child,
+		Width:           304,
+		BackgroundColor: colors.SurfaceContainerLow,
+		ShadowColor:     colors.Shadow,
+	}
+}
+
 // DatePickerOf creates a [widgets.DatePicker] with visual properties filled from
 // the current theme's colors.
 //
@@ -363,13 +808,14 @@ func TabBarOf(ctx core.BuildContext, items []widgets.TabItem, currentIndex int,
 //	})
 func DatePickerOf(ctx core.BuildContext, value *time.Time, onChanged func(time.Time)) widgets.DatePicker {
 	_, colors, _ := UseTheme(ctx)
+	shapes := Shapes(ctx)
 	return widgets.DatePicker{
 		Value:       value,
 		OnChanged:   onChanged,
 		Placeholder: "Select date",
 		TextStyle:   graphics.TextStyle{FontSize: 16, Color: colors.OnSurface},
 		Decoration: &widgets.InputDecoration{
-			BorderRadius:    8,
+			BorderRadius:    shapes.Small,
 			BorderColor:     colors.Outline,
 			BackgroundColor: colors.Surface,
 			HintStyle:       graphics.TextStyle{FontSize: 16, Color: colors.OnSurfaceVariant},
@@ -393,13 +839,14 @@ func DatePickerOf(ctx core.BuildContext, value *time.Time, onChanged func(time.T
 //	})
 func TimePickerOf(ctx core.BuildContext, hour, minute int, onChanged func(hour, minute int)) widgets.TimePicker {
 	_, colors, _ := UseTheme(ctx)
+	shapes := Shapes(ctx)
 	return widgets.TimePicker{
 		Hour:      hour,
 		Minute:    minute,
 		OnChanged: onChanged,
 		TextStyle: graphics.TextStyle{FontSize: 16, Color: colors.OnSurface},
 		Decoration: &widgets.InputDecoration{
-			BorderRadius:    8,
+			BorderRadius:    shapes.Small,
 			BorderColor:     colors.Outline,
 			BackgroundColor: colors.Surface,
 			HintStyle:       graphics.TextStyle{FontSize: 16, Color: colors.OnSurfaceVariant},
@@ -584,3 +1031,126 @@ func LinearProgressIndicatorOf(ctx core.BuildContext, value *float64) widgets.Li
 		BorderRadius: 2,
 	}
 }
+
+// SelectionAreaOf creates a [widgets.SelectionArea] with colors filled from
+// the current theme's [ColorScheme].
+//
+// The returned area has:
+//   - SelectionColor set to ColorScheme.Primary at 30% opacity
+//   - HandleColor set to ColorScheme.Primary
+//   - ToolbarColor set to ColorScheme.InverseSurface
+//   - ToolbarTextColor set to ColorScheme.OnInverseSurface
+//
+// Example:
+//
+//	theme.SelectionAreaOf(ctx, widgets.Column{Children: paragraphs})
+func SelectionAreaOf(ctx core.BuildContext, child core.Widget) widgets.SelectionArea {
+	_, colors, _ := UseTheme(ctx)
+	return widgets.SelectionArea{
+		Child:            child,
+		SelectionColor:   colors.Primary.WithAlpha(0.3),
+		HandleColor:      colors.Primary,
+		ToolbarColor:     colors.InverseSurface,
+		ToolbarTextColor: colors.OnInverseSurface,
+	}
+}
+
+// SelectableTextOf creates a [widgets.SelectableText] with the same theme
+// colors as [SelectionAreaOf].
+//
+// Example:
+//
+//	_, _, textTheme := theme.UseTheme(ctx)
+//	theme.SelectableTextOf(ctx, "Order #4521", textTheme.BodyLarge)
+func SelectableTextOf(ctx core.BuildContext, content string, style graphics.TextStyle) widgets.SelectableText {
+	area := SelectionAreaOf(ctx, nil)
+	return widgets.SelectableText{
+		Content:          content,
+		Style:            style,
+		SelectionColor:   area.SelectionColor,
+		HandleColor:      area.HandleColor,
+		ToolbarColor:     area.ToolbarColor,
+		ToolbarTextColor: area.ToolbarTextColor,
+	}
+}
+
+// ShimmerOf creates a [widgets.Shimmer] with colors filled from the current
+// theme's [ColorScheme].
+//
+// The returned shimmer has:
+//   - BaseColor set to ColorScheme.SurfaceContainerHighest
+//   - HighlightColor set to ColorScheme.SurfaceContainerLow
+//
+// Example:
+//
+//	theme.ShimmerOf(ctx, widgets.Column{Children: []core.Widget{
+//	    theme.SkeletonOf(ctx, 200, 16),
+//	    widgets.VSpace(8),
+//	    theme.SkeletonOf(ctx, 120, 16),
+//	}})
+func ShimmerOf(ctx core.BuildContext, child core.Widget) widgets.Shimmer {
+	_, colors, _ := UseTheme(ctx)
+	return widgets.Shimmer{
+		BaseColor:      colors.SurfaceContainerHighest,
+		HighlightColor: colors.SurfaceContainerLow,
+		Child:          child,
+	}
+}
+
+// SkeletonOf creates a [widgets.Skeleton] of the given size with visual
+// properties filled from the current theme.
+//
+// The returned skeleton has:
+//   - Color set to ColorScheme.SurfaceContainerHighest, matching [ShimmerOf]
+//   - BorderRadius set to 4
+//
+// Pass zero for width or height to fill the available space. Set Circle on
+// the returned skeleton for avatars.
+//
+// Example:
+//
+//	avatar := theme.SkeletonOf(ctx, 40, 40)
+//	avatar.Circle = true
+func SkeletonOf(ctx core.BuildContext, width, height float64) widgets.Skeleton {
+	_, colors, _ := UseTheme(ctx)
+	return widgets.Skeleton{
+		Width:        width,
+		Height:       height,
+		BorderRadius: 4,
+		Color:        colors.SurfaceContainerHighest,
+	}
+}
+
+// BannerOf creates a [widgets.Banner] showing message, with visual
+// properties filled from the current theme.
+//
+// The returned banner has:
+//   - Content set to message in TextTheme.BodyMedium, colored ColorScheme.OnSurface
+//   - BackgroundColor set to ColorScheme.SurfaceContainerLow
+//   - DividerColor set to ColorScheme.OutlineVariant
+//   - Padding and Spacing set to Material's banner spacing
+//
+// Set Leading on the result to add an icon. Show it with
+// [widgets.MessengerState.ShowBanner].
+//
+// Example:
+//
+//	messenger := widgets.MessengerOf(ctx)
+//	messenger.ShowBanner(theme.BannerOf(ctx,
+//	    "Your storage is almost full.",
+//	    theme.ButtonOf(ctx, "Dismiss", messenger.HideBanner),
+//	    theme.ButtonOf(ctx, "Manage", s.openStorage),
+//	))
+func BannerOf(ctx core.BuildContext, message string, actions ...core.Widget) widgets.Banner {
+	_, colors, textTheme := UseTheme(ctx)
+	style := textTheme.BodyMedium
+	style.Color = colors.OnSurface
+	return widgets.Banner{
+		Content:         widgets.Text{Content: message, Style: style},
+		Actions:         actions,
+		BackgroundColor: colors.SurfaceContainerLow,
+		DividerColor:    colors.OutlineVariant,
+		Padding:         layout.EdgeInsets{Left: 16, Top: 16, Right: 16, Bottom: 8},
+		Spacing:         16,
+	}
+}
@@ -0,0 +1,157 @@
+package theme
+
+import (
+	"math"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// CorePalette holds the tonal palettes a Material color scheme is built
+// from: three accents, two neutrals, and error.
+type CorePalette struct {
+	Primary        TonalPalette
+	Secondary      TonalPalette
+	Tertiary       TonalPalette
+	Neutral        TonalPalette
+	NeutralVariant TonalPalette
+	Error          TonalPalette
+}
+
+// CorePaletteFromSeed derives the palettes from a single seed color the way
+// Material 3's tonal spot scheme does: the seed's hue drives every palette,
+// with tertiary rotated 60 degrees and the neutrals nearly gray.
+func CorePaletteFromSeed(seed graphics.Color) CorePalette {
+	h := HCTFromColor(seed)
+	return CorePalette{
+		Primary:        TonalPalette{Hue: h.Hue, Chroma: math.Max(48, h.Chroma)},
+		Secondary:      TonalPalette{Hue: h.Hue, Chroma: 16},
+		Tertiary:       TonalPalette{Hue: h.Hue + 60, Chroma: 24},
+		Neutral:        TonalPalette{Hue: h.Hue, Chroma: 4},
+		NeutralVariant: TonalPalette{Hue: h.Hue, Chroma: 8},
+		Error:          TonalPalette{Hue: 25, Chroma: 84},
+	}
+}
+
+// ColorSchemeFromSeed generates a full ColorScheme from a seed color, such
+// as a brand color or a photo's dominant color.
+func ColorSchemeFromSeed(seed graphics.Color, brightness Brightness) ColorScheme {
+	return CorePaletteFromSeed(seed).ColorScheme(brightness, false)
+}
+
+// ColorScheme generates the scheme for brightness. With highContrast set,
+// foreground and background tones are pushed further apart, for users who
+// ask the system for more contrast.
+func (p CorePalette) ColorScheme(brightness Brightness, highContrast bool) ColorScheme {
+	// Tones for the accent and error roles, in the order: color, on color,
+	// container, on container.
+	type roleTones struct{ color, on, container, onContainer float64 }
+	dark := brightness == BrightnessDark
+	neutral := func(light, darkTone float64) float64 {
+		if dark {
+			return darkTone
+		}
+		return light
+	}
+	var accent roleTones
+	switch {
+	case !dark && !highContrast:
+		accent = roleTones{40, 100, 90, 10}
+	case !dark && highContrast:
+		accent = roleTones{25, 100, 35, 100}
+	case dark && !highContrast:
+		accent = roleTones{80, 20, 30, 90}
+	default:
+		accent = roleTones{90, 0, 70, 0}
+	}
+	onSurface := neutral(10, 90)
+	onSurfaceVariant := neutral(30, 80)
+	outline := neutral(50, 60)
+	if highContrast {
+		onSurface = neutral(0, 100)
+		onSurfaceVariant = neutral(20, 90)
+		outline = neutral(30, 80)
+	}
+
+	return ColorScheme{
+		Primary:            p.Primary.Tone(accent.color),
+		OnPrimary:          p.Primary.Tone(accent.on),
+		PrimaryContainer:   p.Primary.Tone(accent.container),
+		OnPrimaryContainer: p.Primary.Tone(accent.onContainer),
+
+		Secondary:            p.Secondary.Tone(accent.color),
+		OnSecondary:          p.Secondary.Tone(accent.on),
+		SecondaryContainer:   p.Secondary.Tone(accent.container),
+		OnSecondaryContainer: p.Secondary.Tone(accent.onContainer),
+
+		Tertiary:            p.Tertiary.Tone(accent.color),
+		OnTertiary:          p.Tertiary.Tone(accent.on),
+		TertiaryContainer:   p.Tertiary.Tone(accent.container),
+		OnTertiaryContainer: p.Tertiary.Tone(accent.onContainer),
+
+		Surface:                 p.Neutral.Tone(neutral(98, 6)),
+		OnSurface:               p.Neutral.Tone(onSurface),
+		SurfaceVariant:          p.NeutralVariant.Tone(neutral(90, 30)),
+		OnSurfaceVariant:        p.NeutralVariant.Tone(onSurfaceVariant),
+		SurfaceDim:              p.Neutral.Tone(neutral(87, 6)),
+		SurfaceBright:           p.Neutral.Tone(neutral(98, 24)),
+		SurfaceContainerLowest:  p.Neutral.Tone(neutral(100, 4)),
+		SurfaceContainerLow:     p.Neutral.Tone(neutral(96, 10)),
+		SurfaceContainer:        p.Neutral.Tone(neutral(94, 12)),
+		SurfaceContainerHigh:    p.Neutral.Tone(neutral(92, 17)),
+		SurfaceContainerHighest: p.Neutral.Tone(neutral(90, 22)),
+
+		Background:   p.Neutral.Tone(neutral(98, 6)),
+		OnBackground: p.Neutral.Tone(onSurface),
+
+		Error:            p.Error.Tone(accent.color),
+		OnError:          p.Error.Tone(accent.on),
+		ErrorContainer:   p.Error.Tone(accent.container),
+		OnErrorContainer: p.Error.Tone(accent.onContainer),
+
+		Outline:        p.NeutralVariant.Tone(outline),
+		OutlineVariant: p.NeutralVariant.Tone(neutral(80, 30)),
+
+		Shadow: p.Neutral.Tone(0),
+		Scrim:  p.Neutral.Tone(0),
+
+		InverseSurface:   p.Neutral.Tone(neutral(20, 90)),
+		OnInverseSurface: p.Neutral.Tone(neutral(95, 20)),
+		InversePrimary:   p.Primary.Tone(neutral(80, 40)),
+
+		SurfaceTint: p.Primary.Tone(accent.color),
+
+		Brightness: brightness,
+	}
+}
+
+// DynamicColorScheme generates a ColorScheme from the platform's dynamic
+// colors: the wallpaper-based Material You palette on Android 12 and later,
+// or the accent tint on iOS. It honors the system's increased-contrast
+// setting. ok is false when the platform offers no dynamic colors, in which
+// case callers should use their own scheme.
+//
+//	colors, ok := theme.DynamicColorScheme(theme.BrightnessDark)
+//	if !ok {
+//	    colors = theme.ColorSchemeFromSeed(brandColor, theme.BrightnessDark)
+//	}
+func DynamicColorScheme(brightness Brightness) (colors ColorScheme, ok bool) {
+	dynamic, err := platform.GetDynamicColors()
+	if err != nil || dynamic.Primary == 0 {
+		return ColorScheme{}, false
+	}
+	palette := CorePaletteFromSeed(dynamic.Primary)
+	if dynamic.Secondary != 0 {
+		palette.Secondary = TonalPaletteFromColor(dynamic.Secondary)
+	}
+	if dynamic.Tertiary != 0 {
+		palette.Tertiary = TonalPaletteFromColor(dynamic.Tertiary)
+	}
+	if dynamic.Neutral != 0 {
+		palette.Neutral = TonalPaletteFromColor(dynamic.Neutral)
+	}
+	if dynamic.NeutralVariant != 0 {
+		palette.NeutralVariant = TonalPaletteFromColor(dynamic.NeutralVariant)
+	}
+	return palette.ColorScheme(brightness, dynamic.HighContrast), true
+}
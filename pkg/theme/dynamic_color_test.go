@@ -0,0 +1,103 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+func TestHCT_RoundTrip(t *testing.T) {
+	for _, c := range []graphics.Color{
+		graphics.RGB(103, 80, 164),
+		graphics.RGB(66, 133, 244),
+		graphics.RGB(255, 0, 0),
+		graphics.RGB(128, 128, 128),
+	} {
+		if got := HCTFromColor(c).Color(); got != c {
+			t.Errorf("HCT round trip of %08X = %08X", uint32(c), uint32(got))
+		}
+	}
+}
+
+func TestColorSchemeFromSeed_MatchesMaterialBaseline(t *testing.T) {
+	seed := graphics.RGB(0x67, 0x50, 0xA4)
+
+	light := ColorSchemeFromSeed(seed, BrightnessLight)
+	lightWant := map[string][2]graphics.Color{
+		"Primary":            {light.Primary, graphics.RGB(0x67, 0x50, 0xA4)},
+		"OnPrimary":          {light.OnPrimary, graphics.RGB(0xFF, 0xFF, 0xFF)},
+		"PrimaryContainer":   {light.PrimaryContainer, graphics.RGB(0xEA, 0xDD, 0xFF)},
+		"OnPrimaryContainer": {light.OnPrimaryContainer, graphics.RGB(0x21, 0x00, 0x5D)},
+	}
+	for name, pair := range lightWant {
+		if !nearColor(pair[0], pair[1]) {
+			t.Errorf("light %s = %08X, want %08X", name, uint32(pair[0]), uint32(pair[1]))
+		}
+	}
+
+	dark := ColorSchemeFromSeed(seed, BrightnessDark)
+	if want := graphics.RGB(0xD0, 0xBC, 0xFF); !nearColor(dark.Primary, want) {
+		t.Errorf("dark Primary = %08X, want %08X", uint32(dark.Primary), uint32(want))
+	}
+	if dark.Brightness != BrightnessDark {
+		t.Error("expected a dark scheme")
+	}
+}
+
+// nearColor reports whether a and b differ by at most one step per channel,
+// the rounding difference between HCT solvers.
+func nearColor(a, b graphics.Color) bool {
+	for shift := 0; shift < 32; shift += 8 {
+		d := int(uint8(a>>shift)) - int(uint8(b>>shift))
+		if d < -1 || d > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestCorePalette_HighContrast(t *testing.T) {
+	palette := CorePaletteFromSeed(graphics.RGB(0x67, 0x50, 0xA4))
+	normal := palette.ColorScheme(BrightnessLight, false)
+	high := palette.ColorScheme(BrightnessLight, true)
+
+	if lstarFromColor(high.OnSurface) >= lstarFromColor(normal.OnSurface) {
+		t.Error("expected darker text on light surfaces in high contrast")
+	}
+	if lstarFromColor(high.Primary) >= lstarFromColor(normal.Primary) {
+		t.Error("expected a darker primary in high contrast")
+	}
+}
+
+// dynamicColorBridge answers drift/dynamic_color with fixed colors.
+type dynamicColorBridge struct {
+	response map[string]any
+}
+
+func (b dynamicColorBridge) InvokeMethod(channel, method string, args []byte) ([]byte, error) {
+	return platform.DefaultCodec.Encode(b.response)
+}
+func (dynamicColorBridge) StartEventStream(string) error { return nil }
+func (dynamicColorBridge) StopEventStream(string) error  { return nil }
+
+func TestDynamicColorScheme(t *testing.T) {
+	platform.ResetForTest()
+	t.Cleanup(platform.ResetForTest)
+	if _, ok := DynamicColorScheme(BrightnessLight); ok {
+		t.Fatal("expected no dynamic colors without a platform")
+	}
+
+	seed := graphics.RGB(0x00, 0x6A, 0x60)
+	platform.SetNativeBridge(dynamicColorBridge{response: map[string]any{
+		"primary":      int64(seed),
+		"highContrast": false,
+	}})
+	colors, ok := DynamicColorScheme(BrightnessLight)
+	if !ok {
+		t.Fatal("expected dynamic colors from the platform")
+	}
+	if want := ColorSchemeFromSeed(seed, BrightnessLight); colors != want {
+		t.Error("expected the scheme generated from the platform accent")
+	}
+}
@@ -0,0 +1,101 @@
+package theme
+
+import (
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// ElevationLevel describes how a surface at one elevation level is drawn.
+type ElevationLevel struct {
+	// OffsetY is the vertical shadow offset.
+	OffsetY float64
+	// BlurRadius is the shadow blur radius.
+	BlurRadius float64
+	// Spread is the shadow spread.
+	Spread float64
+	// TintOpacity is how strongly the surface tint is blended into the
+	// surface color, from 0 to 1.
+	TintOpacity float64
+}
+
+// ElevationScheme defines the shadow and surface tint for elevation levels
+// 1 through 5. Level 0 is always flat and untinted.
+//
+// Elevated built-in surfaces, such as dialogs, draw their shadows from this
+// scheme. Read it directly to elevate custom surfaces consistently:
+//
+//	elev := theme.Elevation(ctx)
+//	widgets.Container{
+//	    Color:  elev.SurfaceColor(colors.Surface, 1),
+//	    Shadow: elev.Shadow(1),
+//	    Child:  card,
+//	}
+type ElevationScheme struct {
+	// ShadowColor is the color of elevation shadows.
+	// Default: ColorScheme.Shadow.
+	ShadowColor graphics.Color
+	// SurfaceTintColor is blended into elevated surfaces.
+	// Default: ColorScheme.SurfaceTint.
+	SurfaceTintColor graphics.Color
+	// Levels holds elevation levels 1 through 5.
+	Levels [5]ElevationLevel
+}
+
+// DefaultElevationScheme returns an ElevationScheme derived from a
+// [ColorScheme], matching [graphics.BoxShadowElevation] and Material 3 tonal
+// elevation.
+func DefaultElevationScheme(colors ColorScheme) ElevationScheme {
+	return ElevationScheme{
+		ShadowColor:      colors.Shadow,
+		SurfaceTintColor: colors.SurfaceTint,
+		Levels: [5]ElevationLevel{
+			{OffsetY: 1, BlurRadius: 3, Spread: 0, TintOpacity: 0.05},
+			{OffsetY: 2, BlurRadius: 6, Spread: 0, TintOpacity: 0.08},
+			{OffsetY: 4, BlurRadius: 10, Spread: 1, TintOpacity: 0.11},
+			{OffsetY: 6, BlurRadius: 14, Spread: 2, TintOpacity: 0.12},
+			{OffsetY: 8, BlurRadius: 18, Spread: 3, TintOpacity: 0.14},
+		},
+	}
+}
+
+// Level returns the given elevation level, clamped to 0-5. Level 0 is the
+// zero ElevationLevel.
+func (e ElevationScheme) Level(level int) ElevationLevel {
+	if level <= 0 {
+		return ElevationLevel{}
+	}
+	return e.Levels[min(level, len(e.Levels))-1]
+}
+
+// Shadow returns the shadow for the given elevation level, or nil for level 0.
+func (e ElevationScheme) Shadow(level int) *graphics.BoxShadow {
+	if level <= 0 {
+		return nil
+	}
+	l := e.Level(level)
+	return &graphics.BoxShadow{
+		Color:      e.ShadowColor,
+		Offset:     graphics.Offset{Y: l.OffsetY},
+		BlurRadius: l.BlurRadius,
+		Spread:     l.Spread,
+		BlurStyle:  graphics.BlurStyleOuter,
+	}
+}
+
+// SurfaceColor returns surface with the surface tint blended in for the given
+// elevation level.
+func (e ElevationScheme) SurfaceColor(surface graphics.Color, level int) graphics.Color {
+	opacity := e.Level(level).TintOpacity
+	if opacity == 0 || e.SurfaceTintColor == graphics.ColorTransparent {
+		return surface
+	}
+	tint := e.SurfaceTintColor
+	return animation.LerpColor(surface, tint.WithAlpha(1), opacity*tint.Alpha())
+}
+
+// Elevation returns the ElevationScheme from the nearest Theme ancestor.
+// If no Theme is found, returns the default scheme for the light colors.
+func Elevation(ctx core.BuildContext) ElevationScheme {
+	return ThemeOf(ctx).ElevationOf()
+}
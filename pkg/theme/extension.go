@@ -0,0 +1,104 @@
+package theme
+
+import (
+	"maps"
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// Extension is custom, typed theme data that apps and packages attach to
+// [ThemeData] for values the built-in color and text slots don't cover,
+// such as brand colors or a design system's tokens. T is the extension's own
+// type, usually a struct value.
+//
+// Lerp interpolates between two values of the extension so animated theme
+// changes can blend it along with the built-in slots; t runs from 0 (the
+// receiver) to 1 (other).
+//
+//	type BrandColors struct {
+//	    Hero, Accent graphics.Color
+//	}
+//
+//	func (b BrandColors) Lerp(other BrandColors, t float64) BrandColors {
+//	    return BrandColors{
+//	        Hero:   animation.LerpColor(b.Hero, other.Hero, t),
+//	        Accent: animation.LerpColor(b.Accent, other.Accent, t),
+//	    }
+//	}
+type Extension[T any] interface {
+	Lerp(other T, t float64) T
+}
+
+// themeExtension is the type-erased form of an Extension stored on ThemeData.
+type themeExtension interface {
+	lerp(other themeExtension, t float64) themeExtension
+}
+
+type extensionValue[T Extension[T]] struct {
+	value T
+}
+
+func (e extensionValue[T]) lerp(other themeExtension, t float64) themeExtension {
+	o, ok := other.(extensionValue[T])
+	if !ok {
+		return e
+	}
+	return extensionValue[T]{value: e.value.Lerp(o.value, t)}
+}
+
+// WithExtension returns a copy of data with ext attached, replacing any
+// extension of the same type. The original ThemeData is not modified.
+func WithExtension[T Extension[T]](data *ThemeData, ext T) *ThemeData {
+	result := data.CopyWith(nil, nil, nil)
+	result.extensions = maps.Clone(data.extensions)
+	if result.extensions == nil {
+		result.extensions = make(map[reflect.Type]themeExtension)
+	}
+	result.extensions[reflect.TypeFor[T]()] = extensionValue[T]{value: ext}
+	return result
+}
+
+// ExtensionOf returns the extension of type T attached to data, and whether
+// one was found.
+func ExtensionOf[T Extension[T]](data *ThemeData) (T, bool) {
+	if data != nil {
+		if ext, ok := data.extensions[reflect.TypeFor[T]()].(extensionValue[T]); ok {
+			return ext.value, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// UseExtension returns the extension of type T from the nearest theme, and
+// whether one was found. It is the extension counterpart of [UseTheme]:
+//
+//	brand, _ := theme.UseExtension[BrandColors](ctx)
+func UseExtension[T Extension[T]](ctx core.BuildContext) (T, bool) {
+	return ExtensionOf[T](ThemeOf(ctx))
+}
+
+// lerpExtensions interpolates the extensions of a toward b. An extension
+// only b has appears immediately; one only a has is kept until t reaches 1.
+func lerpExtensions(a, b *ThemeData, t float64) map[reflect.Type]themeExtension {
+	if len(a.extensions) == 0 && len(b.extensions) == 0 {
+		return nil
+	}
+	result := make(map[reflect.Type]themeExtension, len(b.extensions))
+	for key, to := range b.extensions {
+		if from, ok := a.extensions[key]; ok {
+			result[key] = from.lerp(to, t)
+		} else {
+			result[key] = to
+		}
+	}
+	if t < 1 {
+		for key, from := range a.extensions {
+			if _, ok := b.extensions[key]; !ok {
+				result[key] = from
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package theme
+
+import "testing"
+
+type testSpacingTokens struct {
+	Gutter float64
+}
+
+func (s testSpacingTokens) Lerp(other testSpacingTokens, t float64) testSpacingTokens {
+	return testSpacingTokens{Gutter: s.Gutter + (other.Gutter-s.Gutter)*t}
+}
+
+type testBrandTokens struct {
+	Name string
+}
+
+func (b testBrandTokens) Lerp(other testBrandTokens, t float64) testBrandTokens {
+	if t < 0.5 {
+		return b
+	}
+	return other
+}
+
+func TestWithExtension(t *testing.T) {
+	base := DefaultLightTheme()
+	themed := WithExtension(base, testSpacingTokens{Gutter: 16})
+
+	if _, ok := ExtensionOf[testSpacingTokens](base); ok {
+		t.Error("WithExtension should not modify the original theme")
+	}
+	if got, ok := ExtensionOf[testSpacingTokens](themed); !ok || got.Gutter != 16 {
+		t.Errorf("ExtensionOf = %+v, %v", got, ok)
+	}
+	if _, ok := ExtensionOf[testBrandTokens](themed); ok {
+		t.Error("expected no extension of an unregistered type")
+	}
+
+	replaced := WithExtension(themed, testSpacingTokens{Gutter: 24})
+	if got, _ := ExtensionOf[testSpacingTokens](replaced); got.Gutter != 24 {
+		t.Errorf("expected replacement, got %+v", got)
+	}
+	if got, _ := ExtensionOf[testSpacingTokens](themed); got.Gutter != 16 {
+		t.Errorf("replacing should not modify the earlier theme, got %+v", got)
+	}
+
+	if got, ok := ExtensionOf[testSpacingTokens](themed.CopyWith(nil, nil, nil)); !ok || got.Gutter != 16 {
+		t.Error("CopyWith should keep extensions")
+	}
+}
+
+func TestLerpExtensions(t *testing.T) {
+	from := WithExtension(WithExtension(DefaultLightTheme(),
+		testSpacingTokens{Gutter: 10}), testBrandTokens{Name: "old"})
+	to := WithExtension(DefaultDarkTheme(), testSpacingTokens{Gutter: 20})
+
+	mid := &ThemeData{extensions: lerpExtensions(from, to, 0.5)}
+	if got, _ := ExtensionOf[testSpacingTokens](mid); got.Gutter != 15 {
+		t.Errorf("expected Gutter 15 halfway, got %v", got.Gutter)
+	}
+	if got, ok := ExtensionOf[testBrandTokens](mid); !ok || got.Name != "old" {
+		t.Errorf("expected extension only on the start theme to be kept mid-animation, got %+v, %v", got, ok)
+	}
+
+	end := &ThemeData{extensions: lerpExtensions(from, to, 1)}
+	if _, ok := ExtensionOf[testBrandTokens](end); ok {
+		t.Error("expected extension only on the start theme to be gone at the end")
+	}
+}
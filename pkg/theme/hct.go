@@ -0,0 +1,336 @@
+package theme
+
+import (
+	"math"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// HCT is a color in the hue, chroma, tone space used by Material Design's
+// dynamic color. Hue (0-360) and chroma come from the CAM16 appearance model
+// and tone (0-100) is CIE L*, so colors with the same tone have the same
+// perceived lightness whatever their hue, which keeps generated schemes
+// legible.
+type HCT struct {
+	Hue    float64
+	Chroma float64
+	Tone   float64
+}
+
+// HCTFromColor converts an opaque sRGB color to HCT. Alpha is ignored.
+func HCTFromColor(c graphics.Color) HCT {
+	cam := cam16FromColor(c)
+	return HCT{Hue: cam.hue, Chroma: cam.chroma, Tone: lstarFromColor(c)}
+}
+
+// Color returns the sRGB color closest to h. Chroma is reduced as needed
+// when the requested color is outside the sRGB gamut; hue and tone are kept.
+func (h HCT) Color() graphics.Color {
+	return solveHCT(h.Hue, h.Chroma, h.Tone)
+}
+
+// TonalPalette is a set of colors sharing a hue and chroma that vary only in
+// tone, from black (0) to white (100).
+type TonalPalette struct {
+	Hue    float64
+	Chroma float64
+}
+
+// TonalPaletteFromColor returns the palette containing c.
+func TonalPaletteFromColor(c graphics.Color) TonalPalette {
+	h := HCTFromColor(c)
+	return TonalPalette{Hue: h.Hue, Chroma: h.Chroma}
+}
+
+// Tone returns the palette's color at tone t (0-100).
+func (p TonalPalette) Tone(t float64) graphics.Color {
+	return solveHCT(p.Hue, p.Chroma, t)
+}
+
+// viewingConditions describes the environment CAM16 colors are seen in.
+type viewingConditions struct {
+	n, aw, nbb, ncb, c, nc, fl, fLRoot, z float64
+	rgbD                                  [3]float64
+}
+
+// defaultViewingConditions matches Material's: sRGB on a mid-gray
+// background under average surround.
+var defaultViewingConditions = func() viewingConditions {
+	whitePoint := [3]float64{95.047, 100.0, 108.883}
+	adaptingLuminance := 200.0 / math.Pi * yFromLstar(50) / 100
+	backgroundLstar := 50.0
+	surround := 2.0
+
+	rW, gW, bW := xyzToCam16RGB(whitePoint[0], whitePoint[1], whitePoint[2])
+	f := 0.8 + surround/10
+	c := 0.59 + (0.69-0.59)*((f-0.9)*10)
+	d := f * (1 - (1/3.6)*math.Exp((-adaptingLuminance-42)/92))
+	d = clamp(d, 0, 1)
+	rgbD := [3]float64{d*(100/rW) + 1 - d, d*(100/gW) + 1 - d, d*(100/bW) + 1 - d}
+	k := 1 / (5*adaptingLuminance + 1)
+	k4 := k * k * k * k
+	k4F := 1 - k4
+	fl := k4*adaptingLuminance + 0.1*k4F*k4F*math.Cbrt(5*adaptingLuminance)
+	n := yFromLstar(backgroundLstar) / whitePoint[1]
+	z := 1.48 + math.Sqrt(n)
+	nbb := 0.725 / math.Pow(n, 0.2)
+	var rgbA [3]float64
+	for i, w := range [3]float64{rW, gW, bW} {
+		factor := math.Pow(fl*rgbD[i]*w/100, 0.42)
+		rgbA[i] = 400 * factor / (factor + 27.13)
+	}
+	aw := (2*rgbA[0] + rgbA[1] + 0.05*rgbA[2]) * nbb
+	return viewingConditions{
+		n: n, aw: aw, nbb: nbb, ncb: nbb, c: c, nc: f,
+		fl: fl, fLRoot: math.Pow(fl, 0.25), z: z, rgbD: rgbD,
+	}
+}()
+
+// cam16 is a color in the CAM16 appearance model, with the CAM16-UCS
+// coordinates used to measure color distance.
+type cam16 struct {
+	hue, chroma, j      float64
+	jstar, astar, bstar float64
+}
+
+func cam16FromColor(color graphics.Color) cam16 {
+	vc := defaultViewingConditions
+	r := linearized(uint8(color >> 16))
+	g := linearized(uint8(color >> 8))
+	b := linearized(uint8(color))
+	x := 0.41233895*r + 0.35762064*g + 0.18051042*b
+	y := 0.2126*r + 0.7152*g + 0.0722*b
+	z := 0.01932141*r + 0.11916382*g + 0.95034478*b
+
+	rC, gC, bC := xyzToCam16RGB(x, y, z)
+	var adapted [3]float64
+	for i, v := range [3]float64{rC, gC, bC} {
+		d := vc.rgbD[i] * v
+		af := math.Pow(vc.fl*math.Abs(d)/100, 0.42)
+		adapted[i] = sign(d) * 400 * af / (af + 27.13)
+	}
+	rA, gA, bA := adapted[0], adapted[1], adapted[2]
+
+	a := (11*rA - 12*gA + bA) / 11
+	bb := (rA + gA - 2*bA) / 9
+	u := (20*rA + 20*gA + 21*bA) / 20
+	p2 := (40*rA + 20*gA + bA) / 20
+	hue := sanitizeDegrees(math.Atan2(bb, a) * 180 / math.Pi)
+
+	ac := p2 * vc.nbb
+	j := 100 * math.Pow(ac/vc.aw, vc.c*vc.z)
+	huePrime := hue
+	if hue < 20.14 {
+		huePrime += 360
+	}
+	eHue := 0.25 * (math.Cos(huePrime*math.Pi/180+2) + 3.8)
+	p1 := 50000.0 / 13 * eHue * vc.nc * vc.ncb
+	t := p1 * math.Hypot(a, bb) / (u + 0.305)
+	alpha := math.Pow(t, 0.9) * math.Pow(1.64-math.Pow(0.29, vc.n), 0.73)
+	chroma := alpha * math.Sqrt(j/100)
+	return newCam16(j, chroma, hue)
+}
+
+// newCam16 returns the CAM16 color with lightness j, chroma, and hue.
+func newCam16(j, chroma, hue float64) cam16 {
+	m := chroma * defaultViewingConditions.fLRoot
+	mstar := 1 / 0.0228 * math.Log1p(0.0228*m)
+	hueRadians := hue * math.Pi / 180
+	return cam16{
+		hue: hue, chroma: chroma, j: j,
+		jstar: 1.7 * j / (1 + 0.007*j),
+		astar: mstar * math.Cos(hueRadians),
+		bstar: mstar * math.Sin(hueRadians),
+	}
+}
+
+func (c cam16) distance(other cam16) float64 {
+	dJ := c.jstar - other.jstar
+	dA := c.astar - other.astar
+	dB := c.bstar - other.bstar
+	return 1.41 * math.Pow(math.Sqrt(dJ*dJ+dA*dA+dB*dB), 0.63)
+}
+
+// color converts c to sRGB, clipping out-of-gamut components.
+func (c cam16) color() graphics.Color {
+	vc := defaultViewingConditions
+	alpha := 0.0
+	if c.chroma != 0 && c.j != 0 {
+		alpha = c.chroma / math.Sqrt(c.j/100)
+	}
+	t := math.Pow(alpha/math.Pow(1.64-math.Pow(0.29, vc.n), 0.73), 1/0.9)
+	hRad := c.hue * math.Pi / 180
+	eHue := 0.25 * (math.Cos(hRad+2) + 3.8)
+	ac := vc.aw * math.Pow(c.j/100, 1/vc.c/vc.z)
+	p1 := eHue * (50000.0 / 13) * vc.nc * vc.ncb
+	p2 := ac / vc.nbb
+	hSin, hCos := math.Sincos(hRad)
+	gamma := 23 * (p2 + 0.305) * t / (23*p1 + 11*t*hCos + 108*t*hSin)
+	a := gamma * hCos
+	b := gamma * hSin
+	adapted := [3]float64{
+		(460*p2 + 451*a + 288*b) / 1403,
+		(460*p2 - 891*a - 261*b) / 1403,
+		(460*p2 - 220*a - 6300*b) / 1403,
+	}
+	var rgbF [3]float64
+	for i, v := range adapted {
+		base := math.Max(0, 27.13*math.Abs(v)/(400-math.Abs(v)))
+		rgbF[i] = sign(v) * (100 / vc.fl) * math.Pow(base, 1/0.42) / vc.rgbD[i]
+	}
+	x := 1.86206786*rgbF[0] - 1.01125463*rgbF[1] + 0.14918677*rgbF[2]
+	y := 0.38752654*rgbF[0] + 0.62144744*rgbF[1] - 0.00897398*rgbF[2]
+	z := -0.01584150*rgbF[0] - 0.03412294*rgbF[1] + 1.04996444*rgbF[2]
+	return colorFromXYZ(x, y, z)
+}
+
+// solveHCT finds the sRGB color with the given hue and tone whose chroma is
+// closest to, without exceeding, the requested chroma.
+func solveHCT(hue, chroma, tone float64) graphics.Color {
+	if chroma < 1 || math.Round(tone) <= 0 || math.Round(tone) >= 100 {
+		return colorFromLstar(tone)
+	}
+	hue = sanitizeDegrees(hue)
+
+	// Binary search chroma downward until a color with this tone is in gamut.
+	low, high, mid := 0.0, chroma, chroma
+	var answer *cam16
+	for first := true; math.Abs(low-high) >= 0.4; first = false {
+		candidate := findCamByJ(hue, mid, tone)
+		if first {
+			if candidate != nil {
+				return candidate.color()
+			}
+		} else if candidate == nil {
+			high = mid
+		} else {
+			answer = candidate
+			low = mid
+		}
+		mid = low + (high-low)/2
+	}
+	if answer == nil {
+		return colorFromLstar(tone)
+	}
+	return answer.color()
+}
+
+// findCamByJ searches CAM16 lightness for an in-gamut color with the given
+// hue and chroma whose L* matches tone, or returns nil if there is none.
+func findCamByJ(hue, chroma, tone float64) *cam16 {
+	low, high := 0.0, 100.0
+	bestDL, bestDE := 1000.0, 1000.0
+	var best *cam16
+	for math.Abs(low-high) > 0.01 {
+		mid := low + (high-low)/2
+		clipped := newCam16(mid, chroma, hue).color()
+		clippedLstar := lstarFromColor(clipped)
+		dL := math.Abs(tone - clippedLstar)
+		if dL < 0.2 {
+			cam := cam16FromColor(clipped)
+			dE := cam.distance(newCam16(cam.j, cam.chroma, hue))
+			if dE <= 1 && dE <= bestDE {
+				bestDL, bestDE = dL, dE
+				best = &cam
+			}
+		}
+		if bestDL == 0 && bestDE == 0 {
+			break
+		}
+		if clippedLstar < tone {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return best
+}
+
+func xyzToCam16RGB(x, y, z float64) (r, g, b float64) {
+	return 0.401288*x + 0.650173*y - 0.051461*z,
+		-0.250268*x + 1.204414*y + 0.045854*z,
+		-0.002079*x + 0.048952*y + 0.953127*z
+}
+
+func colorFromXYZ(x, y, z float64) graphics.Color {
+	r := 3.2413774792388685*x - 1.5376652402851851*y - 0.49885366846268053*z
+	g := -0.9691452513005321*x + 1.8758853451067872*y + 0.04156585616912061*z
+	b := 0.05562093689691305*x - 0.20395524564742123*y + 1.0571799111220335*z
+	return graphics.RGB(delinearized(r), delinearized(g), delinearized(b))
+}
+
+func colorFromLstar(lstar float64) graphics.Color {
+	v := delinearized(yFromLstar(lstar))
+	return graphics.RGB(v, v, v)
+}
+
+func lstarFromColor(c graphics.Color) float64 {
+	y := 0.2126*linearized(uint8(c>>16)) + 0.7152*linearized(uint8(c>>8)) + 0.0722*linearized(uint8(c))
+	return 116*labF(y/100) - 16
+}
+
+func yFromLstar(lstar float64) float64 {
+	return 100 * labInvF((lstar+16)/116)
+}
+
+// linearized converts an sRGB component to linear light on a 0-100 scale.
+func linearized(component uint8) float64 {
+	n := float64(component) / 255
+	if n <= 0.040449936 {
+		return n / 12.92 * 100
+	}
+	return math.Pow((n+0.055)/1.055, 2.4) * 100
+}
+
+// delinearized converts linear light on a 0-100 scale to an sRGB component.
+func delinearized(linear float64) uint8 {
+	n := linear / 100
+	var v float64
+	if n <= 0.0031308 {
+		v = n * 12.92
+	} else {
+		v = 1.055*math.Pow(n, 1/2.4) - 0.055
+	}
+	return uint8(clamp(math.Round(v*255), 0, 255))
+}
+
+const (
+	labEpsilon = 216.0 / 24389
+	labKappa   = 24389.0 / 27
+)
+
+func labF(t float64) float64 {
+	if t > labEpsilon {
+		return math.Cbrt(t)
+	}
+	return (labKappa*t + 16) / 116
+}
+
+func labInvF(ft float64) float64 {
+	if ft3 := ft * ft * ft; ft3 > labEpsilon {
+		return ft3
+	}
+	return (116*ft - 16) / labKappa
+}
+
+func sanitizeDegrees(degrees float64) float64 {
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees
+}
+
+func sign(v float64) float64 {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Min(math.Max(v, lo), hi)
+}
@@ -14,14 +14,16 @@ const (
 
 // ColorsOf returns the ColorScheme from the nearest Theme ancestor.
 // If no Theme is found, returns the default light color scheme.
+// Widgets calling this rebuild only when the color scheme changes.
 func ColorsOf(ctx core.BuildContext) ColorScheme {
-	return ThemeOf(ctx).ColorScheme
+	return themeDataOf(ctx, ThemeAspectColors).ColorScheme
 }
 
 // TextThemeOf returns the TextTheme from the nearest Theme ancestor.
 // If no Theme is found, returns the default text theme.
+// Widgets calling this rebuild only when the text theme changes.
 func TextThemeOf(ctx core.BuildContext) TextTheme {
-	return ThemeOf(ctx).TextTheme
+	return themeDataOf(ctx, ThemeAspectTextTheme).TextTheme
 }
 
 // UseTheme returns all theme components in a single call.
@@ -44,7 +46,7 @@ func UseTheme(ctx core.BuildContext) (*ThemeData, ColorScheme, TextTheme) {
 // Otherwise, returns TargetPlatformMaterial.
 func PlatformOf(ctx core.BuildContext) TargetPlatform {
 	// Check AppTheme first (unified provider)
-	if appTheme := AppThemeMaybeOf(ctx); appTheme != nil {
+	if appTheme := appThemeMaybeOf(ctx, ThemeAspectPlatform); appTheme != nil {
 		return appTheme.Platform
 	}
 	// Fall back to checking CupertinoTheme presence
@@ -0,0 +1,141 @@
+package theme
+
+import (
+	"math"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// Lerp interpolates every color of the scheme toward other; t runs from 0
+// (the receiver) to 1 (other). Brightness switches to other's at the
+// halfway point.
+func (c ColorScheme) Lerp(other ColorScheme, t float64) ColorScheme {
+	return ColorScheme{
+		Primary:                 animation.LerpColor(c.Primary, other.Primary, t),
+		OnPrimary:               animation.LerpColor(c.OnPrimary, other.OnPrimary, t),
+		PrimaryContainer:        animation.LerpColor(c.PrimaryContainer, other.PrimaryContainer, t),
+		OnPrimaryContainer:      animation.LerpColor(c.OnPrimaryContainer, other.OnPrimaryContainer, t),
+		Secondary:               animation.LerpColor(c.Secondary, other.Secondary, t),
+		OnSecondary:             animation.LerpColor(c.OnSecondary, other.OnSecondary, t),
+		SecondaryContainer:      animation.LerpColor(c.SecondaryContainer, other.SecondaryContainer, t),
+		OnSecondaryContainer:    animation.LerpColor(c.OnSecondaryContainer, other.OnSecondaryContainer, t),
+		Tertiary:                animation.LerpColor(c.Tertiary, other.Tertiary, t),
+		OnTertiary:              animation.LerpColor(c.OnTertiary, other.OnTertiary, t),
+		TertiaryContainer:       animation.LerpColor(c.TertiaryContainer, other.TertiaryContainer, t),
+		OnTertiaryContainer:     animation.LerpColor(c.OnTertiaryContainer, other.OnTertiaryContainer, t),
+		Surface:                 animation.LerpColor(c.Surface, other.Surface, t),
+		OnSurface:               animation.LerpColor(c.OnSurface, other.OnSurface, t),
+		SurfaceVariant:          animation.LerpColor(c.SurfaceVariant, other.SurfaceVariant, t),
+		OnSurfaceVariant:        animation.LerpColor(c.OnSurfaceVariant, other.OnSurfaceVariant, t),
+		SurfaceDim:              animation.LerpColor(c.SurfaceDim, other.SurfaceDim, t),
+		SurfaceBright:           animation.LerpColor(c.SurfaceBright, other.SurfaceBright, t),
+		SurfaceContainerLowest:  animation.LerpColor(c.SurfaceContainerLowest, other.SurfaceContainerLowest, t),
+		SurfaceContainerLow:     animation.LerpColor(c.SurfaceContainerLow, other.SurfaceContainerLow, t),
+		SurfaceContainer:        animation.LerpColor(c.SurfaceContainer, other.SurfaceContainer, t),
+		SurfaceContainerHigh:    animation.LerpColor(c.SurfaceContainerHigh, other.SurfaceContainerHigh, t),
+		SurfaceContainerHighest: animation.LerpColor(c.SurfaceContainerHighest, other.SurfaceContainerHighest, t),
+		Background:              animation.LerpColor(c.Background, other.Background, t),
+		OnBackground:            animation.LerpColor(c.OnBackground, other.OnBackground, t),
+		Error:                   animation.LerpColor(c.Error, other.Error, t),
+		OnError:                 animation.LerpColor(c.OnError, other.OnError, t),
+		ErrorContainer:          animation.LerpColor(c.ErrorContainer, other.ErrorContainer, t),
+		OnErrorContainer:        animation.LerpColor(c.OnErrorContainer, other.OnErrorContainer, t),
+		Outline:                 animation.LerpColor(c.Outline, other.Outline, t),
+		OutlineVariant:          animation.LerpColor(c.OutlineVariant, other.OutlineVariant, t),
+		Shadow:                  animation.LerpColor(c.Shadow, other.Shadow, t),
+		Scrim:                   animation.LerpColor(c.Scrim, other.Scrim, t),
+		InverseSurface:          animation.LerpColor(c.InverseSurface, other.InverseSurface, t),
+		OnInverseSurface:        animation.LerpColor(c.OnInverseSurface, other.OnInverseSurface, t),
+		InversePrimary:          animation.LerpColor(c.InversePrimary, other.InversePrimary, t),
+		SurfaceTint:             animation.LerpColor(c.SurfaceTint, other.SurfaceTint, t),
+		Brightness:              pick(c.Brightness, other.Brightness, t),
+	}
+}
+
+// Lerp interpolates every style of the text theme toward other. Colors,
+// sizes, letter spacing, and line heights blend smoothly; font weights step
+// through the nearest standard weights, and properties that can't be blended,
+// such as font family, switch at the halfway point.
+func (tt TextTheme) Lerp(other TextTheme, t float64) TextTheme {
+	return TextTheme{
+		DisplayLarge:   lerpTextStyle(tt.DisplayLarge, other.DisplayLarge, t),
+		DisplayMedium:  lerpTextStyle(tt.DisplayMedium, other.DisplayMedium, t),
+		DisplaySmall:   lerpTextStyle(tt.DisplaySmall, other.DisplaySmall, t),
+		HeadlineLarge:  lerpTextStyle(tt.HeadlineLarge, other.HeadlineLarge, t),
+		HeadlineMedium: lerpTextStyle(tt.HeadlineMedium, other.HeadlineMedium, t),
+		HeadlineSmall:  lerpTextStyle(tt.HeadlineSmall, other.HeadlineSmall, t),
+		TitleLarge:     lerpTextStyle(tt.TitleLarge, other.TitleLarge, t),
+		TitleMedium:    lerpTextStyle(tt.TitleMedium, other.TitleMedium, t),
+		TitleSmall:     lerpTextStyle(tt.TitleSmall, other.TitleSmall, t),
+		BodyLarge:      lerpTextStyle(tt.BodyLarge, other.BodyLarge, t),
+		BodyMedium:     lerpTextStyle(tt.BodyMedium, other.BodyMedium, t),
+		BodySmall:      lerpTextStyle(tt.BodySmall, other.BodySmall, t),
+		LabelLarge:     lerpTextStyle(tt.LabelLarge, other.LabelLarge, t),
+		LabelMedium:    lerpTextStyle(tt.LabelMedium, other.LabelMedium, t),
+		LabelSmall:     lerpTextStyle(tt.LabelSmall, other.LabelSmall, t),
+	}
+}
+
+// LerpThemeData interpolates between two themes, for animating a theme
+// change. Colors, text styles, and extensions blend; component themes,
+// spacing, shape, elevation, and motion schemes, and brightness switch at the
+// halfway point. Nil component themes and a nil elevation scheme are derived
+// from the blended ColorScheme, so they follow the animation too.
+func LerpThemeData(a, b *ThemeData, t float64) *ThemeData {
+	if a == nil || t >= 1 {
+		return b
+	}
+	if b == nil || t <= 0 {
+		return a
+	}
+	c := pick(a, b, t)
+	return &ThemeData{
+		ColorScheme:        a.ColorScheme.Lerp(b.ColorScheme, t),
+		TextTheme:          a.TextTheme.Lerp(b.TextTheme, t),
+		Brightness:         c.Brightness,
+		ButtonTheme:        c.ButtonTheme,
+		CheckboxTheme:      c.CheckboxTheme,
+		SwitchTheme:        c.SwitchTheme,
+		SliderTheme:        c.SliderTheme,
+		TextFieldTheme:     c.TextFieldTheme,
+		TabBarTheme:        c.TabBarTheme,
+		RadioTheme:         c.RadioTheme,
+		DropdownTheme:      c.DropdownTheme,
+		BottomSheetTheme:   c.BottomSheetTheme,
+		DividerTheme:       c.DividerTheme,
+		DialogTheme:        c.DialogTheme,
+		AppBarTheme:        c.AppBarTheme,
+		NavigationBarTheme: c.NavigationBarTheme,
+		Spacing:            c.Spacing,
+		Shapes:             c.Shapes,
+		Elevation:          c.Elevation,
+		Motion:             c.Motion,
+		extensions:         lerpExtensions(a, b, t),
+	}
+}
+
+func lerpTextStyle(a, b graphics.TextStyle, t float64) graphics.TextStyle {
+	result := pick(a, b, t)
+	result.Color = animation.LerpColor(a.Color, b.Color, t)
+	result.FontSize = animation.LerpFloat64(a.FontSize, b.FontSize, t)
+	result.LetterSpacing = animation.LerpFloat64(a.LetterSpacing, b.LetterSpacing, t)
+	// A zero height means the font's default, which has no numeric value
+	// to blend from.
+	if a.Height != 0 && b.Height != 0 {
+		result.Height = animation.LerpFloat64(a.Height, b.Height, t)
+	}
+	if a.FontWeight != 0 && b.FontWeight != 0 {
+		weight := animation.LerpFloat64(float64(a.FontWeight), float64(b.FontWeight), t)
+		result.FontWeight = graphics.FontWeight(math.Round(weight/100) * 100)
+	}
+	return result
+}
+
+// pick returns a before the halfway point and b from it on.
+func pick[T any](a, b T, t float64) T {
+	if t < 0.5 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,67 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestColorScheme_Lerp(t *testing.T) {
+	light, dark := LightColorScheme(), DarkColorScheme()
+
+	if got := light.Lerp(dark, 0); got != light {
+		t.Error("Lerp at 0 should return the receiver")
+	}
+	if got := light.Lerp(dark, 1); got != dark {
+		t.Error("Lerp at 1 should return other")
+	}
+	mid := light.Lerp(dark, 0.5)
+	if mid.Surface == light.Surface || mid.Surface == dark.Surface {
+		t.Errorf("Surface at 0.5 = %v, want a blend", mid.Surface)
+	}
+	if mid.Brightness != dark.Brightness {
+		t.Errorf("Brightness at 0.5 = %v, want %v", mid.Brightness, dark.Brightness)
+	}
+}
+
+func TestLerpTextStyle(t *testing.T) {
+	a := graphics.TextStyle{FontFamily: "a", FontSize: 10, FontWeight: graphics.FontWeightNormal, Height: 1}
+	b := graphics.TextStyle{FontFamily: "b", FontSize: 20, FontWeight: graphics.FontWeightBold, Height: 2}
+
+	mid := lerpTextStyle(a, b, 0.5)
+	if mid.FontSize != 15 || mid.Height != 1.5 {
+		t.Errorf("FontSize, Height = %v, %v; want 15, 1.5", mid.FontSize, mid.Height)
+	}
+	if mid.FontWeight != 600 {
+		t.Errorf("FontWeight = %v, want 600", mid.FontWeight)
+	}
+	if mid.FontFamily != "b" {
+		t.Errorf("FontFamily = %q, want b", mid.FontFamily)
+	}
+
+	a.Height = 0
+	if got := lerpTextStyle(a, b, 0.25).Height; got != 0 {
+		t.Errorf("Height from default = %v, want 0 before the halfway point", got)
+	}
+}
+
+func TestLerpThemeData(t *testing.T) {
+	light, dark := DefaultLightTheme(), DefaultDarkTheme()
+	if LerpThemeData(light, dark, 0) != light || LerpThemeData(light, dark, 1) != dark {
+		t.Error("LerpThemeData should return the endpoints unchanged")
+	}
+
+	button := &ButtonThemeData{}
+	light.ButtonTheme = button
+	early := LerpThemeData(light, dark, 0.25)
+	if early.ButtonTheme != button || early.Brightness != BrightnessLight {
+		t.Error("expected component themes and brightness from a before the halfway point")
+	}
+	late := LerpThemeData(light, dark, 0.75)
+	if late.ButtonTheme != nil || late.Brightness != BrightnessDark {
+		t.Error("expected component themes and brightness from b after the halfway point")
+	}
+	if late.TextTheme.BodyMedium.Color == dark.TextTheme.BodyMedium.Color {
+		t.Error("expected text colors to still be blending")
+	}
+}
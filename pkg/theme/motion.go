@@ -0,0 +1,52 @@
+package theme
+
+import (
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// MotionScheme defines the standard animation durations and curves.
+//
+// Built-in components take their animation timing from this scheme when their
+// component theme is not set. Use it for custom animations so the whole app
+// moves at one pace:
+//
+//	motion := theme.Motion(ctx)
+//	widgets.AnimatedContainer{
+//	    Duration: motion.Medium,
+//	    Curve:    motion.Standard,
+//	    Color:    color,
+//	}
+type MotionScheme struct {
+	// Short is for small, quick changes such as state layers and elevation.
+	// Default: 200ms.
+	Short time.Duration
+	// Medium is for components that change size or position. Default: 300ms.
+	Medium time.Duration
+	// Long is for large or full-screen transitions. Default: 450ms.
+	Long time.Duration
+	// Standard is the curve for most animations. Default: [animation.EaseInOut].
+	Standard func(float64) float64
+	// Emphasized is the curve for prominent transitions.
+	// Default: [animation.IOSNavigationCurve].
+	Emphasized func(float64) float64
+}
+
+// DefaultMotionScheme returns the default 200/300/450ms motion scheme.
+func DefaultMotionScheme() MotionScheme {
+	return MotionScheme{
+		Short:      200 * time.Millisecond,
+		Medium:     300 * time.Millisecond,
+		Long:       450 * time.Millisecond,
+		Standard:   animation.EaseInOut,
+		Emphasized: animation.IOSNavigationCurve,
+	}
+}
+
+// Motion returns the MotionScheme from the nearest Theme ancestor.
+// If no Theme is found, returns the default motion scheme.
+func Motion(ctx core.BuildContext) MotionScheme {
+	return ThemeOf(ctx).MotionOf()
+}
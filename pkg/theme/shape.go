@@ -0,0 +1,42 @@
+package theme
+
+import "github.com/go-drift/drift/pkg/core"
+
+// ShapeScheme defines the corner radius scale used by component surfaces.
+//
+// Built-in component themes take their corner radii from this scale when the
+// component theme is not set, so one change restyles every button, field,
+// sheet, and dialog:
+//
+//	shapes := theme.DefaultShapeScheme()
+//	shapes.Small = 0 // square buttons and fields
+//	themeData.Shapes = &shapes
+type ShapeScheme struct {
+	// ExtraSmall is for small controls such as checkboxes. Default: 4.
+	ExtraSmall float64
+	// Small is for buttons, text fields, dropdowns, and pickers. Default: 8.
+	Small float64
+	// Medium is for cards and menus. Default: 12.
+	Medium float64
+	// Large is for bottom sheets. Default: 16.
+	Large float64
+	// ExtraLarge is for dialogs. Default: 28.
+	ExtraLarge float64
+}
+
+// DefaultShapeScheme returns the default 4/8/12/16/28 corner radius scale.
+func DefaultShapeScheme() ShapeScheme {
+	return ShapeScheme{
+		ExtraSmall: 4,
+		Small:      8,
+		Medium:     12,
+		Large:      16,
+		ExtraLarge: 28,
+	}
+}
+
+// Shapes returns the ShapeScheme from the nearest Theme ancestor.
+// If no Theme is found, returns the default shape scheme.
+func Shapes(ctx core.BuildContext) ShapeScheme {
+	return ThemeOf(ctx).ShapesOf()
+}
@@ -0,0 +1,114 @@
+package theme
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// SpacingScheme defines the spacing scale used for gaps and padding.
+//
+// Reading spacing from the theme instead of hard-coding values keeps layouts
+// consistent and lets a design system retune every gap from one place:
+//
+//	sp := theme.Spacing(ctx)
+//	widgets.Padding{Padding: layout.EdgeInsetsAll(sp.M), Child: content}
+type SpacingScheme struct {
+	// XS is the extra-small step, for tightly grouped elements.
+	XS float64
+	// S is the small step, for related elements such as an icon and its label.
+	S float64
+	// M is the medium step, the default gap between elements.
+	M float64
+	// L is the large step, for separating groups.
+	L float64
+	// XL is the extra-large step, for separating sections.
+	XL float64
+}
+
+// DefaultSpacingScheme returns the default 4/8/16/24/32 spacing scale.
+func DefaultSpacingScheme() SpacingScheme {
+	return SpacingScheme{
+		XS: 4,
+		S:  8,
+		M:  16,
+		L:  24,
+		XL: 32,
+	}
+}
+
+// SpacingSize selects a step on the [SpacingScheme] scale.
+type SpacingSize int
+
+const (
+	// SpaceM selects [SpacingScheme.M]. It is the zero value so an unset
+	// size uses the default gap.
+	SpaceM SpacingSize = iota
+	// SpaceXS selects [SpacingScheme.XS].
+	SpaceXS
+	// SpaceS selects [SpacingScheme.S].
+	SpaceS
+	// SpaceL selects [SpacingScheme.L].
+	SpaceL
+	// SpaceXL selects [SpacingScheme.XL].
+	SpaceXL
+)
+
+// Value returns the spacing for the given step of the scale.
+func (s SpacingScheme) Value(size SpacingSize) float64 {
+	switch size {
+	case SpaceXS:
+		return s.XS
+	case SpaceS:
+		return s.S
+	case SpaceL:
+		return s.L
+	case SpaceXL:
+		return s.XL
+	default:
+		return s.M
+	}
+}
+
+// Insets returns uniform [layout.EdgeInsets] for the given step of the scale.
+func (s SpacingScheme) Insets(size SpacingSize) layout.EdgeInsets {
+	return layout.EdgeInsetsAll(s.Value(size))
+}
+
+// Spacing returns the SpacingScheme from the nearest Theme ancestor.
+// If no Theme is found, returns the default spacing scheme.
+func Spacing(ctx core.BuildContext) SpacingScheme {
+	return ThemeOf(ctx).SpacingOf()
+}
+
+// Gap is a fixed-width horizontal space sized from the theme's [SpacingScheme].
+// Use it between children of a Row in place of [widgets.HSpace]:
+//
+//	widgets.Row{Children: []core.Widget{icon, theme.Gap{Size: theme.SpaceS}, label}}
+type Gap struct {
+	core.StatelessBase
+
+	// Size selects the spacing step. Defaults to SpaceM.
+	Size SpacingSize
+}
+
+// Build returns a SizedBox as wide as the selected spacing step.
+func (g Gap) Build(ctx core.BuildContext) core.Widget {
+	return widgets.SizedBox{Width: Spacing(ctx).Value(g.Size)}
+}
+
+// VGap is a fixed-height vertical space sized from the theme's [SpacingScheme].
+// Use it between children of a Column in place of [widgets.VSpace]:
+//
+//	widgets.Column{Children: []core.Widget{title, theme.VGap{Size: theme.SpaceL}, body}}
+type VGap struct {
+	core.StatelessBase
+
+	// Size selects the spacing step. Defaults to SpaceM.
+	Size SpacingSize
+}
+
+// Build returns a SizedBox as tall as the selected spacing step.
+func (g VGap) Build(ctx core.BuildContext) core.Widget {
+	return widgets.SizedBox{Height: Spacing(ctx).Value(g.Size)}
+}
@@ -26,17 +26,76 @@ func (t Theme) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
 	return true
 }
 
+// ShouldRebuildDependent implements [core.AspectAwareInheritedWidget], so
+// widgets that read one part of the theme with [ColorsOf] or [TextThemeOf]
+// only rebuild when that part changes.
+func (t Theme) ShouldRebuildDependent(oldWidget core.InheritedWidget, aspects map[any]struct{}) bool {
+	old, ok := oldWidget.(Theme)
+	if !ok {
+		return true
+	}
+	return themeDataChanged(old.Data, t.Data, aspects)
+}
+
+// ThemeAspect identifies which part of the theme a widget depends on, so it
+// only rebuilds when that part changes. [ColorsOf], [TextThemeOf], and
+// [PlatformOf] register the matching aspect; [ThemeOf] depends on the whole
+// theme.
+type ThemeAspect int
+
+const (
+	// ThemeAspectColors is the Material color scheme.
+	ThemeAspectColors ThemeAspect = iota
+	// ThemeAspectTextTheme is the Material text theme.
+	ThemeAspectTextTheme
+	// ThemeAspectPlatform is the target platform of an [AppTheme].
+	ThemeAspectPlatform
+)
+
+// themeDataChanged reports whether any of aspects differs between old and
+// new.
+func themeDataChanged(old, new *ThemeData, aspects map[any]struct{}) bool {
+	if old == new {
+		return false
+	}
+	if old == nil || new == nil {
+		return true
+	}
+	for aspect := range aspects {
+		switch aspect {
+		case ThemeAspectColors:
+			if old.ColorScheme != new.ColorScheme {
+				return true
+			}
+		case ThemeAspectTextTheme:
+			if old.TextTheme != new.TextTheme {
+				return true
+			}
+		case ThemeAspectPlatform:
+		default:
+			return true
+		}
+	}
+	return false
+}
+
 var themeType = reflect.TypeFor[Theme]()
 
 // ThemeOf returns the nearest ThemeData in the tree.
 // If no Theme ancestor is found, returns the default light theme.
 func ThemeOf(ctx core.BuildContext) *ThemeData {
+	return themeDataOf(ctx, nil)
+}
+
+// themeDataOf is ThemeOf, depending only on aspect of the theme when it is
+// non-nil.
+func themeDataOf(ctx core.BuildContext, aspect any) *ThemeData {
 	// Check AppTheme first (unified provider)
-	if appTheme := AppThemeMaybeOf(ctx); appTheme != nil {
+	if appTheme := appThemeMaybeOf(ctx, aspect); appTheme != nil {
 		return appTheme.Material
 	}
 	// Fall back to legacy Theme widget
-	inherited := ctx.DependOnInherited(themeType, nil)
+	inherited := ctx.DependOnInherited(themeType, aspect)
 	if inherited == nil {
 		return DefaultLightTheme()
 	}
@@ -1,5 +1,7 @@
 package theme
 
+import "reflect"
+
 // ThemeData contains all theme configuration for an application.
 type ThemeData struct {
 	// ColorScheme defines the color palette.
@@ -12,16 +14,37 @@ type ThemeData struct {
 	Brightness Brightness
 
 	// Component themes - optional, derived from ColorScheme if nil.
-	ButtonTheme      *ButtonThemeData
-	CheckboxTheme    *CheckboxThemeData
-	SwitchTheme      *SwitchThemeData
-	TextFieldTheme   *TextFieldThemeData
-	TabBarTheme      *TabBarThemeData
-	RadioTheme       *RadioThemeData
-	DropdownTheme    *DropdownThemeData
-	BottomSheetTheme *BottomSheetThemeData
-	DividerTheme     *DividerThemeData
-	DialogTheme      *DialogThemeData
+	ButtonTheme        *ButtonThemeData
+	CheckboxTheme      *CheckboxThemeData
+	SwitchTheme        *SwitchThemeData
+	SliderTheme        *SliderThemeData
+	TextFieldTheme     *TextFieldThemeData
+	TabBarTheme        *TabBarThemeData
+	RadioTheme         *RadioThemeData
+	DropdownTheme      *DropdownThemeData
+	BottomSheetTheme   *BottomSheetThemeData
+	DividerTheme       *DividerThemeData
+	DialogTheme        *DialogThemeData
+	AppBarTheme        *AppBarThemeData
+	NavigationBarTheme *NavigationBarThemeData
+
+	// Spacing defines the spacing scale. Uses DefaultSpacingScheme if nil.
+	Spacing *SpacingScheme
+
+	// Shapes defines the corner radius scale. Uses DefaultShapeScheme if nil.
+	Shapes *ShapeScheme
+
+	// Elevation defines shadows and surface tint per elevation level.
+	// Derived from ColorScheme if nil.
+	Elevation *ElevationScheme
+
+	// Motion defines standard animation durations and curves.
+	// Uses DefaultMotionScheme if nil.
+	Motion *MotionScheme
+
+	// extensions holds custom theme data keyed by type. Attach with
+	// [WithExtension] and read with [ExtensionOf] or [UseExtension].
+	extensions map[reflect.Type]themeExtension
 }
 
 // DefaultLightTheme returns the default light theme.
@@ -47,19 +70,27 @@ func DefaultDarkTheme() *ThemeData {
 // CopyWith returns a new ThemeData with the specified fields overridden.
 func (t *ThemeData) CopyWith(colorScheme *ColorScheme, textTheme *TextTheme, brightness *Brightness) *ThemeData {
 	result := &ThemeData{
-		ColorScheme:      t.ColorScheme,
-		TextTheme:        t.TextTheme,
-		Brightness:       t.Brightness,
-		ButtonTheme:      t.ButtonTheme,
-		CheckboxTheme:    t.CheckboxTheme,
-		SwitchTheme:      t.SwitchTheme,
-		TextFieldTheme:   t.TextFieldTheme,
-		TabBarTheme:      t.TabBarTheme,
-		RadioTheme:       t.RadioTheme,
-		DropdownTheme:    t.DropdownTheme,
-		BottomSheetTheme: t.BottomSheetTheme,
-		DividerTheme:     t.DividerTheme,
-		DialogTheme:      t.DialogTheme,
+		ColorScheme:        t.ColorScheme,
+		TextTheme:          t.TextTheme,
+		Brightness:         t.Brightness,
+		ButtonTheme:        t.ButtonTheme,
+		CheckboxTheme:      t.CheckboxTheme,
+		SwitchTheme:        t.SwitchTheme,
+		SliderTheme:        t.SliderTheme,
+		TextFieldTheme:     t.TextFieldTheme,
+		TabBarTheme:        t.TabBarTheme,
+		RadioTheme:         t.RadioTheme,
+		DropdownTheme:      t.DropdownTheme,
+		BottomSheetTheme:   t.BottomSheetTheme,
+		DividerTheme:       t.DividerTheme,
+		DialogTheme:        t.DialogTheme,
+		AppBarTheme:        t.AppBarTheme,
+		NavigationBarTheme: t.NavigationBarTheme,
+		Spacing:            t.Spacing,
+		Shapes:             t.Shapes,
+		Elevation:          t.Elevation,
+		Motion:             t.Motion,
+		extensions:         t.extensions,
 	}
 	if colorScheme != nil {
 		result.ColorScheme = *colorScheme
@@ -73,20 +104,26 @@ func (t *ThemeData) CopyWith(colorScheme *ColorScheme, textTheme *TextTheme, bri
 	return result
 }
 
-// ButtonThemeOf returns the button theme, deriving from ColorScheme if not set.
+// ButtonThemeOf returns the button theme, deriving from ColorScheme and
+// Shapes if not set.
 func (t *ThemeData) ButtonThemeOf() ButtonThemeData {
 	if t.ButtonTheme != nil {
 		return *t.ButtonTheme
 	}
-	return DefaultButtonTheme(t.ColorScheme)
+	th := DefaultButtonTheme(t.ColorScheme)
+	th.BorderRadius = t.ShapesOf().Small
+	return th
 }
 
-// CheckboxThemeOf returns the checkbox theme, deriving from ColorScheme if not set.
+// CheckboxThemeOf returns the checkbox theme, deriving from ColorScheme and
+// Shapes if not set.
 func (t *ThemeData) CheckboxThemeOf() CheckboxThemeData {
 	if t.CheckboxTheme != nil {
 		return *t.CheckboxTheme
 	}
-	return DefaultCheckboxTheme(t.ColorScheme)
+	th := DefaultCheckboxTheme(t.ColorScheme)
+	th.BorderRadius = t.ShapesOf().ExtraSmall
+	return th
 }
 
 // SwitchThemeOf returns the switch theme, deriving from ColorScheme if not set.
@@ -97,12 +134,23 @@ func (t *ThemeData) SwitchThemeOf() SwitchThemeData {
 	return DefaultSwitchTheme(t.ColorScheme)
 }
 
-// TextFieldThemeOf returns the text field theme, deriving from ColorScheme if not set.
+// SliderThemeOf returns the slider theme, deriving from ColorScheme if not set.
+func (t *ThemeData) SliderThemeOf() SliderThemeData {
+	if t.SliderTheme != nil {
+		return *t.SliderTheme
+	}
+	return DefaultSliderTheme(t.ColorScheme)
+}
+
+// TextFieldThemeOf returns the text field theme, deriving from ColorScheme and
+// Shapes if not set.
 func (t *ThemeData) TextFieldThemeOf() TextFieldThemeData {
 	if t.TextFieldTheme != nil {
 		return *t.TextFieldTheme
 	}
-	return DefaultTextFieldTheme(t.ColorScheme)
+	th := DefaultTextFieldTheme(t.ColorScheme)
+	th.BorderRadius = t.ShapesOf().Small
+	return th
 }
 
 // TabBarThemeOf returns the tab bar theme, deriving from ColorScheme if not set.
@@ -121,12 +169,15 @@ func (t *ThemeData) RadioThemeOf() RadioThemeData {
 	return DefaultRadioTheme(t.ColorScheme)
 }
 
-// DropdownThemeOf returns the dropdown theme, deriving from ColorScheme if not set.
+// DropdownThemeOf returns the dropdown theme, deriving from ColorScheme and
+// Shapes if not set.
 func (t *ThemeData) DropdownThemeOf() DropdownThemeData {
 	if t.DropdownTheme != nil {
 		return *t.DropdownTheme
 	}
-	return DefaultDropdownTheme(t.ColorScheme)
+	th := DefaultDropdownTheme(t.ColorScheme)
+	th.BorderRadius = t.ShapesOf().Small
+	return th
 }
 
 // DividerThemeOf returns the divider theme, deriving from ColorScheme if not set.
@@ -138,18 +189,86 @@ func (t *ThemeData) DividerThemeOf() DividerThemeData {
 }
 
 // DialogThemeOf returns the dialog theme, falling back to [DefaultDialogTheme]
-// when [ThemeData.DialogTheme] is nil.
+// with the ExtraLarge shape when [ThemeData.DialogTheme] is nil.
 func (t *ThemeData) DialogThemeOf() DialogThemeData {
 	if t.DialogTheme != nil {
 		return *t.DialogTheme
 	}
-	return DefaultDialogTheme(t.ColorScheme)
+	th := DefaultDialogTheme(t.ColorScheme)
+	th.BorderRadius = t.ShapesOf().ExtraLarge
+	return th
 }
 
-// BottomSheetThemeOf returns the bottom sheet theme, deriving from ColorScheme if not set.
+// AppBarThemeOf returns the app bar theme, falling back to [DefaultAppBarTheme]
+// with the theme's elevation colors and short motion duration when
+// [ThemeData.AppBarTheme] is nil.
+func (t *ThemeData) AppBarThemeOf() AppBarThemeData {
+	if t.AppBarTheme != nil {
+		return *t.AppBarTheme
+	}
+	th := DefaultAppBarTheme(t.ColorScheme)
+	elevation := t.ElevationOf()
+	th.SurfaceTintColor = elevation.SurfaceTintColor
+	th.ShadowColor = elevation.ShadowColor
+	th.Duration = t.MotionOf().Short
+	return th
+}
+
+// NavigationBarThemeOf returns the navigation bar theme, falling back to
+// [DefaultNavigationBarTheme] with the theme's short motion duration when
+// [ThemeData.NavigationBarTheme] is nil.
+func (t *ThemeData) NavigationBarThemeOf() NavigationBarThemeData {
+	if t.NavigationBarTheme != nil {
+		return *t.NavigationBarTheme
+	}
+	th := DefaultNavigationBarTheme(t.ColorScheme)
+	th.Duration = t.MotionOf().Short
+	return th
+}
+
+// BottomSheetThemeOf returns the bottom sheet theme, deriving from ColorScheme
+// and Shapes if not set.
 func (t *ThemeData) BottomSheetThemeOf() BottomSheetThemeData {
 	if t.BottomSheetTheme != nil {
 		return *t.BottomSheetTheme
 	}
-	return DefaultBottomSheetTheme(t.ColorScheme)
+	th := DefaultBottomSheetTheme(t.ColorScheme)
+	th.BorderRadius = t.ShapesOf().Large
+	return th
+}
+
+// SpacingOf returns the spacing scheme, falling back to [DefaultSpacingScheme]
+// when [ThemeData.Spacing] is nil.
+func (t *ThemeData) SpacingOf() SpacingScheme {
+	if t.Spacing != nil {
+		return *t.Spacing
+	}
+	return DefaultSpacingScheme()
+}
+
+// ShapesOf returns the shape scheme, falling back to [DefaultShapeScheme]
+// when [ThemeData.Shapes] is nil.
+func (t *ThemeData) ShapesOf() ShapeScheme {
+	if t.Shapes != nil {
+		return *t.Shapes
+	}
+	return DefaultShapeScheme()
+}
+
+// ElevationOf returns the elevation scheme, falling back to
+// [DefaultElevationScheme] when [ThemeData.Elevation] is nil.
+func (t *ThemeData) ElevationOf() ElevationScheme {
+	if t.Elevation != nil {
+		return *t.Elevation
+	}
+	return DefaultElevationScheme(t.ColorScheme)
+}
+
+// MotionOf returns the motion scheme, falling back to [DefaultMotionScheme]
+// when [ThemeData.Motion] is nil.
+func (t *ThemeData) MotionOf() MotionScheme {
+	if t.Motion != nil {
+		return *t.Motion
+	}
+	return DefaultMotionScheme()
 }
@@ -0,0 +1,104 @@
+package theme
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// ThemeMode selects between an app's light and dark themes.
+type ThemeMode int
+
+const (
+	// ThemeModeSystem follows the platform's dark mode setting.
+	ThemeModeSystem ThemeMode = iota
+	// ThemeModeLight always uses the light theme.
+	ThemeModeLight
+	// ThemeModeDark always uses the dark theme.
+	ThemeModeDark
+)
+
+// String returns a human-readable representation of the theme mode.
+func (m ThemeMode) String() string {
+	switch m {
+	case ThemeModeSystem:
+		return "system"
+	case ThemeModeLight:
+		return "light"
+	case ThemeModeDark:
+		return "dark"
+	default:
+		return "unknown"
+	}
+}
+
+// SystemTheme provides the [Theme] that matches Mode and the platform's
+// dark mode and high contrast settings from [widgets.DarkModeOf] and
+// [widgets.HighContrastOf]. When the user changes those settings, the theme
+// rebuilds; other display changes, such as the text scale, don't affect it.
+//
+// Missing variants fall back: Dark to Light when no dark theme is given,
+// the high contrast themes to their regular counterparts, and Light to
+// [DefaultLightTheme].
+type SystemTheme struct {
+	core.StatelessBase
+
+	// Light is the theme for light mode.
+	Light *ThemeData
+	// Dark is the theme for dark mode.
+	Dark *ThemeData
+	// HighContrastLight replaces Light when high contrast is requested.
+	HighContrastLight *ThemeData
+	// HighContrastDark replaces Dark when high contrast is requested.
+	HighContrastDark *ThemeData
+	// Mode selects which theme to use. Defaults to [ThemeModeSystem].
+	Mode ThemeMode
+	// Child is the child widget tree.
+	Child core.Widget
+}
+
+// Build implements core.StatelessWidget.
+func (s SystemTheme) Build(ctx core.BuildContext) core.Widget {
+	data := s.Resolve(widgets.MediaQueryData{
+		DarkMode:     widgets.DarkModeOf(ctx),
+		HighContrast: widgets.HighContrastOf(ctx),
+	})
+	if parent := AppThemeMaybeOf(ctx); parent != nil {
+		// Keep the parent's Cupertino theme unless it has the wrong brightness.
+		cupertino := parent.Cupertino
+		if cupertino == nil || cupertino.Brightness != data.Brightness {
+			cupertino = NewAppThemeData(parent.Platform, data.Brightness).Cupertino
+		}
+		return AppTheme{
+			Data: &AppThemeData{
+				Platform:  parent.Platform,
+				Material:  data,
+				Cupertino: cupertino,
+			},
+			Child: s.Child,
+		}
+	}
+	return Theme{Data: data, Child: s.Child}
+}
+
+// Resolve returns the theme to use for the given display settings.
+func (s SystemTheme) Resolve(media widgets.MediaQueryData) *ThemeData {
+	dark := s.Mode == ThemeModeDark || (s.Mode == ThemeModeSystem && media.DarkMode)
+
+	light := s.Light
+	if light == nil {
+		light = DefaultLightTheme()
+	}
+	if media.HighContrast && s.HighContrastLight != nil {
+		light = s.HighContrastLight
+	}
+	if !dark {
+		return light
+	}
+	if media.HighContrast && s.HighContrastDark != nil {
+		return s.HighContrastDark
+	}
+	if s.Dark != nil {
+		return s.Dark
+	}
+	return light
+}
@@ -0,0 +1,75 @@
+package theme_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/theme"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestSystemTheme_Resolve(t *testing.T) {
+	light, dark := theme.DefaultLightTheme(), theme.DefaultDarkTheme()
+	hcLight, hcDark := theme.DefaultLightTheme(), theme.DefaultDarkTheme()
+	full := theme.SystemTheme{Light: light, Dark: dark, HighContrastLight: hcLight, HighContrastDark: hcDark}
+
+	tests := []struct {
+		name  string
+		theme theme.SystemTheme
+		mode  theme.ThemeMode
+		media widgets.MediaQueryData
+		want  *theme.ThemeData
+	}{
+		{"system light", full, theme.ThemeModeSystem, widgets.MediaQueryData{}, light},
+		{"system dark", full, theme.ThemeModeSystem, widgets.MediaQueryData{DarkMode: true}, dark},
+		{"forced light", full, theme.ThemeModeLight, widgets.MediaQueryData{DarkMode: true}, light},
+		{"forced dark", full, theme.ThemeModeDark, widgets.MediaQueryData{}, dark},
+		{"high contrast light", full, theme.ThemeModeSystem, widgets.MediaQueryData{HighContrast: true}, hcLight},
+		{"high contrast dark", full, theme.ThemeModeDark, widgets.MediaQueryData{HighContrast: true}, hcDark},
+		{"no dark theme", theme.SystemTheme{Light: light}, theme.ThemeModeDark, widgets.MediaQueryData{}, light},
+		{"no high contrast", theme.SystemTheme{Light: light, Dark: dark}, theme.ThemeModeSystem,
+			widgets.MediaQueryData{DarkMode: true, HighContrast: true}, dark},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.theme.Mode = tt.mode
+			if got := tt.theme.Resolve(tt.media); got != tt.want {
+				t.Errorf("Resolve() returned the wrong theme (brightness %v)", got.Brightness)
+			}
+		})
+	}
+
+	if got := (theme.SystemTheme{}).Resolve(widgets.MediaQueryData{}); got == nil || got.Brightness != theme.BrightnessLight {
+		t.Error("expected the default light theme when no themes are given")
+	}
+}
+
+func TestSystemTheme_FollowsPlatformAppearance(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	t.Cleanup(func() { platform.Appearance.SetAppearanceForTest(platform.SystemAppearance{}) })
+	light, dark := theme.DefaultLightTheme(), theme.DefaultDarkTheme()
+
+	var seen *theme.ThemeData
+	err := tester.PumpWidget(widgets.MediaQueryProvider{
+		Child: theme.SystemTheme{
+			Light: light,
+			Dark:  dark,
+			Child: themeProbe{probe: func(data *theme.ThemeData) { seen = data }},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seen != light {
+		t.Fatal("expected the light theme initially")
+	}
+
+	platform.Appearance.SetAppearanceForTest(platform.SystemAppearance{DarkMode: true})
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if seen != dark {
+		t.Error("expected the dark theme after the platform switched to dark mode")
+	}
+}
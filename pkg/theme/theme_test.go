@@ -153,6 +153,31 @@ func TestSwitchThemeOf_Custom(t *testing.T) {
 	}
 }
 
+func TestSliderThemeOf_Default(t *testing.T) {
+	th := DefaultLightTheme()
+	st := th.SliderThemeOf()
+
+	if st.ActiveTrackColor != th.ColorScheme.Primary {
+		t.Error("default SliderTheme.ActiveTrackColor should match Primary")
+	}
+	if st.ThumbRadius == 0 || st.TrackHeight == 0 {
+		t.Error("default SliderTheme should have a visible thumb and track")
+	}
+}
+
+func TestSliderThemeOf_Custom(t *testing.T) {
+	th := DefaultLightTheme()
+	custom := &SliderThemeData{ThumbColor: graphics.RGB(1, 2, 3), ThumbRadius: 6}
+	th.SliderTheme = custom
+
+	if got := th.SliderThemeOf(); got != *custom {
+		t.Errorf("should return custom slider theme, got %+v", got)
+	}
+	if th.CopyWith(nil, nil, nil).SliderTheme != custom {
+		t.Error("CopyWith should preserve SliderTheme")
+	}
+}
+
 func TestTextFieldThemeOf_Default(t *testing.T) {
 	th := DefaultLightTheme()
 	tf := th.TextFieldThemeOf()
@@ -251,6 +276,133 @@ func TestBottomSheetThemeOf_Custom(t *testing.T) {
 	}
 }
 
+func TestSpacingOf_Default(t *testing.T) {
+	th := DefaultLightTheme()
+	sp := th.SpacingOf()
+
+	if sp != DefaultSpacingScheme() {
+		t.Errorf("expected default spacing scheme, got %+v", sp)
+	}
+	if sp.Value(SpaceM) != sp.M {
+		t.Error("zero SpacingSize should select M")
+	}
+}
+
+func TestSpacingOf_Custom(t *testing.T) {
+	th := DefaultLightTheme()
+	th.Spacing = &SpacingScheme{XS: 2, S: 4, M: 8, L: 12, XL: 16}
+
+	sp := th.SpacingOf()
+	for size, want := range map[SpacingSize]float64{SpaceXS: 2, SpaceS: 4, SpaceM: 8, SpaceL: 12, SpaceXL: 16} {
+		if got := sp.Value(size); got != want {
+			t.Errorf("Value(%d) = %v, want %v", size, got, want)
+		}
+	}
+	if th.CopyWith(nil, nil, nil).Spacing != th.Spacing {
+		t.Error("Spacing should be preserved by CopyWith")
+	}
+}
+
+func TestShapesOf_DefaultKeepsComponentRadii(t *testing.T) {
+	th := DefaultLightTheme()
+	colors := th.ColorScheme
+
+	if got, want := th.ButtonThemeOf().BorderRadius, DefaultButtonTheme(colors).BorderRadius; got != want {
+		t.Errorf("button radius = %v, want %v", got, want)
+	}
+	if got, want := th.CheckboxThemeOf().BorderRadius, DefaultCheckboxTheme(colors).BorderRadius; got != want {
+		t.Errorf("checkbox radius = %v, want %v", got, want)
+	}
+	if got, want := th.DialogThemeOf().BorderRadius, DefaultDialogTheme(colors).BorderRadius; got != want {
+		t.Errorf("dialog radius = %v, want %v", got, want)
+	}
+	if got, want := th.BottomSheetThemeOf().BorderRadius, DefaultBottomSheetTheme(colors).BorderRadius; got != want {
+		t.Errorf("bottom sheet radius = %v, want %v", got, want)
+	}
+}
+
+func TestShapesOf_Custom(t *testing.T) {
+	th := DefaultLightTheme()
+	th.Shapes = &ShapeScheme{ExtraSmall: 1, Small: 2, Medium: 3, Large: 4, ExtraLarge: 5}
+
+	tests := []struct {
+		name      string
+		got, want float64
+	}{
+		{"button", th.ButtonThemeOf().BorderRadius, 2},
+		{"checkbox", th.CheckboxThemeOf().BorderRadius, 1},
+		{"text field", th.TextFieldThemeOf().BorderRadius, 2},
+		{"dropdown", th.DropdownThemeOf().BorderRadius, 2},
+		{"bottom sheet", th.BottomSheetThemeOf().BorderRadius, 4},
+		{"dialog", th.DialogThemeOf().BorderRadius, 5},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s radius = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	th.ButtonTheme = &ButtonThemeData{BorderRadius: 9}
+	if got := th.ButtonThemeOf().BorderRadius; got != 9 {
+		t.Errorf("explicit button theme radius = %v, want 9", got)
+	}
+	if th.CopyWith(nil, nil, nil).Shapes != th.Shapes {
+		t.Error("Shapes should be preserved by CopyWith")
+	}
+}
+
+func TestElevationScheme_MatchesBoxShadowElevation(t *testing.T) {
+	th := DefaultLightTheme()
+	elev := th.ElevationOf()
+
+	if elev.Shadow(0) != nil {
+		t.Error("level 0 should have no shadow")
+	}
+	for level := 1; level <= 5; level++ {
+		got := elev.Shadow(level)
+		want := graphics.BoxShadowElevation(level, th.ColorScheme.Shadow)
+		if got == nil || *got != *want {
+			t.Errorf("Shadow(%d) = %+v, want %+v", level, got, want)
+		}
+	}
+	if got, want := *elev.Shadow(9), *elev.Shadow(5); got != want {
+		t.Error("levels above 5 should clamp to 5")
+	}
+
+	surface := th.ColorScheme.Surface
+	if elev.SurfaceColor(surface, 0) != surface {
+		t.Error("level 0 should leave the surface untinted")
+	}
+	if elev.SurfaceColor(surface, 3) == surface {
+		t.Error("level 3 should tint the surface")
+	}
+	elev.SurfaceTintColor = graphics.ColorTransparent
+	if elev.SurfaceColor(surface, 3) != surface {
+		t.Error("a transparent tint should leave the surface untinted")
+	}
+}
+
+func TestMotionOf_FeedsAppBarTheme(t *testing.T) {
+	th := DefaultLightTheme()
+	if got, want := th.AppBarThemeOf().Duration, DefaultMotionScheme().Short; got != want {
+		t.Errorf("default app bar duration = %v, want %v", got, want)
+	}
+
+	motion := DefaultMotionScheme()
+	motion.Short = 0
+	th.Motion = &motion
+	if got := th.AppBarThemeOf().Duration; got != 0 {
+		t.Errorf("app bar duration = %v, want the motion scheme's Short", got)
+	}
+
+	elev := DefaultElevationScheme(th.ColorScheme)
+	elev.SurfaceTintColor = graphics.RGB(1, 2, 3)
+	th.Elevation = &elev
+	if got := th.AppBarThemeOf().SurfaceTintColor; got != elev.SurfaceTintColor {
+		t.Errorf("app bar tint = %v, want the elevation scheme's tint", got)
+	}
+}
+
 // --- ColorScheme sanity ---
 
 func TestLightColorScheme(t *testing.T) {
@@ -379,3 +531,46 @@ func TestDefaultBottomSheetTheme_UsesColorScheme(t *testing.T) {
 		t.Error("HandleColor should be OnSurfaceVariant")
 	}
 }
+
+func TestAppTheme_ShouldRebuildDependent_FiltersByAspect(t *testing.T) {
+	light := NewAppThemeData(TargetPlatformMaterial, BrightnessLight)
+	retext := light.Copy()
+	retext.Material.TextTheme.BodyLarge.FontSize += 2
+	dark := NewAppThemeData(TargetPlatformMaterial, BrightnessDark)
+
+	colors := map[any]struct{}{ThemeAspectColors: {}}
+	text := map[any]struct{}{ThemeAspectTextTheme: {}}
+	platform := map[any]struct{}{ThemeAspectPlatform: {}}
+
+	old := AppTheme{Data: light}
+	if (AppTheme{Data: retext}).ShouldRebuildDependent(old, colors) {
+		t.Error("expected a text theme change not to rebuild color dependents")
+	}
+	if !(AppTheme{Data: retext}).ShouldRebuildDependent(old, text) {
+		t.Error("expected a text theme change to rebuild text theme dependents")
+	}
+	if !(AppTheme{Data: dark}).ShouldRebuildDependent(old, colors) {
+		t.Error("expected a brightness change to rebuild color dependents")
+	}
+	if (AppTheme{Data: dark}).ShouldRebuildDependent(old, platform) {
+		t.Error("expected a color change not to rebuild platform dependents")
+	}
+	cupertino := NewAppThemeData(TargetPlatformCupertino, BrightnessLight)
+	if !(AppTheme{Data: cupertino}).ShouldRebuildDependent(old, platform) {
+		t.Error("expected a platform change to rebuild platform dependents")
+	}
+}
+
+func TestTheme_ShouldRebuildDependent_FiltersByAspect(t *testing.T) {
+	light := DefaultLightTheme()
+	retext := light.CopyWith(nil, nil, nil)
+	retext.TextTheme.BodyLarge.FontSize += 2
+
+	old := Theme{Data: light}
+	if (Theme{Data: retext}).ShouldRebuildDependent(old, map[any]struct{}{ThemeAspectColors: {}}) {
+		t.Error("expected a text theme change not to rebuild color dependents")
+	}
+	if !(Theme{Data: retext}).ShouldRebuildDependent(old, map[any]struct{}{ThemeAspectTextTheme: {}}) {
+		t.Error("expected a text theme change to rebuild text theme dependents")
+	}
+}
@@ -0,0 +1,739 @@
+package theme
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// DesignTokens is a parsed design tokens document in the W3C Design Tokens
+// Community Group format or the Style Dictionary format.
+//
+// Tokens are addressed by their dot-separated path, such as
+// "color.brand.primary". Aliases ("{color.blue.500}") are resolved when the
+// document is parsed, so every token holds a concrete value.
+//
+// Use [DesignTokens.ApplyTo] or [ThemeFromDesignTokens] to build a
+// [ThemeData] from the tokens, and [DesignTokens.Color] and
+// [DesignTokens.Dimension] to read tokens the theme has no slot for.
+type DesignTokens struct {
+	tokens map[string]designToken
+}
+
+type designToken struct {
+	// typ is the token's $type, inherited from its groups or alias target.
+	// Empty when the document does not declare one.
+	typ   string
+	value any
+}
+
+// ParseDesignTokens parses a design tokens JSON document.
+//
+// Both token styles are accepted: W3C objects with "$value" and "$type",
+// where groups may declare a "$type" for their children, and Style
+// Dictionary objects with "value" and "type". Aliases may point at any token
+// in the document, including from inside composite values such as
+// typography. An alias to a missing token or an alias cycle is an error.
+func ParseDesignTokens(data []byte) (*DesignTokens, error) {
+	var root map[string]any
+	if err := json.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("design tokens: %w", err)
+	}
+	raw := make(map[string]designToken)
+	collectDesignTokens(root, "", "", raw)
+
+	resolved := make(map[string]designToken, len(raw))
+	for path := range raw {
+		if _, err := resolveDesignToken(path, raw, resolved, nil); err != nil {
+			return nil, err
+		}
+	}
+	return &DesignTokens{tokens: resolved}, nil
+}
+
+// ThemeFromDesignTokens parses a design tokens document and applies it to
+// base, or to [DefaultLightTheme] if base is nil. See [DesignTokens.ApplyTo]
+// for how tokens map to theme slots.
+//
+// Embed the token file to load it at build time:
+//
+//	//go:embed tokens.json
+//	var tokensJSON []byte
+//
+//	lightTheme, err := theme.ThemeFromDesignTokens(tokensJSON, nil)
+func ThemeFromDesignTokens(data []byte, base *ThemeData) (*ThemeData, error) {
+	tokens, err := ParseDesignTokens(data)
+	if err != nil {
+		return nil, err
+	}
+	return tokens.ApplyTo(base)
+}
+
+// Paths returns the paths of all tokens in sorted order.
+func (d *DesignTokens) Paths() []string {
+	paths := make([]string, 0, len(d.tokens))
+	for path := range d.tokens {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// Group returns the tokens below prefix with the prefix removed, so
+// tokens.Group("dark").Color("color.primary") reads "dark.color.primary".
+// Use it to select one mode from a document that holds several.
+func (d *DesignTokens) Group(prefix string) *DesignTokens {
+	prefix = strings.TrimSuffix(prefix, ".") + "."
+	group := &DesignTokens{tokens: make(map[string]designToken)}
+	for path, token := range d.tokens {
+		if rest, ok := strings.CutPrefix(path, prefix); ok {
+			group.tokens[rest] = token
+		}
+	}
+	return group
+}
+
+// Color returns the color token at path. It reports false if there is no
+// such token or its value is not a color.
+func (d *DesignTokens) Color(path string) (graphics.Color, bool) {
+	token, ok := d.tokens[path]
+	if !ok {
+		return 0, false
+	}
+	c, err := parseTokenColor(token.value)
+	return c, err == nil
+}
+
+// Dimension returns the dimension token at path in logical pixels. Values
+// may be plain numbers or carry a px, rem, or em unit; rem and em are
+// relative to a 16px root font size. It reports false if there is no such
+// token or its value is not a dimension.
+func (d *DesignTokens) Dimension(path string) (float64, bool) {
+	token, ok := d.tokens[path]
+	if !ok {
+		return 0, false
+	}
+	v, err := parseTokenDimension(token.value)
+	return v, err == nil
+}
+
+// ApplyTo returns a copy of base with the theme slots that have matching
+// tokens replaced. If base is nil, [DefaultLightTheme] is used.
+//
+// Tokens are matched by the group they sit in and their remaining path, with
+// case, hyphens, and underscores ignored, so "color.on-primary",
+// "colors.onPrimary", and "md.sys.color.on_primary" all set
+// ColorScheme.OnPrimary:
+//
+//   - color, colors: [ColorScheme] fields, such as color.surface-container-high
+//   - typography, typescale: [TextTheme] styles as composite typography
+//     tokens (typescale.body-large), or one property at a time
+//     (typescale.body-large.size, .weight, .font, .line-height, .tracking)
+//   - spacing, space: [SpacingScheme] steps named xs, s, m, l, xl or
+//     extra-small through extra-large
+//   - radius, radii, shape, corner: [ShapeScheme] sizes named the same way
+//
+// Tokens that match no slot are ignored; read them with [DesignTokens.Color]
+// and [DesignTokens.Dimension]. Text styles that used the base theme's
+// OnBackground color follow a new OnBackground token. A matched token with
+// a value of the wrong kind is an error.
+func (d *DesignTokens) ApplyTo(base *ThemeData) (*ThemeData, error) {
+	if base == nil {
+		base = DefaultLightTheme()
+	}
+	result := base.CopyWith(nil, nil, nil)
+	spacing := result.SpacingOf()
+	shapes := result.ShapesOf()
+	var setSpacing, setShapes bool
+
+	colors := reflect.ValueOf(&result.ColorScheme).Elem()
+	text := reflect.ValueOf(&result.TextTheme).Elem()
+	// Font sizes go first so that pixel line heights and em letter spacing
+	// in separate tokens are relative to the new size.
+	paths := d.Paths()
+	slices.SortStableFunc(paths, func(a, b string) int {
+		return cmp.Compare(tokenOrder(a), tokenOrder(b))
+	})
+	for _, path := range paths {
+		token := d.tokens[path]
+		category, name := tokenSlot(path)
+		var err error
+		switch category {
+		case tokenCategoryColor:
+			if field, ok := tokenField(colors, name); ok {
+				var c graphics.Color
+				if c, err = parseTokenColor(token.value); err == nil {
+					field.Set(reflect.ValueOf(c))
+				}
+			}
+		case tokenCategoryTypography:
+			err = applyTypographyToken(text, name, token)
+		case tokenCategorySpacing:
+			if step := spacingStep(&spacing, tokenScaleStep(name)); step != nil {
+				if *step, err = parseTokenDimension(token.value); err == nil {
+					setSpacing = true
+				}
+			}
+		case tokenCategoryShape:
+			if step := shapeStep(&shapes, tokenScaleStep(name)); step != nil {
+				if *step, err = parseTokenDimension(token.value); err == nil {
+					setShapes = true
+				}
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("design tokens: %s: %w", path, err)
+		}
+	}
+
+	if onBackground := result.ColorScheme.OnBackground; onBackground != base.ColorScheme.OnBackground {
+		for i := range text.NumField() {
+			style := text.Field(i).Addr().Interface().(*graphics.TextStyle)
+			if style.Color == base.ColorScheme.OnBackground {
+				style.Color = onBackground
+			}
+		}
+	}
+	if setSpacing {
+		result.Spacing = &spacing
+	}
+	if setShapes {
+		result.Shapes = &shapes
+	}
+	return result, nil
+}
+
+// collectDesignTokens flattens the token tree below node into out, keyed by
+// dot-separated path. typ is the $type inherited from enclosing groups.
+func collectDesignTokens(node map[string]any, path, typ string, out map[string]designToken) {
+	if t, ok := node["$type"].(string); ok {
+		typ = t
+	} else if t, ok := node["type"].(string); ok {
+		typ = t
+	}
+	if value, ok := node["$value"]; ok {
+		out[path] = designToken{typ: typ, value: value}
+		return
+	}
+	if value, ok := node["value"]; ok && isStyleDictionaryToken(node) {
+		out[path] = designToken{typ: typ, value: value}
+		return
+	}
+	for key, child := range node {
+		group, ok := child.(map[string]any)
+		if !ok || strings.HasPrefix(key, "$") {
+			continue
+		}
+		childPath := key
+		if path != "" {
+			childPath = path + "." + key
+		}
+		collectDesignTokens(group, childPath, typ, out)
+	}
+}
+
+// isStyleDictionaryToken reports whether a node with a "value" key is a
+// Style Dictionary token rather than a group that has a child named "value".
+// Tokens carry no child groups besides their attributes and extensions.
+func isStyleDictionaryToken(node map[string]any) bool {
+	for key, child := range node {
+		switch key {
+		case "value", "attributes", "extensions":
+			continue
+		}
+		if _, ok := child.(map[string]any); ok && !strings.HasPrefix(key, "$") {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveDesignToken resolves aliases in the token at path, memoizing results
+// in resolved. visiting holds the alias chain, for cycle detection.
+func resolveDesignToken(path string, raw, resolved map[string]designToken, visiting []string) (designToken, error) {
+	if token, ok := resolved[path]; ok {
+		return token, nil
+	}
+	token, ok := raw[path]
+	if !ok {
+		return designToken{}, fmt.Errorf("design tokens: %s: alias to missing token", visiting[len(visiting)-1])
+	}
+	if slices.Contains(visiting, path) {
+		return designToken{}, fmt.Errorf("design tokens: alias cycle: %s -> %s", strings.Join(visiting, " -> "), path)
+	}
+	visiting = append(visiting, path)
+
+	var resolveValue func(v any) (any, string, error)
+	resolveValue = func(v any) (any, string, error) {
+		switch v := v.(type) {
+		case string:
+			if target, ok := tokenAlias(v); ok {
+				t, err := resolveDesignToken(target, raw, resolved, visiting)
+				return t.value, t.typ, err
+			}
+		case map[string]any:
+			out := make(map[string]any, len(v))
+			for key, field := range v {
+				value, _, err := resolveValue(field)
+				if err != nil {
+					return nil, "", err
+				}
+				out[key] = value
+			}
+			return out, "", nil
+		}
+		return v, "", nil
+	}
+	value, aliasType, err := resolveValue(token.value)
+	if err != nil {
+		return designToken{}, err
+	}
+	token.value = value
+	if token.typ == "" {
+		token.typ = aliasType
+	}
+	resolved[path] = token
+	return token, nil
+}
+
+// tokenAlias returns the path referenced by an alias value such as
+// "{color.blue.500}".
+func tokenAlias(s string) (string, bool) {
+	if len(s) > 2 && s[0] == '{' && s[len(s)-1] == '}' && !strings.ContainsAny(s[1:len(s)-1], "{}") {
+		return s[1 : len(s)-1], true
+	}
+	return "", false
+}
+
+type tokenCategory int
+
+const (
+	tokenCategoryNone tokenCategory = iota
+	tokenCategoryColor
+	tokenCategoryTypography
+	tokenCategorySpacing
+	tokenCategoryShape
+)
+
+var tokenCategories = map[string]tokenCategory{
+	"color":        tokenCategoryColor,
+	"colors":       tokenCategoryColor,
+	"typography":   tokenCategoryTypography,
+	"typescale":    tokenCategoryTypography,
+	"spacing":      tokenCategorySpacing,
+	"space":        tokenCategorySpacing,
+	"radius":       tokenCategoryShape,
+	"radii":        tokenCategoryShape,
+	"borderradius": tokenCategoryShape,
+	"shape":        tokenCategoryShape,
+	"corner":       tokenCategoryShape,
+}
+
+// tokenSlot splits a token path at its last category group, returning the
+// category and the normalized segments that follow it.
+func tokenSlot(path string) (tokenCategory, []string) {
+	segments := strings.Split(path, ".")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if category, ok := tokenCategories[normalizeTokenName(segments[i])]; ok {
+			rest := make([]string, 0, len(segments)-i-1)
+			for _, s := range segments[i+1:] {
+				rest = append(rest, normalizeTokenName(s))
+			}
+			return category, rest
+		}
+	}
+	return tokenCategoryNone, nil
+}
+
+// tokenOrder returns 0 for typography font size tokens and 1 otherwise.
+func tokenOrder(path string) int {
+	category, name := tokenSlot(path)
+	if category == tokenCategoryTypography && len(name) > 0 {
+		switch name[len(name)-1] {
+		case "fontsize", "size":
+			return 0
+		}
+	}
+	return 1
+}
+
+// normalizeTokenName lowercases s and drops separators, so "on-primary",
+// "onPrimary", and "on_primary" compare equal.
+func normalizeTokenName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', '_', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(s))
+}
+
+// tokenField returns the field of the struct v whose normalized name equals
+// the joined name segments.
+func tokenField(v reflect.Value, name []string) (reflect.Value, bool) {
+	joined := strings.Join(name, "")
+	t := v.Type()
+	for i := range t.NumField() {
+		if strings.ToLower(t.Field(i).Name) == joined {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// tokenScaleStep maps the names used for spacing and shape steps to
+// xs, s, m, l, or xl.
+func tokenScaleStep(name []string) string {
+	switch strings.Join(name, "") {
+	case "xs", "extrasmall":
+		return "xs"
+	case "s", "sm", "small":
+		return "s"
+	case "m", "md", "medium":
+		return "m"
+	case "l", "lg", "large":
+		return "l"
+	case "xl", "extralarge":
+		return "xl"
+	}
+	return ""
+}
+
+func spacingStep(s *SpacingScheme, step string) *float64 {
+	switch step {
+	case "xs":
+		return &s.XS
+	case "s":
+		return &s.S
+	case "m":
+		return &s.M
+	case "l":
+		return &s.L
+	case "xl":
+		return &s.XL
+	}
+	return nil
+}
+
+func shapeStep(s *ShapeScheme, step string) *float64 {
+	switch step {
+	case "xs":
+		return &s.ExtraSmall
+	case "s":
+		return &s.Small
+	case "m":
+		return &s.Medium
+	case "l":
+		return &s.Large
+	case "xl":
+		return &s.ExtraLarge
+	}
+	return nil
+}
+
+// applyTypographyToken sets a whole text style from a composite token, or
+// one property from a token named after the style and the property.
+func applyTypographyToken(text reflect.Value, name []string, token designToken) error {
+	if field, ok := tokenField(text, name); ok {
+		m, ok := token.value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("want a typography object, got %v", token.value)
+		}
+		style := field.Addr().Interface().(*graphics.TextStyle)
+		// Apply the size first so relative line heights and spacing use it.
+		for _, key := range []string{"fontSize", "fontFamily", "fontWeight", "lineHeight", "letterSpacing"} {
+			if v, ok := m[key]; ok {
+				if err := setTextStyleProperty(style, normalizeTokenName(key), v, ""); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if len(name) < 2 {
+		return nil
+	}
+	field, ok := tokenField(text, name[:len(name)-1])
+	if !ok {
+		return nil
+	}
+	style := field.Addr().Interface().(*graphics.TextStyle)
+	return setTextStyleProperty(style, name[len(name)-1], token.value, token.typ)
+}
+
+// setTextStyleProperty sets the property named by a normalized token name.
+// typ is the token's type, used to tell pixel line heights from multipliers.
+func setTextStyleProperty(style *graphics.TextStyle, property string, value any, typ string) error {
+	var err error
+	switch property {
+	case "fontsize", "size":
+		style.FontSize, err = parseTokenDimension(value)
+	case "fontfamily", "family", "font":
+		style.FontFamily, err = parseTokenFontFamily(value)
+	case "fontweight", "weight":
+		style.FontWeight, err = parseTokenFontWeight(value)
+	case "lineheight":
+		style.Height, err = parseTokenLineHeight(value, typ, style.FontSize)
+	case "letterspacing", "tracking":
+		style.LetterSpacing, err = parseTokenLetterSpacing(value, style.FontSize)
+	}
+	return err
+}
+
+// parseTokenColor parses hex strings (#rgb, #rgba, #rrggbb, #rrggbbaa),
+// rgb() and rgba() strings, and W3C color objects with a hex or sRGB
+// components field.
+func parseTokenColor(value any) (graphics.Color, error) {
+	switch v := value.(type) {
+	case string:
+		s := strings.TrimSpace(v)
+		if hex, ok := strings.CutPrefix(s, "#"); ok {
+			return parseHexColor(hex)
+		}
+		lower := strings.ToLower(s)
+		if args, ok := strings.CutPrefix(lower, "rgba("); ok {
+			return parseRGBFunction(args)
+		}
+		if args, ok := strings.CutPrefix(lower, "rgb("); ok {
+			return parseRGBFunction(args)
+		}
+	case map[string]any:
+		alpha := 1.0
+		if a, ok := v["alpha"].(float64); ok {
+			alpha = a
+		}
+		if hex, ok := v["hex"].(string); ok {
+			c, err := parseHexColor(strings.TrimPrefix(hex, "#"))
+			if err != nil {
+				return 0, err
+			}
+			return c.WithAlpha(c.Alpha() * alpha), nil
+		}
+		if components, ok := v["components"].([]any); ok && len(components) == 3 {
+			if space, _ := v["colorSpace"].(string); space == "" || space == "srgb" {
+				var rgb [3]uint8
+				for i, component := range components {
+					f, ok := component.(float64)
+					if !ok {
+						return 0, fmt.Errorf("invalid color component %v", component)
+					}
+					rgb[i] = uint8(math.Round(clamp(f, 0, 1) * 255))
+				}
+				return graphics.RGBA(rgb[0], rgb[1], rgb[2], alpha), nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("invalid color %v", value)
+}
+
+func parseHexColor(hex string) (graphics.Color, error) {
+	switch len(hex) {
+	case 3, 4:
+		expanded := make([]byte, 0, 8)
+		for i := range len(hex) {
+			expanded = append(expanded, hex[i], hex[i])
+		}
+		hex = string(expanded)
+	case 6, 8:
+	default:
+		return 0, fmt.Errorf("invalid hex color #%s", hex)
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hex color #%s", hex)
+	}
+	if len(hex) == 6 {
+		return graphics.Color(0xFF000000 | uint32(n)), nil
+	}
+	// #rrggbbaa puts alpha last; graphics.Color puts it first.
+	return graphics.Color(uint32(n)>>8 | uint32(n)<<24), nil
+}
+
+// parseRGBFunction parses the arguments of rgb() or rgba(), from after the
+// opening parenthesis: channels from 0 to 255 and an optional alpha from 0
+// to 1 or a percentage, separated by commas or spaces.
+func parseRGBFunction(args string) (graphics.Color, error) {
+	body, ok := strings.CutSuffix(strings.TrimSpace(args), ")")
+	if !ok {
+		return 0, fmt.Errorf("invalid color rgb(%s", args)
+	}
+	parts := strings.FieldsFunc(body, func(r rune) bool { return r == ',' || r == ' ' || r == '/' })
+	if len(parts) != 3 && len(parts) != 4 {
+		return 0, fmt.Errorf("invalid color rgb(%s", args)
+	}
+	var rgb [3]uint8
+	for i := range 3 {
+		f, err := strconv.ParseFloat(parts[i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid color rgb(%s", args)
+		}
+		rgb[i] = uint8(math.Round(clamp(f, 0, 255)))
+	}
+	alpha := 1.0
+	if len(parts) == 4 {
+		a, percent := strings.CutSuffix(parts[3], "%")
+		f, err := strconv.ParseFloat(a, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid color rgb(%s", args)
+		}
+		if percent {
+			f /= 100
+		}
+		alpha = f
+	}
+	return graphics.RGBA(rgb[0], rgb[1], rgb[2], alpha), nil
+}
+
+// remSize is the root font size used to convert rem and em dimensions.
+const remSize = 16
+
+// parseTokenDimension parses a number, a string with a px, rem, or em unit,
+// or a W3C dimension object with value and unit fields.
+func parseTokenDimension(value any) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		s := strings.TrimSpace(v)
+		scale := 1.0
+		switch {
+		case strings.HasSuffix(s, "px"):
+			s = strings.TrimSuffix(s, "px")
+		case strings.HasSuffix(s, "rem"):
+			s, scale = strings.TrimSuffix(s, "rem"), remSize
+		case strings.HasSuffix(s, "em"):
+			s, scale = strings.TrimSuffix(s, "em"), remSize
+		}
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid dimension %q", v)
+		}
+		return f * scale, nil
+	case map[string]any:
+		if n, ok := v["value"].(float64); ok {
+			unit, _ := v["unit"].(string)
+			return parseTokenDimension(strconv.FormatFloat(n, 'f', -1, 64) + unit)
+		}
+	}
+	return 0, fmt.Errorf("invalid dimension %v", value)
+}
+
+// parseTokenFontFamily accepts a family name or a list of names, of which the
+// first is used.
+func parseTokenFontFamily(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []any:
+		if len(v) > 0 {
+			if s, ok := v[0].(string); ok {
+				return s, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("invalid font family %v", value)
+}
+
+var tokenFontWeights = map[string]graphics.FontWeight{
+	"thin":       graphics.FontWeightThin,
+	"hairline":   graphics.FontWeightThin,
+	"extralight": graphics.FontWeightExtraLight,
+	"ultralight": graphics.FontWeightExtraLight,
+	"light":      graphics.FontWeightLight,
+	"normal":     graphics.FontWeightNormal,
+	"regular":    graphics.FontWeightNormal,
+	"book":       graphics.FontWeightNormal,
+	"medium":     graphics.FontWeightMedium,
+	"semibold":   graphics.FontWeightSemibold,
+	"demibold":   graphics.FontWeightSemibold,
+	"bold":       graphics.FontWeightBold,
+	"extrabold":  graphics.FontWeightExtraBold,
+	"ultrabold":  graphics.FontWeightExtraBold,
+	"black":      graphics.FontWeightBlack,
+	"heavy":      graphics.FontWeightBlack,
+}
+
+// parseTokenFontWeight accepts a numeric weight from 1 to 1000 or a weight
+// name such as "semi-bold".
+func parseTokenFontWeight(value any) (graphics.FontWeight, error) {
+	switch v := value.(type) {
+	case float64:
+		if v >= 1 && v <= 1000 {
+			return graphics.FontWeight(math.Round(v)), nil
+		}
+	case string:
+		if w, ok := tokenFontWeights[normalizeTokenName(v)]; ok {
+			return w, nil
+		}
+		if n, err := strconv.ParseFloat(v, 64); err == nil {
+			return parseTokenFontWeight(n)
+		}
+	}
+	return 0, fmt.Errorf("invalid font weight %v", value)
+}
+
+// parseTokenLineHeight returns a line height multiplier. Plain numbers are
+// multipliers unless the token is typed as a dimension; pixel values and
+// percentages are converted using fontSize.
+func parseTokenLineHeight(value any, typ string, fontSize float64) (float64, error) {
+	if s, ok := value.(string); ok {
+		if p, ok := strings.CutSuffix(strings.TrimSpace(s), "%"); ok {
+			f, err := strconv.ParseFloat(p, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid line height %q", s)
+			}
+			return f / 100, nil
+		}
+	}
+	if n, ok := value.(float64); ok && typ != "dimension" {
+		return n, nil
+	}
+	if n, ok := value.(string); ok {
+		if f, err := strconv.ParseFloat(n, 64); err == nil && typ != "dimension" {
+			return f, nil
+		}
+	}
+	px, err := parseTokenDimension(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid line height %v", value)
+	}
+	if fontSize <= 0 {
+		return 0, fmt.Errorf("pixel line height %v needs a font size", value)
+	}
+	return px / fontSize, nil
+}
+
+// parseTokenLetterSpacing returns letter spacing in pixels. Em values and
+// percentages are relative to fontSize.
+func parseTokenLetterSpacing(value any, fontSize float64) (float64, error) {
+	if s, ok := value.(string); ok {
+		s = strings.TrimSpace(s)
+		relative := ""
+		switch {
+		case strings.HasSuffix(s, "rem"):
+		case strings.HasSuffix(s, "em"):
+			relative = "em"
+		case strings.HasSuffix(s, "%"):
+			relative = "%"
+		}
+		if relative != "" {
+			f, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, relative)), 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid letter spacing %q", s)
+			}
+			if relative == "%" {
+				f /= 100
+			}
+			return f * fontSize, nil
+		}
+	}
+	return parseTokenDimension(value)
+}
@@ -0,0 +1,197 @@
+package theme
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestThemeFromDesignTokens_W3C(t *testing.T) {
+	data := []byte(`{
+		"palette": {
+			"$type": "color",
+			"blue": {"500": {"$value": "#2196F3"}},
+			"ink": {"$value": "#1a1c1e"}
+		},
+		"color": {
+			"primary": {"$value": "{palette.blue.500}"},
+			"on-primary": {"$value": "#fff"},
+			"surfaceContainerHigh": {"$value": "rgba(10, 20, 30, 0.5)"},
+			"on_background": {"$value": "{palette.ink}"},
+			"brand-accent": {"$value": "#ff0000"}
+		},
+		"typography": {
+			"body-large": {
+				"$type": "typography",
+				"$value": {
+					"fontFamily": ["Inter", "sans-serif"],
+					"fontSize": "1rem",
+					"fontWeight": "semi-bold",
+					"lineHeight": 1.5,
+					"letterSpacing": "0.05em"
+				}
+			}
+		},
+		"spacing": {
+			"$type": "dimension",
+			"sm": {"$value": "6px"},
+			"extra-large": {"$value": {"value": 2, "unit": "rem"}}
+		},
+		"radius": {
+			"medium": {"$value": 10, "$type": "dimension"}
+		}
+	}`)
+
+	base := DefaultLightTheme()
+	got, err := ThemeFromDesignTokens(data, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.ColorScheme.Primary != graphics.RGB(0x21, 0x96, 0xF3) {
+		t.Errorf("Primary = %v, want alias to palette.blue.500", got.ColorScheme.Primary)
+	}
+	if got.ColorScheme.OnPrimary != graphics.RGB(255, 255, 255) {
+		t.Errorf("OnPrimary = %v, want white", got.ColorScheme.OnPrimary)
+	}
+	if want := graphics.RGBA(10, 20, 30, 0.5); got.ColorScheme.SurfaceContainerHigh != want {
+		t.Errorf("SurfaceContainerHigh = %v, want %v", got.ColorScheme.SurfaceContainerHigh, want)
+	}
+	if got.ColorScheme.Secondary != base.ColorScheme.Secondary {
+		t.Error("colors without tokens should keep the base value")
+	}
+
+	body := got.TextTheme.BodyLarge
+	if body.FontFamily != "Inter" || body.FontSize != 16 || body.FontWeight != graphics.FontWeightSemibold {
+		t.Errorf("BodyLarge = %+v, want Inter 16 semibold", body)
+	}
+	if body.Height != 1.5 || body.LetterSpacing != 0.8 {
+		t.Errorf("BodyLarge height %v spacing %v, want 1.5 and 0.8", body.Height, body.LetterSpacing)
+	}
+	ink := graphics.RGB(0x1a, 0x1c, 0x1e)
+	if body.Color != ink || got.TextTheme.TitleSmall.Color != ink {
+		t.Error("text styles should follow the new OnBackground color")
+	}
+
+	spacing := got.SpacingOf()
+	if spacing.S != 6 || spacing.XL != 32 || spacing.M != DefaultSpacingScheme().M {
+		t.Errorf("Spacing = %+v, want S 6, XL 32, default M", spacing)
+	}
+	if shapes := got.ShapesOf(); shapes.Medium != 10 {
+		t.Errorf("Shapes.Medium = %v, want 10", shapes.Medium)
+	}
+	if base.Spacing != nil || base.ColorScheme.Primary == got.ColorScheme.Primary {
+		t.Error("base theme should not be modified")
+	}
+}
+
+func TestThemeFromDesignTokens_StyleDictionary(t *testing.T) {
+	data := []byte(`{
+		"md": {"sys": {
+			"color": {
+				"primary": {"value": "#6750a4", "type": "color"},
+				"value": {"value": "#010203"}
+			},
+			"typescale": {
+				"display-large": {
+					"size": {"value": 60},
+					"weight": {"value": "700"},
+					"line-height": {"value": "72px", "type": "dimension"},
+					"tracking": {"value": -0.25}
+				}
+			},
+			"shape": {"corner": {"extra-small": {"value": "4px"}}}
+		}}
+	}`)
+
+	got, err := ThemeFromDesignTokens(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ColorScheme.Primary != graphics.RGB(0x67, 0x50, 0xa4) {
+		t.Errorf("Primary = %v", got.ColorScheme.Primary)
+	}
+	display := got.TextTheme.DisplayLarge
+	if display.FontSize != 60 || display.FontWeight != graphics.FontWeightBold || display.Height != 1.2 || display.LetterSpacing != -0.25 {
+		t.Errorf("DisplayLarge = %+v, want size 60, bold, height 1.2, tracking -0.25", display)
+	}
+	if got.ShapesOf().ExtraSmall != 4 {
+		t.Errorf("Shapes.ExtraSmall = %v, want 4", got.ShapesOf().ExtraSmall)
+	}
+
+	tokens, err := ParseDesignTokens(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c, ok := tokens.Color("md.sys.color.value"); !ok || c != graphics.RGB(1, 2, 3) {
+		t.Errorf("a token named value should be read as a token, got %v %v", c, ok)
+	}
+}
+
+func TestDesignTokens_Group(t *testing.T) {
+	tokens, err := ParseDesignTokens([]byte(`{
+		"base": {"white": {"$value": "#ffffff", "$type": "color"}},
+		"light": {"color": {"surface": {"$value": "{base.white}"}}},
+		"dark": {"color": {"surface": {"$value": "#121212"}}}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dark, err := tokens.Group("dark").ApplyTo(DefaultDarkTheme())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dark.ColorScheme.Surface != graphics.RGB(0x12, 0x12, 0x12) {
+		t.Errorf("dark Surface = %v", dark.ColorScheme.Surface)
+	}
+	if c, ok := tokens.Group("light").Color("color.surface"); !ok || c != graphics.RGB(255, 255, 255) {
+		t.Errorf("light surface = %v %v, want resolved alias", c, ok)
+	}
+	if _, ok := tokens.Dimension("base.white"); ok {
+		t.Error("a color token should not read as a dimension")
+	}
+}
+
+func TestParseDesignTokens_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"syntax", `{`, "design tokens:"},
+		{"missing alias", `{"a": {"$value": "{b}"}}`, "alias to missing token"},
+		{"cycle", `{"a": {"$value": "{b}"}, "b": {"$value": "{a}"}}`, "alias cycle"},
+		{"bad color", `{"color": {"primary": {"$value": "blue-ish"}}}`, "color.primary"},
+		{"bad weight", `{"typescale": {"body-small": {"weight": {"$value": "chunky"}}}}`, "invalid font weight"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ThemeFromDesignTokens([]byte(tt.json), nil)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTokenColor(t *testing.T) {
+	tests := []struct {
+		in   any
+		want graphics.Color
+	}{
+		{"#abc", graphics.RGB(0xaa, 0xbb, 0xcc)},
+		{"#abc8", graphics.RGBA8(0xaa, 0xbb, 0xcc, 0x88)},
+		{"#11223344", graphics.RGBA8(0x11, 0x22, 0x33, 0x44)},
+		{"rgb(1, 2, 3)", graphics.RGB(1, 2, 3)},
+		{"rgba(1 2 3 / 50%)", graphics.RGBA(1, 2, 3, 0.5)},
+		{map[string]any{"hex": "#ff0000", "alpha": 0.5}, graphics.RGBA(255, 0, 0, 0.5)},
+		{map[string]any{"colorSpace": "srgb", "components": []any{0.0, 1.0, 0.0}}, graphics.RGB(0, 255, 0)},
+	}
+	for _, tt := range tests {
+		got, err := parseTokenColor(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("parseTokenColor(%v) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
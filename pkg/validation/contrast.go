@@ -4,44 +4,20 @@
 // Package validation provides accessibility validation and linting tools.
 package validation
 
-import (
-	"math"
-
-	"github.com/go-drift/drift/pkg/graphics"
-)
+import "github.com/go-drift/drift/pkg/graphics"
 
 // ContrastRatio calculates the contrast ratio between two colors according to WCAG 2.1.
 // Returns a value between 1 and 21, where higher values indicate more contrast.
 // A ratio of 4.5:1 is required for normal text (AA), 7:1 for enhanced (AAA).
 // A ratio of 3:1 is required for large text (AA), 4.5:1 for enhanced (AAA).
 func ContrastRatio(fg, bg graphics.Color) float64 {
-	l1 := relativeLuminance(fg)
-	l2 := relativeLuminance(bg)
-
-	// Ensure l1 is the lighter color
-	if l1 < l2 {
-		l1, l2 = l2, l1
-	}
-
-	return (l1 + 0.05) / (l2 + 0.05)
+	return graphics.ContrastRatio(fg, bg)
 }
 
 // relativeLuminance calculates the relative luminance of a color.
 // See: https://www.w3.org/WAI/GL/wiki/Relative_luminance
 func relativeLuminance(c graphics.Color) float64 {
-	r := linearize(float64(c.R()) / 255)
-	g := linearize(float64(c.G()) / 255)
-	b := linearize(float64(c.B()) / 255)
-
-	return 0.2126*r + 0.7152*g + 0.0722*b
-}
-
-// linearize converts sRGB component to linear RGB.
-func linearize(v float64) float64 {
-	if v <= 0.03928 {
-		return v / 12.92
-	}
-	return math.Pow((v+0.055)/1.055, 2.4)
+	return c.Luminance()
 }
 
 // WCAGLevel represents a WCAG conformance level.
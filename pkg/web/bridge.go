@@ -0,0 +1,127 @@
+//go:build js && wasm
+
+package web
+
+import (
+	"errors"
+	"syscall/js"
+
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// jsBridge implements [platform.NativeBridge] by calling methods on the
+// globalThis.driftPlatform object:
+//
+//	invokeMethod(channel, method, args: Uint8Array): Uint8Array | null
+//	startEventStream(channel)
+//	stopEventStream(channel)
+//
+// Methods that the host page does not define fail with
+// platform.ErrPlatformUnavailable, so services degrade the same way they do
+// on a platform without the native plugin.
+type jsBridge struct {
+	host js.Value
+}
+
+func (b jsBridge) InvokeMethod(channel, method string, args []byte) (result []byte, err error) {
+	fn := b.host.Get("invokeMethod")
+	if fn.Type() != js.TypeFunction {
+		return nil, platform.ErrPlatformUnavailable
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = jsError(r)
+		}
+	}()
+	out := b.host.Call("invokeMethod", channel, method, bytesToJS(args))
+	if out.IsNull() || out.IsUndefined() {
+		return nil, nil
+	}
+	return bytesFromJS(out), nil
+}
+
+func (b jsBridge) StartEventStream(channel string) error {
+	return b.call("startEventStream", channel)
+}
+
+func (b jsBridge) StopEventStream(channel string) error {
+	return b.call("stopEventStream", channel)
+}
+
+func (b jsBridge) call(name, channel string) (err error) {
+	if b.host.Get(name).Type() != js.TypeFunction {
+		return platform.ErrPlatformUnavailable
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = jsError(r)
+		}
+	}()
+	b.host.Call(name, channel)
+	return nil
+}
+
+// installBridge registers the JavaScript bridge and exposes the functions the
+// host page uses to deliver events back to Go:
+//
+//	driftPlatform.onEvent = (channel, data: Uint8Array) => ...
+//	driftPlatform.onEventError = (channel, code, message) => ...
+//	driftPlatform.onEventDone = (channel) => ...
+func installBridge() {
+	host := js.Global().Get("driftPlatform")
+	if host.IsUndefined() || host.IsNull() {
+		host = js.Global().Get("Object").New()
+		js.Global().Set("driftPlatform", host)
+	}
+	host.Set("onEvent", js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) < 2 {
+			return nil
+		}
+		return errorValue(platform.HandleEvent(args[0].String(), bytesFromJS(args[1])))
+	}))
+	host.Set("onEventError", js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) < 3 {
+			return nil
+		}
+		return errorValue(platform.HandleEventError(args[0].String(), args[1].String(), args[2].String()))
+	}))
+	host.Set("onEventDone", js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) < 1 {
+			return nil
+		}
+		return errorValue(platform.HandleEventDone(args[0].String()))
+	}))
+	platform.SetNativeBridge(jsBridge{host: host})
+}
+
+func bytesToJS(data []byte) js.Value {
+	array := js.Global().Get("Uint8Array").New(len(data))
+	js.CopyBytesToJS(array, data)
+	return array
+}
+
+func bytesFromJS(value js.Value) []byte {
+	if value.Type() == js.TypeString {
+		return []byte(value.String())
+	}
+	if value.IsNull() || value.IsUndefined() {
+		return nil
+	}
+	data := make([]byte, value.Get("length").Int())
+	js.CopyBytesToGo(data, value)
+	return data
+}
+
+func jsError(r any) error {
+	if err, ok := r.(error); ok {
+		return err
+	}
+	return errors.New("web: javascript call failed")
+}
+
+func errorValue(err error) any {
+	if err != nil {
+		return err.Error()
+	}
+	return nil
+}
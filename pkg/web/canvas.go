@@ -0,0 +1,376 @@
+//go:build js && wasm
+
+package web
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"syscall/js"
+	"unsafe"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// htmlCanvas implements [graphics.Canvas] over a CanvasRenderingContext2D.
+// Layers are emulated with save/restore and globalAlpha, so SaveLayerAlpha
+// is exact only for non-overlapping content.
+type htmlCanvas struct {
+	ctx   js.Value
+	size  graphics.Size
+	alpha []float64 // globalAlpha of each saved state, innermost last
+}
+
+func newHTMLCanvas(ctx js.Value, size graphics.Size) *htmlCanvas {
+	return &htmlCanvas{ctx: ctx, size: size}
+}
+
+func (c *htmlCanvas) currentAlpha() float64 {
+	if n := len(c.alpha); n > 0 {
+		return c.alpha[n-1]
+	}
+	return 1
+}
+
+func (c *htmlCanvas) Save() {
+	c.ctx.Call("save")
+	c.alpha = append(c.alpha, c.currentAlpha())
+}
+
+func (c *htmlCanvas) SaveLayerAlpha(bounds graphics.Rect, alpha float64) {
+	c.Save()
+	a := c.currentAlpha() * alpha
+	c.alpha[len(c.alpha)-1] = a
+	c.ctx.Set("globalAlpha", a)
+}
+
+func (c *htmlCanvas) SaveLayer(bounds graphics.Rect, paint *graphics.Paint) {
+	if paint != nil && paint.Alpha > 0 && paint.Alpha < 1 {
+		c.SaveLayerAlpha(bounds, paint.Alpha)
+		return
+	}
+	c.Save()
+}
+
+func (c *htmlCanvas) Restore() {
+	if len(c.alpha) == 0 {
+		return
+	}
+	c.alpha = c.alpha[:len(c.alpha)-1]
+	c.ctx.Call("restore")
+}
+
+func (c *htmlCanvas) Translate(dx, dy float64) { c.ctx.Call("translate", dx, dy) }
+
+func (c *htmlCanvas) Scale(sx, sy float64) { c.ctx.Call("scale", sx, sy) }
+
+func (c *htmlCanvas) Rotate(radians float64) { c.ctx.Call("rotate", radians) }
+
+func (c *htmlCanvas) ClipRect(rect graphics.Rect) {
+	c.ctx.Call("beginPath")
+	c.ctx.Call("rect", rect.Left, rect.Top, rect.Width(), rect.Height())
+	c.ctx.Call("clip")
+}
+
+func (c *htmlCanvas) ClipRRect(rrect graphics.RRect) {
+	c.ctx.Call("beginPath")
+	c.rrectPath(rrect)
+	c.ctx.Call("clip")
+}
+
+func (c *htmlCanvas) ClipPath(path *graphics.Path, op graphics.ClipOp, antialias bool) {
+	if path == nil {
+		return
+	}
+	c.ctx.Call("beginPath")
+	if op == graphics.ClipOpDifference {
+		// Wind an enclosing rectangle so that even-odd filling subtracts path.
+		c.ctx.Call("rect", -1e6, -1e6, 2e6, 2e6)
+		c.tracePath(path)
+		c.ctx.Call("clip", "evenodd")
+		return
+	}
+	c.tracePath(path)
+	c.ctx.Call("clip", fillRule(path))
+}
+
+func (c *htmlCanvas) Clear(color graphics.Color) {
+	c.ctx.Call("save")
+	c.ctx.Call("setTransform", 1, 0, 0, 1, 0, 0)
+	c.ctx.Set("globalAlpha", 1)
+	c.ctx.Call("clearRect", 0, 0, c.size.Width, c.size.Height)
+	c.ctx.Set("fillStyle", cssColor(color))
+	c.ctx.Call("fillRect", 0, 0, c.size.Width, c.size.Height)
+	c.ctx.Call("restore")
+}
+
+func (c *htmlCanvas) DrawRect(rect graphics.Rect, paint graphics.Paint) {
+	c.ctx.Call("beginPath")
+	c.ctx.Call("rect", rect.Left, rect.Top, rect.Width(), rect.Height())
+	c.paintPath(paint, "nonzero")
+}
+
+func (c *htmlCanvas) DrawRRect(rrect graphics.RRect, paint graphics.Paint) {
+	c.ctx.Call("beginPath")
+	c.rrectPath(rrect)
+	c.paintPath(paint, "nonzero")
+}
+
+func (c *htmlCanvas) DrawCircle(center graphics.Offset, radius float64, paint graphics.Paint) {
+	c.ctx.Call("beginPath")
+	c.ctx.Call("arc", center.X, center.Y, radius, 0, 2*math.Pi)
+	c.paintPath(paint, "nonzero")
+}
+
+func (c *htmlCanvas) DrawLine(start, end graphics.Offset, paint graphics.Paint) {
+	c.ctx.Call("beginPath")
+	c.ctx.Call("moveTo", start.X, start.Y)
+	c.ctx.Call("lineTo", end.X, end.Y)
+	paint.Style = graphics.PaintStyleStroke
+	c.paintPath(paint, "nonzero")
+}
+
+// DrawText is a no-op: text layout requires Skia, which is unavailable on
+// the web target.
+func (c *htmlCanvas) DrawText(layout *graphics.TextLayout, position graphics.Offset) {}
+
+func (c *htmlCanvas) DrawImage(img image.Image, position graphics.Offset) {
+	if img == nil {
+		return
+	}
+	b := img.Bounds()
+	src := graphics.RectFromLTWH(float64(b.Min.X), float64(b.Min.Y), float64(b.Dx()), float64(b.Dy()))
+	dst := graphics.RectFromLTWH(position.X, position.Y, float64(b.Dx()), float64(b.Dy()))
+	c.DrawImageRect(img, src, dst, graphics.FilterQualityLow, 0)
+}
+
+func (c *htmlCanvas) DrawImageRect(img image.Image, srcRect, dstRect graphics.Rect, quality graphics.FilterQuality, cacheKey uintptr) {
+	bitmap := imageBitmap(img)
+	if bitmap.IsUndefined() {
+		return
+	}
+	c.ctx.Set("imageSmoothingEnabled", quality != graphics.FilterQualityNone)
+	c.ctx.Call("drawImage", bitmap,
+		srcRect.Left, srcRect.Top, srcRect.Width(), srcRect.Height(),
+		dstRect.Left, dstRect.Top, dstRect.Width(), dstRect.Height())
+}
+
+func (c *htmlCanvas) DrawPath(path *graphics.Path, paint graphics.Paint) {
+	if path == nil {
+		return
+	}
+	c.ctx.Call("beginPath")
+	c.tracePath(path)
+	c.paintPath(paint, fillRule(path))
+}
+
+// DrawVertices fills each triangle with a flat color, since the 2D canvas
+// has no mesh drawing: the average of its vertex colors when mode uses
+// them, otherwise the paint color. Gradients are not supported.
+func (c *htmlCanvas) DrawVertices(vertices *graphics.Vertices, mode graphics.BlendMode, paint graphics.Paint) {
+	if vertices.Validate() != nil {
+		return
+	}
+	alpha := paint.Alpha
+	if !(alpha >= 0 && alpha <= 1) {
+		alpha = 1
+	}
+	useColors := len(vertices.Colors) > 0 && mode != graphics.BlendModeSrc
+	vertices.EachTriangle(func(a, b, d int) {
+		color := paint.Color
+		if useColors {
+			color = averageColor(vertices.Colors[a], vertices.Colors[b], vertices.Colors[d])
+		}
+		pa, pb, pd := vertices.Positions[a], vertices.Positions[b], vertices.Positions[d]
+		c.ctx.Call("beginPath")
+		c.ctx.Call("moveTo", pa.X, pa.Y)
+		c.ctx.Call("lineTo", pb.X, pb.Y)
+		c.ctx.Call("lineTo", pd.X, pd.Y)
+		c.ctx.Call("closePath")
+		c.ctx.Set("fillStyle", cssColor(color.WithAlpha(color.Alpha()*alpha)))
+		c.ctx.Call("fill")
+	})
+}
+
+func (c *htmlCanvas) DrawRectShadow(rect graphics.Rect, shadow graphics.BoxShadow) {
+	c.DrawRRectShadow(graphics.RRect{Rect: rect}, shadow)
+}
+
+func (c *htmlCanvas) DrawRRectShadow(rrect graphics.RRect, shadow graphics.BoxShadow) {
+	if shadow.Color.Alpha() == 0 {
+		return
+	}
+	c.ctx.Call("save")
+	// Draw the shape far off-canvas and let the offset shadow land in place,
+	// so only the shadow is visible. Shadow offsets and blur ignore the
+	// transform, so they are scaled to device pixels here.
+	const away = 1e5
+	scale := c.pixelRatio()
+	c.ctx.Set("shadowColor", cssColor(shadow.Color))
+	c.ctx.Set("shadowBlur", shadow.BlurRadius*scale)
+	c.ctx.Set("shadowOffsetX", (shadow.Offset.X+away)*scale)
+	c.ctx.Set("shadowOffsetY", shadow.Offset.Y*scale)
+	c.ctx.Set("fillStyle", "#000")
+	r := rrect.Rect
+	rrect.Rect = graphics.Rect{
+		Left:   r.Left - shadow.Spread - away,
+		Top:    r.Top - shadow.Spread,
+		Right:  r.Right + shadow.Spread - away,
+		Bottom: r.Bottom + shadow.Spread,
+	}
+	c.ctx.Call("beginPath")
+	c.rrectPath(rrect)
+	c.ctx.Call("fill")
+	c.ctx.Call("restore")
+}
+
+// SaveLayerBlur saves without blurring; backdrop filters are not available
+// in the Canvas 2D API.
+func (c *htmlCanvas) SaveLayerBlur(bounds graphics.Rect, sigmaX, sigmaY float64) {
+	c.Save()
+}
+
+func (c *htmlCanvas) DrawSVG(svgPtr unsafe.Pointer, bounds graphics.Rect) {}
+
+func (c *htmlCanvas) DrawSVGTinted(svgPtr unsafe.Pointer, bounds graphics.Rect, tintColor graphics.Color) {
+}
+
+func (c *htmlCanvas) DrawLottie(animPtr unsafe.Pointer, bounds graphics.Rect, t float64) {}
+
+func (c *htmlCanvas) EmbedPlatformView(viewID int64, size graphics.Size) {}
+
+func (c *htmlCanvas) Size() graphics.Size { return c.size }
+
+// paintPath fills and/or strokes the current path with paint.
+func (c *htmlCanvas) paintPath(paint graphics.Paint, rule string) {
+	// Invalid alpha values default to opaque, as in the Skia canvas.
+	alpha := paint.Alpha
+	if !(alpha >= 0 && alpha <= 1) {
+		alpha = 1
+	}
+	style := cssColor(paint.Color.WithAlpha(paint.Color.Alpha() * alpha))
+	if paint.Style == graphics.PaintStyleFill || paint.Style == graphics.PaintStyleFillAndStroke {
+		c.ctx.Set("fillStyle", style)
+		c.ctx.Call("fill", rule)
+	}
+	if paint.Style == graphics.PaintStyleStroke || paint.Style == graphics.PaintStyleFillAndStroke {
+		c.ctx.Set("strokeStyle", style)
+		c.ctx.Set("lineWidth", max(paint.StrokeWidth, 1/c.pixelRatio()))
+		c.ctx.Set("lineCap", paint.StrokeCap.String())
+		c.ctx.Set("lineJoin", paint.StrokeJoin.String())
+		if paint.MiterLimit > 0 {
+			c.ctx.Set("miterLimit", paint.MiterLimit)
+		}
+		if paint.Dash != nil && len(paint.Dash.Intervals) > 0 {
+			intervals := make([]any, len(paint.Dash.Intervals))
+			for i, v := range paint.Dash.Intervals {
+				intervals[i] = v
+			}
+			c.ctx.Call("setLineDash", js.ValueOf(intervals))
+			c.ctx.Set("lineDashOffset", paint.Dash.Phase)
+		} else {
+			c.ctx.Call("setLineDash", js.ValueOf([]any{}))
+		}
+		c.ctx.Call("stroke")
+	}
+}
+
+// pixelRatio returns the horizontal scale of the current transform, used to
+// keep hairline strokes one device pixel wide.
+func (c *htmlCanvas) pixelRatio() float64 {
+	m := c.ctx.Call("getTransform")
+	if s := math.Hypot(m.Get("a").Float(), m.Get("b").Float()); s > 0 {
+		return s
+	}
+	return 1
+}
+
+func (c *htmlCanvas) rrectPath(r graphics.RRect) {
+	radii := []any{
+		map[string]any{"x": r.TopLeft.X, "y": r.TopLeft.Y},
+		map[string]any{"x": r.TopRight.X, "y": r.TopRight.Y},
+		map[string]any{"x": r.BottomRight.X, "y": r.BottomRight.Y},
+		map[string]any{"x": r.BottomLeft.X, "y": r.BottomLeft.Y},
+	}
+	c.ctx.Call("roundRect", r.Rect.Left, r.Rect.Top, r.Rect.Width(), r.Rect.Height(), js.ValueOf(radii))
+}
+
+func (c *htmlCanvas) tracePath(path *graphics.Path) {
+	for _, cmd := range path.Commands {
+		a := cmd.Args
+		switch cmd.Op {
+		case graphics.PathOpMoveTo:
+			c.ctx.Call("moveTo", a[0], a[1])
+		case graphics.PathOpLineTo:
+			c.ctx.Call("lineTo", a[0], a[1])
+		case graphics.PathOpQuadTo:
+			c.ctx.Call("quadraticCurveTo", a[0], a[1], a[2], a[3])
+		case graphics.PathOpCubicTo:
+			c.ctx.Call("bezierCurveTo", a[0], a[1], a[2], a[3], a[4], a[5])
+		case graphics.PathOpClose:
+			c.ctx.Call("closePath")
+		}
+	}
+}
+
+func fillRule(path *graphics.Path) string {
+	if path.FillRule == graphics.FillRuleEvenOdd {
+		return "evenodd"
+	}
+	return "nonzero"
+}
+
+func averageColor(colors ...graphics.Color) graphics.Color {
+	var a, r, g, b uint32
+	for _, color := range colors {
+		a += uint32(color>>24) & 0xff
+		r += uint32(color>>16) & 0xff
+		g += uint32(color>>8) & 0xff
+		b += uint32(color) & 0xff
+	}
+	n := uint32(len(colors))
+	return graphics.Color((a/n)<<24 | (r/n)<<16 | (g/n)<<8 | b/n)
+}
+
+func cssColor(color graphics.Color) string {
+	r, g, b, a := color.RGBAF()
+	return fmt.Sprintf("rgba(%d,%d,%d,%g)", int(r*255+0.5), int(g*255+0.5), int(b*255+0.5), a)
+}
+
+// imageBitmap converts img into an offscreen canvas that drawImage accepts.
+// Conversions are cached by image so static images are copied once.
+func imageBitmap(img image.Image) js.Value {
+	if img == nil {
+		return js.Undefined()
+	}
+	if cached, ok := bitmapCache[img]; ok {
+		return cached
+	}
+	b := img.Bounds()
+	if b.Empty() {
+		return js.Undefined()
+	}
+	rgba, ok := img.(*image.RGBA)
+	if !ok {
+		rgba = image.NewRGBA(b)
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				rgba.Set(x, y, img.At(x, y))
+			}
+		}
+	}
+	pixels := js.Global().Get("Uint8ClampedArray").New(len(rgba.Pix))
+	js.CopyBytesToJS(pixels, rgba.Pix)
+	data := js.Global().Get("ImageData").New(pixels, b.Dx(), b.Dy())
+	canvas := js.Global().Get("OffscreenCanvas").New(b.Dx(), b.Dy())
+	canvas.Call("getContext", "2d").Call("putImageData", data, 0, 0)
+	if len(bitmapCache) >= maxBitmapCache {
+		clear(bitmapCache)
+	}
+	bitmapCache[img] = canvas
+	return canvas
+}
+
+const maxBitmapCache = 64
+
+var bitmapCache = make(map[image.Image]js.Value)
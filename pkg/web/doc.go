@@ -0,0 +1,21 @@
+// Package web is an experimental embedder that runs Drift apps in a browser
+// when compiled with GOOS=js GOARCH=wasm.
+//
+// The embedder draws into an HTML canvas element through the Canvas 2D API,
+// schedules frames with requestAnimationFrame, and forwards DOM pointer and
+// keyboard events to the engine:
+//
+//	func main() {
+//	    web.Run(app.Root(), web.Options{CanvasID: "drift"})
+//	}
+//
+// Platform channels are bridged to JavaScript through a globalThis.driftPlatform
+// object; see [Run] for the expected shape.
+//
+// # Limitations
+//
+// The web target is flag-gated by build constraints and not yet feature
+// complete. Skia is not available under wasm, so text layout, SVG, Lottie,
+// image filters, and gradients do not render. Platform views are not
+// supported. Everything else in the package is only compiled for js/wasm.
+package web
@@ -0,0 +1,198 @@
+//go:build js && wasm
+
+package web
+
+import (
+	"log"
+	"math"
+	"syscall/js"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/engine"
+	"github.com/go-drift/drift/pkg/focus"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/navigation"
+)
+
+// DefaultCanvasID is the id of the canvas element used when
+// [Options.CanvasID] is empty.
+const DefaultCanvasID = "drift"
+
+// Options configures the web embedder.
+type Options struct {
+	// CanvasID is the id of the <canvas> element to render into.
+	// Defaults to [DefaultCanvasID].
+	CanvasID string
+
+	// DisableHistory stops the embedder from mapping the browser back button
+	// to [navigation.HandleBackButton]. By default a history entry is pushed
+	// so that back pops the app's route stack before leaving the page.
+	DisableHistory bool
+}
+
+// Run mounts root into the page's canvas element and runs the app. It
+// registers a platform bridge backed by globalThis.driftPlatform, if the
+// page defines one, and never returns.
+//
+// The canvas is sized to its CSS box times devicePixelRatio on every frame,
+// so style it with the size the app should fill, for example
+// "width: 100vw; height: 100vh".
+func Run(root core.Widget, opts Options) {
+	id := opts.CanvasID
+	if id == "" {
+		id = DefaultCanvasID
+	}
+	element := js.Global().Get("document").Call("getElementById", id)
+	if element.IsNull() {
+		log.Fatalf("web: no canvas element with id %q", id)
+	}
+
+	installBridge()
+	e := &embedder{canvas: element, ctx: element.Call("getContext", "2d")}
+	e.updateSize()
+	engine.SetApp(root)
+	e.listen(opts)
+	engine.SetPlatformScheduleFrame(e.scheduleFrame)
+	e.scheduleFrame()
+
+	select {}
+}
+
+// embedder owns the canvas element and the requestAnimationFrame loop.
+// All of its methods run on the browser's single JavaScript thread.
+type embedder struct {
+	canvas  js.Value
+	ctx     js.Value
+	width   int
+	height  int
+	scale   float64
+	pending bool
+	onFrame js.Func
+	pressed map[int64]bool
+}
+
+// updateSize resizes the canvas backing store to match its CSS size and
+// the current devicePixelRatio.
+func (e *embedder) updateSize() {
+	scale := js.Global().Get("devicePixelRatio").Float()
+	if scale <= 0 || math.IsNaN(scale) {
+		scale = 1
+	}
+	if scale != e.scale {
+		e.scale = scale
+		engine.SetDeviceScale(scale)
+	}
+	width := int(math.Round(e.canvas.Get("clientWidth").Float() * scale))
+	height := int(math.Round(e.canvas.Get("clientHeight").Float() * scale))
+	if width != e.width || height != e.height {
+		e.width, e.height = width, height
+		e.canvas.Set("width", width)
+		e.canvas.Set("height", height)
+	}
+}
+
+// scheduleFrame requests an animation frame unless one is already pending.
+func (e *embedder) scheduleFrame() {
+	if e.pending {
+		return
+	}
+	e.pending = true
+	if e.onFrame.IsUndefined() {
+		e.onFrame = js.FuncOf(func(this js.Value, args []js.Value) any {
+			e.pending = false
+			e.frame()
+			return nil
+		})
+	}
+	js.Global().Call("requestAnimationFrame", e.onFrame)
+}
+
+func (e *embedder) frame() {
+	e.updateSize()
+	if e.width <= 0 || e.height <= 0 {
+		return
+	}
+	canvas := newHTMLCanvas(e.ctx, graphics.Size{Width: float64(e.width), Height: float64(e.height)})
+	if err := engine.RenderWebFrame(canvas, e.width, e.height); err != nil {
+		log.Printf("web: frame failed: %v", err)
+	}
+	if engine.NeedsFrame() {
+		e.scheduleFrame()
+	}
+}
+
+// listen subscribes to the DOM events the engine consumes.
+func (e *embedder) listen(opts Options) {
+	e.pressed = make(map[int64]bool)
+	e.canvas.Get("style").Set("touchAction", "none")
+
+	pointer := func(phase engine.PointerPhase) js.Func {
+		return js.FuncOf(func(this js.Value, args []js.Value) any {
+			event := args[0]
+			id := int64(event.Get("pointerId").Int())
+			switch phase {
+			case engine.PointerPhaseDown:
+				e.pressed[id] = true
+				e.canvas.Call("setPointerCapture", event.Get("pointerId"))
+			case engine.PointerPhaseMove:
+				// Hover moves have no gesture meaning; only forward drags.
+				if !e.pressed[id] {
+					return nil
+				}
+			case engine.PointerPhaseUp, engine.PointerPhaseCancel:
+				if !e.pressed[id] {
+					return nil
+				}
+				delete(e.pressed, id)
+			}
+			event.Call("preventDefault")
+			rect := e.canvas.Call("getBoundingClientRect")
+			engine.HandlePointerEvent(engine.PointerEvent{
+				PointerID: id,
+				X:         (event.Get("clientX").Float() - rect.Get("left").Float()) * e.scale,
+				Y:         (event.Get("clientY").Float() - rect.Get("top").Float()) * e.scale,
+				Phase:     phase,
+			})
+			return nil
+		})
+	}
+	e.canvas.Call("addEventListener", "pointerdown", pointer(engine.PointerPhaseDown))
+	e.canvas.Call("addEventListener", "pointermove", pointer(engine.PointerPhaseMove))
+	e.canvas.Call("addEventListener", "pointerup", pointer(engine.PointerPhaseUp))
+	e.canvas.Call("addEventListener", "pointercancel", pointer(engine.PointerPhaseCancel))
+
+	window := js.Global()
+	window.Call("addEventListener", "resize", js.FuncOf(func(this js.Value, args []js.Value) any {
+		engine.RequestFrame()
+		return nil
+	}))
+
+	// Key presses go to the focused widget first, so menus can handle
+	// arrow keys and Escape. An unhandled Escape acts as the system back
+	// button.
+	window.Call("addEventListener", "keydown", js.FuncOf(func(this js.Value, args []js.Value) any {
+		key := args[0].Get("key").String()
+		if focus.GetFocusManager().HandleKeyEvent(focus.KeyEvent{Key: key}) == focus.KeyEventHandled ||
+			(key == "Escape" && navigation.HandleBackButton()) {
+			args[0].Call("preventDefault")
+		}
+		return nil
+	}))
+
+	if opts.DisableHistory {
+		return
+	}
+	// Keep one guard entry on the history stack. Browser back pops it; if
+	// the app handled the back press, the guard is restored, otherwise the
+	// browser is allowed to leave the page.
+	history := window.Get("history")
+	history.Call("pushState", "drift", "")
+	window.Call("addEventListener", "popstate", js.FuncOf(func(this js.Value, args []js.Value) any {
+		if navigation.HandleBackButton() {
+			history.Call("pushState", "drift", "")
+		} else {
+			history.Call("back")
+		}
+		return nil
+	}))
+}
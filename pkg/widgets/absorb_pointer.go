@@ -0,0 +1,86 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// AbsorbPointer lays out and paints its child normally but optionally
+// swallows all pointer events within its bounds. When Absorbing is true,
+// neither the child nor widgets behind it receive pointers, unlike
+// [IgnorePointer], which lets them fall through to widgets behind. This is
+// useful for blocking interaction with content under a loading scrim.
+type AbsorbPointer struct {
+	core.RenderObjectBase
+	// Absorbing controls whether pointer events are swallowed.
+	Absorbing bool
+	// Child is the widget to render.
+	Child core.Widget
+}
+
+func (ap AbsorbPointer) ChildWidget() core.Widget {
+	return ap.Child
+}
+
+func (ap AbsorbPointer) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderAbsorbPointer{absorbing: ap.Absorbing}
+	box.SetSelf(box)
+	return box
+}
+
+func (ap AbsorbPointer) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderAbsorbPointer); ok {
+		box.absorbing = ap.Absorbing
+	}
+}
+
+type renderAbsorbPointer struct {
+	layout.RenderBoxBase
+	child     layout.RenderBox
+	absorbing bool
+}
+
+func (r *renderAbsorbPointer) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderAbsorbPointer) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderAbsorbPointer) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child != nil {
+		r.child.Layout(constraints, true)
+		r.SetSize(r.child.Size())
+	} else {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+	}
+}
+
+func (r *renderAbsorbPointer) Paint(ctx *layout.PaintContext) {
+	if r.child != nil {
+		ctx.PaintChildWithLayer(r.child, getChildOffset(r.child))
+	}
+}
+
+func (r *renderAbsorbPointer) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	if r.absorbing {
+		// Report a hit without recording targets so the pointer stops here.
+		return true
+	}
+	if r.child == nil {
+		return false
+	}
+	offset := getChildOffset(r.child)
+	local := graphics.Offset{X: position.X - offset.X, Y: position.Y - offset.Y}
+	return r.child.HitTest(local, result)
+}
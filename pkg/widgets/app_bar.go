@@ -0,0 +1,354 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// ScrollUnderDuration is the elevation change duration used by
+// [theme.AppBarOf].
+const ScrollUnderDuration = 200 * time.Millisecond
+
+// surfaceTintOpacities holds the surface tint overlay opacity for each
+// elevation level, from 0 to 5, following Material 3 tonal elevation.
+var surfaceTintOpacities = [...]float64{0, 0.05, 0.08, 0.11, 0.12, 0.14}
+
+// SurfaceColorAtElevation returns surface overlaid with tint at the opacity
+// Material 3 uses for the given elevation level (0-5). Fractional levels
+// interpolate between neighbours, so the result can be animated.
+func SurfaceColorAtElevation(surface, tint graphics.Color, elevation float64) graphics.Color {
+	if tint == graphics.ColorTransparent || elevation <= 0 {
+		return surface
+	}
+	last := float64(len(surfaceTintOpacities) - 1)
+	elevation = min(elevation, last)
+	lower := int(math.Floor(elevation))
+	upper := min(lower+1, len(surfaceTintOpacities)-1)
+	opacity := animation.LerpFloat64(surfaceTintOpacities[lower], surfaceTintOpacities[upper], elevation-float64(lower))
+	return animation.LerpColor(surface, tint.WithAlpha(1), opacity*tint.Alpha())
+}
+
+// elevationShadow returns the shadow for a fractional elevation level, or nil
+// when the surface is flat. Levels below 1 fade the level 1 shadow in.
+func elevationShadow(elevation float64, color graphics.Color) *graphics.BoxShadow {
+	if elevation <= 0 || color == graphics.ColorTransparent {
+		return nil
+	}
+	shadow := graphics.BoxShadowElevation(int(math.Ceil(elevation)), color)
+	if elevation < 1 {
+		shadow.Color = color.WithAlpha(color.Alpha() * elevation)
+	}
+	return shadow
+}
+
+// ScrollUnderElevation paints a Material surface that rises when content
+// scrolls underneath it.
+//
+// It listens to Controller, or to the nearest [PrimaryScrollController] when
+// Controller is nil. While the scroll offset is zero the surface sits at
+// Elevation; once content scrolls under it, the surface animates to
+// ScrolledUnderElevation, deepening its shadow and blending SurfaceTintColor
+// into Color. [AppBar] uses it, and any other surface pinned above a
+// scrollable can do the same.
+//
+// All fields are explicit: zero colors mean no tint or no shadow, and a zero
+// Duration switches instantly.
+type ScrollUnderElevation struct {
+	core.StatefulBase
+
+	// Controller is the scroll controller to follow. If nil, uses the
+	// nearest PrimaryScrollController.
+	Controller *ScrollController
+	// Color is the surface color at rest.
+	Color graphics.Color
+	// SurfaceTintColor is blended into Color in proportion to elevation.
+	// Zero means no tint.
+	SurfaceTintColor graphics.Color
+	// ShadowColor is the elevation shadow color. Zero means no shadow.
+	ShadowColor graphics.Color
+	// Elevation is the elevation level (0-5) while nothing is scrolled under.
+	Elevation float64
+	// ScrolledUnderElevation is the elevation level (0-5) while content is
+	// scrolled under the surface.
+	ScrolledUnderElevation float64
+	// Duration is the length of the elevation change.
+	Duration time.Duration
+	// Child is the surface content.
+	Child core.Widget
+}
+
+func (s ScrollUnderElevation) CreateState() core.State {
+	return &scrollUnderElevationState{}
+}
+
+type scrollUnderElevationState struct {
+	core.StateBase
+	animation      *animation.AnimationController
+	controller     *ScrollController
+	removeListener func()
+	scrolledUnder  bool
+}
+
+func (s *scrollUnderElevationState) InitState() {
+	w := s.Element().Widget().(ScrollUnderElevation)
+	s.animation = animation.NewAnimationController(w.Duration)
+	s.animation.Curve = animation.EaseInOut
+	core.UseDisposable(s, s.animation)
+	core.UseListenable(s, s.animation)
+	s.OnDispose(func() { s.follow(nil) })
+}
+
+func (s *scrollUnderElevationState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	w := s.Element().Widget().(ScrollUnderElevation)
+	s.animation.Duration = w.Duration
+}
+
+// follow moves the scroll listener to controller.
+func (s *scrollUnderElevationState) follow(controller *ScrollController) {
+	if controller == s.controller {
+		return
+	}
+	if s.removeListener != nil {
+		s.removeListener()
+		s.removeListener = nil
+	}
+	s.controller = controller
+	if controller != nil {
+		s.removeListener = controller.AddListener(s.onScroll)
+	}
+}
+
+func (s *scrollUnderElevationState) onScroll() {
+	scrolledUnder := s.controller != nil && s.controller.Offset() > 0
+	if scrolledUnder == s.scrolledUnder {
+		return
+	}
+	s.SetState(func() { s.scrolledUnder = scrolledUnder })
+	if scrolledUnder {
+		s.animation.Forward()
+	} else {
+		s.animation.Reverse()
+	}
+}
+
+func (s *scrollUnderElevationState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(ScrollUnderElevation)
+	controller := w.Controller
+	if controller == nil {
+		controller = PrimaryScrollControllerOf(ctx)
+	}
+	s.follow(controller)
+
+	elevation := animation.LerpFloat64(w.Elevation, w.ScrolledUnderElevation, s.animation.Value)
+	return Container{
+		Color:  SurfaceColorAtElevation(w.Color, w.SurfaceTintColor, elevation),
+		Shadow: elevationShadow(elevation, w.ShadowColor),
+		Child:  w.Child,
+	}
+}
+
+// AppBar is a toolbar pinned to the top of a screen, with an optional leading
+// widget, a title, and trailing actions.
+//
+// The bar extends behind the top safe area inset, and its elevation follows
+// the screen's scrollable through [ScrollUnderElevation]: when content scrolls
+// underneath, the bar animates to ScrolledUnderElevation and takes on its
+// surface tint. Page routes provide a [PrimaryScrollController], so an AppBar
+// and a ScrollView on the same page are linked without extra wiring.
+//
+// With AutomaticallyImplyLeading set and no Leading, the bar shows a menu
+// button that opens the drawer of the enclosing [Scaffold], or failing that
+// a back button if the page can go back. OverflowActions collects the
+// actions that don't fit in the bar into a menu behind a "more" button.
+//
+// # Styling Model
+//
+// AppBar is explicit by default — all visual properties use their struct field
+// values directly. A zero value means zero, not "use theme default." For example:
+//
+//   - BackgroundColor: 0 means transparent background
+//   - ScrolledUnderElevation: 0 means the bar stays flat when scrolled under
+//   - Height: 0 means the toolbar collapses to the safe area inset
+//
+// For theme-styled app bars, use [theme.AppBarOf] which pre-fills visual
+// properties from the current theme's [theme.AppBarThemeData].
+//
+// # Creation Patterns
+//
+// Explicit with struct literal (full control):
+//
+//	widgets.AppBar{
+//	    Title:                  widgets.Text{Content: "Inbox", Style: titleStyle},
+//	    BackgroundColor:        colors.Surface,
+//	    SurfaceTintColor:       colors.SurfaceTint,
+//	    ShadowColor:            colors.Shadow,
+//	    ScrolledUnderElevation: 2,
+//	    Height:                 64,
+//	    Padding:                layout.EdgeInsetsSymmetric(16, 0),
+//	}
+//
+// Themed (reads from current theme):
+//
+//	theme.AppBarOf(ctx, "Inbox")
+type AppBar struct {
+	core.StatelessBase
+
+	// Leading is shown before the title, typically a back or menu button.
+	Leading core.Widget
+	// AutomaticallyImplyLeading shows a button when Leading is nil: one
+	// that opens the enclosing [Scaffold]'s Drawer if it has one, or else a
+	// back button when the page can go back, as reported by
+	// [BackNavigationOf].
+	AutomaticallyImplyLeading bool
+	// Title is the primary content of the bar.
+	Title core.Widget
+	// Actions are shown after the title.
+	Actions []core.Widget
+	// OverflowActions are shown in a menu opened from a button after
+	// Actions, for actions that don't fit in the bar.
+	OverflowActions []PopupMenuItem
+	// OverflowMenu styles the overflow menu. Its Items are replaced with
+	// OverflowActions.
+	OverflowMenu PopupMenu
+	// FlexibleSpace is drawn behind the bar's content, filling the bar and
+	// the safe area above it, such as a gradient or an image.
+	FlexibleSpace core.Widget
+	// Controller is the scroll controller to follow. If nil, uses the
+	// nearest PrimaryScrollController.
+	Controller *ScrollController
+	// BackgroundColor is the bar background. Zero means transparent.
+	BackgroundColor graphics.Color
+	// ForegroundColor is the color of the automatic back button and the
+	// overflow button. Zero means transparent.
+	ForegroundColor graphics.Color
+	// SurfaceTintColor is blended into the background as the bar rises.
+	// Zero means no tint.
+	SurfaceTintColor graphics.Color
+	// ShadowColor is the elevation shadow color. Zero means no shadow.
+	ShadowColor graphics.Color
+	// Elevation is the elevation level (0-5) at rest.
+	Elevation float64
+	// ScrolledUnderElevation is the elevation level (0-5) while content is
+	// scrolled under the bar.
+	ScrolledUnderElevation float64
+	// Duration is the length of the elevation change. Zero means instant.
+	Duration time.Duration
+	// Height is the toolbar height, excluding the top safe area inset.
+	Height float64
+	// Padding is the toolbar padding. Zero means no padding.
+	Padding layout.EdgeInsets
+	// Spacing is the gap between the leading widget, title, and each action.
+	// Zero means no gap.
+	Spacing float64
+}
+
+func (a AppBar) Build(ctx core.BuildContext) core.Widget {
+	var children []core.Widget
+	leading := a.Leading
+	if leading == nil && a.AutomaticallyImplyLeading {
+		if drawer := DrawerControllerOf(ctx); drawer != nil && drawer.HasDrawer() {
+			leading = appBarButton{glyph: "☰", label: "Open navigation menu", color: a.ForegroundColor, onTap: drawer.OpenDrawer}
+		} else if onBack := BackNavigationOf(ctx); onBack != nil {
+			leading = appBarButton{glyph: "←", label: "Back", color: a.ForegroundColor, mirror: true, onTap: onBack}
+		}
+	}
+	if leading != nil {
+		children = append(children, leading, SizedBox{Width: a.Spacing})
+	}
+	title := a.Title
+	if title == nil {
+		title = SizedBox{}
+	}
+	children = append(children, Expanded{Child: title})
+	for _, action := range a.Actions {
+		children = append(children, SizedBox{Width: a.Spacing}, action)
+	}
+	if len(a.OverflowActions) > 0 {
+		menu := a.OverflowMenu
+		menu.Items = a.OverflowActions
+		children = append(children, SizedBox{Width: a.Spacing}, appBarOverflowButton{color: a.ForegroundColor, menu: menu})
+	}
+
+	top := SafeAreaTopOf(ctx)
+	var bar core.Widget = Padding{
+		Padding: layout.EdgeInsets{Top: top},
+		Child: Container{
+			Height:  a.Height,
+			Padding: a.Padding,
+			Child: Row{
+				Children:           children,
+				CrossAxisAlignment: CrossAxisAlignmentCenter,
+			},
+		},
+	}
+	if a.FlexibleSpace != nil {
+		bar = Stack{Children: []core.Widget{Positioned(a.FlexibleSpace).Fill(0), bar}}
+	}
+	return ScrollUnderElevation{
+		Controller:             a.Controller,
+		Color:                  a.BackgroundColor,
+		SurfaceTintColor:       a.SurfaceTintColor,
+		ShadowColor:            a.ShadowColor,
+		Elevation:              a.Elevation,
+		ScrolledUnderElevation: a.ScrolledUnderElevation,
+		Duration:               a.Duration,
+		Child:                  bar,
+	}
+}
+
+// appBarButtonSize is the tap target of the app bar's own buttons.
+const appBarButtonSize = 48
+
+// appBarButton is an icon button drawn by the app bar itself.
+type appBarButton struct {
+	core.StatelessBase
+	glyph  string
+	label  string
+	color  graphics.Color
+	mirror bool
+	onTap  func()
+}
+
+func (b appBarButton) Build(ctx core.BuildContext) core.Widget {
+	return Semantics{
+		Label:     b.label,
+		Role:      semantics.SemanticsRoleButton,
+		Container: true,
+		OnTap:     b.onTap,
+		Child: GestureDetector{
+			OnTap: b.onTap,
+			Child: SizedBox{
+				Width:  appBarButtonSize,
+				Height: appBarButtonSize,
+				Child: Center{Child: Icon{
+					Glyph:              b.glyph,
+					Size:               24,
+					Color:              b.color,
+					MatchTextDirection: b.mirror,
+				}},
+			},
+		},
+	}
+}
+
+// appBarOverflowButton opens the overflow menu anchored to itself.
+type appBarOverflowButton struct {
+	core.StatelessBase
+	color graphics.Color
+	menu  PopupMenu
+}
+
+func (b appBarOverflowButton) Build(ctx core.BuildContext) core.Widget {
+	return appBarButton{
+		glyph: "⋮",
+		label: "More options",
+		color: b.color,
+		onTap: func() { ShowPopupMenu(ctx, b.menu) },
+	}
+}
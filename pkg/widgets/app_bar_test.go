@@ -0,0 +1,188 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestSurfaceColorAtElevation(t *testing.T) {
+	surface := graphics.RGB(255, 255, 255)
+	tint := graphics.RGB(0, 0, 255)
+
+	if got := widgets.SurfaceColorAtElevation(surface, tint, 0); got != surface {
+		t.Errorf("elevation 0: got %v, want the untinted surface", got)
+	}
+	if got := widgets.SurfaceColorAtElevation(surface, graphics.ColorTransparent, 3); got != surface {
+		t.Errorf("no tint: got %v, want the untinted surface", got)
+	}
+	level1 := widgets.SurfaceColorAtElevation(surface, tint, 1)
+	half := widgets.SurfaceColorAtElevation(surface, tint, 0.5)
+	level5 := widgets.SurfaceColorAtElevation(surface, tint, 5)
+	if !(red(level1) < red(half) && red(half) < red(surface)) {
+		t.Errorf("fractional elevation should tint less than level 1: half %v, level 1 %v", half, level1)
+	}
+	if red(level5) >= red(level1) {
+		t.Errorf("higher elevation should tint more: level 5 %v, level 1 %v", level5, level1)
+	}
+	if got := widgets.SurfaceColorAtElevation(surface, tint, 9); got != level5 {
+		t.Errorf("elevation above 5 should clamp: got %v, want %v", got, level5)
+	}
+}
+
+func red(c graphics.Color) float64 {
+	r, _, _, _ := c.RGBAF()
+	return r
+}
+
+// primaryProbe records the primary scroll controller visible at its position.
+type primaryProbe struct {
+	core.StatelessBase
+	seen  **widgets.ScrollController
+	child core.Widget
+}
+
+func (p primaryProbe) Build(ctx core.BuildContext) core.Widget {
+	*p.seen = widgets.PrimaryScrollControllerOf(ctx)
+	return p.child
+}
+
+func TestAppBar_ElevatesWhenContentScrollsUnder(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	surface := graphics.RGB(255, 255, 255)
+	tint := graphics.RGB(0, 0, 255)
+
+	var primary, nested *widgets.ScrollController
+	err := tester.PumpWidget(widgets.PrimaryScrollScope{
+		Child: widgets.Column{Children: []core.Widget{
+			widgets.AppBar{
+				Title:                  widgets.Text{Content: "Title"},
+				BackgroundColor:        surface,
+				SurfaceTintColor:       tint,
+				ShadowColor:            graphics.RGB(0, 0, 0),
+				ScrolledUnderElevation: 2,
+				Height:                 56,
+			},
+			widgets.Expanded{Child: primaryProbe{
+				seen: &primary,
+				child: widgets.ScrollView{
+					Child: primaryProbe{seen: &nested, child: widgets.SizedBox{Height: 2000}},
+				},
+			}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if primary == nil {
+		t.Fatal("expected a primary scroll controller")
+	}
+	if nested != nil {
+		t.Fatal("scroll view content should not see the primary controller it attached to")
+	}
+
+	bar := func() widgets.Container {
+		return tester.Find(drifttest.ByType[widgets.Container]()).Widget().(widgets.Container)
+	}
+	if got := bar(); got.Color != surface || got.Shadow != nil {
+		t.Fatalf("at rest: color %v shadow %v, want flat untinted surface", got.Color, got.Shadow)
+	}
+
+	primary.JumpTo(100)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if got := bar(); got.Color == surface || got.Shadow == nil {
+		t.Errorf("scrolled under: color %v shadow %v, want tinted surface with shadow", got.Color, got.Shadow)
+	}
+
+	primary.JumpTo(0)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if got := bar(); got.Color != surface || got.Shadow != nil {
+		t.Errorf("back at top: color %v shadow %v, want flat untinted surface", got.Color, got.Shadow)
+	}
+}
+
+func TestAppBar_ImpliesBackButton(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	bar := widgets.AppBar{
+		Title:                     widgets.Text{Content: "Details"},
+		AutomaticallyImplyLeading: true,
+		ForegroundColor:           graphics.RGB(0, 0, 0),
+		Height:                    56,
+	}
+
+	tester.PumpWidget(widgets.Column{Children: []core.Widget{bar}})
+	if tester.Find(drifttest.ByText("←")).Exists() {
+		t.Fatal("expected no back button with nowhere to go back to")
+	}
+
+	wentBack := false
+	tester.PumpWidget(widgets.BackNavigationScope{
+		OnBack: func() { wentBack = true },
+		Child:  widgets.Column{Children: []core.Widget{bar}},
+	})
+	if err := tester.Tap(drifttest.ByText("←")); err != nil {
+		t.Fatal(err)
+	}
+	if !wentBack {
+		t.Error("expected the back button to go back")
+	}
+
+	bar.Leading = widgets.Text{Content: "menu"}
+	tester.PumpWidget(widgets.BackNavigationScope{
+		OnBack: func() {},
+		Child:  widgets.Column{Children: []core.Widget{bar}},
+	})
+	if tester.Find(drifttest.ByText("←")).Exists() {
+		t.Error("expected Leading to replace the back button")
+	}
+}
+
+func TestAppBar_OverflowMenu(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var shown *widgets.PopupMenu
+	tester.PumpWidget(widgets.PopupMenuHost{
+		Show: func(anchor layout.RenderObject, menu widgets.PopupMenu) { shown = &menu },
+		Child: widgets.Column{Children: []core.Widget{widgets.AppBar{
+			Title:           widgets.Text{Content: "Inbox"},
+			OverflowActions: []widgets.PopupMenuItem{{Label: "Settings"}, {Label: "Sign out"}},
+			OverflowMenu:    widgets.PopupMenu{ItemHeight: 48},
+			Height:          56,
+		}}},
+	})
+
+	if err := tester.Tap(drifttest.ByText("⋮")); err != nil {
+		t.Fatal(err)
+	}
+	if shown == nil {
+		t.Fatal("expected the overflow button to show a menu")
+	}
+	if len(shown.Items) != 2 || shown.Items[1].Label != "Sign out" || shown.ItemHeight != 48 {
+		t.Errorf("expected the menu styled by OverflowMenu with the overflow actions, got %+v", *shown)
+	}
+}
+
+func TestAppBar_FlexibleSpaceFillsBar(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 320, Height: 480})
+	tester.PumpWidget(widgets.Column{
+		CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+		Children: []core.Widget{widgets.AppBar{
+			Title:         widgets.Text{Content: "Profile"},
+			FlexibleSpace: widgets.Container{Color: graphics.RGB(40, 80, 160)},
+			Height:        56,
+		}},
+	})
+
+	size := tester.Find(drifttest.ByType[widgets.Container]()).RenderObject().Size()
+	if size != (graphics.Size{Width: 320, Height: 56}) {
+		t.Errorf("expected the flexible space to fill the bar, got %v", size)
+	}
+}
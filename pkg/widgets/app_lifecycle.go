@@ -0,0 +1,106 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// AppLifecycleListener calls back when the app moves between foreground and
+// background, so a subtree can pause timers, streams, or playback while the
+// app is not visible. Callbacks run on the UI thread and use the latest
+// widget configuration; the Child is built unchanged.
+//
+// When the app leaves the foreground, OnInactive and OnHidden run before
+// OnPause; when it returns, OnResume runs once it is interactive again.
+//
+//	widgets.AppLifecycleListener{
+//	    OnPause:  func() { s.ticker.Stop() },
+//	    OnResume: func() { s.ticker.Start() },
+//	    Child:    content,
+//	}
+type AppLifecycleListener struct {
+	core.StatefulBase
+
+	// OnResume is called when the app becomes visible and interactive again.
+	OnResume func()
+
+	// OnInactive is called when the app stops receiving input but may still
+	// be visible (system dialog, app switcher).
+	OnInactive func()
+
+	// OnHidden is called when the app stops being visible, before OnPause or
+	// OnDetach.
+	OnHidden func()
+
+	// OnPause is called when the app is in the background.
+	OnPause func()
+
+	// OnDetach is called when the app is detached from its view and about to
+	// shut down.
+	OnDetach func()
+
+	// OnStateChange, if set, is called with every new lifecycle state after
+	// the specific callbacks.
+	OnStateChange func(state platform.LifecycleState)
+
+	// Child is the widget below this listener.
+	Child core.Widget
+}
+
+// CreateState implements core.StatefulWidget.
+func (AppLifecycleListener) CreateState() core.State {
+	return &appLifecycleListenerState{}
+}
+
+type appLifecycleListenerState struct {
+	core.StateBase
+	state platform.LifecycleState
+}
+
+func (s *appLifecycleListenerState) InitState() {
+	s.state = platform.Lifecycle.State()
+	platform.UseLifecycleObserver(s, s.onStateChanged)
+}
+
+func (s *appLifecycleListenerState) onStateChanged(state platform.LifecycleState) {
+	if s.IsDisposed() || state == s.state {
+		return
+	}
+	prev := s.state
+	s.state = state
+	w := s.Element().Widget().(AppLifecycleListener)
+
+	call := func(fn func()) {
+		if fn != nil {
+			fn()
+		}
+	}
+	visible := func(st platform.LifecycleState) bool {
+		return st == platform.LifecycleStateResumed || st == platform.LifecycleStateInactive
+	}
+	switch state {
+	case platform.LifecycleStateResumed:
+		call(w.OnResume)
+	case platform.LifecycleStateInactive:
+		call(w.OnInactive)
+	case platform.LifecycleStatePaused, platform.LifecycleStateDetached:
+		if prev == platform.LifecycleStateResumed {
+			call(w.OnInactive)
+		}
+		if visible(prev) {
+			call(w.OnHidden)
+		}
+		if state == platform.LifecycleStatePaused {
+			call(w.OnPause)
+		} else {
+			call(w.OnDetach)
+		}
+	}
+	if w.OnStateChange != nil {
+		w.OnStateChange(state)
+	}
+}
+
+func (s *appLifecycleListenerState) Build(ctx core.BuildContext) core.Widget {
+	return s.Element().Widget().(AppLifecycleListener).Child
+}
@@ -0,0 +1,53 @@
+package widgets_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestAppLifecycleListener_Callbacks(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	t.Cleanup(func() { platform.Lifecycle.SetStateForTest(platform.LifecycleStateResumed) })
+
+	var events []string
+	record := func(name string) func() {
+		return func() { events = append(events, name) }
+	}
+	var states []platform.LifecycleState
+	tester.PumpWidget(widgets.AppLifecycleListener{
+		OnResume:      record("resume"),
+		OnInactive:    record("inactive"),
+		OnHidden:      record("hidden"),
+		OnPause:       record("pause"),
+		OnDetach:      record("detach"),
+		OnStateChange: func(state platform.LifecycleState) { states = append(states, state) },
+		Child:         widgets.Text{Content: "content"},
+	})
+
+	if !tester.Find(drifttest.ByText("content")).Exists() {
+		t.Fatal("expected child to be built")
+	}
+
+	platform.Lifecycle.SetStateForTest(platform.LifecycleStatePaused)
+	tester.Pump()
+	if want := []string{"inactive", "hidden", "pause"}; !slices.Equal(events, want) {
+		t.Errorf("after pause got %v, want %v", events, want)
+	}
+
+	events = nil
+	platform.Lifecycle.SetStateForTest(platform.LifecycleStateInactive)
+	platform.Lifecycle.SetStateForTest(platform.LifecycleStateResumed)
+	tester.Pump()
+	if want := []string{"inactive", "resume"}; !slices.Equal(events, want) {
+		t.Errorf("after resume got %v, want %v", events, want)
+	}
+
+	want := []platform.LifecycleState{platform.LifecycleStatePaused, platform.LifecycleStateInactive, platform.LifecycleStateResumed}
+	if !slices.Equal(states, want) {
+		t.Errorf("OnStateChange got %v, want %v", states, want)
+	}
+}
@@ -0,0 +1,283 @@
+package widgets
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+	"image"
+	_ "image/jpeg" // register decoders for Image.Asset
+	_ "image/png"
+	"io/fs"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/errors"
+)
+
+// AssetBundle resolves asset names, such as "icons/logo.svg", to their
+// contents. [Image] and [SvgPicture] load their Asset through the bundle
+// from [AssetBundleOf].
+//
+// Loaded assets are cached by bundle and name, so implementations must be
+// comparable (pointer types are) and must return the same contents for a
+// name for as long as they are in use.
+type AssetBundle interface {
+	// Load returns the contents of the named asset.
+	Load(name string) ([]byte, error)
+}
+
+// FSAssetBundle is an [AssetBundle] that reads assets from a file system,
+// typically an [embed.FS] compiled into the app:
+//
+//	//go:embed assets
+//	var assets embed.FS
+//
+//	bundle := widgets.NewFSAssetBundle(assets)
+type FSAssetBundle struct {
+	fsys fs.FS
+}
+
+// NewFSAssetBundle returns a bundle that reads assets from fsys. Names are
+// slash-separated paths within fsys, as accepted by [fs.ReadFile].
+func NewFSAssetBundle(fsys fs.FS) *FSAssetBundle {
+	return &FSAssetBundle{fsys: fsys}
+}
+
+// Load reads the named file.
+func (b *FSAssetBundle) Load(name string) ([]byte, error) {
+	return fs.ReadFile(b.fsys, name)
+}
+
+// errNoAssetBundle is returned when an asset is requested without a bundle.
+var errNoAssetBundle = fmt.Errorf("widgets: no asset bundle; use DefaultAssetBundle or SetRootAssetBundle")
+
+var (
+	rootBundleMu sync.RWMutex
+	rootBundle   AssetBundle
+)
+
+// SetRootAssetBundle sets the bundle used by widgets that have no
+// [DefaultAssetBundle] above them. Apps usually call it once at startup.
+func SetRootAssetBundle(bundle AssetBundle) {
+	rootBundleMu.Lock()
+	rootBundle = bundle
+	rootBundleMu.Unlock()
+}
+
+// DefaultAssetBundle sets the bundle that widgets below it load assets from,
+// overriding the root bundle for a subtree such as a feature module with its
+// own embedded assets.
+//
+//	widgets.DefaultAssetBundle{
+//	    Bundle: widgets.NewFSAssetBundle(checkoutAssets),
+//	    Child:  checkoutFlow,
+//	}
+type DefaultAssetBundle struct {
+	core.InheritedBase
+	// Bundle is the bundle descendants load assets from.
+	Bundle AssetBundle
+	// Child is the widget below this one in the tree.
+	Child core.Widget
+}
+
+func (d DefaultAssetBundle) ChildWidget() core.Widget { return d.Child }
+
+func (d DefaultAssetBundle) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(DefaultAssetBundle); ok {
+		return d.Bundle != old.Bundle
+	}
+	return true
+}
+
+var defaultAssetBundleType = reflect.TypeFor[DefaultAssetBundle]()
+
+// AssetBundleOf returns the bundle from the nearest [DefaultAssetBundle], or
+// the root bundle set with [SetRootAssetBundle] if there is none. It returns
+// nil if neither is set.
+func AssetBundleOf(ctx core.BuildContext) AssetBundle {
+	if d, ok := ctx.DependOnInherited(defaultAssetBundleType, nil).(DefaultAssetBundle); ok && d.Bundle != nil {
+		return d.Bundle
+	}
+	rootBundleMu.RLock()
+	defer rootBundleMu.RUnlock()
+	return rootBundle
+}
+
+// DefaultAssetCacheLimit is the default number of unused decoded assets of
+// each kind kept by [Image] and [SvgPicture].
+const DefaultAssetCacheLimit = 64
+
+// SetAssetCacheLimit sets how many decoded assets of each kind are kept
+// after the last widget showing them is removed, so showing them again
+// skips loading and decoding. Assets still shown are never evicted. Evicted
+// SVG documents are destroyed. Zero or less keeps nothing unused. Call it on
+// the UI thread.
+func SetAssetCacheLimit(entries int) {
+	imageAssets.setLimit(entries)
+	svgAssets.setLimit(entries)
+}
+
+// reportAssetError reports a failure to load the named asset.
+func reportAssetError(op, name string, err error) {
+	if name != "" {
+		err = fmt.Errorf("load asset %q: %w", name, err)
+	}
+	errors.Report(&errors.DriftError{
+		Op:        op,
+		Kind:      errors.KindBuild,
+		Err:       err,
+		Timestamp: time.Now(),
+	})
+}
+
+// imageAssets holds images decoded for Image.Asset.
+var imageAssets = newAssetCache(DefaultAssetCacheLimit, func(data []byte) (image.Image, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}, nil)
+
+// assetKey identifies an asset by the bundle it was loaded from and its name.
+type assetKey struct {
+	bundle AssetBundle
+	name   string
+}
+
+// assetCache shares decoded assets between the render objects showing them.
+// Render objects hold a reference while they show an asset; once released,
+// the asset stays cached until it is among the least recently released
+// beyond the limit, at which point destroy is called on it. Because only
+// unreferenced assets are evicted, no layer that still draws an asset can
+// outlive it.
+type assetCache[T any] struct {
+	mu      sync.Mutex
+	limit   int
+	entries map[assetKey]*assetEntry[T]
+	unused  *list.List // of *assetEntry[T], most recently released first
+	decode  func([]byte) (T, error)
+	destroy func(T)
+}
+
+type assetEntry[T any] struct {
+	key    assetKey
+	value  T
+	refs   int
+	unused *list.Element // non-nil while refs is zero
+}
+
+func newAssetCache[T any](limit int, decode func([]byte) (T, error), destroy func(T)) *assetCache[T] {
+	return &assetCache[T]{
+		limit:   limit,
+		entries: make(map[assetKey]*assetEntry[T]),
+		unused:  list.New(),
+		decode:  decode,
+		destroy: destroy,
+	}
+}
+
+// acquire returns the decoded asset, loading it through the bundle if it
+// is not cached, and adds a reference that must be dropped with release.
+func (c *assetCache[T]) acquire(key assetKey) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[key]; ok {
+		if entry.unused != nil {
+			c.unused.Remove(entry.unused)
+			entry.unused = nil
+		}
+		entry.refs++
+		return entry.value, nil
+	}
+	var zero T
+	if key.bundle == nil {
+		return zero, errNoAssetBundle
+	}
+	data, err := key.bundle.Load(key.name)
+	if err != nil {
+		return zero, err
+	}
+	value, err := c.decode(data)
+	if err != nil {
+		return zero, err
+	}
+	c.entries[key] = &assetEntry[T]{key: key, value: value, refs: 1}
+	return value, nil
+}
+
+// release drops a reference taken by acquire.
+func (c *assetCache[T]) release(key assetKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || entry.refs == 0 {
+		return
+	}
+	entry.refs--
+	if entry.refs == 0 {
+		entry.unused = c.unused.PushFront(entry)
+		c.evict()
+	}
+}
+
+func (c *assetCache[T]) setLimit(limit int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.limit = limit
+	c.evict()
+}
+
+// evict drops the least recently released assets beyond the limit.
+// Caller must hold mu.
+func (c *assetCache[T]) evict() {
+	for c.unused.Len() > max(c.limit, 0) {
+		entry := c.unused.Remove(c.unused.Back()).(*assetEntry[T])
+		delete(c.entries, entry.key)
+		if c.destroy != nil {
+			c.destroy(entry.value)
+		}
+	}
+}
+
+// assetRef is a render object's reference to a cached asset.
+type assetRef[T any] struct {
+	cache *assetCache[T]
+	key   assetKey
+	value T
+	held  bool
+}
+
+// set points the reference at key, releasing the previous asset. It
+// returns whether the key changed and any error loading the new asset. A
+// key that failed to load is not retried until the key changes.
+func (r *assetRef[T]) set(key assetKey) (bool, error) {
+	if key == r.key {
+		return false, nil
+	}
+	// Acquire before releasing, so switching back to a recently released
+	// asset can't evict it first.
+	var value T
+	var err error
+	if key.name != "" {
+		value, err = r.cache.acquire(key)
+	}
+	r.release()
+	r.key = key
+	if key.name == "" || err != nil {
+		return true, err
+	}
+	r.value = value
+	r.held = true
+	return true, nil
+}
+
+// release drops the held asset, if any.
+func (r *assetRef[T]) release() {
+	if r.held {
+		r.cache.release(r.key)
+	}
+	var zero T
+	r.key = assetKey{}
+	r.value = zero
+	r.held = false
+}
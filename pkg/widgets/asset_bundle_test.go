@@ -0,0 +1,111 @@
+package widgets
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+	"testing/fstest"
+)
+
+func TestAssetCache_SharesAndEvictsUnused(t *testing.T) {
+	bundle := NewFSAssetBundle(fstest.MapFS{
+		"a": {Data: []byte("a")},
+		"b": {Data: []byte("b")},
+		"c": {Data: []byte("c")},
+	})
+	loads := 0
+	var destroyed []string
+	cache := newAssetCache(1, func(data []byte) (*string, error) {
+		loads++
+		s := string(data)
+		return &s, nil
+	}, func(s *string) {
+		destroyed = append(destroyed, *s)
+	})
+
+	first := assetRef[*string]{cache: cache}
+	second := assetRef[*string]{cache: cache}
+	first.set(assetKey{bundle, "a"})
+	second.set(assetKey{bundle, "a"})
+	if loads != 1 || first.value != second.value {
+		t.Fatalf("expected one shared load, got %d loads", loads)
+	}
+
+	first.release()
+	if len(destroyed) != 0 {
+		t.Fatalf("expected an asset in use to be kept, destroyed %v", destroyed)
+	}
+
+	// Switching to b leaves a unused, within the limit of one.
+	second.set(assetKey{bundle, "b"})
+	if len(destroyed) != 0 {
+		t.Fatalf("expected one unused asset to be kept, destroyed %v", destroyed)
+	}
+	second.set(assetKey{bundle, "a"})
+	if loads != 2 {
+		t.Fatalf("expected the unused asset to be reused, got %d loads", loads)
+	}
+
+	// Now b is the unused one; releasing a evicts b, the older of the two.
+	second.set(assetKey{bundle, "c"})
+	if len(destroyed) != 1 || destroyed[0] != "b" {
+		t.Fatalf("expected b to be destroyed, got %v", destroyed)
+	}
+
+	cache.setLimit(0)
+	if len(destroyed) != 2 || destroyed[1] != "a" {
+		t.Fatalf("expected a to be destroyed at limit zero, got %v", destroyed)
+	}
+	second.release()
+	if len(destroyed) != 3 || destroyed[2] != "c" {
+		t.Fatalf("expected c to be destroyed on release, got %v", destroyed)
+	}
+}
+
+func TestAssetCache_ErrorsAreNotCached(t *testing.T) {
+	files := fstest.MapFS{}
+	bundle := NewFSAssetBundle(files)
+	cache := newAssetCache(1, func(data []byte) (string, error) {
+		return string(data), nil
+	}, nil)
+
+	if _, err := cache.acquire(assetKey{bundle, "missing"}); err == nil {
+		t.Fatal("expected an error for a missing asset")
+	}
+	if _, err := cache.acquire(assetKey{nil, "missing"}); err != errNoAssetBundle {
+		t.Fatalf("expected errNoAssetBundle, got %v", err)
+	}
+	files["missing"] = &fstest.MapFile{Data: []byte("found")}
+	if got, err := cache.acquire(assetKey{bundle, "missing"}); err != nil || got != "found" {
+		t.Fatalf("expected the asset to load once present, got %q, %v", got, err)
+	}
+}
+
+func TestImage_ResolvesAsset(t *testing.T) {
+	var data bytes.Buffer
+	if err := png.Encode(&data, image.NewNRGBA(image.Rect(0, 0, 3, 2))); err != nil {
+		t.Fatal(err)
+	}
+	bundle := NewFSAssetBundle(fstest.MapFS{"photo.png": {Data: data.Bytes()}})
+
+	first := &renderImage{asset: assetRef[image.Image]{cache: imageAssets}}
+	second := &renderImage{asset: assetRef[image.Image]{cache: imageAssets}}
+	a := first.resolveSource(Image{Asset: "photo.png"}, bundle)
+	b := second.resolveSource(Image{Asset: "photo.png"}, bundle)
+	if a == nil || a.Bounds().Dx() != 3 || a.Bounds().Dy() != 2 {
+		t.Fatalf("expected a decoded 3x2 image, got %v", a)
+	}
+	if a != b {
+		t.Fatal("expected images showing the same asset to share it")
+	}
+
+	source := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	if got := first.resolveSource(Image{Source: source}, bundle); got != source {
+		t.Fatal("expected Source to be used without an Asset")
+	}
+	if first.asset.held {
+		t.Fatal("expected the asset to be released when no longer used")
+	}
+	second.Dispose()
+}
@@ -0,0 +1,42 @@
+package widgets
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// BackNavigationScope tells the widgets below it how to go back, for
+// [AppBar]'s automatic back button. The navigation package's Navigator
+// provides one for each page, with OnBack popping the page when it is not
+// the first.
+type BackNavigationScope struct {
+	core.InheritedBase
+
+	// OnBack goes back. Nil means there is nowhere to go back to.
+	OnBack func()
+	// Child is the subtree that can go back.
+	Child core.Widget
+}
+
+func (b BackNavigationScope) ChildWidget() core.Widget { return b.Child }
+
+// ShouldRebuildDependents reports whether going back became possible or
+// impossible; a new OnBack alone doesn't rebuild dependents.
+func (b BackNavigationScope) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(BackNavigationScope); ok {
+		return (old.OnBack == nil) != (b.OnBack == nil)
+	}
+	return true
+}
+
+var backNavigationScopeType = reflect.TypeFor[BackNavigationScope]()
+
+// BackNavigationOf returns the nearest [BackNavigationScope]'s OnBack, or
+// nil if there is nowhere to go back to.
+func BackNavigationOf(ctx core.BuildContext) func() {
+	if scope, ok := ctx.DependOnInherited(backNavigationScopeType, nil).(BackNavigationScope); ok {
+		return scope.OnBack
+	}
+	return nil
+}
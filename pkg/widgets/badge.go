@@ -0,0 +1,65 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// Badge marks the top-end corner of its child, typically an icon, with a
+// small count or a dot. An empty Label shows a dot.
+//
+// Badge is explicit by default: a zero BackgroundColor is transparent.
+// [NavigationBar] and [NavigationRail] badge their icons from
+// [TabItem.Badge] with their own colors.
+//
+//	widgets.Badge{
+//	    Label:           "3",
+//	    BackgroundColor: colors.Error,
+//	    TextColor:       colors.OnError,
+//	    Child:           inboxIcon,
+//	}
+type Badge struct {
+	core.StatelessBase
+
+	// Label is the badge text, such as an unread count. Keep it short.
+	Label string
+	// BackgroundColor fills the badge.
+	BackgroundColor graphics.Color
+	// TextColor colors the label.
+	TextColor graphics.Color
+	// Child is the widget being badged.
+	Child core.Widget
+}
+
+func (b Badge) Build(ctx core.BuildContext) core.Widget {
+	var badge core.Widget
+	if b.Label == "" {
+		badge = Positioned(DecoratedBox{
+			Color:        b.BackgroundColor,
+			BorderRadius: 3,
+			Child:        SizedBox{Width: 6, Height: 6},
+		}).Top(0).End(0)
+	} else {
+		badge = Positioned(DecoratedBox{
+			Color:        b.BackgroundColor,
+			BorderRadius: 8,
+			Child: Container{
+				Height:    16,
+				Padding:   layout.EdgeInsetsSymmetric(4, 0),
+				Alignment: layout.AlignmentCenter,
+				Child: Text{
+					Content:  b.Label,
+					Style:    graphics.TextStyle{FontSize: 11, FontWeight: graphics.FontWeightMedium, Color: b.TextColor},
+					MaxLines: 1,
+					Wrap:     graphics.TextWrapNoWrap,
+				},
+			},
+		}).Top(-4).End(-8)
+	}
+	child := b.Child
+	if child == nil {
+		child = SizedBox{}
+	}
+	return Stack{Alignment: layout.AlignmentCenter, Children: []core.Widget{child, badge}}
+}
@@ -708,18 +708,20 @@ func (s *bottomSheetState) animateToDismiss(result any) {
 }
 
 func (s *bottomSheetState) startSpring(dismissResult any) {
-	lastTime := animation.Now()
+	var lastElapsed time.Duration
 	s.ticker = animation.NewTicker(func(elapsed time.Duration) {
 		if s.spring == nil {
 			s.ticker.Stop()
 			return
 		}
-		now := animation.Now()
-		dt := now.Sub(lastTime).Seconds()
-		lastTime = now
+		dt := (elapsed - lastElapsed).Seconds()
+		lastElapsed = elapsed
 
 		done := s.spring.Step(dt)
 		newExtent := s.spring.Position()
+		if animation.AnimationsDisabled() {
+			done, newExtent = true, s.spring.Target()
+		}
 		s.SetState(func() {
 			s.currentExtent = clampFloat(newExtent, 0, s.availableHeight)
 		})
@@ -100,7 +100,7 @@ func (r *renderSheetDragRegion) HandlePointer(event gestures.PointerEvent) {
 
 func (r *renderSheetDragRegion) configure(s sheetDragRegion) {
 	if r.recognizer == nil {
-		r.recognizer = newConditionalVerticalDragRecognizer(gestures.DefaultArena)
+		r.recognizer = newConditionalVerticalDragRecognizer(r.GestureArena())
 	}
 	r.recognizer.ShouldAccept = s.ShouldStart
 	r.recognizer.OnStart = s.OnStart
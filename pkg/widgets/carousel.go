@@ -0,0 +1,428 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// afterFunc runs fn on the UI thread after d and returns a function that
+// stops it.
+var afterFunc = func(d time.Duration, fn func()) (stop func()) {
+	timer := time.AfterFunc(d, func() {
+		if !platform.Dispatch(fn) {
+			fn()
+		}
+	})
+	return func() { timer.Stop() }
+}
+
+// carouselLoopCycles is how many times a looping [Carousel] repeats its
+// items. It starts in the middle, so users can't reach either end in
+// practice.
+const carouselLoopCycles = 10000
+
+// defaultCarouselTransition is how long autoplay takes to move to the next
+// item when TransitionDuration is zero.
+const defaultCarouselTransition = 400 * time.Millisecond
+
+// Carousel is a horizontal [PageView] for banners and galleries. It can
+// loop past its last item, advance on its own, show a [PageIndicator], and
+// shift item content for a parallax effect as pages move.
+//
+//	widgets.Carousel{
+//	    ItemCount:            len(banners),
+//	    ItemBuilder:          func(ctx core.BuildContext, i int) core.Widget { return banners[i] },
+//	    Loop:                 true,
+//	    AutoPlayInterval:     5 * time.Second,
+//	    Parallax:             0.3,
+//	    IndicatorColor:       graphics.RGBA(255, 255, 255, 0.5),
+//	    IndicatorActiveColor: graphics.ColorWhite,
+//	}
+//
+// Autoplay pauses while the user drags the carousel and while the app is in
+// the background, and waits a full interval after either ends.
+//
+// The carousel fills the space it is given; wrap it in a [SizedBox] to set
+// its height.
+type Carousel struct {
+	core.StatefulBase
+
+	// ItemCount is the number of items.
+	ItemCount int
+	// ItemBuilder builds the item at index, from 0 to ItemCount-1.
+	ItemBuilder func(ctx core.BuildContext, index int) core.Widget
+	// Controller controls and reports the page. If nil, the carousel
+	// creates its own. With Loop, pages keep counting past ItemCount and the
+	// item shown is the page modulo ItemCount; the carousel moves the
+	// controller to the middle of the loop when it is first attached.
+	Controller *PageController
+	// OnPageChanged is called with the index of the item that becomes
+	// current.
+	OnPageChanged func(index int)
+
+	// Loop lets the user swipe from the last item to the first and back.
+	Loop bool
+	// AutoPlayInterval is how long each item stays before the carousel
+	// moves to the next one. Zero disables autoplay. Without Loop,
+	// autoplay returns to the first item after the last.
+	AutoPlayInterval time.Duration
+	// TransitionDuration is how long autoplay takes to move to the next
+	// item. Defaults to 400ms.
+	TransitionDuration time.Duration
+	// Curve eases autoplay transitions. Defaults to [animation.EaseInOut].
+	Curve func(float64) float64
+
+	// Parallax is how far item content lags behind as pages move, as a
+	// fraction of the page width. Zero disables the effect; 0.3 to 0.5
+	// gives a subtle depth.
+	Parallax float64
+
+	// IndicatorColor is the color of the inactive indicator dots. The
+	// indicator is shown when IndicatorColor or IndicatorActiveColor is set.
+	IndicatorColor graphics.Color
+	// IndicatorActiveColor is the color of the current item's dot.
+	IndicatorActiveColor graphics.Color
+}
+
+func (c Carousel) CreateState() core.State {
+	return &carouselState{}
+}
+
+type carouselState struct {
+	core.StateBase
+	own            *PageController
+	controller     *PageController
+	removeListener func()
+	dragging       bool
+	resumed        bool
+	stopTimer      func()
+	timerGen       int // invalidates ticks from stopped timers
+}
+
+func (s *carouselState) widget() Carousel {
+	return s.Element().Widget().(Carousel)
+}
+
+func (s *carouselState) InitState() {
+	s.resumed = platform.Lifecycle.State() == platform.LifecycleStateResumed
+	s.attach(s.widget())
+	platform.UseLifecycleObserver(s, func(state platform.LifecycleState) {
+		if s.IsDisposed() {
+			return
+		}
+		s.resumed = state == platform.LifecycleStateResumed
+		s.restartAutoplay()
+	})
+	s.restartAutoplay()
+}
+
+func (s *carouselState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old, _ := oldWidget.(Carousel)
+	w := s.widget()
+	if old.Controller != w.Controller {
+		s.attach(w)
+	}
+	if old.AutoPlayInterval != w.AutoPlayInterval {
+		s.restartAutoplay()
+	}
+}
+
+func (s *carouselState) Dispose() {
+	s.stopAutoplay()
+	if s.removeListener != nil {
+		s.removeListener()
+		s.removeListener = nil
+	}
+	s.StateBase.Dispose()
+}
+
+func (s *carouselState) attach(w Carousel) {
+	if s.removeListener != nil {
+		s.removeListener()
+	}
+	s.controller = w.Controller
+	if s.controller == nil {
+		if s.own == nil {
+			s.own = &PageController{}
+		}
+		s.controller = s.own
+	}
+	// Start a looping carousel in the middle so it can be swiped both ways.
+	if w.Loop && w.ItemCount > 0 && s.controller.extent == 0 && s.controller.InitialPage < w.ItemCount {
+		s.controller.InitialPage += carouselLoopCycles / 2 * w.ItemCount
+	}
+	s.removeListener = s.controller.AddListener(s.onControllerChanged)
+}
+
+func (s *carouselState) onControllerChanged() {
+	if dragging := s.controller.IsDragging(); dragging != s.dragging {
+		s.dragging = dragging
+		s.restartAutoplay()
+	}
+}
+
+// restartAutoplay stops the autoplay timer and, if autoplay is on and
+// nothing pauses it, starts a new full interval.
+func (s *carouselState) restartAutoplay() {
+	s.stopAutoplay()
+	w := s.widget()
+	if w.AutoPlayInterval <= 0 || w.ItemCount < 2 || s.dragging || !s.resumed {
+		return
+	}
+	gen := s.timerGen
+	s.stopTimer = afterFunc(w.AutoPlayInterval, func() { s.autoplayTick(gen) })
+}
+
+func (s *carouselState) stopAutoplay() {
+	s.timerGen++
+	if s.stopTimer != nil {
+		s.stopTimer()
+		s.stopTimer = nil
+	}
+}
+
+// autoplayTick moves to the next item and schedules the following one.
+func (s *carouselState) autoplayTick(gen int) {
+	if s.IsDisposed() || gen != s.timerGen {
+		return
+	}
+	w := s.widget()
+	duration := w.TransitionDuration
+	if duration <= 0 {
+		duration = defaultCarouselTransition
+	}
+	curve := w.Curve
+	if curve == nil {
+		curve = animation.EaseInOut
+	}
+	next := s.controller.CurrentPage() + 1
+	if !w.Loop && next >= w.ItemCount {
+		next = 0
+	}
+	s.controller.AnimateToPage(next, duration, curve)
+	s.restartAutoplay()
+}
+
+// itemIndex maps a page to the item it shows.
+func (s *carouselState) itemIndex(page, count int) int {
+	if count <= 0 {
+		return 0
+	}
+	return ((page % count) + count) % count
+}
+
+func (s *carouselState) Build(ctx core.BuildContext) core.Widget {
+	w := s.widget()
+	count := w.ItemCount
+	pages := count
+	if w.Loop {
+		pages = count * carouselLoopCycles
+	}
+	controller := s.controller
+	view := PageView{
+		ScrollDirection: AxisHorizontal,
+		Controller:      controller,
+		ItemCount:       pages,
+		OnPageChanged: func(page int) {
+			if w.OnPageChanged != nil {
+				w.OnPageChanged(s.itemIndex(page, count))
+			}
+		},
+		ItemBuilder: func(ctx core.BuildContext, page int) core.Widget {
+			var item core.Widget
+			if w.ItemBuilder != nil {
+				item = w.ItemBuilder(ctx, s.itemIndex(page, count))
+			}
+			if w.Parallax == 0 {
+				return item
+			}
+			return parallaxBox{
+				controller: controller,
+				page:       page,
+				factor:     w.Parallax,
+				child:      item,
+			}
+		},
+	}
+	if w.IndicatorColor == 0 && w.IndicatorActiveColor == 0 {
+		return view
+	}
+	return Stack{
+		Fit: StackFitExpand,
+		Children: []core.Widget{
+			view,
+			Positioned(Center{Child: PageIndicator{
+				Controller:  controller,
+				Count:       count,
+				Color:       w.IndicatorColor,
+				ActiveColor: w.IndicatorActiveColor,
+			}}).Left(0).Right(0).Bottom(12),
+		},
+	}
+}
+
+// PageIndicator shows a row of dots for the pages of a [PageView], with the
+// current page's dot stretched and colored ActiveColor. It follows the
+// controller as pages move, so the dots animate with a swipe. Pages past
+// Count wrap around, matching a looping [Carousel].
+type PageIndicator struct {
+	core.StatefulBase
+
+	// Controller is the controller of the PageView to follow.
+	Controller *PageController
+	// Count is the number of dots.
+	Count int
+	// Color is the color of inactive dots.
+	Color graphics.Color
+	// ActiveColor is the color of the current page's dot.
+	ActiveColor graphics.Color
+	// DotSize is the diameter of a dot. Defaults to 8. The current dot is
+	// twice as wide.
+	DotSize float64
+	// Spacing is the gap between dots. Defaults to DotSize.
+	Spacing float64
+}
+
+func (p PageIndicator) CreateState() core.State {
+	return &pageIndicatorState{}
+}
+
+type pageIndicatorState struct {
+	core.StateBase
+	controller     *PageController
+	removeListener func()
+}
+
+func (s *pageIndicatorState) InitState() {
+	s.listen(s.Element().Widget().(PageIndicator).Controller)
+}
+
+func (s *pageIndicatorState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	s.listen(s.Element().Widget().(PageIndicator).Controller)
+}
+
+func (s *pageIndicatorState) Dispose() {
+	s.listen(nil)
+	s.StateBase.Dispose()
+}
+
+func (s *pageIndicatorState) listen(controller *PageController) {
+	if controller == s.controller {
+		return
+	}
+	if s.removeListener != nil {
+		s.removeListener()
+		s.removeListener = nil
+	}
+	s.controller = controller
+	if controller != nil {
+		s.removeListener = controller.AddListener(func() { s.SetState(func() {}) })
+	}
+}
+
+func (s *pageIndicatorState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(PageIndicator)
+	if w.Count <= 0 {
+		return SizedBox{}
+	}
+	size := w.DotSize
+	if size <= 0 {
+		size = 8
+	}
+	spacing := w.Spacing
+	if spacing <= 0 {
+		spacing = size
+	}
+	page := 0.0
+	if w.Controller != nil {
+		page = math.Mod(w.Controller.Page(), float64(w.Count))
+		if page < 0 {
+			page += float64(w.Count)
+		}
+	}
+	dots := make([]core.Widget, 0, w.Count)
+	for i := range w.Count {
+		distance := math.Abs(page - float64(i))
+		distance = min(distance, float64(w.Count)-distance, 1)
+		active := 1 - distance
+		var dot core.Widget = Container{
+			Width:        size * (1 + active),
+			Height:       size,
+			Color:        animation.LerpColor(w.Color, w.ActiveColor, active),
+			BorderRadius: size / 2,
+		}
+		if i > 0 {
+			dot = Padding{Padding: layout.EdgeInsets{Left: spacing}, Child: dot}
+		}
+		dots = append(dots, dot)
+	}
+	return Row{
+		Children:     dots,
+		MainAxisSize: MainAxisSizeMin,
+	}
+}
+
+// parallaxBox paints the child of a horizontal page shifted by how far the
+// page is from the current position, clipped to its bounds. It reads the
+// position at paint time; the enclosing scroll view repaints as it moves.
+type parallaxBox struct {
+	core.RenderObjectBase
+	controller *PageController
+	page       int
+	factor     float64
+	child      core.Widget
+}
+
+func (p parallaxBox) ChildWidget() core.Widget { return p.child }
+
+func (p parallaxBox) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderParallaxBox{controller: p.controller, page: p.page, factor: p.factor}
+	box.SetSelf(box)
+	return box
+}
+
+func (p parallaxBox) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderParallaxBox); ok {
+		box.controller = p.controller
+		box.page = p.page
+		box.factor = p.factor
+		box.MarkNeedsPaint()
+	}
+}
+
+type renderParallaxBox struct {
+	renderPassthrough
+	controller *PageController
+	page       int
+	factor     float64
+}
+
+func (r *renderParallaxBox) offset() graphics.Offset {
+	shift := (r.controller.Page() - float64(r.page)) * r.factor
+	return graphics.Offset{X: shift * r.Size().Width}
+}
+
+func (r *renderParallaxBox) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	size := r.Size()
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height))
+	ctx.PaintChild(r.child.(layout.RenderBox), r.offset())
+	ctx.Canvas.Restore()
+}
+
+func (r *renderParallaxBox) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.child == nil {
+		return false
+	}
+	offset := r.offset()
+	return r.child.HitTest(graphics.Offset{X: position.X - offset.X, Y: position.Y - offset.Y}, result)
+}
@@ -0,0 +1,169 @@
+package widgets_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func pageLabels(count int) func(core.BuildContext, int) core.Widget {
+	return func(ctx core.BuildContext, i int) core.Widget {
+		return widgets.Text{Content: fmt.Sprintf("page %d", i)}
+	}
+}
+
+func TestPageView_SnapsToPages(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 300})
+	controller := &widgets.PageController{InitialPage: 1}
+	var changes []int
+	tester.PumpWidget(widgets.PageView{
+		ScrollDirection: widgets.AxisHorizontal,
+		Controller:      controller,
+		ItemCount:       5,
+		ItemBuilder:     pageLabels(5),
+		OnPageChanged:   func(page int) { changes = append(changes, page) },
+	})
+
+	if got := controller.Page(); got != 1 {
+		t.Fatalf("expected to start on page 1, got %v", got)
+	}
+	if !tester.Find(drifttest.ByText("page 1")).Exists() {
+		t.Fatal("expected page 1 to be built")
+	}
+
+	// A short drag settles back on the current page.
+	tester.DragFrom(graphics.Offset{X: 200, Y: 150}, graphics.Offset{X: -100})
+	tester.PumpAndSettle(2 * time.Second)
+	if got := controller.Page(); got != 1 {
+		t.Errorf("expected a short drag to settle on page 1, got %v", got)
+	}
+
+	// Dragging past halfway moves to the next page.
+	tester.DragFrom(graphics.Offset{X: 300, Y: 150}, graphics.Offset{X: -250})
+	tester.PumpAndSettle(2 * time.Second)
+	if got := controller.Page(); got != 2 {
+		t.Errorf("expected a long drag to settle on page 2, got %v", got)
+	}
+
+	controller.JumpToPage(4)
+	tester.Pump()
+	if got := controller.CurrentPage(); got != 4 {
+		t.Errorf("expected JumpToPage to show page 4, got %d", got)
+	}
+	if want := []int{2, 4}; fmt.Sprint(changes) != fmt.Sprint(want) {
+		t.Errorf("expected page changes %v, got %v", want, changes)
+	}
+}
+
+func TestPageView_KeepsPageOnResize(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 300})
+	controller := &widgets.PageController{InitialPage: 3}
+	tester.PumpWidget(widgets.PageView{
+		ScrollDirection: widgets.AxisHorizontal,
+		Controller:      controller,
+		ItemCount:       5,
+		ItemBuilder:     pageLabels(5),
+	})
+
+	tester.SetSize(graphics.Size{Width: 600, Height: 300})
+	tester.PumpWidget(widgets.PageView{
+		ScrollDirection: widgets.AxisHorizontal,
+		Controller:      controller,
+		ItemCount:       5,
+		ItemBuilder:     pageLabels(5),
+	})
+	if got := controller.Page(); got != 3 {
+		t.Errorf("expected page 3 after resizing, got %v", got)
+	}
+}
+
+func TestCarousel_LoopsAndAutoplays(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 300})
+	controller := &widgets.PageController{}
+	var changes []int
+	tester.PumpWidget(widgets.Carousel{
+		Controller:         controller,
+		ItemCount:          3,
+		ItemBuilder:        pageLabels(3),
+		Loop:               true,
+		AutoPlayInterval:   10 * time.Millisecond,
+		TransitionDuration: 50 * time.Millisecond,
+		OnPageChanged:      func(index int) { changes = append(changes, index) },
+	})
+
+	// A looping carousel can be swiped back from the first item.
+	tester.DragFrom(graphics.Offset{X: 100, Y: 150}, graphics.Offset{X: 250})
+	tester.PumpAndSettle(2 * time.Second)
+	if !tester.Find(drifttest.ByText("page 2")).Exists() {
+		t.Fatal("expected swiping back from the first item to show the last")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(changes) < 3 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+		tester.Pump()
+		tester.Clock().Advance(16 * time.Millisecond)
+	}
+	if want := []int{2, 0, 1}; fmt.Sprint(changes) != fmt.Sprint(want) {
+		t.Errorf("expected item changes %v, got %v", want, changes)
+	}
+}
+
+func TestCarousel_AutoplayPausesInBackground(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	t.Cleanup(func() { platform.Lifecycle.SetStateForTest(platform.LifecycleStateResumed) })
+	controller := &widgets.PageController{}
+	tester.PumpWidget(widgets.Carousel{
+		Controller:       controller,
+		ItemCount:        3,
+		ItemBuilder:      pageLabels(3),
+		AutoPlayInterval: 10 * time.Millisecond,
+	})
+
+	platform.Lifecycle.SetStateForTest(platform.LifecycleStatePaused)
+	tester.Pump()
+	time.Sleep(50 * time.Millisecond)
+	tester.PumpAndSettle(time.Second)
+	if got := controller.Page(); got != 0 {
+		t.Errorf("expected no autoplay in the background, got page %v", got)
+	}
+}
+
+func TestPageIndicator_FollowsController(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 300})
+	controller := &widgets.PageController{InitialPage: 4}
+	tester.PumpWidget(widgets.Carousel{
+		Controller:           controller,
+		ItemCount:            3,
+		ItemBuilder:          pageLabels(3),
+		Loop:                 true,
+		IndicatorColor:       graphics.RGBA(255, 255, 255, 0.5),
+		IndicatorActiveColor: graphics.ColorWhite,
+	})
+
+	dots := tester.Find(drifttest.ByType[widgets.Container]())
+	if dots.Count() != 3 {
+		t.Fatalf("expected 3 dots, got %d", dots.Count())
+	}
+	// Page 4 is item 1, whose dot is stretched.
+	for i, e := range dots.All() {
+		dot := e.Widget().(widgets.Container)
+		want := 8.0
+		if i == 1 {
+			want = 16
+		}
+		if dot.Width != want {
+			t.Errorf("dot %d: expected width %v, got %v", i, want, dot.Width)
+		}
+	}
+}
@@ -298,7 +298,7 @@ func (r *renderCheckbox) HandlePointer(event gestures.PointerEvent) {
 		return
 	}
 	if r.tap == nil {
-		r.tap = gestures.NewTapGestureRecognizer(gestures.DefaultArena)
+		r.tap = gestures.NewTapGestureRecognizer(r.GestureArena())
 		r.tap.OnTap = func() {
 			if r.onChanged != nil {
 				r.onChanged(!r.value)
@@ -0,0 +1,156 @@
+package widgets
+
+import (
+	"hash/fnv"
+	"image"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// defaultAvatarRadius is the radius of a [CircleAvatar] when Radius is zero.
+const defaultAvatarRadius = 20
+
+// CircleAvatar shows a person or account as a circular image, falling back
+// to their initials on a colored circle when there is no image.
+//
+// The initials are taken from Name unless Initials is set, and the circle's
+// color is derived from Name with [AvatarColor], so the same name always
+// gets the same color:
+//
+//	widgets.CircleAvatar{Name: user.Name, Image: user.Photo, Radius: 24}
+//
+// The initials are drawn under the image, so they show while an Asset loads
+// or if it fails to.
+type CircleAvatar struct {
+	core.StatelessBase
+
+	// Image is the picture to show, cropped to the circle.
+	Image image.Image
+	// Asset is the name of an image in the bundle from [AssetBundleOf],
+	// used instead of Image when set.
+	Asset string
+	// Name is who the avatar stands for. It provides the initials, the
+	// background color, and the accessibility label.
+	Name string
+	// Initials overrides the initials taken from Name.
+	Initials string
+	// Radius is the radius of the circle. Defaults to 20.
+	Radius float64
+	// BackgroundColor fills the circle behind the initials. Defaults to
+	// AvatarColor(Name).
+	BackgroundColor graphics.Color
+	// ForegroundColor is the color of the initials. Defaults to black or
+	// white, whichever contrasts more with the background.
+	ForegroundColor graphics.Color
+}
+
+func (a CircleAvatar) Build(ctx core.BuildContext) core.Widget {
+	radius := a.Radius
+	if radius <= 0 {
+		radius = defaultAvatarRadius
+	}
+	size := radius * 2
+	background := a.BackgroundColor
+	if background == 0 {
+		background = AvatarColor(a.Name)
+	}
+	foreground := a.ForegroundColor
+	if foreground == 0 {
+		foreground = graphics.BestOnColor(background)
+	}
+	initials := a.Initials
+	if initials == "" {
+		initials = InitialsOf(a.Name)
+	}
+
+	layers := []core.Widget{
+		Container{
+			Color:     background,
+			Alignment: layout.AlignmentCenter,
+			Child: Text{
+				Content:  initials,
+				MaxLines: 1,
+				Style: graphics.TextStyle{
+					Color:      foreground,
+					FontSize:   radius * 0.8,
+					FontWeight: graphics.FontWeightMedium,
+				},
+			},
+		},
+	}
+	if a.Image != nil || a.Asset != "" {
+		layers = append(layers, Image{
+			Source:               a.Image,
+			Asset:                a.Asset,
+			Width:                size,
+			Height:               size,
+			Fit:                  ImageFitCover,
+			ExcludeFromSemantics: true,
+		})
+	}
+	return Semantics{
+		Label:     a.Name,
+		Role:      semantics.SemanticsRoleImage,
+		Container: a.Name != "",
+		Child: SizedBox{
+			Width:  size,
+			Height: size,
+			Child: ClipRRect{
+				Radius: radius,
+				Child:  Stack{Fit: StackFitExpand, Children: layers},
+			},
+		},
+	}
+}
+
+// avatarPalette holds the colors [AvatarColor] picks from: mid-tone hues
+// that read well with either black or white initials.
+var avatarPalette = []graphics.Color{
+	graphics.RGB(229, 115, 115), // red
+	graphics.RGB(240, 98, 146),  // pink
+	graphics.RGB(186, 104, 200), // purple
+	graphics.RGB(149, 117, 205), // deep purple
+	graphics.RGB(121, 134, 203), // indigo
+	graphics.RGB(100, 181, 246), // blue
+	graphics.RGB(77, 182, 172),  // teal
+	graphics.RGB(129, 199, 132), // green
+	graphics.RGB(220, 231, 117), // lime
+	graphics.RGB(255, 183, 77),  // orange
+	graphics.RGB(161, 136, 127), // brown
+	graphics.RGB(144, 164, 174), // blue gray
+}
+
+// AvatarColor returns a background color for name, chosen by hashing it, so
+// a name always gets the same color. [CircleAvatar] uses it when
+// BackgroundColor is zero; use it to color other things that belong to the
+// same person.
+func AvatarColor(name string) graphics.Color {
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(strings.TrimSpace(name))))
+	return avatarPalette[h.Sum32()%uint32(len(avatarPalette))]
+}
+
+// InitialsOf returns up to two uppercase initials for name: the first
+// letters of its first and last words. "Ada Lovelace" gives "AL" and
+// "grace" gives "G".
+func InitialsOf(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-' || r == '_' || r == '.'
+	})
+	if len(words) == 0 {
+		return ""
+	}
+	first, _ := utf8.DecodeRuneInString(words[0])
+	initials := string(unicode.ToUpper(first))
+	if len(words) > 1 {
+		last, _ := utf8.DecodeRuneInString(words[len(words)-1])
+		initials += string(unicode.ToUpper(last))
+	}
+	return initials
+}
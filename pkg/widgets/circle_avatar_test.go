@@ -0,0 +1,60 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestInitialsOf(t *testing.T) {
+	tests := map[string]string{
+		"Ada Lovelace":         "AL",
+		"grace":                "G",
+		"  jean-paul  sartre ": "JS",
+		"mary_ann":             "MA",
+		"Éric von der Straße":  "ÉS",
+		"":                     "",
+		"john.q.public":        "JP",
+	}
+	for name, want := range tests {
+		if got := widgets.InitialsOf(name); got != want {
+			t.Errorf("InitialsOf(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestAvatarColor_IsStable(t *testing.T) {
+	if widgets.AvatarColor("Ada Lovelace") != widgets.AvatarColor(" ada lovelace") {
+		t.Error("expected case and surrounding space not to change the color")
+	}
+	seen := map[graphics.Color]bool{}
+	for _, name := range []string{"Ada", "Grace", "Alan", "Edsger", "Barbara", "Donald", "Margaret", "Ken"} {
+		seen[widgets.AvatarColor(name)] = true
+	}
+	if len(seen) < 3 {
+		t.Errorf("expected names to spread across the palette, got %d colors", len(seen))
+	}
+}
+
+func TestCircleAvatar_InitialsFallback(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(widgets.Center{Child: widgets.CircleAvatar{Name: "Ada Lovelace", Radius: 24}})
+
+	if !tester.Find(drifttest.ByText("AL")).Exists() {
+		t.Error("expected the initials without an image")
+	}
+	if tester.Find(drifttest.ByType[widgets.Image]()).Exists() {
+		t.Error("expected no image layer without an image")
+	}
+	size := tester.Find(drifttest.ByType[widgets.SizedBox]()).RenderObject().(interface{ Size() graphics.Size }).Size()
+	if size != (graphics.Size{Width: 48, Height: 48}) {
+		t.Errorf("expected a 48x48 avatar, got %v", size)
+	}
+
+	tester.PumpWidget(widgets.Center{Child: widgets.CircleAvatar{Name: "Ada Lovelace", Image: cropperSource()}})
+	if !tester.Find(drifttest.ByType[widgets.Image]()).Exists() {
+		t.Error("expected the image over the initials")
+	}
+}
@@ -70,6 +70,9 @@ type circularProgressState struct {
 func (s *circularProgressState) InitState() {
 	s.controller = animation.NewAnimationController(1800 * time.Millisecond)
 	s.controller.Curve = animation.LinearCurve
+	// Keep spinning when animations are disabled: the motion shows that work
+	// is in progress, and the restart on completion would otherwise loop.
+	s.controller.Behavior = animation.AnimationBehaviorPreserve
 	core.UseDisposable(s, s.controller)
 	core.UseListenable(s, s.controller)
 	s.controller.AddStatusListener(func(status animation.AnimationStatus) {
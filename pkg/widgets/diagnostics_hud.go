@@ -20,6 +20,49 @@ type DiagnosticsHUDDataSource interface {
 	RegisterRenderObject(ro layout.RenderObject)
 }
 
+// DiagnosticsHUDInputLatencySource is an optional extension of
+// [DiagnosticsHUDDataSource] that reports input latency.
+type DiagnosticsHUDInputLatencySource interface {
+	// InputLatencyLabel returns the current input latency display string.
+	InputLatencyLabel() string
+}
+
+// DiagnosticsHUDRebuildSource is an optional extension of
+// [DiagnosticsHUDDataSource] that reports the widget types rebuilding the most.
+type DiagnosticsHUDRebuildSource interface {
+	// RebuildStatLabels returns one display line per widget type, worst first.
+	RebuildStatLabels() []string
+}
+
+// DiagnosticsHUDThreadSource is an optional extension of
+// [DiagnosticsHUDDataSource] that reports per-frame UI and raster times for
+// the performance overlay.
+type DiagnosticsHUDThreadSource interface {
+	// ThreadSampleCount returns the number of frames with UI and raster times.
+	ThreadSampleCount() int
+	// UISamplesInto copies UI times (building, laying out, and recording a
+	// frame) into dst and returns the count copied.
+	UISamplesInto(dst []time.Duration) int
+	// RasterSamplesInto copies raster times (compositing a frame and flushing
+	// it to the GPU) into dst and returns the count copied.
+	RasterSamplesInto(dst []time.Duration) int
+}
+
+// DiagnosticsHUDMemorySource is an optional extension of
+// [DiagnosticsHUDDataSource] that reports memory usage.
+type DiagnosticsHUDMemorySource interface {
+	// MemoryLabels returns the lines of the memory panel.
+	MemoryLabels() []string
+}
+
+// diagnosticsHUDRebuildLines is the number of rebuild lines the HUD reserves
+// space for, so the panel doesn't resize as offenders come and go.
+const diagnosticsHUDRebuildLines = 5
+
+// diagnosticsHUDMemoryLines is the number of memory lines the HUD reserves
+// space for.
+const diagnosticsHUDMemoryLines = 4
+
 // DiagnosticsHUD displays performance metrics overlay.
 type DiagnosticsHUD struct {
 	core.StatelessBase
@@ -36,6 +79,21 @@ type DiagnosticsHUD struct {
 	ShowFPS bool
 	// ShowFrameGraph controls whether to display the frame time graph.
 	ShowFrameGraph bool
+	// ShowInputLatency controls whether to display input latency. The
+	// DataSource must implement [DiagnosticsHUDInputLatencySource].
+	ShowInputLatency bool
+	// ShowRebuildStats controls whether to list the widget types with the
+	// most build time. The DataSource must implement
+	// [DiagnosticsHUDRebuildSource].
+	ShowRebuildStats bool
+	// ShowPerformanceOverlay controls whether to chart UI and raster time
+	// per frame on two tracks, with gridlines at multiples of TargetTime and
+	// frames over it highlighted. The DataSource must implement
+	// [DiagnosticsHUDThreadSource].
+	ShowPerformanceOverlay bool
+	// ShowMemory controls whether to display memory usage. The DataSource
+	// must implement [DiagnosticsHUDMemorySource].
+	ShowMemory bool
 }
 
 func (d DiagnosticsHUD) Build(ctx core.BuildContext) core.Widget {
@@ -49,23 +107,31 @@ func (d DiagnosticsHUD) Build(ctx core.BuildContext) core.Widget {
 	}
 
 	return diagnosticsHUDRender{
-		dataSource:     d.DataSource,
-		targetTime:     d.TargetTime,
-		graphWidth:     graphWidth,
-		graphHeight:    graphHeight,
-		showFPS:        d.ShowFPS,
-		showFrameGraph: d.ShowFrameGraph,
+		dataSource:       d.DataSource,
+		targetTime:       d.TargetTime,
+		graphWidth:       graphWidth,
+		graphHeight:      graphHeight,
+		showFPS:          d.ShowFPS,
+		showFrameGraph:   d.ShowFrameGraph,
+		showInputLatency: d.ShowInputLatency,
+		showRebuildStats: d.ShowRebuildStats,
+		showPerformance:  d.ShowPerformanceOverlay,
+		showMemory:       d.ShowMemory,
 	}
 }
 
 type diagnosticsHUDRender struct {
 	core.RenderObjectBase
-	dataSource     DiagnosticsHUDDataSource
-	targetTime     time.Duration
-	graphWidth     float64
-	graphHeight    float64
-	showFPS        bool
-	showFrameGraph bool
+	dataSource       DiagnosticsHUDDataSource
+	targetTime       time.Duration
+	graphWidth       float64
+	graphHeight      float64
+	showFPS          bool
+	showFrameGraph   bool
+	showInputLatency bool
+	showRebuildStats bool
+	showPerformance  bool
+	showMemory       bool
 }
 
 func (d diagnosticsHUDRender) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
@@ -95,17 +161,28 @@ func (d diagnosticsHUDRender) UpdateRenderObject(ctx core.BuildContext, renderOb
 
 type renderDiagnosticsHUD struct {
 	layout.RenderBoxBase
-	dataSource     DiagnosticsHUDDataSource
-	targetTime     time.Duration
-	graphWidth     float64
-	graphHeight    float64
-	showFPS        bool
-	showFrameGraph bool
+	dataSource       DiagnosticsHUDDataSource
+	targetTime       time.Duration
+	graphWidth       float64
+	graphHeight      float64
+	showFPS          bool
+	showFrameGraph   bool
+	showInputLatency bool
+	showRebuildStats bool
+	showPerformance  bool
+	showMemory       bool
 
 	// Cached state
-	textLayout     *graphics.TextLayout
-	cachedFPSLabel string
-	sampleBuffer   []time.Duration // Reusable buffer for samples
+	textLayout         *graphics.TextLayout
+	cachedFPSLabel     string
+	latencyLayout      *graphics.TextLayout
+	cachedLatencyLabel string
+	sampleBuffer       []time.Duration // Reusable buffer for samples
+	rebuildPanel       diagnosticsHUDPanel
+	memoryPanel        diagnosticsHUDPanel
+	uiBuffer           []time.Duration // Reusable buffers for thread samples
+	rasterBuffer       []time.Duration
+	trackLabels        [2]*graphics.TextLayout
 }
 
 func (r *renderDiagnosticsHUD) update(d diagnosticsHUDRender) {
@@ -115,6 +192,10 @@ func (r *renderDiagnosticsHUD) update(d diagnosticsHUDRender) {
 	r.graphHeight = d.graphHeight
 	r.showFPS = d.showFPS
 	r.showFrameGraph = d.showFrameGraph
+	r.showInputLatency = d.showInputLatency
+	r.showRebuildStats = d.showRebuildStats
+	r.showPerformance = d.showPerformance
+	r.showMemory = d.showMemory
 }
 
 // IsRepaintBoundary returns true to isolate HUD repaints from the main app.
@@ -130,9 +211,21 @@ func (r *renderDiagnosticsHUD) PerformLayout() {
 	if r.showFPS {
 		height += 18 // Text height + padding
 	}
+	if r.showInputLatency {
+		height += 18
+	}
 	if r.showFrameGraph {
 		height += r.graphHeight + 4 // Graph + padding
 	}
+	if r.showPerformance {
+		height += 2 * (r.trackHeight() + 4) // Two tracks + padding
+	}
+	if r.showRebuildStats {
+		height += 16 + diagnosticsHUDRebuildLines*14 // Title + lines
+	}
+	if r.showMemory {
+		height += 16 + diagnosticsHUDMemoryLines*14
+	}
 
 	constraints := r.Constraints()
 	width = min(max(width, constraints.MinWidth), constraints.MaxWidth)
@@ -176,6 +269,31 @@ func (r *renderDiagnosticsHUD) Paint(ctx *layout.PaintContext) {
 		yOffset += 18
 	}
 
+	// Draw input latency label if enabled
+	if r.showInputLatency {
+		if source, ok := r.dataSource.(DiagnosticsHUDInputLatencySource); ok {
+			label := source.InputLatencyLabel()
+			if label == "" {
+				label = "Input: --"
+			}
+			if label != r.cachedLatencyLabel || r.latencyLayout == nil {
+				r.cachedLatencyLabel = label
+				textStyle := graphics.TextStyle{
+					Color:    graphics.RGB(255, 255, 255),
+					FontSize: 12,
+				}
+				manager, _ := graphics.DefaultFontManagerErr()
+				if manager != nil {
+					r.latencyLayout, _ = graphics.LayoutText(label, textStyle, manager)
+				}
+			}
+			if r.latencyLayout != nil {
+				ctx.Canvas.DrawText(r.latencyLayout, graphics.Offset{X: 8, Y: yOffset})
+			}
+		}
+		yOffset += 18
+	}
+
 	// Draw frame graph if enabled
 	if r.showFrameGraph && r.dataSource != nil {
 		graphLeft := 8.0
@@ -253,6 +371,160 @@ func (r *renderDiagnosticsHUD) Paint(ctx *layout.PaintContext) {
 			linePaint.Color = graphics.RGBA(255, 255, 255, 0.5)
 			ctx.Canvas.DrawRect(graphics.RectFromLTWH(graphLeft, targetY, graphWidth, 1), linePaint)
 		}
+		yOffset += graphHeight + 4
+	}
+
+	// Draw UI and raster tracks if enabled
+	if r.showPerformance {
+		r.paintPerformance(ctx, yOffset)
+		yOffset += 2 * (r.trackHeight() + 4)
+	}
+
+	// Draw rebuild offenders if enabled
+	if r.showRebuildStats {
+		if source, ok := r.dataSource.(DiagnosticsHUDRebuildSource); ok {
+			r.rebuildPanel.paint(ctx, yOffset, "Top builds", source.RebuildStatLabels(), diagnosticsHUDRebuildLines)
+		}
+		yOffset += 16 + diagnosticsHUDRebuildLines*14
+	}
+
+	// Draw memory usage if enabled
+	if r.showMemory {
+		if source, ok := r.dataSource.(DiagnosticsHUDMemorySource); ok {
+			r.memoryPanel.paint(ctx, yOffset, "Memory", source.MemoryLabels(), diagnosticsHUDMemoryLines)
+		}
+	}
+}
+
+// trackHeight is the height of each performance overlay track.
+func (r *renderDiagnosticsHUD) trackHeight() float64 {
+	return r.graphHeight / 2
+}
+
+// paintPerformance draws the UI and raster tracks starting at yOffset.
+func (r *renderDiagnosticsHUD) paintPerformance(ctx *layout.PaintContext, yOffset float64) {
+	source, ok := r.dataSource.(DiagnosticsHUDThreadSource)
+	if !ok {
+		return
+	}
+	count := source.ThreadSampleCount()
+	if count > len(r.uiBuffer) {
+		r.uiBuffer = make([]time.Duration, count)
+		r.rasterBuffer = make([]time.Duration, count)
+	}
+	ui := r.uiBuffer[:source.UISamplesInto(r.uiBuffer)]
+	raster := r.rasterBuffer[:source.RasterSamplesInto(r.rasterBuffer)]
+
+	height := r.trackHeight()
+	r.paintTrack(ctx, 0, "UI", ui, yOffset, height)
+	r.paintTrack(ctx, 1, "Raster", raster, yOffset+height+4, height)
+}
+
+// paintTrack draws one performance track: a bar per frame scaled so the
+// track spans three frame budgets, a gridline at each budget, and bars over
+// budget in red. The newest frames are kept if there are more than fit.
+func (r *renderDiagnosticsHUD) paintTrack(ctx *layout.PaintContext, index int, label string, samples []time.Duration, top, height float64) {
+	left := 8.0
+	width := r.graphWidth
+	target := r.targetTime
+	if target <= 0 {
+		target = 16667 * time.Microsecond
+	}
+	const budgets = 3
+	maxTime := target * budgets
+
+	trackPaint := graphics.DefaultPaint()
+	trackPaint.Color = graphics.RGBA(255, 255, 255, 0.08)
+	ctx.Canvas.DrawRect(graphics.RectFromLTWH(left, top, width, height), trackPaint)
+
+	if len(samples) > 0 {
+		barWidth := max(width/float64(len(samples)), 1)
+		if maxBars := int(width / barWidth); len(samples) > maxBars {
+			samples = samples[len(samples)-maxBars:]
+		}
+		okPaint := graphics.DefaultPaint()
+		okPaint.Color = graphics.RGB(76, 175, 80)
+		jankPaint := graphics.DefaultPaint()
+		jankPaint.Color = graphics.RGB(244, 67, 54)
+		for i, sample := range samples {
+			barHeight := min(max(float64(sample)/float64(maxTime)*height, 1), height)
+			paint := okPaint
+			if sample > target {
+				paint = jankPaint
+			}
+			x := left + float64(i)*barWidth
+			ctx.Canvas.DrawRect(graphics.RectFromLTWH(x, top+height-barHeight, max(barWidth-1, 1), barHeight), paint)
+		}
+	}
+
+	gridPaint := graphics.DefaultPaint()
+	gridPaint.Color = graphics.RGBA(255, 255, 255, 0.5)
+	for i := 1; i < budgets; i++ {
+		y := top + height - float64(i)/budgets*height
+		ctx.Canvas.DrawRect(graphics.RectFromLTWH(left, y, width, 1), gridPaint)
+	}
+
+	if r.trackLabels[index] == nil {
+		if manager, _ := graphics.DefaultFontManagerErr(); manager != nil {
+			r.trackLabels[index], _ = graphics.LayoutText(label, graphics.TextStyle{
+				Color:    graphics.RGBA(255, 255, 255, 0.85),
+				FontSize: 9,
+			}, manager)
+		}
+	}
+	if r.trackLabels[index] != nil {
+		ctx.Canvas.DrawText(r.trackLabels[index], graphics.Offset{X: left + 2, Y: top + 1})
+	}
+}
+
+// diagnosticsHUDPanel draws a titled list of text lines, caching a text
+// layout per line so only lines that changed are laid out again.
+type diagnosticsHUDPanel struct {
+	title   *graphics.TextLayout
+	layouts []*graphics.TextLayout
+	labels  []string
+}
+
+// paint draws title and up to maxLines labels starting at yOffset.
+func (p *diagnosticsHUDPanel) paint(ctx *layout.PaintContext, yOffset float64, title string, labels []string, maxLines int) {
+	manager, _ := graphics.DefaultFontManagerErr()
+	if manager == nil {
+		return
+	}
+
+	if p.title == nil {
+		p.title, _ = graphics.LayoutText(title, graphics.TextStyle{
+			Color:      graphics.RGB(255, 255, 255),
+			FontSize:   11,
+			FontWeight: graphics.FontWeightBold,
+		}, manager)
+	}
+	if p.title != nil {
+		ctx.Canvas.DrawText(p.title, graphics.Offset{X: 8, Y: yOffset})
+	}
+	yOffset += 16
+
+	if len(labels) > maxLines {
+		labels = labels[:maxLines]
+	}
+	if len(p.layouts) < len(labels) {
+		p.layouts = append(p.layouts, make([]*graphics.TextLayout, len(labels)-len(p.layouts))...)
+		p.labels = append(p.labels, make([]string, len(labels)-len(p.labels))...)
+	}
+	lineStyle := graphics.TextStyle{
+		Color:    graphics.RGBA(255, 255, 255, 0.85),
+		FontSize: 10,
+	}
+	for i, label := range labels {
+		// Only recreate text layouts for lines that changed
+		if label != p.labels[i] || p.layouts[i] == nil {
+			p.labels[i] = label
+			p.layouts[i], _ = graphics.LayoutText(label, lineStyle, manager)
+		}
+		if p.layouts[i] != nil {
+			ctx.Canvas.DrawText(p.layouts[i], graphics.Offset{X: 8, Y: yOffset})
+		}
+		yOffset += 14
 	}
 }
 
@@ -0,0 +1,74 @@
+package widgets
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// Directionality sets the reading direction for the widgets below it.
+// Direction-aware widgets read it with [DirectionalityOf]:
+//
+//   - [Row] lays out its children from the start edge, so right to left
+//     in RTL, and [Column] mirrors its cross-axis Start and End.
+//   - [Text] and [RichText] resolve TextAlignStart and TextAlignEnd.
+//   - [Stack] resolves AlignmentDirectional, and [Positioned] its Start
+//     and End offsets.
+//   - [PaddingDirectional] resolves its Start and End insets.
+//   - [Icon] mirrors when MatchTextDirection is set.
+//
+// intl.Localizations inserts one for the resolved locale, so apps that use
+// it get right-to-left layout for Arabic, Hebrew, and similar languages
+// without further setup. Without any Directionality, layout is
+// left-to-right.
+//
+//	widgets.Directionality{
+//	    TextDirection: graphics.TextDirectionRTL,
+//	    Child:         content,
+//	}
+type Directionality struct {
+	core.InheritedBase
+	// TextDirection is the reading direction for descendants.
+	TextDirection graphics.TextDirection
+	// Child is the widget below this one in the tree.
+	Child core.Widget
+}
+
+func (d Directionality) ChildWidget() core.Widget { return d.Child }
+
+func (d Directionality) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(Directionality); ok {
+		return d.TextDirection != old.TextDirection
+	}
+	return true
+}
+
+var directionalityType = reflect.TypeFor[Directionality]()
+
+// DirectionalityOf returns the reading direction from the nearest
+// [Directionality], or left-to-right if there is none.
+func DirectionalityOf(ctx core.BuildContext) graphics.TextDirection {
+	if d, ok := ctx.DependOnInherited(directionalityType, nil).(Directionality); ok {
+		return d.TextDirection
+	}
+	return graphics.TextDirectionLTR
+}
+
+// PaddingDirectional is a [Padding] whose Start and End insets follow the
+// reading direction from [DirectionalityOf]:
+//
+//	widgets.PaddingDirectional{
+//	    Padding: layout.EdgeInsetsDirectionalOnly(16, 0, 8, 0),
+//	    Child:   label,
+//	}
+type PaddingDirectional struct {
+	core.StatelessBase
+	Padding layout.EdgeInsetsDirectional
+	Child   core.Widget
+}
+
+func (p PaddingDirectional) Build(ctx core.BuildContext) core.Widget {
+	return Padding{Padding: p.Padding.Resolve(DirectionalityOf(ctx)), Child: p.Child}
+}
@@ -0,0 +1,141 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// tapTarget is a 20x20 box that records taps under name.
+func tapTarget(name string, tapped *[]string) core.Widget {
+	return widgets.GestureDetector{
+		OnTap: func() { *tapped = append(*tapped, name) },
+		Child: widgets.SizedBox{Width: 20, Height: 20},
+	}
+}
+
+// tapIn pumps child under a Directionality of 100x100 and taps at x, y.
+func tapIn(t *testing.T, direction graphics.TextDirection, child core.Widget, x, y float64) {
+	t.Helper()
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 100, Height: 100})
+	if err := tester.PumpWidget(widgets.Directionality{TextDirection: direction, Child: child}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tester.TapAt(graphics.Offset{X: x, Y: y}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDirectionality_RowRunsRightToLeft(t *testing.T) {
+	var tapped []string
+	row := widgets.Row{Children: []core.Widget{tapTarget("first", &tapped), tapTarget("second", &tapped)}}
+
+	tapIn(t, graphics.TextDirectionRTL, row, 90, 10)
+	tapIn(t, graphics.TextDirectionRTL, row, 70, 10)
+	tapIn(t, graphics.TextDirectionLTR, row, 10, 10)
+	if len(tapped) != 3 || tapped[0] != "first" || tapped[1] != "second" || tapped[2] != "first" {
+		t.Errorf("tapped = %v, want [first second first]", tapped)
+	}
+}
+
+func TestDirectionality_ColumnMirrorsCrossAxis(t *testing.T) {
+	var tapped []string
+	column := widgets.Column{
+		CrossAxisAlignment: widgets.CrossAxisAlignmentStart,
+		Children:           []core.Widget{tapTarget("start", &tapped)},
+	}
+
+	tapIn(t, graphics.TextDirectionRTL, column, 90, 10)
+	if len(tapped) != 1 {
+		t.Errorf("expected the start-aligned child on the right in RTL, tapped = %v", tapped)
+	}
+}
+
+func TestDirectionality_PositionedStartEnd(t *testing.T) {
+	var tapped []string
+	stack := widgets.Stack{
+		Fit: widgets.StackFitExpand,
+		Children: []core.Widget{
+			widgets.Positioned(tapTarget("start", &tapped)).Start(8).Top(0),
+			widgets.Positioned(tapTarget("end", &tapped)).End(8).Top(40),
+		},
+	}
+
+	tapIn(t, graphics.TextDirectionRTL, stack, 85, 10)
+	tapIn(t, graphics.TextDirectionRTL, stack, 15, 50)
+	tapIn(t, graphics.TextDirectionLTR, stack, 15, 10)
+	if len(tapped) != 3 || tapped[0] != "start" || tapped[1] != "end" || tapped[2] != "start" {
+		t.Errorf("tapped = %v, want [start end start]", tapped)
+	}
+}
+
+func TestDirectionality_StackAlignmentDirectional(t *testing.T) {
+	var tapped []string
+	stack := widgets.Stack{
+		Fit:                  widgets.StackFitExpand,
+		AlignmentDirectional: &layout.AlignmentTopStart,
+		Children: []core.Widget{
+			widgets.Positioned(tapTarget("aligned", &tapped)).Width(20).Height(20),
+		},
+	}
+
+	tapIn(t, graphics.TextDirectionRTL, stack, 90, 10)
+	if len(tapped) != 1 {
+		t.Errorf("expected the top-start child on the right in RTL, tapped = %v", tapped)
+	}
+}
+
+func TestPaddingDirectional(t *testing.T) {
+	var tapped []string
+	padded := widgets.Row{Children: []core.Widget{
+		widgets.PaddingDirectional{
+			Padding: layout.EdgeInsetsDirectionalOnly(30, 0, 0, 0),
+			Child:   tapTarget("padded", &tapped),
+		},
+	}}
+
+	// In RTL the row starts at the right edge and the start inset is on
+	// the right, so the target spans x 50..70.
+	tapIn(t, graphics.TextDirectionRTL, padded, 60, 10)
+	if len(tapped) != 1 {
+		t.Fatalf("expected a tap at x=60 to hit the target, tapped = %v", tapped)
+	}
+	tapIn(t, graphics.TextDirectionRTL, padded, 90, 10)
+	if len(tapped) != 1 {
+		t.Errorf("expected a tap in the start inset to miss the target, tapped = %v", tapped)
+	}
+}
+
+func TestIcon_MatchTextDirection(t *testing.T) {
+	mirrored := func(direction graphics.TextDirection, match bool) bool {
+		t.Helper()
+		tester := drifttest.NewWidgetTesterWithT(t)
+		if err := tester.PumpWidget(widgets.Directionality{
+			TextDirection: direction,
+			Child:         widgets.Icon{Glyph: "←", Size: 24, Color: graphics.ColorBlack, MatchTextDirection: match},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		for _, op := range tester.CaptureSnapshot().DisplayOps {
+			if op.Op == "scale" && op.Params["sx"] == -1.0 {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !mirrored(graphics.TextDirectionRTL, true) {
+		t.Error("expected the icon to mirror in RTL")
+	}
+	if mirrored(graphics.TextDirectionLTR, true) {
+		t.Error("expected no mirroring in LTR")
+	}
+	if mirrored(graphics.TextDirectionRTL, false) {
+		t.Error("expected no mirroring without MatchTextDirection")
+	}
+}
@@ -54,7 +54,7 @@
 //
 // # Input Widgets
 //
-// Button, TextField, Checkbox, Radio, and Switch handle user input.
+// Button, TextField, Checkbox, Radio, Slider, and Switch handle user input.
 // Use struct literals for explicit control or theme.XxxOf for themed widgets:
 //
 //	// Struct literal (explicit)
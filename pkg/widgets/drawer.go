@@ -0,0 +1,234 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// Drawer is the panel of a [Scaffold] drawer: a full-height surface that
+// holds navigation links or other content. Pass it as Scaffold.Drawer or
+// Scaffold.EndDrawer.
+//
+// Drawer is explicit by default: a zero Width sizes the panel to its child,
+// a zero BackgroundColor is transparent, and a zero ShadowColor draws no
+// shadow. For a themed drawer, use [theme.DrawerOf].
+//
+//	widgets.Drawer{
+//	    Width:           304,
+//	    BackgroundColor: colors.SurfaceContainerLow,
+//	    ShadowColor:     colors.Shadow,
+//	    Child:           widgets.SafeArea{Child: links},
+//	}
+type Drawer struct {
+	core.StatelessBase
+
+	// Child is the drawer's content.
+	Child core.Widget
+	// Width is the width of the panel. It never exceeds the scaffold's
+	// width.
+	Width float64
+	// BackgroundColor fills the panel.
+	BackgroundColor graphics.Color
+	// ShadowColor is the color of the panel's elevation shadow.
+	ShadowColor graphics.Color
+}
+
+func (d Drawer) Build(ctx core.BuildContext) core.Widget {
+	var shadow *graphics.BoxShadow
+	if d.ShadowColor != graphics.ColorTransparent {
+		shadow = graphics.BoxShadowElevation(1, d.ShadowColor)
+	}
+	return DecoratedBox{
+		Color:  d.BackgroundColor,
+		Shadow: shadow,
+		Child:  SizedBox{Width: d.Width, Child: d.Child},
+	}
+}
+
+// drawerScrim covers the scaffold behind an open drawer, fading in with the
+// drawer's animation. It absorbs pointers so the content below can't be
+// touched while a drawer is open.
+type drawerScrim struct {
+	core.RenderObjectBase
+	animation *animation.AnimationController
+	color     graphics.Color
+}
+
+func (s drawerScrim) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderDrawerScrim{}
+	box.SetSelf(box)
+	s.UpdateRenderObject(ctx, box)
+	return box
+}
+
+func (s drawerScrim) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	box, ok := renderObject.(*renderDrawerScrim)
+	if !ok {
+		return
+	}
+	box.listen(s.animation, box.MarkNeedsPaint)
+	box.color = s.color
+	box.MarkNeedsPaint()
+}
+
+type renderDrawerScrim struct {
+	layout.RenderBoxBase
+	drawerAnimation
+	color graphics.Color
+}
+
+func (r *renderDrawerScrim) VisitChildren(visitor func(layout.RenderObject)) {}
+
+func (r *renderDrawerScrim) PerformLayout() {
+	constraints := r.Constraints()
+	r.SetSize(graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight})
+}
+
+func (r *renderDrawerScrim) Paint(ctx *layout.PaintContext) {
+	t := r.progress()
+	if r.color == graphics.ColorTransparent || t <= 0 {
+		return
+	}
+	size := r.Size()
+	paint := graphics.DefaultPaint()
+	paint.Color = r.color.WithAlpha(r.color.Alpha() * t)
+	ctx.Canvas.DrawRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height), paint)
+}
+
+func (r *renderDrawerScrim) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	result.Add(r)
+	return true
+}
+
+func (r *renderDrawerScrim) Dispose() {
+	r.unlisten()
+	r.RenderBoxBase.Dispose()
+}
+
+// drawerSlide fills the scaffold and slides its child, the drawer, in from
+// the left or right edge with the drawer's animation. It records the
+// drawer's width in extent so drags can be measured against it.
+type drawerSlide struct {
+	core.RenderObjectBase
+	animation *animation.AnimationController
+	fromLeft  bool
+	extent    *float64
+	child     core.Widget
+}
+
+func (s drawerSlide) ChildWidget() core.Widget {
+	return s.child
+}
+
+func (s drawerSlide) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderDrawerSlide{}
+	box.SetSelf(box)
+	s.UpdateRenderObject(ctx, box)
+	return box
+}
+
+func (s drawerSlide) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	box, ok := renderObject.(*renderDrawerSlide)
+	if !ok {
+		return
+	}
+	box.listen(s.animation, box.MarkNeedsLayout)
+	box.fromLeft = s.fromLeft
+	box.extent = s.extent
+	box.MarkNeedsLayout()
+}
+
+type renderDrawerSlide struct {
+	renderPassthrough
+	drawerAnimation
+	fromLeft bool
+	extent   *float64
+}
+
+func (r *renderDrawerSlide) PerformLayout() {
+	constraints := r.Constraints()
+	size := graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight}
+	r.SetSize(size)
+	if r.child == nil {
+		return
+	}
+	child := r.child.(layout.RenderBox)
+	child.Layout(layout.Constraints{MaxWidth: size.Width, MinHeight: size.Height, MaxHeight: size.Height}, true)
+	width := child.Size().Width
+	if r.extent != nil {
+		*r.extent = width
+	}
+	x := size.Width - width*r.progress()
+	if r.fromLeft {
+		x = -width * (1 - r.progress())
+	}
+	child.SetParentData(&layout.BoxParentData{Offset: graphics.Offset{X: x}})
+}
+
+func (r *renderDrawerSlide) Paint(ctx *layout.PaintContext) {
+	if r.child != nil && r.progress() > 0 {
+		child := r.child.(layout.RenderBox)
+		ctx.PaintChildWithLayer(child, getChildOffset(child))
+	}
+}
+
+// HitTest absorbs pointers anywhere on the drawer, so taps on its empty
+// space don't reach the scrim behind it.
+func (r *renderDrawerSlide) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.child == nil {
+		return false
+	}
+	child := r.child.(layout.RenderBox)
+	offset := getChildOffset(child)
+	local := graphics.Offset{X: position.X - offset.X, Y: position.Y - offset.Y}
+	if !layout.WithinBounds(local, child.Size()) {
+		return false
+	}
+	child.HitTest(local, result)
+	result.Add(r)
+	return true
+}
+
+func (r *renderDrawerSlide) Dispose() {
+	r.unlisten()
+	r.renderPassthrough.Dispose()
+}
+
+// drawerAnimation subscribes a drawer render object to the drawer's
+// animation.
+type drawerAnimation struct {
+	animation   *animation.AnimationController
+	unsubscribe func()
+}
+
+// listen subscribes onChange to a, replacing any earlier subscription.
+func (d *drawerAnimation) listen(a *animation.AnimationController, onChange func()) {
+	if d.animation == a {
+		return
+	}
+	d.unlisten()
+	d.animation = a
+	if a != nil {
+		d.unsubscribe = a.AddListener(onChange)
+	}
+}
+
+func (d *drawerAnimation) unlisten() {
+	if d.unsubscribe != nil {
+		d.unsubscribe()
+		d.unsubscribe = nil
+	}
+}
+
+// progress returns how far open the drawer is, from 0 to 1.
+func (d *drawerAnimation) progress() float64 {
+	if d.animation == nil {
+		return 1
+	}
+	return min(max(d.animation.Value, 0), 1)
+}
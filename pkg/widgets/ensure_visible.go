@@ -0,0 +1,83 @@
+package widgets
+
+import (
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// ensureVisibleDuration is how long focus changes and form validation take
+// to scroll a field into view.
+const ensureVisibleDuration = 200 * time.Millisecond
+
+// EnsureVisible scrolls every [ScrollView] that contains target so target is
+// fully visible, animating over duration. Views that already show target
+// don't move. If target is larger than a viewport, its leading edge is
+// aligned with the viewport's.
+//
+// Text inputs call EnsureVisible when they gain focus, and [FormState]
+// calls it for the first field that fails validation. Use it after layout,
+// for example from a gesture callback:
+//
+//	widgets.EnsureVisible(fieldKey.CurrentRenderObject(), 300*time.Millisecond)
+func EnsureVisible(target layout.RenderObject, duration time.Duration) {
+	if target == nil {
+		return
+	}
+	size := target.Size()
+	rect := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+	for node := target; node != nil; {
+		parent := renderParentOf(node)
+		if parent == nil {
+			return
+		}
+		if data, ok := node.ParentData().(*layout.BoxParentData); ok && data != nil {
+			rect = rect.Translate(data.Offset.X, data.Offset.Y)
+		}
+		if scroll, ok := parent.(*renderScrollView); ok && scroll.position != nil {
+			// rect is in the scroll content's coordinates here.
+			offset := scroll.position.clampOffset(scroll.revealOffset(rect), false)
+			if offset != scroll.position.Offset() {
+				scroll.position.AnimateTo(offset, duration, animation.EaseInOut)
+			}
+			delta := scroll.offsetFor(offset)
+			rect = rect.Translate(delta.X, delta.Y)
+		} else if provider, ok := parent.(core.ScrollOffsetProvider); ok {
+			scroll := provider.ScrollOffset()
+			rect = rect.Translate(scroll.X, scroll.Y)
+		}
+		node = parent
+	}
+}
+
+// revealOffset returns the scroll offset that brings rect, given in content
+// coordinates, fully into view while moving as little as possible.
+func (r *renderScrollView) revealOffset(rect graphics.Rect) float64 {
+	current := r.scrollOffset()
+	leading, trailing := rect.Top, rect.Bottom
+	viewport := r.Size().Height
+	if r.direction == AxisHorizontal {
+		leading, trailing = rect.Left, rect.Right
+		viewport = r.Size().Width
+	}
+	switch {
+	case leading < current:
+		return leading
+	case trailing > current+viewport:
+		return min(leading, trailing-viewport)
+	default:
+		return current
+	}
+}
+
+// offsetFor returns the paint offset the view applies to its content at
+// scroll offset.
+func (r *renderScrollView) offsetFor(offset float64) graphics.Offset {
+	if r.direction == AxisHorizontal {
+		return graphics.Offset{X: -offset}
+	}
+	return graphics.Offset{Y: -offset}
+}
@@ -55,6 +55,23 @@ import (
 //	    },
 //	}).Run()
 //
+// # Retrying
+//
+// The default fallback, [ErrorWidget], shows a Try Again button that clears
+// the error and rebuilds only the boundary's subtree. Custom fallbacks reach
+// the same reset through [ErrorBoundaryOf], which finds the boundary from
+// the fallback as well as from its child:
+//
+//	FallbackBuilder: func(err *errors.BoundaryError) core.Widget {
+//	    return RetryPrompt{}
+//	}
+//
+//	func (RetryPrompt) Build(ctx core.BuildContext) core.Widget {
+//	    return Button{Label: "Retry", OnTap: ErrorBoundaryOf(ctx).Reset}
+//	}
+//
+// Panics in the fallback itself are caught by the next boundary up.
+//
 // # Programmatic Control
 //
 // Use [ErrorBoundaryOf] to access the boundary's state from descendant widgets:
@@ -94,12 +111,17 @@ type errorBoundaryState struct {
 func (s *errorBoundaryState) Build(ctx core.BuildContext) core.Widget {
 	widget := ctx.Widget().(ErrorBoundary)
 
-	// If we've captured an error, show the fallback
+	// If we've captured an error, show the fallback. It is scoped too, so
+	// it can retry through ErrorBoundaryOf, but errors it panics with go to
+	// the next boundary up rather than back to this one.
 	if s.capturedError != nil {
+		var fallback core.Widget
 		if widget.FallbackBuilder != nil {
-			return widget.FallbackBuilder(s.capturedError)
+			fallback = widget.FallbackBuilder(s.capturedError)
+		} else {
+			fallback = ErrorWidget{Error: s.capturedError, OnRetry: s.Reset}
 		}
-		return ErrorWidget{Error: s.capturedError}
+		return errorBoundaryScope{state: s, fallback: true, child: fallback}
 	}
 
 	// Wrap child in an inherited widget that marks this boundary,
@@ -113,8 +135,9 @@ func (s *errorBoundaryState) Build(ctx core.BuildContext) core.Widget {
 	}
 }
 
-// Reset clears the captured error and rebuilds the child.
-// Use this to retry rendering after an error.
+// Reset clears the captured error and rebuilds the child from scratch,
+// discarding any state the failed subtree held. Use this to retry rendering
+// after an error; the rest of the tree is not rebuilt.
 func (s *errorBoundaryState) Reset() {
 	s.SetState(func() {
 		s.capturedError = nil
@@ -151,14 +174,24 @@ func (s *errorBoundaryState) captureBoundaryError(err *errors.BoundaryError) {
 type errorBoundaryScope struct {
 	core.InheritedBase
 	state *errorBoundaryState
-	child core.Widget
+	// fallback marks the scope around the fallback widget, which exposes
+	// the state but does not capture errors.
+	fallback bool
+	child    core.Widget
 }
 
 // CreateElement overrides InheritedBase to use a custom element wrapper.
 func (e errorBoundaryScope) CreateElement() core.Element {
+	if e.fallback {
+		return core.NewInheritedElement()
+	}
 	return newErrorBoundaryScopeElement()
 }
 
+// Key keeps the child and fallback scopes from updating each other's
+// elements, since only one of them captures errors.
+func (e errorBoundaryScope) Key() any { return e.fallback }
+
 func (e errorBoundaryScope) ChildWidget() core.Widget { return e.child }
 
 func (e errorBoundaryScope) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
@@ -212,7 +245,8 @@ func (e *errorBoundaryScopeElement) CaptureError(err *errors.BoundaryError) bool
 //	    }
 //	}
 //
-// Returns nil if there is no ErrorBoundary ancestor.
+// Called from a boundary's fallback widget, it returns that boundary. Returns
+// nil if there is no ErrorBoundary ancestor.
 func ErrorBoundaryOf(ctx core.BuildContext) *errorBoundaryState {
 	inherited := ctx.DependOnInherited(reflect.TypeFor[errorBoundaryScope](), nil)
 	if inherited == nil {
@@ -0,0 +1,150 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifterrors "github.com/go-drift/drift/pkg/errors"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// flakyWidget panics while *fail is set.
+type flakyWidget struct {
+	core.StatelessBase
+	fail *bool
+}
+
+func (w flakyWidget) Build(ctx core.BuildContext) core.Widget {
+	if *w.fail {
+		panic("flaky")
+	}
+	return widgets.Text{Content: "loaded"}
+}
+
+// retryPrompt is a custom fallback that retries through ErrorBoundaryOf.
+type retryPrompt struct {
+	core.StatelessBase
+}
+
+func (retryPrompt) Build(ctx core.BuildContext) core.Widget {
+	return widgets.GestureDetector{
+		OnTap: boundaryReset(ctx),
+		Child: widgets.Text{Content: "retry"},
+	}
+}
+
+// boundaryReset returns the reset of the boundary above ctx, or nil.
+func boundaryReset(ctx core.BuildContext) func() {
+	if state := widgets.ErrorBoundaryOf(ctx); state != nil {
+		return state.Reset
+	}
+	return nil
+}
+
+type quietHandler struct{}
+
+func (quietHandler) HandleError(*drifterrors.DriftError)            {}
+func (quietHandler) HandlePanic(*drifterrors.PanicError)            {}
+func (quietHandler) HandleBoundaryError(*drifterrors.BoundaryError) {}
+
+func quietErrors(t *testing.T) {
+	t.Helper()
+	prev := drifterrors.DefaultHandler
+	drifterrors.SetHandler(quietHandler{})
+	t.Cleanup(func() { drifterrors.SetHandler(prev) })
+}
+
+func TestErrorBoundary_DefaultFallbackRetries(t *testing.T) {
+	quietErrors(t)
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	fail := true
+	var caught int
+	// Center keeps the root render object stable while the boundary swaps
+	// its subtree for the fallback.
+	tester.PumpWidget(widgets.Center{Child: widgets.ErrorBoundary{
+		Child:   flakyWidget{fail: &fail},
+		OnError: func(*drifterrors.BoundaryError) { caught++ },
+	}})
+	tester.Pump()
+
+	if caught != 1 {
+		t.Fatalf("expected 1 caught error, got %d", caught)
+	}
+	if !tester.Find(drifttest.ByText("Try Again")).Exists() {
+		t.Fatal("expected default fallback to offer Try Again")
+	}
+
+	fail = false
+	if err := tester.Tap(drifttest.ByText("Try Again")); err != nil {
+		t.Fatal(err)
+	}
+	tester.Pump()
+
+	if !tester.Find(drifttest.ByText("loaded")).Exists() {
+		t.Error("expected child to be rebuilt after retry")
+	}
+	if tester.Find(drifttest.ByType[widgets.ErrorWidget]()).Exists() {
+		t.Error("expected fallback to be removed after retry")
+	}
+}
+
+func TestErrorBoundary_CustomFallbackRetries(t *testing.T) {
+	quietErrors(t)
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	fail := true
+	var fallbackErr *drifterrors.BoundaryError
+	tester.PumpWidget(widgets.Center{Child: widgets.ErrorBoundary{
+		Child: flakyWidget{fail: &fail},
+		FallbackBuilder: func(err *drifterrors.BoundaryError) core.Widget {
+			fallbackErr = err
+			return retryPrompt{}
+		},
+	}})
+	tester.Pump()
+
+	if fallbackErr == nil || fallbackErr.Recovered != "flaky" {
+		t.Fatalf("expected fallback to receive the panic, got %v", fallbackErr)
+	}
+
+	fail = false
+	if err := tester.Tap(drifttest.ByText("retry")); err != nil {
+		t.Fatal(err)
+	}
+	tester.Pump()
+
+	if !tester.Find(drifttest.ByText("loaded")).Exists() {
+		t.Error("expected ErrorBoundaryOf from the fallback to reset the boundary")
+	}
+}
+
+func TestErrorBoundary_FallbackPanicGoesToOuterBoundary(t *testing.T) {
+	quietErrors(t)
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	fail := true
+	var outer, inner int
+	tester.PumpWidget(widgets.Center{Child: widgets.ErrorBoundary{
+		OnError: func(*drifterrors.BoundaryError) { outer++ },
+		FallbackBuilder: func(*drifterrors.BoundaryError) core.Widget {
+			return widgets.Text{Content: "outer fallback"}
+		},
+		Child: widgets.ErrorBoundary{
+			OnError: func(*drifterrors.BoundaryError) { inner++ },
+			FallbackBuilder: func(*drifterrors.BoundaryError) core.Widget {
+				return flakyWidget{fail: &fail}
+			},
+			Child: flakyWidget{fail: &fail},
+		},
+	}})
+	tester.Pump()
+
+	if inner != 1 || outer != 1 {
+		t.Fatalf("expected one error at each boundary, got inner=%d outer=%d", inner, outer)
+	}
+	if !tester.Find(drifttest.ByText("outer fallback")).Exists() {
+		t.Error("expected outer fallback to be shown")
+	}
+}
@@ -21,10 +21,11 @@ func init() {
 // It shows a red background with:
 //   - "Something went wrong" message
 //   - Detailed error text (in debug mode or when Verbose is true)
-//   - Restart button to recover the app
+//   - Try Again button when OnRetry is set, otherwise a Restart button to
+//     recover the app
 //
 // This is the default fallback widget used by [ErrorBoundary] when no
-// FallbackBuilder is provided.
+// FallbackBuilder is provided, with OnRetry resetting the boundary.
 type ErrorWidget struct {
 	core.StatelessBase
 
@@ -34,6 +35,9 @@ type ErrorWidget struct {
 	// When true, shows detailed error messages. When false, shows generic text.
 	// If nil (default), uses core.DebugMode.
 	Verbose *bool
+	// OnRetry, if set, replaces the Restart button with a Try Again button
+	// that calls it.
+	OnRetry func()
 }
 
 func (e ErrorWidget) Build(ctx core.BuildContext) core.Widget {
@@ -99,10 +103,14 @@ func (e ErrorWidget) Build(ctx core.BuildContext) core.Widget {
 		)
 	}
 
-	// Add restart button
+	// Add retry or restart button
+	var button core.Widget = errorRestartButton{}
+	if e.OnRetry != nil {
+		button = errorActionButton("Try Again", e.OnRetry)
+	}
 	children = append(children,
 		SizedBox{Height: 16},
-		errorRestartButton{},
+		button,
 	)
 
 	return Container{
@@ -153,13 +161,19 @@ func (s *errorRestartButtonState) Build(ctx core.BuildContext) core.Widget {
 		}
 	}
 
+	return errorActionButton("Restart App", s.restartFn)
+}
+
+// errorActionButton returns a plain button for the error widget, which
+// avoids themed buttons so it still renders when the theme is broken.
+func errorActionButton(label string, onTap func()) core.Widget {
 	return GestureDetector{
-		OnTap: s.restartFn,
+		OnTap: onTap,
 		Child: Container{
 			Color:   graphics.RGBA(255, 255, 255, 0.86),
 			Padding: layout.EdgeInsetsSymmetric(16, 8),
 			Child: Text{
-				Content: "Restart App",
+				Content: label,
 				Style: graphics.TextStyle{
 					Color:      graphics.ColorBlack,
 					FontSize:   14,
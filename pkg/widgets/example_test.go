@@ -364,7 +364,7 @@ func ExampleScrollView_withController() {
 	controller.JumpTo(500)
 
 	// Or animate the scroll
-	controller.AnimateTo(1000, 300*time.Millisecond)
+	controller.AnimateTo(1000, 300*time.Millisecond, animation.EaseInOut)
 
 	_ = scrollView
 }
@@ -36,9 +36,12 @@ func (a Axis) String() string {
 type MainAxisAlignment int
 
 const (
-	// MainAxisAlignmentStart places children at the start (left for Row, top for Column).
+	// MainAxisAlignmentStart places children at the start (the leading edge
+	// for Row, which is left unless the [Directionality] is right-to-left;
+	// top for Column).
 	MainAxisAlignmentStart MainAxisAlignment = iota
-	// MainAxisAlignmentEnd places children at the end (right for Row, bottom for Column).
+	// MainAxisAlignmentEnd places children at the end (the trailing edge for
+	// Row; bottom for Column).
 	MainAxisAlignmentEnd
 	// MainAxisAlignmentCenter centers children along the main axis.
 	MainAxisAlignmentCenter
@@ -79,6 +82,7 @@ type CrossAxisAlignment int
 
 const (
 	// CrossAxisAlignmentStart places children at the start of the cross axis.
+	// For Column, the start is the leading edge from [Directionality].
 	CrossAxisAlignmentStart CrossAxisAlignment = iota
 	// CrossAxisAlignmentEnd places children at the end of the cross axis.
 	CrossAxisAlignmentEnd
@@ -170,7 +174,8 @@ type FlexFitProvider interface {
 	FlexFit() FlexFit
 }
 
-// Row lays out children horizontally from left to right.
+// Row lays out children horizontally in reading order: left to right, or
+// right to left under a right-to-left [Directionality].
 //
 // Row is a flex container where the main axis is horizontal. Children are
 // laid out in a single horizontal run and do not wrap.
@@ -217,6 +222,7 @@ func (r Row) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 		alignment:      r.MainAxisAlignment,
 		crossAlignment: r.CrossAxisAlignment,
 		axisSize:       r.MainAxisSize,
+		textDirection:  DirectionalityOf(ctx),
 	}
 	flex.SetSelf(flex)
 	return flex
@@ -228,6 +234,7 @@ func (r Row) UpdateRenderObject(ctx core.BuildContext, renderObject layout.Rende
 		flex.alignment = r.MainAxisAlignment
 		flex.crossAlignment = r.CrossAxisAlignment
 		flex.axisSize = r.MainAxisSize
+		flex.textDirection = DirectionalityOf(ctx)
 		flex.MarkNeedsLayout()
 		flex.MarkNeedsPaint()
 	}
@@ -280,6 +287,7 @@ func (c Column) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 		alignment:      c.MainAxisAlignment,
 		crossAlignment: c.CrossAxisAlignment,
 		axisSize:       c.MainAxisSize,
+		textDirection:  DirectionalityOf(ctx),
 	}
 	flex.SetSelf(flex)
 	return flex
@@ -291,6 +299,7 @@ func (c Column) UpdateRenderObject(ctx core.BuildContext, renderObject layout.Re
 		flex.alignment = c.MainAxisAlignment
 		flex.crossAlignment = c.CrossAxisAlignment
 		flex.axisSize = c.MainAxisSize
+		flex.textDirection = DirectionalityOf(ctx)
 		flex.MarkNeedsLayout()
 		flex.MarkNeedsPaint()
 	}
@@ -303,6 +312,7 @@ type renderFlex struct {
 	alignment      MainAxisAlignment
 	crossAlignment CrossAxisAlignment
 	axisSize       MainAxisSize
+	textDirection  graphics.TextDirection
 }
 
 func (r *renderFlex) SetChildren(children []layout.RenderObject) {
@@ -461,11 +471,18 @@ func (r *renderFlex) PerformLayout() {
 	freeSpace := math.Max(0, r.mainAxis(size)-mainSize)
 	spacing, startOffset := r.computeSpacing(freeSpace)
 
+	// A right-to-left Row runs from the right edge, so Start is on the right.
+	flipMain := r.direction == AxisHorizontal && r.textDirection == graphics.TextDirectionRTL
 	cursor := startOffset
 	for _, child := range r.children {
+		childMain := r.mainAxis(child.Size())
+		mainOffset := cursor
+		if flipMain {
+			mainOffset = r.mainAxis(size) - cursor - childMain
+		}
 		crossOffset := r.crossAxisOffset(child.Size())
-		child.SetParentData(&layout.BoxParentData{Offset: r.makeOffset(cursor, crossOffset)})
-		cursor += r.mainAxis(child.Size()) + spacing
+		child.SetParentData(&layout.BoxParentData{Offset: r.makeOffset(mainOffset, crossOffset)})
+		cursor += childMain + spacing
 	}
 }
 
@@ -509,8 +526,18 @@ func (r *renderFlex) crossAxisOffset(childSize graphics.Size) float64 {
 	if freeSpace <= 0 {
 		return 0
 	}
+	// A right-to-left Column's cross axis starts at the right edge.
+	rtl := r.direction == AxisVertical && r.textDirection == graphics.TextDirectionRTL
 	switch r.crossAlignment {
+	case CrossAxisAlignmentStart:
+		if rtl {
+			return freeSpace
+		}
+		return 0
 	case CrossAxisAlignmentEnd:
+		if rtl {
+			return 0
+		}
 		return freeSpace
 	case CrossAxisAlignmentCenter:
 		return freeSpace * 0.5
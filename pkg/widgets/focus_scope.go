@@ -0,0 +1,122 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/focus"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// FocusScope groups the focusable widgets below it, such as text inputs,
+// into their own [focus.FocusScopeNode]. Scopes nest: a scope's nodes are
+// also part of every enclosing scope for traversal.
+//
+// With Trap set, keyboard focus is confined to the scope while it is
+// mounted: focus outside it is cleared, and Tab/Next traversal cycles within
+// it. When the scope is removed, focus returns to the node that had it
+// before. Modal routes and overlay dialogs wrap their content in a trapping
+// scope automatically.
+//
+//	widgets.FocusScope{
+//	    Trap:      true,
+//	    Autofocus: true,
+//	    Child:     form,
+//	}
+type FocusScope struct {
+	core.StatefulBase
+
+	// Trap confines focus to this scope while it is mounted and restores the
+	// previous focus when it is removed.
+	Trap bool
+
+	// Autofocus focuses the first focusable descendant once the scope has
+	// been built for the first time.
+	Autofocus bool
+
+	// OnKeyEvent receives key presses that the focused node inside the
+	// scope ignores, or every key press while the scope traps focus and
+	// nothing has it. See [focus.FocusManager.HandleKeyEvent].
+	OnKeyEvent func(event focus.KeyEvent) focus.KeyEventResult
+
+	// Child is the widget below this scope.
+	Child core.Widget
+}
+
+// CreateState implements core.StatefulWidget.
+func (FocusScope) CreateState() core.State {
+	return &focusScopeState{}
+}
+
+type focusScopeState struct {
+	core.StateBase
+	node    *focus.FocusScopeNode
+	parent  *focus.FocusScopeNode
+	release func()
+}
+
+func (s *focusScopeState) InitState() {
+	w := s.Element().Widget().(FocusScope)
+	s.node = &focus.FocusScopeNode{FocusNode: focus.FocusNode{DebugLabel: "FocusScope", OnKeyEvent: w.OnKeyEvent}}
+	s.parent = FocusScopeOf(s.Element())
+	s.parent.AttachScope(s.node)
+	if w.Trap {
+		s.release = focus.GetFocusManager().TrapFocus(s.node)
+	}
+	if w.Autofocus {
+		// Descendants attach their nodes while the child builds.
+		platform.Dispatch(func() {
+			if !s.IsDisposed() {
+				s.node.SetFirstFocus()
+			}
+		})
+	}
+}
+
+func (s *focusScopeState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	s.node.OnKeyEvent = s.Element().Widget().(FocusScope).OnKeyEvent
+}
+
+func (s *focusScopeState) Dispose() {
+	if s.release != nil {
+		s.release()
+		s.release = nil
+	}
+	s.parent.DetachScope(s.node)
+	s.StateBase.Dispose()
+}
+
+func (s *focusScopeState) Build(ctx core.BuildContext) core.Widget {
+	return focusScopeInherited{
+		node:  s.node,
+		child: s.Element().Widget().(FocusScope).Child,
+	}
+}
+
+// focusScopeInherited exposes a FocusScope's node to descendants.
+type focusScopeInherited struct {
+	core.InheritedBase
+	node  *focus.FocusScopeNode
+	child core.Widget
+}
+
+func (f focusScopeInherited) ChildWidget() core.Widget { return f.child }
+
+func (f focusScopeInherited) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(focusScopeInherited); ok {
+		return f.node != old.node
+	}
+	return true
+}
+
+// FocusScopeOf returns the node of the nearest enclosing [FocusScope], or
+// the focus manager's root scope if there is none. It does not register a
+// dependency.
+func FocusScopeOf(ctx core.BuildContext) *focus.FocusScopeNode {
+	element := ctx.FindAncestor(func(e core.Element) bool {
+		_, ok := e.Widget().(focusScopeInherited)
+		return ok
+	})
+	if element == nil {
+		return focus.GetFocusManager().RootScope
+	}
+	return element.Widget().(focusScopeInherited).node
+}
@@ -0,0 +1,125 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/focus"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// focusProbe attaches node to the nearest FocusScope while mounted.
+type focusProbe struct {
+	core.StatefulBase
+	node *focus.FocusNode
+}
+
+func (p focusProbe) CreateState() core.State { return &focusProbeState{} }
+
+type focusProbeState struct {
+	core.StateBase
+}
+
+func (s *focusProbeState) InitState() {
+	widgets.FocusScopeOf(s.Element()).Attach(s.Element().Widget().(focusProbe).node)
+}
+
+func (s *focusProbeState) Dispose() {
+	node := s.Element().Widget().(focusProbe).node
+	if scope := node.Scope(); scope != nil {
+		scope.Detach(node)
+	}
+	s.StateBase.Dispose()
+}
+
+func (s *focusProbeState) Build(ctx core.BuildContext) core.Widget {
+	return widgets.SizedBox{}
+}
+
+// dialogHost shows the result of build and lets the test toggle showDialog.
+type dialogHost struct {
+	core.StatefulBase
+	build func(showDialog bool) core.Widget
+	bind  func(setShowDialog func(bool))
+}
+
+func (h dialogHost) CreateState() core.State { return &dialogHostState{} }
+
+type dialogHostState struct {
+	core.StateBase
+	showDialog bool
+}
+
+func (s *dialogHostState) InitState() {
+	s.Element().Widget().(dialogHost).bind(func(show bool) {
+		s.SetState(func() { s.showDialog = show })
+	})
+}
+
+func (s *dialogHostState) Build(ctx core.BuildContext) core.Widget {
+	return s.Element().Widget().(dialogHost).build(s.showDialog)
+}
+
+func TestFocusScope_TrapAndRestore(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	manager := focus.GetFocusManager()
+
+	page := &focus.FocusNode{CanRequestFocus: true, DebugLabel: "page"}
+	field := &focus.FocusNode{CanRequestFocus: true, DebugLabel: "field"}
+	button := &focus.FocusNode{CanRequestFocus: true, DebugLabel: "button"}
+
+	build := func(showDialog bool) core.Widget {
+		children := []core.Widget{focusProbe{node: page}}
+		if showDialog {
+			children = append(children, widgets.FocusScope{
+				Trap:      true,
+				Autofocus: true,
+				Child: widgets.Column{Children: []core.Widget{
+					focusProbe{node: field},
+					focusProbe{node: button},
+				}},
+			})
+		}
+		return widgets.Column{Children: children}
+	}
+
+	var setShowDialog func(bool)
+	err := tester.PumpWidget(dialogHost{
+		build: build,
+		bind:  func(fn func(bool)) { setShowDialog = fn },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	page.RequestFocus()
+
+	setShowDialog(true)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if manager.PrimaryFocus != field {
+		t.Fatalf("expected autofocus on the dialog's first node, got %v", manager.PrimaryFocus)
+	}
+
+	manager.MoveFocus(1)
+	manager.MoveFocus(1)
+	if manager.PrimaryFocus != field {
+		t.Errorf("expected traversal to wrap within the dialog, got %v", manager.PrimaryFocus)
+	}
+	page.RequestFocus()
+	if page.HasFocus() {
+		t.Error("expected the page to be unfocusable while the dialog traps focus")
+	}
+
+	setShowDialog(false)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if manager.PrimaryFocus != page {
+		t.Errorf("expected focus restored to the page, got %v", manager.PrimaryFocus)
+	}
+}
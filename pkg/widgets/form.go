@@ -4,6 +4,7 @@ import (
 	"reflect"
 
 	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
 )
 
 // Form is a container widget that groups form fields and provides coordinated
@@ -124,15 +125,30 @@ func (s *FormState) UnregisterField(field formFieldState) {
 	delete(s.fields, field)
 }
 
-// Validate runs validators on all fields.
+// Validate runs validators on all fields. If any fail, the topmost failing
+// field is scrolled into view with [EnsureVisible].
 func (s *FormState) Validate() bool {
 	valid := true
+	var first *core.StatefulElement
+	var firstOffset graphics.Offset
 	for field := range s.fields {
-		if !field.Validate() {
-			valid = false
+		if field.Validate() {
+			continue
+		}
+		valid = false
+		element := field.fieldElement()
+		if element == nil {
+			continue
+		}
+		offset := core.GlobalOffsetOf(element)
+		if first == nil || offset.Y < firstOffset.Y || (offset.Y == firstOffset.Y && offset.X < firstOffset.X) {
+			first, firstOffset = element, offset
 		}
 	}
 	s.bumpGeneration()
+	if first != nil {
+		EnsureVisible(first.RenderObject(), ensureVisibleDuration)
+	}
 	return valid
 }
 
@@ -206,6 +222,7 @@ type formFieldState interface {
 	Validate() bool
 	Save()
 	Reset()
+	fieldElement() *core.StatefulElement
 }
 
 type formFieldStateBase struct {
@@ -219,6 +236,10 @@ func (s *formFieldStateBase) setElement(element *core.StatefulElement) {
 	s.element = element
 }
 
+func (s *formFieldStateBase) fieldElement() *core.StatefulElement {
+	return s.element
+}
+
 func (s *formFieldStateBase) setState(fn func()) {
 	fn()
 	if s.element != nil {
@@ -1,17 +1,54 @@
 package widgets
 
 import (
+	"fmt"
+
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/gestures"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
 )
 
+// HitTestBehavior controls how a pointer-handling widget such as
+// [GestureDetector] takes part in hit testing.
+type HitTestBehavior int
+
+const (
+	// HitTestBehaviorOpaque receives pointers anywhere within its bounds,
+	// including empty padding and transparent areas, and keeps widgets
+	// behind it from receiving them. This is the default.
+	HitTestBehaviorOpaque HitTestBehavior = iota
+	// HitTestBehaviorDeferToChild receives pointers only where its child is
+	// hit, so gaps in the child fall through to widgets behind it.
+	HitTestBehaviorDeferToChild
+	// HitTestBehaviorTranslucent receives pointers anywhere within its
+	// bounds but still lets widgets behind it receive them, for overlays
+	// that observe gestures without blocking the content underneath.
+	HitTestBehaviorTranslucent
+)
+
+// String returns a human-readable representation of the hit test behavior.
+func (b HitTestBehavior) String() string {
+	switch b {
+	case HitTestBehaviorOpaque:
+		return "opaque"
+	case HitTestBehaviorDeferToChild:
+		return "defer_to_child"
+	case HitTestBehaviorTranslucent:
+		return "translucent"
+	default:
+		return fmt.Sprintf("HitTestBehavior(%d)", int(b))
+	}
+}
+
 // GestureDetector wraps a child widget with gesture recognition callbacks.
 //
 // GestureDetector supports multiple gesture types that can be used together:
 //   - Tap: Simple tap/click detection via OnTap
 //   - Pan: Free-form drag in any direction via OnPanStart/Update/End
+//   - Double tap: Two quick taps via OnDoubleTap. When OnTap is also set,
+//     single taps are delayed until a second tap is ruled out.
+//   - Long press: A held pointer via OnLongPress and OnLongPressEnd
 //   - Horizontal drag: Constrained horizontal drag via OnHorizontalDrag*
 //   - Vertical drag: Constrained vertical drag via OnVerticalDrag*
 //
@@ -31,17 +68,32 @@ import (
 //	    Child:       draggableItem,
 //	}
 //
+// Behavior decides which pointers the detector receives; see
+// [HitTestBehavior]. The default, [HitTestBehaviorOpaque], makes the whole
+// area tappable, including empty space around the child.
+//
+// Thresholds such as the touch slop and long-press timeout come from the
+// nearest [GestureConfiguration], or from the platform if there is none.
+//
 // For simple tap handling on buttons, prefer [Button] which provides
 // visual feedback. GestureDetector is best for custom gestures.
 type GestureDetector struct {
 	core.RenderObjectBase
 	Child       core.Widget
+	Behavior    HitTestBehavior
 	OnTap       func()
+	OnDoubleTap func()
 	OnPanStart  func(DragStartDetails)
 	OnPanUpdate func(DragUpdateDetails)
 	OnPanEnd    func(DragEndDetails)
 	OnPanCancel func()
 
+	// OnLongPress is called once the pointer has been held down for the
+	// long-press timeout without moving.
+	OnLongPress func()
+	// OnLongPressEnd is called when the pointer lifts after a long press.
+	OnLongPressEnd func()
+
 	OnHorizontalDragStart  func(DragStartDetails)
 	OnHorizontalDragUpdate func(DragUpdateDetails)
 	OnHorizontalDragEnd    func(DragEndDetails)
@@ -60,13 +112,13 @@ func (g GestureDetector) ChildWidget() core.Widget {
 func (g GestureDetector) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 	detector := &renderGestureDetector{}
 	detector.SetSelf(detector)
-	detector.configure(g)
+	detector.configure(g, GestureSettingsOf(ctx))
 	return detector
 }
 
 func (g GestureDetector) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if detector, ok := renderObject.(*renderGestureDetector); ok {
-		detector.configure(g)
+		detector.configure(g, GestureSettingsOf(ctx))
 		detector.MarkNeedsPaint()
 	}
 }
@@ -75,9 +127,12 @@ type renderGestureDetector struct {
 	layout.RenderBoxBase
 	child          layout.RenderBox
 	tap            *gestures.TapGestureRecognizer
+	doubleTap      *gestures.DoubleTapGestureRecognizer
+	longPress      *gestures.LongPressGestureRecognizer
 	pan            *gestures.PanGestureRecognizer
 	horizontalDrag *gestures.HorizontalDragGestureRecognizer
 	verticalDrag   *gestures.VerticalDragGestureRecognizer
+	behavior       HitTestBehavior
 }
 
 func (r *renderGestureDetector) SetChild(child layout.RenderObject) {
@@ -113,8 +168,16 @@ func (r *renderGestureDetector) HitTest(position graphics.Offset, result *layout
 	if !layout.WithinBounds(position, r.Size()) {
 		return false
 	}
-	if r.child != nil {
-		r.child.HitTest(position, result)
+	hitChild := r.child != nil && r.child.HitTest(position, result)
+	switch r.behavior {
+	case HitTestBehaviorDeferToChild:
+		if !hitChild {
+			return false
+		}
+	case HitTestBehaviorTranslucent:
+		// Receive the pointer but let siblings behind be tested too.
+		result.Add(r)
+		return hitChild
 	}
 	result.Add(r)
 	return true
@@ -122,6 +185,22 @@ func (r *renderGestureDetector) HitTest(position graphics.Offset, result *layout
 
 func (r *renderGestureDetector) HandlePointer(event gestures.PointerEvent) {
 	isDown := event.Phase == gestures.PointerPhaseDown
+	// The double-tap recognizer sees each event first so that it can claim
+	// the final tap before the tap recognizer resolves it.
+	if r.doubleTap != nil {
+		if isDown {
+			r.doubleTap.AddPointer(event)
+		} else {
+			r.doubleTap.HandleEvent(event)
+		}
+	}
+	if r.longPress != nil {
+		if isDown {
+			r.longPress.AddPointer(event)
+		} else {
+			r.longPress.HandleEvent(event)
+		}
+	}
 	if r.tap != nil {
 		if isDown {
 			r.tap.AddPointer(event)
@@ -152,11 +231,32 @@ func (r *renderGestureDetector) HandlePointer(event gestures.PointerEvent) {
 	}
 }
 
-func (r *renderGestureDetector) configure(g GestureDetector) {
+func (r *renderGestureDetector) configure(g GestureDetector, settings gestures.GestureSettings) {
+	r.behavior = g.Behavior
 	r.configureTap(g)
+	r.configureDoubleTap(g)
+	r.configureLongPress(g)
 	r.configurePan(g)
 	r.configureHorizontalDrag(g)
 	r.configureVerticalDrag(g)
+	if r.tap != nil {
+		r.tap.Settings = settings
+	}
+	if r.doubleTap != nil {
+		r.doubleTap.Settings = settings
+	}
+	if r.longPress != nil {
+		r.longPress.Settings = settings
+	}
+	if r.pan != nil {
+		r.pan.Settings = settings
+	}
+	if r.horizontalDrag != nil {
+		r.horizontalDrag.Settings = settings
+	}
+	if r.verticalDrag != nil {
+		r.verticalDrag.Settings = settings
+	}
 }
 
 func (r *renderGestureDetector) configureTap(g GestureDetector) {
@@ -168,11 +268,40 @@ func (r *renderGestureDetector) configureTap(g GestureDetector) {
 		return
 	}
 	if r.tap == nil {
-		r.tap = gestures.NewTapGestureRecognizer(gestures.DefaultArena)
+		r.tap = gestures.NewTapGestureRecognizer(r.GestureArena())
 	}
 	r.tap.OnTap = g.OnTap
 }
 
+func (r *renderGestureDetector) configureDoubleTap(g GestureDetector) {
+	if g.OnDoubleTap == nil {
+		if r.doubleTap != nil {
+			r.doubleTap.Dispose()
+			r.doubleTap = nil
+		}
+		return
+	}
+	if r.doubleTap == nil {
+		r.doubleTap = gestures.NewDoubleTapGestureRecognizer(r.GestureArena())
+	}
+	r.doubleTap.OnDoubleTap = g.OnDoubleTap
+}
+
+func (r *renderGestureDetector) configureLongPress(g GestureDetector) {
+	if g.OnLongPress == nil && g.OnLongPressEnd == nil {
+		if r.longPress != nil {
+			r.longPress.Dispose()
+			r.longPress = nil
+		}
+		return
+	}
+	if r.longPress == nil {
+		r.longPress = gestures.NewLongPressGestureRecognizer(r.GestureArena())
+	}
+	r.longPress.OnLongPress = g.OnLongPress
+	r.longPress.OnLongPressEnd = g.OnLongPressEnd
+}
+
 func (r *renderGestureDetector) configurePan(g GestureDetector) {
 	hasPanHandler := g.OnPanStart != nil || g.OnPanUpdate != nil || g.OnPanEnd != nil || g.OnPanCancel != nil
 	// Don't use pan when axis-specific handlers are present (they would conflict)
@@ -192,7 +321,7 @@ func (r *renderGestureDetector) configurePan(g GestureDetector) {
 		return
 	}
 	if r.pan == nil {
-		r.pan = gestures.NewPanGestureRecognizer(gestures.DefaultArena)
+		r.pan = gestures.NewPanGestureRecognizer(r.GestureArena())
 	}
 	r.pan.OnStart = g.OnPanStart
 	r.pan.OnUpdate = g.OnPanUpdate
@@ -211,7 +340,7 @@ func (r *renderGestureDetector) configureHorizontalDrag(g GestureDetector) {
 		return
 	}
 	if r.horizontalDrag == nil {
-		r.horizontalDrag = gestures.NewHorizontalDragGestureRecognizer(gestures.DefaultArena)
+		r.horizontalDrag = gestures.NewHorizontalDragGestureRecognizer(r.GestureArena())
 	}
 	r.horizontalDrag.OnStart = g.OnHorizontalDragStart
 	r.horizontalDrag.OnUpdate = g.OnHorizontalDragUpdate
@@ -230,7 +359,7 @@ func (r *renderGestureDetector) configureVerticalDrag(g GestureDetector) {
 		return
 	}
 	if r.verticalDrag == nil {
-		r.verticalDrag = gestures.NewVerticalDragGestureRecognizer(gestures.DefaultArena)
+		r.verticalDrag = gestures.NewVerticalDragGestureRecognizer(r.GestureArena())
 	}
 	r.verticalDrag.OnStart = g.OnVerticalDragStart
 	r.verticalDrag.OnUpdate = g.OnVerticalDragUpdate
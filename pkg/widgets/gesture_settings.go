@@ -0,0 +1,61 @@
+package widgets
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+)
+
+// GestureConfiguration overrides gesture thresholds, such as the long-press
+// timeout and touch slop, for the [GestureDetector] widgets below it. Zero
+// fields in Settings keep the enclosing configuration's value, which at the
+// root comes from the platform (see [gestures.PlatformGestureSettings]).
+//
+//	widgets.GestureConfiguration{
+//	    Settings: gestures.GestureSettings{LongPressTimeout: time.Second},
+//	    Child:    content,
+//	}
+type GestureConfiguration struct {
+	core.StatelessBase
+	// Settings holds the thresholds to override.
+	Settings gestures.GestureSettings
+	// Child is the widget below this configuration.
+	Child core.Widget
+}
+
+// Build implements core.StatelessWidget.
+func (g GestureConfiguration) Build(ctx core.BuildContext) core.Widget {
+	return gestureSettingsInherited{
+		settings: g.Settings.Merge(GestureSettingsOf(ctx)),
+		child:    g.Child,
+	}
+}
+
+// gestureSettingsInherited exposes the resolved settings of a
+// GestureConfiguration to descendants.
+type gestureSettingsInherited struct {
+	core.InheritedBase
+	settings gestures.GestureSettings
+	child    core.Widget
+}
+
+func (g gestureSettingsInherited) ChildWidget() core.Widget { return g.child }
+
+func (g gestureSettingsInherited) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(gestureSettingsInherited); ok {
+		return g.settings != old.settings
+	}
+	return true
+}
+
+var gestureSettingsType = reflect.TypeFor[gestureSettingsInherited]()
+
+// GestureSettingsOf returns the gesture settings from the nearest
+// [GestureConfiguration], or the platform's settings if there is none.
+func GestureSettingsOf(ctx core.BuildContext) gestures.GestureSettings {
+	if inherited, ok := ctx.DependOnInherited(gestureSettingsType, nil).(gestureSettingsInherited); ok {
+		return inherited.settings
+	}
+	return gestures.PlatformGestureSettings()
+}
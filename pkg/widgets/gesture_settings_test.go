@@ -0,0 +1,51 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestGestureConfiguration_OverridesTouchSlop(t *testing.T) {
+	tests := []struct {
+		name    string
+		slop    float64
+		wantTap bool
+	}{
+		{"default slop rejects the tap", 0, false},
+		{"wider slop keeps the tap", 30, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tester := drifttest.NewWidgetTesterWithT(t)
+			tapped := false
+			err := tester.PumpWidget(widgets.GestureConfiguration{
+				Settings: gestures.GestureSettings{TouchSlop: tt.slop},
+				Child: widgets.GestureDetector{
+					OnTap: func() { tapped = true },
+					Child: widgets.SizedBox{Width: 200, Height: 200},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			const pointer = 1
+			if err := tester.SendPointerDown(graphics.Offset{X: 50, Y: 50}, pointer); err != nil {
+				t.Fatal(err)
+			}
+			if err := tester.SendPointerMove(graphics.Offset{X: 70, Y: 50}, pointer); err != nil {
+				t.Fatal(err)
+			}
+			if err := tester.SendPointerUp(graphics.Offset{X: 70, Y: 50}, pointer); err != nil {
+				t.Fatal(err)
+			}
+			if tapped != tt.wantTap {
+				t.Errorf("tapped = %v, want %v", tapped, tt.wantTap)
+			}
+		})
+	}
+}
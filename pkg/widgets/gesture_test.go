@@ -27,7 +27,7 @@ func TestGestureDetector_HorizontalDrag(t *testing.T) {
 	// Create render object
 	detector := &renderGestureDetector{}
 	detector.SetSelf(detector)
-	detector.configure(gd)
+	detector.configure(gd, gestures.GestureSettings{})
 
 	if detector.horizontalDrag == nil {
 		t.Fatal("horizontalDrag recognizer should be created")
@@ -100,7 +100,7 @@ func TestGestureDetector_VerticalDrag(t *testing.T) {
 	// Create render object
 	detector := &renderGestureDetector{}
 	detector.SetSelf(detector)
-	detector.configure(gd)
+	detector.configure(gd, gestures.GestureSettings{})
 
 	if detector.verticalDrag == nil {
 		t.Fatal("verticalDrag recognizer should be created")
@@ -161,7 +161,7 @@ func TestGestureDetector_RecognizerDisposal(t *testing.T) {
 
 	detector := &renderGestureDetector{}
 	detector.SetSelf(detector)
-	detector.configure(gd1)
+	detector.configure(gd1, gestures.GestureSettings{})
 
 	if detector.horizontalDrag == nil {
 		t.Error("horizontalDrag should be created")
@@ -172,7 +172,7 @@ func TestGestureDetector_RecognizerDisposal(t *testing.T) {
 
 	// Reconfigure without horizontal drag
 	gd2 := GestureDetector{}
-	detector.configure(gd2)
+	detector.configure(gd2, gestures.GestureSettings{})
 
 	if detector.horizontalDrag != nil {
 		t.Error("horizontalDrag should be disposed")
@@ -193,7 +193,7 @@ func TestGestureDetector_MultipleRecognizers(t *testing.T) {
 
 	detector := &renderGestureDetector{}
 	detector.SetSelf(detector)
-	detector.configure(gd)
+	detector.configure(gd, gestures.GestureSettings{})
 
 	if detector.tap == nil {
 		t.Error("tap recognizer should be created")
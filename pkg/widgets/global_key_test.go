@@ -0,0 +1,110 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// movableCounter is a keyed stateful widget whose State must survive moves.
+type movableCounter struct {
+	core.StatefulBase
+	key core.GlobalKey[*movableCounterState]
+}
+
+func (m movableCounter) Key() any              { return m.key }
+func (movableCounter) CreateState() core.State { return &movableCounterState{} }
+
+type movableCounterState struct {
+	core.StateBase
+	count int
+}
+
+func (s *movableCounterState) Build(ctx core.BuildContext) core.Widget {
+	return widgets.SizedBox{Width: 40, Height: 20}
+}
+
+// movableHost shows the counter inside a Padding or, when moved, as the
+// second child of a Row.
+type movableHost struct {
+	core.StatefulBase
+	key     core.GlobalKey[*movableCounterState]
+	hostKey core.GlobalKey[*movableHostState]
+}
+
+func (h movableHost) Key() any              { return h.hostKey }
+func (movableHost) CreateState() core.State { return &movableHostState{} }
+
+type movableHostState struct {
+	core.StateBase
+	moved bool
+}
+
+func (s *movableHostState) Build(ctx core.BuildContext) core.Widget {
+	counter := movableCounter{key: s.Element().Widget().(movableHost).key}
+	if s.moved {
+		return widgets.Row{Children: []core.Widget{
+			widgets.SizedBox{Width: 10, Height: 10},
+			counter,
+		}}
+	}
+	return widgets.Padding{Padding: layout.EdgeInsetsAll(8), Child: counter}
+}
+
+func TestGlobalKey_MovesRenderObjectsWithState(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 200, Height: 200})
+
+	key := core.NewGlobalKey[*movableCounterState]()
+	hostKey := core.NewGlobalKey[*movableHostState]()
+	if err := tester.PumpWidget(widgets.Align{
+		Alignment: layout.AlignmentTopLeft,
+		Child:     movableHost{key: key, hostKey: hostKey},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	state := key.CurrentState()
+	state.count = 3
+	box := key.CurrentRenderObject()
+	if box == nil {
+		t.Fatal("expected a render object for the keyed widget")
+	}
+
+	host := hostKey.CurrentState()
+	host.SetState(func() { host.moved = true })
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+
+	if key.CurrentState() != state || state.count != 3 {
+		t.Fatal("expected the State to move with the widget")
+	}
+	if key.CurrentRenderObject() != box {
+		t.Error("expected the render object to move instead of being recreated")
+	}
+	parent := box.(interface{ Parent() layout.RenderObject }).Parent()
+	row := tester.Find(drifttest.ByType[widgets.Row]()).FirstOrNil()
+	if row == nil || parent != row.(interface{ RenderObject() layout.RenderObject }).RenderObject() {
+		t.Errorf("expected the render object to be reparented under the Row, got %T", parent)
+	}
+	if size := box.Size(); size.Width != 40 || size.Height != 20 {
+		t.Errorf("expected the moved box to be laid out, got %v", size)
+	}
+
+	host.SetState(func() { host.moved = false })
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	padding := tester.Find(drifttest.ByType[widgets.Padding]()).FirstOrNil()
+	if padding == nil || box.(interface{ Parent() layout.RenderObject }).Parent() != padding.(interface{ RenderObject() layout.RenderObject }).RenderObject() {
+		t.Error("expected the render object to move back under the Padding")
+	}
+	if key.CurrentState() != state {
+		t.Error("expected the State to survive moving back")
+	}
+}
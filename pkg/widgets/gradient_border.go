@@ -0,0 +1,183 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+// GradientBorder strokes a border with a gradient around its child, with
+// optional rounded corners and dashing. It is shorthand for a [DecoratedBox]
+// with BorderGradient set; use DecoratedBox directly to combine the border
+// with a background gradient or shadow.
+//
+// Example:
+//
+//	widgets.GradientBorder{
+//	    Gradient: graphics.NewLinearGradient(
+//	        graphics.AlignTopLeft,
+//	        graphics.AlignBottomRight,
+//	        []graphics.GradientStop{
+//	            {Position: 0, Color: colors.Primary},
+//	            {Position: 1, Color: colors.Tertiary},
+//	        },
+//	    ),
+//	    Width:  2,
+//	    Radius: 16,
+//	    Child:  card,
+//	}
+//
+// The stroke is centered on the widget's edge, so half of Width falls
+// outside the bounds. Pad the child by Width to keep it clear of the border.
+type GradientBorder struct {
+	core.StatelessBase
+
+	// Gradient colors the border stroke. Nil draws no border.
+	Gradient *graphics.Gradient
+	// Width is the stroke width in pixels. Zero means no border.
+	Width float64
+	// Radius is the corner radius. Zero means sharp corners.
+	Radius float64
+	// Dash makes the border dashed. Nil draws a solid line.
+	Dash *graphics.DashPattern
+	// Background fills the area inside the border. Zero means transparent.
+	Background graphics.Color
+	// Child is clipped to the bordered shape.
+	Child core.Widget
+}
+
+func (g GradientBorder) Build(ctx core.BuildContext) core.Widget {
+	return DecoratedBox{
+		Color:          g.Background,
+		BorderWidth:    g.Width,
+		BorderRadius:   g.Radius,
+		BorderDash:     g.Dash,
+		BorderGradient: g.Gradient,
+		Child:          g.Child,
+	}
+}
+
+// AnimatedBorder is a border that moves: it spins its gradient, marches its
+// dashes around the edge, or both, looping once per Duration. It covers the
+// common "glowing card" and "marching ants" effects without custom painting.
+//
+// Example:
+//
+//	widgets.AnimatedBorder{
+//	    Gradient:       sheen,
+//	    Width:          3,
+//	    Radius:         20,
+//	    Duration:       3 * time.Second,
+//	    RotateGradient: true,
+//	    Child:          card,
+//	}
+//
+// The border holds still while animations are disabled for reduced motion
+// (see [animation.SetAnimationsDisabled]).
+type AnimatedBorder struct {
+	core.StatefulBase
+
+	// Gradient colors the border stroke. When set, overrides Color.
+	Gradient *graphics.Gradient
+	// Color is the border color when Gradient is nil.
+	Color graphics.Color
+	// Width is the stroke width in pixels. Zero means no border.
+	Width float64
+	// Radius is the corner radius. Zero means sharp corners.
+	Radius float64
+	// Dash makes the border dashed. Nil draws a solid line.
+	Dash *graphics.DashPattern
+	// Background fills the area inside the border. Zero means transparent.
+	Background graphics.Color
+
+	// Duration is the length of one loop: a full turn of the gradient and
+	// one pattern length of dash travel. Zero means no animation.
+	Duration time.Duration
+	// RotateGradient spins Gradient clockwise, using [graphics.Gradient.Rotated].
+	RotateGradient bool
+	// MarchDash moves the Dash pattern clockwise around the border.
+	MarchDash bool
+
+	// Child is clipped to the bordered shape.
+	Child core.Widget
+}
+
+func (a AnimatedBorder) CreateState() core.State {
+	return &animatedBorderState{}
+}
+
+// animates reports whether the border has any motion to loop.
+func (a AnimatedBorder) animates() bool {
+	return a.Duration > 0 && (a.RotateGradient && a.Gradient != nil || a.MarchDash && a.Dash != nil)
+}
+
+type animatedBorderState struct {
+	core.StateBase
+	controller *animation.AnimationController
+}
+
+func (s *animatedBorderState) InitState() {
+	w := s.Element().Widget().(AnimatedBorder)
+	s.controller = animation.NewAnimationController(w.Duration)
+	s.controller.Curve = animation.LinearCurve
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+	s.controller.AddStatusListener(func(status animation.AnimationStatus) {
+		// While animations are disabled Forward completes at once, so
+		// restarting here would loop without ever yielding a frame.
+		if status == animation.AnimationCompleted && !animation.AnimationsDisabled() &&
+			s.Element().Widget().(AnimatedBorder).animates() {
+			s.controller.Reset()
+			s.controller.Forward()
+		}
+	})
+	if w.animates() {
+		s.controller.Forward()
+	}
+}
+
+func (s *animatedBorderState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(AnimatedBorder)
+	w := s.Element().Widget().(AnimatedBorder)
+	s.controller.Duration = w.Duration
+	switch {
+	case old.animates() && !w.animates():
+		s.controller.Stop()
+	case !old.animates() && w.animates():
+		s.controller.Reset()
+		s.controller.Forward()
+	}
+}
+
+func (s *animatedBorderState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(AnimatedBorder)
+	gradient, dash := w.Gradient, w.Dash
+	if w.animates() {
+		t := s.controller.Value
+		if w.RotateGradient {
+			gradient = gradient.Rotated(t * 2 * math.Pi)
+		}
+		if w.MarchDash && dash != nil {
+			var length float64
+			for _, interval := range dash.Intervals {
+				length += interval
+			}
+			// Lowering the phase shifts dashes forward along the path,
+			// which runs clockwise. Counting down from one pattern length
+			// keeps the phase non-negative.
+			dash = &graphics.DashPattern{Intervals: dash.Intervals, Phase: dash.Phase + (1-t)*length}
+		}
+	}
+	return DecoratedBox{
+		Color:          w.Background,
+		BorderColor:    w.Color,
+		BorderWidth:    w.Width,
+		BorderRadius:   w.Radius,
+		BorderDash:     dash,
+		BorderGradient: gradient,
+		Child:          w.Child,
+	}
+}
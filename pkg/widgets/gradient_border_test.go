@@ -0,0 +1,131 @@
+package widgets_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+var borderStops = []graphics.GradientStop{
+	{Position: 0, Color: graphics.RGB(255, 0, 0)},
+	{Position: 1, Color: graphics.RGB(0, 0, 255)},
+}
+
+func decoratedBoxOf(t *testing.T, tester *drifttest.WidgetTester) widgets.DecoratedBox {
+	t.Helper()
+	box, ok := tester.Find(drifttest.ByType[widgets.DecoratedBox]()).Widget().(widgets.DecoratedBox)
+	if !ok {
+		t.Fatal("expected a DecoratedBox")
+	}
+	return box
+}
+
+func TestGradientBorder_BuildsDecoratedBox(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	gradient := graphics.NewLinearGradient(graphics.AlignTopLeft, graphics.AlignBottomRight, borderStops)
+	dash := &graphics.DashPattern{Intervals: []float64{6, 3}}
+	if err := tester.PumpWidget(widgets.GradientBorder{
+		Gradient:   gradient,
+		Width:      2,
+		Radius:     12,
+		Dash:       dash,
+		Background: graphics.ColorWhite,
+		Child:      widgets.SizedBox{Width: 50, Height: 50},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	box := decoratedBoxOf(t, tester)
+	if box.BorderGradient != gradient || box.BorderWidth != 2 || box.BorderRadius != 12 ||
+		box.BorderDash != dash || box.Color != graphics.ColorWhite {
+		t.Errorf("DecoratedBox = %+v, want the border's gradient, width, radius, dash, and background", box)
+	}
+}
+
+func TestAnimatedBorder_RotatesGradient(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	gradient := graphics.NewLinearGradient(graphics.AlignCenterLeft, graphics.AlignCenterRight, borderStops)
+	if err := tester.PumpWidget(widgets.AnimatedBorder{
+		Gradient:       gradient,
+		Width:          2,
+		Duration:       time.Second,
+		RotateGradient: true,
+		Child:          widgets.SizedBox{Width: 50, Height: 50},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tester.Clock().Advance(250 * time.Millisecond)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	start := decoratedBoxOf(t, tester).BorderGradient.Linear.Start
+	if math.Abs(start.X) > 1e-6 || math.Abs(start.Y+1) > 1e-6 {
+		t.Errorf("start after a quarter loop = %v, want top center", start)
+	}
+	if gradient.Linear.Start != graphics.AlignCenterLeft {
+		t.Error("rotation modified the widget's gradient")
+	}
+
+	// The loop restarts instead of settling.
+	tester.Clock().Advance(time.Second)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	tester.Clock().Advance(250 * time.Millisecond)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if start := decoratedBoxOf(t, tester).BorderGradient.Linear.Start; start == graphics.AlignCenterLeft {
+		t.Error("expected the rotation to keep looping")
+	}
+}
+
+func TestAnimatedBorder_MarchesDash(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	if err := tester.PumpWidget(widgets.AnimatedBorder{
+		Color:     graphics.ColorBlack,
+		Width:     1,
+		Dash:      &graphics.DashPattern{Intervals: []float64{6, 2}},
+		Duration:  time.Second,
+		MarchDash: true,
+		Child:     widgets.SizedBox{Width: 50, Height: 50},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tester.Clock().Advance(250 * time.Millisecond)
+	if err := tester.Pump(); err != nil {
+		t.Fatal(err)
+	}
+	if phase := decoratedBoxOf(t, tester).BorderDash.Phase; math.Abs(phase-6) > 1e-6 {
+		t.Errorf("phase after a quarter loop = %v, want 6", phase)
+	}
+}
+
+func TestAnimatedBorder_StillWhenAnimationsDisabled(t *testing.T) {
+	prev := animation.SetAnimationsDisabled(true)
+	t.Cleanup(func() { animation.SetAnimationsDisabled(prev) })
+
+	tester := drifttest.NewWidgetTesterWithT(t)
+	if err := tester.PumpWidget(widgets.AnimatedBorder{
+		Gradient:       graphics.NewLinearGradient(graphics.AlignCenterLeft, graphics.AlignCenterRight, borderStops),
+		Width:          2,
+		Duration:       time.Second,
+		RotateGradient: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatalf("expected the border to settle: %v", err)
+	}
+	start := decoratedBoxOf(t, tester).BorderGradient.Linear.Start
+	if math.Abs(start.X+1) > 1e-6 || math.Abs(start.Y) > 1e-6 {
+		t.Errorf("start = %v, want the unrotated center left", start)
+	}
+}
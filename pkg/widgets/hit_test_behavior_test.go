@@ -0,0 +1,152 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// layeredDetectors stacks a detector with a small centered child over a
+// full-size background detector, returning the tap counts.
+func layeredDetectors(t *testing.T, behavior widgets.HitTestBehavior) (tester *drifttest.WidgetTester, front, back *int) {
+	tester = drifttest.NewWidgetTesterWithT(t)
+	front, back = new(int), new(int)
+	tester.PumpWidget(widgets.Stack{
+		Fit: widgets.StackFitExpand,
+		Children: []core.Widget{
+			widgets.GestureDetector{
+				OnTap: func() { *back++ },
+				Child: widgets.Container{Color: graphics.RGB(200, 200, 200)},
+			},
+			widgets.GestureDetector{
+				Behavior: behavior,
+				OnTap:    func() { *front++ },
+				Child: widgets.Center{
+					Child: widgets.Container{Width: 20, Height: 20, Color: graphics.RGB(0, 0, 0)},
+				},
+			},
+		},
+	})
+	return tester, front, back
+}
+
+func TestGestureDetector_BehaviorOpaqueCatchesEmptyArea(t *testing.T) {
+	tester, front, back := layeredDetectors(t, widgets.HitTestBehaviorOpaque)
+
+	if err := tester.TapAt(graphics.Offset{X: 5, Y: 5}); err != nil {
+		t.Fatalf("TapAt failed: %v", err)
+	}
+	if *front != 1 || *back != 0 {
+		t.Errorf("front = %d, back = %d; want the opaque detector to take the tap", *front, *back)
+	}
+}
+
+func TestGestureDetector_BehaviorDeferToChild(t *testing.T) {
+	tester, front, back := layeredDetectors(t, widgets.HitTestBehaviorDeferToChild)
+
+	if err := tester.TapAt(graphics.Offset{X: 5, Y: 5}); err != nil {
+		t.Fatalf("TapAt failed: %v", err)
+	}
+	if *front != 0 || *back != 1 {
+		t.Errorf("outside child: front = %d, back = %d; want tap to fall through", *front, *back)
+	}
+
+	size := tester.RootRenderObject().(interface{ Size() graphics.Size }).Size()
+	if err := tester.TapAt(graphics.Offset{X: size.Width / 2, Y: size.Height / 2}); err != nil {
+		t.Fatalf("TapAt failed: %v", err)
+	}
+	if *front != 1 || *back != 1 {
+		t.Errorf("on child: front = %d, back = %d; want the front detector to take the tap", *front, *back)
+	}
+}
+
+func TestGestureDetector_BehaviorTranslucentPassesThrough(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var dragged, tapped bool
+	tester.PumpWidget(widgets.Stack{
+		Fit: widgets.StackFitExpand,
+		Children: []core.Widget{
+			widgets.GestureDetector{
+				OnVerticalDragStart: func(widgets.DragStartDetails) { dragged = true },
+				Child:               widgets.Container{Color: graphics.RGB(200, 200, 200)},
+			},
+			widgets.GestureDetector{
+				Behavior: widgets.HitTestBehaviorTranslucent,
+				OnTap:    func() { tapped = true },
+			},
+		},
+	})
+
+	if err := tester.DragFrom(graphics.Offset{X: 50, Y: 50}, graphics.Offset{Y: 100}); err != nil {
+		t.Fatalf("DragFrom failed: %v", err)
+	}
+	if !dragged {
+		t.Error("background detector should receive pointers through a translucent one")
+	}
+
+	if err := tester.TapAt(graphics.Offset{X: 50, Y: 50}); err != nil {
+		t.Fatalf("TapAt failed: %v", err)
+	}
+	if !tapped {
+		t.Error("translucent detector should still receive its own taps")
+	}
+}
+
+func TestAbsorbPointer_BlocksChildAndBackground(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		absorbing      bool
+		wantFront      bool
+		wantBackTapped bool
+	}{
+		{"absorbing", true, false, false},
+		{"not absorbing", false, true, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tester := drifttest.NewWidgetTesterWithT(t)
+			var front, back bool
+			tester.PumpWidget(widgets.Stack{
+				Fit: widgets.StackFitExpand,
+				Children: []core.Widget{
+					widgets.GestureDetector{OnTap: func() { back = true }},
+					widgets.AbsorbPointer{
+						Absorbing: tt.absorbing,
+						Child:     widgets.GestureDetector{OnTap: func() { front = true }},
+					},
+				},
+			})
+
+			if err := tester.TapAt(graphics.Offset{X: 50, Y: 50}); err != nil {
+				t.Fatalf("TapAt failed: %v", err)
+			}
+			if front != tt.wantFront || back != tt.wantBackTapped {
+				t.Errorf("front = %v, back = %v; want %v, %v", front, back, tt.wantFront, tt.wantBackTapped)
+			}
+		})
+	}
+}
+
+func TestIgnorePointer_FallsThroughToBackground(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var front, back bool
+	tester.PumpWidget(widgets.Stack{
+		Fit: widgets.StackFitExpand,
+		Children: []core.Widget{
+			widgets.GestureDetector{OnTap: func() { back = true }},
+			widgets.IgnorePointer{
+				Ignoring: true,
+				Child:    widgets.GestureDetector{OnTap: func() { front = true }},
+			},
+		},
+	})
+
+	if err := tester.TapAt(graphics.Offset{X: 50, Y: 50}); err != nil {
+		t.Fatalf("TapAt failed: %v", err)
+	}
+	if front || !back {
+		t.Errorf("front = %v, back = %v; want the tap to reach only the background", front, back)
+	}
+}
@@ -3,6 +3,7 @@ package widgets
 import (
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
 )
 
 // Icon renders a single glyph with icon-friendly defaults.
@@ -46,6 +47,10 @@ type Icon struct {
 	Color graphics.Color
 	// Weight sets the font weight if non-zero.
 	Weight graphics.FontWeight
+	// MatchTextDirection mirrors the glyph horizontally under a right-to-left
+	// [Directionality]. Set it for icons that point along the reading order,
+	// such as back arrows, chevrons, and reply icons.
+	MatchTextDirection bool
 }
 
 func (i Icon) Build(ctx core.BuildContext) core.Widget {
@@ -56,9 +61,53 @@ func (i Icon) Build(ctx core.BuildContext) core.Widget {
 		FontWeight: i.Weight,
 	}
 
-	return Text{
+	text := Text{
 		Content:  i.Glyph,
 		Style:    style,
 		MaxLines: 1,
 	}
+	if i.MatchTextDirection && DirectionalityOf(ctx) == graphics.TextDirectionRTL {
+		return horizontalMirror{child: text}
+	}
+	return text
+}
+
+// horizontalMirror paints its child flipped left to right.
+type horizontalMirror struct {
+	core.RenderObjectBase
+	child core.Widget
+}
+
+func (m horizontalMirror) ChildWidget() core.Widget { return m.child }
+
+func (m horizontalMirror) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	mirror := &renderHorizontalMirror{}
+	mirror.SetSelf(mirror)
+	return mirror
+}
+
+func (m horizontalMirror) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+}
+
+type renderHorizontalMirror struct {
+	renderPassthrough
+}
+
+func (r *renderHorizontalMirror) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	ctx.Canvas.Save()
+	ctx.Canvas.Translate(r.Size().Width, 0)
+	ctx.Canvas.Scale(-1, 1)
+	ctx.PaintChild(r.child.(layout.RenderBox), graphics.Offset{})
+	ctx.Canvas.Restore()
+}
+
+func (r *renderHorizontalMirror) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.child == nil {
+		return false
+	}
+	mirrored := graphics.Offset{X: r.Size().Width - position.X, Y: position.Y}
+	return r.child.HitTest(mirrored, result)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"image"
 	"image/draw"
+	"sync"
 	"sync/atomic"
 
 	"github.com/go-drift/drift/pkg/core"
@@ -14,8 +15,9 @@ import (
 
 // Image renders a bitmap image onto the canvas with configurable sizing and scaling.
 //
-// Image accepts a Go [image.Image] as its Source. The image is rendered using
-// the specified Fit mode to control scaling behavior.
+// Image accepts a Go [image.Image] as its Source, or the name of a PNG or
+// JPEG in the [AssetBundle] as its Asset. The image is rendered using the
+// specified Fit mode to control scaling behavior.
 //
 // # Creation Pattern
 //
@@ -43,6 +45,11 @@ type Image struct {
 	core.RenderObjectBase
 	// Source is the image to render.
 	Source image.Image
+	// Asset is the name of an image in the bundle from [AssetBundleOf],
+	// used instead of Source when set. Decoded assets are shared by the
+	// Images showing them and cached; see [SetAssetCacheLimit]. Other
+	// formats can be decoded by registering them with the image package.
+	Asset string
 	// Width overrides the image width if non-zero.
 	Width float64
 	// Height overrides the image height if non-zero.
@@ -114,7 +121,7 @@ func (i Image) WithAlignment(alignment layout.Alignment) Image {
 
 func (i Image) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 	box := &renderImage{
-		source:               i.Source,
+		asset:                assetRef[image.Image]{cache: imageAssets},
 		width:                i.Width,
 		height:               i.Height,
 		fit:                  i.Fit,
@@ -123,12 +130,13 @@ func (i Image) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 		excludeFromSemantics: i.ExcludeFromSemantics,
 	}
 	box.SetSelf(box)
+	box.source = box.resolveSource(i, AssetBundleOf(ctx))
 	return box
 }
 
 func (i Image) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if box, ok := renderObject.(*renderImage); ok {
-		box.source = i.Source
+		box.source = box.resolveSource(i, AssetBundleOf(ctx))
 		box.width = i.Width
 		box.height = i.Height
 		box.fit = i.Fit
@@ -145,6 +153,7 @@ func (i Image) UpdateRenderObject(ctx core.BuildContext, renderObject layout.Ren
 type renderImage struct {
 	layout.RenderBoxBase
 	source               image.Image
+	asset                assetRef[image.Image]
 	width                float64
 	height               float64
 	fit                  ImageFit
@@ -161,6 +170,22 @@ type renderImage struct {
 	cacheID      uintptr
 }
 
+// resolveSource returns the image to show for i, loading its Asset from
+// bundle if set.
+func (r *renderImage) resolveSource(i Image, bundle AssetBundle) image.Image {
+	key := assetKey{}
+	if i.Asset != "" {
+		key = assetKey{bundle: bundle, name: i.Asset}
+	}
+	if _, err := r.asset.set(key); err != nil {
+		reportAssetError("widgets.Image", i.Asset, err)
+	}
+	if i.Asset != "" {
+		return r.asset.value
+	}
+	return i.Source
+}
+
 // IsRepaintBoundary isolates image repaints into their own layer.
 func (r *renderImage) IsRepaintBoundary() bool {
 	return true
@@ -174,7 +199,8 @@ func (r *renderImage) PerformLayout() {
 	constraints := r.Constraints()
 	if r.source == nil {
 		r.intrinsic = graphics.Size{}
-		r.cachedRGBA, r.cachedSource = nil, nil
+		r.setCachedRGBA(nil)
+		r.cachedSource = nil
 		r.cacheID = 0
 		r.SetSize(constraints.Constrain(graphics.Size{}))
 		return
@@ -277,9 +303,75 @@ func (r *renderImage) fitSize(fit ImageFit, size graphics.Size) graphics.Size {
 // Using a global ensures IDs are unique across all renderImage instances.
 var imageCacheIDCounter atomic.Uintptr
 
+// imageCacheBytes is the pixel memory held by all renderImage caches.
+var imageCacheBytes atomic.Int64
+
+// ImageCacheBytes returns the bytes of decoded pixel data currently held by
+// Image widgets in the tree, for memory diagnostics.
+func ImageCacheBytes() int64 {
+	return imageCacheBytes.Load()
+}
+
+// precachedImages holds pixels converted ahead of time by [PrecacheImage],
+// keyed by source, until an Image shows the source and takes them over.
+var (
+	precachedMu     sync.Mutex
+	precachedImages = map[image.Image]*image.RGBA{}
+)
+
+// PrecacheImage converts source to the pixel format Image draws, so the first
+// Image that shows it can skip the conversion. Converting a large or
+// compressed-format image can take longer than a frame, so call this ahead of
+// navigating to a screen that shows it, ideally from an idle callback.
+//
+// The converted pixels are held, and counted by [ImageCacheBytes], until an
+// Image shows the same source instance or [EvictPrecachedImage] releases
+// them. Call it on the UI thread.
+func PrecacheImage(source image.Image) {
+	if source == nil {
+		return
+	}
+	if _, ok := source.(*image.RGBA); ok {
+		// Drawn as is, nothing to convert.
+		return
+	}
+	precachedMu.Lock()
+	_, cached := precachedImages[source]
+	precachedMu.Unlock()
+	if cached {
+		return
+	}
+	rgba := toRGBAImage(source)
+	if rgba == nil {
+		return
+	}
+	precachedMu.Lock()
+	precachedImages[source] = rgba
+	precachedMu.Unlock()
+	imageCacheBytes.Add(int64(len(rgba.Pix)))
+}
+
+// EvictPrecachedImage releases pixels held by [PrecacheImage] for source
+// that no Image has shown yet.
+func EvictPrecachedImage(source image.Image) {
+	if rgba := takePrecachedImage(source); rgba != nil {
+		imageCacheBytes.Add(-int64(len(rgba.Pix)))
+	}
+}
+
+// takePrecachedImage removes and returns the precached pixels for source.
+// The caller takes over their share of imageCacheBytes.
+func takePrecachedImage(source image.Image) *image.RGBA {
+	precachedMu.Lock()
+	defer precachedMu.Unlock()
+	rgba := precachedImages[source]
+	delete(precachedImages, source)
+	return rgba
+}
+
 func (r *renderImage) updateImageCache() {
 	if r.source == nil {
-		r.cachedRGBA = nil
+		r.setCachedRGBA(nil)
 		r.cachedSource = nil
 		r.cacheID = 0
 		return
@@ -292,18 +384,49 @@ func (r *renderImage) updateImageCache() {
 		return
 	}
 
-	// Convert and cache
-	r.cachedRGBA = toRGBAImage(r.source)
+	// Convert and cache, unless PrecacheImage already did
+	rgba := takePrecachedImage(r.source)
+	if rgba != nil {
+		imageCacheBytes.Add(-int64(len(rgba.Pix)))
+	} else {
+		rgba = toRGBAImage(r.source)
+	}
+	r.setCachedRGBA(rgba)
 	r.cachedSource = r.source
 	r.cacheID = imageCacheIDCounter.Add(1)
 }
 
+// setCachedRGBA replaces the cached image, keeping imageCacheBytes in step.
+func (r *renderImage) setCachedRGBA(rgba *image.RGBA) {
+	if r.cachedRGBA != nil {
+		imageCacheBytes.Add(-int64(len(r.cachedRGBA.Pix)))
+	}
+	if rgba != nil {
+		imageCacheBytes.Add(int64(len(rgba.Pix)))
+	}
+	r.cachedRGBA = rgba
+}
+
+// Dispose releases the cached image along with the layer.
+func (r *renderImage) Dispose() {
+	r.setCachedRGBA(nil)
+	r.cachedSource = nil
+	r.cacheID = 0
+	r.asset.release()
+	r.RenderBoxBase.Dispose()
+}
+
 func (r *renderImage) cacheKey() uintptr {
 	return r.cacheID
 }
 
 func (r *renderImage) computeFitRects(fit ImageFit, align layout.Alignment, box graphics.Size) (src, dst graphics.Rect) {
-	intrinsic := r.intrinsic
+	return imageFitRects(fit, align, r.intrinsic, box)
+}
+
+// imageFitRects returns the part of an image with the intrinsic size to
+// draw and where to draw it within box for the fit and alignment.
+func imageFitRects(fit ImageFit, align layout.Alignment, intrinsic, box graphics.Size) (src, dst graphics.Rect) {
 	fullSrc := graphics.RectFromLTWH(0, 0, intrinsic.Width, intrinsic.Height)
 
 	switch fit {
@@ -0,0 +1,454 @@
+package widgets
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+	"math"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// defaultCropMaxScale is how far an [ImageCropper] zooms in when MaxScale is
+// zero.
+const defaultCropMaxScale = 4
+
+// errNothingToCrop is returned by [ImageCropController.Crop] before the
+// cropper has an image and a size.
+var errNothingToCrop = errors.New("widgets: image cropper has no image laid out")
+
+// ImageCropController reads and resets the crop of an [ImageCropper].
+//
+// A zero ImageCropController is ready to use. Pass it to one cropper.
+type ImageCropController struct {
+	cropper *renderImageCropper
+}
+
+// CropRect returns the part of the source image inside the crop area, in
+// the source's pixel coordinates. It is empty until the cropper has been
+// laid out with an image.
+func (c *ImageCropController) CropRect() image.Rectangle {
+	if c.cropper == nil {
+		return image.Rectangle{}
+	}
+	return c.cropper.cropRect()
+}
+
+// Crop copies the part of the source inside the crop area into a new
+// image. Show it with [Image] or encode it for upload:
+//
+//	cropped, err := s.crop.Crop()
+//	if err != nil {
+//	    return err
+//	}
+//	var buf bytes.Buffer
+//	err = jpeg.Encode(&buf, cropped, nil)
+func (c *ImageCropController) Crop() (*image.RGBA, error) {
+	if c.cropper == nil {
+		return nil, errNothingToCrop
+	}
+	return c.cropper.crop()
+}
+
+// Reset zooms out to show as much of the image as the crop area allows,
+// centered.
+func (c *ImageCropController) Reset() {
+	if c.cropper != nil {
+		c.cropper.reset()
+	}
+}
+
+// ImageCropper lets the user choose part of an image by panning and
+// pinching it under a fixed crop area, as when picking a profile photo.
+// The image always covers the crop area. Read the result through
+// Controller.
+//
+//	widgets.ImageCropper{
+//	    Source:       photo,
+//	    Controller:   s.crop,
+//	    AspectRatio:  1,
+//	    Oval:         true,
+//	    Padding:      24,
+//	    OverlayColor: graphics.RGBA(0, 0, 0, 0.6),
+//	    BorderColor:  graphics.ColorWhite,
+//	    BorderWidth:  2,
+//	    GridColor:    graphics.RGBA(255, 255, 255, 0.4),
+//	}
+//
+// The cropper fills the space it is given.
+type ImageCropper struct {
+	core.RenderObjectBase
+
+	// Source is the image to crop.
+	Source image.Image
+	// Controller reads the crop. Optional.
+	Controller *ImageCropController
+	// OnChanged is called with the new crop, in source pixels, when the
+	// user finishes a gesture.
+	OnChanged func(rect image.Rectangle)
+
+	// AspectRatio is the width of the crop area divided by its height.
+	// Defaults to 1, a square.
+	AspectRatio float64
+	// Oval draws the crop area as an ellipse, for avatars. The crop itself
+	// is still the bounding rectangle.
+	Oval bool
+	// Padding is the least space between the crop area and the edges of
+	// the cropper.
+	Padding float64
+	// MaxScale is how far the user can zoom in, relative to the zoom at
+	// which the image just covers the crop area. Defaults to 4.
+	MaxScale float64
+
+	// OverlayColor dims the image outside the crop area. Zero leaves it
+	// undimmed.
+	OverlayColor graphics.Color
+	// BorderColor outlines the crop area. Zero draws no border.
+	BorderColor graphics.Color
+	// BorderWidth is the width of the outline.
+	BorderWidth float64
+	// GridColor draws rule-of-thirds lines across the crop area. Zero draws
+	// no grid.
+	GridColor graphics.Color
+}
+
+func (c ImageCropper) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderImageCropper{}
+	box.SetSelf(box)
+	c.UpdateRenderObject(ctx, box)
+	return box
+}
+
+func (c ImageCropper) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	box, ok := renderObject.(*renderImageCropper)
+	if !ok {
+		return
+	}
+	if box.controller != c.Controller {
+		if box.controller != nil && box.controller.cropper == box {
+			box.controller.cropper = nil
+		}
+		box.controller = c.Controller
+		if c.Controller != nil {
+			c.Controller.cropper = box
+		}
+	}
+	box.setSource(c.Source)
+	box.onChanged = c.OnChanged
+	box.aspectRatio = c.AspectRatio
+	box.oval = c.Oval
+	box.padding = c.Padding
+	box.maxScale = c.MaxScale
+	box.overlayColor = c.OverlayColor
+	box.borderColor = c.BorderColor
+	box.borderWidth = c.BorderWidth
+	box.gridColor = c.GridColor
+	box.MarkNeedsLayout()
+	box.MarkNeedsPaint()
+}
+
+type renderImageCropper struct {
+	layout.RenderBoxBase
+	controller   *ImageCropController
+	onChanged    func(rect image.Rectangle)
+	aspectRatio  float64
+	oval         bool
+	padding      float64
+	maxScale     float64
+	overlayColor graphics.Color
+	borderColor  graphics.Color
+	borderWidth  float64
+	gridColor    graphics.Color
+
+	source  image.Image
+	rgba    *image.RGBA
+	cacheID uintptr
+
+	// The image is drawn at scale with its top left corner at origin.
+	scale      float64
+	origin     graphics.Offset
+	area       graphics.Rect // crop area from the last layout
+	sourceCrop graphics.Rect // crop in source pixels, kept across layouts
+
+	gesture    *gestures.ScaleGestureRecognizer
+	startScale float64
+}
+
+// setSource converts a new source to the pixel format the canvas draws and
+// the crop copies from, sharing the [Image] cache accounting.
+func (r *renderImageCropper) setSource(source image.Image) {
+	if source == r.source {
+		return
+	}
+	r.releaseImage()
+	r.source = source
+	r.sourceCrop = graphics.Rect{}
+	if source == nil {
+		return
+	}
+	rgba := takePrecachedImage(source)
+	if rgba == nil {
+		rgba = toRGBAImage(source)
+		if rgba != nil {
+			imageCacheBytes.Add(int64(len(rgba.Pix)))
+		}
+	}
+	r.rgba = rgba
+	r.cacheID = imageCacheIDCounter.Add(1)
+}
+
+func (r *renderImageCropper) releaseImage() {
+	if r.rgba != nil {
+		imageCacheBytes.Add(-int64(len(r.rgba.Pix)))
+	}
+	r.rgba = nil
+	r.cacheID = 0
+}
+
+func (r *renderImageCropper) Dispose() {
+	if r.controller != nil && r.controller.cropper == r {
+		r.controller.cropper = nil
+	}
+	r.releaseImage()
+	r.source = nil
+	r.RenderBoxBase.Dispose()
+}
+
+// imageSize returns the size of the source in pixels.
+func (r *renderImageCropper) imageSize() graphics.Size {
+	if r.rgba == nil {
+		return graphics.Size{}
+	}
+	bounds := r.rgba.Bounds()
+	return graphics.Size{Width: float64(bounds.Dx()), Height: float64(bounds.Dy())}
+}
+
+func (r *renderImageCropper) PerformLayout() {
+	constraints := r.Constraints()
+	size := graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight}
+	if math.IsInf(size.Width, 1) || math.IsInf(size.Height, 1) {
+		size = r.imageSize()
+	}
+	r.SetSize(constraints.Constrain(size))
+	r.layoutCrop()
+}
+
+// layoutCrop places the crop area and keeps the same part of the image in
+// it, or fits the image to it the first time.
+func (r *renderImageCropper) layoutCrop() {
+	size := r.Size()
+	aspect := r.aspectRatio
+	if aspect <= 0 {
+		aspect = 1
+	}
+	width := max(size.Width-2*r.padding, 0)
+	height := max(size.Height-2*r.padding, 0)
+	if width/aspect > height {
+		width = height * aspect
+	} else {
+		height = width / aspect
+	}
+	r.area = graphics.RectFromLTWH((size.Width-width)/2, (size.Height-height)/2, width, height)
+
+	if r.area.IsEmpty() || r.rgba == nil {
+		return
+	}
+	if r.sourceCrop.IsEmpty() {
+		r.reset()
+		return
+	}
+	r.scale = r.area.Width() / r.sourceCrop.Width()
+	r.origin = graphics.Offset{
+		X: r.area.Left - r.sourceCrop.Left*r.scale,
+		Y: r.area.Top - r.sourceCrop.Top*r.scale,
+	}
+	r.clamp()
+}
+
+// minScale returns the scale at which the image just covers the crop area.
+func (r *renderImageCropper) minScale() float64 {
+	img := r.imageSize()
+	if img.Width <= 0 || img.Height <= 0 {
+		return 1
+	}
+	return max(r.area.Width()/img.Width, r.area.Height()/img.Height)
+}
+
+func (r *renderImageCropper) reset() {
+	if r.area.IsEmpty() || r.rgba == nil {
+		return
+	}
+	img := r.imageSize()
+	r.scale = r.minScale()
+	r.origin = graphics.Offset{
+		X: r.area.Left + (r.area.Width()-img.Width*r.scale)/2,
+		Y: r.area.Top + (r.area.Height()-img.Height*r.scale)/2,
+	}
+	r.clamp()
+	r.MarkNeedsPaint()
+}
+
+// clamp keeps the scale in range and the image covering the crop area,
+// then records the crop in source pixels.
+func (r *renderImageCropper) clamp() {
+	maxScale := r.maxScale
+	if maxScale <= 0 {
+		maxScale = defaultCropMaxScale
+	}
+	minScale := r.minScale()
+	r.scale = min(max(r.scale, minScale), minScale*maxScale)
+	img := r.imageSize()
+	r.origin.X = min(max(r.origin.X, r.area.Right-img.Width*r.scale), r.area.Left)
+	r.origin.Y = min(max(r.origin.Y, r.area.Bottom-img.Height*r.scale), r.area.Top)
+	r.sourceCrop = graphics.RectFromLTWH(
+		(r.area.Left-r.origin.X)/r.scale,
+		(r.area.Top-r.origin.Y)/r.scale,
+		r.area.Width()/r.scale,
+		r.area.Height()/r.scale,
+	)
+}
+
+func (r *renderImageCropper) cropRect() image.Rectangle {
+	if r.rgba == nil || r.sourceCrop.IsEmpty() {
+		return image.Rectangle{}
+	}
+	bounds := r.rgba.Bounds()
+	rect := image.Rect(
+		int(math.Round(r.sourceCrop.Left)),
+		int(math.Round(r.sourceCrop.Top)),
+		int(math.Round(r.sourceCrop.Right)),
+		int(math.Round(r.sourceCrop.Bottom)),
+	).Add(bounds.Min)
+	return rect.Intersect(bounds)
+}
+
+func (r *renderImageCropper) crop() (*image.RGBA, error) {
+	rect := r.cropRect()
+	if rect.Empty() {
+		return nil, errNothingToCrop
+	}
+	out := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+	draw.Draw(out, out.Bounds(), r.rgba, rect.Min, draw.Src)
+	return out, nil
+}
+
+func (r *renderImageCropper) Paint(ctx *layout.PaintContext) {
+	size := r.Size()
+	if size.Width <= 0 || size.Height <= 0 {
+		return
+	}
+	bounds := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRect(bounds)
+	if r.rgba != nil && !r.area.IsEmpty() {
+		img := r.imageSize()
+		src := graphics.RectFromLTWH(0, 0, img.Width, img.Height)
+		dst := graphics.RectFromLTWH(r.origin.X, r.origin.Y, img.Width*r.scale, img.Height*r.scale)
+		ctx.Canvas.DrawImageRect(r.rgba, src, dst, graphics.FilterQualityMedium, r.cacheID)
+	}
+	area := r.areaPath()
+	if r.overlayColor != graphics.ColorTransparent {
+		ctx.Canvas.Save()
+		ctx.Canvas.ClipPath(area, graphics.ClipOpDifference, true)
+		paint := graphics.DefaultPaint()
+		paint.Color = r.overlayColor
+		ctx.Canvas.DrawRect(bounds, paint)
+		ctx.Canvas.Restore()
+	}
+	if r.gridColor != graphics.ColorTransparent {
+		r.paintGrid(ctx, area)
+	}
+	if r.borderColor != graphics.ColorTransparent && r.borderWidth > 0 {
+		paint := graphics.DefaultPaint()
+		paint.Color = r.borderColor
+		paint.Style = graphics.PaintStyleStroke
+		paint.StrokeWidth = r.borderWidth
+		ctx.Canvas.DrawPath(area, paint)
+	}
+	ctx.Canvas.Restore()
+}
+
+// areaPath returns the outline of the crop area.
+func (r *renderImageCropper) areaPath() *graphics.Path {
+	path := graphics.NewPath()
+	if r.oval {
+		radius := graphics.Radius{X: r.area.Width() / 2, Y: r.area.Height() / 2}
+		path.AddRRect(graphics.RRectFromRectAndRadius(r.area, radius))
+	} else {
+		path.AddRect(r.area)
+	}
+	return path
+}
+
+func (r *renderImageCropper) paintGrid(ctx *layout.PaintContext, area *graphics.Path) {
+	paint := graphics.DefaultPaint()
+	paint.Color = r.gridColor
+	paint.Style = graphics.PaintStyleStroke
+	paint.StrokeWidth = 1
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipPath(area, graphics.ClipOpIntersect, true)
+	for i := 1; i <= 2; i++ {
+		x := r.area.Left + r.area.Width()*float64(i)/3
+		y := r.area.Top + r.area.Height()*float64(i)/3
+		ctx.Canvas.DrawLine(graphics.Offset{X: x, Y: r.area.Top}, graphics.Offset{X: x, Y: r.area.Bottom}, paint)
+		ctx.Canvas.DrawLine(graphics.Offset{X: r.area.Left, Y: y}, graphics.Offset{X: r.area.Right, Y: y}, paint)
+	}
+	ctx.Canvas.Restore()
+}
+
+func (r *renderImageCropper) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	result.Add(r)
+	return true
+}
+
+func (r *renderImageCropper) HandlePointer(event gestures.PointerEvent) {
+	if r.gesture == nil {
+		r.gesture = gestures.NewScaleGestureRecognizer(r.GestureArena())
+		r.gesture.OnStart = func(gestures.ScaleStartDetails) {
+			r.startScale = r.scale
+		}
+		r.gesture.OnUpdate = r.onScaleUpdate
+		r.gesture.OnEnd = func(gestures.ScaleEndDetails) {
+			if r.onChanged != nil {
+				r.onChanged(r.cropRect())
+			}
+		}
+	}
+	if event.Phase == gestures.PointerPhaseDown {
+		r.gesture.AddPointer(event)
+	} else {
+		r.gesture.HandleEvent(event)
+	}
+}
+
+// onScaleUpdate zooms around the focal point and pans with it.
+func (r *renderImageCropper) onScaleUpdate(details gestures.ScaleUpdateDetails) {
+	if r.rgba == nil || r.area.IsEmpty() {
+		return
+	}
+	previous := graphics.Offset{
+		X: details.FocalPoint.X - details.FocalDelta.X,
+		Y: details.FocalPoint.Y - details.FocalDelta.Y,
+	}
+	// The image point under the previous focal point follows the pointers.
+	pointX := (previous.X - r.origin.X) / r.scale
+	pointY := (previous.Y - r.origin.Y) / r.scale
+	r.scale = r.startScale * details.Scale
+	maxScale := r.maxScale
+	if maxScale <= 0 {
+		maxScale = defaultCropMaxScale
+	}
+	r.scale = min(max(r.scale, r.minScale()), r.minScale()*maxScale)
+	r.origin = graphics.Offset{
+		X: details.FocalPoint.X - pointX*r.scale,
+		Y: details.FocalPoint.Y - pointY*r.scale,
+	}
+	r.clamp()
+	r.MarkNeedsPaint()
+}
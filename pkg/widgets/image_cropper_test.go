@@ -0,0 +1,138 @@
+package widgets_test
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// cropperSource is 200x100 with a red left half and a blue right half.
+func cropperSource() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, 200, 100))
+	for y := range 100 {
+		for x := range 200 {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 100 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func pumpCropper(t *testing.T, controller *widgets.ImageCropController) *drifttest.WidgetTester {
+	t.Helper()
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 300, Height: 300})
+	tester.PumpWidget(widgets.ImageCropper{
+		Source:     cropperSource(),
+		Controller: controller,
+		MaxScale:   4,
+	})
+	return tester
+}
+
+func TestImageCropper_StartsCenteredAndCovering(t *testing.T) {
+	controller := &widgets.ImageCropController{}
+	pumpCropper(t, controller)
+
+	// A square crop covering a 200x100 image takes its full height.
+	if got, want := controller.CropRect(), image.Rect(50, 0, 150, 100); got != want {
+		t.Fatalf("expected crop %v, got %v", want, got)
+	}
+	cropped, err := controller.Crop()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cropped.Bounds() != image.Rect(0, 0, 100, 100) {
+		t.Errorf("expected a 100x100 image at the origin, got %v", cropped.Bounds())
+	}
+	if cropped.RGBAAt(10, 50).R != 255 || cropped.RGBAAt(90, 50).B != 255 {
+		t.Error("expected the crop to copy the middle of the source")
+	}
+}
+
+func TestImageCropper_PanStaysInsideImage(t *testing.T) {
+	controller := &widgets.ImageCropController{}
+	var changed image.Rectangle
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 300, Height: 300})
+	tester.PumpWidget(widgets.ImageCropper{
+		Source:     cropperSource(),
+		Controller: controller,
+		OnChanged:  func(rect image.Rectangle) { changed = rect },
+	})
+
+	// Dragging the image right moves the crop toward its left edge, and no
+	// further.
+	tester.DragFrom(graphics.Offset{X: 100, Y: 150}, graphics.Offset{X: 1000, Y: 0})
+	if got, want := controller.CropRect(), image.Rect(0, 0, 100, 100); got != want {
+		t.Fatalf("expected the crop clamped to %v, got %v", want, got)
+	}
+	if changed != controller.CropRect() {
+		t.Errorf("expected OnChanged with %v, got %v", controller.CropRect(), changed)
+	}
+
+	controller.Reset()
+	if got, want := controller.CropRect(), image.Rect(50, 0, 150, 100); got != want {
+		t.Errorf("expected Reset to recenter at %v, got %v", want, got)
+	}
+}
+
+func TestImageCropper_PinchZooms(t *testing.T) {
+	controller := &widgets.ImageCropController{}
+	tester := pumpCropper(t, controller)
+
+	tester.SendPointerDown(graphics.Offset{X: 100, Y: 150}, 1)
+	tester.SendPointerDown(graphics.Offset{X: 200, Y: 150}, 2)
+	for step := 1; step <= 5; step++ {
+		spread := float64(step) * 10
+		tester.SendPointerMove(graphics.Offset{X: 100 - spread, Y: 150}, 1)
+		tester.SendPointerMove(graphics.Offset{X: 200 + spread, Y: 150}, 2)
+	}
+	tester.SendPointerUp(graphics.Offset{X: 50, Y: 150}, 1)
+	tester.SendPointerUp(graphics.Offset{X: 250, Y: 150}, 2)
+
+	// Doubling the spread around the center halves the crop, keeping it
+	// near the image's center.
+	got := controller.CropRect()
+	if got.Dx() != 50 || got.Dy() != 50 {
+		t.Fatalf("expected a 50x50 crop at 2x zoom, got %v", got)
+	}
+	if center := got.Min.Add(got.Max).Div(2); abs(center.X-100) > 3 || abs(center.Y-50) > 3 {
+		t.Errorf("expected the crop centered near (100, 50), got %v", center)
+	}
+
+	// Zooming is capped at MaxScale.
+	tester.SendPointerDown(graphics.Offset{X: 140, Y: 150}, 1)
+	tester.SendPointerDown(graphics.Offset{X: 160, Y: 150}, 2)
+	for step := 1; step <= 10; step++ {
+		spread := float64(step) * 14
+		tester.SendPointerMove(graphics.Offset{X: 140 - spread, Y: 150}, 1)
+		tester.SendPointerMove(graphics.Offset{X: 160 + spread, Y: 150}, 2)
+	}
+	tester.SendPointerUp(graphics.Offset{X: 0, Y: 150}, 1)
+	tester.SendPointerUp(graphics.Offset{X: 300, Y: 150}, 2)
+	if got := controller.CropRect(); got.Dx() != 25 || got.Dy() != 25 {
+		t.Errorf("expected a 25x25 crop at 4x zoom, got %v", got)
+	}
+}
+
+func TestImageCropController_CropBeforeLayout(t *testing.T) {
+	controller := &widgets.ImageCropController{}
+	if _, err := controller.Crop(); err == nil {
+		t.Error("expected an error before the cropper is laid out")
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,74 @@
+package widgets
+
+import (
+	"image"
+	"testing"
+)
+
+func TestImage_CacheBytesTrackDecodedPixels(t *testing.T) {
+	before := ImageCacheBytes()
+
+	box := &renderImage{source: image.NewNRGBA(image.Rect(0, 0, 4, 2))}
+	box.SetSelf(box)
+	box.updateImageCache()
+	if got := ImageCacheBytes() - before; got != 4*2*4 {
+		t.Fatalf("expected 32 cached bytes, got %d", got)
+	}
+
+	box.source = image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	box.updateImageCache()
+	if got := ImageCacheBytes() - before; got != 2*2*4 {
+		t.Fatalf("expected 16 cached bytes after source change, got %d", got)
+	}
+
+	box.Dispose()
+	if got := ImageCacheBytes() - before; got != 0 {
+		t.Fatalf("expected cached bytes released on dispose, got %d", got)
+	}
+	box.Dispose()
+	if got := ImageCacheBytes() - before; got != 0 {
+		t.Fatalf("expected second dispose to be a no-op, got %d", got)
+	}
+}
+
+func TestPrecacheImage_HandsPixelsToImage(t *testing.T) {
+	before := ImageCacheBytes()
+	source := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+
+	PrecacheImage(source)
+	PrecacheImage(source)
+	if got := ImageCacheBytes() - before; got != 4*2*4 {
+		t.Fatalf("expected 32 precached bytes, got %d", got)
+	}
+	precached := precachedImages[source]
+
+	box := &renderImage{source: source}
+	box.SetSelf(box)
+	box.updateImageCache()
+	if box.cachedRGBA != precached {
+		t.Fatal("expected the image to use the precached pixels")
+	}
+	if got := ImageCacheBytes() - before; got != 4*2*4 {
+		t.Fatalf("expected precached bytes to move to the image, got %d", got)
+	}
+	if _, ok := precachedImages[source]; ok {
+		t.Fatal("expected the precache entry to be taken")
+	}
+
+	box.Dispose()
+	if got := ImageCacheBytes() - before; got != 0 {
+		t.Fatalf("expected cached bytes released on dispose, got %d", got)
+	}
+}
+
+func TestEvictPrecachedImage(t *testing.T) {
+	before := ImageCacheBytes()
+	source := image.NewGray(image.Rect(0, 0, 3, 3))
+
+	PrecacheImage(source)
+	EvictPrecachedImage(source)
+	EvictPrecachedImage(source)
+	if got := ImageCacheBytes() - before; got != 0 {
+		t.Fatalf("expected evicted bytes released, got %d", got)
+	}
+}
@@ -0,0 +1,183 @@
+package widgets
+
+import "github.com/go-drift/drift/pkg/core"
+
+// AutomaticKeepAlive lets descendants keep a lazily built child mounted
+// after it leaves the build window. Descendants ask for it through a
+// [KeepAliveHandle] or the [KeepAlive] widget; the child counts as kept
+// alive while any of them does.
+//
+// [ListViewBuilder] wraps each item in an AutomaticKeepAlive, so items can
+// hold on to scroll positions, text input, or video playback while they
+// are scrolled out of view. Lists and pagers that build children on demand
+// wrap their children the same way and react to OnKeepAliveChanged.
+type AutomaticKeepAlive struct {
+	core.StatefulBase
+
+	// Child is the subtree that may ask to be kept alive.
+	Child core.Widget
+
+	// OnKeepAliveChanged is called when the child starts or stops asking to
+	// be kept alive.
+	OnKeepAliveChanged func(keepAlive bool)
+
+	// WidgetKey is an optional key for the widget. Lazy lists key each
+	// child by its index so kept-alive children keep their state as the
+	// build window moves.
+	WidgetKey any
+}
+
+func (a AutomaticKeepAlive) Key() any {
+	return a.WidgetKey
+}
+
+func (a AutomaticKeepAlive) CreateState() core.State {
+	return &automaticKeepAliveState{}
+}
+
+type automaticKeepAliveState struct {
+	core.StateBase
+	requests int
+}
+
+func (s *automaticKeepAliveState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(AutomaticKeepAlive)
+	return keepAliveScope{state: s, child: w.Child}
+}
+
+// keepAlive reports whether any descendant currently asks to be kept alive.
+func (s *automaticKeepAliveState) keepAlive() bool {
+	return s.requests > 0
+}
+
+func (s *automaticKeepAliveState) update(delta int) {
+	was := s.keepAlive()
+	s.requests += delta
+	if s.keepAlive() == was || s.Element() == nil {
+		return
+	}
+	if w, ok := s.Element().Widget().(AutomaticKeepAlive); ok && w.OnKeepAliveChanged != nil {
+		w.OnKeepAliveChanged(s.keepAlive())
+	}
+}
+
+// keepAliveScope lets descendants find the enclosing AutomaticKeepAlive.
+type keepAliveScope struct {
+	core.StatelessBase
+	state *automaticKeepAliveState
+	child core.Widget
+}
+
+func (k keepAliveScope) Build(ctx core.BuildContext) core.Widget {
+	return k.child
+}
+
+// KeepAliveHandle asks the nearest [AutomaticKeepAlive] to keep its child
+// mounted. A handle starts out not asking; toggle it with SetKeepAlive and
+// release it when the state that owns it is disposed:
+//
+//	func (s *playerState) InitState() {
+//	    s.keepAlive = widgets.NewKeepAliveHandle(s.Element())
+//	}
+//
+//	func (s *playerState) onPlaybackChanged(playing bool) {
+//	    s.keepAlive.SetKeepAlive(playing)
+//	}
+//
+//	func (s *playerState) Dispose() {
+//	    s.keepAlive.Release()
+//	    s.StateBase.Dispose()
+//	}
+type KeepAliveHandle struct {
+	scope *automaticKeepAliveState
+	keep  bool
+}
+
+// NewKeepAliveHandle returns a handle for the [AutomaticKeepAlive] above
+// ctx. Outside one, the handle does nothing.
+func NewKeepAliveHandle(ctx core.BuildContext) *KeepAliveHandle {
+	handle := &KeepAliveHandle{}
+	if ctx == nil {
+		return handle
+	}
+	element := ctx.FindAncestor(func(e core.Element) bool {
+		_, ok := e.Widget().(keepAliveScope)
+		return ok
+	})
+	if element != nil {
+		handle.scope = element.Widget().(keepAliveScope).state
+	}
+	return handle
+}
+
+// SetKeepAlive starts or stops asking for the child to be kept alive.
+func (h *KeepAliveHandle) SetKeepAlive(keep bool) {
+	if h == nil || h.keep == keep {
+		return
+	}
+	h.keep = keep
+	if h.scope == nil {
+		return
+	}
+	if keep {
+		h.scope.update(1)
+	} else {
+		h.scope.update(-1)
+	}
+}
+
+// KeepAlive reports whether the handle is asking for the child to be kept
+// alive.
+func (h *KeepAliveHandle) KeepAlive() bool {
+	return h != nil && h.keep
+}
+
+// Release stops asking for the child to be kept alive. It is the same as
+// SetKeepAlive(false).
+func (h *KeepAliveHandle) Release() {
+	h.SetKeepAlive(false)
+}
+
+// KeepAlive keeps the enclosing [AutomaticKeepAlive] child mounted while
+// Keep is true. Use it when the decision is part of the widget's
+// configuration; use a [KeepAliveHandle] when it follows state:
+//
+//	widgets.KeepAlive{
+//	    Keep:  draft != "",
+//	    Child: commentEditor,
+//	}
+type KeepAlive struct {
+	core.StatefulBase
+
+	// Keep asks for the child to stay mounted outside the build window.
+	Keep bool
+	// Child is the widget below this one.
+	Child core.Widget
+}
+
+func (k KeepAlive) CreateState() core.State {
+	return &keepAliveState{}
+}
+
+type keepAliveState struct {
+	core.StateBase
+	handle *KeepAliveHandle
+}
+
+func (s *keepAliveState) InitState() {
+	s.handle = NewKeepAliveHandle(s.Element())
+	s.handle.SetKeepAlive(s.Element().Widget().(KeepAlive).Keep)
+}
+
+func (s *keepAliveState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	s.handle.SetKeepAlive(s.Element().Widget().(KeepAlive).Keep)
+}
+
+func (s *keepAliveState) Build(ctx core.BuildContext) core.Widget {
+	return s.Element().Widget().(KeepAlive).Child
+}
+
+func (s *keepAliveState) Dispose() {
+	s.handle.Release()
+	s.StateBase.Dispose()
+}
@@ -0,0 +1,131 @@
+package widgets_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// keepAliveItem is a list item that exposes its keep-alive handle and counts
+// how often it is mounted.
+type keepAliveItem struct {
+	core.StatefulBase
+	index   int
+	handles map[int]*widgets.KeepAliveHandle
+	mounts  map[int]int
+}
+
+func (k keepAliveItem) CreateState() core.State { return &keepAliveItemState{} }
+
+type keepAliveItemState struct {
+	core.StateBase
+}
+
+func (s *keepAliveItemState) InitState() {
+	w := s.Element().Widget().(keepAliveItem)
+	w.handles[w.index] = widgets.NewKeepAliveHandle(s.Element())
+	w.mounts[w.index]++
+}
+
+func (s *keepAliveItemState) Build(ctx core.BuildContext) core.Widget {
+	return widgets.Text{Content: fmt.Sprintf("item %d", s.Element().Widget().(keepAliveItem).index)}
+}
+
+func (s *keepAliveItemState) Dispose() {
+	w := s.Element().Widget().(keepAliveItem)
+	w.handles[w.index].Release()
+	s.StateBase.Dispose()
+}
+
+func TestListViewBuilder_KeepAlive(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	controller := &widgets.ScrollController{}
+	handles := map[int]*widgets.KeepAliveHandle{}
+	mounts := map[int]int{}
+	tester.PumpWidget(widgets.ListViewBuilder{
+		ItemCount:  100,
+		ItemExtent: 50,
+		Controller: controller,
+		ItemBuilder: func(ctx core.BuildContext, index int) core.Widget {
+			return keepAliveItem{index: index, handles: handles, mounts: mounts}
+		},
+	})
+	// The first build has no viewport yet, so let the list settle on its
+	// build window.
+	tester.Pump()
+
+	handles[1].SetKeepAlive(true)
+	controller.JumpTo(2000)
+	tester.Pump()
+
+	if tester.Find(drifttest.ByText("item 1")).FirstOrNil() == nil {
+		t.Fatal("expected the kept-alive item to stay mounted")
+	}
+	if tester.Find(drifttest.ByText("item 2")).FirstOrNil() != nil {
+		t.Error("expected items outside the build window to be unmounted")
+	}
+	if tester.Find(drifttest.ByText("item 45")).FirstOrNil() == nil {
+		t.Error("expected the visible items to be built")
+	}
+
+	// Scrolling back reuses the kept-alive item.
+	controller.JumpTo(0)
+	tester.Pump()
+	if got := mounts[1]; got != 1 {
+		t.Errorf("expected item 1 to be mounted once, got %d", got)
+	}
+	if got := mounts[2]; got != 2 {
+		t.Errorf("expected item 2 to be mounted again, got %d", got)
+	}
+
+	// Releasing the handle lets the item go once it is out of view.
+	controller.JumpTo(2000)
+	tester.Pump()
+	handles[1].Release()
+	tester.Pump()
+	if tester.Find(drifttest.ByText("item 1")).FirstOrNil() != nil {
+		t.Error("expected the released item to be unmounted")
+	}
+}
+
+func TestKeepAlive_Widget(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var changes []bool
+	var setStep func(func(int) int)
+	tester.PumpWidget(core.Stateful(
+		func() int { return 0 },
+		func(step int, ctx core.BuildContext, setState func(func(int) int)) core.Widget {
+			setStep = setState
+			var children []core.Widget
+			if step < 2 {
+				children = []core.Widget{
+					widgets.KeepAlive{Keep: step == 0, Child: widgets.Text{Content: "a"}},
+					widgets.KeepAlive{Keep: true, Child: widgets.Text{Content: "b"}},
+				}
+			}
+			return widgets.AutomaticKeepAlive{
+				OnKeepAliveChanged: func(keepAlive bool) { changes = append(changes, keepAlive) },
+				Child:              widgets.Column{Children: children},
+			}
+		},
+	))
+	for range 2 {
+		setStep(func(step int) int { return step + 1 })
+		tester.Pump()
+	}
+
+	// Requests are counted, so only the first and last changes report.
+	if fmt.Sprint(changes) != "[true false]" {
+		t.Errorf("expected [true false], got %v", changes)
+	}
+
+	// Outside an AutomaticKeepAlive the handle does nothing.
+	handle := widgets.NewKeepAliveHandle(nil)
+	handle.SetKeepAlive(true)
+	if !handle.KeepAlive() {
+		t.Error("expected a detached handle to still report its request")
+	}
+}
@@ -648,8 +648,10 @@ func (c *mockCanvas) DrawRect(rect graphics.Rect, paint graphics.Paint)
 func (c *mockCanvas) DrawRRect(rect graphics.RRect, paint graphics.Paint)        {}
 func (c *mockCanvas) DrawCircle(center graphics.Offset, radius float64, paint graphics.Paint) {
 }
-func (c *mockCanvas) DrawLine(p1, p2 graphics.Offset, paint graphics.Paint)          {}
-func (c *mockCanvas) DrawPath(path *graphics.Path, paint graphics.Paint)             {}
+func (c *mockCanvas) DrawLine(p1, p2 graphics.Offset, paint graphics.Paint) {}
+func (c *mockCanvas) DrawPath(path *graphics.Path, paint graphics.Paint)    {}
+func (c *mockCanvas) DrawVertices(vertices *graphics.Vertices, mode graphics.BlendMode, paint graphics.Paint) {
+}
 func (c *mockCanvas) DrawText(layout *graphics.TextLayout, position graphics.Offset) {}
 func (c *mockCanvas) DrawImage(img image.Image, position graphics.Offset)            {}
 func (c *mockCanvas) DrawImageRect(img image.Image, src, dst graphics.Rect, q graphics.FilterQuality, key uintptr) {
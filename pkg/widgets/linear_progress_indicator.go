@@ -71,6 +71,9 @@ type linearProgressState struct {
 func (s *linearProgressState) InitState() {
 	s.controller = animation.NewAnimationController(1500 * time.Millisecond)
 	s.controller.Curve = animation.LinearCurve
+	// Keep moving when animations are disabled: the motion shows that work
+	// is in progress, and the restart on completion would otherwise loop.
+	s.controller.Behavior = animation.AnimationBehaviorPreserve
 	core.UseDisposable(s, s.controller)
 	core.UseListenable(s, s.controller)
 	s.controller.AddStatusListener(func(status animation.AnimationStatus) {
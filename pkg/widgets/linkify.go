@@ -0,0 +1,276 @@
+package widgets
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// LinkKind identifies what a detected [Link] refers to.
+type LinkKind int
+
+const (
+	// LinkKindURL is a web address such as "https://go.dev" or "go.dev/doc".
+	LinkKindURL LinkKind = iota
+	// LinkKindEmail is an email address.
+	LinkKindEmail
+	// LinkKindPhone is a phone number.
+	LinkKindPhone
+	// LinkKindMention is an @mention such as "@gopher".
+	LinkKindMention
+	// LinkKindHashtag is a #hashtag such as "#golang".
+	LinkKindHashtag
+)
+
+// String returns a human-readable representation of the link kind.
+func (k LinkKind) String() string {
+	switch k {
+	case LinkKindURL:
+		return "url"
+	case LinkKindEmail:
+		return "email"
+	case LinkKindPhone:
+		return "phone"
+	case LinkKindMention:
+		return "mention"
+	case LinkKindHashtag:
+		return "hashtag"
+	default:
+		return fmt.Sprintf("LinkKind(%d)", int(k))
+	}
+}
+
+// Link is a match found in text by a [LinkMatcher].
+type Link struct {
+	Kind LinkKind
+	// Text is the matched text as it appears in the source.
+	Text string
+	// URL is the target to open, such as "mailto:a@b.co". It is empty for
+	// matchers without a URL function.
+	URL string
+	// Start and End are the byte offsets of the match in the source text.
+	Start, End int
+}
+
+// LinkMatcher detects one kind of link in text.
+type LinkMatcher struct {
+	Kind LinkKind
+	// Pattern finds candidate matches. If it has a capture group, the first
+	// group is the link and the rest of the match is context.
+	Pattern *regexp.Regexp
+	// URL converts the matched text into the URL to open. Nil leaves
+	// [Link.URL] empty, so taps only reach a custom handler.
+	URL func(text string) string
+}
+
+var (
+	urlPattern     = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"]+|\b[a-z0-9][a-z0-9-]*(?:\.[a-z0-9-]+)*\.(?:com|org|net|dev|io|app|edu|gov)\b(?:/[^\s<>"]*)?`)
+	emailPattern   = regexp.MustCompile(`(?i)\b[a-z0-9._%+-]+@[a-z0-9-]+(?:\.[a-z0-9-]+)*\.[a-z]{2,}\b`)
+	phonePattern   = regexp.MustCompile(`(?:^|[^\w+])(\+?\d[\d\s().-]{5,}\d)`)
+	mentionPattern = regexp.MustCompile(`(?:^|[^\w@])(@\w+)`)
+	hashtagPattern = regexp.MustCompile(`(?:^|[^\w#&])(#\w*[\pL_]\w*)`)
+)
+
+// URLMatcher matches web addresses. Addresses without a scheme open with
+// https.
+func URLMatcher() LinkMatcher {
+	return LinkMatcher{Kind: LinkKindURL, Pattern: urlPattern, URL: func(text string) string {
+		text = strings.TrimRight(text, ".,;:!?)'")
+		if !strings.Contains(text, "://") {
+			return "https://" + text
+		}
+		return text
+	}}
+}
+
+// EmailMatcher matches email addresses and opens them with mailto.
+func EmailMatcher() LinkMatcher {
+	return LinkMatcher{Kind: LinkKindEmail, Pattern: emailPattern, URL: func(text string) string {
+		return "mailto:" + text
+	}}
+}
+
+// PhoneMatcher matches phone numbers of at least seven digits and opens
+// them with tel.
+func PhoneMatcher() LinkMatcher {
+	return LinkMatcher{Kind: LinkKindPhone, Pattern: phonePattern, URL: func(text string) string {
+		digits := strings.Map(func(r rune) rune {
+			if r == '+' || (r >= '0' && r <= '9') {
+				return r
+			}
+			return -1
+		}, text)
+		if len(strings.TrimPrefix(digits, "+")) < 7 {
+			return ""
+		}
+		return "tel:" + digits
+	}}
+}
+
+// MentionMatcher matches @mentions. urlFor converts the handle, without the
+// "@", into a URL; pass nil to handle taps only in [Linkify.OnTap].
+func MentionMatcher(urlFor func(handle string) string) LinkMatcher {
+	m := LinkMatcher{Kind: LinkKindMention, Pattern: mentionPattern}
+	if urlFor != nil {
+		m.URL = func(text string) string { return urlFor(strings.TrimPrefix(text, "@")) }
+	}
+	return m
+}
+
+// HashtagMatcher matches #hashtags. urlFor converts the tag, without the
+// "#", into a URL; pass nil to handle taps only in [Linkify.OnTap].
+func HashtagMatcher(urlFor func(tag string) string) LinkMatcher {
+	m := LinkMatcher{Kind: LinkKindHashtag, Pattern: hashtagPattern}
+	if urlFor != nil {
+		m.URL = func(text string) string { return urlFor(strings.TrimPrefix(text, "#")) }
+	}
+	return m
+}
+
+// DefaultLinkMatchers returns the matchers [Linkify] uses when none are
+// given: URLs, email addresses, and phone numbers.
+func DefaultLinkMatchers() []LinkMatcher {
+	return []LinkMatcher{EmailMatcher(), URLMatcher(), PhoneMatcher()}
+}
+
+// FindLinks returns the non-overlapping links that matchers find in text,
+// ordered by position. Where matches overlap, the one starting first wins,
+// then the earlier matcher.
+func FindLinks(text string, matchers []LinkMatcher) []Link {
+	var found []Link
+	for _, m := range matchers {
+		if m.Pattern == nil {
+			continue
+		}
+		for _, loc := range m.Pattern.FindAllStringSubmatchIndex(text, -1) {
+			start, end := loc[0], loc[1]
+			if len(loc) >= 4 && loc[2] >= 0 {
+				start, end = loc[2], loc[3]
+			}
+			link := Link{Kind: m.Kind, Start: start, End: end}
+			if m.Kind == LinkKindURL {
+				// Trailing punctuation usually ends the sentence, not the URL.
+				link.End = start + len(strings.TrimRight(text[start:end], ".,;:!?)'"))
+			}
+			link.Text = text[link.Start:link.End]
+			if m.URL != nil {
+				link.URL = m.URL(link.Text)
+				if link.URL == "" {
+					continue
+				}
+			}
+			found = append(found, link)
+		}
+	}
+	// Stable sort keeps matcher order for links that start together.
+	slices.SortStableFunc(found, func(a, b Link) int { return a.Start - b.Start })
+
+	links := found[:0]
+	end := 0
+	for _, link := range found {
+		if link.Start < end {
+			continue
+		}
+		links = append(links, link)
+		end = link.End
+	}
+	return links
+}
+
+// Linkify displays text with URLs, email addresses, phone numbers, and
+// optionally @mentions and #hashtags rendered as tappable links:
+//
+//	widgets.Linkify{
+//	    Text:  "Mail support@example.com or visit example.com",
+//	    Style: graphics.SpanStyle{Color: colors.OnSurface, FontSize: 16},
+//	    LinkStyle: graphics.SpanStyle{Color: colors.Primary},
+//	}
+//
+// By default a tap opens the link's URL with [platform.URLLauncher]. Set
+// OnTap to route taps elsewhere, for example to navigate to a profile page
+// for a mention.
+type Linkify struct {
+	core.StatelessBase
+	// Text is the plain text to scan for links.
+	Text string
+	// Style is the style of the whole text.
+	Style graphics.SpanStyle
+	// LinkStyle overrides Style for links. The zero value underlines links.
+	LinkStyle graphics.SpanStyle
+	// Matchers detect links. Nil uses [DefaultLinkMatchers].
+	Matchers []LinkMatcher
+	// OnTap is called when a link is tapped. Nil opens the link's URL.
+	OnTap    func(Link)
+	Align    graphics.TextAlign
+	MaxLines int
+}
+
+func (l Linkify) Build(ctx core.BuildContext) core.Widget {
+	matchers := l.Matchers
+	if matchers == nil {
+		matchers = DefaultLinkMatchers()
+	}
+	linkStyle := l.LinkStyle
+	if linkStyle == (graphics.SpanStyle{}) {
+		linkStyle.Decoration = graphics.TextDecorationUnderline
+	}
+	onTap := l.OnTap
+	if onTap == nil {
+		onTap = openLink
+	}
+
+	links := FindLinks(l.Text, matchers)
+	content := graphics.TextSpan{}
+	targets := make([]TextTapTarget, 0, len(links))
+	last := 0
+	for _, link := range links {
+		if link.Start > last {
+			content.Children = append(content.Children, graphics.TextSpan{Text: l.Text[last:link.Start]})
+		}
+		content.Children = append(content.Children, graphics.TextSpan{Text: link.Text, Style: linkStyle})
+		targets = append(targets, TextTapTarget{
+			Start: link.Start,
+			End:   link.End,
+			OnTap: func() { onTap(link) },
+		})
+		last = link.End
+	}
+	if last < len(l.Text) || len(links) == 0 {
+		content.Children = append(content.Children, graphics.TextSpan{Text: l.Text[last:]})
+	}
+
+	return RichText{
+		Content:    content,
+		Style:      l.Style,
+		Align:      l.Align,
+		MaxLines:   l.MaxLines,
+		TapTargets: targets,
+	}
+}
+
+// openLink opens link.URL in the background so a slow platform call does
+// not block the UI thread.
+func openLink(link Link) {
+	if link.URL == "" {
+		return
+	}
+	if _, err := url.Parse(link.URL); err != nil {
+		return
+	}
+	go func() {
+		if err := platform.URLLauncher.OpenURL(link.URL); err != nil {
+			errors.Report(&errors.DriftError{
+				Op:   "widgets.Linkify",
+				Kind: errors.KindPlatform,
+				Err:  err,
+			})
+		}
+	}()
+}
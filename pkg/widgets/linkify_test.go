@@ -0,0 +1,75 @@
+package widgets
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindLinks_DefaultMatchers(t *testing.T) {
+	text := "Mail support@example.com, visit https://go.dev/doc. or call +1 (555) 010-9999!"
+	links := FindLinks(text, DefaultLinkMatchers())
+
+	type got struct {
+		kind LinkKind
+		text string
+		url  string
+	}
+	var results []got
+	for _, l := range links {
+		if text[l.Start:l.End] != l.Text {
+			t.Errorf("link %q has range [%d,%d) = %q", l.Text, l.Start, l.End, text[l.Start:l.End])
+		}
+		results = append(results, got{l.Kind, l.Text, l.URL})
+	}
+	want := []got{
+		{LinkKindEmail, "support@example.com", "mailto:support@example.com"},
+		{LinkKindURL, "https://go.dev/doc", "https://go.dev/doc"},
+		{LinkKindPhone, "+1 (555) 010-9999", "tel:+15550109999"},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("links = %+v, want %+v", results, want)
+	}
+}
+
+func TestFindLinks_MentionsAndHashtags(t *testing.T) {
+	matchers := []LinkMatcher{
+		MentionMatcher(func(handle string) string { return "app://users/" + handle }),
+		HashtagMatcher(nil),
+		URLMatcher(),
+	}
+	links := FindLinks("Thanks @gopher for #golang tips at go.dev, not a@b or #1", matchers)
+
+	var texts, urls []string
+	for _, l := range links {
+		texts = append(texts, l.Text)
+		urls = append(urls, l.URL)
+	}
+	if want := []string{"@gopher", "#golang", "go.dev"}; !reflect.DeepEqual(texts, want) {
+		t.Errorf("texts = %q, want %q", texts, want)
+	}
+	if want := []string{"app://users/gopher", "", "https://go.dev"}; !reflect.DeepEqual(urls, want) {
+		t.Errorf("urls = %q, want %q", urls, want)
+	}
+}
+
+func TestLinkify_BuildsTapTargets(t *testing.T) {
+	var tapped []Link
+	l := Linkify{
+		Text:  "see go.dev now",
+		OnTap: func(link Link) { tapped = append(tapped, link) },
+	}
+	rt, ok := l.Build(nil).(RichText)
+	if !ok {
+		t.Fatalf("expected RichText, got %T", l.Build(nil))
+	}
+	if got := rt.Content.PlainText(); got != l.Text {
+		t.Errorf("plain text = %q, want %q", got, l.Text)
+	}
+	if len(rt.TapTargets) != 1 || rt.TapTargets[0].Start != 4 || rt.TapTargets[0].End != 10 {
+		t.Fatalf("targets = %+v", rt.TapTargets)
+	}
+	rt.TapTargets[0].OnTap()
+	if len(tapped) != 1 || tapped[0].URL != "https://go.dev" {
+		t.Errorf("tapped = %+v", tapped)
+	}
+}
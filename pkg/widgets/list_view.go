@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"math"
+	"slices"
 
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/layout"
@@ -43,6 +44,9 @@ type ListView struct {
 	MainAxisAlignment MainAxisAlignment
 	// MainAxisSize determines how much space the list takes along the scroll axis.
 	MainAxisSize MainAxisSize
+	// StorageKey saves the scroll offset in the nearest [PageStorage] and
+	// restores it when the list is recreated. See [ScrollView.StorageKey].
+	StorageKey any
 }
 
 // ListViewBuilder builds list items on demand for efficient scrolling of large lists.
@@ -60,6 +64,11 @@ type ListView struct {
 // CacheExtent controls how many pixels beyond the visible area are pre-built,
 // reducing flicker during fast scrolling.
 //
+// Items outside the build window are unmounted, losing their state. An item
+// that should survive scrolling out of view, such as one holding a focused
+// text field or a playing video, can stay mounted with [KeepAlive] or a
+// [KeepAliveHandle]. Focused text inputs do this automatically.
+//
 // Example:
 //
 //	ListViewBuilder{
@@ -93,6 +102,9 @@ type ListViewBuilder struct {
 	MainAxisAlignment MainAxisAlignment
 	// MainAxisSize determines how much space the list takes along the scroll axis.
 	MainAxisSize MainAxisSize
+	// StorageKey saves the scroll offset in the nearest [PageStorage] and
+	// restores it when the list is recreated. See [ScrollView.StorageKey].
+	StorageKey any
 }
 
 func (l ListView) Build(ctx core.BuildContext) core.Widget {
@@ -106,6 +118,7 @@ func (l ListView) Build(ctx core.BuildContext) core.Widget {
 		ScrollDirection: l.ScrollDirection,
 		Controller:      l.Controller,
 		Physics:         l.Physics,
+		StorageKey:      l.StorageKey,
 	}
 }
 
@@ -134,6 +147,7 @@ type listViewBuilderState struct {
 	removeListener func()
 	visibleStart   int
 	visibleEnd     int
+	keepAlive      map[int]bool // items asking to stay mounted
 }
 
 func (s *listViewBuilderState) InitState() {
@@ -154,25 +168,36 @@ func (s *listViewBuilderState) Build(ctx core.BuildContext) core.Widget {
 	if !ok {
 		return nil
 	}
+	// Without its own controller, a vertical list follows the primary
+	// controller so app bars can react to it. The ScrollView below resolves
+	// the same controller and hides it from nested scrollables.
+	controller := s.controller
+	if widgetValue.Controller == nil && widgetValue.ScrollDirection == AxisVertical {
+		if primary := PrimaryScrollControllerOf(ctx); primary != nil {
+			if primary != s.controller {
+				s.detachListener()
+				s.controller = primary
+			}
+			controller = nil
+		}
+	}
 	s.attachListener(widgetValue)
 	s.updateVisibleRange(widgetValue)
-	children := widgetValue.buildChildren(ctx, s.controller, s.visibleStart, s.visibleEnd)
+	children := widgetValue.buildChildren(ctx, s.controller, s.visibleStart, s.visibleEnd, s.keepAlive, s.setKeepAlive)
 	return ListView{
 		Children:          children,
 		ScrollDirection:   widgetValue.ScrollDirection,
-		Controller:        s.controller,
+		Controller:        controller,
 		Physics:           widgetValue.Physics,
 		Padding:           widgetValue.Padding,
 		MainAxisAlignment: widgetValue.MainAxisAlignment,
 		MainAxisSize:      widgetValue.MainAxisSize,
+		StorageKey:        widgetValue.StorageKey,
 	}
 }
 
 func (s *listViewBuilderState) Dispose() {
-	if s.removeListener != nil {
-		s.removeListener()
-		s.removeListener = nil
-	}
+	s.detachListener()
 	s.StateBase.Dispose()
 }
 
@@ -186,10 +211,7 @@ func (s *listViewBuilderState) DidUpdateWidget(oldWidget core.StatefulWidget) {
 		return
 	}
 	if oldList.Controller != current.Controller {
-		if s.removeListener != nil {
-			s.removeListener()
-			s.removeListener = nil
-		}
+		s.detachListener()
 		s.controller = current.Controller
 		if s.controller == nil {
 			s.controller = &ScrollController{}
@@ -216,6 +238,13 @@ func (s *listViewBuilderState) attachListener(_ ListViewBuilder) {
 	})
 }
 
+func (s *listViewBuilderState) detachListener() {
+	if s.removeListener != nil {
+		s.removeListener()
+		s.removeListener = nil
+	}
+}
+
 func (s *listViewBuilderState) onScroll() {
 	widgetValue, ok := s.currentWidget()
 	if !ok {
@@ -238,43 +267,101 @@ func (s *listViewBuilderState) updateVisibleRange(widgetValue ListViewBuilder) b
 	return true
 }
 
-func (l ListViewBuilder) buildChildren(ctx core.BuildContext, controller *ScrollController, start, end int) []core.Widget {
+// setKeepAlive records whether the item at index asks to stay mounted and
+// rebuilds when that changes which items are built.
+func (s *listViewBuilderState) setKeepAlive(index int, keep bool) {
+	if s.keepAlive[index] == keep {
+		return
+	}
+	if keep {
+		if s.keepAlive == nil {
+			s.keepAlive = make(map[int]bool)
+		}
+		s.keepAlive[index] = true
+	} else {
+		delete(s.keepAlive, index)
+	}
+	widgetValue, ok := s.currentWidget()
+	if !ok || index >= widgetValue.ItemCount {
+		return
+	}
+	if index < s.visibleStart || index >= s.visibleEnd {
+		s.Element().MarkNeedsBuild()
+	}
+}
+
+// listItemKey identifies a virtualized item so its element follows the
+// item as the build window moves.
+type listItemKey int
+
+// buildChildren builds the items in [start, end) and the kept-alive items
+// outside it, with spacers standing in for the items that aren't built.
+func (l ListViewBuilder) buildChildren(ctx core.BuildContext, controller *ScrollController, start, end int, keepAlive map[int]bool, setKeepAlive func(index int, keep bool)) []core.Widget {
 	if l.ItemBuilder == nil || l.ItemCount <= 0 {
 		return nil
 	}
 	if l.ItemExtent <= 0 || controller == nil || controller.ViewportExtent() <= 0 {
-		return l.buildAllChildren(ctx)
+		return l.buildAllChildren(ctx, setKeepAlive)
 	}
-	children := make([]core.Widget, 0, end-start+2)
-	if start > 0 {
-		children = append(children, l.buildSpacer(float64(start)*l.ItemExtent))
+	indexes := make([]int, 0, end-start+len(keepAlive))
+	for i := range keepAlive {
+		if i < start && i >= 0 {
+			indexes = append(indexes, i)
+		}
 	}
+	slices.Sort(indexes)
 	for i := start; i < end; i++ {
-		child := l.ItemBuilder(ctx, i)
-		children = append(children, l.wrapItem(child))
+		indexes = append(indexes, i)
 	}
-	trailing := l.ItemCount - end
-	if trailing > 0 {
+	trailingKept := len(indexes)
+	for i := range keepAlive {
+		if i >= end && i < l.ItemCount {
+			indexes = append(indexes, i)
+		}
+	}
+	slices.Sort(indexes[trailingKept:])
+
+	children := make([]core.Widget, 0, 2*len(indexes)+1)
+	next := 0
+	for _, i := range indexes {
+		if i > next {
+			children = append(children, l.buildSpacer(float64(i-next)*l.ItemExtent))
+		}
+		children = append(children, l.keyedItem(i, l.wrapItem(l.ItemBuilder(ctx, i)), setKeepAlive))
+		next = i + 1
+	}
+	if trailing := l.ItemCount - next; trailing > 0 {
 		children = append(children, l.buildSpacer(float64(trailing)*l.ItemExtent))
 	}
 	return children
 }
 
-func (l ListViewBuilder) buildAllChildren(ctx core.BuildContext) []core.Widget {
+func (l ListViewBuilder) buildAllChildren(ctx core.BuildContext, setKeepAlive func(index int, keep bool)) []core.Widget {
 	children := make([]core.Widget, 0, l.ItemCount)
 	for i := 0; i < l.ItemCount; i++ {
 		child := l.ItemBuilder(ctx, i)
-		if l.ItemExtent > 0 {
-			children = append(children, l.wrapItem(child))
+		if l.ItemExtent <= 0 && child == nil {
 			continue
 		}
-		if child != nil {
-			children = append(children, child)
-		}
+		children = append(children, l.keyedItem(i, l.wrapItem(child), setKeepAlive))
 	}
 	return children
 }
 
+// keyedItem wraps the item at index so it can ask to be kept alive and
+// keeps its state as the build window moves.
+func (l ListViewBuilder) keyedItem(index int, child core.Widget, setKeepAlive func(index int, keep bool)) core.Widget {
+	return AutomaticKeepAlive{
+		WidgetKey: listItemKey(index),
+		OnKeepAliveChanged: func(keep bool) {
+			if setKeepAlive != nil {
+				setKeepAlive(index, keep)
+			}
+		},
+		Child: child,
+	}
+}
+
 func (l ListViewBuilder) wrapItem(child core.Widget) core.Widget {
 	if l.ItemExtent <= 0 {
 		return child
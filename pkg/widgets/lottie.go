@@ -142,6 +142,9 @@ func (s *lottieState) InitState() {
 		if dur > 0 {
 			s.ownController = animation.NewAnimationController(dur)
 			s.ownController.Curve = animation.LinearCurve
+			// The animation is the content, so it plays even when
+			// animations are disabled.
+			s.ownController.Behavior = animation.AnimationBehaviorPreserve
 			core.UseDisposable(s, s.ownController)
 			s.ownController.AddStatusListener(func(status animation.AnimationStatus) {
 				s.onStatus(status)
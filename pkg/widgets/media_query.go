@@ -0,0 +1,233 @@
+package widgets
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// MediaQueryData describes the system display settings that widgets may
+// adapt to.
+type MediaQueryData struct {
+	// DarkMode reports that the platform is using a dark color scheme.
+	DarkMode bool
+	// HighContrast reports that the user asked for increased contrast.
+	HighContrast bool
+	// TextScaleFactor multiplies font sizes to follow the user's system font
+	// size setting, such as 1.3 for 130%. Zero means 1. Read it with
+	// [TextScaleFactorOf], which resolves zero and registers a narrower
+	// dependency.
+	TextScaleFactor float64
+	// ReduceMotion reports that the user asked for less motion. Animation
+	// controllers already skip to their end state while it is set (see
+	// [animation.SetAnimationsDisabled]); read it with [ReduceMotionOf] to
+	// pick a calmer alternative, such as a fade instead of a slide.
+	ReduceMotion bool
+}
+
+// MediaQueryAspect identifies which part of [MediaQueryData] a widget depends
+// on, so it only rebuilds when that part changes.
+type MediaQueryAspect int
+
+const (
+	MediaQueryAspectDarkMode MediaQueryAspect = iota
+	MediaQueryAspectHighContrast
+	MediaQueryAspectTextScale
+	MediaQueryAspectReduceMotion
+)
+
+// MediaQuery provides [MediaQueryData] to descendants. The engine inserts one
+// above the app's root widget, driven by [platform.Appearance]; insert your
+// own to replace the values for a subtree, for example in tests, or use
+// [MediaQueryOverride] to adjust individual values.
+//
+// It implements [core.AspectAwareInheritedWidget], so widgets that depend on
+// a single [MediaQueryAspect] only rebuild when that value changes.
+type MediaQuery struct {
+	core.InheritedBase
+	Data  MediaQueryData
+	Child core.Widget
+}
+
+func (m MediaQuery) ChildWidget() core.Widget { return m.Child }
+
+func (m MediaQuery) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(MediaQuery); ok {
+		return m.Data != old.Data
+	}
+	return true
+}
+
+func (m MediaQuery) ShouldRebuildDependent(oldWidget core.InheritedWidget, aspects map[any]struct{}) bool {
+	old, ok := oldWidget.(MediaQuery)
+	if !ok {
+		return true
+	}
+	for aspect := range aspects {
+		switch aspect.(MediaQueryAspect) {
+		case MediaQueryAspectDarkMode:
+			if m.Data.DarkMode != old.Data.DarkMode {
+				return true
+			}
+		case MediaQueryAspectHighContrast:
+			if m.Data.HighContrast != old.Data.HighContrast {
+				return true
+			}
+		case MediaQueryAspectTextScale:
+			if m.Data.TextScaleFactor != old.Data.TextScaleFactor {
+				return true
+			}
+		case MediaQueryAspectReduceMotion:
+			if m.Data.ReduceMotion != old.Data.ReduceMotion {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var mediaQueryType = reflect.TypeFor[MediaQuery]()
+
+// MediaQueryOf returns the nearest MediaQueryData, or the zero value (light,
+// normal contrast) if there is no MediaQuery ancestor. Widgets calling this
+// rebuild when the data changes.
+func MediaQueryOf(ctx core.BuildContext) MediaQueryData {
+	if m, ok := ctx.DependOnInherited(mediaQueryType, nil).(MediaQuery); ok {
+		return m.Data
+	}
+	return MediaQueryData{}
+}
+
+// DarkModeOf reports whether the nearest MediaQuery uses a dark color scheme.
+// Widgets calling this rebuild only when that setting changes.
+func DarkModeOf(ctx core.BuildContext) bool {
+	if m, ok := ctx.DependOnInherited(mediaQueryType, MediaQueryAspectDarkMode).(MediaQuery); ok {
+		return m.Data.DarkMode
+	}
+	return false
+}
+
+// HighContrastOf reports whether the nearest MediaQuery asks for increased
+// contrast. Widgets calling this rebuild only when that setting changes.
+func HighContrastOf(ctx core.BuildContext) bool {
+	if m, ok := ctx.DependOnInherited(mediaQueryType, MediaQueryAspectHighContrast).(MediaQuery); ok {
+		return m.Data.HighContrast
+	}
+	return false
+}
+
+// TextScaleFactorOf returns the text scale factor of the nearest MediaQuery,
+// or 1 if there is none or it is unset. Widgets calling this rebuild only when
+// the text scale factor changes.
+func TextScaleFactorOf(ctx core.BuildContext) float64 {
+	if m, ok := ctx.DependOnInherited(mediaQueryType, MediaQueryAspectTextScale).(MediaQuery); ok && m.Data.TextScaleFactor > 0 {
+		return m.Data.TextScaleFactor
+	}
+	return 1
+}
+
+// ReduceMotionOf reports whether the nearest MediaQuery asks for reduced
+// motion. Widgets calling this rebuild only when that setting changes.
+func ReduceMotionOf(ctx core.BuildContext) bool {
+	if m, ok := ctx.DependOnInherited(mediaQueryType, MediaQueryAspectReduceMotion).(MediaQuery); ok {
+		return m.Data.ReduceMotion
+	}
+	return false
+}
+
+// MediaQueryOverride adjusts the [MediaQueryData] seen by its subtree,
+// starting from the nearest MediaQuery.
+//
+// Use it to keep dense layouts usable at large font sizes by bounding the
+// text scale factor, or to opt a subtree out of text scaling entirely:
+//
+//	// Allow scaling up to 1.5x in a toolbar.
+//	widgets.MediaQueryOverride{MaxTextScaleFactor: 1.5, Child: toolbar}
+//
+//	// Draw a logo at its design size regardless of the system setting.
+//	widgets.MediaQueryOverride{TextScaleFactor: 1, Child: logo}
+//
+// Prefer bounding the scale factor to disabling it: users who raise their
+// font size rely on it to read the app.
+type MediaQueryOverride struct {
+	core.StatelessBase
+
+	// TextScaleFactor replaces the inherited text scale factor.
+	// Zero keeps the inherited value.
+	TextScaleFactor float64
+	// MinTextScaleFactor is the smallest text scale factor descendants see.
+	// Zero means no lower bound.
+	MinTextScaleFactor float64
+	// MaxTextScaleFactor is the largest text scale factor descendants see.
+	// Zero means no upper bound.
+	MaxTextScaleFactor float64
+	// Child is the subtree that sees the adjusted data.
+	Child core.Widget
+}
+
+func (m MediaQueryOverride) Build(ctx core.BuildContext) core.Widget {
+	data := MediaQueryOf(ctx)
+	scale := data.TextScaleFactor
+	if scale <= 0 {
+		scale = 1
+	}
+	if m.TextScaleFactor > 0 {
+		scale = m.TextScaleFactor
+	}
+	if m.MinTextScaleFactor > 0 {
+		scale = max(scale, m.MinTextScaleFactor)
+	}
+	if m.MaxTextScaleFactor > 0 {
+		scale = min(scale, m.MaxTextScaleFactor)
+	}
+	data.TextScaleFactor = scale
+	return MediaQuery{Data: data, Child: m.Child}
+}
+
+// MediaQueryProvider subscribes to platform appearance changes and provides
+// a [MediaQuery] to descendants, so only widgets that read it rebuild.
+type MediaQueryProvider struct {
+	core.StatefulBase
+
+	Child core.Widget
+}
+
+func (m MediaQueryProvider) CreateState() core.State {
+	return &mediaQueryProviderState{}
+}
+
+type mediaQueryProviderState struct {
+	core.StateBase
+	data MediaQueryData
+}
+
+func (s *mediaQueryProviderState) InitState() {
+	s.data = mediaQueryDataFrom(platform.Appearance.Current())
+	s.OnDispose(platform.Appearance.AddListener(s.onAppearanceChanged))
+}
+
+func (s *mediaQueryProviderState) onAppearanceChanged() {
+	data := mediaQueryDataFrom(platform.Appearance.Current())
+	if data == s.data {
+		return
+	}
+	s.SetState(func() { s.data = data })
+}
+
+func (s *mediaQueryProviderState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(MediaQueryProvider)
+	return MediaQuery{
+		Data:  s.data,
+		Child: w.Child,
+	}
+}
+
+func mediaQueryDataFrom(appearance platform.SystemAppearance) MediaQueryData {
+	return MediaQueryData{
+		DarkMode:        appearance.DarkMode,
+		HighContrast:    appearance.HighContrast,
+		TextScaleFactor: appearance.TextScaleFactor,
+		ReduceMotion:    appearance.ReduceMotion,
+	}
+}
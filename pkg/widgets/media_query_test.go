@@ -0,0 +1,116 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// textScaleProbe records the text scale factor visible at its position.
+type textScaleProbe struct {
+	core.StatelessBase
+	seen *float64
+}
+
+func (p textScaleProbe) Build(ctx core.BuildContext) core.Widget {
+	*p.seen = widgets.TextScaleFactorOf(ctx)
+	return widgets.SizedBox{}
+}
+
+func TestTextScaleFactorOf_DefaultsToOne(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	var scale float64
+	if err := tester.PumpWidget(widgets.MediaQuery{
+		Data:  widgets.MediaQueryData{DarkMode: true},
+		Child: textScaleProbe{seen: &scale},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if scale != 1 {
+		t.Errorf("unset text scale factor: got %v, want 1", scale)
+	}
+}
+
+// appearanceProbe records the dark mode and high contrast settings visible
+// at its position.
+type appearanceProbe struct {
+	core.StatelessBase
+	dark, highContrast *bool
+}
+
+func (p appearanceProbe) Build(ctx core.BuildContext) core.Widget {
+	*p.dark = widgets.DarkModeOf(ctx)
+	*p.highContrast = widgets.HighContrastOf(ctx)
+	return widgets.SizedBox{}
+}
+
+func TestDarkModeOf_HighContrastOf(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	var dark, highContrast bool
+	if err := tester.PumpWidget(widgets.MediaQuery{
+		Data:  widgets.MediaQueryData{DarkMode: true, HighContrast: true},
+		Child: appearanceProbe{dark: &dark, highContrast: &highContrast},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !dark || !highContrast {
+		t.Errorf("got dark=%v highContrast=%v, want both true", dark, highContrast)
+	}
+}
+
+func TestMediaQueryOverride_TextScale(t *testing.T) {
+	tests := []struct {
+		name     string
+		override widgets.MediaQueryOverride
+		want     float64
+	}{
+		{"inherits", widgets.MediaQueryOverride{}, 2},
+		{"clamps to max", widgets.MediaQueryOverride{MaxTextScaleFactor: 1.5}, 1.5},
+		{"raises to min", widgets.MediaQueryOverride{MinTextScaleFactor: 2.5}, 2.5},
+		{"opts out", widgets.MediaQueryOverride{TextScaleFactor: 1}, 1},
+		{"replaces then clamps", widgets.MediaQueryOverride{TextScaleFactor: 3, MaxTextScaleFactor: 2}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tester := drifttest.NewWidgetTesterWithT(t)
+
+			var scale float64
+			override := tt.override
+			override.Child = textScaleProbe{seen: &scale}
+			if err := tester.PumpWidget(widgets.MediaQuery{
+				Data:  widgets.MediaQueryData{DarkMode: true, TextScaleFactor: 2},
+				Child: override,
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if scale != tt.want {
+				t.Errorf("got %v, want %v", scale, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextInput_GrowsWithTextScale(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	input := widgets.TextInput{
+		Height: 40,
+		Style:  graphics.TextStyle{FontSize: 16, Height: 1.25},
+	}
+	if err := tester.PumpWidget(widgets.MediaQuery{
+		Data:  widgets.MediaQueryData{TextScaleFactor: 2},
+		Child: widgets.Column{Children: []core.Widget{input}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	got := tester.Find(drifttest.ByType[widgets.TextInput]()).RenderObject().Size().Height
+	if want := 40 + 16*1.25; got != want {
+		t.Errorf("height at 2x text scale: got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,282 @@
+package widgets
+
+import (
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// defaultBannerTransition is how long a banner takes to open or close when
+// Messenger.TransitionDuration is zero.
+const defaultBannerTransition = 250 * time.Millisecond
+
+// Messenger shows app-level messages above its child. Place it under the
+// app bar and around the page content, then call [MessengerOf] from
+// anywhere below it to show a banner:
+//
+//	widgets.Column{Children: []core.Widget{
+//	    theme.AppBarOf(ctx, "Inbox"),
+//	    widgets.Expanded{Child: widgets.Messenger{Child: inbox}},
+//	}}
+//
+//	messenger := widgets.MessengerOf(ctx)
+//	messenger.ShowBanner(theme.BannerOf(ctx,
+//	    "You're offline. Changes will sync later.",
+//	    theme.ButtonOf(ctx, "Dismiss", messenger.HideBanner),
+//	))
+//
+// One banner shows at a time; later ones wait until it closes. Banners grow
+// in from the top with a [SizeTransition], pushing the child down, and stay
+// until closed.
+type Messenger struct {
+	core.StatefulBase
+
+	// Child is the content below the banners.
+	Child core.Widget
+	// TransitionDuration is how long a banner takes to open or close.
+	// Defaults to 250ms.
+	TransitionDuration time.Duration
+}
+
+func (m Messenger) CreateState() core.State {
+	return &MessengerState{}
+}
+
+// MessengerState holds the banners of a [Messenger]. Get it with
+// [MessengerOf].
+type MessengerState struct {
+	core.StateBase
+	banners   []*BannerHandle // banners[0] is showing, the rest wait
+	animation *animation.AnimationController
+}
+
+// BannerHandle refers to a banner shown with [MessengerState.ShowBanner].
+type BannerHandle struct {
+	messenger *MessengerState
+	banner    core.Widget
+	closing   bool
+}
+
+// Close hides the banner, or removes it from the queue if it is still
+// waiting to show. Closing a closed banner does nothing.
+func (h *BannerHandle) Close() {
+	h.messenger.closeBanner(h)
+}
+
+func (s *MessengerState) InitState() {
+	s.animation = animation.NewAnimationController(s.duration())
+	s.animation.Curve = animation.EaseInOut
+	core.UseDisposable(s, s.animation)
+	s.animation.AddStatusListener(s.onStatus)
+}
+
+func (s *MessengerState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	s.animation.Duration = s.duration()
+}
+
+func (s *MessengerState) duration() time.Duration {
+	w := s.Element().Widget().(Messenger)
+	if w.TransitionDuration > 0 {
+		return w.TransitionDuration
+	}
+	return defaultBannerTransition
+}
+
+// ShowBanner queues banner to show above the child and returns a handle to
+// close it. The banner shows once any earlier ones have closed. banner is
+// typically a [Banner].
+func (s *MessengerState) ShowBanner(banner core.Widget) *BannerHandle {
+	handle := &BannerHandle{messenger: s, banner: banner}
+	s.SetState(func() { s.banners = append(s.banners, handle) })
+	if len(s.banners) == 1 {
+		s.animation.Forward()
+	}
+	return handle
+}
+
+// HideBanner closes the banner that is showing. The next queued banner, if
+// any, shows after it.
+func (s *MessengerState) HideBanner() {
+	if len(s.banners) > 0 {
+		s.closeBanner(s.banners[0])
+	}
+}
+
+// ClearBanners closes the banner that is showing and drops the queue.
+func (s *MessengerState) ClearBanners() {
+	if len(s.banners) == 0 {
+		return
+	}
+	s.SetState(func() { s.banners = s.banners[:1] })
+	s.closeBanner(s.banners[0])
+}
+
+func (s *MessengerState) closeBanner(handle *BannerHandle) {
+	index := slices.Index(s.banners, handle)
+	switch {
+	case index < 0 || handle.closing:
+		return
+	case index > 0:
+		s.SetState(func() { s.banners = slices.Delete(s.banners, index, index+1) })
+	default:
+		handle.closing = true
+		s.animation.Reverse()
+	}
+}
+
+// onStatus removes the showing banner once it has closed and opens the next.
+func (s *MessengerState) onStatus(status animation.AnimationStatus) {
+	if status != animation.AnimationDismissed || len(s.banners) == 0 || !s.banners[0].closing {
+		return
+	}
+	s.SetState(func() { s.banners = s.banners[1:] })
+	if len(s.banners) > 0 {
+		s.animation.Forward()
+	}
+}
+
+func (s *MessengerState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(Messenger)
+	children := make([]core.Widget, 0, 2)
+	if len(s.banners) > 0 {
+		children = append(children, SizeTransition{
+			Animation: s.animation,
+			Child:     s.banners[0].banner,
+		})
+	}
+	children = append(children, Expanded{Child: w.Child})
+	return messengerScope{
+		state: s,
+		child: Column{
+			CrossAxisAlignment: CrossAxisAlignmentStretch,
+			Children:           children,
+		},
+	}
+}
+
+// MessengerOf returns the [MessengerState] of the nearest ancestor
+// [Messenger], or nil if there is none.
+func MessengerOf(ctx core.BuildContext) *MessengerState {
+	inherited := ctx.DependOnInherited(messengerScopeType, nil)
+	if scope, ok := inherited.(messengerScope); ok {
+		return scope.state
+	}
+	return nil
+}
+
+type messengerScope struct {
+	core.InheritedBase
+	state *MessengerState
+	child core.Widget
+}
+
+func (m messengerScope) ChildWidget() core.Widget { return m.child }
+
+func (m messengerScope) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(messengerScope); ok {
+		return old.state != m.state
+	}
+	return true
+}
+
+var messengerScopeType = reflect.TypeFor[messengerScope]()
+
+// Banner is a message with optional actions that stays until dismissed,
+// for things like a lost connection or an account that needs attention.
+// Show it above the page with [MessengerState.ShowBanner].
+//
+// With one action, it sits beside the content; with more, they are laid
+// out in a row below it, aligned to the end.
+//
+// # Styling Model
+//
+// Banner is explicit by default. A zero BackgroundColor is transparent and a
+// zero DividerColor draws no divider. For a themed banner, use
+// [theme.BannerOf].
+//
+//	widgets.Banner{
+//	    Leading:         widgets.Icon{Glyph: "⚠", Size: 24, Color: colors.Primary},
+//	    Content:         widgets.Text{Content: "Your storage is almost full.", Style: bodyStyle},
+//	    Actions:         []core.Widget{dismissButton, manageButton},
+//	    BackgroundColor: colors.SurfaceContainerLow,
+//	    DividerColor:    colors.OutlineVariant,
+//	    Padding:         layout.EdgeInsets{Left: 16, Top: 16, Right: 16, Bottom: 8},
+//	}
+type Banner struct {
+	core.StatelessBase
+
+	// Leading is shown before the content, typically an icon.
+	Leading core.Widget
+	// Content is the message.
+	Content core.Widget
+	// Actions are the buttons that respond to the message, usually one of
+	// them closing the banner.
+	Actions []core.Widget
+	// BackgroundColor fills the banner.
+	BackgroundColor graphics.Color
+	// DividerColor draws a line along the bottom edge.
+	DividerColor graphics.Color
+	// Padding surrounds the banner's content.
+	Padding layout.EdgeInsets
+	// Spacing separates the leading widget, content, and actions.
+	Spacing float64
+}
+
+func (b Banner) Build(ctx core.BuildContext) core.Widget {
+	message := []core.Widget{}
+	if b.Leading != nil {
+		message = append(message, b.Leading, SizedBox{Width: b.Spacing})
+	}
+	if b.Content != nil {
+		message = append(message, Expanded{Child: b.Content})
+	}
+
+	var body core.Widget
+	switch len(b.Actions) {
+	case 0:
+		body = Row{Children: message}
+	case 1:
+		message = append(message, SizedBox{Width: b.Spacing}, b.Actions[0])
+		body = Row{Children: message}
+	default:
+		actions := make([]core.Widget, 0, 2*len(b.Actions)-1)
+		for i, action := range b.Actions {
+			if i > 0 {
+				actions = append(actions, SizedBox{Width: b.Spacing / 2})
+			}
+			actions = append(actions, action)
+		}
+		body = Column{
+			CrossAxisAlignment: CrossAxisAlignmentStretch,
+			MainAxisSize:       MainAxisSizeMin,
+			Children: []core.Widget{
+				Row{Children: message},
+				SizedBox{Height: b.Spacing / 2},
+				Row{MainAxisAlignment: MainAxisAlignmentEnd, Children: actions},
+			},
+		}
+	}
+
+	children := []core.Widget{Padding{Padding: b.Padding, Child: body}}
+	if b.DividerColor != graphics.ColorTransparent {
+		children = append(children, Divider{Thickness: 1, Height: 1, Color: b.DividerColor})
+	}
+	return Semantics{
+		Container: true,
+		Flags:     semantics.SemanticsIsLiveRegion,
+		Child: Container{
+			Color: b.BackgroundColor,
+			Child: Column{
+				CrossAxisAlignment: CrossAxisAlignmentStretch,
+				MainAxisSize:       MainAxisSizeMin,
+				Children:           children,
+			},
+		},
+	}
+}
@@ -0,0 +1,150 @@
+package widgets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+type messengerProbe struct {
+	core.StatelessBase
+	seen **widgets.MessengerState
+}
+
+func (p messengerProbe) Build(ctx core.BuildContext) core.Widget {
+	*p.seen = widgets.MessengerOf(ctx)
+	return widgets.Text{Content: "page"}
+}
+
+func testBanner(message string) widgets.Banner {
+	return widgets.Banner{
+		Content: widgets.SizedBox{Height: 50, Child: widgets.Text{Content: message}},
+		Padding: layout.EdgeInsetsAll(8),
+	}
+}
+
+func pumpMessenger(t *testing.T) (*drifttest.WidgetTester, *widgets.MessengerState) {
+	t.Helper()
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 300, Height: 400})
+	var messenger *widgets.MessengerState
+	if err := tester.PumpWidget(widgets.Messenger{
+		TransitionDuration: 200 * time.Millisecond,
+		Child:              messengerProbe{seen: &messenger},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if messenger == nil {
+		t.Fatal("expected MessengerOf to find the messenger")
+	}
+	return tester, messenger
+}
+
+// textTop returns the distance from the top of the screen to the text.
+func textTop(tester *drifttest.WidgetTester, content string) float64 {
+	var top float64
+	var ro layout.RenderObject = tester.Find(drifttest.ByText(content)).RenderObject()
+	for ro != nil {
+		if data, ok := ro.ParentData().(*layout.BoxParentData); ok {
+			top += data.Offset.Y
+		}
+		parent, ok := ro.(interface{ Parent() layout.RenderObject })
+		if !ok {
+			break
+		}
+		ro = parent.Parent()
+	}
+	return top
+}
+
+// pageTop returns how far the content below the banners has been pushed down.
+func pageTop(tester *drifttest.WidgetTester) float64 {
+	return textTop(tester, "page")
+}
+
+func TestMessenger_BannerPushesContentDown(t *testing.T) {
+	tester, messenger := pumpMessenger(t)
+	if top := pageTop(tester); top != 0 {
+		t.Fatalf("expected the page at the top without a banner, got %v", top)
+	}
+
+	messenger.ShowBanner(testBanner("offline"))
+	tester.Pump()
+	tester.Clock().Advance(100 * time.Millisecond)
+	tester.Pump()
+	if top := pageTop(tester); top <= 0 || top >= 66 {
+		t.Errorf("expected the banner part way open, got the page at %v", top)
+	}
+
+	tester.PumpAndSettle(time.Second)
+	if top := pageTop(tester); top != 66 {
+		t.Errorf("expected the open banner to take its full height, got %v", top)
+	}
+
+	messenger.HideBanner()
+	tester.PumpAndSettle(time.Second)
+	if tester.Find(drifttest.ByText("offline")).Exists() {
+		t.Error("expected the banner removed once closed")
+	}
+	if top := pageTop(tester); top != 0 {
+		t.Errorf("expected the page back at the top, got %v", top)
+	}
+}
+
+func TestMessenger_QueuesBanners(t *testing.T) {
+	tester, messenger := pumpMessenger(t)
+
+	messenger.ShowBanner(testBanner("first"))
+	second := messenger.ShowBanner(testBanner("second"))
+	messenger.ShowBanner(testBanner("third"))
+	tester.PumpAndSettle(time.Second)
+	if !tester.Find(drifttest.ByText("first")).Exists() || tester.Find(drifttest.ByText("third")).Exists() {
+		t.Fatal("expected only the first banner to show")
+	}
+
+	second.Close()
+	messenger.HideBanner()
+	tester.PumpAndSettle(time.Second)
+	if !tester.Find(drifttest.ByText("third")).Exists() || tester.Find(drifttest.ByText("second")).Exists() {
+		t.Error("expected a closed banner to leave the queue and the next to show")
+	}
+
+	messenger.ShowBanner(testBanner("fourth"))
+	messenger.ClearBanners()
+	tester.PumpAndSettle(time.Second)
+	if tester.Find(drifttest.ByType[widgets.Banner]()).Exists() {
+		t.Error("expected ClearBanners to close the banner and drop the queue")
+	}
+}
+
+func TestBanner_ActionsLayout(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 300, Height: 400})
+	action := func(label string) core.Widget {
+		return widgets.SizedBox{Width: 60, Height: 30, Child: widgets.Text{Content: label}}
+	}
+	top := func(content string) float64 { return textTop(tester, content) }
+
+	tester.PumpWidget(widgets.Column{Children: []core.Widget{widgets.Banner{
+		Content: widgets.Text{Content: "message"},
+		Actions: []core.Widget{action("ok")},
+		Spacing: 16,
+	}}})
+	if top("ok") != 0 {
+		t.Errorf("expected a single action beside the message, got it at %v", top("ok"))
+	}
+
+	tester.PumpWidget(widgets.Column{Children: []core.Widget{widgets.Banner{
+		Content: widgets.Text{Content: "message"},
+		Actions: []core.Widget{action("later"), action("ok")},
+		Spacing: 16,
+	}}})
+	if top("ok") <= top("message") {
+		t.Errorf("expected several actions below the message, got them at %v", top("ok"))
+	}
+}
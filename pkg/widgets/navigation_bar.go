@@ -0,0 +1,472 @@
+package widgets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// NavigationLabelBehavior controls which destinations of a [NavigationBar]
+// or [NavigationRail] show their labels.
+type NavigationLabelBehavior int
+
+const (
+	// NavigationLabelAlwaysShow shows every destination's label. This is the
+	// default.
+	NavigationLabelAlwaysShow NavigationLabelBehavior = iota
+	// NavigationLabelOnlyShowSelected shows only the selected destination's
+	// label.
+	NavigationLabelOnlyShowSelected
+	// NavigationLabelAlwaysHide shows icons only. Labels are still read by
+	// accessibility services.
+	NavigationLabelAlwaysHide
+)
+
+// String returns a human-readable representation of the label behavior.
+func (b NavigationLabelBehavior) String() string {
+	switch b {
+	case NavigationLabelAlwaysShow:
+		return "always_show"
+	case NavigationLabelOnlyShowSelected:
+		return "only_show_selected"
+	case NavigationLabelAlwaysHide:
+		return "always_hide"
+	default:
+		return fmt.Sprintf("NavigationLabelBehavior(%d)", int(b))
+	}
+}
+
+// shows reports whether a destination's label is shown.
+func (b NavigationLabelBehavior) shows(selected bool) bool {
+	return b == NavigationLabelAlwaysShow || (b == NavigationLabelOnlyShowSelected && selected)
+}
+
+// NavigationBar is a bar of top-level destinations along the bottom of the
+// screen. The selected destination's icon sits on a pill-shaped indicator
+// that grows in when it is selected.
+//
+// The bar extends under the bottom safe area inset, padding its
+// destinations above it, so place it at the very bottom of the screen.
+// [navigation.TabNavigator] can use it in place of its tab bar.
+//
+// # Styling Model
+//
+// NavigationBar is explicit by default. A zero BackgroundColor is
+// transparent, a zero IndicatorColor draws no indicator, and a zero Height
+// collapses the bar to the safe area inset. For a themed bar, use
+// [theme.NavigationBarOf].
+//
+//	widgets.NavigationBar{
+//	    Items: []widgets.TabItem{
+//	        {Label: "Inbox", Icon: inboxIcon, Badge: "3"},
+//	        {Label: "Search", Icon: searchIcon},
+//	    },
+//	    CurrentIndex:    s.index,
+//	    OnTap:           func(i int) { s.SetState(func() { s.index = i }) },
+//	    BackgroundColor: colors.SurfaceContainer,
+//	    IndicatorColor:  colors.SecondaryContainer,
+//	    SelectedColor:   colors.OnSecondaryContainer,
+//	    UnselectedColor: colors.OnSurfaceVariant,
+//	    BadgeColor:      colors.Error,
+//	    BadgeTextColor:  colors.OnError,
+//	    LabelStyle:      textTheme.LabelMedium,
+//	    Height:          80,
+//	    Duration:        200 * time.Millisecond,
+//	}
+type NavigationBar struct {
+	core.StatelessBase
+
+	// Items are the destinations, in order.
+	Items []TabItem
+	// CurrentIndex is the selected destination.
+	CurrentIndex int
+	// OnTap is called with a destination's index when it is tapped.
+	OnTap func(index int)
+
+	// BackgroundColor fills the bar, including under the safe area inset.
+	BackgroundColor graphics.Color
+	// IndicatorColor fills the pill behind the selected icon.
+	IndicatorColor graphics.Color
+	// SelectedColor colors the selected destination's icon and label.
+	SelectedColor graphics.Color
+	// UnselectedColor colors the other destinations' icons and labels.
+	UnselectedColor graphics.Color
+	// BadgeColor fills the badges set with [TabItem.Badge].
+	BadgeColor graphics.Color
+	// BadgeTextColor colors the badge labels.
+	BadgeTextColor graphics.Color
+	// LabelStyle styles the labels. Its color is replaced with SelectedColor
+	// or UnselectedColor.
+	LabelStyle graphics.TextStyle
+	// LabelBehavior controls which labels are shown.
+	LabelBehavior NavigationLabelBehavior
+	// Height is the height of the bar above the safe area inset.
+	Height float64
+	// Duration is how long the indicator takes to grow in. Zero switches
+	// instantly.
+	Duration time.Duration
+}
+
+func (n NavigationBar) Build(ctx core.BuildContext) core.Widget {
+	style := navigationStyle{
+		indicatorColor:  n.IndicatorColor,
+		selectedColor:   n.SelectedColor,
+		unselectedColor: n.UnselectedColor,
+		badgeColor:      n.BadgeColor,
+		badgeTextColor:  n.BadgeTextColor,
+		labelStyle:      n.LabelStyle,
+		duration:        n.Duration,
+	}
+	children := make([]core.Widget, 0, len(n.Items))
+	for i, item := range n.Items {
+		selected := i == n.CurrentIndex
+		children = append(children, Expanded{Child: navigationDestination{
+			item:      item,
+			index:     i,
+			count:     len(n.Items),
+			selected:  selected,
+			showLabel: n.LabelBehavior.shows(selected),
+			onTap:     n.OnTap,
+			style:     style,
+		}})
+	}
+	return Container{
+		Color: n.BackgroundColor,
+		Padding: layout.EdgeInsets{
+			Left:   SafeAreaLeftOf(ctx),
+			Right:  SafeAreaRightOf(ctx),
+			Bottom: SafeAreaBottomOf(ctx),
+		},
+		Child: SizedBox{
+			Height: n.Height,
+			Child: Row{
+				CrossAxisAlignment: CrossAxisAlignmentStretch,
+				Children:           children,
+			},
+		},
+	}
+}
+
+// NavigationRail is a column of top-level destinations along the leading
+// edge of the screen, for layouts too wide for a [NavigationBar]. It
+// otherwise looks and behaves like the bar.
+//
+// The rail extends under the top, bottom, and leading safe area insets,
+// padding its destinations inside them, so place it against the screen's
+// leading edge. [navigation.TabNavigator] can switch to it on wide screens.
+//
+// # Styling Model
+//
+// NavigationRail is explicit by default, like [NavigationBar]. A zero Width
+// sizes the rail to its destinations. For a themed rail, use
+// [theme.NavigationRailOf].
+//
+//	widgets.Row{Children: []core.Widget{
+//	    theme.NavigationRailOf(ctx, items, s.index, s.selectTab),
+//	    widgets.Expanded{Child: pages[s.index]},
+//	}}
+type NavigationRail struct {
+	core.StatelessBase
+
+	// Items are the destinations, top to bottom.
+	Items []TabItem
+	// CurrentIndex is the selected destination.
+	CurrentIndex int
+	// OnTap is called with a destination's index when it is tapped.
+	OnTap func(index int)
+	// Leading is shown above the destinations, such as a menu button.
+	Leading core.Widget
+
+	// BackgroundColor fills the rail, including under the safe area insets.
+	BackgroundColor graphics.Color
+	// IndicatorColor fills the pill behind the selected icon.
+	IndicatorColor graphics.Color
+	// SelectedColor colors the selected destination's icon and label.
+	SelectedColor graphics.Color
+	// UnselectedColor colors the other destinations' icons and labels.
+	UnselectedColor graphics.Color
+	// BadgeColor fills the badges set with [TabItem.Badge].
+	BadgeColor graphics.Color
+	// BadgeTextColor colors the badge labels.
+	BadgeTextColor graphics.Color
+	// LabelStyle styles the labels. Its color is replaced with SelectedColor
+	// or UnselectedColor.
+	LabelStyle graphics.TextStyle
+	// LabelBehavior controls which labels are shown.
+	LabelBehavior NavigationLabelBehavior
+	// Width is the width of the rail inside the leading safe area inset.
+	Width float64
+	// Spacing separates the destinations, and Leading from the first.
+	Spacing float64
+	// Duration is how long the indicator takes to grow in. Zero switches
+	// instantly.
+	Duration time.Duration
+}
+
+func (n NavigationRail) Build(ctx core.BuildContext) core.Widget {
+	style := navigationStyle{
+		indicatorColor:  n.IndicatorColor,
+		selectedColor:   n.SelectedColor,
+		unselectedColor: n.UnselectedColor,
+		badgeColor:      n.BadgeColor,
+		badgeTextColor:  n.BadgeTextColor,
+		labelStyle:      n.LabelStyle,
+		duration:        n.Duration,
+	}
+
+	children := make([]core.Widget, 0, 2*len(n.Items)+1)
+	if n.Leading != nil {
+		children = append(children, n.Leading)
+	}
+	for i, item := range n.Items {
+		if len(children) > 0 {
+			children = append(children, SizedBox{Height: n.Spacing})
+		}
+		selected := i == n.CurrentIndex
+		children = append(children, navigationDestination{
+			item:      item,
+			index:     i,
+			count:     len(n.Items),
+			selected:  selected,
+			showLabel: n.LabelBehavior.shows(selected),
+			onTap:     n.OnTap,
+			style:     style,
+		})
+	}
+
+	padding := layout.EdgeInsets{Top: SafeAreaTopOf(ctx), Bottom: SafeAreaBottomOf(ctx)}
+	if DirectionalityOf(ctx) == graphics.TextDirectionRTL {
+		padding.Right = SafeAreaRightOf(ctx)
+	} else {
+		padding.Left = SafeAreaLeftOf(ctx)
+	}
+	return Container{
+		Color:   n.BackgroundColor,
+		Padding: padding,
+		Child: SizedBox{
+			Width: n.Width,
+			Child: Column{
+				CrossAxisAlignment: CrossAxisAlignmentStretch,
+				Children:           children,
+			},
+		},
+	}
+}
+
+// navigationStyle holds the visual properties shared by the destinations
+// of a NavigationBar or NavigationRail.
+type navigationStyle struct {
+	indicatorColor  graphics.Color
+	selectedColor   graphics.Color
+	unselectedColor graphics.Color
+	badgeColor      graphics.Color
+	badgeTextColor  graphics.Color
+	labelStyle      graphics.TextStyle
+	duration        time.Duration
+}
+
+// navigationDestination is one destination of a NavigationBar or
+// NavigationRail: an icon on an indicator that grows in when selected, with
+// an optional label below.
+type navigationDestination struct {
+	core.StatefulBase
+	item      TabItem
+	index     int
+	count     int
+	selected  bool
+	showLabel bool
+	onTap     func(index int)
+	style     navigationStyle
+}
+
+func (d navigationDestination) CreateState() core.State {
+	return &navigationDestinationState{}
+}
+
+type navigationDestinationState struct {
+	core.StateBase
+	indicator *animation.AnimationController
+}
+
+func (s *navigationDestinationState) widget() navigationDestination {
+	return s.Element().Widget().(navigationDestination)
+}
+
+func (s *navigationDestinationState) InitState() {
+	w := s.widget()
+	s.indicator = animation.NewAnimationController(w.style.duration)
+	s.indicator.Curve = animation.EaseOut
+	core.UseDisposable(s, s.indicator)
+	if w.selected {
+		s.indicator.SetValue(1)
+	}
+}
+
+func (s *navigationDestinationState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	w := s.widget()
+	s.indicator.Duration = w.style.duration
+	if old, ok := oldWidget.(navigationDestination); ok && old.selected != w.selected {
+		if w.selected {
+			s.indicator.Forward()
+		} else {
+			// The indicator leaves at once so only one is ever visible.
+			s.indicator.SetValue(0)
+		}
+	}
+}
+
+func (s *navigationDestinationState) Build(ctx core.BuildContext) core.Widget {
+	w := s.widget()
+	color := w.style.unselectedColor
+	icon := w.item.Icon
+	if w.selected {
+		color = w.style.selectedColor
+		if w.item.SelectedIcon != nil {
+			icon = w.item.SelectedIcon
+		}
+	}
+	if tinted, ok := icon.(Icon); ok {
+		tinted.Color = color
+		icon = tinted
+	}
+	if icon == nil {
+		icon = SizedBox{}
+	}
+	if w.item.Badge != "" {
+		icon = Badge{
+			Label:           w.item.Badge,
+			BackgroundColor: w.style.badgeColor,
+			TextColor:       w.style.badgeTextColor,
+			Child:           icon,
+		}
+	}
+
+	content := []core.Widget{Stack{
+		Alignment: layout.AlignmentCenter,
+		Children: []core.Widget{
+			navigationIndicator{animation: s.indicator, color: w.style.indicatorColor},
+			icon,
+		},
+	}}
+	if w.showLabel {
+		label := w.style.labelStyle
+		label.Color = color
+		content = append(content, SizedBox{Height: 4}, Text{
+			Content:  w.item.Label,
+			Style:    label,
+			MaxLines: 1,
+			Wrap:     graphics.TextWrapNoWrap,
+		})
+	}
+
+	flags := semantics.SemanticsHasSelectedState
+	if w.selected {
+		flags = flags.Set(semantics.SemanticsIsSelected)
+	}
+	onTap := func() {
+		if w.onTap != nil {
+			w.onTap(w.index)
+		}
+	}
+	sem := Semantics{
+		Hint:             fmt.Sprintf("Tab %d of %d", w.index+1, w.count),
+		Role:             semantics.SemanticsRoleTab,
+		Flags:            flags,
+		Container:        true,
+		MergeDescendants: true,
+		OnTap:            onTap,
+		Child: GestureDetector{
+			OnTap: onTap,
+			Child: Container{
+				Padding:   layout.EdgeInsetsSymmetric(0, 4),
+				Alignment: layout.AlignmentCenter,
+				Child: Column{
+					MainAxisAlignment:  MainAxisAlignmentCenter,
+					CrossAxisAlignment: CrossAxisAlignmentCenter,
+					MainAxisSize:       MainAxisSizeMin,
+					Children:           content,
+				},
+			},
+		},
+	}
+	if !w.showLabel {
+		sem.Label = w.item.Label
+	}
+	return sem
+}
+
+// navigationIndicator is the 64x32 pill behind a destination's icon. It
+// grows out from its center as animation runs from 0 to 1.
+type navigationIndicator struct {
+	core.RenderObjectBase
+	animation *animation.AnimationController
+	color     graphics.Color
+}
+
+func (n navigationIndicator) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderNavigationIndicator{}
+	box.SetSelf(box)
+	n.UpdateRenderObject(ctx, box)
+	return box
+}
+
+func (n navigationIndicator) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	box, ok := renderObject.(*renderNavigationIndicator)
+	if !ok {
+		return
+	}
+	if box.animation != n.animation {
+		if box.unsubscribe != nil {
+			box.unsubscribe()
+			box.unsubscribe = nil
+		}
+		box.animation = n.animation
+		if n.animation != nil {
+			box.unsubscribe = n.animation.AddListener(box.MarkNeedsPaint)
+		}
+	}
+	box.color = n.color
+	box.MarkNeedsPaint()
+}
+
+type renderNavigationIndicator struct {
+	layout.RenderBoxBase
+	animation   *animation.AnimationController
+	unsubscribe func()
+	color       graphics.Color
+}
+
+func (r *renderNavigationIndicator) VisitChildren(visitor func(layout.RenderObject)) {}
+
+func (r *renderNavigationIndicator) PerformLayout() {
+	r.SetSize(r.Constraints().Constrain(graphics.Size{Width: 64, Height: 32}))
+}
+
+func (r *renderNavigationIndicator) Paint(ctx *layout.PaintContext) {
+	if r.color == graphics.ColorTransparent || r.animation == nil || r.animation.Value <= 0 {
+		return
+	}
+	size := r.Size()
+	width := size.Width * min(r.animation.Value, 1)
+	rect := graphics.RectFromLTWH((size.Width-width)/2, 0, width, size.Height)
+	paint := graphics.DefaultPaint()
+	paint.Color = r.color
+	ctx.Canvas.DrawRRect(graphics.RRectFromRectAndRadius(rect, graphics.CircularRadius(size.Height/2)), paint)
+}
+
+func (r *renderNavigationIndicator) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
+
+func (r *renderNavigationIndicator) Dispose() {
+	if r.unsubscribe != nil {
+		r.unsubscribe()
+		r.unsubscribe = nil
+	}
+	r.RenderBoxBase.Dispose()
+}
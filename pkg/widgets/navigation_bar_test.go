@@ -0,0 +1,98 @@
+package widgets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func navigationItems() []widgets.TabItem {
+	return []widgets.TabItem{
+		{
+			Label:        "Inbox",
+			Icon:         widgets.Text{Content: "inbox"},
+			SelectedIcon: widgets.Text{Content: "inbox-filled"},
+			Badge:        "3",
+		},
+		{Label: "Settings", Icon: widgets.Text{Content: "settings"}},
+	}
+}
+
+func TestNavigationBar_TapSelects(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+	tapped := -1
+	tester.PumpWidget(widgets.NavigationBar{
+		Items:        navigationItems(),
+		CurrentIndex: 0,
+		OnTap:        func(i int) { tapped = i },
+		Height:       80,
+	})
+
+	if !tester.Find(drifttest.ByText("inbox-filled")).Exists() {
+		t.Error("expected the selected tab to show its selected icon")
+	}
+	if tester.Find(drifttest.ByText("inbox")).Exists() {
+		t.Error("expected the selected icon to replace the icon")
+	}
+	if !tester.Find(drifttest.ByText("3")).Exists() {
+		t.Error("expected the inbox badge")
+	}
+	if err := tester.Tap(drifttest.ByText("Settings")); err != nil {
+		t.Fatal(err)
+	}
+	if tapped != 1 {
+		t.Errorf("expected OnTap(1), got %d", tapped)
+	}
+}
+
+func TestNavigationBar_LabelBehavior(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 800})
+	bar := widgets.NavigationBar{
+		Items:         navigationItems(),
+		CurrentIndex:  1,
+		Height:        80,
+		LabelBehavior: widgets.NavigationLabelOnlyShowSelected,
+	}
+	tester.PumpWidget(bar)
+	tester.PumpAndSettle(time.Second)
+
+	if tester.Find(drifttest.ByText("Inbox")).Exists() {
+		t.Error("expected the unselected label to be hidden")
+	}
+	if !tester.Find(drifttest.ByText("Settings")).Exists() {
+		t.Error("expected the selected label to show")
+	}
+
+	bar.LabelBehavior = widgets.NavigationLabelAlwaysHide
+	tester.PumpWidget(bar)
+	if tester.Find(drifttest.ByText("Settings")).Exists() {
+		t.Error("expected every label to be hidden")
+	}
+}
+
+func TestNavigationRail_Leading(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 800, Height: 400})
+	tapped := -1
+	tester.PumpWidget(widgets.NavigationRail{
+		Items:   navigationItems(),
+		OnTap:   func(i int) { tapped = i },
+		Leading: widgets.Text{Content: "compose"},
+		Width:   80,
+	})
+
+	if !tester.Find(drifttest.ByText("compose")).Exists() {
+		t.Error("expected the rail's leading widget")
+	}
+	if err := tester.Tap(drifttest.ByText("settings")); err != nil {
+		t.Fatal(err)
+	}
+	if tapped != 1 {
+		t.Errorf("expected OnTap(1), got %d", tapped)
+	}
+}
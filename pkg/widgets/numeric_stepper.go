@@ -0,0 +1,278 @@
+package widgets
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// NumericStepper shows a number between a minus and a plus button that
+// step it down and up. Holding a button repeats the step, faster the
+// longer it is held.
+//
+// # Styling Model
+//
+// NumericStepper is explicit by default — all visual properties use their
+// struct field values directly. A zero value means zero, not "use theme
+// default." For example:
+//
+//   - BackgroundColor: 0 means transparent background
+//   - BorderColor: 0 means no outline
+//   - Height: 0 means zero height (not rendered)
+//
+// For a theme-styled stepper, use [theme.NumericStepperOf] which pre-fills
+// visual properties from the current theme.
+//
+// # Creation Patterns
+//
+// Explicit with struct literal (full control):
+//
+//	widgets.NumericStepper{
+//	    Value:        float64(s.quantity),
+//	    Min:          1,
+//	    Max:          99,
+//	    OnChanged:    func(v float64) { s.SetState(func() { s.quantity = int(v) }) },
+//	    BorderColor:  colors.Outline,
+//	    BorderWidth:  1,
+//	    IconColor:    colors.OnSurface,
+//	    TextStyle:    graphics.TextStyle{FontSize: 16, Color: colors.OnSurface},
+//	    Height:       40,
+//	    ButtonWidth:  40,
+//	    BorderRadius: 8,
+//	    ValuePadding: layout.EdgeInsetsSymmetric(12, 0),
+//	}
+//
+// Themed (reads from current theme):
+//
+//	theme.NumericStepperOf(ctx, float64(s.quantity), 1, 99, onChanged)
+//
+// NumericStepper is a controlled component: it shows Value and calls
+// OnChanged with the stepped value. Screen readers treat it as an
+// adjustable control that steps by Step.
+type NumericStepper struct {
+	core.StatefulBase
+
+	// Value is the number shown.
+	Value float64
+	// Min is the smallest value. The minus button is disabled at Min.
+	Min float64
+	// Max is the largest value. The plus button is disabled at Max.
+	Max float64
+	// Step is the amount each press adds or removes. Zero means 1.
+	Step float64
+	// OnChanged is called with the new value when a button steps it.
+	OnChanged func(float64)
+	// Disabled disables interaction and fades the stepper when true.
+	Disabled bool
+	// Format formats the value for display and for screen readers.
+	// Defaults to the shortest decimal representation.
+	Format func(float64) string
+
+	// BackgroundColor fills the stepper.
+	BackgroundColor graphics.Color
+	// BorderColor is the color of the outline and the lines beside the value.
+	BorderColor graphics.Color
+	// BorderWidth is the width of the outline and the lines beside the value.
+	BorderWidth float64
+	// BorderRadius rounds the stepper's corners.
+	BorderRadius float64
+	// IconColor is the color of the minus and plus signs.
+	IconColor graphics.Color
+	// TextStyle styles the value.
+	TextStyle graphics.TextStyle
+	// Height is the height of the stepper.
+	Height float64
+	// ButtonWidth is the width of the minus and plus buttons.
+	ButtonWidth float64
+	// ValuePadding surrounds the value.
+	ValuePadding layout.EdgeInsets
+}
+
+// Hold-to-repeat timing: the first repeat comes stepperRepeatInterval after
+// the long press is recognized, and each later one comes sooner by
+// stepperRepeatAcceleration, down to stepperMinRepeatInterval.
+const (
+	stepperRepeatInterval     = 200 * time.Millisecond
+	stepperRepeatAcceleration = 0.8
+	stepperMinRepeatInterval  = 40 * time.Millisecond
+)
+
+func (n NumericStepper) CreateState() core.State {
+	return &numericStepperState{}
+}
+
+// step returns the amount each press changes the value by.
+func (n NumericStepper) step() float64 {
+	if n.Step == 0 {
+		return 1
+	}
+	return math.Abs(n.Step)
+}
+
+// canStep reports whether stepping by delta would change the value.
+func (n NumericStepper) canStep(delta float64) bool {
+	if n.Disabled || n.OnChanged == nil {
+		return false
+	}
+	if delta > 0 {
+		return n.Value < n.Max
+	}
+	return n.Value > n.Min
+}
+
+func (n NumericStepper) format(value float64) string {
+	if n.Format != nil {
+		return n.Format(value)
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+type numericStepperState struct {
+	core.StateBase
+	repeat holdRepeater
+}
+
+func (s *numericStepperState) widget() NumericStepper {
+	return s.Element().Widget().(NumericStepper)
+}
+
+func (s *numericStepperState) Dispose() {
+	s.repeat.cancel()
+	s.StateBase.Dispose()
+}
+
+// stepBy moves the value by delta steps, clamped to the range, and reports
+// whether it could.
+func (s *numericStepperState) stepBy(delta float64) bool {
+	if s.IsDisposed() {
+		return false
+	}
+	n := s.widget()
+	if !n.canStep(delta) {
+		return false
+	}
+	// Round away the float error that repeated steps accumulate.
+	steps := math.Round((n.Value + delta*n.step() - n.Min) / n.step())
+	n.OnChanged(min(max(n.Min+steps*n.step(), n.Min), n.Max))
+	return true
+}
+
+func (s *numericStepperState) Build(ctx core.BuildContext) core.Widget {
+	n := s.widget()
+	var divider core.Widget = SizedBox{}
+	if n.BorderWidth > 0 {
+		divider = VerticalDivider{Width: n.BorderWidth, Thickness: n.BorderWidth, Color: n.BorderColor}
+	}
+
+	var result core.Widget = SizedBox{
+		Height: n.Height,
+		Child: DecoratedBox{
+			Color:        n.BackgroundColor,
+			BorderColor:  n.BorderColor,
+			BorderWidth:  n.BorderWidth,
+			BorderRadius: n.BorderRadius,
+			Overflow:     OverflowClip,
+			Child: Row{
+				MainAxisSize:       MainAxisSizeMin,
+				CrossAxisAlignment: CrossAxisAlignmentStretch,
+				Children: []core.Widget{
+					s.buildButton(n, -1, "−"),
+					divider,
+					Padding{
+						Padding: n.ValuePadding,
+						Child:   Center{Child: Text{Content: n.format(n.Value), Style: n.TextStyle, MaxLines: 1}},
+					},
+					divider,
+					s.buildButton(n, 1, "+"),
+				},
+			},
+		},
+	}
+	if n.Disabled {
+		result = Opacity{Opacity: 0.5, Child: result}
+	}
+
+	value, minValue, maxValue := n.Value, n.Min, n.Max
+	flags := semantics.SemanticsIsSlider | semantics.SemanticsHasEnabledState
+	var onIncrease, onDecrease func()
+	if !n.Disabled && n.OnChanged != nil {
+		flags = flags.Set(semantics.SemanticsIsEnabled)
+		onIncrease = func() { s.stepBy(1) }
+		onDecrease = func() { s.stepBy(-1) }
+	}
+	return Semantics{
+		Value:        n.format(n.Value),
+		Role:         semantics.SemanticsRoleSlider,
+		Flags:        flags,
+		Container:    true,
+		CurrentValue: &value,
+		MinValue:     &minValue,
+		MaxValue:     &maxValue,
+		OnIncrease:   onIncrease,
+		OnDecrease:   onDecrease,
+		Child:        ExcludeSemantics{Excluding: true, Child: result},
+	}
+}
+
+// buildButton creates the minus (delta -1) or plus (delta 1) button. A tap
+// steps once; a long press steps repeatedly until the pointer lifts.
+func (s *numericStepperState) buildButton(n NumericStepper, delta float64, glyph string) core.Widget {
+	enabled := n.canStep(delta)
+	color := n.IconColor
+	if !enabled && !n.Disabled {
+		color = color.WithAlpha(color.Alpha() * 0.38)
+	}
+	var onTap, onLongPress, onLongPressEnd func()
+	if enabled {
+		onTap = func() { s.stepBy(delta) }
+		onLongPress = func() { s.repeat.start(func() bool { return s.stepBy(delta) }) }
+		onLongPressEnd = s.repeat.cancel
+	}
+	return GestureDetector{
+		OnTap:          onTap,
+		OnLongPress:    onLongPress,
+		OnLongPressEnd: onLongPressEnd,
+		Child: SizedBox{
+			Width: n.ButtonWidth,
+			Child: Center{Child: Text{
+				Content:  glyph,
+				Style:    graphics.TextStyle{Color: color, FontSize: n.TextStyle.FontSize + 4},
+				MaxLines: 1,
+			}},
+		},
+	}
+}
+
+// holdRepeater calls a step function over and over while a button is held,
+// sooner each time.
+type holdRepeater struct {
+	stop func()
+}
+
+// start calls step now and then again after each repeat interval, until
+// cancel is called or step reports that it could not step.
+func (r *holdRepeater) start(step func() bool) {
+	r.cancel()
+	var repeat func(interval time.Duration)
+	repeat = func(interval time.Duration) {
+		if !step() {
+			r.stop = nil
+			return
+		}
+		next := max(time.Duration(float64(interval)*stepperRepeatAcceleration), stepperMinRepeatInterval)
+		r.stop = afterFunc(interval, func() { repeat(next) })
+	}
+	repeat(stepperRepeatInterval)
+}
+
+func (r *holdRepeater) cancel() {
+	if r.stop != nil {
+		r.stop()
+		r.stop = nil
+	}
+}
@@ -0,0 +1,71 @@
+package widgets
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestHoldRepeater_Accelerates(t *testing.T) {
+	var delays []time.Duration
+	var pending func()
+	orig := afterFunc
+	afterFunc = func(d time.Duration, fn func()) func() {
+		delays = append(delays, d)
+		pending = fn
+		return func() { pending = nil }
+	}
+	t.Cleanup(func() { afterFunc = orig })
+
+	steps := 0
+	var r holdRepeater
+	r.start(func() bool {
+		steps++
+		return true
+	})
+	if steps != 1 {
+		t.Fatalf("expected a step as soon as the repeat starts, got %d", steps)
+	}
+	for range 12 {
+		pending()
+	}
+	if steps != 13 {
+		t.Errorf("expected a step per timer, got %d", steps)
+	}
+	if delays[0] != 200*time.Millisecond || delays[1] != 160*time.Millisecond {
+		t.Errorf("expected repeats to start at 200ms and speed up, got %v", delays)
+	}
+	if !slices.IsSortedFunc(delays, func(a, b time.Duration) int { return int(b - a) }) || delays[len(delays)-1] != 40*time.Millisecond {
+		t.Errorf("expected repeats to speed up to 40ms, got %v", delays)
+	}
+
+	r.cancel()
+	if pending != nil {
+		t.Error("expected cancel to stop the timer")
+	}
+}
+
+func TestHoldRepeater_StopsWhenStepFails(t *testing.T) {
+	var pending func()
+	orig := afterFunc
+	afterFunc = func(d time.Duration, fn func()) func() {
+		pending = fn
+		return func() { pending = nil }
+	}
+	t.Cleanup(func() { afterFunc = orig })
+
+	remaining := 2
+	var r holdRepeater
+	r.start(func() bool {
+		if remaining == 0 {
+			return false
+		}
+		remaining--
+		return true
+	})
+	pending()
+	pending()
+	if remaining != 0 || r.stop != nil {
+		t.Errorf("expected the repeat to end at the limit, %d steps left", remaining)
+	}
+}
@@ -0,0 +1,56 @@
+package widgets_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestNumericStepper_StepsWithinRange(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 300, Height: 200})
+	var changes []float64
+	tester.PumpWidget(segmentHost[float64]{
+		initial: 0.8,
+		build: func(value float64, onChanged func(float64)) core.Widget {
+			return widgets.Row{Children: []core.Widget{widgets.NumericStepper{
+				Value: value,
+				Min:   0,
+				Max:   1,
+				Step:  0.1,
+				OnChanged: func(v float64) {
+					changes = append(changes, v)
+					onChanged(v)
+				},
+				Height:       40,
+				ButtonWidth:  40,
+				ValuePadding: layout.EdgeInsetsSymmetric(20, 0),
+			}}}
+		},
+	})
+
+	// The minus button is at the start of the row, the plus button at 80-120.
+	plus := graphics.Offset{X: 100, Y: 100}
+	for range 3 {
+		tester.TapAt(plus)
+		tester.Pump()
+	}
+	if !slices.Equal(changes, []float64{0.9, 1}) {
+		t.Errorf("expected plus to stop at Max without float drift, got %v", changes)
+	}
+
+	tester.TapAt(graphics.Offset{X: 20, Y: 100})
+	tester.Pump()
+	if last := changes[len(changes)-1]; last != 0.9 {
+		t.Errorf("expected minus to step down, got %v", last)
+	}
+	node := tester.Find(drifttest.ByType[widgets.Semantics]()).Widget().(widgets.Semantics)
+	if node.Value != "0.9" || node.OnIncrease == nil {
+		t.Errorf("expected an adjustable node with the value, got %q", node.Value)
+	}
+}
@@ -0,0 +1,61 @@
+package widgets
+
+import "github.com/go-drift/drift/pkg/core"
+
+// PageStorageBucket holds values, such as scroll offsets, that should
+// outlive the widgets that wrote them. A zero PageStorageBucket is ready to
+// use.
+type PageStorageBucket struct {
+	values map[any]any
+}
+
+// Read returns the value stored under key, or nil if there is none.
+func (b *PageStorageBucket) Read(key any) any {
+	if b == nil {
+		return nil
+	}
+	return b.values[key]
+}
+
+// Write stores value under key. Keys must be comparable.
+func (b *PageStorageBucket) Write(key, value any) {
+	if b == nil {
+		return
+	}
+	if b.values == nil {
+		b.values = make(map[any]any)
+	}
+	b.values[key] = value
+}
+
+// PageStorage provides a [PageStorageBucket] to descendants. Widgets that
+// are torn down and created again, such as the pages of a tab view or a
+// [ScrollView] with a StorageKey, save their state in the bucket and read it
+// back when they return.
+//
+// Page routes insert a PageStorage around each page, so values are kept per
+// route and dropped when the route is popped.
+type PageStorage struct {
+	core.StatelessBase
+
+	Bucket *PageStorageBucket
+	Child  core.Widget
+}
+
+func (p PageStorage) Build(ctx core.BuildContext) core.Widget {
+	return p.Child
+}
+
+// PageStorageOf returns the bucket of the nearest [PageStorage], or nil if
+// there is none. It does not make ctx depend on the PageStorage, since the
+// bucket is read when state is created and written as it changes.
+func PageStorageOf(ctx core.BuildContext) *PageStorageBucket {
+	element := ctx.FindAncestor(func(e core.Element) bool {
+		_, ok := e.Widget().(PageStorage)
+		return ok
+	})
+	if element == nil {
+		return nil
+	}
+	return element.Widget().(PageStorage).Bucket
+}
@@ -0,0 +1,227 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// PageController controls which page a [PageView] shows.
+//
+// A zero PageController is ready to use and starts at page 0; set
+// InitialPage to start elsewhere. Share one controller between a PageView
+// and widgets that follow it, such as a [PageIndicator].
+type PageController struct {
+	// InitialPage is the page shown when the view is first laid out.
+	InitialPage int
+
+	scroll ScrollController
+	extent float64 // page extent from the last layout
+}
+
+// Page returns the current scroll position in pages. It is fractional while
+// the view is between pages, so 1.5 means halfway from page 1 to page 2.
+func (c *PageController) Page() float64 {
+	if c.extent <= 0 {
+		return float64(c.InitialPage)
+	}
+	return c.scroll.Offset() / c.extent
+}
+
+// CurrentPage returns the page nearest to the current position.
+func (c *PageController) CurrentPage() int {
+	return int(math.Round(c.Page()))
+}
+
+// IsDragging reports whether the user is dragging the view.
+func (c *PageController) IsDragging() bool {
+	return c.scroll.IsDragging()
+}
+
+// JumpToPage shows page without animating.
+func (c *PageController) JumpToPage(page int) {
+	if c.extent <= 0 {
+		c.InitialPage = page
+		c.scroll.notifyListeners()
+		return
+	}
+	c.scroll.JumpTo(float64(page) * c.extent)
+}
+
+// AnimateToPage scrolls to page over duration, easing with curve. A nil
+// curve uses [animation.Ease].
+func (c *PageController) AnimateToPage(page int, duration time.Duration, curve func(float64) float64) {
+	if c.extent <= 0 {
+		c.JumpToPage(page)
+		return
+	}
+	c.scroll.AnimateTo(float64(page)*c.extent, duration, curve)
+}
+
+// NextPage animates to the page after the current one.
+func (c *PageController) NextPage(duration time.Duration, curve func(float64) float64) {
+	c.AnimateToPage(c.CurrentPage()+1, duration, curve)
+}
+
+// PreviousPage animates to the page before the current one.
+func (c *PageController) PreviousPage(duration time.Duration, curve func(float64) float64) {
+	c.AnimateToPage(c.CurrentPage()-1, duration, curve)
+}
+
+// AddListener registers a callback for position changes and drag starts
+// and ends. It returns a function that removes the listener.
+func (c *PageController) AddListener(listener func()) func() {
+	return c.scroll.AddListener(listener)
+}
+
+// setExtent records the page extent from layout. The first time, it moves
+// the view to InitialPage; later, it keeps the current page in place when
+// the extent changes.
+func (c *PageController) setExtent(extent float64) {
+	if extent <= 0 || extent == c.extent {
+		return
+	}
+	page := c.Page()
+	c.extent = extent
+	offset := page * extent
+	c.scroll.InitialScrollOffset = offset
+	for _, position := range c.scroll.positions {
+		// Layout clamps the offset once the new content extent is known.
+		position.StopBallistic()
+		position.offset = offset
+	}
+	c.scroll.setViewportExtent(extent)
+}
+
+// PageView shows one page at a time and snaps to whole pages when the user
+// swipes. Pages fill the view. Supply them as Children, or with ItemCount
+// and ItemBuilder to build only the pages near the visible one.
+//
+// Like [ListView], a PageView scrolls vertically unless ScrollDirection is
+// [AxisHorizontal]:
+//
+//	widgets.PageView{
+//	    ScrollDirection: widgets.AxisHorizontal,
+//	    Controller:      s.pages,
+//	    OnPageChanged:   func(page int) { s.SetState(func() { s.page = page }) },
+//	    Children:        []core.Widget{welcome, features, signUp},
+//	}
+//
+// For looping, autoplay, and indicators, see [Carousel].
+type PageView struct {
+	core.StatefulBase
+
+	// Children are the pages. Ignored when ItemBuilder is set.
+	Children []core.Widget
+	// ItemCount is the number of pages ItemBuilder can build.
+	ItemCount int
+	// ItemBuilder builds the page at index on demand.
+	ItemBuilder func(ctx core.BuildContext, index int) core.Widget
+	// Controller controls and reports the page. If nil, the view creates
+	// its own.
+	Controller *PageController
+	// ScrollDirection is the axis pages move along. Defaults to vertical.
+	ScrollDirection Axis
+	// OnPageChanged is called when the page nearest to the position
+	// changes, whether by swiping or through the controller.
+	OnPageChanged func(page int)
+}
+
+func (p PageView) CreateState() core.State {
+	return &pageViewState{}
+}
+
+type pageViewState struct {
+	core.StateBase
+	controller     *PageController
+	own            *PageController
+	removeListener func()
+	page           int
+}
+
+func (s *pageViewState) widget() PageView {
+	return s.Element().Widget().(PageView)
+}
+
+func (s *pageViewState) InitState() {
+	s.attach(s.widget().Controller)
+}
+
+func (s *pageViewState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	if old, ok := oldWidget.(PageView); ok && old.Controller != s.widget().Controller {
+		s.attach(s.widget().Controller)
+	}
+}
+
+func (s *pageViewState) Dispose() {
+	if s.removeListener != nil {
+		s.removeListener()
+		s.removeListener = nil
+	}
+	s.StateBase.Dispose()
+}
+
+func (s *pageViewState) attach(controller *PageController) {
+	if s.removeListener != nil {
+		s.removeListener()
+	}
+	if controller == nil {
+		if s.own == nil {
+			s.own = &PageController{}
+		}
+		controller = s.own
+	}
+	s.controller = controller
+	s.page = controller.CurrentPage()
+	s.removeListener = controller.AddListener(s.onScroll)
+}
+
+func (s *pageViewState) onScroll() {
+	page := s.controller.CurrentPage()
+	if page == s.page {
+		return
+	}
+	s.page = page
+	if w := s.widget(); w.OnPageChanged != nil {
+		w.OnPageChanged(page)
+	}
+}
+
+func (s *pageViewState) Build(ctx core.BuildContext) core.Widget {
+	w := s.widget()
+	count := w.ItemCount
+	build := w.ItemBuilder
+	if build == nil {
+		count = len(w.Children)
+		children := w.Children
+		build = func(ctx core.BuildContext, index int) core.Widget {
+			return children[index]
+		}
+	}
+	controller := s.controller
+	return LayoutBuilder{Builder: func(ctx core.BuildContext, constraints layout.Constraints) core.Widget {
+		extent, cross := constraints.MaxHeight, constraints.MaxWidth
+		if w.ScrollDirection == AxisHorizontal {
+			extent, cross = cross, extent
+		}
+		// Knowing the extent before the list builds lets it build only the
+		// pages in view.
+		controller.setExtent(extent)
+		return ListViewBuilder{
+			ItemCount:       count,
+			ItemExtent:      extent,
+			ScrollDirection: w.ScrollDirection,
+			Controller:      &controller.scroll,
+			Physics:         PageScrollPhysics{},
+			ItemBuilder: func(ctx core.BuildContext, index int) core.Widget {
+				page := build(ctx, index)
+				if w.ScrollDirection == AxisHorizontal {
+					return SizedBox{Height: cross, Child: page}
+				}
+				return SizedBox{Width: cross, Child: page}
+			},
+		}
+	}}
+}
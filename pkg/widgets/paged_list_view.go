@@ -0,0 +1,326 @@
+package widgets
+
+import (
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// defaultLoadMoreExtent is how close to the end, in pixels, a
+// [PagedListView] starts loading the next page when LoadMoreExtent is zero.
+const defaultLoadMoreExtent = 200
+
+// PagedListView is a [ListViewBuilder] that loads more items as the user
+// scrolls toward the end, for feeds, chat history, and search results.
+//
+// The list calls OnLoadMore when the end is within LoadMoreExtent pixels,
+// or when the footer is visible because the items don't fill the viewport.
+// OnLoadMore runs on the UI thread and should start the fetch, then call
+// done once the new items have been added and ItemCount updated. done may
+// be called from any goroutine. Until then the list shows a loading footer
+// and doesn't ask for another page. If done receives an error, the list
+// shows an error footer and waits for the user to retry.
+//
+//	widgets.PagedListView{
+//	    ItemCount:   len(s.posts),
+//	    ItemExtent:  72,
+//	    HasMore:     s.cursor != "",
+//	    ItemBuilder: func(ctx core.BuildContext, i int) core.Widget {
+//	        return postTile(s.posts[i])
+//	    },
+//	    OnLoadMore: func(done func(error)) {
+//	        go func() {
+//	            page, err := api.Posts(s.cursor)
+//	            drift.Dispatch(func() {
+//	                if err == nil {
+//	                    s.SetState(func() {
+//	                        s.posts = append(s.posts, page.Posts...)
+//	                        s.cursor = page.Next
+//	                    })
+//	                }
+//	                done(err)
+//	            })
+//	        }()
+//	    },
+//	}
+//
+// The footer is built as one more item after the last one, so with
+// ItemExtent set it gets the same extent as the items.
+type PagedListView struct {
+	core.StatefulBase
+
+	// ItemCount is the number of items loaded so far.
+	ItemCount int
+	// ItemBuilder creates the widget for the item at index.
+	ItemBuilder func(ctx core.BuildContext, index int) core.Widget
+	// HasMore reports whether there are more pages to load. While false,
+	// OnLoadMore isn't called and EndBuilder provides the footer.
+	HasMore bool
+	// OnLoadMore starts loading the next page and calls done when it has
+	// been added or failed.
+	OnLoadMore func(done func(err error))
+	// LoadMoreExtent is how close to the end, in pixels, loading starts.
+	// Defaults to 200.
+	LoadMoreExtent float64
+
+	// LoadingBuilder builds the footer shown while a page loads. Defaults
+	// to a small progress indicator.
+	LoadingBuilder func(ctx core.BuildContext) core.Widget
+	// ErrorBuilder builds the footer shown after a page failed to load.
+	// Call retry to try again. Defaults to a message that retries on tap.
+	ErrorBuilder func(ctx core.BuildContext, err error, retry func()) core.Widget
+	// EndBuilder builds the footer shown once HasMore is false. Defaults to
+	// no footer.
+	EndBuilder func(ctx core.BuildContext) core.Widget
+
+	// ItemExtent is the fixed extent of each item along the scroll axis.
+	// See [ListViewBuilder.ItemExtent].
+	ItemExtent float64
+	// CacheExtent is the number of pixels to build beyond the visible area.
+	CacheExtent float64
+	// ScrollDirection is the axis along which the list scrolls. Defaults to vertical.
+	ScrollDirection Axis
+	// Controller manages scroll position and provides scroll notifications.
+	Controller *ScrollController
+	// Physics determines how the scroll view responds to user input.
+	Physics ScrollPhysics
+	// Padding is applied around the list content.
+	Padding layout.EdgeInsets
+	// StorageKey saves the scroll offset in the nearest [PageStorage] and
+	// restores it when the list is recreated. See [ScrollView.StorageKey].
+	StorageKey any
+}
+
+func (p PagedListView) CreateState() core.State {
+	return &pagedListViewState{}
+}
+
+type pagedListViewState struct {
+	core.StateBase
+	controller     *ScrollController // the controller the list scrolls with
+	own            *ScrollController // created when no other controller applies
+	removeListener func()
+	loading        bool
+	err            error
+	footerVisible  bool
+	checkQueued    bool
+	generation     int // invalidates done callbacks from earlier loads
+}
+
+func (s *pagedListViewState) widget() PagedListView {
+	return s.Element().Widget().(PagedListView)
+}
+
+// listen follows controller, the one the list scrolls with.
+func (s *pagedListViewState) listen(controller *ScrollController) {
+	if controller == s.controller {
+		return
+	}
+	if s.removeListener != nil {
+		s.removeListener()
+	}
+	s.controller = controller
+	s.removeListener = controller.AddListener(s.scheduleCheck)
+}
+
+func (s *pagedListViewState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	// New items may still leave the end in view.
+	s.scheduleCheck()
+}
+
+func (s *pagedListViewState) Dispose() {
+	if s.removeListener != nil {
+		s.removeListener()
+		s.removeListener = nil
+	}
+	if s.own != nil {
+		s.own.Dispose()
+	}
+	s.generation++
+	s.StateBase.Dispose()
+}
+
+// nearEnd reports whether the end of the list is within LoadMoreExtent of
+// the viewport.
+func (s *pagedListViewState) nearEnd() bool {
+	if s.footerVisible {
+		return true
+	}
+	if s.controller == nil || s.controller.ViewportExtent() <= 0 {
+		return false
+	}
+	extent := s.widget().LoadMoreExtent
+	if extent <= 0 {
+		extent = defaultLoadMoreExtent
+	}
+	return s.controller.MaxScrollExtent()-s.controller.Offset() <= extent
+}
+
+// scheduleCheck runs maybeLoadMore on the next frame, once the list is laid
+// out. Scroll extents change one at a time during layout, so checking right
+// away could see the new viewport with stale content.
+func (s *pagedListViewState) scheduleCheck() {
+	if s.checkQueued {
+		return
+	}
+	s.checkQueued = true
+	check := func() {
+		s.checkQueued = false
+		s.maybeLoadMore()
+	}
+	if !platform.Dispatch(check) {
+		s.checkQueued = false
+	}
+}
+
+// maybeLoadMore asks for the next page if the end is near and no load is
+// running or failed.
+func (s *pagedListViewState) maybeLoadMore() {
+	if s.IsDisposed() || s.loading || s.err != nil {
+		return
+	}
+	w := s.widget()
+	if !w.HasMore || w.OnLoadMore == nil || !s.nearEnd() {
+		return
+	}
+	s.load(w)
+}
+
+func (s *pagedListViewState) load(w PagedListView) {
+	s.generation++
+	generation := s.generation
+	s.SetState(func() {
+		s.loading = true
+		s.err = nil
+	})
+	w.OnLoadMore(func(err error) {
+		finish := func() {
+			if s.IsDisposed() || generation != s.generation {
+				return
+			}
+			s.SetState(func() {
+				s.loading = false
+				s.err = err
+			})
+			if err == nil {
+				s.scheduleCheck()
+			}
+		}
+		if !platform.Dispatch(finish) {
+			finish()
+		}
+	})
+}
+
+func (s *pagedListViewState) retry() {
+	if s.IsDisposed() || s.loading {
+		return
+	}
+	s.err = nil
+	if w := s.widget(); w.HasMore && w.OnLoadMore != nil {
+		s.load(w)
+	}
+}
+
+func (s *pagedListViewState) Build(ctx core.BuildContext) core.Widget {
+	w := s.widget()
+	footer := s.buildFooter(ctx, w)
+	count := w.ItemCount
+	if footer != nil {
+		count++
+	}
+	// Like ListViewBuilder, a vertical list without a controller follows
+	// the primary controller, and the list listens to whichever applies.
+	controller := w.Controller
+	switch {
+	case controller != nil:
+		s.listen(controller)
+	case w.ScrollDirection == AxisVertical && PrimaryScrollControllerOf(ctx) != nil:
+		s.listen(PrimaryScrollControllerOf(ctx))
+	default:
+		if s.own == nil {
+			s.own = &ScrollController{}
+		}
+		controller = s.own
+		s.listen(controller)
+	}
+	return ListViewBuilder{
+		ItemCount: count,
+		ItemBuilder: func(ctx core.BuildContext, index int) core.Widget {
+			if index == w.ItemCount {
+				return footer
+			}
+			if w.ItemBuilder == nil {
+				return nil
+			}
+			return w.ItemBuilder(ctx, index)
+		},
+		ItemExtent:      w.ItemExtent,
+		CacheExtent:     w.CacheExtent,
+		ScrollDirection: w.ScrollDirection,
+		Controller:      controller,
+		Physics:         w.Physics,
+		Padding:         w.Padding,
+		StorageKey:      w.StorageKey,
+	}
+}
+
+// buildFooter returns the footer for the current state, wrapped so the list
+// learns when it is visible, or nil if there is none.
+func (s *pagedListViewState) buildFooter(ctx core.BuildContext, w PagedListView) core.Widget {
+	var footer core.Widget
+	switch {
+	case s.err != nil:
+		if w.ErrorBuilder != nil {
+			footer = w.ErrorBuilder(ctx, s.err, s.retry)
+		} else {
+			footer = defaultPagedErrorFooter(s.retry)
+		}
+	case w.HasMore:
+		if w.LoadingBuilder != nil {
+			footer = w.LoadingBuilder(ctx)
+		} else {
+			footer = defaultPagedLoadingFooter()
+		}
+	case w.EndBuilder != nil:
+		footer = w.EndBuilder(ctx)
+	}
+	if footer == nil {
+		s.footerVisible = false
+		return nil
+	}
+	return VisibilityDetector{
+		OnVisibilityChanged: func(info VisibilityInfo) {
+			s.footerVisible = info.VisibleFraction > 0
+			s.maybeLoadMore()
+		},
+		Child: footer,
+	}
+}
+
+var pagedFooterColor = graphics.RGBA(120, 120, 120, 1.0)
+
+func defaultPagedLoadingFooter() core.Widget {
+	return Padding{
+		Padding: layout.EdgeInsetsAll(16),
+		Child: Center{Child: CircularProgressIndicator{
+			Color:       pagedFooterColor,
+			Size:        24,
+			StrokeWidth: 3,
+		}},
+	}
+}
+
+func defaultPagedErrorFooter(retry func()) core.Widget {
+	return GestureDetector{
+		OnTap: retry,
+		Child: Padding{
+			Padding: layout.EdgeInsetsAll(16),
+			Child: Center{Child: Text{
+				Content: "Couldn't load more. Tap to retry.",
+				Style:   graphics.TextStyle{Color: pagedFooterColor, FontSize: 14},
+			}},
+		},
+	}
+}
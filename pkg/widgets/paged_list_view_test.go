@@ -0,0 +1,119 @@
+package widgets_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// pagedFeed hosts a PagedListView over count items and records the done
+// callbacks of pending loads.
+type pagedFeed struct {
+	controller *widgets.ScrollController
+	pending    []func(error)
+	setCount   func(func(int) int)
+}
+
+func (f *pagedFeed) widget(initial, limit int) core.Widget {
+	return core.Stateful(
+		func() int { return initial },
+		func(count int, ctx core.BuildContext, setState func(func(int) int)) core.Widget {
+			f.setCount = setState
+			return widgets.PagedListView{
+				Controller: f.controller,
+				ItemCount:  count,
+				ItemExtent: 50,
+				HasMore:    count < limit,
+				ItemBuilder: func(ctx core.BuildContext, i int) core.Widget {
+					return widgets.Text{Content: fmt.Sprintf("item %d", i)}
+				},
+				OnLoadMore: func(done func(error)) {
+					f.pending = append(f.pending, done)
+				},
+			}
+		},
+	)
+}
+
+// finish completes the oldest pending load, adding n items on success.
+func (f *pagedFeed) finish(n int, err error) {
+	done := f.pending[0]
+	f.pending = f.pending[1:]
+	if err == nil {
+		f.setCount(func(count int) int { return count + n })
+	}
+	done(err)
+}
+
+func TestPagedListView_LoadsNearEnd(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	feed := &pagedFeed{controller: &widgets.ScrollController{}}
+	tester.PumpWidget(feed.widget(20, 100))
+	tester.Pump()
+
+	if len(feed.pending) != 0 {
+		t.Fatalf("expected no load at the top, got %d", len(feed.pending))
+	}
+
+	// 20 items and the footer are 1050 tall, so the end is 150 away.
+	feed.controller.JumpTo(300)
+	tester.Pump()
+	feed.controller.JumpTo(400)
+	tester.Pump()
+	if len(feed.pending) != 1 {
+		t.Fatalf("expected one load while near the end, got %d", len(feed.pending))
+	}
+
+	feed.finish(20, nil)
+	tester.Pump()
+	tester.Pump()
+	if len(feed.pending) != 0 {
+		t.Fatalf("expected no load once the list is long again, got %d", len(feed.pending))
+	}
+	if got := feed.controller.MaxScrollExtent(); got != 1450 {
+		t.Errorf("expected 40 items and the footer, got max extent %v", got)
+	}
+}
+
+func TestPagedListView_FillsViewportAndRetries(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	feed := &pagedFeed{controller: &widgets.ScrollController{}}
+	tester.PumpWidget(feed.widget(0, 30))
+	tester.Pump()
+
+	// An empty list shows its footer, so it loads straight away.
+	if len(feed.pending) != 1 {
+		t.Fatalf("expected the first page to load, got %d loads", len(feed.pending))
+	}
+	feed.finish(0, errors.New("offline"))
+	tester.Pump()
+	if len(feed.pending) != 0 {
+		t.Fatalf("expected no load after an error, got %d", len(feed.pending))
+	}
+
+	if err := tester.Tap(drifttest.ByText("Couldn't load more. Tap to retry.")); err != nil {
+		t.Fatal(err)
+	}
+	tester.Pump()
+	if len(feed.pending) != 1 {
+		t.Fatalf("expected a retry, got %d loads", len(feed.pending))
+	}
+
+	// A page too short for the viewport is followed by the next one.
+	feed.finish(5, nil)
+	tester.Pump()
+	tester.Pump()
+	if len(feed.pending) != 1 {
+		t.Fatalf("expected another load for a short list, got %d", len(feed.pending))
+	}
+	feed.finish(25, nil)
+	tester.Pump()
+	tester.Pump()
+	if len(feed.pending) != 0 {
+		t.Errorf("expected no load once everything is loaded, got %d", len(feed.pending))
+	}
+}
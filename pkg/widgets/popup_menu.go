@@ -0,0 +1,577 @@
+package widgets
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/focus"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// PopupMenuItem is an entry in a [PopupMenu].
+type PopupMenuItem struct {
+	// Label is the item's text.
+	Label string
+	// OnSelected is called when the item is tapped, after the menu closes.
+	OnSelected func()
+	// Disabled shows the item in DisabledTextColor and ignores taps.
+	Disabled bool
+	// Checkable reserves room before the label for a check mark, which is
+	// shown while Checked is set. Items without it line up with checkable
+	// ones in the same menu.
+	Checkable bool
+	// Checked shows the check mark of a Checkable item.
+	Checked bool
+	// Divider makes the entry a line separating groups of items. The other
+	// fields are ignored.
+	Divider bool
+}
+
+// PopupMenu is the panel of a popup menu: a column of items on a raised
+// surface. Show it above the page with [ShowPopupMenu], from a
+// [PopupMenuButton], or as a context menu with [ShowContextMenu].
+//
+// PopupMenu is explicit by default: a zero BackgroundColor is transparent, a
+// zero ShadowColor draws no shadow, and a zero ItemHeight sizes items to
+// their text. For a themed menu, use [theme.PopupMenuOf].
+//
+//	widgets.PopupMenu{
+//	    Items:             items,
+//	    BackgroundColor:   colors.SurfaceContainer,
+//	    ShadowColor:       colors.Shadow,
+//	    TextStyle:         graphics.TextStyle{FontSize: 16, Color: colors.OnSurface},
+//	    DisabledTextColor: colors.OnSurface.WithAlpha(0.38),
+//	    DividerColor:      colors.OutlineVariant,
+//	    HighlightColor:    colors.OnSurface.WithAlpha(0.12),
+//	    ItemHeight:        48,
+//	    ItemPadding:       layout.EdgeInsetsSymmetric(12, 0),
+//	    BorderRadius:      4,
+//	    MinWidth:          112,
+//	}
+//
+// The menu traps keyboard focus while it is open: the arrow keys move a
+// highlight between the enabled items, Enter or Space selects the
+// highlighted item, and Escape closes the menu.
+type PopupMenu struct {
+	core.StatelessBase
+
+	// Items are the entries, top to bottom.
+	Items []PopupMenuItem
+	// OnDismiss closes the menu. [ShowPopupMenu] sets it; an item tap calls
+	// it before the item's OnSelected.
+	OnDismiss func()
+
+	// BackgroundColor fills the panel.
+	BackgroundColor graphics.Color
+	// ShadowColor is the color of the panel's elevation shadow.
+	ShadowColor graphics.Color
+	// TextStyle styles the item labels and check marks.
+	TextStyle graphics.TextStyle
+	// DisabledTextColor is the label color of disabled items.
+	DisabledTextColor graphics.Color
+	// DividerColor is the color of divider entries.
+	DividerColor graphics.Color
+	// HighlightColor fills the item that has keyboard focus.
+	HighlightColor graphics.Color
+	// ItemHeight is the height of each item.
+	ItemHeight float64
+	// ItemPadding surrounds each item's label.
+	ItemPadding layout.EdgeInsets
+	// BorderRadius rounds the panel's corners.
+	BorderRadius float64
+	// MinWidth is the narrowest the panel gets.
+	MinWidth float64
+}
+
+// popupMenuCheckWidth is the room reserved for check marks.
+const popupMenuCheckWidth = 24
+
+func (m PopupMenu) Build(ctx core.BuildContext) core.Widget {
+	count := 0
+	checkable := false
+	for _, item := range m.Items {
+		if !item.Divider {
+			count++
+			checkable = checkable || item.Checkable
+		}
+	}
+
+	items := make([]core.Widget, 0, len(m.Items))
+	position := 0
+	for _, item := range m.Items {
+		if item.Divider {
+			items = append(items, Divider{Height: 9, Thickness: 1, Color: m.DividerColor})
+			continue
+		}
+		position++
+		style := m.TextStyle
+		var onTap func()
+		if item.Disabled {
+			style.Color = m.DisabledTextColor
+		} else {
+			onTap = func() {
+				if m.OnDismiss != nil {
+					m.OnDismiss()
+				}
+				if item.OnSelected != nil {
+					item.OnSelected()
+				}
+			}
+		}
+		items = append(items, popupMenuEntry{
+			item:      item,
+			checkable: checkable,
+			style:     style,
+			padding:   m.ItemPadding,
+			height:    m.ItemHeight,
+			highlight: m.HighlightColor,
+			hint:      fmt.Sprintf("Item %d of %d", position, count),
+			onTap:     onTap,
+		})
+	}
+
+	var shadow *graphics.BoxShadow
+	if m.ShadowColor != graphics.ColorTransparent {
+		shadow = graphics.BoxShadowElevation(2, m.ShadowColor)
+	}
+	return FocusScope{
+		Trap:       true,
+		OnKeyEvent: m.handleKey,
+		Child: Semantics{
+			Role:      semantics.SemanticsRoleMenu,
+			Container: true,
+			Child: DecoratedBox{
+				Color:        m.BackgroundColor,
+				BorderRadius: m.BorderRadius,
+				Shadow:       shadow,
+				Child:        popupMenuItems{minWidth: m.MinWidth, children: items},
+			},
+		},
+	}
+}
+
+// handleKey moves the keyboard highlight between items and closes the menu
+// on Escape. The highlighted item handles Enter and Space itself.
+func (m PopupMenu) handleKey(event focus.KeyEvent) focus.KeyEventResult {
+	switch event.Key {
+	case "ArrowDown":
+		focus.GetFocusManager().MoveFocus(1)
+	case "ArrowUp":
+		focus.GetFocusManager().MoveFocus(-1)
+	case "Escape":
+		if m.OnDismiss == nil {
+			return focus.KeyEventIgnored
+		}
+		m.OnDismiss()
+	default:
+		return focus.KeyEventIgnored
+	}
+	return focus.KeyEventHandled
+}
+
+// popupMenuEntry is one selectable row of a menu. It registers a focus node
+// so the keyboard can highlight and select it.
+type popupMenuEntry struct {
+	core.StatefulBase
+	item      PopupMenuItem
+	checkable bool
+	style     graphics.TextStyle
+	padding   layout.EdgeInsets
+	height    float64
+	highlight graphics.Color
+	hint      string
+	onTap     func()
+}
+
+func (e popupMenuEntry) CreateState() core.State {
+	return &popupMenuEntryState{}
+}
+
+type popupMenuEntryState struct {
+	core.StateBase
+	node *focus.FocusNode
+}
+
+func (s *popupMenuEntryState) widget() popupMenuEntry {
+	return s.Element().Widget().(popupMenuEntry)
+}
+
+func (s *popupMenuEntryState) InitState() {
+	s.node = &focus.FocusNode{
+		CanRequestFocus: s.widget().onTap != nil,
+		DebugLabel:      "PopupMenuItem",
+		OnFocusChange: func(bool) {
+			if !s.IsDisposed() {
+				s.SetState(func() {})
+			}
+		},
+		OnKeyEvent: func(event focus.KeyEvent) focus.KeyEventResult {
+			onTap := s.widget().onTap
+			if onTap == nil || (event.Key != "Enter" && event.Key != " ") {
+				return focus.KeyEventIgnored
+			}
+			onTap()
+			return focus.KeyEventHandled
+		},
+	}
+	FocusScopeOf(s.Element()).Attach(s.node)
+}
+
+func (s *popupMenuEntryState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	s.node.CanRequestFocus = s.widget().onTap != nil
+	if !s.node.CanRequestFocus {
+		s.node.Unfocus()
+	}
+}
+
+func (s *popupMenuEntryState) Dispose() {
+	s.node.OnFocusChange = nil
+	if scope := s.node.Scope(); scope != nil {
+		scope.Detach(s.node)
+	}
+	s.StateBase.Dispose()
+}
+
+func (s *popupMenuEntryState) Build(ctx core.BuildContext) core.Widget {
+	e := s.widget()
+	children := make([]core.Widget, 0, 2)
+	flags := semantics.SemanticsHasEnabledState
+	if e.onTap != nil {
+		flags = flags.Set(semantics.SemanticsIsEnabled)
+	}
+	if e.checkable {
+		mark := ""
+		if e.item.Checkable && e.item.Checked {
+			mark = "✓"
+		}
+		children = append(children, SizedBox{
+			Width: popupMenuCheckWidth,
+			Child: Text{Content: mark, Style: e.style, MaxLines: 1},
+		})
+	}
+	if e.item.Checkable {
+		flags = flags.Set(semantics.SemanticsHasCheckedState)
+		if e.item.Checked {
+			flags = flags.Set(semantics.SemanticsIsChecked)
+		}
+	}
+	children = append(children, Text{Content: e.item.Label, Style: e.style, MaxLines: 1, Wrap: graphics.TextWrapNoWrap})
+
+	var row core.Widget = Padding{
+		Padding: e.padding,
+		Child: Row{
+			CrossAxisAlignment: CrossAxisAlignmentCenter,
+			Children:           children,
+		},
+	}
+	if e.height > 0 {
+		row = SizedBox{Height: e.height, Child: row}
+	}
+	if s.node.HasPrimaryFocus() {
+		row = DecoratedBox{Color: e.highlight, Child: row}
+	}
+	return Semantics{
+		Role:             semantics.SemanticsRoleMenuItem,
+		Flags:            flags,
+		Hint:             e.hint,
+		Container:        true,
+		MergeDescendants: true,
+		OnTap:            e.onTap,
+		Child:            GestureDetector{OnTap: e.onTap, Child: row},
+	}
+}
+
+// popupMenuItems stacks menu items at the width of the widest, so their tap
+// targets line up.
+type popupMenuItems struct {
+	core.RenderObjectBase
+	minWidth float64
+	children []core.Widget
+}
+
+func (p popupMenuItems) ChildrenWidgets() []core.Widget {
+	return p.children
+}
+
+func (p popupMenuItems) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderPopupMenuItems{minWidth: p.minWidth}
+	box.SetSelf(box)
+	return box
+}
+
+func (p popupMenuItems) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderPopupMenuItems); ok {
+		box.minWidth = p.minWidth
+		box.MarkNeedsLayout()
+	}
+}
+
+type renderPopupMenuItems struct {
+	layout.RenderBoxBase
+	children []layout.RenderBox
+	minWidth float64
+}
+
+func (r *renderPopupMenuItems) SetChildren(children []layout.RenderObject) {
+	for _, child := range r.children {
+		layout.SetParentOnChild(child, nil)
+	}
+	r.children = r.children[:0]
+	for _, child := range children {
+		if box, ok := child.(layout.RenderBox); ok {
+			r.children = append(r.children, box)
+			layout.SetParentOnChild(box, r)
+		}
+	}
+}
+
+func (r *renderPopupMenuItems) VisitChildren(visitor func(layout.RenderObject)) {
+	for _, child := range r.children {
+		visitor(child)
+	}
+}
+
+func (r *renderPopupMenuItems) PerformLayout() {
+	constraints := r.Constraints()
+	width := min(r.minWidth, constraints.MaxWidth)
+	loose := layout.Constraints{MaxWidth: constraints.MaxWidth, MaxHeight: math.Inf(1)}
+	for _, child := range r.children {
+		child.Layout(loose, true)
+		width = max(width, child.Size().Width)
+	}
+	var height float64
+	for _, child := range r.children {
+		child.Layout(layout.Constraints{MinWidth: width, MaxWidth: width, MaxHeight: math.Inf(1)}, true)
+		child.SetParentData(&layout.BoxParentData{Offset: graphics.Offset{Y: height}})
+		height += child.Size().Height
+	}
+	r.SetSize(constraints.Constrain(graphics.Size{Width: width, Height: height}))
+}
+
+func (r *renderPopupMenuItems) Paint(ctx *layout.PaintContext) {
+	for _, child := range r.children {
+		ctx.PaintChildWithLayer(child, getChildOffset(child))
+	}
+}
+
+func (r *renderPopupMenuItems) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	for _, child := range r.children {
+		offset := getChildOffset(child)
+		if child.HitTest(graphics.Offset{X: position.X - offset.X, Y: position.Y - offset.Y}, result) {
+			return true
+		}
+	}
+	return false
+}
+
+// PopupMenuHost shows the menus requested with [ShowPopupMenu] below it.
+// Show places menu next to anchor, which is the render object of the widget
+// that asked.
+//
+// The overlay package's Overlay provides one, so menus work anywhere inside
+// a Navigator. Provide your own to show menus some other way.
+type PopupMenuHost struct {
+	core.InheritedBase
+
+	// Show displays menu next to anchor.
+	Show func(anchor layout.RenderObject, menu PopupMenu)
+	// ShowContextMenu displays menu's panel next to anchor, with its preview
+	// lifted over anchor. Optional: without it, [ShowContextMenu] falls back
+	// to Show and the preview is dropped.
+	ShowContextMenu func(anchor layout.RenderObject, menu ContextMenu)
+	// Child is the subtree that can show menus.
+	Child core.Widget
+}
+
+func (h PopupMenuHost) ChildWidget() core.Widget { return h.Child }
+
+func (h PopupMenuHost) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	return false
+}
+
+// ShowPopupMenu shows menu next to the widget that ctx belongs to, through
+// the nearest [PopupMenuHost]. It reports false if there is no host.
+//
+// Call it from an event handler with the context of the widget the menu
+// belongs to, such as a "more" button:
+//
+//	widgets.GestureDetector{
+//	    OnTap: func() { widgets.ShowPopupMenu(ctx, theme.PopupMenuOf(ctx, items...)) },
+//	    Child: moreIcon,
+//	}
+func ShowPopupMenu(ctx core.BuildContext, menu PopupMenu) bool {
+	host, anchor, ok := popupMenuHostOf(ctx)
+	if !ok || host.Show == nil {
+		return false
+	}
+	host.Show(anchor, menu)
+	return true
+}
+
+// ShowContextMenu shows menu for the widget that ctx belongs to, through
+// the nearest [PopupMenuHost]. Hosts without ShowContextMenu show the plain
+// menu instead. It reports false if there is no host.
+func ShowContextMenu(ctx core.BuildContext, menu ContextMenu) bool {
+	host, anchor, ok := popupMenuHostOf(ctx)
+	if !ok {
+		return false
+	}
+	switch {
+	case host.ShowContextMenu != nil:
+		host.ShowContextMenu(anchor, menu)
+	case host.Show != nil:
+		host.Show(anchor, menu.Menu)
+	default:
+		return false
+	}
+	return true
+}
+
+// popupMenuHostOf finds the nearest [PopupMenuHost] and the render object
+// of the widget that ctx belongs to.
+func popupMenuHostOf(ctx core.BuildContext) (PopupMenuHost, layout.RenderObject, bool) {
+	element := ctx.FindAncestor(func(e core.Element) bool {
+		_, ok := e.Widget().(PopupMenuHost)
+		return ok
+	})
+	if element == nil {
+		return PopupMenuHost{}, nil, false
+	}
+	anchor, ok := ctx.(interface{ RenderObject() layout.RenderObject })
+	if !ok || anchor.RenderObject() == nil {
+		return PopupMenuHost{}, nil, false
+	}
+	return element.Widget().(PopupMenuHost), anchor.RenderObject(), true
+}
+
+// PopupMenuButton shows a popup menu of Items next to itself when tapped,
+// the usual "more" button of app bars and list rows.
+//
+// PopupMenuButton is explicit by default: Menu supplies the panel's styling
+// and is shown with Items in place of its own. For a themed button, use
+// [theme.PopupMenuButtonOf].
+//
+//	widgets.PopupMenuButton{
+//	    Child: widgets.Icon{Glyph: "⋮", Size: 24, Color: colors.OnSurface},
+//	    Items: []widgets.PopupMenuItem{
+//	        {Label: "Rename", OnSelected: rename},
+//	        {Divider: true},
+//	        {Label: "Delete", OnSelected: remove},
+//	    },
+//	    Menu:          theme.PopupMenuOf(ctx),
+//	    SemanticLabel: "More options",
+//	}
+type PopupMenuButton struct {
+	core.StatelessBase
+
+	// Child is the button's content, typically an icon.
+	Child core.Widget
+	// Items are the menu entries.
+	Items []PopupMenuItem
+	// Menu styles the menu panel. Its Items are replaced with Items.
+	Menu PopupMenu
+	// Disabled ignores taps.
+	Disabled bool
+	// SemanticLabel describes the button for screen readers.
+	SemanticLabel string
+}
+
+func (b PopupMenuButton) Build(ctx core.BuildContext) core.Widget {
+	var onTap func()
+	if !b.Disabled && len(b.Items) > 0 {
+		onTap = func() {
+			menu := b.Menu
+			menu.Items = b.Items
+			ShowPopupMenu(ctx, menu)
+		}
+	}
+	flags := semantics.SemanticsIsButton | semantics.SemanticsHasEnabledState
+	if onTap != nil {
+		flags = flags.Set(semantics.SemanticsIsEnabled)
+	}
+	return Semantics{
+		Label:            b.SemanticLabel,
+		Hint:             "Double tap to show menu",
+		Role:             semantics.SemanticsRoleButton,
+		Flags:            flags,
+		Container:        true,
+		MergeDescendants: true,
+		OnTap:            onTap,
+		Child:            GestureDetector{OnTap: onTap, Child: b.Child},
+	}
+}
+
+// ContextMenu is a popup menu shown for a long press on some content.
+//
+// On iOS the pressed content is lifted above a dimmed page with the menu
+// next to it; set Preview and BarrierColor to get that presentation.
+// Without a Preview the page isn't dimmed and the menu appears like a
+// regular [PopupMenu].
+type ContextMenu struct {
+	// Menu is the menu panel.
+	Menu PopupMenu
+	// Preview is shown over the pressed content while the menu is open.
+	// Optional.
+	Preview core.Widget
+	// BarrierColor dims the page behind the preview and menu.
+	BarrierColor graphics.Color
+}
+
+// ContextMenuArea shows a context menu for Child when it is long pressed.
+//
+// ContextMenuArea is explicit by default: Menu supplies the panel's
+// styling, and the menu is shown without a preview unless Preview is set.
+// For a themed area that adapts to the platform, use
+// [theme.ContextMenuAreaOf].
+//
+//	widgets.ContextMenuArea{
+//	    Child: photo,
+//	    Items: []widgets.PopupMenuItem{
+//	        {Label: "Share", OnSelected: share},
+//	        {Label: "Favorite", Checkable: true, Checked: favorite, OnSelected: toggleFavorite},
+//	    },
+//	    Menu: theme.PopupMenuOf(ctx),
+//	}
+type ContextMenuArea struct {
+	core.StatelessBase
+
+	// Child is the content that can be long pressed.
+	Child core.Widget
+	// Items are the menu entries.
+	Items []PopupMenuItem
+	// Menu styles the menu panel. Its Items are replaced with Items.
+	Menu PopupMenu
+	// Preview is lifted over Child while the menu is open. Optional.
+	Preview core.Widget
+	// BarrierColor dims the page behind the preview and menu.
+	BarrierColor graphics.Color
+	// Haptic plays a medium impact when the menu opens.
+	Haptic bool
+	// Disabled ignores long presses.
+	Disabled bool
+}
+
+func (a ContextMenuArea) Build(ctx core.BuildContext) core.Widget {
+	var onLongPress func()
+	if !a.Disabled && len(a.Items) > 0 {
+		onLongPress = func() {
+			if a.Haptic {
+				platform.Haptics.MediumImpact()
+			}
+			menu := a.Menu
+			menu.Items = a.Items
+			ShowContextMenu(ctx, ContextMenu{Menu: menu, Preview: a.Preview, BarrierColor: a.BarrierColor})
+		}
+	}
+	return Semantics{
+		OnLongPress: onLongPress,
+		Child:       GestureDetector{OnLongPress: onLongPress, Child: a.Child},
+	}
+}
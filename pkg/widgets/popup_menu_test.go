@@ -0,0 +1,156 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/focus"
+	"github.com/go-drift/drift/pkg/layout"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestPopupMenuButton_ShowsItems(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var shown *widgets.PopupMenu
+	tester.PumpWidget(widgets.PopupMenuHost{
+		Show: func(anchor layout.RenderObject, menu widgets.PopupMenu) { shown = &menu },
+		Child: widgets.Center{Child: widgets.PopupMenuButton{
+			Child: widgets.SizedBox{Width: 40, Height: 40},
+			Items: []widgets.PopupMenuItem{{Label: "Rename"}, {Divider: true}, {Label: "Delete"}},
+			Menu:  widgets.PopupMenu{ItemHeight: 48},
+		}},
+	})
+
+	if err := tester.Tap(drifttest.ByType[widgets.PopupMenuButton]()); err != nil {
+		t.Fatal(err)
+	}
+	if shown == nil {
+		t.Fatal("expected the button to show a menu")
+	}
+	if len(shown.Items) != 3 || shown.Items[2].Label != "Delete" || shown.ItemHeight != 48 {
+		t.Errorf("expected the menu styled by Menu with the button's items, got %+v", *shown)
+	}
+}
+
+func TestShowContextMenu_FallsBackToPopupMenu(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var shown *widgets.PopupMenu
+	tester.PumpWidget(widgets.PopupMenuHost{
+		Show: func(anchor layout.RenderObject, menu widgets.PopupMenu) { shown = &menu },
+		Child: widgets.Center{Child: contextMenuProbe{menu: widgets.ContextMenu{
+			Menu:    widgets.PopupMenu{Items: []widgets.PopupMenuItem{{Label: "Share"}}},
+			Preview: widgets.SizedBox{Width: 40, Height: 40},
+		}}},
+	})
+
+	if err := tester.Tap(drifttest.ByType[contextMenuProbe]()); err != nil {
+		t.Fatal(err)
+	}
+	if shown == nil || len(shown.Items) != 1 || shown.Items[0].Label != "Share" {
+		t.Errorf("expected a host without ShowContextMenu to show the plain menu, got %v", shown)
+	}
+}
+
+// contextMenuProbe shows menu as a context menu when tapped.
+type contextMenuProbe struct {
+	core.StatelessBase
+	menu widgets.ContextMenu
+}
+
+func (p contextMenuProbe) Build(ctx core.BuildContext) core.Widget {
+	return widgets.GestureDetector{
+		OnTap: func() { widgets.ShowContextMenu(ctx, p.menu) },
+		Child: widgets.SizedBox{Width: 40, Height: 40},
+	}
+}
+
+func TestPopupMenu_KeyboardNavigation(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	manager := focus.GetFocusManager()
+
+	selected := ""
+	var setOpen func(bool)
+	build := func(open bool) core.Widget {
+		if !open {
+			return widgets.SizedBox{}
+		}
+		return widgets.PopupMenu{
+			Items: []widgets.PopupMenuItem{
+				{Label: "Rename", OnSelected: func() { selected = "rename" }},
+				{Label: "Delete", OnSelected: func() { selected = "delete" }, Disabled: true},
+				{Divider: true},
+				{Label: "Archive", OnSelected: func() { selected = "archive" }},
+			},
+			OnDismiss:  func() { setOpen(false) },
+			ItemHeight: 48,
+		}
+	}
+	err := tester.PumpWidget(dialogHost{
+		build: build,
+		bind:  func(fn func(bool)) { setOpen = fn },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	open := func() {
+		t.Helper()
+		setOpen(true)
+		if err := tester.Pump(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	press := func(key string) focus.KeyEventResult {
+		t.Helper()
+		result := manager.HandleKeyEvent(focus.KeyEvent{Key: key})
+		if err := tester.Pump(); err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+	isOpen := func() bool {
+		return tester.Find(drifttest.ByType[widgets.PopupMenu]()).Exists()
+	}
+
+	open()
+	press("ArrowDown")
+	press("ArrowDown")
+	if press("Enter") != focus.KeyEventHandled {
+		t.Error("expected Enter on the highlighted item to be handled")
+	}
+	if selected != "archive" || isOpen() {
+		t.Errorf("expected ArrowDown to skip the disabled item and Enter to select Archive, got %q (open: %v)", selected, isOpen())
+	}
+
+	open()
+	press("ArrowUp")
+	press("ArrowUp")
+	press(" ")
+	if selected != "rename" || isOpen() {
+		t.Errorf("expected ArrowUp to wrap from the end and Space to select Rename, got %q (open: %v)", selected, isOpen())
+	}
+
+	open()
+	if press("Escape") != focus.KeyEventHandled || isOpen() {
+		t.Error("expected Escape to close the menu")
+	}
+}
+
+func TestPopupMenu_CheckedItems(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(widgets.PopupMenu{
+		Items: []widgets.PopupMenuItem{
+			{Label: "Grid", Checkable: true, Checked: true},
+			{Label: "List", Checkable: true},
+			{Divider: true},
+			{Label: "Refresh"},
+		},
+	})
+
+	if n := tester.Find(drifttest.ByText("✓")).Count(); n != 1 {
+		t.Errorf("expected one check mark, got %d", n)
+	}
+	if !tester.Find(drifttest.ByType[widgets.Divider]()).Exists() {
+		t.Error("expected a divider entry")
+	}
+}
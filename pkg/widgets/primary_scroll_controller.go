@@ -0,0 +1,72 @@
+package widgets
+
+import (
+	"reflect"
+
+	"github.com/go-drift/drift/pkg/core"
+)
+
+// PrimaryScrollController provides the [ScrollController] of a screen's main
+// scrollable to descendants.
+//
+// A vertical [ScrollView] or [ListViewBuilder] without its own Controller
+// attaches to the primary controller, and widgets such as [AppBar] and
+// [ScrollUnderElevation] listen to it to react when content scrolls
+// underneath them. Page routes insert a [PrimaryScrollScope] around each page,
+// so this works without passing a controller around by hand.
+//
+// A scroll view that attaches to the primary controller hides it from its own
+// descendants, so nested scrollables do not fight over the same controller.
+// Provide a PrimaryScrollController with a nil Controller to opt a subtree out.
+type PrimaryScrollController struct {
+	core.InheritedBase
+	Controller *ScrollController
+	Child      core.Widget
+}
+
+func (p PrimaryScrollController) ChildWidget() core.Widget { return p.Child }
+
+func (p PrimaryScrollController) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(PrimaryScrollController); ok {
+		return p.Controller != old.Controller
+	}
+	return true
+}
+
+var primaryScrollControllerType = reflect.TypeFor[PrimaryScrollController]()
+
+// PrimaryScrollControllerOf returns the nearest primary scroll controller, or
+// nil if there is none.
+func PrimaryScrollControllerOf(ctx core.BuildContext) *ScrollController {
+	if p, ok := ctx.DependOnInherited(primaryScrollControllerType, nil).(PrimaryScrollController); ok {
+		return p.Controller
+	}
+	return nil
+}
+
+// PrimaryScrollScope creates a [ScrollController] for its lifetime and
+// provides it to descendants as the [PrimaryScrollController].
+type PrimaryScrollScope struct {
+	core.StatefulBase
+
+	Child core.Widget
+}
+
+func (p PrimaryScrollScope) CreateState() core.State {
+	return &primaryScrollScopeState{}
+}
+
+type primaryScrollScopeState struct {
+	core.StateBase
+	controller *ScrollController
+}
+
+func (s *primaryScrollScopeState) InitState() {
+	s.controller = NewScrollController(0)
+	core.UseDisposable(s, s.controller)
+}
+
+func (s *primaryScrollScopeState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(PrimaryScrollScope)
+	return PrimaryScrollController{Controller: s.controller, Child: w.Child}
+}
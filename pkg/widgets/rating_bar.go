@@ -0,0 +1,385 @@
+package widgets
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// RatingBar shows a rating as a row of stars and lets the user set it by
+// tapping a star or dragging across the row.
+//
+// # Styling Model
+//
+// RatingBar is explicit by default — all visual properties use their struct
+// field values directly. A zero value means zero, not "use theme default."
+// For example:
+//
+//   - FilledColor: 0 means filled stars are transparent
+//   - ItemSize: 0 means zero size (not rendered)
+//
+// For a theme-styled rating bar, use [theme.RatingBarOf] which pre-fills
+// visual properties from the current theme.
+//
+// # Creation Patterns
+//
+// Explicit with struct literal (full control):
+//
+//	widgets.RatingBar{
+//	    Value:       s.rating,
+//	    ItemCount:   5,
+//	    AllowHalf:   true,
+//	    OnChanged:   func(v float64) { s.SetState(func() { s.rating = v }) },
+//	    FilledColor: graphics.RGB(255, 193, 7),
+//	    EmptyColor:  graphics.RGB(224, 224, 224),
+//	    ItemSize:    32,
+//	    Spacing:     4,
+//	}
+//
+// Themed (reads from current theme):
+//
+//	theme.RatingBarOf(ctx, s.rating, onChanged)
+//
+// RatingBar is a controlled component: it shows Value and calls OnChanged
+// while the user taps or drags. Without OnChanged it is a read-only
+// display, and a fractional Value fills part of a star.
+//
+// Screen readers treat the bar as an adjustable control that steps by one
+// star, or half a star with AllowHalf.
+type RatingBar struct {
+	core.StatelessBase
+
+	// Value is the rating, from 0 to ItemCount.
+	Value float64
+	// ItemCount is the number of stars.
+	ItemCount int
+	// AllowHalf lets taps and drags select half stars.
+	AllowHalf bool
+	// OnChanged is called with the new rating while the user taps or drags.
+	OnChanged func(float64)
+	// Disabled disables interaction and fades the bar when true.
+	Disabled bool
+
+	// ItemBuilder builds the item at index, filled by fill from 0 to 1, in
+	// place of the default star. Optional. Each item is sized ItemSize
+	// square.
+	ItemBuilder func(index int, fill float64) core.Widget
+	// FilledColor is the color of the filled part of the default stars.
+	FilledColor graphics.Color
+	// EmptyColor is the color of the unfilled part of the default stars.
+	EmptyColor graphics.Color
+	// ItemSize is the width and height of each item.
+	ItemSize float64
+	// Spacing is the gap between items.
+	Spacing float64
+}
+
+func (r RatingBar) Build(ctx core.BuildContext) core.Widget {
+	enabled := !r.Disabled && r.OnChanged != nil
+	rtl := DirectionalityOf(ctx) == graphics.TextDirectionRTL
+
+	items := make([]core.Widget, 0, 2*r.ItemCount)
+	for i := range r.ItemCount {
+		if i > 0 && r.Spacing > 0 {
+			items = append(items, SizedBox{Width: r.Spacing})
+		}
+		fill := min(max(r.Value-float64(i), 0), 1)
+		var item core.Widget
+		if r.ItemBuilder != nil {
+			item = r.ItemBuilder(i, fill)
+		} else {
+			item = ratingStar{fill: fill, rtl: rtl, filledColor: r.FilledColor, emptyColor: r.EmptyColor}
+		}
+		items = append(items, SizedBox{Width: r.ItemSize, Height: r.ItemSize, Child: item})
+	}
+
+	var result core.Widget = ratingGesture{
+		bar:     r,
+		enabled: enabled,
+		rtl:     rtl,
+		child:   Row{Children: items, MainAxisSize: MainAxisSizeMin},
+	}
+	if !enabled && r.OnChanged != nil {
+		result = Opacity{Opacity: 0.5, Child: result}
+	}
+
+	value, minValue, maxValue := min(max(r.Value, 0), float64(r.ItemCount)), 0.0, float64(r.ItemCount)
+	flags := semantics.SemanticsIsSlider | semantics.SemanticsHasEnabledState
+	var onIncrease, onDecrease func()
+	if enabled {
+		flags = flags.Set(semantics.SemanticsIsEnabled)
+		onIncrease = func() { r.setValue(r.Value + r.step()) }
+		onDecrease = func() { r.setValue(r.Value - r.step()) }
+	}
+	return Semantics{
+		Value:        fmt.Sprintf("%s of %d", strconv.FormatFloat(value, 'f', -1, 64), r.ItemCount),
+		Role:         semantics.SemanticsRoleSlider,
+		Flags:        flags,
+		Container:    true,
+		CurrentValue: &value,
+		MinValue:     &minValue,
+		MaxValue:     &maxValue,
+		OnIncrease:   onIncrease,
+		OnDecrease:   onDecrease,
+		Child:        result,
+	}
+}
+
+// step returns the smallest change in rating.
+func (r RatingBar) step() float64 {
+	if r.AllowHalf {
+		return 0.5
+	}
+	return 1
+}
+
+func (r RatingBar) setValue(value float64) {
+	value = min(max(value, 0), float64(r.ItemCount))
+	if r.OnChanged != nil && value != r.Value {
+		r.OnChanged(value)
+	}
+}
+
+// valueAt returns the rating for a local x position from the start of the
+// bar: the star under x counts in full, or by half in its first half with
+// AllowHalf. Positions before the first star select the smallest rating.
+func (r RatingBar) valueAt(x float64) float64 {
+	slot := r.ItemSize + r.Spacing
+	if r.ItemCount <= 0 || slot <= 0 {
+		return 0
+	}
+	index := min(max(math.Floor(x/slot), 0), float64(r.ItemCount-1))
+	if r.AllowHalf && r.ItemSize > 0 && x-index*slot <= r.ItemSize/2 {
+		return index + 0.5
+	}
+	return index + 1
+}
+
+// ratingGesture turns taps and horizontal drags on its child into rating
+// changes, like the track of a [Slider].
+type ratingGesture struct {
+	core.RenderObjectBase
+	bar     RatingBar
+	enabled bool
+	rtl     bool
+	child   core.Widget
+}
+
+func (g ratingGesture) ChildWidget() core.Widget {
+	return g.child
+}
+
+func (g ratingGesture) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderRatingGesture{}
+	r.SetSelf(r)
+	g.UpdateRenderObject(ctx, r)
+	return r
+}
+
+func (g ratingGesture) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderRatingGesture); ok {
+		r.bar = g.bar
+		r.enabled = g.enabled
+		r.rtl = g.rtl
+	}
+}
+
+type renderRatingGesture struct {
+	layout.RenderBoxBase
+	child   layout.RenderBox
+	bar     RatingBar
+	enabled bool
+	rtl     bool
+	tap     *gestures.TapGestureRecognizer
+	drag    *gestures.HorizontalDragGestureRecognizer
+
+	// downLocalX and downGlobalX map global pointer positions to local
+	// positions for the gesture in progress.
+	downLocalX  float64
+	downGlobalX float64
+}
+
+func (r *renderRatingGesture) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderRatingGesture) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderRatingGesture) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child == nil {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+		return
+	}
+	r.child.Layout(constraints, true)
+	r.child.SetParentData(&layout.BoxParentData{})
+	r.SetSize(r.child.Size())
+}
+
+func (r *renderRatingGesture) Paint(ctx *layout.PaintContext) {
+	if r.child != nil {
+		ctx.PaintChildWithLayer(r.child, graphics.Offset{})
+	}
+}
+
+func (r *renderRatingGesture) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	r.downLocalX = position.X
+	result.Add(r)
+	return true
+}
+
+// valueAt returns the rating for a local x position, mirrored for
+// right-to-left text.
+func (r *renderRatingGesture) valueAt(x float64) float64 {
+	if r.rtl {
+		x = r.Size().Width - x
+	}
+	return r.bar.valueAt(x)
+}
+
+func (r *renderRatingGesture) HandlePointer(event gestures.PointerEvent) {
+	if !r.enabled {
+		return
+	}
+	if r.tap == nil {
+		r.tap = gestures.NewTapGestureRecognizer(r.GestureArena())
+		r.tap.OnTap = func() {
+			r.bar.setValue(r.valueAt(r.downLocalX))
+		}
+	}
+	if r.drag == nil {
+		r.drag = gestures.NewHorizontalDragGestureRecognizer(r.GestureArena())
+		r.drag.OnUpdate = func(details gestures.DragUpdateDetails) {
+			r.bar.setValue(r.valueAt(r.downLocalX + details.Position.X - r.downGlobalX))
+		}
+	}
+	if event.Phase == gestures.PointerPhaseDown {
+		r.downGlobalX = event.Position.X
+		r.tap.AddPointer(event)
+		r.drag.AddPointer(event)
+	} else {
+		r.tap.HandleEvent(event)
+		r.drag.HandleEvent(event)
+	}
+}
+
+// Dispose releases the gesture recognizers.
+func (r *renderRatingGesture) Dispose() {
+	if r.tap != nil {
+		r.tap.Dispose()
+		r.tap = nil
+	}
+	if r.drag != nil {
+		r.drag.Dispose()
+		r.drag = nil
+	}
+	r.RenderBoxBase.Dispose()
+}
+
+// ratingStar paints a five-pointed star filling its box, with the leading
+// fill fraction of it in filledColor and the rest in emptyColor.
+type ratingStar struct {
+	core.RenderObjectBase
+	fill        float64
+	rtl         bool
+	filledColor graphics.Color
+	emptyColor  graphics.Color
+}
+
+func (s ratingStar) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderRatingStar{}
+	r.SetSelf(r)
+	s.UpdateRenderObject(ctx, r)
+	return r
+}
+
+func (s ratingStar) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderRatingStar); ok {
+		r.fill = s.fill
+		r.rtl = s.rtl
+		r.filledColor = s.filledColor
+		r.emptyColor = s.emptyColor
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderRatingStar struct {
+	layout.RenderBoxBase
+	fill        float64
+	rtl         bool
+	filledColor graphics.Color
+	emptyColor  graphics.Color
+}
+
+func (r *renderRatingStar) PerformLayout() {
+	constraints := r.Constraints()
+	r.SetSize(graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight})
+}
+
+func (r *renderRatingStar) Paint(ctx *layout.PaintContext) {
+	size := r.Size()
+	path := starPath(size)
+
+	if r.fill < 1 {
+		empty := graphics.DefaultPaint()
+		empty.Color = r.emptyColor
+		ctx.Canvas.DrawPath(path, empty)
+	}
+	if r.fill <= 0 {
+		return
+	}
+	filledWidth := size.Width * r.fill
+	left := 0.0
+	if r.rtl {
+		left = size.Width - filledWidth
+	}
+	filled := graphics.DefaultPaint()
+	filled.Color = r.filledColor
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRect(graphics.RectFromLTWH(left, 0, filledWidth, size.Height))
+	ctx.Canvas.DrawPath(path, filled)
+	ctx.Canvas.Restore()
+}
+
+// HitTest leaves pointers to the rating bar's gesture handling.
+func (r *renderRatingStar) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
+
+// starPath returns a five-pointed star, point up, inscribed in size.
+func starPath(size graphics.Size) *graphics.Path {
+	cx, cy := size.Width/2, size.Height/2
+	outer := min(size.Width, size.Height) / 2
+	inner := outer * 0.4
+	path := graphics.NewPath()
+	for i := range 10 {
+		radius := outer
+		if i%2 == 1 {
+			radius = inner
+		}
+		angle := -math.Pi/2 + float64(i)*math.Pi/5
+		x, y := cx+radius*math.Cos(angle), cy+radius*math.Sin(angle)
+		if i == 0 {
+			path.MoveTo(x, y)
+		} else {
+			path.LineTo(x, y)
+		}
+	}
+	path.Close()
+	return path
+}
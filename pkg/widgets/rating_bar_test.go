@@ -0,0 +1,87 @@
+package widgets_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestRatingBar_TapAndDrag(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 300, Height: 200})
+	var changes []float64
+	tester.PumpWidget(segmentHost[float64]{
+		initial: 1,
+		build: func(value float64, onChanged func(float64)) core.Widget {
+			return widgets.Row{Children: []core.Widget{widgets.RatingBar{
+				Value:     value,
+				ItemCount: 5,
+				AllowHalf: true,
+				OnChanged: func(v float64) {
+					changes = append(changes, v)
+					onChanged(v)
+				},
+				ItemSize: 20,
+				Spacing:  10,
+			}}}
+		},
+	})
+
+	// Stars start every 30 points; the bar is centered vertically.
+	tester.TapAt(graphics.Offset{X: 75, Y: 100})
+	tester.Pump()
+	tester.TapAt(graphics.Offset{X: 95, Y: 100})
+	tester.Pump()
+	if !slices.Equal(changes, []float64{3, 3.5}) {
+		t.Errorf("expected a full third star then half the fourth, got %v", changes)
+	}
+
+	tester.DragFrom(graphics.Offset{X: 5, Y: 100}, graphics.Offset{X: 200, Y: 0})
+	tester.Pump()
+	if last := changes[len(changes)-1]; last != 5 {
+		t.Errorf("expected dragging past the last star to rate 5, got %v", last)
+	}
+}
+
+func TestRatingBar_AdjustableSemantics(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var changed []float64
+	tester.PumpWidget(widgets.RatingBar{
+		Value:     4.5,
+		ItemCount: 5,
+		AllowHalf: true,
+		OnChanged: func(v float64) { changed = append(changed, v) },
+		ItemSize:  20,
+	})
+
+	node := tester.Find(drifttest.ByType[widgets.Semantics]()).Widget().(widgets.Semantics)
+	if node.Value != "4.5 of 5" || *node.CurrentValue != 4.5 || *node.MaxValue != 5 {
+		t.Errorf("expected the rating in the semantics value, got %q", node.Value)
+	}
+	node.OnIncrease()
+	node.OnDecrease()
+	if !slices.Equal(changed, []float64{5, 4}) {
+		t.Errorf("expected increase and decrease to step by half a star, got %v", changed)
+	}
+}
+
+func TestRatingBar_ItemBuilderFill(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var fills []float64
+	tester.PumpWidget(widgets.RatingBar{
+		Value:     2.25,
+		ItemCount: 4,
+		ItemSize:  20,
+		ItemBuilder: func(index int, fill float64) core.Widget {
+			fills = append(fills, fill)
+			return widgets.SizedBox{}
+		},
+	})
+	if !slices.Equal(fills, []float64{1, 1, 0.25, 0}) {
+		t.Errorf("expected each item's share of the rating, got %v", fills)
+	}
+}
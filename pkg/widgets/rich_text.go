@@ -1,7 +1,10 @@
 package widgets
 
 import (
+	"slices"
+
 	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
 )
@@ -36,6 +39,25 @@ type RichText struct {
 	// ([graphics.TextWrapWrap]) wraps text at the constraint width.
 	// Set to [graphics.TextWrapNoWrap] for single-line text.
 	Wrap graphics.TextWrap
+	// TapTargets make ranges of the content tappable. Taps outside every
+	// target are left to ancestor gesture detectors. See [Linkify].
+	TapTargets []TextTapTarget
+	// Highlights styles ranges of the content, given as byte offsets into
+	// its plain text, for example search matches from
+	// [graphics.SearchHighlighter]. As with [Text], highlights that only set
+	// BackgroundColor are painted without laying the text out again.
+	Highlights []graphics.TextHighlight
+}
+
+// TextTapTarget makes a range of [RichText] content tappable.
+type TextTapTarget struct {
+	// Start is the byte offset, in the content's plain text, of the first
+	// tappable byte.
+	Start int
+	// End is the byte offset just past the tappable range.
+	End int
+	// OnTap is called when the range is tapped.
+	OnTap func()
 }
 
 // WithStyle returns a copy with the given widget-level default style.
@@ -64,12 +86,15 @@ func (r RichText) WithAlign(align graphics.TextAlign) RichText {
 
 func (r RichText) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 	ro := &renderRichText{
-		span:      r.Content,
-		text:      r.Content.PlainText(),
-		baseStyle: r.Style,
-		align:     r.Align,
-		maxLines:  r.MaxLines,
-		wrapMode:  r.Wrap,
+		span:       r.Content,
+		text:       r.Content.PlainText(),
+		baseStyle:  r.Style,
+		align:      r.Align.Resolve(DirectionalityOf(ctx)),
+		maxLines:   r.MaxLines,
+		wrapMode:   r.Wrap,
+		targets:    r.TapTargets,
+		highlights: r.Highlights,
+		textScale:  TextScaleFactorOf(ctx),
 	}
 	ro.SetSelf(ro)
 	return ro
@@ -77,13 +102,18 @@ func (r RichText) CreateRenderObject(ctx core.BuildContext) layout.RenderObject
 
 func (r RichText) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if ro, ok := renderObject.(*renderRichText); ok {
-		ro.span = r.Content
-		ro.text = r.Content.PlainText()
-		ro.baseStyle = r.Style
-		ro.align = r.Align
+		if !r.Content.Equal(ro.span) || r.Style != ro.baseStyle {
+			ro.span = r.Content
+			ro.text = r.Content.PlainText()
+			ro.baseStyle = r.Style
+			ro.generation++
+		}
+		ro.align = r.Align.Resolve(DirectionalityOf(ctx))
 		ro.maxLines = r.MaxLines
 		ro.wrapMode = r.Wrap
-		ro.generation++
+		ro.targets = r.TapTargets
+		ro.highlights = r.Highlights
+		ro.textScale = TextScaleFactorOf(ctx)
 		ro.MarkNeedsLayout()
 		ro.MarkNeedsPaint()
 	}
@@ -98,8 +128,16 @@ type renderRichText struct {
 	textLayout *graphics.TextLayout
 	maxLines   int
 	wrapMode   graphics.TextWrap
+	textScale  float64
 	generation uint64
 	cache      richTextLayoutCache
+	targets    []TextTapTarget
+	highlights []graphics.TextHighlight
+	// cachedHighlights are the restyling highlights shaped into textLayout.
+	cachedHighlights []graphics.TextHighlight
+	tap              *gestures.TapGestureRecognizer
+	// hitTarget is the tap target under the last hit-tested position.
+	hitTarget *TextTapTarget
 }
 
 type richTextLayoutCache struct {
@@ -108,6 +146,7 @@ type richTextLayoutCache struct {
 	maxWidth   float64
 	maxLines   int
 	wrapMode   graphics.TextWrap
+	textScale  float64
 }
 
 func (r *renderRichText) PerformLayout() {
@@ -122,12 +161,18 @@ func (r *renderRichText) PerformLayout() {
 		maxWidth:   maxWidth,
 		maxLines:   r.maxLines,
 		wrapMode:   r.wrapMode,
+		textScale:  r.textScale,
 	}
-	if r.textLayout != nil && r.cache == current {
+	var highlights []graphics.TextHighlight
+	if graphics.HighlightsRestyle(r.highlights) {
+		highlights = r.highlights
+	}
+	if r.textLayout != nil && r.cache == current && slices.Equal(r.cachedHighlights, highlights) {
 		r.SetSize(constraints.Constrain(textLayoutSize(r.textLayout.Size, r.align, maxWidth)))
 		return
 	}
 	r.cache = current
+	r.cachedHighlights = slices.Clone(highlights)
 
 	manager, _ := graphics.DefaultFontManagerErr()
 	if manager == nil {
@@ -136,10 +181,11 @@ func (r *renderRichText) PerformLayout() {
 		return
 	}
 
-	tl, err := graphics.LayoutRichText(r.span, r.baseStyle, manager, graphics.ParagraphOptions{
+	tl, err := graphics.LayoutRichText(graphics.HighlightTextSpan(r.span, highlights), r.baseStyle, manager, graphics.ParagraphOptions{
 		MaxWidth:  maxWidth,
 		MaxLines:  r.maxLines,
 		TextAlign: r.align,
+		TextScale: r.textScale,
 	})
 	if err != nil {
 		r.textLayout = nil
@@ -151,10 +197,19 @@ func (r *renderRichText) PerformLayout() {
 	r.SetSize(constraints.Constrain(textLayoutSize(tl.Size, r.align, maxWidth)))
 }
 
+// DeviceScaleChanged drops the cached paragraph so the next layout shapes
+// the text for the new scale.
+func (r *renderRichText) DeviceScaleChanged(float64) {
+	r.textLayout = nil
+}
+
 func (r *renderRichText) Paint(ctx *layout.PaintContext) {
 	if r.textLayout == nil {
 		return
 	}
+	if len(r.cachedHighlights) == 0 {
+		paintHighlightBackgrounds(ctx.Canvas, r.textLayout, r.highlights)
+	}
 	ctx.Canvas.DrawText(r.textLayout, graphics.Offset{})
 }
 
@@ -162,6 +217,54 @@ func (r *renderRichText) HitTest(position graphics.Offset, result *layout.HitTes
 	if !layout.WithinBounds(position, r.Size()) {
 		return false
 	}
+	r.hitTarget = r.targetAt(position)
 	result.Add(r)
 	return true
 }
+
+// targetAt returns the tap target drawn at position, if any.
+func (r *renderRichText) targetAt(position graphics.Offset) *TextTapTarget {
+	if len(r.targets) == 0 || r.textLayout == nil {
+		return nil
+	}
+	offset, ok := r.textLayout.OffsetAt(position)
+	if !ok {
+		return nil
+	}
+	for i := range r.targets {
+		if t := &r.targets[i]; t.Start <= offset && offset < t.End {
+			return t
+		}
+	}
+	return nil
+}
+
+// HandlePointer implements PointerHandler. Only pointers that went down on a
+// tap target join the gesture arena.
+func (r *renderRichText) HandlePointer(event gestures.PointerEvent) {
+	if event.Phase == gestures.PointerPhaseDown {
+		target := r.hitTarget
+		r.hitTarget = nil
+		if target == nil || target.OnTap == nil {
+			return
+		}
+		if r.tap == nil {
+			r.tap = gestures.NewTapGestureRecognizer(r.GestureArena())
+		}
+		r.tap.OnTap = target.OnTap
+		r.tap.AddPointer(event)
+		return
+	}
+	if r.tap != nil {
+		r.tap.HandleEvent(event)
+	}
+}
+
+// Dispose releases the tap recognizer.
+func (r *renderRichText) Dispose() {
+	if r.tap != nil {
+		r.tap.Dispose()
+		r.tap = nil
+	}
+	r.RenderBoxBase.Dispose()
+}
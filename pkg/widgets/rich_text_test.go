@@ -174,3 +174,16 @@ func TestRichText_AlignCenter_ExpandsWidth(t *testing.T) {
 		t.Errorf("center-aligned rich text width: expected 300, got %v", size.Width)
 	}
 }
+
+func TestRichText_Highlights(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	content := graphics.Spans(graphics.Span("Café "), graphics.Span("au lait").Bold())
+	search := graphics.SearchHighlighter{Query: "e au", FontWeight: graphics.FontWeightBold, Decoration: graphics.TextDecorationUnderline}
+	tester.PumpWidget(widgets.RichText{Content: content, Highlights: search.Highlights(content.PlainText())})
+
+	result := tester.Find(drifttest.ByText("Café au lait"))
+	if !result.Exists() || result.RenderObject() == nil {
+		t.Fatal("expected highlighted RichText to render")
+	}
+}
@@ -0,0 +1,412 @@
+package widgets
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+const (
+	// drawerTransitionDuration is how long a drawer takes to open or close.
+	drawerTransitionDuration = 250 * time.Millisecond
+	// defaultDrawerEdgeDragWidth is the width of the strip along each edge
+	// that opens a drawer when dragged, used when DrawerEdgeDragWidth is zero.
+	defaultDrawerEdgeDragWidth = 20.0
+	// drawerFlingVelocity is the release speed, in drawer widths per
+	// second, above which a drag opens or closes the drawer regardless of
+	// how far it got.
+	drawerFlingVelocity = 1.0
+)
+
+// drawerSide identifies which of a scaffold's drawers is showing.
+type drawerSide int
+
+const (
+	drawerNone drawerSide = iota
+	drawerStart
+	drawerEnd
+)
+
+// DrawerController opens and closes the drawers of a [Scaffold].
+//
+// A zero DrawerController is ready to use. Pass it as Scaffold.Controller,
+// or get the controller of the enclosing scaffold with [DrawerControllerOf].
+// Its methods do nothing until it is attached to a scaffold.
+type DrawerController struct {
+	scaffold *scaffoldState
+}
+
+// OpenDrawer slides in the scaffold's Drawer from the leading edge.
+func (c *DrawerController) OpenDrawer() {
+	if c.scaffold != nil {
+		c.scaffold.open(drawerStart)
+	}
+}
+
+// OpenEndDrawer slides in the scaffold's EndDrawer from the trailing edge.
+func (c *DrawerController) OpenEndDrawer() {
+	if c.scaffold != nil {
+		c.scaffold.open(drawerEnd)
+	}
+}
+
+// Close slides out whichever drawer is showing.
+func (c *DrawerController) Close() {
+	if c.scaffold != nil {
+		c.scaffold.close()
+	}
+}
+
+// IsOpen reports whether a drawer is showing or opening.
+func (c *DrawerController) IsOpen() bool {
+	if c.scaffold == nil || c.scaffold.side == drawerNone {
+		return false
+	}
+	status := c.scaffold.animation.Status()
+	return status == animation.AnimationForward || status == animation.AnimationCompleted
+}
+
+// HasDrawer reports whether the scaffold has a Drawer.
+func (c *DrawerController) HasDrawer() bool {
+	return c.scaffold != nil && c.scaffold.widget().Drawer != nil
+}
+
+// HasEndDrawer reports whether the scaffold has an EndDrawer.
+func (c *DrawerController) HasEndDrawer() bool {
+	return c.scaffold != nil && c.scaffold.widget().EndDrawer != nil
+}
+
+// Scaffold lays out a screen: an app bar above the body, and drawers that
+// slide in over both from the sides.
+//
+// Drawer slides in from the leading edge and EndDrawer from the trailing
+// edge, either by dragging from that edge or through the scaffold's
+// [DrawerController]. An [AppBar] with AutomaticallyImplyLeading shows a
+// menu button that opens Drawer.
+//
+//	widgets.Scaffold{
+//	    AppBar:          theme.AppBarOf(ctx, "Inbox"),
+//	    Body:            inbox,
+//	    Drawer:          theme.DrawerOf(ctx, mailboxes),
+//	    BackgroundColor: colors.Surface,
+//	    ScrimColor:      colors.Scrim.WithAlpha(0.32),
+//	}
+//
+// While a drawer is open, a scrim covers the rest of the screen and closes
+// the drawer when tapped, the drawer can be dragged closed, and keyboard
+// focus and accessibility services are confined to it.
+//
+// # Styling Model
+//
+// Scaffold is explicit by default: a zero BackgroundColor is transparent and
+// a zero ScrimColor draws no scrim. For a themed scaffold, use
+// [theme.ScaffoldOf].
+type Scaffold struct {
+	core.StatefulBase
+
+	// AppBar is shown at the top, typically an [AppBar].
+	AppBar core.Widget
+	// Body is the content below the app bar.
+	Body core.Widget
+	// Drawer slides in from the leading edge, typically a [Drawer].
+	Drawer core.Widget
+	// EndDrawer slides in from the trailing edge, typically a [Drawer].
+	EndDrawer core.Widget
+	// Controller opens and closes the drawers. If nil, the scaffold creates
+	// its own, available through [DrawerControllerOf].
+	Controller *DrawerController
+
+	// BackgroundColor fills the scaffold behind the app bar and body.
+	BackgroundColor graphics.Color
+	// ScrimColor covers the app bar and body while a drawer is open. It
+	// fades in as the drawer opens.
+	ScrimColor graphics.Color
+	// DrawerEdgeDragWidth is the width of the strip along each edge that
+	// opens a drawer when dragged. Defaults to 20.
+	DrawerEdgeDragWidth float64
+	// DisableEdgeDrag stops drags from the edges opening the drawers. They
+	// can still be opened through the controller and dragged closed.
+	DisableEdgeDrag bool
+}
+
+func (s Scaffold) CreateState() core.State {
+	return &scaffoldState{}
+}
+
+type scaffoldState struct {
+	core.StateBase
+	controller *DrawerController
+	own        *DrawerController
+	animation  *animation.AnimationController
+	side       drawerSide
+	extent     float64 // width of the showing drawer, from its last layout
+	dragging   bool
+}
+
+func (s *scaffoldState) widget() Scaffold {
+	return s.Element().Widget().(Scaffold)
+}
+
+func (s *scaffoldState) InitState() {
+	s.animation = animation.NewAnimationController(drawerTransitionDuration)
+	s.animation.Curve = animation.EaseOut
+	core.UseDisposable(s, s.animation)
+	s.animation.AddStatusListener(s.onStatus)
+	s.attach(s.widget().Controller)
+}
+
+func (s *scaffoldState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	if old, ok := oldWidget.(Scaffold); ok && old.Controller != s.widget().Controller {
+		s.attach(s.widget().Controller)
+	}
+	// A drawer removed while showing closes without animating.
+	w := s.widget()
+	if (s.side == drawerStart && w.Drawer == nil) || (s.side == drawerEnd && w.EndDrawer == nil) {
+		s.side = drawerNone
+		s.dragging = false
+		s.animation.SetValue(0)
+	}
+}
+
+func (s *scaffoldState) Dispose() {
+	if s.controller != nil && s.controller.scaffold == s {
+		s.controller.scaffold = nil
+	}
+	s.StateBase.Dispose()
+}
+
+func (s *scaffoldState) attach(controller *DrawerController) {
+	if s.controller != nil && s.controller.scaffold == s {
+		s.controller.scaffold = nil
+	}
+	if controller == nil {
+		if s.own == nil {
+			s.own = &DrawerController{}
+		}
+		controller = s.own
+	}
+	s.controller = controller
+	controller.scaffold = s
+}
+
+// has reports whether the scaffold has a drawer on side.
+func (s *scaffoldState) has(side drawerSide) bool {
+	w := s.widget()
+	return (side == drawerStart && w.Drawer != nil) || (side == drawerEnd && w.EndDrawer != nil)
+}
+
+// show mounts the drawer on side, closed, replacing any other drawer.
+func (s *scaffoldState) show(side drawerSide) {
+	if s.side == side {
+		return
+	}
+	s.animation.SetValue(0)
+	s.SetState(func() { s.side = side })
+}
+
+func (s *scaffoldState) open(side drawerSide) {
+	if !s.has(side) {
+		return
+	}
+	s.dragging = false
+	s.show(side)
+	s.animation.Forward()
+}
+
+func (s *scaffoldState) close() {
+	if s.side == drawerNone {
+		return
+	}
+	s.dragging = false
+	s.animation.Reverse()
+}
+
+// onStatus unmounts the drawer once it has closed.
+func (s *scaffoldState) onStatus(status animation.AnimationStatus) {
+	if status == animation.AnimationDismissed && !s.dragging && s.side != drawerNone {
+		s.SetState(func() { s.side = drawerNone })
+	}
+}
+
+// opensRightward reports whether the drawer on side opens by moving right,
+// which is the case for the drawer on the left edge.
+func (s *scaffoldState) opensRightward(side drawerSide) bool {
+	rtl := DirectionalityOf(s.Element()) == graphics.TextDirectionRTL
+	return (side == drawerStart) != rtl
+}
+
+func (s *scaffoldState) startDrag(side drawerSide) {
+	if !s.has(side) {
+		return
+	}
+	s.animation.Stop()
+	s.show(side)
+	s.dragging = true
+}
+
+// updateDrag moves the showing drawer by delta pixels.
+func (s *scaffoldState) updateDrag(delta float64) {
+	if !s.dragging {
+		return
+	}
+	if !s.opensRightward(s.side) {
+		delta = -delta
+	}
+	s.animation.SetValue(s.animation.Value + delta/s.drawerExtent())
+}
+
+// endDrag opens or closes the drawer depending on how far it was dragged
+// and velocity, the release speed in pixels per second.
+func (s *scaffoldState) endDrag(velocity float64) {
+	if !s.dragging {
+		return
+	}
+	s.dragging = false
+	if !s.opensRightward(s.side) {
+		velocity = -velocity
+	}
+	velocity /= s.drawerExtent()
+	if velocity > drawerFlingVelocity || (velocity >= -drawerFlingVelocity && s.animation.Value >= 0.5) {
+		s.animation.Forward()
+	} else {
+		s.animation.Reverse()
+	}
+}
+
+// drawerExtent returns the width the drawer was last laid out at, or a
+// typical drawer width before its first layout.
+func (s *scaffoldState) drawerExtent() float64 {
+	if s.extent > 0 {
+		return s.extent
+	}
+	return 304
+}
+
+func (s *scaffoldState) Build(ctx core.BuildContext) core.Widget {
+	w := s.widget()
+	column := make([]core.Widget, 0, 2)
+	if w.AppBar != nil {
+		column = append(column, w.AppBar)
+	}
+	body := w.Body
+	if body == nil {
+		body = SizedBox{}
+	}
+	column = append(column, Expanded{Child: body})
+
+	children := []core.Widget{ExcludeSemantics{
+		Excluding: s.side != drawerNone,
+		Child: Container{
+			Color: w.BackgroundColor,
+			Child: Column{CrossAxisAlignment: CrossAxisAlignmentStretch, Children: column},
+		},
+	}}
+
+	// The edge strips stay mounted through an edge drag, which they drive.
+	if !w.DisableEdgeDrag && (s.side == drawerNone || s.dragging) {
+		edge := w.DrawerEdgeDragWidth
+		if edge <= 0 {
+			edge = defaultDrawerEdgeDragWidth
+		}
+		if w.Drawer != nil {
+			children = append(children, Positioned(s.dragDetector(drawerStart, nil)).Start(0).Top(0).Bottom(0).Width(edge))
+		}
+		if w.EndDrawer != nil {
+			children = append(children, Positioned(s.dragDetector(drawerEnd, nil)).End(0).Top(0).Bottom(0).Width(edge))
+		}
+	}
+
+	if s.side != drawerNone {
+		drawer, label := w.Drawer, "Navigation menu"
+		if s.side == drawerEnd {
+			drawer, label = w.EndDrawer, "Menu"
+		}
+		children = append(children, s.dragDetector(s.side, Stack{
+			Fit: StackFitExpand,
+			Children: []core.Widget{
+				Semantics{
+					Label:     "Close " + label,
+					Container: true,
+					OnTap:     s.close,
+					OnDismiss: s.close,
+					Child: GestureDetector{
+						OnTap: s.close,
+						Child: drawerScrim{animation: s.animation, color: w.ScrimColor},
+					},
+				},
+				drawerSlide{
+					animation: s.animation,
+					fromLeft:  s.opensRightward(s.side),
+					extent:    &s.extent,
+					child: FocusScope{
+						Trap: true,
+						Child: Semantics{
+							Label:     label,
+							Flags:     semantics.SemanticsScopesRoute,
+							Container: true,
+							OnDismiss: s.close,
+							Child:     drawer,
+						},
+					},
+				},
+			},
+		}))
+	}
+
+	return scaffoldScope{
+		controller: s.controller,
+		drawer:     w.Drawer != nil,
+		endDrawer:  w.EndDrawer != nil,
+		child:      Stack{Fit: StackFitExpand, Children: children},
+	}
+}
+
+// dragDetector wraps child in horizontal drag handling for the drawer on
+// side. A nil child gives an empty strip that still receives drags.
+func (s *scaffoldState) dragDetector(side drawerSide, child core.Widget) core.Widget {
+	return GestureDetector{
+		OnHorizontalDragStart:  func(DragStartDetails) { s.startDrag(side) },
+		OnHorizontalDragUpdate: func(d DragUpdateDetails) { s.updateDrag(d.PrimaryDelta) },
+		OnHorizontalDragEnd:    func(d DragEndDetails) { s.endDrag(d.PrimaryVelocity) },
+		OnHorizontalDragCancel: func() { s.endDrag(0) },
+		Child:                  child,
+	}
+}
+
+// DrawerControllerOf returns the [DrawerController] of the nearest
+// ancestor [Scaffold], or nil if there is none.
+//
+//	widgets.GestureDetector{
+//	    OnTap: func() { widgets.DrawerControllerOf(ctx).OpenEndDrawer() },
+//	    Child: filterIcon,
+//	}
+func DrawerControllerOf(ctx core.BuildContext) *DrawerController {
+	inherited := ctx.DependOnInherited(scaffoldScopeType, nil)
+	if scope, ok := inherited.(scaffoldScope); ok {
+		return scope.controller
+	}
+	return nil
+}
+
+type scaffoldScope struct {
+	core.InheritedBase
+	controller *DrawerController
+	drawer     bool
+	endDrawer  bool
+	child      core.Widget
+}
+
+func (s scaffoldScope) ChildWidget() core.Widget { return s.child }
+
+func (s scaffoldScope) ShouldRebuildDependents(oldWidget core.InheritedWidget) bool {
+	if old, ok := oldWidget.(scaffoldScope); ok {
+		return old.controller != s.controller || old.drawer != s.drawer || old.endDrawer != s.endDrawer
+	}
+	return true
+}
+
+var scaffoldScopeType = reflect.TypeFor[scaffoldScope]()
@@ -0,0 +1,180 @@
+package widgets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func drawerScaffold(controller *widgets.DrawerController) widgets.Scaffold {
+	return widgets.Scaffold{
+		Controller: controller,
+		Body:       widgets.Text{Content: "body"},
+		Drawer: widgets.Drawer{
+			Width: 200,
+			Child: widgets.Text{Content: "drawer"},
+		},
+		EndDrawer: widgets.Drawer{
+			Width: 150,
+			Child: widgets.Text{Content: "filters"},
+		},
+		ScrimColor: graphics.RGBA(0, 0, 0, 0.5),
+	}
+}
+
+func textLeft(tester *drifttest.WidgetTester, content string) float64 {
+	var left float64
+	var ro layout.RenderObject = tester.Find(drifttest.ByText(content)).RenderObject()
+	for ro != nil {
+		if data, ok := ro.ParentData().(*layout.BoxParentData); ok {
+			left += data.Offset.X
+		}
+		parent, ok := ro.(interface{ Parent() layout.RenderObject })
+		if !ok {
+			break
+		}
+		ro = parent.Parent()
+	}
+	return left
+}
+
+func TestScaffold_ControllerOpensAndScrimCloses(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+	controller := &widgets.DrawerController{}
+	tester.PumpWidget(drawerScaffold(controller))
+
+	if tester.Find(drifttest.ByText("drawer")).Exists() {
+		t.Fatal("expected the drawer to be hidden until opened")
+	}
+
+	controller.OpenDrawer()
+	tester.PumpAndSettle(time.Second)
+	if !controller.IsOpen() {
+		t.Fatal("expected the drawer to be open")
+	}
+	if left := textLeft(tester, "drawer"); left != 0 {
+		t.Errorf("expected the drawer at the leading edge, got x=%v", left)
+	}
+
+	// Taps on the drawer itself keep it open.
+	tester.TapAt(graphics.Offset{X: 100, Y: 300})
+	tester.PumpAndSettle(time.Second)
+	if !controller.IsOpen() {
+		t.Fatal("expected a tap on the drawer to keep it open")
+	}
+
+	tester.TapAt(graphics.Offset{X: 300, Y: 300})
+	tester.PumpAndSettle(time.Second)
+	if controller.IsOpen() || tester.Find(drifttest.ByText("drawer")).Exists() {
+		t.Error("expected a tap on the scrim to close the drawer")
+	}
+}
+
+func TestScaffold_EndDrawerSlidesFromTrailingEdge(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+	controller := &widgets.DrawerController{}
+	tester.PumpWidget(drawerScaffold(controller))
+
+	controller.OpenEndDrawer()
+	tester.PumpAndSettle(time.Second)
+	if left := textLeft(tester, "filters"); left != 250 {
+		t.Errorf("expected the end drawer against the right edge, got x=%v", left)
+	}
+
+	tester.PumpWidget(widgets.Directionality{
+		TextDirection: graphics.TextDirectionRTL,
+		Child:         drawerScaffold(controller),
+	})
+	controller.Close()
+	tester.PumpAndSettle(time.Second)
+	controller.OpenDrawer()
+	tester.PumpAndSettle(time.Second)
+	if left := textLeft(tester, "drawer"); left != 200 {
+		t.Errorf("expected the drawer against the right edge in RTL, got x=%v", left)
+	}
+}
+
+func TestScaffold_EdgeDragOpensAndDragCloses(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+	controller := &widgets.DrawerController{}
+	tester.PumpWidget(drawerScaffold(controller))
+
+	// A short drag settles back closed.
+	tester.SendPointerDown(graphics.Offset{X: 5, Y: 300}, 1)
+	tester.SendPointerMove(graphics.Offset{X: 40, Y: 300}, 1)
+	tester.SendPointerMove(graphics.Offset{X: 60, Y: 300}, 1)
+	tester.SendPointerUp(graphics.Offset{X: 60, Y: 300}, 1)
+	tester.PumpAndSettle(time.Second)
+	if controller.IsOpen() {
+		t.Fatal("expected a short drag to leave the drawer closed")
+	}
+
+	tester.SendPointerDown(graphics.Offset{X: 5, Y: 300}, 1)
+	tester.SendPointerMove(graphics.Offset{X: 40, Y: 300}, 1)
+	tester.SendPointerMove(graphics.Offset{X: 100, Y: 300}, 1)
+	tester.Pump()
+	if left := textLeft(tester, "drawer"); left >= 0 || left <= -200 {
+		t.Errorf("expected the drawer to follow the finger, got x=%v", left)
+	}
+	tester.SendPointerMove(graphics.Offset{X: 180, Y: 300}, 1)
+	tester.SendPointerUp(graphics.Offset{X: 180, Y: 300}, 1)
+	tester.PumpAndSettle(time.Second)
+	if !controller.IsOpen() {
+		t.Fatal("expected a drag past halfway to open the drawer")
+	}
+
+	tester.SendPointerDown(graphics.Offset{X: 150, Y: 300}, 1)
+	tester.SendPointerMove(graphics.Offset{X: 120, Y: 300}, 1)
+	tester.SendPointerMove(graphics.Offset{X: 20, Y: 300}, 1)
+	tester.SendPointerUp(graphics.Offset{X: 20, Y: 300}, 1)
+	tester.PumpAndSettle(time.Second)
+	if controller.IsOpen() || tester.Find(drifttest.ByText("drawer")).Exists() {
+		t.Error("expected dragging the drawer back to close it")
+	}
+}
+
+func TestScaffold_AppBarOpensDrawer(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 400, Height: 600})
+	scaffold := drawerScaffold(nil)
+	scaffold.AppBar = widgets.AppBar{
+		Title:                     widgets.Text{Content: "Inbox"},
+		AutomaticallyImplyLeading: true,
+		Height:                    56,
+	}
+	tester.PumpWidget(widgets.BackNavigationScope{OnBack: func() {}, Child: scaffold})
+
+	if tester.Find(drifttest.ByText("←")).Exists() {
+		t.Error("expected the menu button to take the place of the back button")
+	}
+	if err := tester.Tap(drifttest.ByText("☰")); err != nil {
+		t.Fatal(err)
+	}
+	tester.PumpAndSettle(time.Second)
+	if !tester.Find(drifttest.ByText("drawer")).Exists() {
+		t.Error("expected the menu button to open the drawer")
+	}
+}
+
+func TestDrawerControllerOf(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var found *widgets.DrawerController
+	scaffold := drawerScaffold(nil)
+	scaffold.Body = widgets.LayoutBuilder{Builder: func(ctx core.BuildContext, _ layout.Constraints) core.Widget {
+		found = widgets.DrawerControllerOf(ctx)
+		return widgets.SizedBox{}
+	}}
+	tester.PumpWidget(scaffold)
+
+	if found == nil || !found.HasDrawer() || !found.HasEndDrawer() {
+		t.Fatalf("expected the scaffold's own controller, got %+v", found)
+	}
+}
@@ -31,10 +31,17 @@ import (
 //
 // Use a [ScrollController] to programmatically control or observe scroll position:
 //
-//	controller := &widgets.ScrollController{}
+//	controller := widgets.NewScrollController(0)
 //	controller.AddListener(func() {
 //	    fmt.Println("Offset:", controller.Offset())
 //	})
+//	controller.AnimateTo(0, 300*time.Millisecond, animation.EaseInOut)
+//
+// # Restoring the Scroll Offset
+//
+// Set StorageKey to save the offset in the nearest [PageStorage] and restore
+// it when the view is created again, for example when a tab is revisited.
+// Page routes provide a PageStorage for each route.
 //
 // # Safe Area Handling
 //
@@ -45,6 +52,10 @@ import (
 //	    Child:   content,
 //	}
 //
+// A vertical ScrollView without a Controller attaches to the nearest
+// [PrimaryScrollController], which lets an [AppBar] above it react to
+// scrolling.
+//
 // For scrollable lists, consider [ListView] or [ListViewBuilder] which provide
 // additional features like item-based layout and virtualization.
 type ScrollView struct {
@@ -54,9 +65,15 @@ type ScrollView struct {
 	// ScrollDirection is the axis along which the view scrolls.
 	// Defaults to AxisVertical (the zero value).
 	ScrollDirection Axis
-	Controller      *ScrollController
-	Physics         ScrollPhysics
-	Padding         layout.EdgeInsets
+	// Controller observes and drives the scroll position. If nil, a vertical
+	// scroll view uses the [PrimaryScrollController], when there is one.
+	Controller *ScrollController
+	Physics    ScrollPhysics
+	Padding    layout.EdgeInsets
+	// StorageKey, when set, saves the scroll offset in the nearest
+	// [PageStorage] under this key and restores it when the view is
+	// recreated. Keys must be comparable and unique within the page.
+	StorageKey any
 }
 
 func (s ScrollView) Build(ctx core.BuildContext) core.Widget {
@@ -68,11 +85,20 @@ func (s ScrollView) Build(ctx core.BuildContext) core.Widget {
 		}
 	}
 
+	controller := s.Controller
+	if controller == nil && s.ScrollDirection == AxisVertical {
+		if controller = PrimaryScrollControllerOf(ctx); controller != nil {
+			// Nested scrollables must not attach to the same controller.
+			child = PrimaryScrollController{Child: child}
+		}
+	}
+
 	return scrollViewCore{
 		Child:           child,
 		ScrollDirection: s.ScrollDirection,
-		Controller:      s.Controller,
+		Controller:      controller,
 		Physics:         s.Physics,
+		StorageKey:      s.StorageKey,
 	}
 }
 
@@ -83,6 +109,7 @@ type scrollViewCore struct {
 	ScrollDirection Axis
 	Controller      *ScrollController
 	Physics         ScrollPhysics
+	StorageKey      any
 }
 
 func (s scrollViewCore) ChildWidget() core.Widget {
@@ -102,12 +129,16 @@ func (s scrollViewCore) CreateRenderObject(ctx core.BuildContext) layout.RenderO
 		direction:  s.ScrollDirection,
 		controller: controller,
 		physics:    physics,
+		storageKey: s.StorageKey,
+		storage:    PageStorageOf(ctx),
 	}
 	scroll.SetSelf(scroll)
 	scroll.position = NewScrollPosition(controller, physics, func() {
+		scroll.saveOffset()
 		scroll.MarkNeedsPaint()
 		scroll.MarkNeedsSemanticsUpdate()
 	})
+	scroll.restoreOffset()
 	scroll.configureDrag()
 	return scroll
 }
@@ -115,6 +146,8 @@ func (s scrollViewCore) CreateRenderObject(ctx core.BuildContext) layout.RenderO
 func (s scrollViewCore) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if scroll, ok := renderObject.(*renderScrollView); ok {
 		scroll.direction = s.ScrollDirection
+		scroll.storageKey = s.StorageKey
+		scroll.storage = PageStorageOf(ctx)
 		scroll.updateController(s.Controller)
 		scroll.updatePhysics(s.Physics)
 		scroll.configureDrag()
@@ -132,6 +165,8 @@ type renderScrollView struct {
 	position       *ScrollPosition
 	horizontalDrag *gestures.HorizontalDragGestureRecognizer
 	verticalDrag   *gestures.VerticalDragGestureRecognizer
+	storageKey     any
+	storage        *PageStorageBucket
 }
 
 // IsRepaintBoundary returns true - scrolling content benefits from isolation.
@@ -297,6 +332,11 @@ func (r *renderScrollView) HandlePointer(event gestures.PointerEvent) {
 		recognizer.AddPointer(event)
 	default:
 		recognizer.HandleEvent(event)
+		// A tap can stop a page between pages; put it back on one.
+		if event.Phase == gestures.PointerPhaseUp && r.position != nil &&
+			r.position.activity == nil && !r.position.dragging {
+			r.position.settle()
+		}
 	}
 }
 
@@ -304,6 +344,7 @@ func (r *renderScrollView) configureDrag() {
 	onStart := func(details gestures.DragStartDetails) {
 		if r.position != nil {
 			r.position.StopBallistic()
+			r.position.setDragging(true)
 		}
 	}
 	onUpdate := func(details gestures.DragUpdateDetails) {
@@ -316,17 +357,20 @@ func (r *renderScrollView) configureDrag() {
 		if r.position == nil {
 			return
 		}
+		r.position.setDragging(false)
 		r.position.StartBallistic(-details.PrimaryVelocity)
 	}
 	onCancel := func() {
 		if r.position != nil {
 			r.position.StopBallistic()
+			r.position.setDragging(false)
+			r.position.settle()
 		}
 	}
 
 	if r.direction == AxisHorizontal {
 		if r.horizontalDrag == nil {
-			r.horizontalDrag = gestures.NewHorizontalDragGestureRecognizer(gestures.DefaultArena)
+			r.horizontalDrag = gestures.NewHorizontalDragGestureRecognizer(r.GestureArena())
 		}
 		r.horizontalDrag.OnStart = onStart
 		r.horizontalDrag.OnUpdate = onUpdate
@@ -339,7 +383,7 @@ func (r *renderScrollView) configureDrag() {
 		}
 	} else {
 		if r.verticalDrag == nil {
-			r.verticalDrag = gestures.NewVerticalDragGestureRecognizer(gestures.DefaultArena)
+			r.verticalDrag = gestures.NewVerticalDragGestureRecognizer(r.GestureArena())
 		}
 		r.verticalDrag.OnStart = onStart
 		r.verticalDrag.OnUpdate = onUpdate
@@ -370,6 +414,20 @@ func (r *renderScrollView) updateController(controller *ScrollController) {
 	}
 }
 
+// Dispose detaches the scroll position so the controller outlives the view.
+// The view stops saving its offset, so a final layout after removal can't
+// overwrite it.
+func (r *renderScrollView) Dispose() {
+	r.storage = nil
+	if r.position != nil {
+		r.position.StopBallistic()
+		if r.controller != nil {
+			r.controller.detach(r.position)
+		}
+	}
+	r.RenderBoxBase.Dispose()
+}
+
 func (r *renderScrollView) updatePhysics(physics ScrollPhysics) {
 	if physics == nil {
 		return
@@ -405,6 +463,25 @@ func (r *renderScrollView) updateExtents() {
 	r.position.SetExtents(0, max)
 }
 
+// restoreOffset moves the position to the offset saved under storageKey, if
+// any. Layout clamps it once the content size is known.
+func (r *renderScrollView) restoreOffset() {
+	if r.storageKey == nil || r.storage == nil || r.position == nil {
+		return
+	}
+	if offset, ok := r.storage.Read(r.storageKey).(float64); ok {
+		r.position.offset = offset
+	}
+}
+
+// saveOffset writes the current offset under storageKey.
+func (r *renderScrollView) saveOffset() {
+	if r.storageKey == nil || r.storage == nil || r.position == nil {
+		return
+	}
+	r.storage.Write(r.storageKey, r.position.offset)
+}
+
 func (r *renderScrollView) scrollOffset() float64 {
 	if r.position == nil {
 		return 0
@@ -485,12 +562,34 @@ func (r *renderScrollView) paintFlex(
 }
 
 // ScrollController controls scroll position.
+//
+// A zero ScrollController is ready to use. Controllers created with
+// [NewScrollController] are tracked by disposal auditing and should be
+// released with Dispose when the owning State is disposed.
 type ScrollController struct {
 	InitialScrollOffset float64
 	positions           []*ScrollPosition
 	viewportExtent      float64
 	listeners           map[int]func()
 	nextListenerID      int
+	untrack             func()
+}
+
+// NewScrollController creates a controller that starts at initialOffset.
+func NewScrollController(initialOffset float64) *ScrollController {
+	c := &ScrollController{InitialScrollOffset: initialOffset}
+	c.untrack = errors.TrackDisposable("*widgets.ScrollController", nil)
+	return c
+}
+
+// Dispose removes all listeners. Attached scroll views keep working, but the
+// controller no longer notifies anyone.
+func (c *ScrollController) Dispose() {
+	c.listeners = nil
+	if c.untrack != nil {
+		c.untrack()
+		c.untrack = nil
+	}
 }
 
 // Offset returns the current scroll offset.
@@ -506,6 +605,35 @@ func (c *ScrollController) ViewportExtent() float64 {
 	return c.viewportExtent
 }
 
+// MinScrollExtent returns the smallest offset the attached scroll view can
+// scroll to, or 0 before it has been laid out.
+func (c *ScrollController) MinScrollExtent() float64 {
+	if len(c.positions) > 0 {
+		return c.positions[0].min
+	}
+	return 0
+}
+
+// MaxScrollExtent returns the largest offset the attached scroll view can
+// scroll to, or 0 before it has been laid out.
+func (c *ScrollController) MaxScrollExtent() float64 {
+	if len(c.positions) > 0 {
+		return c.positions[0].max
+	}
+	return 0
+}
+
+// IsDragging reports whether the user is dragging an attached scroll view.
+// Listeners are notified when a drag starts and ends.
+func (c *ScrollController) IsDragging() bool {
+	for _, position := range c.positions {
+		if position.dragging {
+			return true
+		}
+	}
+	return false
+}
+
 // AddListener registers a callback for scroll changes.
 func (c *ScrollController) AddListener(listener func()) func() {
 	if listener == nil {
@@ -522,21 +650,38 @@ func (c *ScrollController) AddListener(listener func()) func() {
 	}
 }
 
-// JumpTo moves all attached positions to a new offset.
+// JumpTo moves all attached positions to a new offset, stopping any fling
+// or animation in progress.
 func (c *ScrollController) JumpTo(offset float64) {
+	errors.CheckUIThread("*widgets.ScrollController", "JumpTo")
 	c.InitialScrollOffset = offset
 	if len(c.positions) == 0 {
 		c.notifyListeners()
 		return
 	}
 	for _, position := range c.positions {
+		position.StopBallistic()
 		position.SetOffset(offset)
 	}
 }
 
-// AnimateTo moves to a new offset immediately (placeholder for animations).
-func (c *ScrollController) AnimateTo(offset float64, _ time.Duration) {
-	c.JumpTo(offset)
+// AnimateTo scrolls all attached positions to offset over duration, easing
+// with curve. A nil curve uses [animation.Ease]. The target is clamped to the
+// scroll extents. Dragging the view stops the animation.
+//
+// AnimateTo jumps when duration is not positive, when animations are
+// disabled (see [animation.SetAnimationsDisabled]), or when no scroll view
+// is attached.
+func (c *ScrollController) AnimateTo(offset float64, duration time.Duration, curve func(float64) float64) {
+	errors.CheckUIThread("*widgets.ScrollController", "AnimateTo")
+	if len(c.positions) == 0 {
+		c.JumpTo(offset)
+		return
+	}
+	c.InitialScrollOffset = offset
+	for _, position := range c.positions {
+		position.AnimateTo(offset, duration, curve)
+	}
 }
 
 func (c *ScrollController) attach(position *ScrollPosition) {
@@ -577,7 +722,15 @@ type ScrollPosition struct {
 	physics    ScrollPhysics
 	onUpdate   func()
 	controller *ScrollController
-	ballistic  *ballisticState
+	activity   scrollActivity
+	dragging   bool
+}
+
+// scrollActivity moves a position between frames, such as a fling or an
+// animation started by AnimateTo.
+type scrollActivity interface {
+	// step advances the activity to now and reports whether it is done.
+	step(now time.Time) bool
 }
 
 // NewScrollPosition creates a new scroll position.
@@ -614,6 +767,40 @@ func (p *ScrollPosition) SetOffset(value float64) {
 	p.notify()
 }
 
+// MinScrollExtent returns the smallest offset the position can rest at.
+func (p *ScrollPosition) MinScrollExtent() float64 {
+	return p.min
+}
+
+// MaxScrollExtent returns the largest offset the position can rest at.
+func (p *ScrollPosition) MaxScrollExtent() float64 {
+	return p.max
+}
+
+// AnimateTo moves the position to offset over duration, easing with curve.
+// A nil curve uses [animation.Ease]. It jumps when duration is not positive
+// or animations are disabled.
+func (p *ScrollPosition) AnimateTo(offset float64, duration time.Duration, curve func(float64) float64) {
+	p.StopBallistic()
+	target := p.clampOffset(offset, false)
+	if duration <= 0 || animation.AnimationsDisabled() || target == p.offset {
+		p.SetOffset(target)
+		return
+	}
+	if curve == nil {
+		curve = animation.Ease
+	}
+	p.activity = &scrollAnimation{
+		position: p,
+		from:     p.offset,
+		to:       target,
+		duration: duration,
+		curve:    curve,
+		start:    animation.Now(),
+	}
+	registerBallistic(p)
+}
+
 // SetExtents updates the min/max scroll extents.
 func (p *ScrollPosition) SetExtents(min, max float64) {
 	if max < min {
@@ -639,12 +826,18 @@ func (p *ScrollPosition) ApplyUserOffset(delta float64) {
 }
 
 // StartBallistic begins inertial scrolling with the provided velocity.
+// With [PageScrollPhysics], it animates to the page the velocity points to
+// instead.
 func (p *ScrollPosition) StartBallistic(velocity float64) {
 	p.StopBallistic()
 	velocity = p.normalizeBallisticVelocity(velocity)
+	if paging, ok := p.physics.(PageScrollPhysics); ok {
+		p.AnimateTo(paging.targetOffset(p, velocity), pageSettleDuration, animation.EaseOut)
+		return
+	}
 	// Always animate back when overscrolled (iOS-style spring)
 	if isOverscrolled(p) {
-		p.ballistic = newBallisticState(p, velocity)
+		p.activity = newBallisticState(p, velocity)
 		registerBallistic(p)
 		p.notify()
 		return
@@ -652,7 +845,7 @@ func (p *ScrollPosition) StartBallistic(velocity float64) {
 	if math.Abs(velocity) < 5 {
 		return
 	}
-	p.ballistic = newBallisticState(p, velocity)
+	p.activity = newBallisticState(p, velocity)
 	registerBallistic(p)
 	p.notify()
 }
@@ -667,11 +860,29 @@ func (p *ScrollPosition) normalizeBallisticVelocity(velocity float64) float64 {
 	return Clamp(velocity, -maxAbs, maxAbs)
 }
 
-// StopBallistic halts any ongoing inertial scroll.
+// settle moves a position with [PageScrollPhysics] that rests between pages
+// onto the nearest page.
+func (p *ScrollPosition) settle() {
+	if _, ok := p.physics.(PageScrollPhysics); ok {
+		p.StartBallistic(0)
+	}
+}
+
+func (p *ScrollPosition) setDragging(dragging bool) {
+	if p.dragging == dragging {
+		return
+	}
+	p.dragging = dragging
+	if p.controller != nil {
+		p.controller.notifyListeners()
+	}
+}
+
+// StopBallistic halts any ongoing inertial scroll or scroll animation.
 func (p *ScrollPosition) StopBallistic() {
-	if p.ballistic != nil {
+	if p.activity != nil {
 		unregisterBallistic(p)
-		p.ballistic = nil
+		p.activity = nil
 	}
 }
 
@@ -757,6 +968,42 @@ func (BouncingScrollPhysics) ApplyBoundaryConditions(position *ScrollPosition, v
 	return 0
 }
 
+// pageSettleDuration is how long a [PageScrollPhysics] view takes to settle
+// on a page after a drag.
+const pageSettleDuration = 300 * time.Millisecond
+
+// minPageFlingVelocity is the release speed, in pixels per second, above
+// which a drag moves to the next page even if it covered less than half of
+// one.
+const minPageFlingVelocity = 400
+
+// PageScrollPhysics makes a scroll view come to rest on whole multiples of
+// its viewport extent, so it moves one page at a time. A release faster
+// than a slow fling moves to the next page in that direction; otherwise
+// the view settles on the nearest page. [PageView] uses it.
+type PageScrollPhysics struct {
+	ClampingScrollPhysics
+}
+
+// targetOffset returns the page offset a drag released at velocity settles
+// on.
+func (PageScrollPhysics) targetOffset(position *ScrollPosition, velocity float64) float64 {
+	extent := viewportExtentForPosition(position)
+	if extent <= 0 {
+		return position.clampOffset(position.offset, false)
+	}
+	page := position.offset / extent
+	switch {
+	case velocity > minPageFlingVelocity:
+		page = math.Ceil(page - 0.001)
+	case velocity < -minPageFlingVelocity:
+		page = math.Floor(page + 0.001)
+	default:
+		page = math.Round(page)
+	}
+	return position.clampOffset(page*extent, false)
+}
+
 func (p *ScrollPosition) clampOffset(value float64, allowOverscroll bool) float64 {
 	if !allowOverscroll {
 		return Clamp(value, p.min, p.max)
@@ -884,6 +1131,29 @@ func (b *ballisticState) advance(dt float64) bool {
 	return false
 }
 
+// scrollAnimation moves a position from one offset to another over a fixed
+// duration.
+type scrollAnimation struct {
+	position *ScrollPosition
+	from, to float64
+	duration time.Duration
+	curve    func(float64) float64
+	start    time.Time
+}
+
+func (a *scrollAnimation) step(now time.Time) bool {
+	t := float64(now.Sub(a.start)) / float64(a.duration)
+	if t >= 1 {
+		a.position.SetOffset(a.to)
+		return true
+	}
+	if t < 0 {
+		t = 0
+	}
+	a.position.SetOffset(a.from + (a.to-a.from)*a.curve(t))
+	return false
+}
+
 var ballisticMu sync.Mutex
 var ballisticPositions = make(map[*ScrollPosition]struct{})
 
@@ -921,10 +1191,10 @@ func StepBallistics() {
 	ballisticMu.Unlock()
 
 	for _, position := range positions {
-		if position.ballistic == nil {
+		if position.activity == nil {
 			continue
 		}
-		if position.ballistic.step(now) {
+		if position.activity.step(now) {
 			position.StopBallistic()
 		}
 	}
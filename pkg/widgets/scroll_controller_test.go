@@ -0,0 +1,117 @@
+package widgets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// tallContent is a 2000px column with a 50px marker at 1000px.
+func tallContent() core.Widget {
+	return widgets.Column{Children: []core.Widget{
+		widgets.SizedBox{Height: 1000},
+		widgets.SizedBox{Height: 50, Child: widgets.Text{Content: "target"}},
+		widgets.SizedBox{Height: 950},
+	}}
+}
+
+func TestScrollController_AnimateTo(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	controller := &widgets.ScrollController{}
+	tester.PumpWidget(widgets.ScrollView{Controller: controller, Child: tallContent()})
+
+	if got := controller.MinScrollExtent(); got != 0 {
+		t.Errorf("expected min extent 0, got %v", got)
+	}
+	if got := controller.MaxScrollExtent(); got != 1400 {
+		t.Errorf("expected max extent 1400, got %v", got)
+	}
+
+	controller.AnimateTo(400, 200*time.Millisecond, animation.LinearCurve)
+	tester.Clock().Advance(100 * time.Millisecond)
+	tester.Pump()
+	if got := controller.Offset(); got != 200 {
+		t.Errorf("expected offset 200 halfway through, got %v", got)
+	}
+
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if got := controller.Offset(); got != 400 {
+		t.Errorf("expected offset 400 after the animation, got %v", got)
+	}
+
+	// Targets past the end are clamped, and JumpTo interrupts animations.
+	controller.AnimateTo(5000, 200*time.Millisecond, nil)
+	controller.JumpTo(100)
+	tester.PumpAndSettle(time.Second)
+	if got := controller.Offset(); got != 100 {
+		t.Errorf("expected JumpTo to stop the animation at 100, got %v", got)
+	}
+}
+
+func TestEnsureVisible_ScrollsTargetIntoView(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	controller := &widgets.ScrollController{}
+	tester.PumpWidget(widgets.ScrollView{Controller: controller, Child: tallContent()})
+
+	target := tester.Find(drifttest.ByText("target")).RenderObject()
+	widgets.EnsureVisible(target, 0)
+	// The marker ends at 1050 and the viewport is 600 tall.
+	if got := controller.Offset(); got != 450 {
+		t.Fatalf("expected offset 450, got %v", got)
+	}
+
+	// A visible target doesn't move the view.
+	widgets.EnsureVisible(target, 0)
+	if got := controller.Offset(); got != 450 {
+		t.Errorf("expected offset to stay at 450, got %v", got)
+	}
+
+	controller.JumpTo(1200)
+	tester.Pump()
+	widgets.EnsureVisible(target, 0)
+	if got := controller.Offset(); got != 1000 {
+		t.Errorf("expected the leading edge at 1000, got %v", got)
+	}
+}
+
+// storageHost shows a scroll view with a storage key, or nothing.
+type storageHost struct {
+	core.StatelessBase
+	bucket     *widgets.PageStorageBucket
+	show       bool
+	controller *widgets.ScrollController
+}
+
+func (h storageHost) Build(ctx core.BuildContext) core.Widget {
+	var child core.Widget = widgets.SizedBox{}
+	if h.show {
+		child = widgets.ScrollView{Controller: h.controller, StorageKey: "feed", Child: tallContent()}
+	}
+	return widgets.PageStorage{Bucket: h.bucket, Child: child}
+}
+
+func TestScrollView_StorageKeyRestoresOffset(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	bucket := &widgets.PageStorageBucket{}
+
+	first := &widgets.ScrollController{}
+	tester.PumpWidget(storageHost{bucket: bucket, show: true, controller: first})
+	first.JumpTo(300)
+	tester.Pump()
+	if got := bucket.Read("feed"); got != 300.0 {
+		t.Fatalf("expected the bucket to hold 300, got %v", got)
+	}
+
+	tester.PumpWidget(storageHost{bucket: bucket})
+	second := &widgets.ScrollController{}
+	tester.PumpWidget(storageHost{bucket: bucket, show: true, controller: second})
+	if got := second.Offset(); got != 300 {
+		t.Errorf("expected the recreated view to restore 300, got %v", got)
+	}
+}
@@ -296,3 +296,42 @@ func (m *mockRenderBox) PerformLayout() {
 }
 
 func (m *mockRenderBox) Paint(ctx *layout.PaintContext) {}
+
+type fixedSizeBox struct {
+	layout.RenderBoxBase
+	size graphics.Size
+}
+
+func (b *fixedSizeBox) PerformLayout() {
+	b.SetSize(b.size)
+}
+
+func (b *fixedSizeBox) Paint(ctx *layout.PaintContext) {}
+
+func (b *fixedSizeBox) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
+
+func TestScrollController_MaxScrollExtentAndDispose(t *testing.T) {
+	controller := &ScrollController{}
+	scroll := &renderScrollView{
+		direction:  AxisVertical,
+		physics:    ClampingScrollPhysics{},
+		controller: controller,
+	}
+	scroll.SetSelf(scroll)
+	scroll.position = NewScrollPosition(controller, scroll.physics, func() {})
+	content := &fixedSizeBox{size: graphics.Size{Width: 100, Height: 500}}
+	content.SetSelf(content)
+	scroll.SetChild(content)
+	scroll.Layout(layout.Tight(graphics.Size{Width: 100, Height: 200}), false)
+
+	if got := controller.MaxScrollExtent(); got != 300 {
+		t.Errorf("expected max scroll extent 300, got %v", got)
+	}
+
+	scroll.Dispose()
+	if got := controller.MaxScrollExtent(); got != 0 {
+		t.Errorf("expected disposed view to detach from controller, got extent %v", got)
+	}
+}
@@ -0,0 +1,345 @@
+package widgets
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// Segment is one option of a [SegmentedControl] or [ToggleButtons].
+type Segment[T comparable] struct {
+	// Value is the value the segment selects.
+	Value T
+	// Label is the text shown for the segment. With Child set, it is only
+	// read out by screen readers.
+	Label string
+	// Child replaces the label when provided, such as an icon.
+	Child core.Widget
+	// Disabled fades the segment and ignores taps on it.
+	Disabled bool
+}
+
+// SegmentedControl displays a row of mutually exclusive segments, with a
+// thumb behind the selected one that slides to the new segment when the
+// selection changes. The segments share the available width equally.
+//
+// # Styling Model
+//
+// SegmentedControl is explicit by default — all visual properties use their
+// struct field values directly. A zero value means zero, not "use theme
+// default." For example:
+//
+//   - BackgroundColor: 0 means transparent track
+//   - ThumbColor: 0 means transparent thumb
+//   - Height: 0 means zero height (not rendered)
+//
+// For theme-styled segmented controls, use [theme.SegmentedControlOf] which
+// pre-fills visual properties from the current theme.
+//
+// # Creation Patterns
+//
+// Explicit with struct literal (full control):
+//
+//	widgets.SegmentedControl[string]{
+//	    Segments: []widgets.Segment[string]{
+//	        {Value: "day", Label: "Day"},
+//	        {Value: "week", Label: "Week"},
+//	        {Value: "month", Label: "Month"},
+//	    },
+//	    Value:              s.period,
+//	    OnChanged:          func(v string) { s.SetState(func() { s.period = v }) },
+//	    BackgroundColor:    colors.SurfaceVariant,
+//	    ThumbColor:         colors.Surface,
+//	    LabelStyle:         graphics.TextStyle{FontSize: 14, Color: colors.OnSurfaceVariant},
+//	    SelectedLabelColor: colors.OnSurface,
+//	    Height:             36,
+//	    BorderRadius:       8,
+//	    ThumbInset:         2,
+//	    Haptic:             true,
+//	}
+//
+// Themed (reads from current theme):
+//
+//	theme.SegmentedControlOf(ctx, segments, s.period, onChanged)
+type SegmentedControl[T comparable] struct {
+	core.StatefulBase
+
+	// Segments are the options, from start to end.
+	Segments []Segment[T]
+	// Value is the selected segment's value. If no segment has it, no
+	// thumb is shown.
+	Value T
+	// OnChanged is called with the tapped segment's value when it differs
+	// from Value.
+	OnChanged func(T)
+	// Disabled disables interaction and fades the control when true.
+	Disabled bool
+	// Haptic plays a selection click when the selection changes.
+	Haptic bool
+
+	// BackgroundColor fills the track behind the segments.
+	BackgroundColor graphics.Color
+	// ThumbColor fills the thumb behind the selected segment.
+	ThumbColor graphics.Color
+	// ThumbShadowColor is the color of the thumb's shadow. Zero draws none.
+	ThumbShadowColor graphics.Color
+	// LabelStyle styles the segment labels.
+	LabelStyle graphics.TextStyle
+	// SelectedLabelColor is the label color of the selected segment.
+	SelectedLabelColor graphics.Color
+	// Height is the height of the control.
+	Height float64
+	// BorderRadius rounds the track's corners. The thumb's corners are
+	// rounded to match, less ThumbInset.
+	BorderRadius float64
+	// ThumbInset is the space between the track's edge and the thumb.
+	ThumbInset float64
+}
+
+// segmentedControlDuration is the length of the thumb's slide.
+const segmentedControlDuration = 250 * time.Millisecond
+
+func (c SegmentedControl[T]) CreateState() core.State {
+	return &segmentedControlState[T]{}
+}
+
+type segmentedControlState[T comparable] struct {
+	core.StateBase
+	controller *animation.AnimationController
+	// from and to are the segment positions the thumb slides between.
+	from, to float64
+}
+
+func (s *segmentedControlState[T]) widget() SegmentedControl[T] {
+	return s.Element().Widget().(SegmentedControl[T])
+}
+
+func (s *segmentedControlState[T]) InitState() {
+	s.controller = animation.NewAnimationController(segmentedControlDuration)
+	s.controller.Curve = animation.EaseInOut
+	s.controller.Value = 1
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+	s.to = float64(s.widget().selectedIndex())
+	s.from = s.to
+}
+
+func (s *segmentedControlState[T]) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	index := float64(s.widget().selectedIndex())
+	if index == s.to {
+		return
+	}
+	current := s.position()
+	s.from, s.to = current, index
+	if current < 0 || index < 0 {
+		// Show or hide the thumb in place rather than sliding from nowhere.
+		s.from = index
+	}
+	s.controller.Reset()
+	s.controller.Forward()
+}
+
+// position returns where the thumb is, as a fractional segment index, or
+// -1 if there is no thumb.
+func (s *segmentedControlState[T]) position() float64 {
+	if s.to < 0 {
+		return -1
+	}
+	return s.from + (s.to-s.from)*s.controller.Value
+}
+
+// selectedIndex returns the index of the segment with Value, or -1.
+func (c SegmentedControl[T]) selectedIndex() int {
+	return slices.IndexFunc(c.Segments, func(segment Segment[T]) bool {
+		return segment.Value == c.Value
+	})
+}
+
+func (s *segmentedControlState[T]) Build(ctx core.BuildContext) core.Widget {
+	c := s.widget()
+	enabled := !c.Disabled && c.OnChanged != nil
+	selected := c.selectedIndex()
+
+	segments := make([]core.Widget, 0, len(c.Segments))
+	for i, segment := range c.Segments {
+		segments = append(segments, Expanded{Child: c.buildSegment(i, segment, i == selected, enabled)})
+	}
+	position := s.position()
+	thumbRadius := max(c.BorderRadius-c.ThumbInset, 0)
+	var shadow *graphics.BoxShadow
+	if c.ThumbShadowColor != graphics.ColorTransparent {
+		shadow = graphics.BoxShadowElevation(1, c.ThumbShadowColor)
+	}
+
+	var result core.Widget = SizedBox{
+		Height: c.Height,
+		Child: DecoratedBox{
+			Color:        c.BackgroundColor,
+			BorderRadius: c.BorderRadius,
+			Child: Padding{
+				Padding: layout.EdgeInsetsAll(c.ThumbInset),
+				Child: Stack{Fit: StackFitExpand, Children: []core.Widget{
+					segmentedThumb{
+						position: position,
+						count:    len(c.Segments),
+						rtl:      DirectionalityOf(ctx) == graphics.TextDirectionRTL,
+						child: DecoratedBox{
+							Color:        c.ThumbColor,
+							BorderRadius: thumbRadius,
+							Shadow:       shadow,
+						},
+					},
+					Row{Children: segments, CrossAxisAlignment: CrossAxisAlignmentStretch},
+				}},
+			},
+		},
+	}
+	if !enabled {
+		result = Opacity{Opacity: 0.5, Child: result}
+	}
+	return result
+}
+
+// buildSegment creates the label and tap target of one segment.
+func (c SegmentedControl[T]) buildSegment(index int, segment Segment[T], selected, enabled bool) core.Widget {
+	style := c.LabelStyle
+	if selected {
+		style.Color = c.SelectedLabelColor
+	}
+	child := segment.Child
+	if child == nil {
+		child = Text{Content: segment.Label, Style: style, MaxLines: 1}
+	}
+
+	var onTap func()
+	if enabled && !segment.Disabled {
+		onTap = func() {
+			if segment.Value == c.Value {
+				return
+			}
+			if c.Haptic {
+				platform.Haptics.SelectionClick()
+			}
+			c.OnChanged(segment.Value)
+		}
+	}
+
+	flags := semantics.SemanticsHasSelectedState |
+		semantics.SemanticsHasEnabledState |
+		semantics.SemanticsIsInMutuallyExclusiveGroup
+	if selected {
+		flags = flags.Set(semantics.SemanticsIsSelected)
+	}
+	if onTap != nil {
+		flags = flags.Set(semantics.SemanticsIsEnabled)
+	}
+
+	var content core.Widget = Center{Child: child}
+	if segment.Disabled {
+		content = Opacity{Opacity: 0.5, Child: content}
+	}
+	// A text label is merged in from the Text; a custom child needs Label.
+	var label string
+	if segment.Child != nil {
+		label = segment.Label
+	}
+	return Semantics{
+		Label:            label,
+		Hint:             fmt.Sprintf("Segment %d of %d", index+1, len(c.Segments)),
+		Role:             semantics.SemanticsRoleButton,
+		Flags:            flags,
+		Container:        true,
+		MergeDescendants: true,
+		OnTap:            onTap,
+		Child:            GestureDetector{OnTap: onTap, Child: content},
+	}
+}
+
+// segmentedThumb fills its parent and lays out its child over one of count
+// equal segments, at a fractional segment position. A negative position
+// hides the child.
+type segmentedThumb struct {
+	core.RenderObjectBase
+	position float64
+	count    int
+	rtl      bool
+	child    core.Widget
+}
+
+func (t segmentedThumb) ChildWidget() core.Widget {
+	return t.child
+}
+
+func (t segmentedThumb) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderSegmentedThumb{}
+	r.SetSelf(r)
+	t.UpdateRenderObject(ctx, r)
+	return r
+}
+
+func (t segmentedThumb) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderSegmentedThumb); ok {
+		r.position = t.position
+		r.count = t.count
+		r.rtl = t.rtl
+		r.MarkNeedsLayout()
+	}
+}
+
+type renderSegmentedThumb struct {
+	layout.RenderBoxBase
+	child    layout.RenderBox
+	position float64
+	count    int
+	rtl      bool
+}
+
+func (r *renderSegmentedThumb) SetChild(child layout.RenderObject) {
+	layout.SetParentOnChild(r.child, nil)
+	r.child = layout.AsRenderBox(child)
+	layout.SetParentOnChild(r.child, r)
+}
+
+func (r *renderSegmentedThumb) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.child != nil {
+		visitor(r.child)
+	}
+}
+
+func (r *renderSegmentedThumb) visible() bool {
+	return r.child != nil && r.count > 0 && r.position >= 0
+}
+
+func (r *renderSegmentedThumb) PerformLayout() {
+	constraints := r.Constraints()
+	size := graphics.Size{Width: constraints.MaxWidth, Height: constraints.MaxHeight}
+	r.SetSize(size)
+	if !r.visible() {
+		return
+	}
+	width := size.Width / float64(r.count)
+	position := r.position
+	if r.rtl {
+		position = float64(r.count-1) - position
+	}
+	r.child.Layout(layout.Tight(graphics.Size{Width: width, Height: size.Height}), false)
+	r.child.SetParentData(&layout.BoxParentData{Offset: graphics.Offset{X: position * width}})
+}
+
+func (r *renderSegmentedThumb) Paint(ctx *layout.PaintContext) {
+	if r.visible() {
+		ctx.PaintChildWithLayer(r.child, getChildOffset(r.child))
+	}
+}
+
+// HitTest lets taps through to the segments above the thumb.
+func (r *renderSegmentedThumb) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
@@ -0,0 +1,137 @@
+package widgets_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+var periodSegments = []widgets.Segment[string]{
+	{Value: "day", Label: "Day"},
+	{Value: "week", Label: "Week"},
+	{Value: "month", Label: "Month", Disabled: true},
+}
+
+// segmentHost keeps a selection in state and builds a control for it.
+type segmentHost[T any] struct {
+	core.StatefulBase
+	initial T
+	build   func(value T, onChanged func(T)) core.Widget
+}
+
+func (h segmentHost[T]) CreateState() core.State { return &segmentHostState[T]{} }
+
+type segmentHostState[T any] struct {
+	core.StateBase
+	value T
+}
+
+func (s *segmentHostState[T]) InitState() {
+	s.value = s.Element().Widget().(segmentHost[T]).initial
+}
+
+func (s *segmentHostState[T]) Build(ctx core.BuildContext) core.Widget {
+	return widgets.Center{Child: widgets.SizedBox{
+		Width: 300,
+		Child: s.Element().Widget().(segmentHost[T]).build(s.value, func(value T) {
+			s.SetState(func() { s.value = value })
+		}),
+	}}
+}
+
+// thumbLeft returns the offset of a segmented control's thumb in its track.
+func thumbLeft(tester *drifttest.WidgetTester) float64 {
+	thumb := tester.Find(drifttest.ByType[widgets.DecoratedBox]()).At(1)
+	render := thumb.(interface{ RenderObject() layout.RenderObject }).RenderObject()
+	return render.ParentData().(*layout.BoxParentData).Offset.X
+}
+
+func TestSegmentedControl_ThumbSlides(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 300, Height: 200})
+	var changes []string
+	tester.PumpWidget(segmentHost[string]{
+		initial: "day",
+		build: func(value string, onChanged func(string)) core.Widget {
+			return widgets.SegmentedControl[string]{
+				Segments: periodSegments,
+				Value:    value,
+				OnChanged: func(v string) {
+					changes = append(changes, v)
+					onChanged(v)
+				},
+				Height: 36,
+			}
+		},
+	})
+	tester.Pump()
+	if left := thumbLeft(tester); left != 0 {
+		t.Fatalf("expected the thumb under the first segment, got %v", left)
+	}
+
+	tester.TapAt(graphics.Offset{X: 150, Y: 100})
+	tester.Pump()
+	tester.Clock().Advance(100 * time.Millisecond)
+	tester.Pump()
+	if left := thumbLeft(tester); left <= 0 || left >= 100 {
+		t.Errorf("expected the thumb part way to the second segment, got %v", left)
+	}
+	tester.PumpAndSettle(time.Second)
+	if left := thumbLeft(tester); left != 100 {
+		t.Errorf("expected the thumb under the second segment, got %v", left)
+	}
+
+	// Tapping the selected segment or a disabled one changes nothing.
+	tester.TapAt(graphics.Offset{X: 150, Y: 100})
+	tester.TapAt(graphics.Offset{X: 250, Y: 100})
+	tester.Pump()
+	if !slices.Equal(changes, []string{"week"}) {
+		t.Errorf("expected a single change to week, got %v", changes)
+	}
+}
+
+func TestToggleButtons_TogglesInSegmentOrder(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 300, Height: 200})
+	var selection []string
+	tester.PumpWidget(segmentHost[[]string]{
+		initial: []string{"week"},
+		build: func(value []string, onChanged func([]string)) core.Widget {
+			return widgets.Row{
+				MainAxisAlignment: widgets.MainAxisAlignmentStart,
+				Children: []core.Widget{widgets.ToggleButtons[string]{
+					Segments: periodSegments,
+					Selected: value,
+					OnChanged: func(v []string) {
+						selection = v
+						onChanged(v)
+					},
+					Height:  40,
+					Padding: layout.EdgeInsetsSymmetric(20, 0),
+				}},
+			}
+		},
+	})
+
+	buttons := tester.Find(drifttest.ByType[widgets.GestureDetector]())
+	if buttons.Count() != 3 {
+		t.Fatalf("expected a button per segment, got %d", buttons.Count())
+	}
+	tester.Tap(drifttest.ByType[widgets.GestureDetector]())
+	tester.Pump()
+	if !slices.Equal(selection, []string{"day", "week"}) {
+		t.Errorf("expected day switched on ahead of week, got %v", selection)
+	}
+
+	tester.Tap(drifttest.ByType[widgets.GestureDetector]())
+	tester.Pump()
+	if !slices.Equal(selection, []string{"week"}) {
+		t.Errorf("expected day switched back off, got %v", selection)
+	}
+}
@@ -0,0 +1,980 @@
+package widgets
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/errors"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+)
+
+// SelectionArea lets users select and copy the text of the [Text] widgets
+// below it.
+//
+// A long press selects the word under the pointer, and moving before lifting
+// extends the selection. Handles at both ends adjust it, and a toolbar next
+// to it offers Copy and Select all. Tapping the area clears the selection.
+// The selection runs through the Text descendants in tree order, so it can
+// start in one paragraph and end in another; copied text joins the pieces
+// with newlines.
+//
+// Example:
+//
+//	widgets.SelectionArea{
+//	    SelectionColor:   colors.Primary.WithAlpha(0.3),
+//	    HandleColor:      colors.Primary,
+//	    ToolbarColor:     colors.InverseSurface,
+//	    ToolbarTextColor: colors.OnInverseSurface,
+//	    Child: widgets.Column{Children: []core.Widget{
+//	        widgets.Text{Content: "Terms of service", Style: titleStyle},
+//	        widgets.Text{Content: terms, Style: bodyStyle},
+//	    }},
+//	}
+//
+// Themed (using [theme.SelectionAreaOf]):
+//
+//	theme.SelectionAreaOf(ctx, child)
+//
+// For a single selectable string, use [SelectableText].
+type SelectionArea struct {
+	core.StatefulBase
+	// Child contains the Text widgets that can be selected.
+	Child core.Widget
+	// SelectionColor fills the selected text. Zero means transparent.
+	SelectionColor graphics.Color
+	// HandleColor is the color of the selection handles. Zero means
+	// transparent.
+	HandleColor graphics.Color
+	// ToolbarColor is the background of the Copy and Select all toolbar.
+	// Zero means transparent.
+	ToolbarColor graphics.Color
+	// ToolbarTextColor is the color of the toolbar labels. Zero means
+	// transparent.
+	ToolbarTextColor graphics.Color
+	// OnSelectionChanged is called with the selected plain text whenever
+	// it changes, and with "" when the selection is cleared.
+	OnSelectionChanged func(text string)
+}
+
+// CreateState creates the state for the selection area.
+func (a SelectionArea) CreateState() core.State {
+	return &selectionAreaState{}
+}
+
+type selectionAreaState struct {
+	core.StateBase
+	region      *renderSelectionArea
+	showToolbar bool
+}
+
+func (s *selectionAreaState) Build(ctx core.BuildContext) core.Widget {
+	w := ctx.Widget().(SelectionArea)
+	var toolbar core.Widget
+	if s.showToolbar {
+		toolbar = selectionToolbar{
+			color:     w.ToolbarColor,
+			textColor: w.ToolbarTextColor,
+			onCopy:    s.copySelection,
+			onSelectAll: func() {
+				if s.region != nil {
+					s.region.selectAll()
+				}
+			},
+		}
+	}
+	return selectionRegion{
+		child:              w.Child,
+		toolbar:            toolbar,
+		state:              s,
+		selectionColor:     w.SelectionColor,
+		handleColor:        w.HandleColor,
+		onSelectionChanged: w.OnSelectionChanged,
+	}
+}
+
+// setToolbar shows or hides the Copy and Select all toolbar.
+func (s *selectionAreaState) setToolbar(show bool) {
+	if s.showToolbar == show || s.Element() == nil {
+		return
+	}
+	s.SetState(func() {
+		s.showToolbar = show
+	})
+}
+
+// copySelection puts the selected text on the clipboard and clears the
+// selection.
+func (s *selectionAreaState) copySelection() {
+	if s.region == nil {
+		return
+	}
+	text := s.region.selectedText()
+	s.region.clearSelection()
+	if text == "" {
+		return
+	}
+	go func() {
+		if err := platform.Clipboard.SetText(text); err != nil {
+			errors.Report(&errors.DriftError{
+				Op:   "widgets.SelectionArea",
+				Kind: errors.KindPlatform,
+				Err:  err,
+			})
+		}
+	}()
+}
+
+// SelectableText displays a string the user can select and copy. It is a
+// [Text] inside a [SelectionArea]; use SelectionArea directly to select
+// across several Text widgets.
+//
+// Themed (using [theme.SelectableTextOf]):
+//
+//	theme.SelectableTextOf(ctx, "Order #4521", textTheme.BodyLarge)
+type SelectableText struct {
+	core.StatelessBase
+	// Content is the text string to display.
+	Content string
+	// Style controls the font, size, color, and other text properties.
+	Style graphics.TextStyle
+	// Align controls paragraph-level horizontal text alignment.
+	Align graphics.TextAlign
+	// MaxLines limits the number of visible lines (0 = unlimited).
+	MaxLines int
+	// SelectionColor fills the selected text. Zero means transparent.
+	SelectionColor graphics.Color
+	// HandleColor is the color of the selection handles. Zero means
+	// transparent.
+	HandleColor graphics.Color
+	// ToolbarColor is the background of the Copy and Select all toolbar.
+	// Zero means transparent.
+	ToolbarColor graphics.Color
+	// ToolbarTextColor is the color of the toolbar labels. Zero means
+	// transparent.
+	ToolbarTextColor graphics.Color
+	// OnSelectionChanged is called with the selected text whenever it
+	// changes.
+	OnSelectionChanged func(text string)
+}
+
+func (t SelectableText) Build(ctx core.BuildContext) core.Widget {
+	return SelectionArea{
+		SelectionColor:     t.SelectionColor,
+		HandleColor:        t.HandleColor,
+		ToolbarColor:       t.ToolbarColor,
+		ToolbarTextColor:   t.ToolbarTextColor,
+		OnSelectionChanged: t.OnSelectionChanged,
+		Child: Text{
+			Content:  t.Content,
+			Style:    t.Style,
+			Align:    t.Align,
+			MaxLines: t.MaxLines,
+		},
+	}
+}
+
+// selectable is implemented by render objects whose text a SelectionArea can
+// select. Offsets are byte offsets into selectableText, and positions are
+// local to the render object.
+type selectable interface {
+	layout.RenderBox
+	selectableText() string
+	caretOffsetAt(position graphics.Offset) int
+	selectionRects(start, end int) []graphics.Rect
+	setSelection(selection textSelection)
+}
+
+// textSelection is the part of a selection that falls in one selectable.
+type textSelection struct {
+	start, end  int
+	color       graphics.Color
+	handleColor graphics.Color
+	startHandle bool // the selection starts in this text
+	endHandle   bool // the selection ends in this text
+}
+
+const (
+	selectionHandleRadius = 7.0
+	// selectionHandleSlop is how far from a handle's center a pointer down
+	// still grabs it.
+	selectionHandleSlop = 24.0
+	selectionToolbarGap = 8.0
+)
+
+// startHandleCenter returns the center of the handle below the start of
+// rect, the first box of a selection.
+func startHandleCenter(rect graphics.Rect) graphics.Offset {
+	return graphics.Offset{X: rect.Left, Y: rect.Bottom + selectionHandleRadius}
+}
+
+// endHandleCenter returns the center of the handle below the end of rect,
+// the last box of a selection.
+func endHandleCenter(rect graphics.Rect) graphics.Offset {
+	return graphics.Offset{X: rect.Right, Y: rect.Bottom + selectionHandleRadius}
+}
+
+// paintSelectionHighlight fills the selected boxes. Call it before drawing
+// the text so the glyphs stay on top.
+func paintSelectionHighlight(canvas graphics.Canvas, rects []graphics.Rect, selection textSelection) {
+	paint := graphics.DefaultPaint()
+	paint.Color = selection.color
+	for _, rect := range rects {
+		canvas.DrawRect(rect, paint)
+	}
+}
+
+// paintSelectionHandles draws a stem and knob at each end of the selection
+// that falls in rects.
+func paintSelectionHandles(canvas graphics.Canvas, rects []graphics.Rect, selection textSelection) {
+	if len(rects) == 0 {
+		return
+	}
+	paint := graphics.DefaultPaint()
+	paint.Color = selection.handleColor
+	if selection.startHandle {
+		first := rects[0]
+		canvas.DrawRect(graphics.Rect{Left: first.Left - 1, Top: first.Top, Right: first.Left + 1, Bottom: first.Bottom}, paint)
+		canvas.DrawCircle(startHandleCenter(first), selectionHandleRadius, paint)
+	}
+	if selection.endHandle {
+		last := rects[len(rects)-1]
+		canvas.DrawRect(graphics.Rect{Left: last.Right - 1, Top: last.Top, Right: last.Right + 1, Bottom: last.Bottom}, paint)
+		canvas.DrawCircle(endHandleCenter(last), selectionHandleRadius, paint)
+	}
+}
+
+// wordBoundsAt returns the word around the byte offset in text. Offsets on
+// whitespace or punctuation select that single character, unless they sit
+// right after a word, which is then selected.
+func wordBoundsAt(text string, offset int) (int, int) {
+	if text == "" {
+		return 0, 0
+	}
+	offset = min(max(offset, 0), len(text))
+	if offset == len(text) || !isWordRune(runeAt(text, offset)) {
+		if prev, size := utf8.DecodeLastRuneInString(text[:offset]); size > 0 && isWordRune(prev) {
+			offset -= size
+		} else if offset == len(text) {
+			return offset - size, offset
+		}
+	}
+	r, size := utf8.DecodeRuneInString(text[offset:])
+	if !isWordRune(r) {
+		return offset, offset + size
+	}
+	start := offset
+	for start > 0 {
+		r, n := utf8.DecodeLastRuneInString(text[:start])
+		if !isWordRune(r) {
+			break
+		}
+		start -= n
+	}
+	end := offset
+	for end < len(text) {
+		r, n := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(r) {
+			break
+		}
+		end += n
+	}
+	return start, end
+}
+
+func runeAt(text string, offset int) rune {
+	r, _ := utf8.DecodeRuneInString(text[offset:])
+	return r
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_' || r == '\''
+}
+
+// selectionRegion is the render object widget under a SelectionArea. Its
+// first child is the content and its optional second child the toolbar.
+type selectionRegion struct {
+	core.RenderObjectBase
+	child              core.Widget
+	toolbar            core.Widget
+	state              *selectionAreaState
+	selectionColor     graphics.Color
+	handleColor        graphics.Color
+	onSelectionChanged func(text string)
+}
+
+func (s selectionRegion) ChildrenWidgets() []core.Widget {
+	children := make([]core.Widget, 0, 2)
+	if s.child != nil {
+		children = append(children, s.child)
+	}
+	if s.toolbar != nil {
+		children = append(children, s.toolbar)
+	}
+	return children
+}
+
+func (s selectionRegion) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := newRenderSelectionArea()
+	s.UpdateRenderObject(ctx, r)
+	return r
+}
+
+func (s selectionRegion) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	r, ok := renderObject.(*renderSelectionArea)
+	if !ok {
+		return
+	}
+	settings := GestureSettingsOf(ctx)
+	r.longPress.Settings = settings
+	r.tap.Settings = settings
+	r.state = s.state
+	s.state.region = r
+	r.onSelectionChanged = s.onSelectionChanged
+	if r.selectionColor != s.selectionColor || r.handleColor != s.handleColor {
+		r.selectionColor = s.selectionColor
+		r.handleColor = s.handleColor
+		r.applySelection()
+	}
+}
+
+// selectionPoint is a caret position in one selectable.
+type selectionPoint struct {
+	target selectable
+	offset int
+}
+
+// resolvedPoint is a selection point located in the list of selectables
+// returned by collectSelectables.
+type resolvedPoint struct {
+	index, offset int
+}
+
+func (p resolvedPoint) before(other resolvedPoint) bool {
+	return p.index < other.index || (p.index == other.index && p.offset < other.offset)
+}
+
+const (
+	noHandle = iota
+	startHandle
+	endHandle
+)
+
+type renderSelectionArea struct {
+	layout.RenderBoxBase
+	content            layout.RenderBox
+	toolbar            layout.RenderBox
+	state              *selectionAreaState
+	selectionColor     graphics.Color
+	handleColor        graphics.Color
+	onSelectionChanged func(text string)
+
+	longPress  *gestures.LongPressGestureRecognizer
+	tap        *gestures.TapGestureRecognizer
+	handleDrag *selectionHandleDrag
+
+	active         bool
+	anchor, extent selectionPoint
+	// word is the word selected by the long press, kept so that dragging
+	// back past it keeps the whole word selected.
+	wordStart, wordEnd selectionPoint
+	lastText           string
+}
+
+func newRenderSelectionArea() *renderSelectionArea {
+	r := &renderSelectionArea{}
+	r.SetSelf(r)
+	r.longPress = gestures.NewLongPressGestureRecognizer(r.GestureArena())
+	r.longPress.OnLongPressStart = r.onLongPressStart
+	r.longPress.OnLongPressMoveUpdate = r.onLongPressMove
+	r.longPress.OnLongPressEnd = r.onGestureEnd
+	r.tap = gestures.NewTapGestureRecognizer(r.GestureArena())
+	r.tap.OnTap = r.clearSelection
+	r.handleDrag = &selectionHandleDrag{}
+	return r
+}
+
+// SetChildren sets the content and toolbar render objects.
+func (r *renderSelectionArea) SetChildren(children []layout.RenderObject) {
+	layout.SetParentOnChild(r.content, nil)
+	layout.SetParentOnChild(r.toolbar, nil)
+	r.content, r.toolbar = nil, nil
+	if len(children) > 0 {
+		r.content = layout.AsRenderBox(children[0])
+		layout.SetParentOnChild(r.content, r)
+	}
+	if len(children) > 1 {
+		r.toolbar = layout.AsRenderBox(children[1])
+		layout.SetParentOnChild(r.toolbar, r)
+	}
+	r.MarkNeedsLayout()
+}
+
+func (r *renderSelectionArea) VisitChildren(visitor func(layout.RenderObject)) {
+	if r.content != nil {
+		visitor(r.content)
+	}
+	if r.toolbar != nil {
+		visitor(r.toolbar)
+	}
+}
+
+func (r *renderSelectionArea) PerformLayout() {
+	constraints := r.Constraints()
+	if r.content == nil {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+	} else {
+		r.content.Layout(constraints, true)
+		r.content.SetParentData(&layout.BoxParentData{})
+		r.SetSize(r.content.Size())
+	}
+	if r.toolbar != nil {
+		r.toolbar.Layout(layout.Loose(graphics.Size{Width: math.Inf(1), Height: math.Inf(1)}), true)
+		r.toolbar.SetParentData(&layout.BoxParentData{Offset: r.toolbarOffset(r.toolbar.Size())})
+	}
+}
+
+// toolbarOffset centers the toolbar above the selection, or below it when
+// there is no room above on screen.
+func (r *renderSelectionArea) toolbarOffset(size graphics.Size) graphics.Offset {
+	bounds, ok := r.selectionBounds()
+	if !ok {
+		return graphics.Offset{}
+	}
+	x := bounds.Center().X - size.Width/2
+	x = max(0, min(x, r.Size().Width-size.Width))
+	y := bounds.Top - size.Height - selectionToolbarGap
+	if r.globalOrigin().Y+y < 0 {
+		y = bounds.Bottom + 2*selectionHandleRadius + selectionToolbarGap
+	}
+	return graphics.Offset{X: x, Y: y}
+}
+
+func (r *renderSelectionArea) Paint(ctx *layout.PaintContext) {
+	if r.content != nil {
+		ctx.PaintChildWithLayer(r.content, graphics.Offset{})
+	}
+	if r.toolbar != nil {
+		ctx.PaintChildWithLayer(r.toolbar, getChildOffset(r.toolbar))
+	}
+}
+
+// HitTest claims pointers anywhere in the area, and on the handles, which
+// hang below the text and may lie outside it.
+func (r *renderSelectionArea) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.toolbar != nil {
+		offset := getChildOffset(r.toolbar)
+		if r.toolbar.HitTest(graphics.Offset{X: position.X - offset.X, Y: position.Y - offset.Y}, result) {
+			return true
+		}
+	}
+	if r.handleAt(position) != noHandle {
+		result.Add(r)
+		return true
+	}
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	if r.content != nil {
+		r.content.HitTest(position, result)
+	}
+	result.Add(r)
+	return true
+}
+
+func (r *renderSelectionArea) HandlePointer(event gestures.PointerEvent) {
+	if event.Phase == gestures.PointerPhaseDown {
+		if handle := r.handleAt(event.Position); handle != noHandle {
+			r.startHandleDrag(event, handle)
+			return
+		}
+		r.setToolbar(false)
+		r.longPress.AddPointer(event)
+		if r.hasSelection() {
+			r.tap.AddPointer(event)
+		}
+		return
+	}
+	if r.handleDrag.active && event.PointerID == r.handleDrag.pointer {
+		r.handleDragEvent(event)
+		return
+	}
+	r.longPress.HandleEvent(event)
+	r.tap.HandleEvent(event)
+}
+
+// Dispose releases the gesture recognizers.
+func (r *renderSelectionArea) Dispose() {
+	r.longPress.Dispose()
+	r.tap.Dispose()
+	r.RenderBoxBase.Dispose()
+}
+
+func (r *renderSelectionArea) setToolbar(show bool) {
+	if r.state != nil {
+		r.state.setToolbar(show)
+	}
+}
+
+func (r *renderSelectionArea) onLongPressStart(details gestures.LongPressDetails) {
+	targets := r.collectSelectables()
+	for i, target := range targets {
+		local, ok := r.localPosition(target, details.Position)
+		if !ok || !layout.WithinBounds(local, target.Size()) {
+			continue
+		}
+		start, end := wordBoundsAt(target.selectableText(), target.caretOffsetAt(local))
+		r.active = true
+		r.wordStart = selectionPoint{target: targets[i], offset: start}
+		r.wordEnd = selectionPoint{target: targets[i], offset: end}
+		r.anchor, r.extent = r.wordStart, r.wordEnd
+		r.applySelection()
+		return
+	}
+}
+
+// onLongPressMove extends the selection from the long-pressed word to the
+// pointer, in either direction.
+func (r *renderSelectionArea) onLongPressMove(details gestures.LongPressDetails) {
+	if !r.active {
+		return
+	}
+	targets := r.collectSelectables()
+	point, ok := r.pointAt(targets, details.Position)
+	wordStart, okStart := resolvePoint(targets, r.wordStart)
+	wordEnd, okEnd := resolvePoint(targets, r.wordEnd)
+	if !ok || !okStart || !okEnd {
+		return
+	}
+	switch {
+	case point.before(wordStart):
+		r.anchor, r.extent = r.wordEnd, pointIn(targets, point)
+	case wordEnd.before(point):
+		r.anchor, r.extent = r.wordStart, pointIn(targets, point)
+	default:
+		r.anchor, r.extent = r.wordStart, r.wordEnd
+	}
+	r.applySelection()
+}
+
+func (r *renderSelectionArea) onGestureEnd() {
+	if r.hasSelection() {
+		r.setToolbar(true)
+		r.MarkNeedsLayout()
+	}
+}
+
+// startHandleDrag claims the pointer for dragging one end of the selection.
+// The other end becomes the anchor, so the ends may cross.
+func (r *renderSelectionArea) startHandleDrag(event gestures.PointerEvent, handle int) {
+	targets := r.collectSelectables()
+	start, end, ok := r.orderedSelection(targets)
+	if !ok {
+		return
+	}
+	moving := end
+	r.anchor, r.extent = pointIn(targets, start), pointIn(targets, end)
+	if handle == startHandle {
+		moving = start
+		r.anchor, r.extent = r.extent, r.anchor
+	}
+	// Track the caret in the middle of the line the handle hangs from, not
+	// the knob below it.
+	caret, ok := r.caretRect(targets, moving, handle)
+	if !ok {
+		return
+	}
+	drag := r.handleDrag
+	drag.pointer = event.PointerID
+	drag.active = true
+	drag.correction = graphics.Offset{X: 0, Y: caret.Center().Y - event.Position.Y}
+	arena := r.longPress.Arena
+	arena.Add(event.PointerID, drag)
+	arena.Resolve(event.PointerID, drag)
+	r.setToolbar(false)
+}
+
+func (r *renderSelectionArea) handleDragEvent(event gestures.PointerEvent) {
+	switch event.Phase {
+	case gestures.PointerPhaseMove:
+		targets := r.collectSelectables()
+		position := graphics.Offset{X: event.Position.X + r.handleDrag.correction.X, Y: event.Position.Y + r.handleDrag.correction.Y}
+		if point, ok := r.pointAt(targets, position); ok {
+			r.extent = pointIn(targets, point)
+			r.applySelection()
+		}
+	case gestures.PointerPhaseUp:
+		r.handleDrag.active = false
+		r.onGestureEnd()
+	case gestures.PointerPhaseCancel:
+		r.handleDrag.active = false
+	}
+}
+
+// selectAll selects the text of every selectable in the area.
+func (r *renderSelectionArea) selectAll() {
+	targets := r.collectSelectables()
+	if len(targets) == 0 {
+		return
+	}
+	last := targets[len(targets)-1]
+	r.active = true
+	r.anchor = selectionPoint{target: targets[0]}
+	r.extent = selectionPoint{target: last, offset: len(last.selectableText())}
+	r.applySelection()
+	r.MarkNeedsLayout()
+}
+
+func (r *renderSelectionArea) clearSelection() {
+	r.active = false
+	r.anchor, r.extent = selectionPoint{}, selectionPoint{}
+	r.wordStart, r.wordEnd = selectionPoint{}, selectionPoint{}
+	r.applySelection()
+	r.setToolbar(false)
+}
+
+func (r *renderSelectionArea) hasSelection() bool {
+	_, _, ok := r.orderedSelection(r.collectSelectables())
+	return ok
+}
+
+// applySelection pushes each selectable's part of the selection to it and
+// reports the selected text if it changed.
+func (r *renderSelectionArea) applySelection() {
+	targets := r.collectSelectables()
+	start, end, ok := r.orderedSelection(targets)
+	for i, target := range targets {
+		if !ok || i < start.index || i > end.index {
+			target.setSelection(textSelection{})
+			continue
+		}
+		from, to := selectionRangeIn(targets, start, end, i)
+		target.setSelection(textSelection{
+			start:       from,
+			end:         to,
+			color:       r.selectionColor,
+			handleColor: r.handleColor,
+			startHandle: i == start.index,
+			endHandle:   i == end.index,
+		})
+	}
+	text := r.selectedText()
+	if text == r.lastText {
+		return
+	}
+	r.lastText = text
+	if r.onSelectionChanged != nil {
+		r.onSelectionChanged(text)
+	}
+}
+
+// selectedText returns the selected text, with a newline between the parts
+// from different selectables.
+func (r *renderSelectionArea) selectedText() string {
+	targets := r.collectSelectables()
+	start, end, ok := r.orderedSelection(targets)
+	if !ok {
+		return ""
+	}
+	parts := make([]string, 0, end.index-start.index+1)
+	for i := start.index; i <= end.index; i++ {
+		from, to := selectionRangeIn(targets, start, end, i)
+		parts = append(parts, targets[i].selectableText()[from:to])
+	}
+	return strings.Join(parts, "\n")
+}
+
+// selectionRangeIn returns the byte range of the selection from start to
+// end that falls in targets[i].
+func selectionRangeIn(targets []selectable, start, end resolvedPoint, i int) (int, int) {
+	n := len(targets[i].selectableText())
+	from, to := 0, n
+	if i == start.index {
+		from = min(start.offset, n)
+	}
+	if i == end.index {
+		to = min(end.offset, n)
+	}
+	return from, max(from, to)
+}
+
+// orderedSelection returns the selection in document order. Ends that sit
+// on a boundary between selectables are moved into the text they select,
+// so the handles are drawn where the selected text is. It reports false if
+// nothing is selected.
+func (r *renderSelectionArea) orderedSelection(targets []selectable) (resolvedPoint, resolvedPoint, bool) {
+	if !r.active {
+		return resolvedPoint{}, resolvedPoint{}, false
+	}
+	start, okStart := resolvePoint(targets, r.anchor)
+	end, okEnd := resolvePoint(targets, r.extent)
+	if !okStart || !okEnd {
+		return resolvedPoint{}, resolvedPoint{}, false
+	}
+	if end.before(start) {
+		start, end = end, start
+	}
+	for start.index < end.index && start.offset >= len(targets[start.index].selectableText()) {
+		start = resolvedPoint{index: start.index + 1}
+	}
+	for end.index > start.index && end.offset == 0 {
+		end = resolvedPoint{index: end.index - 1, offset: len(targets[end.index-1].selectableText())}
+	}
+	if start == end || (start.index == end.index && start.offset >= len(targets[start.index].selectableText())) {
+		return resolvedPoint{}, resolvedPoint{}, false
+	}
+	return start, end, true
+}
+
+func resolvePoint(targets []selectable, point selectionPoint) (resolvedPoint, bool) {
+	for i, target := range targets {
+		if target == point.target {
+			return resolvedPoint{index: i, offset: point.offset}, true
+		}
+	}
+	return resolvedPoint{}, false
+}
+
+func pointIn(targets []selectable, point resolvedPoint) selectionPoint {
+	return selectionPoint{target: targets[point.index], offset: point.offset}
+}
+
+// collectSelectables returns the selectable render objects in the content,
+// in paint order.
+func (r *renderSelectionArea) collectSelectables() []selectable {
+	var targets []selectable
+	var visit func(layout.RenderObject)
+	visit = func(node layout.RenderObject) {
+		if target, ok := node.(selectable); ok {
+			targets = append(targets, target)
+			return
+		}
+		if parent, ok := node.(interface {
+			VisitChildren(func(layout.RenderObject))
+		}); ok {
+			parent.VisitChildren(visit)
+		}
+	}
+	if r.content != nil {
+		visit(r.content)
+	}
+	return targets
+}
+
+// pointAt returns the caret position nearest to position, in area
+// coordinates. Points between selectables snap to the start of the next one
+// in document order.
+func (r *renderSelectionArea) pointAt(targets []selectable, position graphics.Offset) (resolvedPoint, bool) {
+	found := false
+	for i, target := range targets {
+		origin, ok := r.originOf(target)
+		if !ok {
+			continue
+		}
+		found = true
+		size := target.Size()
+		local := graphics.Offset{X: position.X - origin.X, Y: position.Y - origin.Y}
+		if local.Y < 0 || (local.Y <= size.Height && local.X < 0) {
+			return resolvedPoint{index: i}, true
+		}
+		if local.Y <= size.Height && local.X <= size.Width {
+			return resolvedPoint{index: i, offset: target.caretOffsetAt(local)}, true
+		}
+	}
+	if !found {
+		return resolvedPoint{}, false
+	}
+	last := len(targets) - 1
+	return resolvedPoint{index: last, offset: len(targets[last].selectableText())}, true
+}
+
+// caretRect returns the selection box, in area coordinates, that the given
+// handle hangs from.
+func (r *renderSelectionArea) caretRect(targets []selectable, point resolvedPoint, handle int) (graphics.Rect, bool) {
+	start, end, ok := r.orderedSelection(targets)
+	if !ok {
+		return graphics.Rect{}, false
+	}
+	target := targets[point.index]
+	origin, ok := r.originOf(target)
+	if !ok {
+		return graphics.Rect{}, false
+	}
+	from, to := selectionRangeIn(targets, start, end, point.index)
+	rects := target.selectionRects(from, to)
+	if len(rects) == 0 {
+		return graphics.Rect{}, false
+	}
+	rect := rects[0]
+	if handle == endHandle {
+		rect = rects[len(rects)-1]
+	}
+	return rect.Translate(origin.X, origin.Y), true
+}
+
+// handleAt returns the selection handle under position, in area
+// coordinates. The end handle wins when both are in reach.
+func (r *renderSelectionArea) handleAt(position graphics.Offset) int {
+	targets := r.collectSelectables()
+	start, end, ok := r.orderedSelection(targets)
+	if !ok {
+		return noHandle
+	}
+	within := func(center graphics.Offset) bool {
+		return math.Hypot(position.X-center.X, position.Y-center.Y) <= selectionHandleSlop
+	}
+	if rect, ok := r.caretRect(targets, end, endHandle); ok && within(endHandleCenter(rect)) {
+		return endHandle
+	}
+	if rect, ok := r.caretRect(targets, start, startHandle); ok && within(startHandleCenter(rect)) {
+		return startHandle
+	}
+	return noHandle
+}
+
+// selectionBounds returns the box around the selection, in area
+// coordinates.
+func (r *renderSelectionArea) selectionBounds() (graphics.Rect, bool) {
+	targets := r.collectSelectables()
+	start, end, ok := r.orderedSelection(targets)
+	if !ok {
+		return graphics.Rect{}, false
+	}
+	var bounds graphics.Rect
+	found := false
+	for i := start.index; i <= end.index; i++ {
+		origin, ok := r.originOf(targets[i])
+		if !ok {
+			continue
+		}
+		from, to := selectionRangeIn(targets, start, end, i)
+		for _, rect := range targets[i].selectionRects(from, to) {
+			rect = rect.Translate(origin.X, origin.Y)
+			if !found {
+				bounds, found = rect, true
+			} else {
+				bounds = bounds.Union(rect)
+			}
+		}
+	}
+	return bounds, found
+}
+
+// localPosition converts position from area coordinates to target's.
+func (r *renderSelectionArea) localPosition(target selectable, position graphics.Offset) (graphics.Offset, bool) {
+	origin, ok := r.originOf(target)
+	if !ok {
+		return graphics.Offset{}, false
+	}
+	return graphics.Offset{X: position.X - origin.X, Y: position.Y - origin.Y}, true
+}
+
+// originOf returns where target's origin sits in the area, including the
+// scroll offsets of any scroll views in between. It reports false if target
+// is not below the area.
+func (r *renderSelectionArea) originOf(target layout.RenderObject) (graphics.Offset, bool) {
+	var origin graphics.Offset
+	for node := target; node != nil; {
+		if node == layout.RenderObject(r) {
+			return origin, true
+		}
+		parent := renderParentOf(node)
+		offset := offsetInRenderParent(node, parent)
+		origin.X += offset.X
+		origin.Y += offset.Y
+		node = parent
+	}
+	return graphics.Offset{}, false
+}
+
+// globalOrigin returns the area's position in the root render object.
+func (r *renderSelectionArea) globalOrigin() graphics.Offset {
+	var origin graphics.Offset
+	for node := layout.RenderObject(r); node != nil; {
+		parent := renderParentOf(node)
+		offset := offsetInRenderParent(node, parent)
+		origin.X += offset.X
+		origin.Y += offset.Y
+		node = parent
+	}
+	return origin
+}
+
+func renderParentOf(node layout.RenderObject) layout.RenderObject {
+	if child, ok := node.(interface{ Parent() layout.RenderObject }); ok {
+		return child.Parent()
+	}
+	return nil
+}
+
+// offsetInRenderParent returns where node's origin sits in parent, including
+// any paint-time scroll offset parent applies to its children.
+func offsetInRenderParent(node, parent layout.RenderObject) graphics.Offset {
+	var offset graphics.Offset
+	if data, ok := node.ParentData().(*layout.BoxParentData); ok && data != nil {
+		offset = data.Offset
+	}
+	if provider, ok := parent.(core.ScrollOffsetProvider); ok {
+		scroll := provider.ScrollOffset()
+		offset.X += scroll.X
+		offset.Y += scroll.Y
+	}
+	return offset
+}
+
+// selectionHandleDrag is the arena member that claims a pointer pressed on
+// a selection handle.
+type selectionHandleDrag struct {
+	pointer    int64
+	active     bool
+	correction graphics.Offset
+}
+
+func (d *selectionHandleDrag) AcceptGesture(pointerID int64) {}
+
+func (d *selectionHandleDrag) RejectGesture(pointerID int64) {
+	if pointerID == d.pointer {
+		d.active = false
+	}
+}
+
+// selectionToolbar shows the Copy and Select all actions.
+type selectionToolbar struct {
+	core.StatelessBase
+	color       graphics.Color
+	textColor   graphics.Color
+	onCopy      func()
+	onSelectAll func()
+}
+
+func (t selectionToolbar) Build(ctx core.BuildContext) core.Widget {
+	action := func(label string, onTap func()) core.Widget {
+		return GestureDetector{
+			OnTap: onTap,
+			Child: Padding{
+				Padding: layout.EdgeInsetsSymmetric(12, 10),
+				Child: Text{
+					Content: label,
+					Style:   graphics.TextStyle{Color: t.textColor, FontSize: 14},
+					Wrap:    graphics.TextWrapNoWrap,
+				},
+			},
+		}
+	}
+	return Container{
+		Color:        t.color,
+		BorderRadius: 8,
+		Child: Row{
+			MainAxisSize: MainAxisSizeMin,
+			Children: []core.Widget{
+				action("Copy", t.onCopy),
+				action("Select all", t.onSelectAll),
+			},
+		},
+	}
+}
@@ -0,0 +1,176 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// monoText is a selectable with 10px wide characters on a single 20px line,
+// standing in for renderText, which has no glyph geometry in tests.
+type monoText struct {
+	layout.RenderBoxBase
+	text      string
+	selection textSelection
+}
+
+func (m *monoText) selectableText() string { return m.text }
+
+func (m *monoText) caretOffsetAt(position graphics.Offset) int {
+	return min(max(int(position.X/10+0.5), 0), len(m.text))
+}
+
+func (m *monoText) selectionRects(start, end int) []graphics.Rect {
+	if start >= end {
+		return nil
+	}
+	return []graphics.Rect{{Left: float64(start) * 10, Top: 0, Right: float64(end) * 10, Bottom: 20}}
+}
+
+func (m *monoText) setSelection(selection textSelection) { m.selection = selection }
+
+func (m *monoText) Paint(*layout.PaintContext) {}
+
+func (m *monoText) HitTest(graphics.Offset, *layout.HitTestResult) bool { return false }
+
+// monoColumn stacks its children 30px apart.
+type monoColumn struct {
+	layout.RenderBoxBase
+	children []layout.RenderBox
+}
+
+func (c *monoColumn) Paint(*layout.PaintContext) {}
+
+func (c *monoColumn) HitTest(graphics.Offset, *layout.HitTestResult) bool { return false }
+
+func (c *monoColumn) VisitChildren(visitor func(layout.RenderObject)) {
+	for _, child := range c.children {
+		visitor(child)
+	}
+}
+
+func newSelectionFixture(texts ...string) (*renderSelectionArea, []*monoText) {
+	column := &monoColumn{}
+	column.SetSelf(column)
+	var items []*monoText
+	for i, text := range texts {
+		item := &monoText{text: text}
+		item.SetSelf(item)
+		item.SetSize(graphics.Size{Width: float64(len(text)) * 10, Height: 20})
+		item.SetParentData(&layout.BoxParentData{Offset: graphics.Offset{Y: float64(i) * 30}})
+		layout.SetParentOnChild(item, column)
+		column.children = append(column.children, item)
+		items = append(items, item)
+	}
+	column.SetSize(graphics.Size{Width: 200, Height: float64(len(texts)) * 30})
+	region := newRenderSelectionArea()
+	region.SetChildren([]layout.RenderObject{column})
+	region.SetSize(column.Size())
+	column.SetParentData(&layout.BoxParentData{})
+	return region, items
+}
+
+func TestSelectionArea_LongPressSelectsWordAndExtends(t *testing.T) {
+	region, items := newSelectionFixture("hello world", "second line")
+	var changes []string
+	region.onSelectionChanged = func(text string) { changes = append(changes, text) }
+
+	region.onLongPressStart(gestures.LongPressDetails{Position: graphics.Offset{X: 72, Y: 10}})
+	if got := region.selectedText(); got != "world" {
+		t.Fatalf("selected %q after long press, want %q", got, "world")
+	}
+
+	// Dragging into the next text extends past the word.
+	region.onLongPressMove(gestures.LongPressDetails{Position: graphics.Offset{X: 60, Y: 40}})
+	if got := region.selectedText(); got != "world\nsecond" {
+		t.Fatalf("selected %q after moving, want %q", got, "world\nsecond")
+	}
+	// Dragging back before the word keeps the whole word.
+	region.onLongPressMove(gestures.LongPressDetails{Position: graphics.Offset{X: 20, Y: 10}})
+	if got := region.selectedText(); got != "llo world" {
+		t.Fatalf("selected %q after moving back, want %q", got, "llo world")
+	}
+
+	first, second := items[0].selection, items[1].selection
+	if first.start != 2 || first.end != 11 || !first.startHandle || !first.endHandle {
+		t.Errorf("first text selection = %+v", first)
+	}
+	if second != (textSelection{}) {
+		t.Errorf("expected the second text to be deselected, got %+v", second)
+	}
+	want := []string{"world", "world\nsecond", "llo world"}
+	if len(changes) != len(want) {
+		t.Fatalf("changes = %q, want %q", changes, want)
+	}
+}
+
+func TestSelectionArea_SelectAllAndClear(t *testing.T) {
+	region, items := newSelectionFixture("one", "two", "three")
+	region.selectAll()
+	if got := region.selectedText(); got != "one\ntwo\nthree" {
+		t.Fatalf("selected %q, want all text", got)
+	}
+	if !items[0].selection.startHandle || items[1].selection.startHandle || !items[2].selection.endHandle {
+		t.Errorf("expected handles at the first and last text only")
+	}
+
+	region.clearSelection()
+	if got := region.selectedText(); got != "" {
+		t.Fatalf("selected %q after clearing", got)
+	}
+	for i, item := range items {
+		if item.selection != (textSelection{}) {
+			t.Errorf("text %d still selected: %+v", i, item.selection)
+		}
+	}
+}
+
+func TestSelectionArea_DragEndHandle(t *testing.T) {
+	region, _ := newSelectionFixture("hello world")
+	region.onLongPressStart(gestures.LongPressDetails{Position: graphics.Offset{X: 15, Y: 10}})
+	if got := region.selectedText(); got != "hello" {
+		t.Fatalf("selected %q, want %q", got, "hello")
+	}
+
+	// The end handle hangs below the end of "hello".
+	knob := graphics.Offset{X: 50, Y: 20 + selectionHandleRadius}
+	if got := region.handleAt(knob); got != endHandle {
+		t.Fatalf("handleAt(%v) = %d, want the end handle", knob, got)
+	}
+	result := &layout.HitTestResult{}
+	if !region.HitTest(graphics.Offset{X: 50, Y: 30}, result) {
+		t.Fatal("expected the handle below the text to be hit")
+	}
+
+	region.HandlePointer(gestures.PointerEvent{PointerID: 7, Position: knob, Phase: gestures.PointerPhaseDown})
+	region.HandlePointer(gestures.PointerEvent{PointerID: 7, Position: graphics.Offset{X: 110, Y: knob.Y}, Phase: gestures.PointerPhaseMove})
+	region.HandlePointer(gestures.PointerEvent{PointerID: 7, Position: graphics.Offset{X: 110, Y: knob.Y}, Phase: gestures.PointerPhaseUp})
+	gestures.DefaultArena.Sweep(7)
+
+	if got := region.selectedText(); got != "hello world" {
+		t.Errorf("selected %q after dragging the end handle, want %q", got, "hello world")
+	}
+}
+
+func TestWordBoundsAt(t *testing.T) {
+	text := "it's a café, ok"
+	tests := []struct {
+		offset     int
+		start, end int
+	}{
+		{0, 0, 4},    // it's
+		{4, 0, 4},    // just after a word
+		{5, 5, 6},    // a
+		{8, 7, 12},   // café
+		{13, 13, 14}, // the space after the comma
+		{len(text), 14, 16},
+	}
+	for _, tt := range tests {
+		start, end := wordBoundsAt(text, tt.offset)
+		if start != tt.start || end != tt.end {
+			t.Errorf("wordBoundsAt(%d) = %d, %d; want %d, %d", tt.offset, start, end, tt.start, tt.end)
+		}
+	}
+}
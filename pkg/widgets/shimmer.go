@@ -0,0 +1,266 @@
+package widgets
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// defaultShimmerPeriod is how long one sweep takes when Period is zero.
+const defaultShimmerPeriod = 1500 * time.Millisecond
+
+// Shimmer paints its child in BaseColor with a band of HighlightColor
+// sweeping across it, the usual loading effect for [Skeleton] placeholders.
+// Only the shape of the child is kept: everything it paints takes the
+// shimmer's colors.
+//
+// All shimmers are driven by one shared ticker, so separate shimmers with
+// the same Period sweep in step. While animations are disabled the shimmer
+// shows BaseColor without moving.
+//
+// # Styling Model
+//
+// Shimmer is explicit by default: zero colors paint nothing. For
+// theme-styled shimmers, use [theme.ShimmerOf].
+//
+//	widgets.Shimmer{
+//	    BaseColor:      colors.SurfaceVariant,
+//	    HighlightColor: colors.Surface,
+//	    Child: widgets.Column{Children: []core.Widget{
+//	        widgets.Skeleton{Width: 200, Height: 16, BorderRadius: 4, Color: graphics.ColorBlack},
+//	        widgets.VSpace(8),
+//	        widgets.Skeleton{Width: 120, Height: 16, BorderRadius: 4, Color: graphics.ColorBlack},
+//	    }},
+//	}
+type Shimmer struct {
+	core.RenderObjectBase
+
+	// BaseColor is the color of the placeholder shapes between sweeps.
+	BaseColor graphics.Color
+	// HighlightColor is the color at the center of the sweeping band.
+	HighlightColor graphics.Color
+	// Period is how long one sweep takes. Defaults to 1.5s.
+	Period time.Duration
+	// Child provides the placeholder shapes.
+	Child core.Widget
+}
+
+func (s Shimmer) ChildWidget() core.Widget {
+	return s.Child
+}
+
+func (s Shimmer) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderShimmer{
+		baseColor:      s.BaseColor,
+		highlightColor: s.HighlightColor,
+		period:         s.Period,
+	}
+	box.SetSelf(box)
+	shimmerClock.add(box)
+	return box
+}
+
+func (s Shimmer) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderShimmer); ok {
+		box.baseColor = s.BaseColor
+		box.highlightColor = s.HighlightColor
+		box.period = s.Period
+		box.MarkNeedsPaint()
+	}
+}
+
+type renderShimmer struct {
+	renderPassthrough
+	baseColor      graphics.Color
+	highlightColor graphics.Color
+	period         time.Duration
+}
+
+// IsRepaintBoundary returns true so each sweep frame repaints only the
+// shimmer.
+func (r *renderShimmer) IsRepaintBoundary() bool {
+	return true
+}
+
+func (r *renderShimmer) Dispose() {
+	shimmerClock.remove(r)
+	r.renderPassthrough.Dispose()
+}
+
+// progress returns how far the current sweep has gone, from 0 to 1.
+func (r *renderShimmer) progress() float64 {
+	if animation.AnimationsDisabled() {
+		return 0
+	}
+	period := r.period
+	if period <= 0 {
+		period = defaultShimmerPeriod
+	}
+	return float64(shimmerClock.elapsed()%period) / float64(period)
+}
+
+func (r *renderShimmer) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	size := r.Size()
+	if size.Width <= 0 || size.Height <= 0 {
+		return
+	}
+	bounds := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+
+	// The gradient is twice as wide as the shimmer, with the highlight in its
+	// middle, and slides from fully left of the shimmer to fully right.
+	center := -2 + 4*r.progress()
+	base, highlight := r.baseColor, r.highlightColor
+	layerPaint := graphics.DefaultPaint()
+	ctx.Canvas.SaveLayer(bounds, &layerPaint)
+	ctx.PaintChildWithLayer(r.child.(layout.RenderBox), graphics.Offset{})
+	// SrcIn keeps the child's coverage and replaces its colors.
+	sweepPaint := graphics.DefaultPaint()
+	sweepPaint.BlendMode = graphics.BlendModeSrcIn
+	sweepPaint.Gradient = graphics.NewLinearGradient(
+		graphics.Alignment{X: center - 2},
+		graphics.Alignment{X: center + 2},
+		[]graphics.GradientStop{
+			{Position: 0, Color: base},
+			{Position: 0.35, Color: base},
+			{Position: 0.5, Color: highlight},
+			{Position: 0.65, Color: base},
+			{Position: 1, Color: base},
+		},
+	)
+	ctx.Canvas.DrawRect(bounds, sweepPaint)
+	ctx.Canvas.Restore()
+}
+
+// shimmerClock runs one ticker for all shimmers while any are mounted and
+// repaints them each frame.
+var shimmerClock = &shimmerTicker{shimmers: make(map[*renderShimmer]struct{})}
+
+type shimmerTicker struct {
+	ticker   *animation.Ticker
+	shimmers map[*renderShimmer]struct{}
+}
+
+func (c *shimmerTicker) add(r *renderShimmer) {
+	c.shimmers[r] = struct{}{}
+	if c.ticker == nil {
+		c.ticker = animation.NewTicker(c.tick)
+	}
+	c.ticker.Start()
+}
+
+func (c *shimmerTicker) remove(r *renderShimmer) {
+	delete(c.shimmers, r)
+	if len(c.shimmers) == 0 && c.ticker != nil {
+		c.ticker.Stop()
+	}
+}
+
+func (c *shimmerTicker) elapsed() time.Duration {
+	if c.ticker == nil {
+		return 0
+	}
+	return c.ticker.Elapsed()
+}
+
+func (c *shimmerTicker) tick(time.Duration) {
+	if animation.AnimationsDisabled() {
+		return
+	}
+	for r := range c.shimmers {
+		r.MarkNeedsPaint()
+	}
+}
+
+// Skeleton is a placeholder shape shown in place of content that is still
+// loading. Lay skeletons out like the content they stand for and wrap them
+// in a [Shimmer] to animate them.
+//
+// Skeleton is explicit by default: a zero Color paints nothing, even under
+// a Shimmer, which keeps only the shapes its child paints. For theme-styled
+// skeletons, use [theme.SkeletonOf].
+//
+//	widgets.Row{Children: []core.Widget{
+//	    widgets.Skeleton{Width: 40, Height: 40, Circle: true, Color: colors.SurfaceVariant},
+//	    widgets.HSpace(12),
+//	    widgets.Expanded{Child: widgets.Skeleton{Height: 14, BorderRadius: 4, Color: colors.SurfaceVariant}},
+//	}}
+type Skeleton struct {
+	core.RenderObjectBase
+
+	// Width is the width of the shape. Zero fills the available width.
+	Width float64
+	// Height is the height of the shape. Zero fills the available height.
+	Height float64
+	// BorderRadius rounds the corners.
+	BorderRadius float64
+	// Circle rounds the shape fully, ignoring BorderRadius: a circle when
+	// Width equals Height, a pill otherwise.
+	Circle bool
+	// Color fills the shape.
+	Color graphics.Color
+}
+
+func (s Skeleton) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderSkeleton{}
+	box.SetSelf(box)
+	s.UpdateRenderObject(ctx, box)
+	return box
+}
+
+func (s Skeleton) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderSkeleton); ok {
+		box.width = s.Width
+		box.height = s.Height
+		box.radius = s.BorderRadius
+		box.circle = s.Circle
+		box.color = s.Color
+		box.MarkNeedsLayout()
+		box.MarkNeedsPaint()
+	}
+}
+
+type renderSkeleton struct {
+	layout.RenderBoxBase
+	width, height float64
+	radius        float64
+	circle        bool
+	color         graphics.Color
+}
+
+func (r *renderSkeleton) PerformLayout() {
+	constraints := r.Constraints()
+	size := graphics.Size{Width: r.width, Height: r.height}
+	if size.Width <= 0 && !math.IsInf(constraints.MaxWidth, 1) {
+		size.Width = constraints.MaxWidth
+	}
+	if size.Height <= 0 && !math.IsInf(constraints.MaxHeight, 1) {
+		size.Height = constraints.MaxHeight
+	}
+	r.SetSize(constraints.Constrain(size))
+}
+
+func (r *renderSkeleton) Paint(ctx *layout.PaintContext) {
+	size := r.Size()
+	if r.color == graphics.ColorTransparent || size.Width <= 0 || size.Height <= 0 {
+		return
+	}
+	radius := r.radius
+	if r.circle {
+		radius = min(size.Width, size.Height) / 2
+	}
+	paint := graphics.DefaultPaint()
+	paint.Color = r.color
+	rect := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+	ctx.Canvas.DrawRRect(graphics.RRectFromRectAndRadius(rect, graphics.CircularRadius(radius)), paint)
+}
+
+func (r *renderSkeleton) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
@@ -0,0 +1,79 @@
+package widgets_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func skeletonShimmer() widgets.Shimmer {
+	return widgets.Shimmer{
+		BaseColor:      graphics.RGB(220, 220, 220),
+		HighlightColor: graphics.RGB(245, 245, 245),
+		Child: widgets.Column{
+			MainAxisSize: widgets.MainAxisSizeMin,
+			Children: []core.Widget{
+				widgets.Skeleton{Width: 200, Height: 16, BorderRadius: 4, Color: graphics.ColorBlack},
+				widgets.Skeleton{Width: 40, Height: 40, Circle: true, Color: graphics.ColorBlack},
+			},
+		},
+	}
+}
+
+func TestShimmer_SharesOneTicker(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	before := animation.ActiveTickerCount()
+
+	tester.PumpWidget(widgets.Column{Children: []core.Widget{
+		skeletonShimmer(),
+		skeletonShimmer(),
+	}})
+	if got := animation.ActiveTickerCount() - before; got != 1 {
+		t.Fatalf("expected two shimmers to share one ticker, got %d tickers", got)
+	}
+
+	tester.PumpWidget(widgets.Text{Content: "loaded"})
+	if got := animation.ActiveTickerCount() - before; got != 0 {
+		t.Errorf("expected the ticker to stop once no shimmer is mounted, got %d tickers", got)
+	}
+}
+
+func TestShimmer_MasksChildWithSweep(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.PumpWidget(widgets.Center{Child: skeletonShimmer()})
+
+	var ops []string
+	for _, op := range tester.CaptureSnapshot().DisplayOps {
+		if op.Op == "saveLayer" || strings.HasPrefix(op.Op, "draw") {
+			ops = append(ops, op.Op)
+		}
+	}
+	// The skeletons are drawn into a layer, then the sweep replaces their
+	// colors.
+	want := []string{"saveLayer", "drawRRect", "drawRRect", "drawRect"}
+	if !slices.Equal(ops, want) {
+		t.Errorf("expected ops %v, got %v", want, ops)
+	}
+}
+
+func TestSkeleton_Size(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	tester.SetSize(graphics.Size{Width: 300, Height: 400})
+	tester.PumpWidget(widgets.Column{
+		CrossAxisAlignment: widgets.CrossAxisAlignmentStretch,
+		Children: []core.Widget{
+			widgets.Skeleton{Height: 14, Color: graphics.ColorBlack},
+		},
+	})
+
+	size := tester.Find(drifttest.ByType[widgets.Skeleton]()).RenderObject().(interface{ Size() graphics.Size }).Size()
+	if size != (graphics.Size{Width: 300, Height: 14}) {
+		t.Errorf("expected a zero width to fill the row, got %v", size)
+	}
+}
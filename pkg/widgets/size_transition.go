@@ -0,0 +1,136 @@
+package widgets
+
+import (
+	"math"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// SizeTransition reveals its child by growing along Axis as Animation runs
+// from 0 to 1, pushing the widgets after it along. At 0 it takes no space;
+// at 1 it is the child's full size.
+//
+// The child keeps its full size and is clipped, anchored to the end of the
+// axis, so it appears to slide out from under whatever is before it.
+//
+//	widgets.SizeTransition{Animation: s.reveal, Child: details}
+type SizeTransition struct {
+	core.RenderObjectBase
+
+	// Animation drives the reveal. Its curved Value is the fraction of the
+	// child shown.
+	Animation *animation.AnimationController
+	// Axis is the direction the transition grows in. Defaults to vertical.
+	Axis Axis
+	// Child is the widget being revealed.
+	Child core.Widget
+}
+
+// ChildWidget returns the child widget.
+func (s SizeTransition) ChildWidget() core.Widget {
+	return s.Child
+}
+
+func (s SizeTransition) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderSizeTransition{}
+	box.SetSelf(box)
+	s.UpdateRenderObject(ctx, box)
+	return box
+}
+
+func (s SizeTransition) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	box, ok := renderObject.(*renderSizeTransition)
+	if !ok {
+		return
+	}
+	if box.animation != s.Animation {
+		if box.unsubscribe != nil {
+			box.unsubscribe()
+			box.unsubscribe = nil
+		}
+		box.animation = s.Animation
+		if s.Animation != nil {
+			box.unsubscribe = s.Animation.AddListener(box.MarkNeedsLayout)
+		}
+	}
+	box.axis = s.Axis
+	box.MarkNeedsLayout()
+}
+
+type renderSizeTransition struct {
+	renderPassthrough
+	animation   *animation.AnimationController
+	unsubscribe func()
+	axis        Axis
+}
+
+// factor returns the fraction of the child shown.
+func (r *renderSizeTransition) factor() float64 {
+	if r.animation == nil {
+		return 1
+	}
+	return min(max(r.animation.Value, 0), 1)
+}
+
+func (r *renderSizeTransition) PerformLayout() {
+	constraints := r.Constraints()
+	if r.child == nil {
+		r.SetSize(constraints.Constrain(graphics.Size{}))
+		return
+	}
+	// The child lays out as if fully shown, so it keeps its size while the
+	// transition runs.
+	childConstraints := constraints
+	if r.axis == AxisHorizontal {
+		childConstraints.MinWidth = 0
+	} else {
+		childConstraints.MinHeight = 0
+	}
+	r.child.Layout(childConstraints, true)
+	child := r.child.Size()
+	size := child
+	offset := graphics.Offset{}
+	if r.axis == AxisHorizontal {
+		size.Width = child.Width * r.factor()
+		offset.X = size.Width - child.Width
+	} else {
+		size.Height = child.Height * r.factor()
+		offset.Y = size.Height - child.Height
+	}
+	size = constraints.Constrain(graphics.Size{Width: math.Ceil(size.Width), Height: math.Ceil(size.Height)})
+	r.SetSize(size)
+	r.child.SetParentData(&layout.BoxParentData{Offset: offset})
+}
+
+func (r *renderSizeTransition) Paint(ctx *layout.PaintContext) {
+	if r.child == nil {
+		return
+	}
+	size := r.Size()
+	if size.Width <= 0 || size.Height <= 0 {
+		return
+	}
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height))
+	ctx.PaintChild(r.child.(layout.RenderBox), getChildOffset(r.child.(layout.RenderBox)))
+	ctx.Canvas.Restore()
+}
+
+func (r *renderSizeTransition) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if r.child == nil || !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	offset := getChildOffset(r.child.(layout.RenderBox))
+	return r.child.HitTest(graphics.Offset{X: position.X - offset.X, Y: position.Y - offset.Y}, result)
+}
+
+func (r *renderSizeTransition) Dispose() {
+	if r.unsubscribe != nil {
+		r.unsubscribe()
+		r.unsubscribe = nil
+	}
+	r.renderPassthrough.Dispose()
+}
@@ -0,0 +1,379 @@
+package widgets
+
+import (
+	"math"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/gestures"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// Slider lets the user select a value from a continuous or discrete range by
+// dragging a thumb along a track.
+//
+// # Styling Model
+//
+// Slider is explicit by default — all visual properties use their struct field
+// values directly. A zero value means zero, not "use theme default." For example:
+//
+//   - ActiveColor: 0 means the filled part of the track is transparent
+//   - TrackHeight: 0 means no track is drawn
+//   - ThumbRadius: 0 means no thumb is drawn
+//
+// For theme-styled sliders, use [theme.SliderOf] which pre-fills visual
+// properties from the current theme's [theme.SliderThemeData].
+//
+// # Creation Patterns
+//
+// Struct literal (full control):
+//
+//	widgets.Slider{
+//	    Value:         volume,
+//	    Max:           1,
+//	    OnChanged:     func(v float64) { s.SetState(func() { s.volume = v }) },
+//	    ActiveColor:   graphics.RGB(33, 150, 243),
+//	    InactiveColor: graphics.RGB(200, 200, 200),
+//	    ThumbColor:    graphics.RGB(33, 150, 243),
+//	    TrackHeight:   4,
+//	    ThumbRadius:   10,
+//	    Height:        44,
+//	}
+//
+// Themed (reads from current theme):
+//
+//	theme.SliderOf(ctx, volume, onChanged)
+//
+// Slider is a controlled component - it displays the Value you provide and
+// calls OnChanged while the user drags or taps the track. Update Value in your
+// state in response to OnChanged.
+//
+// The slider expands to the maximum width allowed by its constraints.
+type Slider struct {
+	core.StatelessBase
+
+	// Value is the currently selected value, clamped to [Min, Max].
+	Value float64
+
+	// Min is the smallest selectable value.
+	Min float64
+
+	// Max is the largest selectable value. If Max <= Min the thumb stays at
+	// the start of the track.
+	Max float64
+
+	// Divisions splits the range into discrete steps. Zero means continuous.
+	Divisions int
+
+	// OnChanged is called with the new value as the user interacts.
+	OnChanged func(float64)
+
+	// Disabled disables interaction when true.
+	Disabled bool
+
+	// ActiveColor is the track color between Min and the thumb.
+	// Zero means transparent.
+	ActiveColor graphics.Color
+
+	// InactiveColor is the track color between the thumb and Max.
+	// Zero means transparent.
+	InactiveColor graphics.Color
+
+	// ThumbColor is the thumb fill color. Zero means transparent.
+	ThumbColor graphics.Color
+
+	// TrackHeight is the thickness of the track. Zero means no track.
+	TrackHeight float64
+
+	// ThumbRadius is the radius of the thumb. Zero means no thumb.
+	ThumbRadius float64
+
+	// Height is the height of the touch target. The slider is never shorter
+	// than the thumb diameter.
+	Height float64
+}
+
+// WithRange returns a copy of the slider with the specified bounds.
+func (s Slider) WithRange(minValue, maxValue float64) Slider {
+	s.Min = minValue
+	s.Max = maxValue
+	return s
+}
+
+// WithDivisions returns a copy of the slider with the specified number of
+// discrete steps.
+func (s Slider) WithDivisions(divisions int) Slider {
+	s.Divisions = divisions
+	return s
+}
+
+// WithColors returns a copy of the slider with the specified track and thumb
+// colors.
+func (s Slider) WithColors(active, inactive, thumb graphics.Color) Slider {
+	s.ActiveColor = active
+	s.InactiveColor = inactive
+	s.ThumbColor = thumb
+	return s
+}
+
+// WithTrackHeight returns a copy of the slider with the specified track
+// thickness.
+func (s Slider) WithTrackHeight(height float64) Slider {
+	s.TrackHeight = height
+	return s
+}
+
+// WithThumbRadius returns a copy of the slider with the specified thumb radius.
+func (s Slider) WithThumbRadius(radius float64) Slider {
+	s.ThumbRadius = radius
+	return s
+}
+
+func (s Slider) Build(ctx core.BuildContext) core.Widget {
+	enabled := !s.Disabled && s.OnChanged != nil
+
+	var result core.Widget = sliderRender{
+		value:         s.Value,
+		min:           s.Min,
+		max:           s.Max,
+		divisions:     s.Divisions,
+		onChanged:     s.OnChanged,
+		enabled:       enabled,
+		activeColor:   s.ActiveColor,
+		inactiveColor: s.InactiveColor,
+		thumbColor:    s.ThumbColor,
+		trackHeight:   s.TrackHeight,
+		thumbRadius:   s.ThumbRadius,
+		height:        s.Height,
+	}
+	if !enabled {
+		result = Opacity{Opacity: 0.5, Child: result}
+	}
+	return result
+}
+
+type sliderRender struct {
+	core.RenderObjectBase
+	value         float64
+	min           float64
+	max           float64
+	divisions     int
+	onChanged     func(float64)
+	enabled       bool
+	activeColor   graphics.Color
+	inactiveColor graphics.Color
+	thumbColor    graphics.Color
+	trackHeight   float64
+	thumbRadius   float64
+	height        float64
+}
+
+func (s sliderRender) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	r := &renderSlider{}
+	r.SetSelf(r)
+	r.update(s)
+	return r
+}
+
+func (s sliderRender) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if r, ok := renderObject.(*renderSlider); ok {
+		r.update(s)
+		r.MarkNeedsLayout()
+		r.MarkNeedsPaint()
+	}
+}
+
+type renderSlider struct {
+	layout.RenderBoxBase
+	value         float64
+	min           float64
+	max           float64
+	divisions     int
+	onChanged     func(float64)
+	enabled       bool
+	activeColor   graphics.Color
+	inactiveColor graphics.Color
+	thumbColor    graphics.Color
+	trackHeight   float64
+	thumbRadius   float64
+	height        float64
+	tap           *gestures.TapGestureRecognizer
+	drag          *gestures.HorizontalDragGestureRecognizer
+
+	// downLocalX and downGlobalX map global pointer positions to local track
+	// positions for the gesture in progress.
+	downLocalX  float64
+	downGlobalX float64
+}
+
+func (r *renderSlider) update(s sliderRender) {
+	r.value = s.value
+	r.min = s.min
+	r.max = s.max
+	r.divisions = s.divisions
+	r.onChanged = s.onChanged
+	r.enabled = s.enabled
+	r.activeColor = s.activeColor
+	r.inactiveColor = s.inactiveColor
+	r.thumbColor = s.thumbColor
+	r.trackHeight = s.trackHeight
+	r.thumbRadius = s.thumbRadius
+	r.height = s.height
+}
+
+// fraction returns the thumb position along the track, from 0 to 1.
+func (r *renderSlider) fraction() float64 {
+	if r.max <= r.min {
+		return 0
+	}
+	return min(max((r.value-r.min)/(r.max-r.min), 0), 1)
+}
+
+// valueAt returns the value for a local x position, snapped to divisions.
+func (r *renderSlider) valueAt(x float64) float64 {
+	width := r.Size().Width - 2*r.thumbRadius
+	if width <= 0 || r.max <= r.min {
+		return r.min
+	}
+	t := min(max((x-r.thumbRadius)/width, 0), 1)
+	if r.divisions > 0 {
+		t = math.Round(t*float64(r.divisions)) / float64(r.divisions)
+	}
+	return r.min + t*(r.max-r.min)
+}
+
+// step returns the amount accessibility increase and decrease actions move by.
+func (r *renderSlider) step() float64 {
+	if r.divisions > 0 {
+		return (r.max - r.min) / float64(r.divisions)
+	}
+	return (r.max - r.min) / 10
+}
+
+func (r *renderSlider) setValue(value float64) {
+	value = min(max(value, r.min), r.max)
+	if r.onChanged != nil && value != r.value {
+		r.onChanged(value)
+	}
+}
+
+func (r *renderSlider) PerformLayout() {
+	constraints := r.Constraints()
+	width := constraints.MaxWidth
+	if math.IsInf(width, 1) {
+		width = constraints.MinWidth
+	}
+	height := max(r.height, 2*r.thumbRadius)
+	height = min(max(height, constraints.MinHeight), constraints.MaxHeight)
+	r.SetSize(graphics.Size{Width: width, Height: height})
+}
+
+func (r *renderSlider) Paint(ctx *layout.PaintContext) {
+	size := r.Size()
+	centerY := size.Height / 2
+	start := r.thumbRadius
+	end := max(size.Width-r.thumbRadius, start)
+	thumbX := start + (end-start)*r.fraction()
+
+	if r.trackHeight > 0 {
+		radius := graphics.CircularRadius(r.trackHeight / 2)
+		top := centerY - r.trackHeight/2
+
+		inactive := graphics.DefaultPaint()
+		inactive.Color = r.inactiveColor
+		ctx.Canvas.DrawRRect(graphics.RRectFromRectAndRadius(
+			graphics.RectFromLTWH(start, top, end-start, r.trackHeight), radius), inactive)
+
+		if thumbX > start {
+			active := graphics.DefaultPaint()
+			active.Color = r.activeColor
+			ctx.Canvas.DrawRRect(graphics.RRectFromRectAndRadius(
+				graphics.RectFromLTWH(start, top, thumbX-start, r.trackHeight), radius), active)
+		}
+	}
+
+	if r.thumbRadius > 0 {
+		thumb := graphics.DefaultPaint()
+		thumb.Color = r.thumbColor
+		ctx.Canvas.DrawCircle(graphics.Offset{X: thumbX, Y: centerY}, r.thumbRadius, thumb)
+	}
+}
+
+func (r *renderSlider) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	r.downLocalX = position.X
+	result.Add(r)
+	return true
+}
+
+func (r *renderSlider) HandlePointer(event gestures.PointerEvent) {
+	if !r.enabled {
+		return
+	}
+	if r.tap == nil {
+		r.tap = gestures.NewTapGestureRecognizer(r.GestureArena())
+		r.tap.OnTap = func() {
+			r.setValue(r.valueAt(r.downLocalX))
+		}
+	}
+	if r.drag == nil {
+		r.drag = gestures.NewHorizontalDragGestureRecognizer(r.GestureArena())
+		r.drag.OnUpdate = func(details gestures.DragUpdateDetails) {
+			r.setValue(r.valueAt(r.downLocalX + details.Position.X - r.downGlobalX))
+		}
+	}
+	if event.Phase == gestures.PointerPhaseDown {
+		r.downGlobalX = event.Position.X
+		r.tap.AddPointer(event)
+		r.drag.AddPointer(event)
+	} else {
+		r.tap.HandleEvent(event)
+		r.drag.HandleEvent(event)
+	}
+}
+
+// Dispose releases the gesture recognizers.
+func (r *renderSlider) Dispose() {
+	if r.tap != nil {
+		r.tap.Dispose()
+		r.tap = nil
+	}
+	if r.drag != nil {
+		r.drag.Dispose()
+		r.drag = nil
+	}
+	r.RenderBoxBase.Dispose()
+}
+
+// DescribeSemanticsConfiguration implements SemanticsDescriber for accessibility.
+func (r *renderSlider) DescribeSemanticsConfiguration(config *semantics.SemanticsConfiguration) bool {
+	config.IsSemanticBoundary = true
+	config.Properties.Role = semantics.SemanticsRoleSlider
+
+	flags := semantics.SemanticsIsSlider | semantics.SemanticsHasEnabledState
+	if r.enabled {
+		flags = flags.Set(semantics.SemanticsIsEnabled)
+	}
+	config.Properties.Flags = flags
+
+	value, minValue, maxValue := min(max(r.value, r.min), max(r.max, r.min)), r.min, r.max
+	config.Properties.CurrentValue = &value
+	config.Properties.MinValue = &minValue
+	config.Properties.MaxValue = &maxValue
+
+	if r.enabled && r.onChanged != nil && r.max > r.min {
+		step := r.step()
+		config.Actions = semantics.NewSemanticsActions()
+		config.Actions.SetHandler(semantics.SemanticsActionIncrease, func(args any) {
+			r.setValue(r.value + step)
+		})
+		config.Actions.SetHandler(semantics.SemanticsActionDecrease, func(args any) {
+			r.setValue(r.value - step)
+		})
+	}
+
+	return true
+}
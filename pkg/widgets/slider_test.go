@@ -0,0 +1,77 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+// sliderAt lays out a 220px wide slider at the top-left corner, giving a
+// 200px track from x=10 to x=210.
+func sliderAt(s widgets.Slider) core.Widget {
+	s.ThumbRadius = 10
+	s.TrackHeight = 4
+	s.Height = 40
+	return widgets.Column{Children: []core.Widget{
+		widgets.SizedBox{Width: 220, Child: s},
+	}}
+}
+
+func TestSlider_TapSetsValue(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	var got float64
+	tester.PumpWidget(sliderAt(widgets.Slider{
+		Max:       100,
+		OnChanged: func(v float64) { got = v },
+	}))
+
+	if err := tester.TapAt(graphics.Offset{X: 160, Y: 20}); err != nil {
+		t.Fatalf("TapAt failed: %v", err)
+	}
+	if got != 75 {
+		t.Errorf("tap at 75%% of the track: got %v, want 75", got)
+	}
+}
+
+func TestSlider_DragSnapsToDivisions(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	var values []float64
+	tester.PumpWidget(sliderAt(widgets.Slider{
+		Max:       1,
+		Divisions: 4,
+		OnChanged: func(v float64) { values = append(values, v) },
+	}))
+
+	if err := tester.DragFrom(graphics.Offset{X: 10, Y: 20}, graphics.Offset{X: 95, Y: 0}); err != nil {
+		t.Fatalf("DragFrom failed: %v", err)
+	}
+	if len(values) == 0 {
+		t.Fatal("expected OnChanged during drag")
+	}
+	if last := values[len(values)-1]; last != 0.5 {
+		t.Errorf("drag to 47.5%% with 4 divisions: got %v, want 0.5", last)
+	}
+}
+
+func TestSlider_Disabled(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	called := false
+	tester.PumpWidget(sliderAt(widgets.Slider{
+		Max:       1,
+		Disabled:  true,
+		OnChanged: func(float64) { called = true },
+	}))
+
+	if err := tester.TapAt(graphics.Offset{X: 110, Y: 20}); err != nil {
+		t.Fatalf("TapAt failed: %v", err)
+	}
+	if called {
+		t.Error("disabled slider should not call OnChanged")
+	}
+}
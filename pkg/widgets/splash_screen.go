@@ -0,0 +1,121 @@
+package widgets
+
+import (
+	"time"
+
+	"github.com/go-drift/drift/pkg/animation"
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// DefaultSplashFadeDuration is the fade used by [SplashScreen] when
+// FadeDuration is zero.
+const DefaultSplashFadeDuration = 300 * time.Millisecond
+
+// SplashScreen draws a copy of the native launch screen over Child and fades
+// it out once Child is ready, so the app goes from launch screen to content
+// without a flash of empty or half-loaded UI.
+//
+// The engine hides the native splash as soon as the first frame is on
+// screen. With SplashScreen at the root, that frame already shows the same
+// background and logo, so the native splash disappears seamlessly and the
+// cross-fade to Child happens when Ready becomes true. Match Color and Logo
+// to the platform launch screen (launch_background.xml on Android,
+// LaunchScreen.storyboard on iOS).
+//
+//	widgets.SplashScreen{
+//	    Color: graphics.RGB(18, 18, 18),
+//	    Logo:  widgets.SvgPicture{Asset: "logo.svg", Width: 96},
+//	    Ready: s.loaded,
+//	    Child: home,
+//	}
+//
+// Child is built and laid out under the splash from the start, so it can
+// load while the splash is up. The splash absorbs pointer events until it
+// has faded out. SplashScreen fills the space it is given.
+type SplashScreen struct {
+	core.StatefulBase
+	// Color is the splash background.
+	Color graphics.Color
+	// Logo is drawn centered on the background. Optional.
+	Logo core.Widget
+	// Ready starts the fade to Child. Set it once Child has what it needs
+	// to draw its first screen, or leave it true to fade right after the
+	// first frame.
+	Ready bool
+	// FadeDuration is the length of the fade. Zero uses
+	// DefaultSplashFadeDuration.
+	FadeDuration time.Duration
+	// OnDone is called once the splash has faded out.
+	OnDone func()
+	// Child is the app content shown after the splash.
+	Child core.Widget
+}
+
+func (s SplashScreen) CreateState() core.State {
+	return &splashScreenState{}
+}
+
+type splashScreenState struct {
+	core.StateBase
+	controller *animation.AnimationController
+	done       bool
+}
+
+func (s *splashScreenState) InitState() {
+	w := s.Element().Widget().(SplashScreen)
+	s.controller = animation.NewAnimationController(splashFadeDuration(w))
+	s.controller.Curve = animation.EaseOut
+	core.UseDisposable(s, s.controller)
+	core.UseListenable(s, s.controller)
+	s.controller.AddStatusListener(func(status animation.AnimationStatus) {
+		if status != animation.AnimationCompleted {
+			return
+		}
+		s.SetState(func() { s.done = true })
+		if w := s.Element().Widget().(SplashScreen); w.OnDone != nil {
+			w.OnDone()
+		}
+	})
+	if w.Ready {
+		s.controller.Forward()
+	}
+}
+
+func (s *splashScreenState) DidUpdateWidget(oldWidget core.StatefulWidget) {
+	old := oldWidget.(SplashScreen)
+	w := s.Element().Widget().(SplashScreen)
+	s.controller.Duration = splashFadeDuration(w)
+	if w.Ready && !old.Ready && !s.done {
+		s.controller.Forward()
+	}
+}
+
+func (s *splashScreenState) Build(ctx core.BuildContext) core.Widget {
+	w := s.Element().Widget().(SplashScreen)
+	// Child stays the first entry, so it keeps its state when the splash
+	// is removed.
+	children := []core.Widget{w.Child}
+	if !s.done {
+		children = append(children, AbsorbPointer{
+			Absorbing: true,
+			Child: Opacity{
+				Opacity: 1 - s.controller.Value,
+				Child: Container{
+					Color:     w.Color,
+					Alignment: layout.AlignmentCenter,
+					Child:     w.Logo,
+				},
+			},
+		})
+	}
+	return Stack{Fit: StackFitExpand, Children: children}
+}
+
+func splashFadeDuration(w SplashScreen) time.Duration {
+	if w.FadeDuration > 0 {
+		return w.FadeDuration
+	}
+	return DefaultSplashFadeDuration
+}
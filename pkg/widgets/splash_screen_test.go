@@ -0,0 +1,49 @@
+package widgets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestSplashScreen_FadesOutWhenReady(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	done := 0
+	splash := func(ready bool) widgets.SplashScreen {
+		return widgets.SplashScreen{
+			Color:        graphics.RGB(18, 18, 18),
+			Ready:        ready,
+			FadeDuration: 100 * time.Millisecond,
+			OnDone:       func() { done++ },
+			Child:        widgets.Text{Content: "home"},
+		}
+	}
+
+	tester.PumpWidget(splash(false))
+	if !tester.Find(drifttest.ByText("home")).Exists() {
+		t.Fatal("expected the child to be built under the splash")
+	}
+	tester.Clock().Advance(time.Second)
+	tester.Pump()
+	if !tester.Find(drifttest.ByType[widgets.AbsorbPointer]()).Exists() {
+		t.Fatal("expected the splash to stay up until ready")
+	}
+
+	tester.PumpWidget(splash(true))
+	tester.Clock().Advance(200 * time.Millisecond)
+	if err := tester.PumpAndSettle(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if tester.Find(drifttest.ByType[widgets.AbsorbPointer]()).Exists() {
+		t.Error("expected the splash to be removed after fading out")
+	}
+	if done != 1 {
+		t.Errorf("expected OnDone once, got %d", done)
+	}
+	if !tester.Find(drifttest.ByText("home")).Exists() {
+		t.Error("expected the child to remain")
+	}
+}
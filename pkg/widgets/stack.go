@@ -61,6 +61,10 @@ type Stack struct {
 	// Alignment positions non-Positioned children within the stack.
 	// Defaults to top-left (AlignmentTopLeft).
 	Alignment layout.Alignment
+	// AlignmentDirectional, when set, replaces Alignment with one whose
+	// horizontal position follows the [Directionality], such as
+	// layout.AlignmentTopStart.
+	AlignmentDirectional *layout.AlignmentDirectional
 	// Fit controls how children are sized.
 	Fit StackFit
 }
@@ -80,7 +84,7 @@ func (s Stack) ChildrenWidgets() []core.Widget {
 // CreateRenderObject creates the RenderStack.
 func (s Stack) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 	stack := &renderStack{
-		alignment: s.Alignment,
+		alignment: resolveStackAlignment(ctx, s.Alignment, s.AlignmentDirectional),
 		fit:       s.Fit,
 	}
 	stack.SetSelf(stack)
@@ -90,7 +94,7 @@ func (s Stack) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 // UpdateRenderObject updates the RenderStack.
 func (s Stack) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if stack, ok := renderObject.(*renderStack); ok {
-		stack.alignment = s.Alignment
+		stack.alignment = resolveStackAlignment(ctx, s.Alignment, s.AlignmentDirectional)
 		stack.fit = s.Fit
 		stack.MarkNeedsLayout()
 	}
@@ -154,6 +158,16 @@ func (r *renderStack) HitTest(position graphics.Offset, result *layout.HitTestRe
 	return true
 }
 
+// resolveStackAlignment returns directional resolved against the
+// [Directionality] when set, or alignment otherwise. Stacks without a
+// directional alignment don't depend on the direction.
+func resolveStackAlignment(ctx core.BuildContext, alignment layout.Alignment, directional *layout.AlignmentDirectional) layout.Alignment {
+	if directional == nil {
+		return alignment
+	}
+	return directional.Resolve(DirectionalityOf(ctx))
+}
+
 // layoutStackChildren performs the common layout logic for stack-based widgets.
 // It lays out children according to the fit mode and positions them using alignment.
 // Positioned children contribute to stack sizing and use alignment for unset axes.
@@ -358,6 +372,7 @@ func layoutPositionedChild(pos *renderPositioned, stackSize graphics.Size, stack
 			maxWidth = w
 		} else {
 			// Keep current width from first pass
+			minWidth = childSize.Width
 			maxWidth = childSize.Width
 		}
 
@@ -371,6 +386,7 @@ func layoutPositionedChild(pos *renderPositioned, stackSize graphics.Size, stack
 			maxHeight = h
 		} else {
 			// Keep current height from first pass
+			minHeight = childSize.Height
 			maxHeight = childSize.Height
 		}
 
@@ -485,8 +501,10 @@ type IndexedStack struct {
 	core.RenderObjectBase
 	Children  []core.Widget
 	Alignment layout.Alignment
-	Fit       StackFit
-	Index     int
+	// AlignmentDirectional, when set, replaces Alignment as in [Stack].
+	AlignmentDirectional *layout.AlignmentDirectional
+	Fit                  StackFit
+	Index                int
 }
 
 func (s IndexedStack) ChildrenWidgets() []core.Widget {
@@ -495,7 +513,7 @@ func (s IndexedStack) ChildrenWidgets() []core.Widget {
 
 func (s IndexedStack) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
 	stack := &renderIndexedStack{
-		alignment: s.Alignment,
+		alignment: resolveStackAlignment(ctx, s.Alignment, s.AlignmentDirectional),
 		fit:       s.Fit,
 		index:     s.Index,
 	}
@@ -505,7 +523,7 @@ func (s IndexedStack) CreateRenderObject(ctx core.BuildContext) layout.RenderObj
 
 func (s IndexedStack) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if stack, ok := renderObject.(*renderIndexedStack); ok {
-		stack.alignment = s.Alignment
+		stack.alignment = resolveStackAlignment(ctx, s.Alignment, s.AlignmentDirectional)
 		stack.fit = s.Fit
 		stack.index = s.Index
 		stack.MarkNeedsLayout()
@@ -623,6 +641,8 @@ func (r *renderIndexedStack) HitTest(position graphics.Offset, result *layout.Hi
 //
 // When both Left and Right are set (or Top and Bottom), the child stretches
 // to fill that dimension. Width/Height override the stretching behavior.
+// Start and End are Left and Right resolved against the [Directionality],
+// for layouts that mirror in right-to-left languages.
 //
 // For axes where no position is set, the child uses the Stack's Alignment.
 type positioned struct {
@@ -633,6 +653,8 @@ type positioned struct {
 	top       *float64
 	right     *float64
 	bottom    *float64
+	start     *float64
+	end       *float64
 	width     *float64
 	height    *float64
 }
@@ -670,6 +692,22 @@ func (p positioned) Bottom(v float64) positioned {
 	return p
 }
 
+// Start sets the distance from the Stack's start edge: the left edge, or
+// the right edge under a right-to-left [Directionality]. It takes
+// precedence over Left or Right for that edge.
+func (p positioned) Start(v float64) positioned {
+	p.start = &v
+	return p
+}
+
+// End sets the distance from the Stack's end edge: the right edge, or the
+// left edge under a right-to-left [Directionality]. It takes precedence
+// over Left or Right for that edge.
+func (p positioned) End(v float64) positioned {
+	p.end = &v
+	return p
+}
+
 // Width overrides the child's width.
 func (p positioned) Width(v float64) positioned {
 	p.width = &v
@@ -723,13 +761,34 @@ func (p positioned) ChildWidget() core.Widget {
 	return p.child
 }
 
+// horizontal returns the left and right offsets, with Start and End
+// resolved against the [Directionality].
+func (p positioned) horizontal(ctx core.BuildContext) (left, right *float64) {
+	left, right = p.left, p.right
+	if p.start == nil && p.end == nil {
+		return left, right
+	}
+	start, end := p.start, p.end
+	if DirectionalityOf(ctx) == graphics.TextDirectionRTL {
+		start, end = end, start
+	}
+	if start != nil {
+		left = start
+	}
+	if end != nil {
+		right = end
+	}
+	return left, right
+}
+
 // CreateRenderObject creates the renderPositioned.
 func (p positioned) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	left, right := p.horizontal(ctx)
 	pos := &renderPositioned{
 		alignment: p.alignment,
-		left:      p.left,
+		left:      left,
 		top:       p.top,
-		right:     p.right,
+		right:     right,
 		bottom:    p.bottom,
 		width:     p.width,
 		height:    p.height,
@@ -742,9 +801,8 @@ func (p positioned) CreateRenderObject(ctx core.BuildContext) layout.RenderObjec
 func (p positioned) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
 	if pos, ok := renderObject.(*renderPositioned); ok {
 		pos.alignment = p.alignment
-		pos.left = p.left
+		pos.left, pos.right = p.horizontal(ctx)
 		pos.top = p.top
-		pos.right = p.right
 		pos.bottom = p.bottom
 		pos.width = p.width
 		pos.height = p.height
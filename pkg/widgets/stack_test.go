@@ -84,3 +84,46 @@ func TestIndexedStack_ExpandPositionsActiveChild(t *testing.T) {
 }
 
 func ptrF64(v float64) *float64 { return &v }
+
+// testMinSizeBox takes the smallest size its constraints allow, like a
+// GestureDetector without a child.
+type testMinSizeBox struct {
+	layout.RenderBoxBase
+}
+
+func (b *testMinSizeBox) PerformLayout() {
+	b.SetSize(b.Constraints().Constrain(graphics.Size{}))
+}
+
+func (b *testMinSizeBox) Paint(ctx *layout.PaintContext) {}
+
+func (b *testMinSizeBox) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	return false
+}
+
+func TestStack_PositionedKeepsWidthWhenStretchingHeight(t *testing.T) {
+	child := &testMinSizeBox{}
+	child.SetSelf(child)
+
+	pos := &renderPositioned{
+		child:  child,
+		left:   ptrF64(0),
+		top:    ptrF64(0),
+		bottom: ptrF64(0),
+		width:  ptrF64(20),
+	}
+	pos.SetSelf(pos)
+
+	stack := &renderStack{
+		alignment: layout.AlignmentTopLeft,
+		fit:       StackFitExpand,
+	}
+	stack.SetSelf(stack)
+	stack.SetChildren([]layout.RenderObject{pos})
+
+	stack.Layout(layout.Tight(graphics.Size{Width: 100, Height: 80}), true)
+
+	if size := child.Size(); size.Width != 20 || size.Height != 80 {
+		t.Fatalf("expected 20x80 strip, got %.1fx%.1f", size.Width, size.Height)
+	}
+}
@@ -19,3 +19,17 @@ func (r *renderSvgImage) DescribeSemanticsConfiguration(config *semantics.Semant
 	// Note: source field is not used in semantics, only semanticLabel matters
 	return true
 }
+
+func (r *renderSvgPicture) DescribeSemanticsConfiguration(config *semantics.SemanticsConfiguration) bool {
+	if r.excludeFromSemantics {
+		return false
+	}
+
+	config.Properties.Role = semantics.SemanticsRoleImage
+	config.Properties.Flags = config.Properties.Flags.Set(semantics.SemanticsIsImage)
+
+	if r.semanticLabel != "" {
+		config.Properties.Label = r.semanticLabel
+	}
+	return true
+}
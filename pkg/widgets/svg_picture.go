@@ -0,0 +1,260 @@
+package widgets
+
+import (
+	"bytes"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/svg"
+)
+
+// svgAssets holds SVG documents parsed for SvgPicture.Asset. Evicted
+// documents are destroyed.
+var svgAssets = newAssetCache(DefaultAssetCacheLimit, svg.LoadBytes, (*svg.Icon).Destroy)
+
+// SvgPicture renders an SVG loaded from an asset or from bytes, without
+// managing an [svg.Icon] by hand.
+//
+// Asset names are resolved through the [AssetBundle] from [AssetBundleOf].
+// Parsed documents are shared by every SvgPicture showing the same asset
+// and kept in a cache once no longer shown, so rebuilding or scrolling back
+// to a picture does not parse it again. Documents evicted from the cache are
+// destroyed; see [SetAssetCacheLimit]. A document parsed from Bytes belongs
+// to the widget and is destroyed when the widget is removed.
+//
+// # Creation Pattern
+//
+// Use struct literal:
+//
+//	widgets.SvgPicture{
+//	    Asset:         "icons/logo.svg",
+//	    Width:         120,
+//	    Tint:          colors.Primary,
+//	    SemanticLabel: "Logo",
+//	}
+//
+// # Sizing Behavior
+//
+// Sizing follows [SvgImage]: with Width and/or Height the missing dimension
+// follows the viewBox aspect ratio, and with neither the viewBox size is
+// used. Fit then places the drawing within that size the way it places
+// bitmaps in [Image]. Fit is applied by scaling the canvas, so it does not
+// change the SVG's own preserveAspectRatio and pictures sharing a document
+// can use different fits.
+//
+// Load and parse errors are reported through the errors package, and the
+// picture takes the size it would have without a source.
+type SvgPicture struct {
+	core.RenderObjectBase
+	// Asset is the name of the SVG in the asset bundle.
+	Asset string
+	// Bytes is SVG data to parse, used when Asset is empty. Pass a new slice
+	// rather than modifying it in place to change the picture.
+	Bytes []byte
+	// Width is the desired width. If zero and Height is set, calculated from aspect ratio.
+	// If both zero, uses the SVG's intrinsic viewBox width.
+	Width float64
+	// Height is the desired height. If zero and Width is set, calculated from aspect ratio.
+	// If both zero, uses the SVG's intrinsic viewBox height.
+	Height float64
+	// Fit controls how the drawing is scaled within its bounds. The zero
+	// value, ImageFitContain, matches SvgImage.
+	Fit ImageFit
+	// Alignment positions the drawing within its bounds. The zero value
+	// centers it.
+	Alignment layout.Alignment
+	// Tint replaces all SVG colors with this color while preserving alpha,
+	// which suits single-color icons. Zero keeps the original colors.
+	Tint graphics.Color
+	// SemanticLabel provides an accessibility description.
+	SemanticLabel string
+	// ExcludeFromSemantics excludes from the semantics tree when true.
+	ExcludeFromSemantics bool
+}
+
+func (s SvgPicture) Child() core.Widget {
+	return nil
+}
+
+func (s SvgPicture) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	box := &renderSvgPicture{asset: assetRef[*svg.Icon]{cache: svgAssets}}
+	box.SetSelf(box)
+	box.update(s, AssetBundleOf(ctx))
+	return box
+}
+
+func (s SvgPicture) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if box, ok := renderObject.(*renderSvgPicture); ok {
+		box.update(s, AssetBundleOf(ctx))
+	}
+}
+
+type renderSvgPicture struct {
+	layout.RenderBoxBase
+	asset                assetRef[*svg.Icon]
+	data                 []byte
+	owned                *svg.Icon
+	width                float64
+	height               float64
+	fit                  ImageFit
+	alignment            layout.Alignment
+	tint                 graphics.Color
+	semanticLabel        string
+	excludeFromSemantics bool
+}
+
+// update applies the widget's configuration, loading a new document if
+// the source changed.
+func (r *renderSvgPicture) update(s SvgPicture, bundle AssetBundle) {
+	old := r.source()
+
+	key := assetKey{name: s.Asset}
+	if s.Asset != "" {
+		key.bundle = bundle
+	}
+	if key != r.asset.key || (s.Asset == "" && !bytes.Equal(s.Bytes, r.data)) {
+		// Drop the recorded drawing before releasing the document it draws.
+		if layer := r.Layer(); layer != nil {
+			layer.Dispose()
+		}
+		if _, err := r.asset.set(key); err != nil {
+			reportAssetError("widgets.SvgPicture", s.Asset, err)
+		}
+		r.setBytes(s.Bytes, s.Asset == "")
+	}
+
+	layoutChanged := r.source() != old || r.width != s.Width || r.height != s.Height
+	paintChanged := layoutChanged || r.fit != s.Fit || r.alignment != s.Alignment || r.tint != s.Tint
+	semanticsChanged := r.semanticLabel != s.SemanticLabel || r.excludeFromSemantics != s.ExcludeFromSemantics
+
+	r.width = s.Width
+	r.height = s.Height
+	r.fit = s.Fit
+	r.alignment = s.Alignment
+	r.tint = s.Tint
+	r.semanticLabel = s.SemanticLabel
+	r.excludeFromSemantics = s.ExcludeFromSemantics
+
+	if layoutChanged {
+		r.MarkNeedsLayout()
+	}
+	if paintChanged {
+		r.MarkNeedsPaint()
+	}
+	if semanticsChanged {
+		r.MarkNeedsSemanticsUpdate()
+	}
+}
+
+// setBytes replaces the document owned by the picture, parsing data if use
+// is set.
+func (r *renderSvgPicture) setBytes(data []byte, use bool) {
+	if r.owned != nil {
+		r.owned.Destroy()
+		r.owned = nil
+	}
+	r.data = nil
+	if !use || len(data) == 0 {
+		return
+	}
+	r.data = data
+	icon, err := svg.LoadBytes(data)
+	if err != nil {
+		reportAssetError("widgets.SvgPicture", "", err)
+		return
+	}
+	r.owned = icon
+}
+
+// source returns the document to draw, or nil.
+func (r *renderSvgPicture) source() *svg.Icon {
+	if r.asset.held {
+		return r.asset.value
+	}
+	return r.owned
+}
+
+// IsRepaintBoundary isolates SVG repaints into their own layer.
+func (r *renderSvgPicture) IsRepaintBoundary() bool {
+	return true
+}
+
+func (r *renderSvgPicture) SetChild(child layout.RenderObject) {
+	// SvgPicture has no children
+}
+
+func (r *renderSvgPicture) PerformLayout() {
+	size := graphics.Size{Width: 24, Height: 24}
+	if icon := r.source(); icon != nil {
+		size = svgSize(icon.ViewBox(), r.width, r.height)
+	} else if r.width > 0 || r.height > 0 {
+		size = graphics.Size{Width: r.width, Height: r.height}
+	}
+	r.SetSize(r.Constraints().Constrain(size))
+}
+
+// svgSize returns the size of an SVG with the given viewBox when laid out
+// with the requested width and height, either of which may be zero.
+func svgSize(viewBox graphics.Rect, width, height float64) graphics.Size {
+	aspectRatio := 1.0
+	if viewBox.Height() > 0 {
+		aspectRatio = viewBox.Width() / viewBox.Height()
+	}
+	switch {
+	case width > 0 && height > 0:
+		return graphics.Size{Width: width, Height: height}
+	case width > 0:
+		return graphics.Size{Width: width, Height: width / aspectRatio}
+	case height > 0:
+		return graphics.Size{Width: height * aspectRatio, Height: height}
+	default:
+		return graphics.Size{Width: viewBox.Width(), Height: viewBox.Height()}
+	}
+}
+
+func (r *renderSvgPicture) Paint(ctx *layout.PaintContext) {
+	icon := r.source()
+	if icon == nil {
+		return
+	}
+	size := r.Size()
+	vb := icon.ViewBox()
+	intrinsic := graphics.Size{Width: vb.Width(), Height: vb.Height()}
+	if size.Width <= 0 || size.Height <= 0 || intrinsic.Width <= 0 || intrinsic.Height <= 0 {
+		return
+	}
+	alignment := r.alignment
+	if alignment == (layout.Alignment{}) {
+		alignment = layout.AlignmentCenter
+	}
+	src, dst := imageFitRects(r.fit, alignment, intrinsic, size)
+	if src.IsEmpty() || dst.IsEmpty() {
+		return
+	}
+
+	// Map the visible part of the viewBox onto dst and draw the whole
+	// drawing at its intrinsic size, clipped to the picture.
+	sx, sy := dst.Width()/src.Width(), dst.Height()/src.Height()
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height))
+	ctx.Canvas.Translate(dst.Left-src.Left*sx, dst.Top-src.Top*sy)
+	ctx.Canvas.Scale(sx, sy)
+	icon.Draw(ctx.Canvas, graphics.RectFromLTWH(0, 0, intrinsic.Width, intrinsic.Height), r.tint)
+	ctx.Canvas.Restore()
+}
+
+func (r *renderSvgPicture) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
+	if !layout.WithinBounds(position, r.Size()) {
+		return false
+	}
+	result.Add(r)
+	return true
+}
+
+// Dispose releases the document along with the layer.
+func (r *renderSvgPicture) Dispose() {
+	r.RenderBoxBase.Dispose()
+	r.asset.release()
+	r.setBytes(nil, false)
+}
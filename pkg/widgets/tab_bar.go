@@ -13,6 +13,12 @@ import (
 type TabItem struct {
 	Label string
 	Icon  core.Widget
+	// SelectedIcon replaces Icon while the tab is selected, such as a filled
+	// version of an outlined icon. Defaults to Icon.
+	SelectedIcon core.Widget
+	// Badge marks the icon with a short label, such as an unread count.
+	// Shown by [NavigationBar] and [NavigationRail]; empty shows no badge.
+	Badge string
 }
 
 // TabBar displays a row of tabs.
@@ -117,6 +123,9 @@ func (t TabBar) buildTabItem(index int, item TabItem, active, inactive, indicato
 	itemLabelStyle.Color = color
 
 	iconWidget := item.Icon
+	if isActive && item.SelectedIcon != nil {
+		iconWidget = item.SelectedIcon
+	}
 	if icon, ok := iconWidget.(Icon); ok {
 		icon.Color = color
 		iconWidget = icon
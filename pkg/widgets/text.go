@@ -1,9 +1,13 @@
 package widgets
 
 import (
+	"math"
+	"slices"
+
 	"github.com/go-drift/drift/pkg/core"
 	"github.com/go-drift/drift/pkg/graphics"
 	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
 )
 
 // Text displays a string with a single style.
@@ -58,6 +62,47 @@ import (
 //
 //	// Centered wrapping text
 //	Text{Content: longText, Align: graphics.TextAlignCenter}
+//
+// # Overflow
+//
+// Overflow controls how text that does not fit is presented: clipped,
+// ended with an ellipsis, shortened in the middle, or faded out. Use
+// OnOverflowChanged to show a "show more" action only when text was
+// actually cut off:
+//
+//	Text{
+//	    Content:  description,
+//	    MaxLines: 3,
+//	    Overflow: graphics.TextOverflowEllipsis,
+//	    OnOverflowChanged: func(overflowed bool) {
+//	        s.SetState(func() { s.canExpand = overflowed })
+//	    },
+//	}
+//
+// # Hyphenation and Line Breaking
+//
+// Hyphenate lets long words break across lines with a hyphen, and LineBreak
+// selects strict, normal, or loose CJK line breaking. Both follow Locale,
+// which defaults to [graphics.DefaultLocale]:
+//
+//	Text{Content: article, Hyphenate: true, Locale: "de"}
+//
+// # Highlights
+//
+// Highlights colors ranges of the content, such as search matches:
+//
+//	search := graphics.SearchHighlighter{Query: query, BackgroundColor: colors.TertiaryContainer}
+//	Text{Content: title, Highlights: search.Highlights(title)}
+//
+// Background-only highlights are cheap to change on every keystroke; set
+// FontWeight or Decoration on the highlighter to embolden or underline
+// matches, which shapes the text again when the matches change.
+//
+// # Text Scaling
+//
+// Font sizes are multiplied by [TextScaleFactorOf], which follows the user's
+// system font size setting. Use [MediaQueryOverride] to bound or disable
+// scaling for a subtree.
 type Text struct {
 	core.RenderObjectBase
 	// Content is the text string to display.
@@ -65,7 +110,8 @@ type Text struct {
 	// Style controls the font, size, color, and other text properties.
 	Style graphics.TextStyle
 	// Align controls paragraph-level horizontal text alignment.
-	// Zero value is left-aligned. Only takes effect when text wraps;
+	// Zero value is left-aligned; TextAlignStart and TextAlignEnd follow the
+	// enclosing [Directionality]. Only takes effect when text wraps;
 	// unwrapped text has no paragraph width to align within.
 	Align graphics.TextAlign
 	// MaxLines limits the number of visible lines (0 = unlimited).
@@ -75,6 +121,37 @@ type Text struct {
 	// ([graphics.TextWrapWrap]) wraps text at the constraint width.
 	// Set to [graphics.TextWrapNoWrap] for single-line text.
 	Wrap graphics.TextWrap
+	// Overflow controls how text that does not fit is presented. The zero
+	// value ([graphics.TextOverflowVisible]) drops lines past MaxLines and
+	// lets unwrapped text paint beyond the widget bounds. Ellipsis modes on
+	// unwrapped text shorten it to a single line that fits the constraints.
+	// Fade fades out the end of the last visible line, which is its left
+	// edge for right-to-left text.
+	Overflow graphics.TextOverflow
+	// OnOverflowChanged is called after layout when the text starts or stops
+	// overflowing: lines were dropped by MaxLines, an ellipsis was applied,
+	// or the text is wider or taller than its constraints. It is not called
+	// while the text fits. The callback runs on the UI thread after the
+	// frame, so it may call SetState.
+	OnOverflowChanged func(overflowed bool)
+	// Locale is the BCP 47 language tag of the content, used to pick the
+	// hyphenator and automatic line break strictness. Empty uses
+	// [graphics.DefaultLocale].
+	Locale string
+	// Hyphenate allows long words to break across lines with a hyphen.
+	// Only applies when the text wraps.
+	Hyphenate bool
+	// LineBreak controls how strictly CJK line breaking rules are applied.
+	// The zero value ([graphics.LineBreakAuto]) picks strict rules for
+	// Japanese and normal rules otherwise.
+	LineBreak graphics.LineBreakStrictness
+	// Highlights draws ranges of Content with their own background color,
+	// text color, weight, or decoration, for example search matches from
+	// [graphics.SearchHighlighter]. Highlights that only set BackgroundColor
+	// are painted behind the text without laying it out again. Once any
+	// highlight restyles its range, the text does not draw Style.Gradient or
+	// Style.Shadow, and ellipsis overflow modes fall back to dropping lines.
+	Highlights []graphics.TextHighlight
 }
 
 // WithWrap returns a copy of the text with the specified wrap mode.
@@ -95,6 +172,12 @@ func (t Text) WithMaxLines(maxLines int) Text {
 	return t
 }
 
+// WithOverflow returns a copy of the text with the specified overflow mode.
+func (t Text) WithOverflow(overflow graphics.TextOverflow) Text {
+	t.Overflow = overflow
+	return t
+}
+
 // WithAlign returns a copy of the text with the specified alignment.
 // Alignment only takes effect when text wraps. See [graphics.TextAlign]
 // for the available alignment options.
@@ -104,7 +187,21 @@ func (t Text) WithAlign(align graphics.TextAlign) Text {
 }
 
 func (t Text) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
-	text := &renderText{text: t.Content, style: t.Style, align: t.Align, maxLines: t.MaxLines, wrapMode: t.Wrap}
+	text := &renderText{
+		text:              t.Content,
+		style:             t.Style,
+		align:             t.Align.Resolve(DirectionalityOf(ctx)),
+		direction:         DirectionalityOf(ctx),
+		maxLines:          t.MaxLines,
+		wrapMode:          t.Wrap,
+		overflow:          t.Overflow,
+		onOverflowChanged: t.OnOverflowChanged,
+		locale:            t.Locale,
+		hyphenate:         t.Hyphenate,
+		lineBreak:         t.LineBreak,
+		highlights:        t.Highlights,
+		textScale:         TextScaleFactorOf(ctx),
+	}
 	text.SetSelf(text)
 	return text
 }
@@ -113,9 +210,17 @@ func (t Text) UpdateRenderObject(ctx core.BuildContext, renderObject layout.Rend
 	if text, ok := renderObject.(*renderText); ok {
 		text.text = t.Content
 		text.style = t.Style
-		text.align = t.Align
+		text.align = t.Align.Resolve(DirectionalityOf(ctx))
+		text.direction = DirectionalityOf(ctx)
 		text.maxLines = t.MaxLines
 		text.wrapMode = t.Wrap
+		text.overflow = t.Overflow
+		text.onOverflowChanged = t.OnOverflowChanged
+		text.locale = t.Locale
+		text.hyphenate = t.Hyphenate
+		text.lineBreak = t.LineBreak
+		text.highlights = t.Highlights
+		text.textScale = TextScaleFactorOf(ctx)
 		text.MarkNeedsLayout()
 		text.MarkNeedsPaint()
 	}
@@ -123,22 +228,43 @@ func (t Text) UpdateRenderObject(ctx core.BuildContext, renderObject layout.Rend
 
 type renderText struct {
 	layout.RenderBoxBase
-	text     string
-	style    graphics.TextStyle
-	align    graphics.TextAlign
-	layout   *graphics.TextLayout
-	maxLines int
-	wrapMode graphics.TextWrap
-	cache    textLayoutCache
+	text              string
+	style             graphics.TextStyle
+	align             graphics.TextAlign
+	direction         graphics.TextDirection // where the trailing edge fades
+	layout            *graphics.TextLayout
+	maxLines          int
+	wrapMode          graphics.TextWrap
+	overflow          graphics.TextOverflow
+	onOverflowChanged func(overflowed bool)
+	overflowed        bool // last overflow state reported to onOverflowChanged
+	locale            string
+	hyphenate         bool
+	lineBreak         graphics.LineBreakStrictness
+	highlights        []graphics.TextHighlight
+	textScale         float64
+	cache             textLayoutCache
+	cachedHighlights  []graphics.TextHighlight
+	selection         textSelection // set by an enclosing SelectionArea
 }
 
 type textLayoutCache struct {
-	text     string
-	style    graphics.TextStyle
-	align    graphics.TextAlign
-	maxWidth float64
-	maxLines int
-	wrapMode graphics.TextWrap
+	text      string
+	style     graphics.TextStyle
+	align     graphics.TextAlign
+	maxWidth  float64
+	maxLines  int
+	wrapMode  graphics.TextWrap
+	overflow  graphics.TextOverflow
+	locale    string
+	hyphenate bool
+	lineBreak graphics.LineBreakStrictness
+	textScale float64
+}
+
+// isEllipsis reports whether the overflow mode shortens text during layout.
+func isEllipsis(overflow graphics.TextOverflow) bool {
+	return overflow == graphics.TextOverflowEllipsis || overflow == graphics.TextOverflowMiddleEllipsis
 }
 
 // textLayoutSize returns the widget size for a laid-out paragraph. When text
@@ -147,10 +273,9 @@ type textLayoutCache struct {
 // rendered text positions.
 func textLayoutSize(layoutSize graphics.Size, align graphics.TextAlign, maxWidth float64) graphics.Size {
 	switch align {
-	case graphics.TextAlignLeft, graphics.TextAlignStart:
-		// Left-flush alignments: use the tight (longest-line) width.
-		// TextAlignStart resolves to left in LTR; if RTL support is added,
-		// this case will need to check the text direction.
+	case graphics.TextAlignLeft:
+		// Left-flush alignment: use the tight (longest-line) width. Start
+		// and End were resolved against the text direction by the widget.
 	default:
 		if maxWidth > 0 {
 			layoutSize.Width = maxWidth
@@ -162,22 +287,42 @@ func textLayoutSize(layoutSize graphics.Size, align graphics.TextAlign, maxWidth
 func (r *renderText) PerformLayout() {
 	constraints := r.Constraints()
 	maxWidth := constraints.MaxWidth // Default: wrap
+	maxLines := r.maxLines
 	if r.wrapMode == graphics.TextWrapNoWrap {
 		maxWidth = 0
+		// Ellipsizing unwrapped text needs a width to measure against;
+		// lay it out as a single line bounded by the constraints.
+		if isEllipsis(r.overflow) && !math.IsInf(constraints.MaxWidth, 0) {
+			maxWidth = constraints.MaxWidth
+			maxLines = 1
+		}
 	}
 	current := textLayoutCache{
-		text:     r.text,
-		style:    r.style,
-		align:    r.align,
-		maxWidth: maxWidth,
-		maxLines: r.maxLines,
-		wrapMode: r.wrapMode,
-	}
-	if r.layout != nil && r.cache == current {
+		text:      r.text,
+		style:     r.style,
+		align:     r.align,
+		maxWidth:  maxWidth,
+		maxLines:  maxLines,
+		wrapMode:  r.wrapMode,
+		overflow:  r.overflow,
+		locale:    r.locale,
+		hyphenate: r.hyphenate,
+		lineBreak: r.lineBreak,
+		textScale: r.textScale,
+	}
+	// Background-only highlights are painted behind the plain paragraph, so
+	// they don't take part in layout.
+	var highlights []graphics.TextHighlight
+	if graphics.HighlightsRestyle(r.highlights) {
+		highlights = r.highlights
+	}
+	if r.layout != nil && r.cache == current && slices.Equal(r.cachedHighlights, highlights) {
 		r.SetSize(constraints.Constrain(textLayoutSize(r.layout.Size, r.align, maxWidth)))
+		r.updateOverflow(constraints)
 		return
 	}
 	r.cache = current
+	r.cachedHighlights = slices.Clone(highlights)
 
 	manager, _ := graphics.DefaultFontManagerErr()
 	if manager == nil {
@@ -187,11 +332,23 @@ func (r *renderText) PerformLayout() {
 		return
 	}
 
-	layout, err := graphics.LayoutTextWithOptions(r.text, r.style, manager, graphics.ParagraphOptions{
+	opts := graphics.ParagraphOptions{
 		MaxWidth:  maxWidth,
-		MaxLines:  r.maxLines,
+		MaxLines:  maxLines,
 		TextAlign: r.align,
-	})
+		Overflow:  r.overflow,
+		Locale:    r.locale,
+		Hyphenate: r.hyphenate,
+		LineBreak: r.lineBreak,
+		TextScale: r.textScale,
+	}
+	var layout *graphics.TextLayout
+	var err error
+	if len(highlights) > 0 && r.text != "" {
+		layout, err = graphics.LayoutHighlightedText(r.text, r.style, highlights, manager, opts)
+	} else {
+		layout, err = graphics.LayoutTextWithOptions(r.text, r.style, manager, opts)
+	}
 	if err != nil {
 		r.layout = nil
 		r.SetSize(constraints.Constrain(graphics.Size{}))
@@ -200,12 +357,72 @@ func (r *renderText) PerformLayout() {
 
 	r.layout = layout
 	r.SetSize(constraints.Constrain(textLayoutSize(layout.Size, r.align, maxWidth)))
+	r.updateOverflow(constraints)
+}
+
+// DeviceScaleChanged drops the cached paragraph so the next layout shapes
+// the text for the new scale.
+func (r *renderText) DeviceScaleChanged(float64) {
+	r.layout = nil
+}
+
+// textOverflows reports whether the laid-out text does not fit: either the
+// paragraph was truncated or it is larger than the constraints allow.
+func (r *renderText) textOverflows(constraints layout.Constraints) bool {
+	if r.layout == nil {
+		return false
+	}
+	const epsilon = 0.5 // tolerate sub-pixel rounding in paragraph metrics
+	return r.layout.Truncated ||
+		r.layout.Size.Width > constraints.MaxWidth+epsilon ||
+		r.layout.Size.Height > constraints.MaxHeight+epsilon
+}
+
+// updateOverflow notifies onOverflowChanged after the frame when the
+// overflow state differs from the last reported value.
+func (r *renderText) updateOverflow(constraints layout.Constraints) {
+	overflowed := r.textOverflows(constraints)
+	if overflowed == r.overflowed {
+		return
+	}
+	r.overflowed = overflowed
+	if callback := r.onOverflowChanged; callback != nil {
+		platform.Dispatch(func() { callback(overflowed) })
+	}
 }
 
 func (r *renderText) Paint(ctx *layout.PaintContext) {
 	if r.layout == nil {
 		return
 	}
+	if len(r.cachedHighlights) == 0 {
+		paintHighlightBackgrounds(ctx.Canvas, r.layout, r.highlights)
+	}
+	var selected []graphics.Rect
+	if r.selection.start < r.selection.end {
+		selected = r.layout.RectsForRange(r.selection.start, r.selection.end)
+		paintSelectionHighlight(ctx.Canvas, selected, r.selection)
+	}
+	r.paintText(ctx)
+	paintSelectionHandles(ctx.Canvas, selected, r.selection)
+}
+
+// paintHighlightBackgrounds fills behind the ranges of highlights that were
+// not shaped into textLayout.
+func paintHighlightBackgrounds(canvas graphics.Canvas, textLayout *graphics.TextLayout, highlights []graphics.TextHighlight) {
+	paint := graphics.DefaultPaint()
+	for _, h := range highlights {
+		if h.BackgroundColor == 0 {
+			continue
+		}
+		paint.Color = h.BackgroundColor
+		for _, rect := range textLayout.RectsForRange(h.Start, h.End) {
+			canvas.DrawRect(rect, paint)
+		}
+	}
+}
+
+func (r *renderText) paintText(ctx *layout.PaintContext) {
 	// NOTE: No clipping here (matches Flutter's Clip.none default) so text shadows
 	// can paint outside bounds. This means all text can overflow, not just shadows.
 	// If overflow becomes an issue, consider conditional clipping:
@@ -217,7 +434,84 @@ func (r *renderText) Paint(ctx *layout.PaintContext) {
 	//       defer ctx.Canvas.Restore()
 	//   }
 	//
+	// Clip and fade opt in to clipping explicitly.
+	switch r.overflow {
+	case graphics.TextOverflowClip:
+		size := r.Size()
+		ctx.Canvas.Save()
+		ctx.Canvas.ClipRect(graphics.RectFromLTWH(0, 0, size.Width, size.Height))
+		ctx.Canvas.DrawText(r.layout, graphics.Offset{})
+		ctx.Canvas.Restore()
+		return
+	case graphics.TextOverflowFade:
+		if r.overflowed {
+			r.paintFaded(ctx)
+			return
+		}
+	}
+	ctx.Canvas.DrawText(r.layout, graphics.Offset{})
+}
+
+// paintFaded draws the text into a layer and fades out the trailing edge of
+// the last visible line with a DstIn gradient.
+func (r *renderText) paintFaded(ctx *layout.PaintContext) {
+	size := r.Size()
+	bounds := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+	fontSize := r.style.FontSize
+	if fontSize <= 0 {
+		fontSize = 16
+	}
+	fadeWidth := math.Min(fontSize*r.textScale*3, size.Width/2)
+	lineHeight := math.Min(r.layout.LineHeight, size.Height)
+	fadeRect, from, to := trailingFade(size, lineHeight, fadeWidth, r.direction)
+
+	layerPaint := graphics.DefaultPaint()
+	ctx.Canvas.Save()
+	ctx.Canvas.ClipRect(bounds)
+	ctx.Canvas.SaveLayer(bounds, &layerPaint)
 	ctx.Canvas.DrawText(r.layout, graphics.Offset{})
+	// DstIn only affects the fade rect, so earlier lines stay fully visible.
+	fadePaint := graphics.DefaultPaint()
+	fadePaint.BlendMode = graphics.BlendModeDstIn
+	fadePaint.Gradient = graphics.NewLinearGradient(from, to, []graphics.GradientStop{
+		{Position: 0, Color: graphics.ColorBlack},
+		{Position: 1, Color: graphics.ColorTransparent},
+	})
+	ctx.Canvas.DrawRect(fadeRect, fadePaint)
+	ctx.Canvas.Restore()
+	ctx.Canvas.Restore()
+}
+
+// trailingFade returns the area of the last line that fades out and the
+// gradient direction, from opaque to transparent. Lines end on the right for
+// left-to-right text and on the left for right-to-left text.
+func trailingFade(size graphics.Size, lineHeight, fadeWidth float64, direction graphics.TextDirection) (graphics.Rect, graphics.Alignment, graphics.Alignment) {
+	top := size.Height - lineHeight
+	if direction == graphics.TextDirectionRTL {
+		return graphics.RectFromLTWH(0, top, fadeWidth, lineHeight), graphics.AlignCenterRight, graphics.AlignCenterLeft
+	}
+	return graphics.RectFromLTWH(size.Width-fadeWidth, top, fadeWidth, lineHeight), graphics.AlignCenterLeft, graphics.AlignCenterRight
+}
+
+func (r *renderText) selectableText() string {
+	return r.text
+}
+
+func (r *renderText) caretOffsetAt(position graphics.Offset) int {
+	offset, _ := r.layout.CaretOffsetAt(position)
+	return offset
+}
+
+func (r *renderText) selectionRects(start, end int) []graphics.Rect {
+	return r.layout.RectsForRange(start, end)
+}
+
+func (r *renderText) setSelection(selection textSelection) {
+	if r.selection == selection {
+		return
+	}
+	r.selection = selection
+	r.MarkNeedsPaint()
 }
 
 func (r *renderText) HitTest(position graphics.Offset, result *layout.HitTestResult) bool {
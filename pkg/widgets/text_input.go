@@ -25,6 +25,10 @@ import (
 //   - Style.FontSize: 0 means zero (text won't render)
 //   - Style.Color: 0 means transparent text
 //
+// Style.FontSize is multiplied by [TextScaleFactorOf] before it reaches the
+// native field, and Height grows by the extra line height so enlarged text
+// is not clipped.
+//
 // # Recommended Usage
 //
 // For most use cases, prefer [TextField] which provides sensible defaults and
@@ -190,26 +194,33 @@ type textInputState struct {
 	platformView       *platform.TextInputView
 	focused            bool
 	focusNode          *focus.FocusNode
-	updatingController bool // suppress echo during programmatic updates
+	updatingController bool    // suppress echo during programmatic updates
+	textScale          float64 // text scale factor from the last build
+	// keepAlive keeps the input mounted in a lazy list while it has focus.
+	keepAlive *KeepAliveHandle
 }
 
 func (s *textInputState) InitState() {
+	s.keepAlive = NewKeepAliveHandle(s.Element())
 	// Create and register focus node for tab navigation
 	s.focusNode = &focus.FocusNode{
 		CanRequestFocus: true,
 		DebugLabel:      "TextInput",
 		Rect:            s, // s implements RectProvider
 		OnFocusChange: func(hasFocus bool) {
+			s.keepAlive.SetKeepAlive(hasFocus)
 			if hasFocus && !s.focused {
 				s.focus()
+				if s.Element() != nil {
+					EnsureVisible(s.Element().RenderObject(), ensureVisibleDuration)
+				}
 			} else if !hasFocus && s.focused {
 				s.unfocus()
 			}
 		},
 	}
-	manager := focus.GetFocusManager()
-	if manager.RootScope != nil {
-		manager.RootScope.Children = append(manager.RootScope.Children, s.focusNode)
+	if scope := FocusScopeOf(s.Element()); scope != nil {
+		scope.Attach(s.focusNode)
 	}
 }
 
@@ -222,23 +233,14 @@ func (s *textInputState) Dispose() {
 
 	// Remove focus node from scope
 	if s.focusNode != nil {
-		manager := focus.GetFocusManager()
-		if manager.RootScope != nil {
-			// Clear FocusedChild if it points to this node
-			if manager.RootScope.FocusedChild == s.focusNode {
-				manager.RootScope.FocusedChild = nil
-			}
-			// Remove from children
-			children := manager.RootScope.Children
-			for i, child := range children {
-				if child == s.focusNode {
-					manager.RootScope.Children = append(children[:i], children[i+1:]...)
-					break
-				}
-			}
+		// Detaching clears primary focus; don't react to it while disposing.
+		s.focusNode.OnFocusChange = nil
+		if scope := s.focusNode.Scope(); scope != nil {
+			scope.Detach(s.focusNode)
 		}
 		s.focusNode = nil
 	}
+	s.keepAlive.Release()
 	s.StateBase.Dispose()
 }
 
@@ -296,11 +298,18 @@ func (s *textInputState) FocusRect() focus.FocusRect {
 func (s *textInputState) Build(ctx core.BuildContext) core.Widget {
 	w := s.Element().Widget().(TextInput)
 
+	// The text scale factor is not part of the widget, so DidUpdateWidget
+	// cannot see it change; push it to the native view here.
+	if scale := TextScaleFactorOf(ctx); scale != s.textScale {
+		s.textScale = scale
+		s.updatePlatformViewConfig(w)
+	}
+
 	// Fully explicit: zero means zero, no fallbacks.
 	// Callers (TextField, theme.TextFieldOf) must provide all visual values.
 	return textInputRender{
 		width:        w.Width,
-		height:       w.Height,
+		height:       scaledInputHeight(w.Height, w.Style, s.textScale),
 		padding:      w.Padding,
 		bgColor:      w.BackgroundColor,
 		borderColor:  w.BorderColor,
@@ -383,7 +392,7 @@ func (s *textInputState) buildPlatformViewConfig(w TextInput) platform.TextInput
 	}
 	return platform.TextInputViewConfig{
 		FontFamily:       w.Style.FontFamily,
-		FontSize:         w.Style.FontSize,
+		FontSize:         w.Style.FontSize * s.fontScale(),
 		FontWeight:       int(w.Style.FontWeight),
 		TextColor:        uint32(w.Style.Color),
 		PlaceholderColor: uint32(w.PlaceholderColor),
@@ -402,6 +411,28 @@ func (s *textInputState) buildPlatformViewConfig(w TextInput) platform.TextInput
 	}
 }
 
+// fontScale returns the text scale factor to apply to the native font size,
+// or 1 before the first build.
+func (s *textInputState) fontScale() float64 {
+	if s.textScale <= 0 {
+		return 1
+	}
+	return s.textScale
+}
+
+// scaledInputHeight grows a field height by the extra line height that text
+// scaling adds. Scale factors below 1 keep the original height.
+func scaledInputHeight(height float64, style graphics.TextStyle, scale float64) float64 {
+	if scale <= 1 || height == 0 {
+		return height
+	}
+	lineHeight := style.Height
+	if lineHeight <= 0 {
+		lineHeight = 1.2 // typical default line height of system fonts
+	}
+	return height + style.FontSize*(scale-1)*lineHeight
+}
+
 func (s *textInputState) updatePlatformViewConfig(w TextInput) {
 	if s.platformView == nil {
 		return
@@ -711,7 +742,7 @@ func (r *renderTextInput) PlatformViewID() int64 {
 // HandlePointer implements PointerHandler for gesture recognition.
 func (r *renderTextInput) HandlePointer(event gestures.PointerEvent) {
 	if r.tap == nil {
-		r.tap = gestures.NewTapGestureRecognizer(gestures.DefaultArena)
+		r.tap = gestures.NewTapGestureRecognizer(r.GestureArena())
 		r.tap.OnTap = func() {
 			if r.state != nil {
 				r.state.focus()
@@ -0,0 +1,27 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/graphics"
+)
+
+func TestTrailingFade_FollowsDirection(t *testing.T) {
+	size := graphics.Size{Width: 200, Height: 60}
+
+	rect, from, to := trailingFade(size, 20, 48, graphics.TextDirectionLTR)
+	if want := graphics.RectFromLTWH(152, 40, 48, 20); rect != want {
+		t.Errorf("LTR fade rect = %+v, want %+v", rect, want)
+	}
+	if from != graphics.AlignCenterLeft || to != graphics.AlignCenterRight {
+		t.Errorf("LTR fade should run left to right, got %v to %v", from, to)
+	}
+
+	rect, from, to = trailingFade(size, 20, 48, graphics.TextDirectionRTL)
+	if want := graphics.RectFromLTWH(0, 40, 48, 20); rect != want {
+		t.Errorf("RTL fade rect = %+v, want %+v", rect, want)
+	}
+	if from != graphics.AlignCenterRight || to != graphics.AlignCenterLeft {
+		t.Errorf("RTL fade should run right to left, got %v to %v", from, to)
+	}
+}
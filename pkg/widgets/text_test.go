@@ -174,3 +174,31 @@ func findOpIndex(ops []drifttest.DisplayOp, name string) int {
 	}
 	return -1
 }
+
+func TestText_WithOverflow(t *testing.T) {
+	txt := widgets.Text{Content: "long", MaxLines: 2}.WithOverflow(graphics.TextOverflowFade)
+
+	if txt.Overflow != graphics.TextOverflowFade {
+		t.Errorf("expected Overflow fade, got %v", txt.Overflow)
+	}
+	if txt.MaxLines != 2 {
+		t.Errorf("WithOverflow should preserve MaxLines, got %d", txt.MaxLines)
+	}
+}
+
+func TestText_Highlights(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+
+	content := "Café au lait"
+	search := graphics.SearchHighlighter{Query: "cafe", BackgroundColor: graphics.RGB(255, 235, 59)}
+	tester.PumpWidget(widgets.Text{Content: content, Highlights: search.Highlights(content)})
+
+	result := tester.Find(drifttest.ByText(content))
+	if !result.Exists() || result.RenderObject() == nil {
+		t.Fatal("expected highlighted Text to render")
+	}
+	txt := result.Widget().(widgets.Text)
+	if len(txt.Highlights) != 1 || txt.Highlights[0].End != len("Café") {
+		t.Errorf("unexpected highlights %+v", txt.Highlights)
+	}
+}
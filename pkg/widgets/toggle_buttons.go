@@ -0,0 +1,189 @@
+package widgets
+
+import (
+	"slices"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+	"github.com/go-drift/drift/pkg/platform"
+	"github.com/go-drift/drift/pkg/semantics"
+)
+
+// ToggleButtons displays a row of joined buttons that are each switched on
+// and off independently, such as the bold, italic, and underline buttons of
+// a text toolbar. For a single choice, use [SegmentedControl].
+//
+// # Styling Model
+//
+// ToggleButtons is explicit by default — all visual properties use their
+// struct field values directly. A zero value means zero, not "use theme
+// default." For example:
+//
+//   - SelectedColor: 0 means selected buttons have no fill
+//   - BorderColor: 0 means no outline or separators
+//   - Height: 0 means zero height (not rendered)
+//
+// For theme-styled toggle buttons, use [theme.ToggleButtonsOf] which
+// pre-fills visual properties from the current theme.
+//
+// # Creation Patterns
+//
+// Explicit with struct literal (full control):
+//
+//	widgets.ToggleButtons[string]{
+//	    Segments: []widgets.Segment[string]{
+//	        {Value: "bold", Label: "B"},
+//	        {Value: "italic", Label: "I"},
+//	    },
+//	    Selected:           s.styles,
+//	    OnChanged:          func(v []string) { s.SetState(func() { s.styles = v }) },
+//	    SelectedColor:      colors.SecondaryContainer,
+//	    BorderColor:        colors.Outline,
+//	    LabelStyle:         graphics.TextStyle{FontSize: 14, Color: colors.OnSurface},
+//	    SelectedLabelColor: colors.OnSecondaryContainer,
+//	    Height:             40,
+//	    BorderRadius:       20,
+//	    Padding:            layout.EdgeInsetsSymmetric(12, 0),
+//	}
+//
+// Themed (reads from current theme):
+//
+//	theme.ToggleButtonsOf(ctx, segments, s.styles, onChanged)
+type ToggleButtons[T comparable] struct {
+	core.StatelessBase
+
+	// Segments are the buttons, from start to end.
+	Segments []Segment[T]
+	// Selected holds the values of the buttons that are on.
+	Selected []T
+	// OnChanged is called with the new selection when a button is tapped.
+	// The selection keeps the order of Segments.
+	OnChanged func([]T)
+	// Disabled disables interaction and fades the buttons when true.
+	Disabled bool
+	// Haptic plays a selection click when a button is toggled.
+	Haptic bool
+
+	// BackgroundColor fills the buttons that are off.
+	BackgroundColor graphics.Color
+	// SelectedColor fills the buttons that are on.
+	SelectedColor graphics.Color
+	// BorderColor is the color of the outline and the lines between buttons.
+	BorderColor graphics.Color
+	// BorderWidth is the width of the outline and the lines between buttons.
+	BorderWidth float64
+	// LabelStyle styles the button labels.
+	LabelStyle graphics.TextStyle
+	// SelectedLabelColor is the label color of the buttons that are on.
+	SelectedLabelColor graphics.Color
+	// Height is the height of the buttons.
+	Height float64
+	// BorderRadius rounds the outer corners of the group.
+	BorderRadius float64
+	// Padding surrounds each button's label.
+	Padding layout.EdgeInsets
+}
+
+func (b ToggleButtons[T]) Build(ctx core.BuildContext) core.Widget {
+	enabled := !b.Disabled && b.OnChanged != nil
+	children := make([]core.Widget, 0, 2*len(b.Segments))
+	for i, segment := range b.Segments {
+		if i > 0 && b.BorderWidth > 0 {
+			children = append(children, VerticalDivider{Width: b.BorderWidth, Thickness: b.BorderWidth, Color: b.BorderColor})
+		}
+		children = append(children, b.buildButton(segment, enabled))
+	}
+
+	var result core.Widget = SizedBox{
+		Height: b.Height,
+		Child: DecoratedBox{
+			Color:        b.BackgroundColor,
+			BorderColor:  b.BorderColor,
+			BorderWidth:  b.BorderWidth,
+			BorderRadius: b.BorderRadius,
+			Overflow:     OverflowClip,
+			Child: Row{
+				Children:           children,
+				MainAxisSize:       MainAxisSizeMin,
+				CrossAxisAlignment: CrossAxisAlignmentStretch,
+			},
+		},
+	}
+	if !enabled {
+		result = Opacity{Opacity: 0.5, Child: result}
+	}
+	return result
+}
+
+// buildButton creates one button of the group.
+func (b ToggleButtons[T]) buildButton(segment Segment[T], enabled bool) core.Widget {
+	selected := slices.Contains(b.Selected, segment.Value)
+	style := b.LabelStyle
+	fill := graphics.ColorTransparent
+	if selected {
+		style.Color = b.SelectedLabelColor
+		fill = b.SelectedColor
+	}
+	child := segment.Child
+	if child == nil {
+		child = Text{Content: segment.Label, Style: style, MaxLines: 1}
+	}
+
+	var onTap func()
+	if enabled && !segment.Disabled {
+		onTap = func() {
+			if b.Haptic {
+				platform.Haptics.SelectionClick()
+			}
+			b.OnChanged(b.toggled(segment.Value))
+		}
+	}
+
+	flags := semantics.SemanticsHasToggledState | semantics.SemanticsHasEnabledState
+	if selected {
+		flags = flags.Set(semantics.SemanticsIsToggled)
+	}
+	if onTap != nil {
+		flags = flags.Set(semantics.SemanticsIsEnabled)
+	}
+
+	var content core.Widget = DecoratedBox{
+		Color: fill,
+		Child: Padding{Padding: b.Padding, Child: Center{Child: child}},
+	}
+	if segment.Disabled {
+		content = Opacity{Opacity: 0.5, Child: content}
+	}
+	// A text label is merged in from the Text; a custom child needs Label.
+	var label string
+	if segment.Child != nil {
+		label = segment.Label
+	}
+	return Semantics{
+		Label:            label,
+		Role:             semantics.SemanticsRoleButton,
+		Flags:            flags,
+		Container:        true,
+		MergeDescendants: true,
+		OnTap:            onTap,
+		Child:            GestureDetector{OnTap: onTap, Child: content},
+	}
+}
+
+// toggled returns the selection with value switched on or off, in the
+// order of Segments.
+func (b ToggleButtons[T]) toggled(value T) []T {
+	on := !slices.Contains(b.Selected, value)
+	selection := make([]T, 0, len(b.Selected)+1)
+	for _, segment := range b.Segments {
+		if segment.Value == value {
+			if on {
+				selection = append(selection, value)
+			}
+		} else if slices.Contains(b.Selected, segment.Value) {
+			selection = append(selection, segment.Value)
+		}
+	}
+	return selection
+}
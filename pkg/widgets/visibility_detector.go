@@ -0,0 +1,175 @@
+package widgets
+
+import (
+	"sync"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	"github.com/go-drift/drift/pkg/layout"
+)
+
+// VisibilityInfo describes how much of a [VisibilityDetector] is on screen.
+type VisibilityInfo struct {
+	// Size is the detector's laid-out size.
+	Size graphics.Size
+	// VisibleBounds is the visible part of the detector in its own
+	// coordinates. It is empty when nothing is visible.
+	VisibleBounds graphics.Rect
+	// VisibleFraction is the visible area divided by the total area, from
+	// 0 to 1. A detector with no area reports 0.
+	VisibleFraction float64
+}
+
+// VisibilityDetector reports how much of its child is visible inside the
+// enclosing scroll views and the screen.
+//
+// Visibility is checked once per frame, after paint, and OnVisibilityChanged
+// is called only when it changed since the last report. The first report
+// comes after the detector's first frame. A detector that is removed while
+// visible reports a zero VisibilityInfo on the next frame. Content hidden
+// by an [Offstage] counts as not visible; other clips and overlapping
+// widgets are not taken into account.
+//
+// Use it for impression tracking, to start video playback when a player
+// scrolls into view, or to load data lazily:
+//
+//	widgets.VisibilityDetector{
+//	    OnVisibilityChanged: func(info widgets.VisibilityInfo) {
+//	        if info.VisibleFraction >= 0.5 {
+//	            controller.Play()
+//	        } else {
+//	            controller.Pause()
+//	        }
+//	    },
+//	    Child: player,
+//	}
+type VisibilityDetector struct {
+	core.RenderObjectBase
+
+	// OnVisibilityChanged is called with the new visibility when it
+	// changes.
+	OnVisibilityChanged func(info VisibilityInfo)
+	// Child is the widget whose visibility is tracked.
+	Child core.Widget
+}
+
+func (v VisibilityDetector) ChildWidget() core.Widget {
+	return v.Child
+}
+
+func (v VisibilityDetector) CreateRenderObject(ctx core.BuildContext) layout.RenderObject {
+	detector := &renderVisibilityDetector{onChanged: v.OnVisibilityChanged}
+	detector.SetSelf(detector)
+	visibilityMu.Lock()
+	visibilityDetectors[detector] = struct{}{}
+	visibilityMu.Unlock()
+	return detector
+}
+
+func (v VisibilityDetector) UpdateRenderObject(ctx core.BuildContext, renderObject layout.RenderObject) {
+	if detector, ok := renderObject.(*renderVisibilityDetector); ok {
+		detector.onChanged = v.OnVisibilityChanged
+	}
+}
+
+type renderVisibilityDetector struct {
+	renderPassthrough
+	onChanged func(info VisibilityInfo)
+	reported  bool
+	last      VisibilityInfo
+}
+
+// Dispose stops tracking the detector. If it was visible, a final zero
+// report is queued for the next frame.
+func (r *renderVisibilityDetector) Dispose() {
+	visibilityMu.Lock()
+	delete(visibilityDetectors, r)
+	if r.reported && r.last.VisibleFraction > 0 && r.onChanged != nil {
+		visibilityRemoved = append(visibilityRemoved, r.onChanged)
+	}
+	visibilityMu.Unlock()
+	r.renderPassthrough.Dispose()
+}
+
+// visibility computes the detector's current visibility by walking up the
+// render tree, clipping to each scroll view's viewport and to the root.
+func (r *renderVisibilityDetector) visibility() VisibilityInfo {
+	size := r.Size()
+	info := VisibilityInfo{Size: size}
+	bounds := graphics.RectFromLTWH(0, 0, size.Width, size.Height)
+	if bounds.IsEmpty() {
+		return info
+	}
+	visible := bounds
+	var origin graphics.Offset // the detector's origin in node's coordinates
+	var node layout.RenderObject = r
+	for {
+		parent := renderParentOf(node)
+		if parent == nil {
+			break
+		}
+		if offstage, ok := parent.(*renderOffstage); ok && offstage.offstage {
+			return info
+		}
+		offset := offsetInRenderParent(node, parent)
+		origin = graphics.Offset{X: origin.X + offset.X, Y: origin.Y + offset.Y}
+		visible = visible.Translate(offset.X, offset.Y)
+		if _, ok := parent.(*renderScrollView); ok {
+			parentSize := parent.(layout.RenderBox).Size()
+			visible = visible.Intersect(graphics.RectFromLTWH(0, 0, parentSize.Width, parentSize.Height))
+		}
+		if visible.IsEmpty() {
+			return info
+		}
+		node = parent
+	}
+	if root, ok := node.(layout.RenderBox); ok {
+		rootSize := root.Size()
+		visible = visible.Intersect(graphics.RectFromLTWH(0, 0, rootSize.Width, rootSize.Height))
+	}
+	if visible.IsEmpty() {
+		return info
+	}
+	info.VisibleBounds = visible.Translate(-origin.X, -origin.Y)
+	info.VisibleFraction = visible.Width() * visible.Height() / (size.Width * size.Height)
+	return info
+}
+
+var (
+	visibilityMu        sync.Mutex
+	visibilityDetectors = make(map[*renderVisibilityDetector]struct{})
+	visibilityRemoved   []func(info VisibilityInfo)
+)
+
+// FlushVisibility checks every [VisibilityDetector] and calls
+// OnVisibilityChanged for those whose visibility changed. The engine calls
+// it once per frame after paint; widget testers call it from Pump.
+func FlushVisibility() {
+	visibilityMu.Lock()
+	if len(visibilityDetectors) == 0 && len(visibilityRemoved) == 0 {
+		visibilityMu.Unlock()
+		return
+	}
+	detectors := make([]*renderVisibilityDetector, 0, len(visibilityDetectors))
+	for detector := range visibilityDetectors {
+		detectors = append(detectors, detector)
+	}
+	removed := visibilityRemoved
+	visibilityRemoved = nil
+	visibilityMu.Unlock()
+
+	for _, callback := range removed {
+		callback(VisibilityInfo{})
+	}
+	for _, detector := range detectors {
+		info := detector.visibility()
+		if detector.reported && info == detector.last {
+			continue
+		}
+		detector.reported = true
+		detector.last = info
+		if detector.onChanged != nil {
+			detector.onChanged(info)
+		}
+	}
+}
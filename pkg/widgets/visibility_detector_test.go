@@ -0,0 +1,102 @@
+package widgets_test
+
+import (
+	"testing"
+
+	"github.com/go-drift/drift/pkg/core"
+	"github.com/go-drift/drift/pkg/graphics"
+	drifttest "github.com/go-drift/drift/pkg/testing"
+	"github.com/go-drift/drift/pkg/widgets"
+)
+
+func TestVisibilityDetector_ReportsVisibleFraction(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	controller := &widgets.ScrollController{}
+	var reports []widgets.VisibilityInfo
+	tester.PumpWidget(widgets.ScrollView{
+		Controller: controller,
+		Child: widgets.Column{Children: []core.Widget{
+			widgets.SizedBox{Height: 500},
+			widgets.VisibilityDetector{
+				OnVisibilityChanged: func(info widgets.VisibilityInfo) {
+					reports = append(reports, info)
+				},
+				Child: widgets.SizedBox{Width: 100, Height: 200},
+			},
+			widgets.SizedBox{Height: 1000},
+		}},
+	})
+
+	// The viewport is 600 tall, so the top 100 of the detector shows.
+	if len(reports) != 1 {
+		t.Fatalf("expected one report after the first frame, got %d", len(reports))
+	}
+	if got := reports[0].VisibleFraction; got != 0.5 {
+		t.Errorf("expected fraction 0.5, got %v", got)
+	}
+	if got, want := reports[0].VisibleBounds, graphics.RectFromLTWH(0, 0, 100, 100); got != want {
+		t.Errorf("expected visible bounds %v, got %v", want, got)
+	}
+
+	// Unchanged frames don't report.
+	tester.Pump()
+	if len(reports) != 1 {
+		t.Fatalf("expected no report for an unchanged frame, got %d", len(reports))
+	}
+
+	controller.JumpTo(150)
+	tester.Pump()
+	if got := reports[len(reports)-1].VisibleFraction; got != 1 {
+		t.Errorf("expected fully visible after scrolling, got %v", got)
+	}
+
+	controller.JumpTo(800)
+	tester.Pump()
+	last := reports[len(reports)-1]
+	if last.VisibleFraction != 0 || !last.VisibleBounds.IsEmpty() {
+		t.Errorf("expected invisible after scrolling past, got %+v", last)
+	}
+}
+
+func TestVisibilityDetector_ReportsRemovalAndOffstage(t *testing.T) {
+	tester := drifttest.NewWidgetTesterWithT(t)
+	var reports []float64
+	detector := widgets.VisibilityDetector{
+		OnVisibilityChanged: func(info widgets.VisibilityInfo) {
+			reports = append(reports, info.VisibleFraction)
+		},
+		Child: widgets.SizedBox{Width: 50, Height: 50},
+	}
+	var setStep func(func(int) int)
+	tester.PumpWidget(core.Stateful(
+		func() int { return 0 },
+		func(step int, ctx core.BuildContext, setState func(func(int) int)) core.Widget {
+			setStep = setState
+			switch step {
+			case 0:
+				return widgets.Offstage{Offstage: false, Child: detector}
+			case 1:
+				return widgets.Offstage{Offstage: true, Child: detector}
+			case 2:
+				return widgets.Offstage{Offstage: false, Child: detector}
+			default:
+				return widgets.SizedBox{}
+			}
+		},
+	))
+	for range 3 {
+		setStep(func(step int) int { return step + 1 })
+		tester.Pump()
+	}
+	tester.Pump()
+
+	want := []float64{1, 0, 1, 0}
+	if len(reports) != len(want) {
+		t.Fatalf("expected reports %v, got %v", want, reports)
+	}
+	for i := range want {
+		if reports[i] != want[i] {
+			t.Fatalf("expected reports %v, got %v", want, reports)
+		}
+	}
+}